@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package bundle contains the bundle logic for the control plane
+package bundle
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+)
+
+// BundleCmd is the root command for the bundle subcommands
+var BundleCmd = &cobra.Command{
+	Use:   "bundle",
+	Short: "Manage bundle subscriptions within a minder control plane",
+	Long: `The bundle subcommand allows browsing the bundles of rule types, profiles
+and data sources available in the marketplace, and managing the subscriptions
+of projects to them.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+func init() {
+	app.RootCmd.AddCommand(BundleCmd)
+	// Flags for all subcommands
+	BundleCmd.PersistentFlags().StringP("project", "j", "", "ID of the project")
+}
+
+// parseBundle splits a bundle reference of the form namespace/name
+func parseBundle(bundle string) (string, string, error) {
+	namespace, name, ok := strings.Cut(bundle, "/")
+	if !ok || namespace == "" || name == "" {
+		return "", "", fmt.Errorf("invalid bundle %q, expected namespace/name", bundle)
+	}
+	return namespace, name, nil
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var subscribeCmd = &cobra.Command{
+	Use:   "subscribe",
+	Short: "Subscribe a project to a bundle",
+	Long: `The bundle subscribe subcommand subscribes a project to the latest version
+of a bundle, adding the rule types and data sources of the bundle to the
+project. Profiles from the bundle are not enabled automatically.`,
+	RunE: cli.GRPCClientWrapRunE(subscribeCommand),
+}
+
+// subscribeCommand is the bundle subscribe subcommand
+func subscribeCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewMarketplaceServiceClient(conn)
+
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+	namespace, name, err := parseBundle(viper.GetString("bundle"))
+	if err != nil {
+		return err
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.SubscribeBundle(ctx, &minderv1.SubscribeBundleRequest{
+		Context: &minderv1.ContextV2{
+			ProjectId: project,
+		},
+		Namespace: namespace,
+		Name:      name,
+	})
+	if err != nil {
+		return cli.MessageAndError("Failed to subscribe to bundle", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	default:
+		cmd.Printf("Subscribed to bundle %s/%s at version %s\n",
+			namespace, name, resp.GetSubscription().GetVersion())
+	}
+
+	return nil
+}
+
+func init() {
+	BundleCmd.AddCommand(subscribeCmd)
+
+	subscribeCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	subscribeCmd.Flags().StringP("bundle", "b", "", "Bundle to subscribe to, as namespace/name")
+	if err := subscribeCmd.MarkFlagRequired("bundle"); err != nil {
+		subscribeCmd.Printf("Error marking flag required: %s", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var subscriptionsCmd = &cobra.Command{
+	Use:   "subscriptions",
+	Short: "List bundle subscriptions",
+	Long: `The bundle subscriptions subcommand lists the bundles a project and its
+child projects are subscribed to, along with the version each project is on.`,
+	RunE: cli.GRPCClientWrapRunE(subscriptionsCommand),
+}
+
+// subscriptionsCommand is the bundle subscriptions subcommand
+func subscriptionsCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewMarketplaceServiceClient(conn)
+
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.ListBundleSubscriptions(ctx, &minderv1.ListBundleSubscriptionsRequest{
+		Context: &minderv1.ContextV2{
+			ProjectId: project,
+		},
+	})
+	if err != nil {
+		return cli.MessageAndError("Failed to list bundle subscriptions", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := table.New(table.Simple, layouts.Default, []string{"Project", "Bundle", "Version", "Latest"})
+		for _, s := range resp.GetSubscriptions() {
+			t.AddRow(s.GetProjectName(), s.GetNamespace()+"/"+s.GetName(), s.GetVersion(), s.GetLatestVersion())
+		}
+		t.Render()
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+
+	return nil
+}
+
+func init() {
+	BundleCmd.AddCommand(subscriptionsCmd)
+
+	subscriptionsCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+}
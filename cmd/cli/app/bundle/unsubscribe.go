@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var unsubscribeCmd = &cobra.Command{
+	Use:   "unsubscribe",
+	Short: "Unsubscribe a project from a bundle",
+	Long: `The bundle unsubscribe subcommand removes the subscription of a project to a
+bundle, deleting the rule types, profiles and data sources of the bundle from
+the project. It fails if profiles outside the bundle use its rule types.`,
+	RunE: cli.GRPCClientWrapRunE(unsubscribeCommand),
+}
+
+// unsubscribeCommand is the bundle unsubscribe subcommand
+func unsubscribeCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewMarketplaceServiceClient(conn)
+
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+	namespace, name, err := parseBundle(viper.GetString("bundle"))
+	if err != nil {
+		return err
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	_, err = client.UnsubscribeBundle(ctx, &minderv1.UnsubscribeBundleRequest{
+		Context: &minderv1.ContextV2{
+			ProjectId: project,
+		},
+		Namespace: namespace,
+		Name:      name,
+	})
+	if err != nil {
+		return cli.MessageAndError("Failed to unsubscribe from bundle", err)
+	}
+
+	switch format {
+	case app.JSON:
+		cmd.Println(`{"status": "success"}`)
+	case app.YAML:
+		cmd.Println("status: success")
+	default:
+		cmd.Printf("Unsubscribed from bundle %s/%s\n", namespace, name)
+	}
+	return nil
+}
+
+func init() {
+	BundleCmd.AddCommand(unsubscribeCmd)
+
+	unsubscribeCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	unsubscribeCmd.Flags().StringP("bundle", "b", "", "Bundle to unsubscribe from, as namespace/name")
+	if err := unsubscribeCmd.MarkFlagRequired("bundle"); err != nil {
+		unsubscribeCmd.Printf("Error marking flag required: %s", err)
+		os.Exit(1)
+	}
+}
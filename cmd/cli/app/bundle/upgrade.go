@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "Upgrade a bundle subscription",
+	Long: `The bundle upgrade subcommand upgrades the subscription of a project to a
+newer version of a bundle, updating the rule types, data sources and profiles
+of the bundle in the project. Use --dry-run to preview the changes first.`,
+	RunE: cli.GRPCClientWrapRunE(upgradeCommand),
+}
+
+// upgradeCommand is the bundle upgrade subcommand
+func upgradeCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewMarketplaceServiceClient(conn)
+
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+	version := viper.GetString("version")
+	dryRun := viper.GetBool("dry-run")
+	namespace, name, err := parseBundle(viper.GetString("bundle"))
+	if err != nil {
+		return err
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.UpgradeBundle(ctx, &minderv1.UpgradeBundleRequest{
+		Context: &minderv1.ContextV2{
+			ProjectId: project,
+		},
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+		DryRun:    dryRun,
+	})
+	if err != nil {
+		return cli.MessageAndError("Failed to upgrade bundle", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := table.New(table.Simple, layouts.Default, []string{"Kind", "Name", "Change"})
+		addDiffRows(t, "rule type", resp.GetDiff().GetRuleTypes())
+		addDiffRows(t, "data source", resp.GetDiff().GetDataSources())
+		addDiffRows(t, "profile", resp.GetDiff().GetProfiles())
+		t.Render()
+		if resp.GetApplied() {
+			cmd.Printf("Upgraded bundle %s/%s from %s to %s\n",
+				namespace, name, resp.GetFromVersion(), resp.GetToVersion())
+		} else {
+			cmd.Printf("Upgrading bundle %s/%s from %s to %s would make the changes above\n",
+				namespace, name, resp.GetFromVersion(), resp.GetToVersion())
+		}
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+
+	return nil
+}
+
+func addDiffRows(t table.Table, kind string, entries []*minderv1.BundleDiffEntry) {
+	for _, entry := range entries {
+		if entry.GetChange() == minderv1.BundleDiffEntry_CHANGE_UNCHANGED {
+			continue
+		}
+		change := strings.ToLower(strings.TrimPrefix(entry.GetChange().String(), "CHANGE_"))
+		t.AddRow(kind, entry.GetName(), change)
+	}
+}
+
+func init() {
+	BundleCmd.AddCommand(upgradeCmd)
+
+	upgradeCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	upgradeCmd.Flags().StringP("bundle", "b", "", "Bundle to upgrade, as namespace/name")
+	upgradeCmd.Flags().String("version", "", "Version to upgrade to (defaults to the latest version)")
+	upgradeCmd.Flags().Bool("dry-run", false, "Preview the changes of the upgrade without applying them")
+	if err := upgradeCmd.MarkFlagRequired("bundle"); err != nil {
+		upgradeCmd.Printf("Error marking flag required: %s", err)
+		os.Exit(1)
+	}
+}
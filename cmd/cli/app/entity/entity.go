@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package entity provides the CLI subcommand for inspecting entities
+package entity
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+)
+
+// EntityCmd is the root command for the entity subcommands
+var EntityCmd = &cobra.Command{
+	Use:   "entity",
+	Short: "Inspect entities within a minder control plane",
+	Long:  `The entity subcommand allows inspecting the entities registered within Minder.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+func init() {
+	app.RootCmd.AddCommand(EntityCmd)
+	// Flags for all subcommands
+	EntityCmd.PersistentFlags().StringP("project", "j", "", "ID of the project")
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "List the property changes of an entity",
+	Long: `The entity history subcommand lets you list the recorded changes of the
+properties of an entity, newest first, along with what triggered them
+(webhook, refresh, reconcile or registration), e.g.:
+
+  minder entity history --id 2e2c3a1f-0e1e-4a4f-9c42-6f8a3b7d2c11 --key is_private
+
+Changes older than the retention period configured on the server are purged.`,
+	RunE: cli.GRPCClientWrapRunE(historyCommand),
+}
+
+// historyCommand is the entity history subcommand
+func historyCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewEntityInstanceServiceClient(conn)
+
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.ListEntityPropertyHistory(ctx, &minderv1.ListEntityPropertyHistoryRequest{
+		Context: &minderv1.ContextV2{ProjectId: project},
+		Id:      viper.GetString("id"),
+		Key:     viper.GetString("key"),
+		Cursor: &minderv1.Cursor{
+			Cursor: viper.GetString("cursor"),
+			Size:   viper.GetUint32("size"),
+		},
+	})
+	if err != nil {
+		return cli.MessageAndError("Error listing entity property history", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := table.New(table.Simple, layouts.Default,
+			[]string{"Changed At", "Property", "Old Value", "New Value", "Source"})
+		for _, change := range resp.GetResults() {
+			t.AddRow(
+				change.GetChangedAt().AsTime().Local().Format(time.DateTime),
+				change.GetKey(),
+				valueToString(change.GetOldValue()),
+				valueToString(change.GetNewValue()),
+				change.GetSource(),
+			)
+		}
+		t.Render()
+		if next := resp.GetPage().GetNext().GetCursor(); next != "" {
+			cmd.Printf("More changes are available, use --cursor %s to list them\n", next)
+		}
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+	return nil
+}
+
+// valueToString renders a property value for the table output,
+// a missing value means the property was added or removed
+func valueToString(v *structpb.Value) string {
+	if v == nil {
+		return "-"
+	}
+	if s, ok := v.GetKind().(*structpb.Value_StringValue); ok {
+		return s.StringValue
+	}
+	out, err := protojson.Marshal(v)
+	if err != nil {
+		return v.String()
+	}
+	return string(out)
+}
+
+func init() {
+	EntityCmd.AddCommand(historyCmd)
+	// Flags
+	historyCmd.Flags().StringP("id", "i", "", "ID of the entity")
+	historyCmd.Flags().StringP("key", "k", "", "Only list the changes of this property")
+	historyCmd.Flags().StringP("cursor", "c", "", "Cursor of the page of changes to list")
+	historyCmd.Flags().Uint32P("size", "s", 20, "Number of changes to list")
+	historyCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	// Required
+	if err := historyCmd.MarkFlagRequired("id"); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var explainCmd = &cobra.Command{
+	Use:   "explain",
+	Short: "Explain an evaluation",
+	Long: `The history explain subcommand evaluates again the rule and entity of a past
+evaluation, and explains how the rule's Rego policy came to its result. It
+shows the output of the print statements of the policy, which of its rules
+matched, and the input it was evaluated with, with sensitive values redacted.
+
+The evaluation is re-run against the current state of the entity and rule, it
+is not a replay of the past one, so its result may differ. No actions are
+taken, and the result of the new evaluation isn't stored in the evaluation
+history. Use --trace to also show the full trace of the evaluation.
+
+Explaining an evaluation requires the permission to update profiles in the
+project, as the input of the policy is shown.`,
+	RunE: cli.GRPCClientWrapRunE(explainCommand),
+}
+
+// explainCommand is the history "explain" subcommand
+func explainCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewEvalResultsServiceClient(conn)
+
+	project := viper.GetString("project")
+	id := viper.GetString("id")
+	trace := viper.GetBool("trace")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.ExplainEvaluation(ctx, &minderv1.ExplainEvaluationRequest{
+		Context: &minderv1.Context{Project: &project},
+		Id:      id,
+		Trace:   trace,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error explaining evaluation", err)
+	}
+
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		printExplanation(cmd, resp.GetExplanation())
+	}
+
+	return nil
+}
+
+func printExplanation(cmd *cobra.Command, explanation *minderv1.EvaluationExplanation) {
+	eval := explanation.GetEvaluation()
+	cmd.Printf("Rule %s of profile %s on %s\n",
+		eval.GetRule().GetName(), eval.GetRule().GetProfile(), eval.GetEntity().GetName())
+	cmd.Printf("Past status: %s %s\n", eval.GetStatus().GetStatus(), eval.GetStatus().GetDetails())
+	cmd.Printf("New status:  %s %s\n", explanation.GetStatus().GetStatus(), explanation.GetStatus().GetDetails())
+
+	if len(explanation.GetPrints()) > 0 {
+		cmd.Println("\nPrint output:")
+		for _, line := range explanation.GetPrints() {
+			cmd.Printf("  %s\n", line)
+		}
+	}
+
+	if len(explanation.GetRules()) > 0 {
+		cmd.Println("")
+		rulesTable := table.New(table.Simple, layouts.Default, []string{"Rule", "Location", "Matched"})
+		for _, rule := range explanation.GetRules() {
+			rulesTable.AddRow(rule.GetName(), rule.GetLocation(), fmt.Sprintf("%t", rule.GetMatched()))
+		}
+		rulesTable.Render()
+	}
+
+	if explanation.GetInput() != nil {
+		input, err := json.MarshalIndent(explanation.GetInput().AsMap(), "  ", "  ")
+		if err == nil {
+			cmd.Printf("\nInput:\n  %s\n", input)
+		}
+	}
+
+	if len(explanation.GetTrace()) > 0 {
+		cmd.Println("\nTrace:")
+		for _, line := range explanation.GetTrace() {
+			cmd.Printf("  %s\n", line)
+		}
+	}
+}
+
+func init() {
+	historyCmd.AddCommand(explainCmd)
+	// Flags
+	explainCmd.Flags().StringP("id", "i", "", "ID of the evaluation to explain")
+	explainCmd.Flags().Bool("trace", false, "Show the full trace of the evaluation")
+	// Required
+	if err := explainCmd.MarkFlagRequired("id"); err != nil {
+		explainCmd.Printf("Error marking flag required: %s", err)
+		os.Exit(1)
+	}
+}
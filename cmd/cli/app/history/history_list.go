@@ -248,6 +248,8 @@ var evalStatuses = []string{
 	string(db.EvalStatusTypesError),
 	string(db.EvalStatusTypesSuccess),
 	string(db.EvalStatusTypesSkipped),
+	string(db.EvalStatusTypesTimeout),
+	string(db.EvalStatusTypesWaived),
 }
 
 var remediationStatuses = []string{
@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package history
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/types"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Watch evaluations as they happen",
+	Long: `The watch command streams the evaluations of a project as they are recorded,
+along with the status of their remediations and alerts, until interrupted.
+
+The evaluations can be filtered like with the history list subcommand. They are
+rendered as table rows, or as JSON lines with --output json.`,
+	RunE: cli.GRPCClientWrapRunE(watchCommand),
+}
+
+// watchCommand is the "watch" command
+func watchCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewEvalResultsServiceClient(conn)
+
+	project := viper.GetString("project")
+	profileName := viper.GetStringSlice("profile-name")
+	entityName := viper.GetStringSlice("entity-name")
+	entityType := viper.GetStringSlice("entity-type")
+	evalStatus := viper.GetStringSlice("eval-status")
+	remediationStatus := viper.GetStringSlice("remediation-status")
+	alertStatus := viper.GetStringSlice("alert-status")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if format != app.Table && format != app.JSON {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// validate the filters which need validation
+	if err := validatedFilter(evalStatus, evalStatuses); err != nil {
+		return err
+	}
+
+	if err := validatedFilter(remediationStatus, remediationStatuses); err != nil {
+		return err
+	}
+
+	if err := validatedFilter(alertStatus, alertStatuses); err != nil {
+		return err
+	}
+
+	if err := validatedFilter(entityType, entityTypes); err != nil {
+		return err
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	// The watch goes on until interrupted, so it isn't bound by the
+	// timeout of the command's context
+	stream, err := client.WatchEvaluations(context.WithoutCancel(ctx), &minderv1.WatchEvaluationsRequest{
+		Context:     &minderv1.Context{Project: &project},
+		EntityType:  entityType,
+		EntityName:  entityName,
+		ProfileName: profileName,
+		Status:      evalStatus,
+		Remediation: remediationStatus,
+		Alert:       alertStatus,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error watching evaluations", err)
+	}
+
+	var printer func(*minderv1.EvaluationHistory) error
+	switch format {
+	case app.JSON:
+		printer = func(eval *minderv1.EvaluationHistory) error {
+			out, err := protojson.Marshal(eval)
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(out))
+			return nil
+		}
+	case app.Table:
+		w := newWatchTable(cmd.OutOrStdout(), viper.GetBool("emoji"))
+		printer = w.print
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return cli.MessageAndError("Error watching evaluations", err)
+		}
+		if err := printer(resp.GetEvaluation()); err != nil {
+			return cli.MessageAndError("Error printing evaluation", err)
+		}
+	}
+}
+
+// watchTable prints the evaluations as rows of a table, as they are received
+type watchTable struct {
+	w     *tabwriter.Writer
+	emoji bool
+}
+
+func newWatchTable(out io.Writer, emoji bool) *watchTable {
+	t := &watchTable{
+		w:     tabwriter.NewWriter(out, 0, 0, 2, ' ', 0),
+		emoji: emoji,
+	}
+	_, _ = fmt.Fprintln(t.w, strings.Join([]string{"TIME", "ENTITY", "PROFILE", "RULE", "STATUS"}, "\t"))
+	return t
+}
+
+func (t *watchTable) print(eval *minderv1.EvaluationHistory) error {
+	row := []string{
+		eval.GetEvaluatedAt().AsTime().Local().Format(time.DateTime),
+		eval.GetEntity().GetName(),
+		eval.GetRule().GetProfile(),
+		eval.GetRule().GetName(),
+		table.GetStatusIcon(types.HistoryStatus(eval), t.emoji).Column,
+	}
+	if _, err := fmt.Fprintln(t.w, strings.Join(row, "\t")); err != nil {
+		return err
+	}
+	// Flush each row, as rows are printed as they are received
+	return t.w.Flush()
+}
+
+func init() {
+	app.RootCmd.AddCommand(watchCmd)
+
+	basicMsg := "Filter evaluations by %s - one of %s"
+	evalFilterMsg := fmt.Sprintf(basicMsg, "evaluation status", strings.Join(evalStatuses, ", "))
+	remediationFilterMsg := fmt.Sprintf(basicMsg, "remediation status", strings.Join(remediationStatuses, ", "))
+	alertFilterMsg := fmt.Sprintf(basicMsg, "alert status", strings.Join(alertStatuses, ", "))
+	entityTypesMsg := fmt.Sprintf(basicMsg, "entity type", strings.Join(entityTypes, ", "))
+
+	// Flags
+	watchCmd.Flags().StringP("project", "j", "", "ID of the project")
+	watchCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join([]string{app.Table, app.JSON}, ",")))
+	watchCmd.Flags().StringSlice("profile-name", nil, "Filter evaluations by profile name")
+	watchCmd.Flags().StringSlice("entity-name", nil, "Filter evaluations by entity name")
+	watchCmd.Flags().StringSlice("entity-type", nil, entityTypesMsg)
+	watchCmd.Flags().StringSlice("eval-status", nil, evalFilterMsg)
+	watchCmd.Flags().StringSlice("remediation-status", nil, remediationFilterMsg)
+	watchCmd.Flags().StringSlice("alert-status", nil, alertFilterMsg)
+	watchCmd.Flags().Bool("emoji", true, "Use emojis in the output")
+}
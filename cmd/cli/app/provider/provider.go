@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 )
 
@@ -66,3 +67,19 @@ func getAuthFlowsAsStrings(p *minderv1.Provider) []string {
 
 	return afs
 }
+
+// getHealthColumn returns the health status of the provider, colored by how
+// usable the provider is
+func getHealthColumn(p *minderv1.Provider) layouts.ColoredColumn {
+	status := p.GetHealth().GetStatus()
+	switch status {
+	case minderv1.ProviderHealthStatus_PROVIDER_HEALTH_STATUS_HEALTHY.ToString():
+		return layouts.GreenColumn(status)
+	case minderv1.ProviderHealthStatus_PROVIDER_HEALTH_STATUS_DEGRADED.ToString():
+		return layouts.YellowColumn(status)
+	case minderv1.ProviderHealthStatus_PROVIDER_HEALTH_STATUS_UNHEALTHY.ToString():
+		return layouts.RedColumn(status)
+	default:
+		return layouts.NoColor("unknown")
+	}
+}
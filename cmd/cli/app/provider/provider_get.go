@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -88,6 +89,16 @@ func GetProviderCommand(ctx context.Context, cmd *cobra.Command, _ []string, con
 		t.AddRow("Version", p.GetVersion())
 		t.AddRow("Implements", strings.Join(impls, ", "))
 		t.AddRow("Auth Flows", strings.Join(afs, ", "))
+		t.AddRowWithColor(layouts.NoColor("Health"), getHealthColumn(p))
+		if h := p.GetHealth(); h != nil {
+			t.AddRow("Last Checked", h.GetCheckedAt().AsTime().Format(time.RFC3339))
+			if h.GetLastError() != "" {
+				t.AddRow("Last Error", h.GetLastError())
+			}
+			if h.GetCredentialExpiresAt() != nil {
+				t.AddRow("Credential Expires", h.GetCredentialExpiresAt().AsTime().Format(time.RFC3339))
+			}
+		}
 		config := configAsKeyValues(p)
 		if config != "" {
 			t.AddRow("Config", config)
@@ -83,12 +83,17 @@ func ListProviderCommand(ctx context.Context, cmd *cobra.Command, _ []string, co
 		cmd.Println(out)
 	case app.Table:
 		t := table.New(table.Simple, layouts.Default,
-			[]string{"Name", "Version", "Implements"})
+			[]string{"Name", "Version", "Implements", "Health"})
 		// TODO: set automerge common cells
 		for _, v := range out.Providers {
 			impls := getImplementsAsStrings(v)
 
-			t.AddRow(v.GetName(), v.GetVersion(), strings.Join(impls, ", "))
+			t.AddRowWithColor(
+				layouts.NoColor(v.GetName()),
+				layouts.NoColor(v.GetVersion()),
+				layouts.NoColor(strings.Join(impls, ", ")),
+				getHealthColumn(v),
+			)
 		}
 		t.Render()
 		return nil
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// autoregCmd is the root command for the auto-registration rules subcommands
+var autoregCmd = &cobra.Command{
+	Use:   "autoreg",
+	Short: "Manage repository auto-registration rules",
+	Long: `The repo autoreg subcommands allow the management of auto-registration rules.
+
+An auto-registration rule is a CEL expression over the properties of remote
+repositories, e.g. their topics, visibility or name. The repositories selected
+by a rule are registered automatically when a provider is enrolled, when they
+are created upstream, and periodically. Repositories registered by a rule are
+deregistered once no rule selects them anymore.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+func init() {
+	RepoCmd.AddCommand(autoregCmd)
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var autoregCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an auto-registration rule",
+	Long: `The repo autoreg create subcommand is used to create an auto-registration rule.
+
+The selector is written like the selectors of profiles, e.g.:
+
+  repository.properties['github/topics'].exists(t, t == 'production')
+  !repository.is_private && repository.name.glob('my-org/service-*')
+
+When a provider is given, the rule only applies to its repositories. The rule
+is applied on the next reconciliation, see "minder repo autoreg reconcile".`,
+	RunE: cli.GRPCClientWrapRunE(autoregCreateCommand),
+}
+
+// autoregCreateCommand is the repo autoreg create subcommand
+func autoregCreateCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewRepositoryServiceClient(conn)
+
+	provider := viper.GetString("provider")
+	project := viper.GetString("project")
+	name := viper.GetString("name")
+	selector := viper.GetString("selector")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.CreateAutoRegistrationRule(ctx, &minderv1.CreateAutoRegistrationRuleRequest{
+		Rule: &minderv1.AutoRegistrationRule{
+			Context:  &minderv1.Context{Provider: &provider, Project: &project},
+			Name:     name,
+			Selector: selector,
+		},
+	})
+	if err != nil {
+		return cli.MessageAndError("Error creating auto-registration rule", err)
+	}
+
+	cmd.Println("Successfully created auto-registration rule:", resp.GetRule().GetName())
+	return nil
+}
+
+func init() {
+	autoregCmd.AddCommand(autoregCreateCmd)
+	// Flags
+	autoregCreateCmd.Flags().StringP("name", "n", "", "Name of the auto-registration rule")
+	autoregCreateCmd.Flags().StringP("selector", "s", "", "CEL expression selecting the repositories to register")
+	// Required
+	if err := autoregCreateCmd.MarkFlagRequired("name"); err != nil {
+		panic(err)
+	}
+	if err := autoregCreateCmd.MarkFlagRequired("selector"); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var autoregDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete an auto-registration rule",
+	Long: `The repo autoreg delete subcommand is used to delete an auto-registration rule.
+
+The repositories registered by the rule are deregistered on the next
+reconciliation, unless another rule selects them.`,
+	RunE: cli.GRPCClientWrapRunE(autoregDeleteCommand),
+}
+
+// autoregDeleteCommand is the repo autoreg delete subcommand
+func autoregDeleteCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewRepositoryServiceClient(conn)
+
+	project := viper.GetString("project")
+	name := viper.GetString("name")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.DeleteAutoRegistrationRule(ctx, &minderv1.DeleteAutoRegistrationRuleRequest{
+		Context: &minderv1.Context{Project: &project},
+		Name:    name,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error deleting auto-registration rule", err)
+	}
+
+	cmd.Println("Successfully deleted auto-registration rule:", resp.GetName())
+	return nil
+}
+
+func init() {
+	autoregCmd.AddCommand(autoregDeleteCmd)
+	// Flags
+	autoregDeleteCmd.Flags().StringP("name", "n", "", "Name of the auto-registration rule to delete")
+	// Required
+	if err := autoregDeleteCmd.MarkFlagRequired("name"); err != nil {
+		panic(err)
+	}
+}
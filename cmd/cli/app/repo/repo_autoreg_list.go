@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var autoregListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List auto-registration rules",
+	Long:  `The repo autoreg list subcommand is used to list the auto-registration rules of a project.`,
+	RunE:  cli.GRPCClientWrapRunE(autoregListCommand),
+}
+
+// autoregListCommand is the repo autoreg list subcommand
+func autoregListCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewRepositoryServiceClient(conn)
+
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.ListAutoRegistrationRules(ctx, &minderv1.ListAutoRegistrationRulesRequest{
+		Context: &minderv1.Context{Project: &project},
+	})
+	if err != nil {
+		return cli.MessageAndError("Error listing auto-registration rules", err)
+	}
+
+	switch format {
+	case app.Table:
+		t := table.New(table.Simple, layouts.Default,
+			[]string{"Name", "Provider", "Selector"})
+		for _, v := range resp.GetRules() {
+			provider := v.GetContext().GetProvider()
+			if provider == "" {
+				provider = "*"
+			}
+			t.AddRow(
+				v.GetName(),
+				provider,
+				v.GetSelector(),
+			)
+		}
+		t.Render()
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	}
+	return nil
+}
+
+func init() {
+	autoregCmd.AddCommand(autoregListCmd)
+	// Flags
+	autoregListCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+}
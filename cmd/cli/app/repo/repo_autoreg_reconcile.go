@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var autoregReconcileCmd = &cobra.Command{
+	Use:   "reconcile",
+	Short: "Apply the auto-registration rules of a project",
+	Long: `The repo autoreg reconcile subcommand registers the remote repositories selected
+by the auto-registration rules of a project, and deregisters the auto-registered
+repositories which are no longer selected. Use --dry-run to preview the changes.`,
+	RunE: cli.GRPCClientWrapRunE(autoregReconcileCommand),
+}
+
+// autoregReconcileCommand is the repo autoreg reconcile subcommand
+func autoregReconcileCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewRepositoryServiceClient(conn)
+
+	provider := viper.GetString("provider")
+	project := viper.GetString("project")
+	dryRun := viper.GetBool("dry-run")
+	format := viper.GetString("output")
+
+	// Ensure the output format is supported
+	if !app.IsOutputFormatSupported(format) {
+		return cli.MessageAndError(fmt.Sprintf("Output format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.ReconcileAutoRegistration(ctx, &minderv1.ReconcileAutoRegistrationRequest{
+		Context: &minderv1.Context{Provider: &provider, Project: &project},
+		DryRun:  dryRun,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error reconciling auto-registration rules", err)
+	}
+
+	switch format {
+	case app.Table:
+		report := resp.GetReport()
+		if len(report.GetRegistered())+len(report.GetDeregistered()) == 0 {
+			cmd.Println("No changes")
+		} else {
+			renderAutoRegistrationReport(report)
+		}
+		if len(report.GetFailedProviders()) > 0 {
+			cmd.Printf("Could not list the repositories of: %s\n", strings.Join(report.GetFailedProviders(), ", "))
+		}
+		if report.GetDryRun() {
+			cmd.Println("Dry run, no changes were applied")
+		}
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	}
+	return nil
+}
+
+func renderAutoRegistrationReport(report *minderv1.AutoRegistrationReport) {
+	t := table.New(table.Simple, layouts.Default,
+		[]string{"Change", "Provider", "Name", "Rule", "Error"})
+	for _, v := range report.GetRegistered() {
+		t.AddRow("registered", v.GetProvider(), v.GetName(), v.GetRule(), v.GetError())
+	}
+	for _, v := range report.GetDeregistered() {
+		t.AddRow("deregistered", v.GetProvider(), v.GetName(), v.GetRule(), v.GetError())
+	}
+	t.Render()
+}
+
+func init() {
+	autoregCmd.AddCommand(autoregReconcileCmd)
+	// Flags
+	autoregReconcileCmd.Flags().Bool("dry-run", false, "Report the changes without applying them")
+	autoregReconcileCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package report provides the CLI subcommand for generating compliance reports
+package report
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/reports"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var supportedFormats = []string{reports.FormatJSON, reports.FormatCSV, reports.FormatHTML}
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Generate a compliance report",
+	Long: `The report command generates a compliance report of a project and its sub-projects.
+
+The report aggregates the latest evaluation results by project, profile, rule type,
+severity and entity, along with their remediation and alert status, and the trends
+of the evaluation history over a time range (the last 30 days by default).
+
+It can be rendered to JSON, to CSV (one line per evaluation result), or to a
+self-contained HTML document which can be printed to PDF from a browser.`,
+	RunE: cli.GRPCClientWrapRunE(reportCommand),
+}
+
+// reportCommand is the report command
+func reportCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewEvalResultsServiceClient(conn)
+
+	project := viper.GetString("project")
+	format := viper.GetString("format")
+	output := viper.GetString("output")
+	from := viper.GetTime("from")
+	to := viper.GetTime("to")
+
+	if !slices.Contains(supportedFormats, format) {
+		return cli.MessageAndError(fmt.Sprintf("Format %s not supported", format), fmt.Errorf("invalid argument"))
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	req := &minderv1.GenerateComplianceReportRequest{
+		Context: &minderv1.Context{Project: &project},
+		Format:  format,
+	}
+	// Viper returns time.Time rather than a pointer to it, so we
+	// have to check whether from and/or to were specified.
+	if cmd.Flags().Lookup("from").Changed {
+		req.From = timestamppb.New(from)
+	}
+	if cmd.Flags().Lookup("to").Changed {
+		req.To = timestamppb.New(to)
+	}
+
+	resp, err := client.GenerateComplianceReport(ctx, req)
+	if err != nil {
+		return cli.MessageAndError("Error generating compliance report", err)
+	}
+
+	var out io.Writer = cmd.OutOrStdout()
+	if output != "" && output != "-" {
+		file, err := os.Create(filepath.Clean(output))
+		if err != nil {
+			return cli.MessageAndError("Error opening file", err)
+		}
+		defer func() {
+			_ = file.Close()
+		}()
+		out = file
+	}
+
+	if _, err := io.WriteString(out, resp.GetRendered()); err != nil {
+		return cli.MessageAndError("Error writing compliance report", err)
+	}
+	return nil
+}
+
+func init() {
+	app.RootCmd.AddCommand(reportCmd)
+	reportCmd.Flags().StringP("project", "j", "", "ID of the project")
+	reportCmd.Flags().StringP("format", "f", reports.FormatJSON,
+		fmt.Sprintf("Format of the report (one of %s)", strings.Join(supportedFormats, ",")))
+	reportCmd.Flags().StringP("output", "o", "-", "Output file (or stdout)")
+	reportCmd.Flags().String("from", "", "Start of the time range of the trends")
+	reportCmd.Flags().String("to", "", "End of the time range of the trends")
+}
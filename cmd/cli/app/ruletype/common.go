@@ -107,6 +107,13 @@ func initializeTableForList() table.Table {
 	// TODO: set automerge common cells
 }
 
+// initializeTableForMigrations initializes the table for the profile rules
+// migrated by a rule type update
+func initializeTableForMigrations() table.Table {
+	return table.New(table.Simple, layouts.Default,
+		[]string{"Profile", "Rule", "Entity Type"})
+}
+
 // initializeTableForList initializes the table for the rule type
 func initializeTableForOne() table.Table {
 	return table.New(table.Simple, layouts.Default,
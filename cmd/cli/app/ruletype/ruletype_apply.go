@@ -22,9 +22,13 @@ import (
 var applyCmd = &cobra.Command{
 	Use:   "apply [files...]",
 	Short: "Apply a rule type",
-	Long:  `The ruletype apply subcommand lets you create or update rule types for a project within Minder.`,
-	RunE:  cli.GRPCClientWrapRunE(applyCommand),
-	Args:  cobra.ArbitraryArgs,
+	Long: `The ruletype apply subcommand lets you create or update rule types for a project within Minder.
+
+Updating a rule type to a new schema version migrates the rules of the profiles
+using it. Use --dry-run to list the rules an update would migrate without
+applying it.`,
+	RunE: cli.GRPCClientWrapRunE(applyCommand),
+	Args: cobra.ArbitraryArgs,
 }
 
 // applyCommand is the "rule type" apply subcommand
@@ -58,35 +62,47 @@ func applyCommand(_ context.Context, cmd *cobra.Command, args []string, conn *gr
 	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
 	cmd.SilenceUsage = true
 
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return cli.MessageAndError("Error parsing dry-run flag", err)
+	}
+
 	table := initializeTableForList()
+	var migrations []*minderv1.RuleMigration
 
 	applyFunc := func(ctx context.Context, fileName string, rt *minderv1.RuleType) (*minderv1.RuleType, error) {
-		createResp, err := client.CreateRuleType(ctx, &minderv1.CreateRuleTypeRequest{
-			RuleType: rt,
-		})
-
-		if err == nil {
-			return createResp.RuleType, nil
-		}
+		// A dry run only previews updates, as creating a rule type
+		// changes no profile
+		if !dryRun {
+			createResp, err := client.CreateRuleType(ctx, &minderv1.CreateRuleTypeRequest{
+				RuleType: rt,
+			})
+
+			if err == nil {
+				return createResp.RuleType, nil
+			}
 
-		st, ok := status.FromError(err)
-		if !ok {
-			// We can't parse the error, so just return it
-			return nil, fmt.Errorf("error creating rule type from %s: %w", fileName, err)
-		}
+			st, ok := status.FromError(err)
+			if !ok {
+				// We can't parse the error, so just return it
+				return nil, fmt.Errorf("error creating rule type from %s: %w", fileName, err)
+			}
 
-		if st.Code() != codes.AlreadyExists {
-			return nil, fmt.Errorf("error creating rule type from %s: %w", fileName, err)
+			if st.Code() != codes.AlreadyExists {
+				return nil, fmt.Errorf("error creating rule type from %s: %w", fileName, err)
+			}
 		}
 
 		updateResp, err := client.UpdateRuleType(ctx, &minderv1.UpdateRuleTypeRequest{
 			RuleType: rt,
+			DryRun:   dryRun,
 		})
 
 		if err != nil {
 			return nil, fmt.Errorf("error updating rule type from %s: %w", fileName, err)
 		}
 
+		migrations = append(migrations, updateResp.GetMigrations()...)
 		return updateResp.RuleType, nil
 	}
 
@@ -107,6 +123,19 @@ func applyCommand(_ context.Context, cmd *cobra.Command, args []string, conn *gr
 	}
 	// Render the table
 	table.Render()
+
+	if len(migrations) > 0 {
+		if dryRun {
+			cmd.Println("The update would migrate the following profile rules:")
+		} else {
+			cmd.Println("Migrated the following profile rules:")
+		}
+		migrationsTable := initializeTableForMigrations()
+		for _, m := range migrations {
+			migrationsTable.AddRow(m.GetProfileName(), m.GetRuleName(), m.GetEntity().ToString())
+		}
+		migrationsTable.Render()
+	}
 	return nil
 }
 
@@ -115,5 +144,6 @@ func init() {
 	// Flags
 	applyCmd.Flags().StringArrayP("file", "f", []string{},
 		"Path to the YAML defining the rule type (or - for stdin). Can be specified multiple times. Can be a directory.")
+	applyCmd.Flags().Bool("dry-run", false, "List the profile rules updates would migrate, without applying them")
 
 }
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package waiver
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/cli"
+	"github.com/mindersec/minder/internal/util/cli/table"
+	"github.com/mindersec/minder/internal/util/cli/table/layouts"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// addExpiryFlags adds the flags setting the expiry of a waiver, either as a
+// point in time or relative to now
+func addExpiryFlags(cmd *cobra.Command) {
+	cmd.Flags().String("expires", "", "Time the waiver expires at, e.g. 2025-12-31 or 2025-12-31T12:00:00Z")
+	cmd.Flags().Duration("expires-in", 0, "Duration after which the waiver expires, e.g. 720h")
+	cmd.MarkFlagsOneRequired("expires", "expires-in")
+	cmd.MarkFlagsMutuallyExclusive("expires", "expires-in")
+}
+
+// getExpiry returns the expiry of a waiver set by the flags of addExpiryFlags
+func getExpiry(cmd *cobra.Command) (*timestamppb.Timestamp, error) {
+	if cmd.Flags().Lookup("expires-in").Changed {
+		expiresIn := viper.GetDuration("expires-in")
+		if expiresIn <= 0 {
+			return nil, fmt.Errorf("expires-in must be positive")
+		}
+		return timestamppb.New(time.Now().Add(expiresIn)), nil
+	}
+	expires := viper.GetTime("expires")
+	if expires.IsZero() {
+		return nil, fmt.Errorf("invalid expiry %q", viper.GetString("expires"))
+	}
+	return timestamppb.New(expires), nil
+}
+
+func outputWaivers(cmd *cobra.Command, format string, resp protoreflect.ProtoMessage, waivers []*minderv1.Waiver) error {
+	switch format {
+	case app.JSON:
+		out, err := util.GetJsonFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting json from proto", err)
+		}
+		cmd.Println(out)
+	case app.YAML:
+		out, err := util.GetYamlFromProto(resp)
+		if err != nil {
+			return cli.MessageAndError("Error getting yaml from proto", err)
+		}
+		cmd.Println(out)
+	case app.Table:
+		t := table.New(table.Simple, layouts.Default,
+			[]string{"ID", "Profile", "Rule", "Entity", "Approver", "Expires", "Justification"})
+		for _, w := range waivers {
+			expires := w.GetExpiresAt().AsTime().Local().Format(time.DateTime)
+			if !w.GetExpiresAt().AsTime().After(time.Now()) {
+				expires += " (expired)"
+			}
+			t.AddRow(
+				w.GetId(),
+				w.GetProfile(),
+				w.GetRuleName(),
+				w.GetEntity().GetName(),
+				w.GetApprover(),
+				expires,
+				w.GetJustification(),
+			)
+		}
+		t.Render()
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+	return nil
+}
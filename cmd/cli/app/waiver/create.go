@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package waiver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var createCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a waiver",
+	Long: `The waiver create subcommand lets you accept the failure of a rule of a
+profile for a single entity until the waiver expires.
+
+The rule is identified by its name in the profile, which defaults to the name
+of its rule type. The entity is identified by its name or ID, e.g.:
+
+  minder waiver create --profile security --rule branch_protection \
+    --entity my-org/legacy-service --justification "Archived next quarter" \
+    --expires-in 720h
+
+Creating a waiver for a rule and entity which already have one replaces it.
+The new status is recorded on the next evaluation of the entity.`,
+	RunE: cli.GRPCClientWrapRunE(createCommand),
+}
+
+// createCommand is the waiver create subcommand
+func createCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProfileServiceClient(conn)
+
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+	entityType := viper.GetString("entity-type")
+
+	expiresAt, err := getExpiry(cmd)
+	if err != nil {
+		return cli.MessageAndError("Invalid expiry", err)
+	}
+
+	entity := &minderv1.EntityTypedId{
+		Type: minderv1.EntityFromString(entityType),
+	}
+	if entity.Type == minderv1.Entity_ENTITY_UNSPECIFIED {
+		return fmt.Errorf("invalid entity type: %s", entityType)
+	}
+	if id := viper.GetString("entity-id"); id != "" {
+		entity.Id = id
+	} else {
+		entity.Name = viper.GetString("entity")
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.CreateWaiver(ctx, &minderv1.CreateWaiverRequest{
+		Waiver: &minderv1.Waiver{
+			Context:       &minderv1.Context{Project: &project},
+			Profile:       viper.GetString("profile"),
+			RuleName:      viper.GetString("rule"),
+			Entity:        entity,
+			Justification: viper.GetString("justification"),
+			ExpiresAt:     expiresAt,
+		},
+	})
+	if err != nil {
+		return cli.MessageAndError("Error creating waiver", err)
+	}
+
+	return outputWaivers(cmd, format, resp, []*minderv1.Waiver{resp.GetWaiver()})
+}
+
+func init() {
+	WaiverCmd.AddCommand(createCmd)
+	// Flags
+	createCmd.Flags().StringP("profile", "p", "", "Name of the profile")
+	createCmd.Flags().StringP("rule", "r", "", "Name of the rule in the profile")
+	createCmd.Flags().StringP("entity", "e", "", "Name of the entity")
+	createCmd.Flags().String("entity-id", "", "ID of the entity")
+	createCmd.Flags().StringP("entity-type", "t", minderv1.RepositoryEntity.String(), "Type of the entity")
+	createCmd.Flags().String("justification", "", "Reason why the failure is accepted")
+	createCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	addExpiryFlags(createCmd)
+	// Required
+	for _, flag := range []string{"profile", "rule", "justification"} {
+		if err := createCmd.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+	createCmd.MarkFlagsOneRequired("entity", "entity-id")
+	createCmd.MarkFlagsMutuallyExclusive("entity", "entity-id")
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package waiver
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a waiver",
+	Long: `The waiver delete subcommand lets you delete a waiver. The rule is enforced
+again for the entity on its next evaluation.`,
+	RunE: cli.GRPCClientWrapRunE(deleteCommand),
+}
+
+// deleteCommand is the waiver delete subcommand
+func deleteCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProfileServiceClient(conn)
+
+	project := viper.GetString("project")
+	id := viper.GetString("id")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.DeleteWaiver(ctx, &minderv1.DeleteWaiverRequest{
+		Context: &minderv1.Context{Project: &project},
+		Id:      id,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error deleting waiver", err)
+	}
+
+	cmd.Println("Successfully deleted waiver with ID:", resp.GetId())
+	return nil
+}
+
+func init() {
+	WaiverCmd.AddCommand(deleteCmd)
+	// Flags
+	deleteCmd.Flags().StringP("id", "i", "", "ID of the waiver to delete")
+	// Required
+	if err := deleteCmd.MarkFlagRequired("id"); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package waiver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var getCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Get a waiver",
+	Long:  `The waiver get subcommand lets you retrieve the details of a waiver.`,
+	RunE:  cli.GRPCClientWrapRunE(getCommand),
+}
+
+// getCommand is the waiver get subcommand
+func getCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProfileServiceClient(conn)
+
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.GetWaiverById(ctx, &minderv1.GetWaiverByIdRequest{
+		Context: &minderv1.Context{Project: &project},
+		Id:      viper.GetString("id"),
+	})
+	if err != nil {
+		return cli.MessageAndError("Error getting waiver", err)
+	}
+
+	return outputWaivers(cmd, format, resp, []*minderv1.Waiver{resp.GetWaiver()})
+}
+
+func init() {
+	WaiverCmd.AddCommand(getCmd)
+	// Flags
+	getCmd.Flags().StringP("id", "i", "", "ID of the waiver")
+	getCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	// Required
+	if err := getCmd.MarkFlagRequired("id"); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package waiver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List waivers",
+	Long: `The waiver list subcommand lets you list the active waivers of a project,
+optionally restricted to a profile.`,
+	RunE: cli.GRPCClientWrapRunE(listCommand),
+}
+
+// listCommand is the waiver list subcommand
+func listCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProfileServiceClient(conn)
+
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.ListWaivers(ctx, &minderv1.ListWaiversRequest{
+		Context:        &minderv1.Context{Project: &project},
+		Profile:        viper.GetString("profile"),
+		IncludeExpired: viper.GetBool("include-expired"),
+	})
+	if err != nil {
+		return cli.MessageAndError("Error listing waivers", err)
+	}
+
+	return outputWaivers(cmd, format, resp, resp.GetWaivers())
+}
+
+func init() {
+	WaiverCmd.AddCommand(listCmd)
+	// Flags
+	listCmd.Flags().StringP("profile", "p", "", "Only list the waivers of this profile")
+	listCmd.Flags().Bool("include-expired", false, "List the expired waivers too")
+	listCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package waiver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+	"github.com/mindersec/minder/internal/util/cli"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+var updateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update a waiver",
+	Long: `The waiver update subcommand lets you change the justification and the
+expiry of a waiver, for example to extend it. You become the approver of the
+updated waiver.`,
+	RunE: cli.GRPCClientWrapRunE(updateCommand),
+}
+
+// updateCommand is the waiver update subcommand
+func updateCommand(ctx context.Context, cmd *cobra.Command, _ []string, conn *grpc.ClientConn) error {
+	client := minderv1.NewProfileServiceClient(conn)
+
+	project := viper.GetString("project")
+	format := viper.GetString("output")
+
+	expiresAt, err := getExpiry(cmd)
+	if err != nil {
+		return cli.MessageAndError("Invalid expiry", err)
+	}
+
+	// No longer print usage on returned error, since we've parsed our inputs
+	// See https://github.com/spf13/cobra/issues/340#issuecomment-374617413
+	cmd.SilenceUsage = true
+
+	resp, err := client.UpdateWaiver(ctx, &minderv1.UpdateWaiverRequest{
+		Context:       &minderv1.Context{Project: &project},
+		Id:            viper.GetString("id"),
+		Justification: viper.GetString("justification"),
+		ExpiresAt:     expiresAt,
+	})
+	if err != nil {
+		return cli.MessageAndError("Error updating waiver", err)
+	}
+
+	return outputWaivers(cmd, format, resp, []*minderv1.Waiver{resp.GetWaiver()})
+}
+
+func init() {
+	WaiverCmd.AddCommand(updateCmd)
+	// Flags
+	updateCmd.Flags().StringP("id", "i", "", "ID of the waiver")
+	updateCmd.Flags().String("justification", "", "Reason why the failure is accepted")
+	updateCmd.Flags().StringP("output", "o", app.Table,
+		fmt.Sprintf("Output format (one of %s)", strings.Join(app.SupportedOutputFormats(), ",")))
+	addExpiryFlags(updateCmd)
+	// Required
+	for _, flag := range []string{"id", "justification"} {
+		if err := updateCmd.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+}
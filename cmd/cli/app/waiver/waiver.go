@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package waiver provides the CLI subcommand for managing waivers
+package waiver
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/mindersec/minder/cmd/cli/app"
+)
+
+// WaiverCmd is the root command for the waiver subcommands
+var WaiverCmd = &cobra.Command{
+	Use:   "waiver",
+	Short: "Manage waivers within a minder control plane",
+	Long: `The waiver subcommand allows the management of waivers within Minder.
+
+A waiver accepts the failure of a rule of a profile for a single entity until
+it expires. While the waiver is active, failures are recorded with the
+"waived" status and no remediation or alert is performed.`,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return cmd.Usage()
+	},
+}
+
+func init() {
+	app.RootCmd.AddCommand(WaiverCmd)
+	// Flags for all subcommands
+	WaiverCmd.PersistentFlags().StringP("project", "j", "", "ID of the project")
+}
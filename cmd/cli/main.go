@@ -10,8 +10,10 @@ import (
 	_ "github.com/mindersec/minder/cmd/cli/app/auth"
 	_ "github.com/mindersec/minder/cmd/cli/app/auth/invite"
 	_ "github.com/mindersec/minder/cmd/cli/app/auth/offline_token"
+	_ "github.com/mindersec/minder/cmd/cli/app/bundle"
 	_ "github.com/mindersec/minder/cmd/cli/app/datasource"
 	_ "github.com/mindersec/minder/cmd/cli/app/docs"
+	_ "github.com/mindersec/minder/cmd/cli/app/entity"
 	_ "github.com/mindersec/minder/cmd/cli/app/history"
 	_ "github.com/mindersec/minder/cmd/cli/app/profile"
 	_ "github.com/mindersec/minder/cmd/cli/app/profile/status"
@@ -20,9 +22,11 @@ import (
 	_ "github.com/mindersec/minder/cmd/cli/app/provider"
 	_ "github.com/mindersec/minder/cmd/cli/app/quickstart"
 	_ "github.com/mindersec/minder/cmd/cli/app/repo"
+	_ "github.com/mindersec/minder/cmd/cli/app/report"
 	_ "github.com/mindersec/minder/cmd/cli/app/ruletype"
 	_ "github.com/mindersec/minder/cmd/cli/app/set_project"
 	_ "github.com/mindersec/minder/cmd/cli/app/version"
+	_ "github.com/mindersec/minder/cmd/cli/app/waiver"
 )
 
 func main() {
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bundles
+
+import (
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/spf13/cobra"
+
+	"github.com/mindersec/minder/pkg/mindpak/build"
+)
+
+// CmdPush is the push command
+func CmdPush() *cobra.Command {
+	var pushCmd = &cobra.Command{
+		Use:   "push bundle_id input reference",
+		Short: "build a mindpak bundle and push it to an OCI registry",
+		Args:  cobra.ExactArgs(3),
+		Long: `
+The 'bundle push' subcommand allows you to build a mindpak bundle from the specified path
+and push it to an OCI registry, where Minder servers can pull it from.
+
+Registry credentials are read from the docker configuration, e.g. after 'docker login'.
+Sign the pushed bundle with 'cosign sign <reference>@<digest>' to allow servers
+requiring signed bundles to pull it.
+
+Arguments:
+
+build_id: an identifier of the form 'namespace/name@version'
+input: Directory containing bundle profiles and rule types
+reference: OCI reference to push the bundle to, e.g. 'ghcr.io/org/bundles/name:version'
+`,
+		RunE:         pushCmdRun,
+		SilenceUsage: true,
+	}
+	return pushCmd
+}
+
+func pushCmdRun(cmd *cobra.Command, args []string) error {
+	metadata, err := parseVersion(args[0])
+	if err != nil {
+		return err
+	}
+	ref, err := name.ParseReference(args[2])
+	if err != nil {
+		return fmt.Errorf("invalid reference %s: %w", args[2], err)
+	}
+
+	packer := build.NewPacker()
+	options := build.InitOptions{
+		Metadata: metadata,
+		Path:     args[1],
+	}
+
+	bundle, err := packer.InitBundle(&options)
+	if err != nil {
+		return err
+	}
+
+	img, err := packer.Image(bundle)
+	if err != nil {
+		return err
+	}
+
+	err = remote.Write(ref, img,
+		remote.WithContext(cmd.Context()),
+		remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	if err != nil {
+		return fmt.Errorf("pushing bundle to %s: %w", ref, err)
+	}
+
+	digest, err := img.Digest()
+	if err != nil {
+		return fmt.Errorf("computing bundle digest: %w", err)
+	}
+	cmd.Printf("pushed %s@%s\n", ref.Context(), digest)
+	return nil
+}
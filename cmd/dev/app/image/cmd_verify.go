@@ -15,6 +15,7 @@ import (
 	"github.com/mindersec/minder/internal/providers/credentials"
 	"github.com/mindersec/minder/internal/providers/github/clients"
 	"github.com/mindersec/minder/internal/providers/github/properties"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	"github.com/mindersec/minder/internal/providers/ratecache"
 	"github.com/mindersec/minder/internal/providers/telemetry"
 	"github.com/mindersec/minder/internal/verifier"
@@ -106,7 +107,7 @@ func buildGitHubClient(token string) (provifv1.GitHub, error) {
 		nil,
 		&ratecache.NoopRestClientCache{},
 		credentials.NewGitHubTokenCredential(token),
-		clients.NewGitHubClientFactory(telemetry.NewNoopMetrics()),
+		clients.NewGitHubClientFactory(telemetry.NewNoopMetrics(), &ratebudget.NoopTracker{}),
 		properties.NewPropertyFetcherFactory(),
 		"",
 	)
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rule_type
+
+import (
+	internalds "github.com/mindersec/minder/internal/datasources"
+	"github.com/mindersec/minder/internal/engine/eval/rego"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+	tkv1 "github.com/mindersec/minder/pkg/testkit/v1"
+)
+
+// cassetteKit is a TestKit which records the HTTP requests and git clones
+// of a live provider into a cassette, or replays them from it
+type cassetteKit struct {
+	*tkv1.TestKit
+	cassette *tkv1.Cassette
+	// dsOptions route the requests that data sources make without the
+	// provider through the cassette
+	dsOptions []internalds.Option
+}
+
+// newRecordingKit records the requests and clones made with the live
+// provider into a new cassette, scrubbing the token from it
+func newRecordingKit(live provifv1.Provider, token string) *cassetteKit {
+	cassette := tkv1.NewCassette()
+	cassette.AddSecrets(token)
+	return &cassetteKit{
+		TestKit:  tkv1.NewTestKit(tkv1.WithRecording(live, cassette)),
+		cassette: cassette,
+		dsOptions: []internalds.Option{
+			internalds.WithHTTPTransport(cassette.RecordingTransport(rego.LimitedDialer(nil))),
+		},
+	}
+}
+
+// newReplayingKit serves the requests and clones from a recorded cassette
+func newReplayingKit(path string) (*cassetteKit, error) {
+	cassette, err := tkv1.LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+	return &cassetteKit{
+		TestKit:  tkv1.NewTestKit(tkv1.WithReplay(cassette)),
+		cassette: cassette,
+		dsOptions: []internalds.Option{
+			internalds.WithHTTPTransport(cassette.ReplayingTransport()),
+		},
+	}, nil
+}
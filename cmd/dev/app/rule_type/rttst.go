@@ -24,13 +24,16 @@ import (
 	"github.com/mindersec/minder/internal/engine/errors"
 	"github.com/mindersec/minder/internal/engine/eval/rego"
 	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	"github.com/mindersec/minder/internal/engine/limits"
 	"github.com/mindersec/minder/internal/engine/options"
 	entModels "github.com/mindersec/minder/internal/entities/models"
+	"github.com/mindersec/minder/internal/providers/azuredevops"
 	"github.com/mindersec/minder/internal/providers/credentials"
 	"github.com/mindersec/minder/internal/providers/dockerhub"
 	"github.com/mindersec/minder/internal/providers/github/clients"
 	"github.com/mindersec/minder/internal/providers/github/properties"
 	"github.com/mindersec/minder/internal/providers/gitlab"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	"github.com/mindersec/minder/internal/providers/ratecache"
 	provsel "github.com/mindersec/minder/internal/providers/selectors"
 	"github.com/mindersec/minder/internal/providers/telemetry"
@@ -49,9 +52,24 @@ import (
 // CmdTest is the root command for the rule subcommands
 func CmdTest() *cobra.Command {
 	var testCmd = &cobra.Command{
-		Use:          "test",
-		Short:        "test a rule type definition",
-		Long:         `The 'rule type test' subcommand allows you test a rule type definition`,
+		Use:   "test",
+		Short: "test a rule type definition",
+		Long: `The 'rule type test' subcommand allows you test a rule type definition.
+
+Either evaluate a rule type against a single entity and profile, or run test
+suites with --suite. A test suite is a YAML file stored alongside the rule
+type, named after it with a '.test.yaml' suffix, which lists test cases with
+their entity, mocked ingestion and data source responses, and expected outcome.
+
+With --cassette, the HTTP requests and git clones made by the provider and the
+data sources are served from a cassette file instead of the network. Add
+--record to make them with the live provider and record them into the
+cassette, scrubbing the token. When running test suites, --record records
+the cassettes of the test cases which set one.
+
+The output of the print statements of Rego rules is shown after each
+evaluation. Add --trace to also show which rules of the policy matched, the
+input it was evaluated with and the full evaluation trace.`,
 		RunE:         testCmdRun,
 		SilenceUsage: true,
 	}
@@ -67,16 +85,20 @@ func CmdTest() *cobra.Command {
 	testCmd.Flags().StringP("token", "t", "", "token to authenticate to the provider."+
 		"Can also be set via the TEST_AUTH_TOKEN environment variable.")
 	testCmd.Flags().StringArrayP("data-source", "d", []string{}, "YAML file containing the data source to test the rule with")
-
-	if err := testCmd.MarkFlagRequired("rule-type"); err != nil {
-		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
-		os.Exit(1)
-	}
-
-	if err := testCmd.MarkFlagRequired("entity"); err != nil {
-		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
-		os.Exit(1)
-	}
+	testCmd.Flags().Bool("offline", false, "Disable the rule evaluation functions that need network access")
+	testCmd.Flags().StringArrayP("suite", "s", []string{},
+		"Test suite file to run, or directory to search for '*.test.yaml' test suites")
+	testCmd.Flags().String("format", suiteFormatText, "Output format of the test suite results: text, junit or tap")
+	testCmd.Flags().Bool("update", false, "Record the current outcome of the test suite cases as their expected outcome")
+	testCmd.Flags().Bool("coverage", false, "Report which Rego rules fired during the test suites")
+	testCmd.Flags().String("cassette", "", "Cassette file to replay the HTTP requests and git clones from")
+	testCmd.Flags().Bool("record", false, "Record the HTTP requests and git clones into the cassette files")
+	testCmd.Flags().Bool("trace", false, "Show the matched rules, input and trace of Rego evaluations")
+
+	testCmd.MarkFlagsMutuallyExclusive("suite", "rule-type")
+	testCmd.MarkFlagsMutuallyExclusive("suite", "entity")
+	testCmd.MarkFlagsMutuallyExclusive("suite", "cassette")
+	testCmd.MarkFlagsMutuallyExclusive("suite", "trace")
 
 	if err := viper.BindPFlag("test.auth.token", testCmd.Flags().Lookup("token")); err != nil {
 		fmt.Fprintf(os.Stderr, "Error binding flag: %s\n", err)
@@ -90,6 +112,14 @@ func CmdTest() *cobra.Command {
 
 //nolint:gocyclo // this function is a cobra command and is expected to be complex
 func testCmdRun(cmd *cobra.Command, _ []string) error {
+	suites, err := cmd.Flags().GetStringArray("suite")
+	if err != nil {
+		return fmt.Errorf("error getting test suites: %w", err)
+	}
+	if len(suites) > 0 {
+		return runTestSuites(cmd, suites)
+	}
+
 	rtpath := cmd.Flag("rule-type")
 	epath := cmd.Flag("entity")
 	ppath := cmd.Flag("profile")
@@ -99,6 +129,19 @@ func testCmdRun(cmd *cobra.Command, _ []string) error {
 	providerclass := cmd.Flag("provider")
 	providerconfig := cmd.Flag("provider-config")
 
+	if rtpath.Value.String() == "" || epath.Value.String() == "" {
+		return fmt.Errorf("the rule-type and entity flags are required unless running test suites")
+	}
+
+	cassettePath := cmd.Flag("cassette").Value.String()
+	record, err := cmd.Flags().GetBool("record")
+	if err != nil {
+		return fmt.Errorf("error getting record flag: %w", err)
+	}
+	if record && cassettePath == "" {
+		return fmt.Errorf("the cassette flag is required to record")
+	}
+
 	dataSourceFileStrings, err := cmd.Flags().GetStringArray("data-source")
 	if err != nil {
 		return fmt.Errorf("error getting data source files: %w", err)
@@ -117,12 +160,6 @@ func testCmdRun(cmd *cobra.Command, _ []string) error {
 		}
 	}()
 
-	// set rego env variable for debugging
-	if err := os.Setenv(rego.EnablePrintEnvVar, "true"); err != nil {
-		cmd.Printf("Unable to set %s environment variable: %s\n", rego.EnablePrintEnvVar, err)
-		cmd.Println("If the rule you're testing is rego-based, you will not be able to use `print` statements for debugging.")
-	}
-
 	ruletype, err := readRuleTypeFromFile(rtpath.Value.String())
 	if err != nil {
 		return fmt.Errorf("error reading rule type from file: %w", err)
@@ -190,7 +227,26 @@ func testCmdRun(cmd *cobra.Command, _ []string) error {
 		Alert:     actionOptFromString(profile.Alert, models.ActionOptOff),
 	}
 
-	dsRegistry, err := getDataSources(dataSourcefiles, prov)
+	// The rule type engine either uses the provider directly, or records
+	// or replays its requests. The provider still builds the entities.
+	var engProv provifv1.Provider = prov
+	var kit *cassetteKit
+	switch {
+	case record:
+		kit = newRecordingKit(prov, token)
+	case cassettePath != "":
+		kit, err = newReplayingKit(cassettePath)
+		if err != nil {
+			return err
+		}
+	}
+	var dsOpts []internalds.Option
+	if kit != nil {
+		engProv = kit.TestKit
+		dsOpts = kit.dsOptions
+	}
+
+	dsRegistry, err := getDataSources(dataSourcefiles, engProv, dsOpts...)
 	if err != nil {
 		return fmt.Errorf("error getting data sources: %w", err)
 	}
@@ -198,11 +254,20 @@ func testCmdRun(cmd *cobra.Command, _ []string) error {
 	// TODO: use cobra context here
 	ctx := context.Background()
 	// TODO: accomodate flags here or enable them all
-	eng, err := rtengine.NewRuleTypeEngine(ctx, ruletype, prov, options.WithDataSources(dsRegistry))
+	offline, err := cmd.Flags().GetBool("offline")
+	if err != nil {
+		return fmt.Errorf("error getting offline flag: %w", err)
+	}
+	ruleTypeLimits, err := limits.FromRuleType(ruletype)
+	if err != nil {
+		return fmt.Errorf("error parsing rule type limits: %w", err)
+	}
+	eng, err := rtengine.NewRuleTypeEngine(ctx, ruletype, engProv,
+		options.WithDataSources(dsRegistry), options.WithOfflineMode(offline), options.WithLimits(ruleTypeLimits))
 	if err != nil {
 		return fmt.Errorf("cannot create rule type engine: %w", err)
 	}
-	actionEngine, err := actions.NewRuleActions(ctx, ruletype, prov, &actionConfig)
+	actionEngine, err := actions.NewRuleActions(ctx, ruletype, engProv, &actionConfig)
 	if err != nil {
 		return fmt.Errorf("cannot create rule actions engine: %w", err)
 	}
@@ -212,7 +277,16 @@ func testCmdRun(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("error creating selectors: %w", err)
 	}
 
-	return runEvaluationForRules(cmd, eng, ewp, profSel, remediateStatus, remMetadata, rules, actionEngine, prov)
+	evalErr := runEvaluationForRules(cmd, eng, ewp, profSel, remediateStatus, remMetadata, rules, actionEngine, prov)
+	// The cassette is kept even if the evaluation failed, as failures are
+	// worth replaying too
+	if record {
+		if err := kit.cassette.Save(cassettePath); err != nil {
+			return err
+		}
+		cmd.Printf("Recorded cassette %s\n", cassettePath)
+	}
+	return evalErr
 }
 
 func getProfileSelectors(entType minderv1.Entity, profile *minderv1.Profile) (selectors.Selection, error) {
@@ -250,6 +324,11 @@ func runEvaluationForRules(
 	actionEngine *actions.RuleActionsEngine,
 	prov provifv1.Provider,
 ) error {
+	trace, err := cmd.Flags().GetBool("trace")
+	if err != nil {
+		return fmt.Errorf("error getting trace flag: %w", err)
+	}
+
 	for _, frag := range frags {
 		val := eng.GetRuleInstanceValidator()
 		err := val.ValidateRuleDefAgainstSchema(frag.Def.AsMap())
@@ -281,6 +360,10 @@ func runEvaluationForRules(
 		logConfig := serverconfig.LoggingConfig{Level: cmd.Flag("log-level").Value.String()}
 		ctx = serverconfig.LoggerFromConfigFlags(logConfig).WithContext(ctx)
 
+		// Capture the print output of Rego rules, along with their trace
+		var explanation rego.Explanation
+		ctx = rego.WithExplanation(ctx, &explanation)
+
 		// convert to EntityInfoWrapper as that's what the engine operates on
 		inf, err := entityWithPropertiesToEntityInfoWrapper(ewp, prov)
 		if err != nil {
@@ -290,6 +373,7 @@ func runEvaluationForRules(
 		// Perform rule evaluation
 		evalErr := selectAndEval(ctx, eng, inf, ewp, evalStatus, entitySelectors)
 		evalStatus.SetEvalErr(evalErr)
+		printExplanation(cmd, &explanation, trace)
 
 		// Perform the actions, if any
 		evalStatus.SetActionsErr(ctx, actionEngine.DoActions(ctx, inf.Entity, evalStatus))
@@ -308,6 +392,43 @@ func runEvaluationForRules(
 	return nil
 }
 
+// printExplanation prints the output of the print statements of a Rego
+// evaluation, and if requested the rules which matched, its input and trace
+func printExplanation(cmd *cobra.Command, explanation *rego.Explanation, trace bool) {
+	if len(explanation.Prints) > 0 {
+		cmd.Println("Print output:")
+		for _, line := range explanation.Prints {
+			cmd.Printf("  %s\n", line)
+		}
+	}
+	if !trace {
+		return
+	}
+
+	if len(explanation.Rules) > 0 {
+		cmd.Println("Rules:")
+		for _, rule := range explanation.Rules {
+			outcome := "not matched"
+			if rule.Matched {
+				outcome = "matched"
+			}
+			cmd.Printf("  %s (%s): %s\n", rule.Name, rule.Location, outcome)
+		}
+	}
+	if explanation.Input != nil {
+		input, err := json.MarshalIndent(explanation.Input, "  ", "  ")
+		if err == nil {
+			cmd.Printf("Input:\n  %s\n", input)
+		}
+	}
+	if len(explanation.Trace) > 0 {
+		cmd.Println("Trace:")
+		for _, line := range explanation.Trace {
+			cmd.Printf("  %s\n", line)
+		}
+	}
+}
+
 func selectAndEval(
 	ctx context.Context,
 	eng *rtengine.RuleTypeEngine,
@@ -372,6 +493,12 @@ func readEntityWithPropertiesFromFile(
 		return nil, fmt.Errorf("error decoding json: %w", err)
 	}
 
+	return entityWithPropertiesFromMap(propertiesMap, projectID, entType)
+}
+
+func entityWithPropertiesFromMap(
+	propertiesMap map[string]any, projectID uuid.UUID, entType minderv1.Entity,
+) (*entModels.EntityWithProperties, error) {
 	props := entProps.NewProperties(propertiesMap)
 
 	return &entModels.EntityWithProperties{
@@ -426,7 +553,7 @@ func getProvider(pstr string, token string, providerConfigFile string) (provifv1
 			&ratecache.NoopRestClientCache{},
 			credentials.NewGitHubTokenCredential(token),
 			nil,
-			clients.NewGitHubClientFactory(telemetry.NewNoopMetrics()),
+			clients.NewGitHubClientFactory(telemetry.NewNoopMetrics(), &ratebudget.NoopTracker{}),
 			properties.NewPropertyFetcherFactory(),
 			false,
 		)
@@ -456,11 +583,25 @@ func getProvider(pstr string, token string, providerConfigFile string) (provifv1
 		}
 
 		// We may pass a "fake" webhook URL here as it is not used in the test
-		client, err := gitlab.New(credentials.NewGitLabTokenCredential(token), cfg, "fake", "fake")
+		client, err := gitlab.New(credentials.NewGitLabTokenCredential(token), cfg, "fake", "fake", &ratebudget.NoopTracker{})
 		if err != nil {
 			return nil, fmt.Errorf("error instantiating gitlab provider: %w", err)
 		}
 		return client, nil
+	case "azure-devops":
+		// read provider config
+		cfg, err := azuredevops.ParseV1Config(cfgbytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing azure devops provider config: %w", err)
+		}
+
+		// We may pass a "fake" webhook URL here as it is not used in the test
+		client, err := azuredevops.New(
+			credentials.NewAzureDevOpsPATCredential(token), cfg, serverconfig.GitConfig{}, "fake", "fake")
+		if err != nil {
+			return nil, fmt.Errorf("error instantiating azure devops provider: %w", err)
+		}
+		return client, nil
 	default:
 		return nil, fmt.Errorf("unknown or unsupported provider: %s", pstr)
 	}
@@ -502,7 +643,9 @@ func actionOptFromString(s *string, defAction models.ActionOpt) models.ActionOpt
 	return models.ActionOptUnknown
 }
 
-func getDataSources(readers []*os.File, provider provifv1.Provider) (*v1datasources.DataSourceRegistry, error) {
+func getDataSources(
+	readers []*os.File, provider provifv1.Provider, opts ...internalds.Option,
+) (*v1datasources.DataSourceRegistry, error) {
 	reg := v1datasources.NewDataSourceRegistry()
 	for _, r := range readers {
 		fname := r.Name()
@@ -515,7 +658,7 @@ func getDataSources(readers []*os.File, provider provifv1.Provider) (*v1datasour
 			return nil, fmt.Errorf("error validating data source %s: %w", fname, err)
 		}
 
-		intds, err := internalds.BuildFromProtobuf(ds, provider)
+		intds, err := internalds.BuildFromProtobuf(ds, provider, opts...)
 		if err != nil {
 			return nil, fmt.Errorf("error building data source %s: %w", fname, err)
 		}
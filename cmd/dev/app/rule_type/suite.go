@@ -0,0 +1,651 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rule_type
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+
+	internalds "github.com/mindersec/minder/internal/datasources"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/actions"
+	"github.com/mindersec/minder/internal/engine/errors"
+	"github.com/mindersec/minder/internal/engine/eval/rego"
+	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	"github.com/mindersec/minder/internal/engine/limits"
+	"github.com/mindersec/minder/internal/engine/options"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	"github.com/mindersec/minder/pkg/engine/v1/rtengine"
+	"github.com/mindersec/minder/pkg/profiles/models"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+	tkv1 "github.com/mindersec/minder/pkg/testkit/v1"
+)
+
+// suiteFileSuffix is the suffix of test suite files. A suite named
+// `foo.test.yaml` tests the rule type in `foo.yaml` unless it sets another one.
+const suiteFileSuffix = ".test.yaml"
+
+// testSuite is a set of test cases for a rule type, read from a YAML file
+// stored alongside the rule type
+type testSuite struct {
+	Version string `yaml:"version"`
+	// RuleType is the path of the rule type, relative to the suite file
+	RuleType string `yaml:"rule_type,omitempty"`
+	// Provider is the provider class used to build the entities from their
+	// properties. Defaults to github.
+	Provider string `yaml:"provider,omitempty"`
+	// DataSources are the paths of the data source definitions, relative to
+	// the suite file, used by the test cases which replay a cassette
+	DataSources []string   `yaml:"data_sources,omitempty"`
+	Tests       []testCase `yaml:"tests"`
+
+	path     string
+	ruleType *minderv1.RuleType
+}
+
+// testCase evaluates the rule type against an entity, with mocked ingestion
+// and data source responses
+type testCase struct {
+	Name string `yaml:"name"`
+	// Entity holds the properties of the entity, like the entity files of `ruletype test`
+	Entity map[string]any `yaml:"entity"`
+	Def    map[string]any `yaml:"def,omitempty"`
+	Params map[string]any `yaml:"params,omitempty"`
+	// Remediate is the remediation mode: on, off or dry_run. Defaults to off.
+	Remediate string `yaml:"remediate,omitempty"`
+	// Git is the repository the git ingester sees
+	Git *gitFixture `yaml:"git,omitempty"`
+	// HTTP are the responses to the HTTP requests made by the ingester and
+	// the remediation, in order
+	HTTP []httpMock `yaml:"http,omitempty"`
+	// DataSources are the responses of the data source functions, in order,
+	// indexed by data source and function name
+	DataSources map[string]map[string][]any `yaml:"data_sources,omitempty"`
+	// Cassette is the path of a cassette, relative to the suite file, which
+	// serves the HTTP requests and git clones instead of the mocks above
+	Cassette string           `yaml:"cassette,omitempty"`
+	Expect   *testExpectation `yaml:"expect,omitempty"`
+}
+
+// gitFixture is a git tree, either a directory relative to the suite file or
+// a set of files with their contents
+type gitFixture struct {
+	Dir   string            `yaml:"dir,omitempty"`
+	Files map[string]string `yaml:"files,omitempty"`
+}
+
+// httpMock is a mocked HTTP response. Method and path are optional, and the
+// request must match them if set.
+type httpMock struct {
+	Method   string            `yaml:"method,omitempty"`
+	Path     string            `yaml:"path,omitempty"`
+	Status   int               `yaml:"status,omitempty"`
+	Headers  map[string]string `yaml:"headers,omitempty"`
+	Body     string            `yaml:"body,omitempty"`
+	BodyFile string            `yaml:"body_file,omitempty"`
+}
+
+// testExpectation is the expected outcome of a test case
+type testExpectation struct {
+	Status      string                  `yaml:"status"`
+	Message     string                  `yaml:"message,omitempty"`
+	Remediation *remediationExpectation `yaml:"remediation,omitempty"`
+}
+
+// remediationExpectation is the expected outcome of the remediation
+type remediationExpectation struct {
+	Status   string               `yaml:"status"`
+	Requests []requestExpectation `yaml:"requests,omitempty"`
+}
+
+// requestExpectation is an HTTP request the remediation is expected to make
+type requestExpectation struct {
+	Method string `yaml:"method"`
+	URL    string `yaml:"url"`
+	Body   string `yaml:"body,omitempty"`
+}
+
+// caseResult is the outcome of running a test case
+type caseResult struct {
+	name     string
+	actual   *testExpectation
+	err      error
+	failures []string
+	duration time.Duration
+}
+
+func (r *caseResult) passed() bool {
+	return r.err == nil && len(r.failures) == 0
+}
+
+// suiteResult is the outcome of running a test suite
+type suiteResult struct {
+	suite    *testSuite
+	cases    []*caseResult
+	coverage *regoCoverage
+	duration time.Duration
+}
+
+func (r *suiteResult) failed() int {
+	failed := 0
+	for _, c := range r.cases {
+		if !c.passed() {
+			failed++
+		}
+	}
+	return failed
+}
+
+// runTestSuites runs the test suites in the given files and directories
+func runTestSuites(cmd *cobra.Command, paths []string) error {
+	format, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return fmt.Errorf("error getting format flag: %w", err)
+	}
+	update, err := cmd.Flags().GetBool("update")
+	if err != nil {
+		return fmt.Errorf("error getting update flag: %w", err)
+	}
+	coverage, err := cmd.Flags().GetBool("coverage")
+	if err != nil {
+		return fmt.Errorf("error getting coverage flag: %w", err)
+	}
+	record, err := cmd.Flags().GetBool("record")
+	if err != nil {
+		return fmt.Errorf("error getting record flag: %w", err)
+	}
+	token := viper.GetString("test.auth.token")
+
+	files, err := findTestSuites(paths)
+	if err != nil {
+		return err
+	}
+
+	logConfig := serverconfig.LoggingConfig{Level: cmd.Flag("log-level").Value.String()}
+	ctx := serverconfig.LoggerFromConfigFlags(logConfig).WithContext(cmd.Context())
+
+	results := make([]*suiteResult, 0, len(files))
+	failed, total := 0, 0
+	for _, file := range files {
+		suite, err := readTestSuite(file)
+		if err != nil {
+			return err
+		}
+		// When recording, the cassettes are recorded with the live provider
+		var live provifv1.Provider
+		if record {
+			live, err = getProvider(suite.Provider, token, "")
+			if err != nil {
+				return err
+			}
+		}
+		result, err := suite.run(ctx, live, token)
+		if err != nil {
+			return fmt.Errorf("error running test suite %s: %w", file, err)
+		}
+		if update {
+			if err := updateExpectations(result); err != nil {
+				return err
+			}
+			for _, c := range result.cases {
+				c.failures = nil
+			}
+		}
+		results = append(results, result)
+		failed += result.failed()
+		total += len(result.cases)
+	}
+
+	if err := writeSuiteResults(cmd.OutOrStdout(), format, results, coverage); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d test cases failed", failed, total)
+	}
+	return nil
+}
+
+// findTestSuites returns the test suite files among the given paths,
+// searching directories for files with the test suite suffix
+func findTestSuites(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading test suite: %w", err)
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && strings.HasSuffix(p, suiteFileSuffix) {
+				files = append(files, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error searching for test suites in %s: %w", path, err)
+		}
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no test suites found")
+	}
+	return files, nil
+}
+
+// readTestSuite reads a test suite and the rule type it tests
+func readTestSuite(path string) (*testSuite, error) {
+	contents, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("error reading test suite: %w", err)
+	}
+
+	suite := &testSuite{}
+	if err := yaml.Unmarshal(contents, suite); err != nil {
+		return nil, fmt.Errorf("error parsing test suite %s: %w", path, err)
+	}
+	if suite.Version != "v1" {
+		return nil, fmt.Errorf("unsupported test suite version %q in %s", suite.Version, path)
+	}
+	suite.path = path
+
+	rtPath := strings.TrimSuffix(path, suiteFileSuffix) + ".yaml"
+	if suite.RuleType != "" {
+		rtPath = suite.relativePath(suite.RuleType)
+	}
+	suite.ruleType, err = readRuleTypeFromFile(rtPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading rule type for test suite %s: %w", path, err)
+	}
+
+	provider := "test"
+	rootProject := "00000000-0000-0000-0000-000000000002"
+	suite.ruleType.Context = &minderv1.Context{
+		Provider: &provider,
+		Project:  &rootProject,
+	}
+	if suite.Provider == "" {
+		suite.Provider = "github"
+	}
+	return suite, nil
+}
+
+func (s *testSuite) name() string {
+	return strings.TrimSuffix(filepath.Base(s.path), suiteFileSuffix)
+}
+
+func (s *testSuite) relativePath(path string) string {
+	return filepath.Join(filepath.Dir(s.path), filepath.Clean(path))
+}
+
+// run runs every test case of the suite, comparing their outcome with the
+// expected one. If a live provider is given, the test cases with a cassette
+// record it with the provider instead of replaying it.
+func (s *testSuite) run(ctx context.Context, live provifv1.Provider, token string) (*suiteResult, error) {
+	// The provider is only used to build the entities, the rule type
+	// engine uses the mocks of the TestKit instead
+	entityProvider, err := getProvider(s.Provider, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	result := &suiteResult{suite: s}
+	if s.ruleType.GetDef().GetEval().GetType() == rego.RegoEvalType {
+		result.coverage, err = newRegoCoverage(s.ruleType.GetDef().GetEval().GetRego().GetDef())
+		if err != nil {
+			return nil, fmt.Errorf("error parsing rego policy: %w", err)
+		}
+	}
+
+	start := time.Now()
+	for i := range s.Tests {
+		tc := &s.Tests[i]
+		caseStart := time.Now()
+		actual, err := s.runCase(ctx, tc, entityProvider, result.coverage, live, token)
+		res := &caseResult{
+			name:     tc.Name,
+			actual:   actual,
+			err:      err,
+			duration: time.Since(caseStart),
+		}
+		if err == nil {
+			res.failures = compareExpectation(tc.Expect, actual)
+		}
+		result.cases = append(result.cases, res)
+	}
+	result.duration = time.Since(start)
+	return result, nil
+}
+
+func (s *testSuite) runCase(
+	ctx context.Context,
+	tc *testCase,
+	entityProvider provifv1.Provider,
+	coverage *regoCoverage,
+	live provifv1.Provider,
+	token string,
+) (*testExpectation, error) {
+	if tc.Cassette != "" {
+		if tc.Git != nil || len(tc.HTTP) > 0 {
+			return nil, fmt.Errorf("test case %q sets both a cassette and git or HTTP mocks", tc.Name)
+		}
+		var kit *cassetteKit
+		if live != nil {
+			kit = newRecordingKit(live, token)
+		} else {
+			var err error
+			kit, err = newReplayingKit(s.relativePath(tc.Cassette))
+			if err != nil {
+				return nil, err
+			}
+		}
+		actual, err := s.evalCase(ctx, tc, kit.TestKit, entityProvider, coverage, kit.dsOptions...)
+		if err == nil && live != nil {
+			err = kit.cassette.Save(s.relativePath(tc.Cassette))
+		}
+		return actual, err
+	}
+
+	tkOpts, err := s.testKitOptions(tc)
+	if err != nil {
+		return nil, err
+	}
+	if tc.Git != nil && tc.Git.Dir == "" {
+		dir, err := os.MkdirTemp("", "mindev-git-fixture")
+		if err != nil {
+			return nil, fmt.Errorf("error creating git fixture: %w", err)
+		}
+		defer os.RemoveAll(dir)
+		if err := writeGitFiles(dir, tc.Git.Files); err != nil {
+			return nil, err
+		}
+		tkOpts = append(tkOpts, tkv1.WithGitDir(dir))
+	}
+	return s.evalCase(ctx, tc, tkv1.NewTestKit(tkOpts...), entityProvider, coverage)
+}
+
+// evalCase evaluates the rule type for a test case with the given TestKit,
+// then runs the remediation, if enabled
+func (s *testSuite) evalCase(
+	ctx context.Context,
+	tc *testCase,
+	tk *tkv1.TestKit,
+	entityProvider provifv1.Provider,
+	coverage *regoCoverage,
+	dsOpts ...internalds.Option,
+) (*testExpectation, error) {
+	dsRegistry, err := s.dataSources(tc, tk, dsOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleTypeLimits, err := limits.FromRuleType(s.ruleType)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing rule type limits: %w", err)
+	}
+	engOpts := []interfaces.Option{
+		options.WithDataSources(dsRegistry),
+		options.WithOfflineMode(true),
+		options.WithLimits(ruleTypeLimits),
+	}
+	if coverage != nil {
+		engOpts = append(engOpts, rego.WithQueryTracer(coverage))
+	}
+	eng, err := rtengine.NewRuleTypeEngine(ctx, s.ruleType, tk, engOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create rule type engine: %w", err)
+	}
+	if tk.ShouldOverrideIngest() {
+		eng.WithCustomIngester(tk)
+	}
+
+	remediate := models.ActionOptOff
+	if tc.Remediate != "" {
+		remediate = actionOptFromString(&tc.Remediate, models.ActionOptOff)
+	}
+	actionEngine, err := actions.NewRuleActions(ctx, s.ruleType, tk, &models.ActionConfiguration{
+		Remediate: remediate,
+		Alert:     models.ActionOptOff,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create rule actions engine: %w", err)
+	}
+
+	ewp, err := entityWithPropertiesFromMap(
+		tc.Entity, uuid.MustParse(s.ruleType.GetContext().GetProject()),
+		minderv1.EntityFromString(s.ruleType.GetDef().GetInEntity()))
+	if err != nil {
+		return nil, err
+	}
+	inf, err := entityWithPropertiesToEntityInfoWrapper(ewp, entityProvider)
+	if err != nil {
+		return nil, fmt.Errorf("error converting entity to entity info wrapper: %w", err)
+	}
+
+	// Like rules read from a profile, the definition and parameters are never nil
+	def := tc.Def
+	if def == nil {
+		def = map[string]any{}
+	}
+	params := tc.Params
+	if params == nil {
+		params = map[string]any{}
+	}
+	evalStatus := &engif.EvalStatusParams{
+		Rule: &models.RuleInstance{
+			ID:     uuid.New(),
+			Def:    def,
+			Params: params,
+		},
+		EvalStatusFromDb: &db.ListRuleEvaluationsByProfileIdRow{
+			RemStatus:   db.RemediationStatusTypesSkipped,
+			RemMetadata: []byte("{}"),
+		},
+	}
+	_, evalErr := eng.Eval(ctx, inf.Entity, def, params, evalStatus)
+	evalStatus.SetEvalErr(evalErr)
+
+	actual := &testExpectation{
+		Status:  errors.EvalErrorAsString(evalErr),
+		Message: errors.ErrorAsEvalDetails(evalErr),
+	}
+
+	// Only the requests made by the remediation are compared
+	tk.ResetRequests()
+	actionsErr := actionEngine.DoActions(ctx, inf.Entity, evalStatus)
+	if remediate != models.ActionOptOff {
+		actual.Remediation = &remediationExpectation{
+			Status: errors.RemediationErrorAsString(actionsErr.RemediateErr),
+		}
+		for _, req := range tk.Requests() {
+			actual.Remediation.Requests = append(actual.Remediation.Requests, requestExpectation{
+				Method: req.Method,
+				URL:    req.URL,
+				Body:   string(req.Body),
+			})
+		}
+	}
+	return actual, nil
+}
+
+// dataSources returns the mocked data sources of the test case or, for test
+// cases with a cassette, the data sources of the suite
+func (s *testSuite) dataSources(
+	tc *testCase, tk *tkv1.TestKit, dsOpts ...internalds.Option,
+) (*v1datasources.DataSourceRegistry, error) {
+	if len(tc.DataSources) > 0 || tc.Cassette == "" {
+		dsRegistry := v1datasources.NewDataSourceRegistry()
+		for name, funcs := range tc.DataSources {
+			if err := dsRegistry.RegisterDataSource(name, tkv1.NewFakeDataSource(funcs)); err != nil {
+				return nil, fmt.Errorf("error registering data source %s: %w", name, err)
+			}
+		}
+		return dsRegistry, nil
+	}
+
+	paths := make([]string, 0, len(s.DataSources))
+	for _, path := range s.DataSources {
+		paths = append(paths, s.relativePath(path))
+	}
+	files, err := getDataSourceFiles(paths)
+	defer func() {
+		for _, f := range files {
+			//nolint:gosec // we are closing the file
+			f.Close()
+		}
+	}()
+	if err != nil {
+		return nil, err
+	}
+	return getDataSources(files, tk, dsOpts...)
+}
+
+func (s *testSuite) testKitOptions(tc *testCase) ([]tkv1.Option, error) {
+	var opts []tkv1.Option
+	if tc.Git != nil && tc.Git.Dir != "" {
+		opts = append(opts, tkv1.WithGitDir(s.relativePath(tc.Git.Dir)))
+	}
+
+	responses := make([]tkv1.HTTPResponse, 0, len(tc.HTTP))
+	for _, mock := range tc.HTTP {
+		body := []byte(mock.Body)
+		if mock.BodyFile != "" {
+			var err error
+			body, err = os.ReadFile(s.relativePath(mock.BodyFile))
+			if err != nil {
+				return nil, fmt.Errorf("error reading HTTP response body: %w", err)
+			}
+		}
+		status := mock.Status
+		if status == 0 {
+			status = 200
+		}
+		responses = append(responses, tkv1.HTTPResponse{
+			Method:  mock.Method,
+			Path:    mock.Path,
+			Status:  status,
+			Body:    body,
+			Headers: mock.Headers,
+		})
+	}
+	if len(responses) > 0 {
+		opts = append(opts, tkv1.WithHTTPResponses(responses...))
+	}
+	return opts, nil
+}
+
+func writeGitFiles(dir string, files map[string]string) error {
+	for name, contents := range files {
+		path := filepath.Join(dir, filepath.Clean("/"+name))
+		if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+			return fmt.Errorf("error creating git fixture: %w", err)
+		}
+		if err := os.WriteFile(path, []byte(contents), 0600); err != nil {
+			return fmt.Errorf("error creating git fixture: %w", err)
+		}
+	}
+	return nil
+}
+
+// compareExpectation returns the differences between the expected and the
+// actual outcome of a test case
+func compareExpectation(expected, actual *testExpectation) []string {
+	if expected == nil {
+		return []string{"no expectation set, run with --update to record the current outcome"}
+	}
+
+	var failures []string
+	if expected.Status != actual.Status {
+		failures = append(failures, fmt.Sprintf("expected status %q, got %q", expected.Status, actual.Status))
+	}
+	if expected.Message != actual.Message {
+		failures = append(failures, fmt.Sprintf("expected message %q, got %q", expected.Message, actual.Message))
+	}
+	if !reflect.DeepEqual(expected.Remediation, actual.Remediation) {
+		want, _ := yaml.Marshal(expected.Remediation)
+		got, _ := yaml.Marshal(actual.Remediation)
+		failures = append(failures, fmt.Sprintf("expected remediation:\n%s\ngot:\n%s", want, got))
+	}
+	return failures
+}
+
+// updateExpectations rewrites the expectations of the test suite file with
+// the actual outcome of its test cases, keeping the rest of the file as is
+func updateExpectations(result *suiteResult) error {
+	path := result.suite.path
+	contents, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return fmt.Errorf("error reading test suite: %w", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(contents, &doc); err != nil {
+		return fmt.Errorf("error parsing test suite %s: %w", path, err)
+	}
+	tests := mappingValue(doc.Content[0], "tests")
+	if tests == nil || tests.Kind != yaml.SequenceNode || len(tests.Content) != len(result.cases) {
+		return fmt.Errorf("unexpected structure of test suite %s", path)
+	}
+
+	for i, res := range result.cases {
+		if res.actual == nil {
+			continue
+		}
+		var expect yaml.Node
+		if err := expect.Encode(res.actual); err != nil {
+			return fmt.Errorf("error encoding expectation: %w", err)
+		}
+		tc := tests.Content[i]
+		if existing := mappingValue(tc, "expect"); existing != nil {
+			*existing = expect
+			continue
+		}
+		tc.Content = append(tc.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "expect"}, &expect)
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return fmt.Errorf("error encoding test suite: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("error encoding test suite: %w", err)
+	}
+	//nolint:gosec // the suite file is meant to be readable
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
+
+// mappingValue returns the value of a key in a YAML mapping node
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rule_type
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/topdown"
+
+	"github.com/mindersec/minder/internal/engine/eval/rego"
+)
+
+const (
+	suiteFormatText  = "text"
+	suiteFormatJUnit = "junit"
+	suiteFormatTAP   = "tap"
+)
+
+// regoCoverage records which rules of a Rego policy succeeded during the
+// evaluations of a test suite
+type regoCoverage struct {
+	rules []*ast.Rule
+	mu    sync.Mutex
+	fired map[int]int
+}
+
+var _ topdown.QueryTracer = (*regoCoverage)(nil)
+
+func newRegoCoverage(policy string) (*regoCoverage, error) {
+	module, err := ast.ParseModuleWithOpts(rego.MinderRegoFile, policy, ast.ParserOptions{
+		RegoVersion: ast.RegoV0,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &regoCoverage{
+		rules: module.Rules,
+		fired: make(map[int]int),
+	}, nil
+}
+
+// Enabled implements the topdown.QueryTracer interface
+func (*regoCoverage) Enabled() bool {
+	return true
+}
+
+// Config implements the topdown.QueryTracer interface
+func (*regoCoverage) Config() topdown.TraceConfig {
+	return topdown.TraceConfig{}
+}
+
+// TraceEvent implements the topdown.QueryTracer interface. A rule fired
+// when its evaluation exits successfully.
+func (c *regoCoverage) TraceEvent(ev topdown.Event) {
+	if ev.Op != topdown.ExitOp {
+		return
+	}
+	rule, ok := ev.Node.(*ast.Rule)
+	if !ok || rule.Location == nil || rule.Location.File != rego.MinderRegoFile {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.fired[rule.Location.Row]++
+}
+
+// ruleCoverage is the number of times a rule of the policy fired
+type ruleCoverage struct {
+	name  string
+	row   int
+	fired int
+}
+
+func (c *regoCoverage) report() []ruleCoverage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	report := make([]ruleCoverage, 0, len(c.rules))
+	for _, rule := range c.rules {
+		name := rule.Head.Ref().String()
+		if rule.Default {
+			name = "default " + name
+		}
+		report = append(report, ruleCoverage{
+			name:  name,
+			row:   rule.Location.Row,
+			fired: c.fired[rule.Location.Row],
+		})
+	}
+	return report
+}
+
+func (c *regoCoverage) summary() string {
+	report := c.report()
+	covered := 0
+	for _, rule := range report {
+		if rule.fired > 0 {
+			covered++
+		}
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Rego rule coverage: %d/%d rules fired\n", covered, len(report))
+	for _, rule := range report {
+		fmt.Fprintf(&b, "  %s (line %d): fired %d times\n", rule.name, rule.row, rule.fired)
+	}
+	return b.String()
+}
+
+func writeSuiteResults(w io.Writer, format string, results []*suiteResult, coverage bool) error {
+	switch format {
+	case suiteFormatText:
+		writeText(w, results, coverage)
+		return nil
+	case suiteFormatTAP:
+		writeTAP(w, results, coverage)
+		return nil
+	case suiteFormatJUnit:
+		return writeJUnit(w, results, coverage)
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+func caseFailure(c *caseResult) string {
+	if c.err != nil {
+		return fmt.Sprintf("error running test case: %s", c.err)
+	}
+	return strings.Join(c.failures, "\n")
+}
+
+func writeText(w io.Writer, results []*suiteResult, coverage bool) {
+	for _, res := range results {
+		fmt.Fprintf(w, "=== %s\n", res.suite.name())
+		for _, c := range res.cases {
+			if c.passed() {
+				fmt.Fprintf(w, "--- PASS: %s (%.2fs)\n", c.name, c.duration.Seconds())
+				continue
+			}
+			fmt.Fprintf(w, "--- FAIL: %s (%.2fs)\n", c.name, c.duration.Seconds())
+			for _, line := range strings.Split(caseFailure(c), "\n") {
+				fmt.Fprintf(w, "    %s\n", line)
+			}
+		}
+		if coverage && res.coverage != nil {
+			fmt.Fprint(w, res.coverage.summary())
+		}
+		status := "ok"
+		if res.failed() > 0 {
+			status = "FAIL"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d/%d passed\t%.2fs\n",
+			status, res.suite.name(), len(res.cases)-res.failed(), len(res.cases), res.duration.Seconds())
+	}
+}
+
+func writeTAP(w io.Writer, results []*suiteResult, coverage bool) {
+	total := 0
+	for _, res := range results {
+		total += len(res.cases)
+	}
+	fmt.Fprintln(w, "TAP version 13")
+	fmt.Fprintf(w, "1..%d\n", total)
+
+	n := 0
+	for _, res := range results {
+		for _, c := range res.cases {
+			n++
+			if c.passed() {
+				fmt.Fprintf(w, "ok %d - %s: %s\n", n, res.suite.name(), c.name)
+				continue
+			}
+			fmt.Fprintf(w, "not ok %d - %s: %s\n", n, res.suite.name(), c.name)
+			fmt.Fprintln(w, "  ---")
+			fmt.Fprintln(w, "  message: |")
+			for _, line := range strings.Split(caseFailure(c), "\n") {
+				fmt.Fprintf(w, "    %s\n", line)
+			}
+			fmt.Fprintln(w, "  ...")
+		}
+		if coverage && res.coverage != nil {
+			for _, line := range strings.Split(strings.TrimSpace(res.coverage.summary()), "\n") {
+				fmt.Fprintf(w, "# %s: %s\n", res.suite.name(), strings.TrimSpace(line))
+			}
+		}
+	}
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+	SystemOut string          `xml:"system-out,omitempty"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+func writeJUnit(w io.Writer, results []*suiteResult, coverage bool) error {
+	doc := junitTestSuites{}
+	for _, res := range results {
+		suite := junitTestSuite{
+			Name:  res.suite.name(),
+			Tests: len(res.cases),
+			Time:  fmt.Sprintf("%.3f", res.duration.Seconds()),
+		}
+		for _, c := range res.cases {
+			tc := junitTestCase{
+				Name:      c.name,
+				ClassName: res.suite.name(),
+				Time:      fmt.Sprintf("%.3f", c.duration.Seconds()),
+			}
+			switch {
+			case c.err != nil:
+				suite.Errors++
+				tc.Error = &junitMessage{Message: "error running test case", Text: c.err.Error()}
+			case len(c.failures) > 0:
+				suite.Failures++
+				tc.Failure = &junitMessage{Message: c.failures[0], Text: caseFailure(c)}
+			}
+			suite.TestCases = append(suite.TestCases, tc)
+		}
+		if coverage && res.coverage != nil {
+			suite.SystemOut = res.coverage.summary()
+		}
+		doc.Suites = append(doc.Suites, suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("error encoding JUnit report: %w", err)
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rule_type
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const coveragePolicy = `package minder
+
+default allow = false
+
+allow {
+	input.ingested.enabled
+}
+`
+
+// reportResults returns a suite with a passing, a failing and an erroring
+// test case, and the Rego coverage of the passing one
+func reportResults(t *testing.T) []*suiteResult {
+	t.Helper()
+
+	coverage, err := newRegoCoverage(coveragePolicy)
+	require.NoError(t, err)
+	// the allow rule fired once
+	coverage.fired[5]++
+
+	return []*suiteResult{{
+		suite: &testSuite{path: "rules/secret_scanning.test.yaml"},
+		cases: []*caseResult{
+			{name: "enabled"},
+			{name: "disabled", failures: []string{`expected status "failure", got "success"`, "second failure"}},
+			{name: "broken", err: errors.New("no HTTP response left")},
+		},
+		coverage: coverage,
+	}}
+}
+
+func TestWriteSuiteResults(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		format   string
+		coverage bool
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:   "text",
+			format: suiteFormatText,
+			want: `=== secret_scanning
+--- PASS: enabled (0.00s)
+--- FAIL: disabled (0.00s)
+    expected status "failure", got "success"
+    second failure
+--- FAIL: broken (0.00s)
+    error running test case: no HTTP response left
+FAIL	secret_scanning	1/3 passed	0.00s
+`,
+		},
+		{
+			name:     "text with coverage",
+			format:   suiteFormatText,
+			coverage: true,
+			want: `=== secret_scanning
+--- PASS: enabled (0.00s)
+--- FAIL: disabled (0.00s)
+    expected status "failure", got "success"
+    second failure
+--- FAIL: broken (0.00s)
+    error running test case: no HTTP response left
+Rego rule coverage: 1/2 rules fired
+  default allow (line 3): fired 0 times
+  allow (line 5): fired 1 times
+FAIL	secret_scanning	1/3 passed	0.00s
+`,
+		},
+		{
+			name:     "tap",
+			format:   suiteFormatTAP,
+			coverage: true,
+			want: `TAP version 13
+1..3
+ok 1 - secret_scanning: enabled
+not ok 2 - secret_scanning: disabled
+  ---
+  message: |
+    expected status "failure", got "success"
+    second failure
+  ...
+not ok 3 - secret_scanning: broken
+  ---
+  message: |
+    error running test case: no HTTP response left
+  ...
+# secret_scanning: Rego rule coverage: 1/2 rules fired
+# secret_scanning: default allow (line 3): fired 0 times
+# secret_scanning: allow (line 5): fired 1 times
+`,
+		},
+		{
+			name:     "junit",
+			format:   suiteFormatJUnit,
+			coverage: true,
+			want: `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="secret_scanning" tests="3" failures="1" errors="1" time="0.000">
+    <testcase name="enabled" classname="secret_scanning" time="0.000"></testcase>
+    <testcase name="disabled" classname="secret_scanning" time="0.000">
+      <failure message="expected status &#34;failure&#34;, got &#34;success&#34;">expected status &#34;failure&#34;, got &#34;success&#34;&#xA;second failure</failure>
+    </testcase>
+    <testcase name="broken" classname="secret_scanning" time="0.000">
+      <error message="error running test case">no HTTP response left</error>
+    </testcase>
+    <system-out>Rego rule coverage: 1/2 rules fired&#xA;  default allow (line 3): fired 0 times&#xA;  allow (line 5): fired 1 times&#xA;</system-out>
+  </testsuite>
+</testsuites>
+`,
+		},
+		{
+			name:    "unknown format",
+			format:  "html",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var out bytes.Buffer
+			err := writeSuiteResults(&out, tt.format, reportResults(t), tt.coverage)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, out.String())
+		})
+	}
+}
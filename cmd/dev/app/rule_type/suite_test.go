@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rule_type
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	exampleSuite    = "../../examples/secret_scanning.test.yaml"
+	exampleCassette = "../../examples/secret_scanning.cassette.yaml"
+	exampleRuleType = "../../../cli/app/quickstart/embed/secret_scanning.yaml"
+)
+
+// copyExampleSuite copies the example test suite, its rule type and its
+// cassette to a temporary directory, dropping the expectations of the suite
+func copyExampleSuite(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for src, dst := range map[string]string{
+		exampleRuleType: "secret_scanning.yaml",
+		exampleCassette: "secret_scanning.cassette.yaml",
+	} {
+		contents, err := os.ReadFile(src)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, dst), contents, 0600))
+	}
+
+	contents, err := os.ReadFile(exampleSuite)
+	require.NoError(t, err)
+	var doc yaml.Node
+	require.NoError(t, yaml.Unmarshal(contents, &doc))
+
+	// The rule type is next to the suite in the copy
+	root := doc.Content[0]
+	root.Content = removeMappingKey(root.Content, "rule_type")
+	for _, tc := range mappingValue(root, "tests").Content {
+		tc.Content = removeMappingKey(tc.Content, "expect")
+	}
+
+	out, err := yaml.Marshal(&doc)
+	require.NoError(t, err)
+	path := filepath.Join(dir, "secret_scanning.test.yaml")
+	require.NoError(t, os.WriteFile(path, out, 0600))
+	return path
+}
+
+func removeMappingKey(content []*yaml.Node, key string) []*yaml.Node {
+	for i := 0; i+1 < len(content); i += 2 {
+		if content[i].Value == key {
+			return append(content[:i], content[i+2:]...)
+		}
+	}
+	return content
+}
+
+func runSuiteCmd(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+
+	cmd := CmdTest()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+	err := cmd.ExecuteContext(context.Background())
+	return out.String(), err
+}
+
+func readExpectations(t *testing.T, path string) []*testExpectation {
+	t.Helper()
+
+	suite, err := readTestSuite(path)
+	require.NoError(t, err)
+	expectations := make([]*testExpectation, 0, len(suite.Tests))
+	for _, tc := range suite.Tests {
+		expectations = append(expectations, tc.Expect)
+	}
+	return expectations
+}
+
+func TestRunTestSuitesUpdate(t *testing.T) {
+	t.Parallel()
+
+	path := copyExampleSuite(t)
+
+	// Without expectations, every test case fails
+	out, err := runSuiteCmd(t, "--suite", path)
+	require.ErrorContains(t, err, "4 of 4 test cases failed")
+	require.Contains(t, out, "no expectation set, run with --update to record the current outcome")
+
+	// --update records the expectations and passes
+	out, err = runSuiteCmd(t, "--suite", path, "--update")
+	require.NoError(t, err, out)
+	require.Equal(t, readExpectations(t, exampleSuite), readExpectations(t, path))
+
+	// the rest of the suite is kept as is
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(contents), "# Test suite for the secret_scanning rule type")
+	require.Contains(t, string(contents), "entity: &repo")
+
+	// and the recorded expectations are met on the next run
+	out, err = runSuiteCmd(t, "--suite", path)
+	require.NoError(t, err, out)
+	require.Contains(t, out, "ok\tsecret_scanning\t4/4 passed")
+}
+
+func TestUpdateExpectationsStructureMismatch(t *testing.T) {
+	t.Parallel()
+
+	path := copyExampleSuite(t)
+	suite, err := readTestSuite(path)
+	require.NoError(t, err)
+
+	// the results don't match the test cases of the file
+	err = updateExpectations(&suiteResult{
+		suite: suite,
+		cases: []*caseResult{{name: "only one", actual: &testExpectation{Status: "success"}}},
+	})
+	require.ErrorContains(t, err, "unexpected structure of test suite")
+}
+
+func TestCompareExpectation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		expected *testExpectation
+		actual   *testExpectation
+		want     []string
+	}{
+		{
+			name:     "matching",
+			expected: &testExpectation{Status: "failure", Message: "disabled"},
+			actual:   &testExpectation{Status: "failure", Message: "disabled"},
+		},
+		{
+			name:   "no expectation",
+			actual: &testExpectation{Status: "success"},
+			want:   []string{"no expectation set, run with --update to record the current outcome"},
+		},
+		{
+			name:     "status and message differ",
+			expected: &testExpectation{Status: "success"},
+			actual:   &testExpectation{Status: "failure", Message: "disabled"},
+			want: []string{
+				`expected status "success", got "failure"`,
+				`expected message "", got "disabled"`,
+			},
+		},
+		{
+			name: "remediation differs",
+			expected: &testExpectation{Status: "failure", Remediation: &remediationExpectation{
+				Status: "success",
+			}},
+			actual: &testExpectation{Status: "failure", Remediation: &remediationExpectation{
+				Status: "failure",
+			}},
+			want: []string{"expected remediation:\nstatus: success\n\ngot:\nstatus: failure\n"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, compareExpectation(tt.expected, tt.actual))
+		})
+	}
+}
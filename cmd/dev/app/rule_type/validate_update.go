@@ -8,8 +8,11 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 
 	"github.com/mindersec/minder/internal/util/schemaupdate"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/profiles"
 )
 
 // CmdValidateUpdate is the command for validating an update of a rule type definition
@@ -19,12 +22,18 @@ func CmdValidateUpdate() *cobra.Command {
 		Aliases: []string{"vu"},
 		Short:   "validate an update of a rule type definition",
 		Long: `The 'ruletype validate-update' subcommand allows you to validate an update of a rule type 
-definition`,
+definition.
+
+Updates keeping the schema version must be backwards compatible. Updates to a
+new schema version may change the schemas freely, and their migration is
+checked against the rules of the profiles given with --profile instead.`,
 		RunE:         vuCmdRun,
 		SilenceUsage: true,
 	}
 	vuCmd.Flags().StringP("before", "b", "", "file to read rule type definition from")
 	vuCmd.Flags().StringP("after", "a", "", "file to read rule type definition from")
+	vuCmd.Flags().StringArrayP("profile", "p", []string{},
+		"profile whose rules are migrated when the schema version changes (optional)")
 
 	if err := vuCmd.MarkFlagRequired("before"); err != nil {
 		fmt.Fprintf(os.Stderr, "Error marking flag as required: %s\n", err)
@@ -64,6 +73,18 @@ func vuCmdRun(cmd *cobra.Command, _ []string) error {
 	beforeDef := beforeRt.GetDef()
 	afterDef := afterRt.GetDef()
 
+	if afterDef.GetSchemaVersion() < beforeDef.GetSchemaVersion() {
+		return fmt.Errorf("schema version cannot decrease from %d to %d",
+			beforeDef.GetSchemaVersion(), afterDef.GetSchemaVersion())
+	}
+	if afterDef.GetSchemaVersion() > beforeDef.GetSchemaVersion() {
+		profilePaths, err := cmd.Flags().GetStringArray("profile")
+		if err != nil {
+			return fmt.Errorf("error getting profile flag: %w", err)
+		}
+		return validateMigration(cmd, afterRt, profilePaths)
+	}
+
 	if err := schemaupdate.ValidateSchemaUpdate(beforeDef.GetRuleSchema(), afterDef.GetRuleSchema()); err != nil {
 		return fmt.Errorf("error validating rule schema update: %w", err)
 	}
@@ -74,3 +95,53 @@ func vuCmdRun(cmd *cobra.Command, _ []string) error {
 
 	return nil
 }
+
+// validateMigration migrates the rules of the given profiles which use the
+// rule type, and checks them against its new schemas
+func validateMigration(cmd *cobra.Command, ruleType *minderv1.RuleType, profilePaths []string) error {
+	def := ruleType.GetDef()
+	migration := &schemaupdate.Migration{}
+	if m := def.GetMigration(); m != nil {
+		var err error
+		migration, err = schemaupdate.NewMigration(m.GetType(), m.GetDef(), m.GetParams())
+		if err != nil {
+			return err
+		}
+	}
+
+	validator, err := profiles.NewRuleValidator(ruleType)
+	if err != nil {
+		return fmt.Errorf("error creating rule validator: %w", err)
+	}
+
+	for _, path := range profilePaths {
+		profile, err := profiles.ReadProfileFromFile(path)
+		if err != nil {
+			return fmt.Errorf("error reading profile from %s: %w", path, err)
+		}
+		rules, err := profiles.GetRulesFromProfileOfType(profile, ruleType)
+		if err != nil {
+			return fmt.Errorf("error getting rules from profile %s: %w", path, err)
+		}
+		for _, rule := range rules {
+			newDef, newParams, err := migration.Apply(cmd.Context(), rule.GetDef().AsMap(), rule.GetParams().AsMap())
+			if err != nil {
+				return fmt.Errorf("error migrating rule %s of profile %s: %w", rule.GetName(), path, err)
+			}
+			if err := validator.ValidateRuleDefAgainstSchema(newDef); err != nil {
+				return fmt.Errorf("migrated rule %s of profile %s is invalid: %w", rule.GetName(), path, err)
+			}
+			if err := validator.ValidateParamsAgainstSchema(newParams); err != nil {
+				return fmt.Errorf("migrated rule %s of profile %s is invalid: %w", rule.GetName(), path, err)
+			}
+
+			migrated, err := yaml.Marshal(map[string]any{"def": newDef, "params": newParams})
+			if err != nil {
+				return fmt.Errorf("error encoding migrated rule: %w", err)
+			}
+			cmd.Printf("Migrated rule %s of profile %s:\n%s\n", rule.GetName(), path, migrated)
+		}
+	}
+
+	return nil
+}
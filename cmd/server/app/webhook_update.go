@@ -25,6 +25,7 @@ import (
 	"github.com/mindersec/minder/internal/providers/github/clients"
 	ghmanager "github.com/mindersec/minder/internal/providers/github/manager"
 	"github.com/mindersec/minder/internal/providers/manager"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	"github.com/mindersec/minder/internal/providers/ratecache"
 	"github.com/mindersec/minder/internal/providers/telemetry"
 	"github.com/mindersec/minder/pkg/config"
@@ -236,7 +237,8 @@ func wireUpProviderManager(
 	propSvc := propssvc.NewPropertiesService(store)
 	githubProviderManager := ghmanager.NewGitHubProviderClassManager(
 		&ratecache.NoopRestClientCache{},
-		clients.NewGitHubClientFactory(telemetry.NewNoopMetrics()),
+		clients.NewGitHubClientFactory(telemetry.NewNoopMetrics(), &ratebudget.NoopTracker{}),
+		&ratebudget.NoopTracker{},
 		&cfg.Provider,
 		&cfg.WebhookConfig,
 		fallbackTokenClient,
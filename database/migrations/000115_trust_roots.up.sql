@@ -0,0 +1,26 @@
+-- SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- Trust roots hold custom Sigstore trusted material (a trusted root or a
+-- TUF repository for a private Sigstore deployment, and/or static public
+-- keys) that artifact signatures can be verified against. The definition
+-- is stored as the JSON-serialized protobuf message.
+--
+-- As with data sources, names must be unique within a project hierarchy,
+-- which is enforced at the application layer.
+
+CREATE TABLE trust_roots(
+    id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    name TEXT NOT NULL,
+    project_id UUID NOT NULL,
+    definition JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX trust_roots_name_lower_idx ON trust_roots (project_id, lower(name));
+
+COMMIT;
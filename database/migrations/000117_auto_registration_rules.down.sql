@@ -0,0 +1,9 @@
+-- SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+DROP TABLE auto_registered_entities;
+DROP TABLE auto_registration_rules;
+
+COMMIT;
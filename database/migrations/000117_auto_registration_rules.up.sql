@@ -0,0 +1,36 @@
+-- SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- Auto-registration rules select, by means of a CEL expression over the
+-- properties of the remote entities, which entities of a project are
+-- registered automatically. A rule may be restricted to a single provider,
+-- otherwise it applies to all the providers of the project.
+
+CREATE TABLE auto_registration_rules(
+    id UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    provider_id UUID REFERENCES providers(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    entity_type entities NOT NULL,
+    selector TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX auto_registration_rules_name_lower_idx ON auto_registration_rules (project_id, lower(name));
+
+-- Auto-registered entities are the entities registered because they matched
+-- an auto-registration rule. Only those are deregistered when they no longer
+-- match any rule, entities registered by hand are left alone.
+
+CREATE TABLE auto_registered_entities(
+    entity_instance_id UUID NOT NULL PRIMARY KEY REFERENCES entity_instances(id) ON DELETE CASCADE,
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    rule_name TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX auto_registered_entities_project_id_idx ON auto_registered_entities (project_id);
+
+COMMIT;
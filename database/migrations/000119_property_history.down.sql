@@ -0,0 +1,9 @@
+-- SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+DROP TABLE IF EXISTS property_history;
+DROP TYPE IF EXISTS property_change_source;
+
+COMMIT;
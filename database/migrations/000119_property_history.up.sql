@@ -0,0 +1,26 @@
+-- SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- What caused a property of an entity to change
+CREATE TYPE property_change_source AS ENUM ('webhook', 'refresh', 'reconcile', 'registration');
+
+-- Every change of a property of an entity, recorded when the properties
+-- table is updated. A NULL old_value means the property was added, a NULL
+-- new_value that it was removed. Values use the same versioned format as
+-- the properties table.
+CREATE TABLE property_history(
+    id BIGSERIAL PRIMARY KEY,
+    entity_id UUID NOT NULL REFERENCES entity_instances(id) ON DELETE CASCADE,
+    key TEXT NOT NULL,
+    old_value JSONB,
+    new_value JSONB,
+    source property_change_source NOT NULL,
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX property_history_entity_id_idx ON property_history (entity_id, id DESC);
+CREATE INDEX property_history_changed_at_idx ON property_history (changed_at);
+
+COMMIT;
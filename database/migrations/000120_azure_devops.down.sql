@@ -0,0 +1,4 @@
+-- SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- Can't delete enum types, so we'll just leave it
@@ -0,0 +1,5 @@
+-- SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+-- Add `azure-devops` provider class
+ALTER TYPE provider_class ADD VALUE 'azure-devops';
@@ -0,0 +1,9 @@
+-- SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+DROP TABLE IF EXISTS provider_health;
+DROP TYPE IF EXISTS provider_health_status;
+
+COMMIT;
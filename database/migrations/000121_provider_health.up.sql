@@ -0,0 +1,21 @@
+-- SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+CREATE TYPE provider_health_status AS ENUM ('healthy', 'degraded', 'unhealthy');
+
+-- The result of the last periodic health check of each provider. The
+-- expiry_notified_at column records when the upcoming expiry of the
+-- current credential was notified, so that it is only notified once.
+CREATE TABLE provider_health(
+    provider_id UUID PRIMARY KEY REFERENCES providers(id) ON DELETE CASCADE,
+    status provider_health_status NOT NULL,
+    last_error TEXT NOT NULL DEFAULT '',
+    checked_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_healthy_at TIMESTAMP WITH TIME ZONE,
+    credential_expires_at TIMESTAMP WITH TIME ZONE,
+    expiry_notified_at TIMESTAMP WITH TIME ZONE
+);
+
+COMMIT;
@@ -0,0 +1,19 @@
+-- SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+-- SPDX-License-Identifier: Apache-2.0
+
+BEGIN;
+
+-- The webhook deliveries received for each registered entity and the result
+-- of the last reconciliation of its webhook. quiet_since is set when no
+-- delivery was received for longer than the configured threshold and is
+-- cleared by the next delivery.
+CREATE TABLE webhook_delivery_status(
+    entity_instance_id UUID PRIMARY KEY REFERENCES entity_instances(id) ON DELETE CASCADE,
+    last_delivery_at TIMESTAMP WITH TIME ZONE,
+    last_reconciled_at TIMESTAMP WITH TIME ZONE,
+    last_repair TEXT NOT NULL DEFAULT '',
+    last_repaired_at TIMESTAMP WITH TIME ZONE,
+    quiet_since TIMESTAMP WITH TIME ZONE
+);
+
+COMMIT;
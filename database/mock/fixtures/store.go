@@ -241,3 +241,11 @@ func WithFailedGetEntitiesByProjectHierarchy(
 			Return(nil, err)
 	}
 }
+
+func WithSuccessfulRecordWebhookDelivery(entID uuid.UUID) func(*mockdb.MockStore) {
+	return func(mockStore *mockdb.MockStore) {
+		mockStore.EXPECT().
+			RecordWebhookDelivery(gomock.Any(), entID).
+			Return(nil)
+	}
+}
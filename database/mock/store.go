@@ -14,6 +14,7 @@ import (
 	sql "database/sql"
 	json "encoding/json"
 	reflect "reflect"
+	time "time"
 
 	uuid "github.com/google/uuid"
 	db "github.com/mindersec/minder/internal/db"
@@ -162,6 +163,21 @@ func (mr *MockStoreMockRecorder) CountEntitiesByTypeAndProject(ctx, arg any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountEntitiesByTypeAndProject", reflect.TypeOf((*MockStore)(nil).CountEntitiesByTypeAndProject), ctx, arg)
 }
 
+// CountEvaluationHistoryByDay mocks base method.
+func (m *MockStore) CountEvaluationHistoryByDay(ctx context.Context, arg db.CountEvaluationHistoryByDayParams) ([]db.CountEvaluationHistoryByDayRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountEvaluationHistoryByDay", ctx, arg)
+	ret0, _ := ret[0].([]db.CountEvaluationHistoryByDayRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountEvaluationHistoryByDay indicates an expected call of CountEvaluationHistoryByDay.
+func (mr *MockStoreMockRecorder) CountEvaluationHistoryByDay(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountEvaluationHistoryByDay", reflect.TypeOf((*MockStore)(nil).CountEvaluationHistoryByDay), ctx, arg)
+}
+
 // CountProfilesByEntityType mocks base method.
 func (m *MockStore) CountProfilesByEntityType(ctx context.Context) ([]db.CountProfilesByEntityTypeRow, error) {
 	m.ctrl.T.Helper()
@@ -222,6 +238,36 @@ func (mr *MockStoreMockRecorder) CountUsers(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUsers", reflect.TypeOf((*MockStore)(nil).CountUsers), ctx)
 }
 
+// CreateAutoRegisteredEntity mocks base method.
+func (m *MockStore) CreateAutoRegisteredEntity(ctx context.Context, arg db.CreateAutoRegisteredEntityParams) (db.AutoRegisteredEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAutoRegisteredEntity", ctx, arg)
+	ret0, _ := ret[0].(db.AutoRegisteredEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAutoRegisteredEntity indicates an expected call of CreateAutoRegisteredEntity.
+func (mr *MockStoreMockRecorder) CreateAutoRegisteredEntity(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoRegisteredEntity", reflect.TypeOf((*MockStore)(nil).CreateAutoRegisteredEntity), ctx, arg)
+}
+
+// CreateAutoRegistrationRule mocks base method.
+func (m *MockStore) CreateAutoRegistrationRule(ctx context.Context, arg db.CreateAutoRegistrationRuleParams) (db.AutoRegistrationRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAutoRegistrationRule", ctx, arg)
+	ret0, _ := ret[0].(db.AutoRegistrationRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAutoRegistrationRule indicates an expected call of CreateAutoRegistrationRule.
+func (mr *MockStoreMockRecorder) CreateAutoRegistrationRule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoRegistrationRule", reflect.TypeOf((*MockStore)(nil).CreateAutoRegistrationRule), ctx, arg)
+}
+
 // CreateDataSource mocks base method.
 func (m *MockStore) CreateDataSource(ctx context.Context, arg db.CreateDataSourceParams) (db.DataSource, error) {
 	m.ctrl.T.Helper()
@@ -446,6 +492,21 @@ func (mr *MockStoreMockRecorder) CreateSubscription(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockStore)(nil).CreateSubscription), ctx, arg)
 }
 
+// CreateTrustRoot mocks base method.
+func (m *MockStore) CreateTrustRoot(ctx context.Context, arg db.CreateTrustRootParams) (db.TrustRoot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTrustRoot", ctx, arg)
+	ret0, _ := ret[0].(db.TrustRoot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTrustRoot indicates an expected call of CreateTrustRoot.
+func (mr *MockStoreMockRecorder) CreateTrustRoot(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrustRoot", reflect.TypeOf((*MockStore)(nil).CreateTrustRoot), ctx, arg)
+}
+
 // CreateUser mocks base method.
 func (m *MockStore) CreateUser(ctx context.Context, identitySubject string) (db.User, error) {
 	m.ctrl.T.Helper()
@@ -461,6 +522,21 @@ func (mr *MockStoreMockRecorder) CreateUser(ctx, identitySubject any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), ctx, identitySubject)
 }
 
+// CreateWaiver mocks base method.
+func (m *MockStore) CreateWaiver(ctx context.Context, arg db.CreateWaiverParams) (db.Waiver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWaiver", ctx, arg)
+	ret0, _ := ret[0].(db.Waiver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWaiver indicates an expected call of CreateWaiver.
+func (mr *MockStoreMockRecorder) CreateWaiver(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWaiver", reflect.TypeOf((*MockStore)(nil).CreateWaiver), ctx, arg)
+}
+
 // DeleteAllPropertiesForEntity mocks base method.
 func (m *MockStore) DeleteAllPropertiesForEntity(ctx context.Context, entityID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -475,6 +551,21 @@ func (mr *MockStoreMockRecorder) DeleteAllPropertiesForEntity(ctx, entityID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllPropertiesForEntity", reflect.TypeOf((*MockStore)(nil).DeleteAllPropertiesForEntity), ctx, entityID)
 }
 
+// DeleteAutoRegistrationRule mocks base method.
+func (m *MockStore) DeleteAutoRegistrationRule(ctx context.Context, arg db.DeleteAutoRegistrationRuleParams) (db.AutoRegistrationRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAutoRegistrationRule", ctx, arg)
+	ret0, _ := ret[0].(db.AutoRegistrationRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAutoRegistrationRule indicates an expected call of DeleteAutoRegistrationRule.
+func (mr *MockStoreMockRecorder) DeleteAutoRegistrationRule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutoRegistrationRule", reflect.TypeOf((*MockStore)(nil).DeleteAutoRegistrationRule), ctx, arg)
+}
+
 // DeleteDataSource mocks base method.
 func (m *MockStore) DeleteDataSource(ctx context.Context, arg db.DeleteDataSourceParams) (db.DataSource, error) {
 	m.ctrl.T.Helper()
@@ -664,6 +755,21 @@ func (mr *MockStoreMockRecorder) DeleteProperty(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProperty", reflect.TypeOf((*MockStore)(nil).DeleteProperty), ctx, arg)
 }
 
+// DeletePropertyHistoryBefore mocks base method.
+func (m *MockStore) DeletePropertyHistoryBefore(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePropertyHistoryBefore", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePropertyHistoryBefore indicates an expected call of DeletePropertyHistoryBefore.
+func (mr *MockStoreMockRecorder) DeletePropertyHistoryBefore(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePropertyHistoryBefore", reflect.TypeOf((*MockStore)(nil).DeletePropertyHistoryBefore), ctx, before)
+}
+
 // DeleteProvider mocks base method.
 func (m *MockStore) DeleteProvider(ctx context.Context, arg db.DeleteProviderParams) error {
 	m.ctrl.T.Helper()
@@ -762,6 +868,35 @@ func (mr *MockStoreMockRecorder) DeleteSessionStateByProjectID(ctx, arg any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSessionStateByProjectID", reflect.TypeOf((*MockStore)(nil).DeleteSessionStateByProjectID), ctx, arg)
 }
 
+// DeleteSubscription mocks base method.
+func (m *MockStore) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockStoreMockRecorder) DeleteSubscription(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockStore)(nil).DeleteSubscription), ctx, id)
+}
+
+// DeleteTrustRoot mocks base method.
+func (m *MockStore) DeleteTrustRoot(ctx context.Context, arg db.DeleteTrustRootParams) (db.TrustRoot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrustRoot", ctx, arg)
+	ret0, _ := ret[0].(db.TrustRoot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTrustRoot indicates an expected call of DeleteTrustRoot.
+func (mr *MockStoreMockRecorder) DeleteTrustRoot(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrustRoot", reflect.TypeOf((*MockStore)(nil).DeleteTrustRoot), ctx, arg)
+}
+
 // DeleteUser mocks base method.
 func (m *MockStore) DeleteUser(ctx context.Context, id int32) error {
 	m.ctrl.T.Helper()
@@ -776,6 +911,21 @@ func (mr *MockStoreMockRecorder) DeleteUser(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockStore)(nil).DeleteUser), ctx, id)
 }
 
+// DeleteWaiver mocks base method.
+func (m *MockStore) DeleteWaiver(ctx context.Context, arg db.DeleteWaiverParams) (db.Waiver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWaiver", ctx, arg)
+	ret0, _ := ret[0].(db.Waiver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWaiver indicates an expected call of DeleteWaiver.
+func (mr *MockStoreMockRecorder) DeleteWaiver(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWaiver", reflect.TypeOf((*MockStore)(nil).DeleteWaiver), ctx, arg)
+}
+
 // EnqueueFlush mocks base method.
 func (m *MockStore) EnqueueFlush(ctx context.Context, arg db.EnqueueFlushParams) (db.FlushCache, error) {
 	m.ctrl.T.Helper()
@@ -896,6 +1046,21 @@ func (mr *MockStoreMockRecorder) GetAccessTokenSinceDate(ctx, arg any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessTokenSinceDate", reflect.TypeOf((*MockStore)(nil).GetAccessTokenSinceDate), ctx, arg)
 }
 
+// GetActiveWaiver mocks base method.
+func (m *MockStore) GetActiveWaiver(ctx context.Context, arg db.GetActiveWaiverParams) (db.Waiver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveWaiver", ctx, arg)
+	ret0, _ := ret[0].(db.Waiver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveWaiver indicates an expected call of GetActiveWaiver.
+func (mr *MockStoreMockRecorder) GetActiveWaiver(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveWaiver", reflect.TypeOf((*MockStore)(nil).GetActiveWaiver), ctx, arg)
+}
+
 // GetAllPropertiesForEntity mocks base method.
 func (m *MockStore) GetAllPropertiesForEntity(ctx context.Context, entityID uuid.UUID) ([]db.Property, error) {
 	m.ctrl.T.Helper()
@@ -926,6 +1091,21 @@ func (mr *MockStoreMockRecorder) GetAllPropertyValuesV1(ctx, entityID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPropertyValuesV1", reflect.TypeOf((*MockStore)(nil).GetAllPropertyValuesV1), ctx, entityID)
 }
 
+// GetAutoRegistrationRuleByName mocks base method.
+func (m *MockStore) GetAutoRegistrationRuleByName(ctx context.Context, arg db.GetAutoRegistrationRuleByNameParams) (db.AutoRegistrationRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutoRegistrationRuleByName", ctx, arg)
+	ret0, _ := ret[0].(db.AutoRegistrationRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutoRegistrationRuleByName indicates an expected call of GetAutoRegistrationRuleByName.
+func (mr *MockStoreMockRecorder) GetAutoRegistrationRuleByName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoRegistrationRuleByName", reflect.TypeOf((*MockStore)(nil).GetAutoRegistrationRuleByName), ctx, arg)
+}
+
 // GetBundle mocks base method.
 func (m *MockStore) GetBundle(ctx context.Context, arg db.GetBundleParams) (db.Bundle, error) {
 	m.ctrl.T.Helper()
@@ -1496,6 +1676,21 @@ func (mr *MockStoreMockRecorder) GetProviderByName(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProviderByName", reflect.TypeOf((*MockStore)(nil).GetProviderByName), ctx, arg)
 }
 
+// GetProviderHealth mocks base method.
+func (m *MockStore) GetProviderHealth(ctx context.Context, providerID uuid.UUID) (db.ProviderHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProviderHealth", ctx, providerID)
+	ret0, _ := ret[0].(db.ProviderHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProviderHealth indicates an expected call of GetProviderHealth.
+func (mr *MockStoreMockRecorder) GetProviderHealth(ctx, providerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProviderHealth", reflect.TypeOf((*MockStore)(nil).GetProviderHealth), ctx, providerID)
+}
+
 // GetQuerierWithTransaction mocks base method.
 func (m *MockStore) GetQuerierWithTransaction(tx *sql.Tx) db.ExtendQuerier {
 	m.ctrl.T.Helper()
@@ -1540,6 +1735,36 @@ func (mr *MockStoreMockRecorder) GetRuleEvaluationByProfileIdAndRuleType(ctx, pr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuleEvaluationByProfileIdAndRuleType", reflect.TypeOf((*MockStore)(nil).GetRuleEvaluationByProfileIdAndRuleType), ctx, profileID, ruleName, entityID, ruleTypeName)
 }
 
+// GetRuleInstanceByID mocks base method.
+func (m *MockStore) GetRuleInstanceByID(ctx context.Context, id uuid.UUID) (db.RuleInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRuleInstanceByID", ctx, id)
+	ret0, _ := ret[0].(db.RuleInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRuleInstanceByID indicates an expected call of GetRuleInstanceByID.
+func (mr *MockStoreMockRecorder) GetRuleInstanceByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuleInstanceByID", reflect.TypeOf((*MockStore)(nil).GetRuleInstanceByID), ctx, id)
+}
+
+// GetRuleInstanceByProfileAndName mocks base method.
+func (m *MockStore) GetRuleInstanceByProfileAndName(ctx context.Context, arg db.GetRuleInstanceByProfileAndNameParams) (db.RuleInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRuleInstanceByProfileAndName", ctx, arg)
+	ret0, _ := ret[0].(db.RuleInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRuleInstanceByProfileAndName indicates an expected call of GetRuleInstanceByProfileAndName.
+func (mr *MockStoreMockRecorder) GetRuleInstanceByProfileAndName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuleInstanceByProfileAndName", reflect.TypeOf((*MockStore)(nil).GetRuleInstanceByProfileAndName), ctx, arg)
+}
+
 // GetRuleInstancesEntityInProjects mocks base method.
 func (m *MockStore) GetRuleInstancesEntityInProjects(ctx context.Context, arg db.GetRuleInstancesEntityInProjectsParams) ([]db.RuleInstance, error) {
 	m.ctrl.T.Helper()
@@ -1690,6 +1915,21 @@ func (mr *MockStoreMockRecorder) GetSubscriptionByProjectBundle(ctx, arg any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionByProjectBundle", reflect.TypeOf((*MockStore)(nil).GetSubscriptionByProjectBundle), ctx, arg)
 }
 
+// GetTrustRootByName mocks base method.
+func (m *MockStore) GetTrustRootByName(ctx context.Context, arg db.GetTrustRootByNameParams) (db.TrustRoot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrustRootByName", ctx, arg)
+	ret0, _ := ret[0].(db.TrustRoot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrustRootByName indicates an expected call of GetTrustRootByName.
+func (mr *MockStoreMockRecorder) GetTrustRootByName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrustRootByName", reflect.TypeOf((*MockStore)(nil).GetTrustRootByName), ctx, arg)
+}
+
 // GetTypedEntitiesByProperty mocks base method.
 func (m *MockStore) GetTypedEntitiesByProperty(ctx context.Context, arg db.GetTypedEntitiesByPropertyParams) ([]db.EntityInstance, error) {
 	m.ctrl.T.Helper()
@@ -1765,6 +2005,36 @@ func (mr *MockStoreMockRecorder) GetUserBySubject(ctx, identitySubject any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserBySubject", reflect.TypeOf((*MockStore)(nil).GetUserBySubject), ctx, identitySubject)
 }
 
+// GetWaiverByID mocks base method.
+func (m *MockStore) GetWaiverByID(ctx context.Context, arg db.GetWaiverByIDParams) (db.GetWaiverByIDRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaiverByID", ctx, arg)
+	ret0, _ := ret[0].(db.GetWaiverByIDRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWaiverByID indicates an expected call of GetWaiverByID.
+func (mr *MockStoreMockRecorder) GetWaiverByID(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaiverByID", reflect.TypeOf((*MockStore)(nil).GetWaiverByID), ctx, arg)
+}
+
+// GetWebhookDeliveryStatus mocks base method.
+func (m *MockStore) GetWebhookDeliveryStatus(ctx context.Context, entityInstanceID uuid.UUID) (db.WebhookDeliveryStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDeliveryStatus", ctx, entityInstanceID)
+	ret0, _ := ret[0].(db.WebhookDeliveryStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookDeliveryStatus indicates an expected call of GetWebhookDeliveryStatus.
+func (mr *MockStoreMockRecorder) GetWebhookDeliveryStatus(ctx, entityInstanceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveryStatus", reflect.TypeOf((*MockStore)(nil).GetWebhookDeliveryStatus), ctx, entityInstanceID)
+}
+
 // GlobalListProviders mocks base method.
 func (m *MockStore) GlobalListProviders(ctx context.Context) ([]db.Provider, error) {
 	m.ctrl.T.Helper()
@@ -1839,6 +2109,20 @@ func (mr *MockStoreMockRecorder) InsertEvaluationStatus(ctx, arg any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertEvaluationStatus", reflect.TypeOf((*MockStore)(nil).InsertEvaluationStatus), ctx, arg)
 }
 
+// InsertPropertyHistory mocks base method.
+func (m *MockStore) InsertPropertyHistory(ctx context.Context, arg db.InsertPropertyHistoryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertPropertyHistory", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertPropertyHistory indicates an expected call of InsertPropertyHistory.
+func (mr *MockStoreMockRecorder) InsertPropertyHistory(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPropertyHistory", reflect.TypeOf((*MockStore)(nil).InsertPropertyHistory), ctx, arg)
+}
+
 // InsertRemediationEvent mocks base method.
 func (m *MockStore) InsertRemediationEvent(ctx context.Context, arg db.InsertRemediationEventParams) error {
 	m.ctrl.T.Helper()
@@ -1868,6 +2152,51 @@ func (mr *MockStoreMockRecorder) ListAllRootProjects(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllRootProjects", reflect.TypeOf((*MockStore)(nil).ListAllRootProjects), ctx)
 }
 
+// ListAutoRegisteredEntities mocks base method.
+func (m *MockStore) ListAutoRegisteredEntities(ctx context.Context, arg db.ListAutoRegisteredEntitiesParams) ([]db.ListAutoRegisteredEntitiesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAutoRegisteredEntities", ctx, arg)
+	ret0, _ := ret[0].([]db.ListAutoRegisteredEntitiesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAutoRegisteredEntities indicates an expected call of ListAutoRegisteredEntities.
+func (mr *MockStoreMockRecorder) ListAutoRegisteredEntities(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoRegisteredEntities", reflect.TypeOf((*MockStore)(nil).ListAutoRegisteredEntities), ctx, arg)
+}
+
+// ListAutoRegistrationRules mocks base method.
+func (m *MockStore) ListAutoRegistrationRules(ctx context.Context, arg db.ListAutoRegistrationRulesParams) ([]db.AutoRegistrationRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAutoRegistrationRules", ctx, arg)
+	ret0, _ := ret[0].([]db.AutoRegistrationRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAutoRegistrationRules indicates an expected call of ListAutoRegistrationRules.
+func (mr *MockStoreMockRecorder) ListAutoRegistrationRules(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoRegistrationRules", reflect.TypeOf((*MockStore)(nil).ListAutoRegistrationRules), ctx, arg)
+}
+
+// ListAutoRegistrationTargets mocks base method.
+func (m *MockStore) ListAutoRegistrationTargets(ctx context.Context) ([]db.ListAutoRegistrationTargetsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAutoRegistrationTargets", ctx)
+	ret0, _ := ret[0].([]db.ListAutoRegistrationTargetsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAutoRegistrationTargets indicates an expected call of ListAutoRegistrationTargets.
+func (mr *MockStoreMockRecorder) ListAutoRegistrationTargets(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoRegistrationTargets", reflect.TypeOf((*MockStore)(nil).ListAutoRegistrationTargets), ctx)
+}
+
 // ListDataSourceFunctions mocks base method.
 func (m *MockStore) ListDataSourceFunctions(ctx context.Context, arg db.ListDataSourceFunctionsParams) ([]db.DataSourcesFunction, error) {
 	m.ctrl.T.Helper()
@@ -1898,6 +2227,21 @@ func (mr *MockStoreMockRecorder) ListDataSources(ctx, projects any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDataSources", reflect.TypeOf((*MockStore)(nil).ListDataSources), ctx, projects)
 }
 
+// ListDataSourcesBySubscription mocks base method.
+func (m *MockStore) ListDataSourcesBySubscription(ctx context.Context, subscriptionID uuid.NullUUID) ([]db.DataSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDataSourcesBySubscription", ctx, subscriptionID)
+	ret0, _ := ret[0].([]db.DataSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDataSourcesBySubscription indicates an expected call of ListDataSourcesBySubscription.
+func (mr *MockStoreMockRecorder) ListDataSourcesBySubscription(ctx, subscriptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDataSourcesBySubscription", reflect.TypeOf((*MockStore)(nil).ListDataSourcesBySubscription), ctx, subscriptionID)
+}
+
 // ListEntitiesAfterID mocks base method.
 func (m *MockStore) ListEntitiesAfterID(ctx context.Context, arg db.ListEntitiesAfterIDParams) ([]db.EntityInstance, error) {
 	m.ctrl.T.Helper()
@@ -1913,6 +2257,21 @@ func (mr *MockStoreMockRecorder) ListEntitiesAfterID(ctx, arg any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntitiesAfterID", reflect.TypeOf((*MockStore)(nil).ListEntitiesAfterID), ctx, arg)
 }
 
+// ListEntitiesByProjectAndName mocks base method.
+func (m *MockStore) ListEntitiesByProjectAndName(ctx context.Context, arg db.ListEntitiesByProjectAndNameParams) ([]db.EntityInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntitiesByProjectAndName", ctx, arg)
+	ret0, _ := ret[0].([]db.EntityInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntitiesByProjectAndName indicates an expected call of ListEntitiesByProjectAndName.
+func (mr *MockStoreMockRecorder) ListEntitiesByProjectAndName(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntitiesByProjectAndName", reflect.TypeOf((*MockStore)(nil).ListEntitiesByProjectAndName), ctx, arg)
+}
+
 // ListEvaluationHistory mocks base method.
 func (m *MockStore) ListEvaluationHistory(ctx context.Context, arg db.ListEvaluationHistoryParams) ([]db.ListEvaluationHistoryRow, error) {
 	m.ctrl.T.Helper()
@@ -1973,6 +2332,21 @@ func (mr *MockStoreMockRecorder) ListInvitationsForProject(ctx, project any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitationsForProject", reflect.TypeOf((*MockStore)(nil).ListInvitationsForProject), ctx, project)
 }
 
+// ListLatestEvalStatusesForEntityAndProfile mocks base method.
+func (m *MockStore) ListLatestEvalStatusesForEntityAndProfile(ctx context.Context, arg db.ListLatestEvalStatusesForEntityAndProfileParams) ([]db.ListLatestEvalStatusesForEntityAndProfileRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLatestEvalStatusesForEntityAndProfile", ctx, arg)
+	ret0, _ := ret[0].([]db.ListLatestEvalStatusesForEntityAndProfileRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLatestEvalStatusesForEntityAndProfile indicates an expected call of ListLatestEvalStatusesForEntityAndProfile.
+func (mr *MockStoreMockRecorder) ListLatestEvalStatusesForEntityAndProfile(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLatestEvalStatusesForEntityAndProfile", reflect.TypeOf((*MockStore)(nil).ListLatestEvalStatusesForEntityAndProfile), ctx, arg)
+}
+
 // ListOldestRuleEvaluationsByEntityID mocks base method.
 func (m *MockStore) ListOldestRuleEvaluationsByEntityID(ctx context.Context, entityIds []uuid.UUID) ([]db.ListOldestRuleEvaluationsByEntityIDRow, error) {
 	m.ctrl.T.Helper()
@@ -2018,6 +2392,21 @@ func (mr *MockStoreMockRecorder) ListProfilesByProjectIDAndLabel(ctx, arg any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProfilesByProjectIDAndLabel", reflect.TypeOf((*MockStore)(nil).ListProfilesByProjectIDAndLabel), ctx, arg)
 }
 
+// ListProfilesBySubscription mocks base method.
+func (m *MockStore) ListProfilesBySubscription(ctx context.Context, subscriptionID uuid.NullUUID) ([]db.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProfilesBySubscription", ctx, subscriptionID)
+	ret0, _ := ret[0].([]db.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProfilesBySubscription indicates an expected call of ListProfilesBySubscription.
+func (mr *MockStoreMockRecorder) ListProfilesBySubscription(ctx, subscriptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProfilesBySubscription", reflect.TypeOf((*MockStore)(nil).ListProfilesBySubscription), ctx, subscriptionID)
+}
+
 // ListProfilesInstantiatingRuleType mocks base method.
 func (m *MockStore) ListProfilesInstantiatingRuleType(ctx context.Context, ruleTypeID uuid.UUID) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -2033,6 +2422,21 @@ func (mr *MockStoreMockRecorder) ListProfilesInstantiatingRuleType(ctx, ruleType
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProfilesInstantiatingRuleType", reflect.TypeOf((*MockStore)(nil).ListProfilesInstantiatingRuleType), ctx, ruleTypeID)
 }
 
+// ListPropertyHistory mocks base method.
+func (m *MockStore) ListPropertyHistory(ctx context.Context, arg db.ListPropertyHistoryParams) ([]db.PropertyHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPropertyHistory", ctx, arg)
+	ret0, _ := ret[0].([]db.PropertyHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPropertyHistory indicates an expected call of ListPropertyHistory.
+func (mr *MockStoreMockRecorder) ListPropertyHistory(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPropertyHistory", reflect.TypeOf((*MockStore)(nil).ListPropertyHistory), ctx, arg)
+}
+
 // ListProvidersByProjectID mocks base method.
 func (m *MockStore) ListProvidersByProjectID(ctx context.Context, projects []uuid.UUID) ([]db.Provider, error) {
 	m.ctrl.T.Helper()
@@ -2078,6 +2482,21 @@ func (mr *MockStoreMockRecorder) ListRuleEvaluationsByProfileId(ctx, arg any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleEvaluationsByProfileId", reflect.TypeOf((*MockStore)(nil).ListRuleEvaluationsByProfileId), ctx, arg)
 }
 
+// ListRuleInstancesForRuleType mocks base method.
+func (m *MockStore) ListRuleInstancesForRuleType(ctx context.Context, ruleTypeID uuid.UUID) ([]db.ListRuleInstancesForRuleTypeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuleInstancesForRuleType", ctx, ruleTypeID)
+	ret0, _ := ret[0].([]db.ListRuleInstancesForRuleTypeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRuleInstancesForRuleType indicates an expected call of ListRuleInstancesForRuleType.
+func (mr *MockStoreMockRecorder) ListRuleInstancesForRuleType(ctx, ruleTypeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleInstancesForRuleType", reflect.TypeOf((*MockStore)(nil).ListRuleInstancesForRuleType), ctx, ruleTypeID)
+}
+
 // ListRuleTypesByProject mocks base method.
 func (m *MockStore) ListRuleTypesByProject(ctx context.Context, projectID uuid.UUID) ([]db.RuleType, error) {
 	m.ctrl.T.Helper()
@@ -2093,6 +2512,21 @@ func (mr *MockStoreMockRecorder) ListRuleTypesByProject(ctx, projectID any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleTypesByProject", reflect.TypeOf((*MockStore)(nil).ListRuleTypesByProject), ctx, projectID)
 }
 
+// ListRuleTypesBySubscription mocks base method.
+func (m *MockStore) ListRuleTypesBySubscription(ctx context.Context, subscriptionID uuid.NullUUID) ([]db.RuleType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuleTypesBySubscription", ctx, subscriptionID)
+	ret0, _ := ret[0].([]db.RuleType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRuleTypesBySubscription indicates an expected call of ListRuleTypesBySubscription.
+func (mr *MockStoreMockRecorder) ListRuleTypesBySubscription(ctx, subscriptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleTypesBySubscription", reflect.TypeOf((*MockStore)(nil).ListRuleTypesBySubscription), ctx, subscriptionID)
+}
+
 // ListRuleTypesReferencesByDataSource mocks base method.
 func (m *MockStore) ListRuleTypesReferencesByDataSource(ctx context.Context, dataSourcesID uuid.UUID) ([]db.RuleTypeDataSource, error) {
 	m.ctrl.T.Helper()
@@ -2108,6 +2542,21 @@ func (mr *MockStoreMockRecorder) ListRuleTypesReferencesByDataSource(ctx, dataSo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuleTypesReferencesByDataSource", reflect.TypeOf((*MockStore)(nil).ListRuleTypesReferencesByDataSource), ctx, dataSourcesID)
 }
 
+// ListSubscriptionsByProjects mocks base method.
+func (m *MockStore) ListSubscriptionsByProjects(ctx context.Context, projects []uuid.UUID) ([]db.ListSubscriptionsByProjectsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptionsByProjects", ctx, projects)
+	ret0, _ := ret[0].([]db.ListSubscriptionsByProjectsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptionsByProjects indicates an expected call of ListSubscriptionsByProjects.
+func (mr *MockStoreMockRecorder) ListSubscriptionsByProjects(ctx, projects any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptionsByProjects", reflect.TypeOf((*MockStore)(nil).ListSubscriptionsByProjects), ctx, projects)
+}
+
 // ListTokensToMigrate mocks base method.
 func (m *MockStore) ListTokensToMigrate(ctx context.Context, arg db.ListTokensToMigrateParams) ([]db.ProviderAccessToken, error) {
 	m.ctrl.T.Helper()
@@ -2123,6 +2572,21 @@ func (mr *MockStoreMockRecorder) ListTokensToMigrate(ctx, arg any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTokensToMigrate", reflect.TypeOf((*MockStore)(nil).ListTokensToMigrate), ctx, arg)
 }
 
+// ListTrustRoots mocks base method.
+func (m *MockStore) ListTrustRoots(ctx context.Context, projects []uuid.UUID) ([]db.TrustRoot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrustRoots", ctx, projects)
+	ret0, _ := ret[0].([]db.TrustRoot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrustRoots indicates an expected call of ListTrustRoots.
+func (mr *MockStoreMockRecorder) ListTrustRoots(ctx, projects any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrustRoots", reflect.TypeOf((*MockStore)(nil).ListTrustRoots), ctx, projects)
+}
+
 // ListUsers mocks base method.
 func (m *MockStore) ListUsers(ctx context.Context, arg db.ListUsersParams) ([]db.User, error) {
 	m.ctrl.T.Helper()
@@ -2138,6 +2602,21 @@ func (mr *MockStoreMockRecorder) ListUsers(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockStore)(nil).ListUsers), ctx, arg)
 }
 
+// ListWaivers mocks base method.
+func (m *MockStore) ListWaivers(ctx context.Context, arg db.ListWaiversParams) ([]db.ListWaiversRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWaivers", ctx, arg)
+	ret0, _ := ret[0].([]db.ListWaiversRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWaivers indicates an expected call of ListWaivers.
+func (mr *MockStoreMockRecorder) ListWaivers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWaivers", reflect.TypeOf((*MockStore)(nil).ListWaivers), ctx, arg)
+}
+
 // LockIfThresholdNotExceeded mocks base method.
 func (m *MockStore) LockIfThresholdNotExceeded(ctx context.Context, arg db.LockIfThresholdNotExceededParams) (db.EntityExecutionLock, error) {
 	m.ctrl.T.Helper()
@@ -2168,6 +2647,20 @@ func (mr *MockStoreMockRecorder) OrphanProject(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrphanProject", reflect.TypeOf((*MockStore)(nil).OrphanProject), ctx, arg)
 }
 
+// RecordWebhookDelivery mocks base method.
+func (m *MockStore) RecordWebhookDelivery(ctx context.Context, entityInstanceID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordWebhookDelivery", ctx, entityInstanceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordWebhookDelivery indicates an expected call of RecordWebhookDelivery.
+func (mr *MockStoreMockRecorder) RecordWebhookDelivery(ctx, entityInstanceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWebhookDelivery", reflect.TypeOf((*MockStore)(nil).RecordWebhookDelivery), ctx, entityInstanceID)
+}
+
 // ReleaseLock mocks base method.
 func (m *MockStore) ReleaseLock(ctx context.Context, arg db.ReleaseLockParams) error {
 	m.ctrl.T.Helper()
@@ -2210,6 +2703,20 @@ func (mr *MockStoreMockRecorder) SetSubscriptionBundleVersion(ctx, arg any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubscriptionBundleVersion", reflect.TypeOf((*MockStore)(nil).SetSubscriptionBundleVersion), ctx, arg)
 }
 
+// SetSubscriptionVersion mocks base method.
+func (m *MockStore) SetSubscriptionVersion(ctx context.Context, arg db.SetSubscriptionVersionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSubscriptionVersion", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSubscriptionVersion indicates an expected call of SetSubscriptionVersion.
+func (mr *MockStoreMockRecorder) SetSubscriptionVersion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSubscriptionVersion", reflect.TypeOf((*MockStore)(nil).SetSubscriptionVersion), ctx, arg)
+}
+
 // UpdateDataSource mocks base method.
 func (m *MockStore) UpdateDataSource(ctx context.Context, arg db.UpdateDataSourceParams) (db.DataSource, error) {
 	m.ctrl.T.Helper()
@@ -2327,6 +2834,20 @@ func (mr *MockStoreMockRecorder) UpdateProvider(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProvider", reflect.TypeOf((*MockStore)(nil).UpdateProvider), ctx, arg)
 }
 
+// UpdateRuleInstanceDefinition mocks base method.
+func (m *MockStore) UpdateRuleInstanceDefinition(ctx context.Context, arg db.UpdateRuleInstanceDefinitionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRuleInstanceDefinition", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRuleInstanceDefinition indicates an expected call of UpdateRuleInstanceDefinition.
+func (mr *MockStoreMockRecorder) UpdateRuleInstanceDefinition(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRuleInstanceDefinition", reflect.TypeOf((*MockStore)(nil).UpdateRuleInstanceDefinition), ctx, arg)
+}
+
 // UpdateRuleType mocks base method.
 func (m *MockStore) UpdateRuleType(ctx context.Context, arg db.UpdateRuleTypeParams) (db.RuleType, error) {
 	m.ctrl.T.Helper()
@@ -2357,6 +2878,36 @@ func (mr *MockStoreMockRecorder) UpdateSelector(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSelector", reflect.TypeOf((*MockStore)(nil).UpdateSelector), ctx, arg)
 }
 
+// UpdateTrustRoot mocks base method.
+func (m *MockStore) UpdateTrustRoot(ctx context.Context, arg db.UpdateTrustRootParams) (db.TrustRoot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTrustRoot", ctx, arg)
+	ret0, _ := ret[0].(db.TrustRoot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTrustRoot indicates an expected call of UpdateTrustRoot.
+func (mr *MockStoreMockRecorder) UpdateTrustRoot(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTrustRoot", reflect.TypeOf((*MockStore)(nil).UpdateTrustRoot), ctx, arg)
+}
+
+// UpdateWaiver mocks base method.
+func (m *MockStore) UpdateWaiver(ctx context.Context, arg db.UpdateWaiverParams) (db.Waiver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWaiver", ctx, arg)
+	ret0, _ := ret[0].(db.Waiver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWaiver indicates an expected call of UpdateWaiver.
+func (mr *MockStoreMockRecorder) UpdateWaiver(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWaiver", reflect.TypeOf((*MockStore)(nil).UpdateWaiver), ctx, arg)
+}
+
 // UpsertAccessToken mocks base method.
 func (m *MockStore) UpsertAccessToken(ctx context.Context, arg db.UpsertAccessTokenParams) (db.ProviderAccessToken, error) {
 	m.ctrl.T.Helper()
@@ -2460,6 +3011,21 @@ func (mr *MockStoreMockRecorder) UpsertPropertyValueV1(ctx, params any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertPropertyValueV1", reflect.TypeOf((*MockStore)(nil).UpsertPropertyValueV1), ctx, params)
 }
 
+// UpsertProviderHealth mocks base method.
+func (m *MockStore) UpsertProviderHealth(ctx context.Context, arg db.UpsertProviderHealthParams) (db.ProviderHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertProviderHealth", ctx, arg)
+	ret0, _ := ret[0].(db.ProviderHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertProviderHealth indicates an expected call of UpsertProviderHealth.
+func (mr *MockStoreMockRecorder) UpsertProviderHealth(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProviderHealth", reflect.TypeOf((*MockStore)(nil).UpsertProviderHealth), ctx, arg)
+}
+
 // UpsertRuleInstance mocks base method.
 func (m *MockStore) UpsertRuleInstance(ctx context.Context, arg db.UpsertRuleInstanceParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -2475,6 +3041,21 @@ func (mr *MockStoreMockRecorder) UpsertRuleInstance(ctx, arg any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRuleInstance", reflect.TypeOf((*MockStore)(nil).UpsertRuleInstance), ctx, arg)
 }
 
+// UpsertWebhookReconciliation mocks base method.
+func (m *MockStore) UpsertWebhookReconciliation(ctx context.Context, arg db.UpsertWebhookReconciliationParams) (db.WebhookDeliveryStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWebhookReconciliation", ctx, arg)
+	ret0, _ := ret[0].(db.WebhookDeliveryStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWebhookReconciliation indicates an expected call of UpsertWebhookReconciliation.
+func (mr *MockStoreMockRecorder) UpsertWebhookReconciliation(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWebhookReconciliation", reflect.TypeOf((*MockStore)(nil).UpsertWebhookReconciliation), ctx, arg)
+}
+
 // WithTransactionErr mocks base method.
 func (m *MockStore) WithTransactionErr(fn func(db.ExtendQuerier) error) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,49 @@
+-- CreateAutoRegistrationRule creates a new auto-registration rule in a given project.
+
+-- name: CreateAutoRegistrationRule :one
+INSERT INTO auto_registration_rules (project_id, provider_id, name, entity_type, selector)
+VALUES ($1, sqlc.narg(provider_id), $2, $3, $4) RETURNING *;
+
+-- name: DeleteAutoRegistrationRule :one
+DELETE FROM auto_registration_rules
+WHERE project_id = $1 AND lower(name) = lower(sqlc.arg(name))
+RETURNING *;
+
+-- name: GetAutoRegistrationRuleByName :one
+SELECT * FROM auto_registration_rules
+WHERE project_id = $1 AND lower(name) = lower(sqlc.arg(name));
+
+-- ListAutoRegistrationRules lists the auto-registration rules of a project.
+-- When a provider is given, only the rules which apply to it are returned.
+
+-- name: ListAutoRegistrationRules :many
+SELECT * FROM auto_registration_rules
+WHERE project_id = $1
+  AND (sqlc.narg(provider_id)::uuid IS NULL OR provider_id IS NULL OR provider_id = sqlc.narg(provider_id)::uuid)
+ORDER BY name;
+
+-- ListAutoRegistrationTargets lists the providers that auto-registration
+-- must be reconciled for, that is the providers with rules applying to them
+-- or with entities registered by a rule.
+
+-- name: ListAutoRegistrationTargets :many
+SELECT DISTINCT p.project_id, p.id AS provider_id FROM providers AS p
+JOIN auto_registration_rules AS r
+  ON r.project_id = p.project_id AND (r.provider_id IS NULL OR r.provider_id = p.id)
+UNION
+SELECT DISTINCT ei.project_id, ei.provider_id FROM auto_registered_entities AS are
+JOIN entity_instances AS ei ON ei.id = are.entity_instance_id;
+
+-- name: CreateAutoRegisteredEntity :one
+INSERT INTO auto_registered_entities (entity_instance_id, project_id, rule_name)
+VALUES ($1, $2, $3)
+ON CONFLICT (entity_instance_id) DO UPDATE SET rule_name = excluded.rule_name
+RETURNING *;
+
+-- ListAutoRegisteredEntities lists the entities of a provider which were
+-- registered by an auto-registration rule.
+
+-- name: ListAutoRegisteredEntities :many
+SELECT are.entity_instance_id, are.rule_name, ei.name, ei.entity_type FROM auto_registered_entities AS are
+JOIN entity_instances AS ei ON ei.id = are.entity_instance_id
+WHERE are.project_id = $1 AND ei.provider_id = $2;
@@ -73,6 +73,10 @@ WHERE name = $1 AND project_id = ANY(sqlc.arg(projects)::uuid[]);
 SELECT * FROM data_sources
 WHERE project_id = ANY(sqlc.arg(projects)::uuid[]);
 
+-- name: ListDataSourcesBySubscription :many
+SELECT * FROM data_sources
+WHERE subscription_id = $1;
+
 -- ListDataSourceFunctions retrieves all functions for a datasource.
 
 -- name: ListDataSourceFunctions :many
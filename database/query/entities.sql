@@ -61,6 +61,14 @@ WHERE
     AND entity_instances.provider_id = sqlc.arg(provider_id)
 LIMIT 1;
 
+-- ListEntitiesByProjectAndName retrieves the entities of a given type and name
+-- in a project, across all the providers of the project.
+-- name: ListEntitiesByProjectAndName :many
+SELECT * FROM entity_instances
+WHERE entity_instances.project_id = $1
+    AND entity_instances.entity_type = $2
+    AND entity_instances.name = sqlc.arg(name);
+
 -- GetEntitiesByType retrieves all entities of a given type for a project or hierarchy of projects.
 -- this is how one would get all repositories, artifacts, etc.
 
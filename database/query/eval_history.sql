@@ -8,6 +8,16 @@ JOIN evaluation_statuses AS eh ON les.evaluation_history_id = eh.id
 WHERE re.rule_id = $1 AND re.entity_instance_id = $2
 FOR UPDATE;
 
+-- ListLatestEvalStatusesForEntityAndProfile returns the status of the latest
+-- evaluation of each rule of a profile against an entity.
+
+-- name: ListLatestEvalStatusesForEntityAndProfile :many
+SELECT ri.name AS rule_name, es.status FROM latest_evaluation_statuses AS les
+JOIN evaluation_rule_entities AS ere ON ere.id = les.rule_entity_id
+JOIN evaluation_statuses AS es ON es.id = les.evaluation_history_id
+JOIN rule_instances AS ri ON ri.id = ere.rule_id
+WHERE les.profile_id = sqlc.arg(profile_id) AND ere.entity_instance_id = sqlc.arg(entity_id);
+
 -- name: InsertEvaluationRuleEntity :one
 INSERT INTO evaluation_rule_entities(
     rule_id,
@@ -95,7 +105,9 @@ SELECT s.id::uuid AS evaluation_id,
     re.details AS remediation_details,
     -- alert status and details
     ae.status AS alert_status,
-    ae.details AS alert_details
+    ae.details AS alert_details,
+    -- rule instance
+    ri.id AS rule_id
 FROM evaluation_statuses s
     JOIN evaluation_rule_entities ere ON ere.id = s.rule_entity_id
     JOIN rule_instances ri ON ere.rule_id = ri.id
@@ -194,3 +206,28 @@ SELECT s.evaluation_time,
 -- name: DeleteEvaluationHistoryByIDs :execrows
 DELETE FROM evaluation_statuses s
  WHERE s.id = ANY(sqlc.slice(evaluationIds)::uuid[]);
+
+-- name: CountEvaluationHistoryByDay :many
+-- CountEvaluationHistoryByDay counts the evaluations of entities in the
+-- given projects by day and outcome, within a time range.
+SELECT date_trunc('day', s.evaluation_time)::timestamp AS day,
+       count(*) AS total,
+       count(*) FILTER (WHERE s.status = 'success') AS success,
+       count(*) FILTER (WHERE s.status = 'failure') AS failure,
+       count(*) FILTER (WHERE s.status IN ('error', 'timeout')) AS error,
+       count(*) FILTER (WHERE s.status = 'skipped') AS skipped,
+       count(*) FILTER (WHERE s.status = 'pending') AS pending,
+       count(*) FILTER (WHERE s.status = 'waived') AS waived,
+       count(*) FILTER (WHERE ae.status = 'on') AS alerts_on,
+       count(*) FILTER (WHERE re.status = 'success') AS remediations_success,
+       count(*) FILTER (WHERE re.status IN ('failure', 'error')) AS remediations_failed
+  FROM evaluation_statuses s
+  JOIN evaluation_rule_entities ere ON ere.id = s.rule_entity_id
+  JOIN entity_instances ei ON ere.entity_instance_id = ei.id
+  LEFT JOIN remediation_events re ON re.evaluation_id = s.id
+  LEFT JOIN alert_events ae ON ae.evaluation_id = s.id
+ WHERE ei.project_id = ANY(sqlc.slice(projectIds)::uuid[])
+   AND s.evaluation_time >= sqlc.arg(fromts)::timestamp without time zone
+   AND s.evaluation_time < sqlc.arg(tots)::timestamp without time zone
+ GROUP BY 1
+ ORDER BY 1;
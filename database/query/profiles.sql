@@ -129,6 +129,9 @@ AND (
 DELETE FROM profiles
 WHERE id = $1 AND project_id = $2;
 
+-- name: ListProfilesBySubscription :many
+SELECT * FROM profiles WHERE subscription_id = $1;
+
 -- name: ListProfilesInstantiatingRuleType :many
 SELECT DISTINCT(p.name)
 FROM profiles AS p
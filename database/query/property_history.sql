@@ -0,0 +1,30 @@
+-- name: InsertPropertyHistory :exec
+INSERT INTO property_history (
+    entity_id,
+    key,
+    old_value,
+    new_value,
+    source
+) VALUES (
+    sqlc.arg(entity_id),
+    sqlc.arg(key),
+    sqlc.narg(old_value),
+    sqlc.narg(new_value),
+    sqlc.arg(source)
+);
+
+-- ListPropertyHistory lists the changes of the properties of an entity,
+-- newest first. The cursor is the ID of the last change of the previous
+-- page, zero starts from the newest change.
+
+-- name: ListPropertyHistory :many
+SELECT * FROM property_history
+WHERE entity_id = sqlc.arg(entity_id)
+  AND (sqlc.narg(key)::text IS NULL OR key = sqlc.narg(key))
+  AND (sqlc.arg(cursor)::bigint = 0 OR id < sqlc.arg(cursor))
+ORDER BY id DESC
+LIMIT sqlc.arg(size)::bigint;
+
+-- name: DeletePropertyHistoryBefore :execrows
+DELETE FROM property_history
+WHERE changed_at < sqlc.arg(before);
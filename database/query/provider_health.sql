@@ -0,0 +1,29 @@
+-- UpsertProviderHealth records the result of a health check of a provider.
+-- A NULL last_healthy_at keeps the time the provider was last found healthy.
+
+-- name: UpsertProviderHealth :one
+INSERT INTO provider_health (
+    provider_id,
+    status,
+    last_error,
+    last_healthy_at,
+    credential_expires_at,
+    expiry_notified_at
+) VALUES (
+    sqlc.arg(provider_id),
+    sqlc.arg(status),
+    sqlc.arg(last_error),
+    sqlc.narg(last_healthy_at),
+    sqlc.narg(credential_expires_at),
+    sqlc.narg(expiry_notified_at)
+) ON CONFLICT (provider_id) DO UPDATE SET
+    status = EXCLUDED.status,
+    last_error = EXCLUDED.last_error,
+    checked_at = NOW(),
+    last_healthy_at = COALESCE(EXCLUDED.last_healthy_at, provider_health.last_healthy_at),
+    credential_expires_at = EXCLUDED.credential_expires_at,
+    expiry_notified_at = EXCLUDED.expiry_notified_at
+RETURNING *;
+
+-- name: GetProviderHealth :one
+SELECT * FROM provider_health WHERE provider_id = $1;
@@ -33,6 +33,9 @@ RETURNING id;
 -- name: GetRuleInstancesForProfile :many
 SELECT * FROM rule_instances WHERE profile_id = $1;
 
+-- name: GetRuleInstanceByID :one
+SELECT * FROM rule_instances WHERE id = $1;
+
 -- name: GetRuleInstancesEntityInProjects :many
 SELECT * FROM rule_instances
 WHERE entity_type = $1
@@ -54,3 +57,21 @@ AND profile_id = $3;
 
 -- name: DeleteRuleInstanceOfProfileInProject :exec
 DELETE FROM rule_instances WHERE project_id = $1 AND profile_id = $2 AND rule_type_id = $3;
+
+-- name: ListRuleInstancesForRuleType :many
+SELECT ri.id, ri.profile_id, ri.name, ri.entity_type, ri.def, ri.params, ri.project_id,
+    p.name AS profile_name
+FROM rule_instances AS ri
+JOIN profiles AS p ON p.id = ri.profile_id
+WHERE ri.rule_type_id = $1
+ORDER BY p.name, ri.entity_type, ri.name
+FOR UPDATE OF ri;
+
+-- name: UpdateRuleInstanceDefinition :exec
+UPDATE rule_instances
+SET def = $2, params = $3, updated_at = NOW()
+WHERE id = $1;
+
+-- name: GetRuleInstanceByProfileAndName :one
+SELECT * FROM rule_instances
+WHERE profile_id = $1 AND entity_type = $2 AND lower(name) = lower(sqlc.arg(name));
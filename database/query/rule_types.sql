@@ -26,6 +26,9 @@ INSERT INTO rule_type (
 -- name: ListRuleTypesByProject :many
 SELECT * FROM rule_type WHERE project_id = $1;
 
+-- name: ListRuleTypesBySubscription :many
+SELECT * FROM rule_type WHERE subscription_id = $1;
+
 -- name: GetRuleTypeByID :one
 SELECT * FROM rule_type WHERE id = $1;
 
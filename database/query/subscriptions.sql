@@ -24,3 +24,16 @@ WHERE bu.namespace = $1 AND bu.name = $2 AND su.project_id = $3;
 
 -- name: SetSubscriptionBundleVersion :exec
 UPDATE subscriptions SET current_version = $2 WHERE project_id = $1;
+
+-- name: SetSubscriptionVersion :exec
+UPDATE subscriptions SET current_version = $2 WHERE id = $1;
+
+-- name: DeleteSubscription :exec
+DELETE FROM subscriptions WHERE id = $1;
+
+-- name: ListSubscriptionsByProjects :many
+SELECT su.*, bu.namespace, bu.name, pr.name AS project_name FROM subscriptions AS su
+JOIN bundles AS bu ON bu.id = su.bundle_id
+JOIN projects AS pr ON pr.id = su.project_id
+WHERE su.project_id = ANY(sqlc.arg(projects)::uuid[])
+ORDER BY pr.name, bu.namespace, bu.name;
@@ -0,0 +1,38 @@
+-- CreateTrustRoot creates a new trust root in a given project.
+
+-- name: CreateTrustRoot :one
+INSERT INTO trust_roots (project_id, name, definition)
+VALUES ($1, $2, sqlc.arg(definition)::jsonb) RETURNING *;
+
+-- UpdateTrustRoot updates the definition of a trust root in a given project.
+
+-- name: UpdateTrustRoot :one
+UPDATE trust_roots
+SET definition = sqlc.arg(definition)::jsonb, updated_at = NOW()
+WHERE id = $1 AND project_id = $2
+RETURNING *;
+
+-- name: DeleteTrustRoot :one
+DELETE FROM trust_roots
+WHERE id = $1 AND project_id = $2
+RETURNING *;
+
+-- GetTrustRootByName retrieves a trust root by its name and a project
+-- hierarchy.
+--
+-- Note that to get a trust root for a given project, one can simply
+-- pass one project id in the project_id array.
+
+-- name: GetTrustRootByName :one
+SELECT * FROM trust_roots
+WHERE name = $1 AND project_id = ANY(sqlc.arg(projects)::uuid[]);
+
+-- ListTrustRoots retrieves all trust roots for a project hierarchy.
+--
+-- Note that to get the trust roots of a given project, one can simply
+-- pass one project id in the project_id array.
+
+-- name: ListTrustRoots :many
+SELECT * FROM trust_roots
+WHERE project_id = ANY(sqlc.arg(projects)::uuid[])
+ORDER BY name;
@@ -0,0 +1,57 @@
+-- CreateWaiver creates a waiver for a rule instance and an entity. Creating
+-- a waiver for a pair which already has one replaces it.
+
+-- name: CreateWaiver :one
+INSERT INTO waivers (project_id, profile_id, rule_instance_id, entity_instance_id, justification, approver, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (rule_instance_id, entity_instance_id) DO UPDATE SET
+    justification = excluded.justification,
+    approver = excluded.approver,
+    expires_at = excluded.expires_at,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: UpdateWaiver :one
+UPDATE waivers SET
+    justification = $3,
+    approver = $4,
+    expires_at = $5,
+    updated_at = NOW()
+WHERE id = $1 AND project_id = $2
+RETURNING *;
+
+-- name: DeleteWaiver :one
+DELETE FROM waivers
+WHERE id = $1 AND project_id = $2
+RETURNING *;
+
+-- name: GetWaiverByID :one
+SELECT w.*, p.name AS profile_name, ri.name AS rule_name,
+       ei.name AS entity_name, ei.entity_type
+FROM waivers AS w
+JOIN profiles AS p ON p.id = w.profile_id
+JOIN rule_instances AS ri ON ri.id = w.rule_instance_id
+JOIN entity_instances AS ei ON ei.id = w.entity_instance_id
+WHERE w.id = $1 AND w.project_id = $2;
+
+-- ListWaivers lists the waivers of a project, optionally restricted to a
+-- profile. Expired waivers are only listed when requested.
+
+-- name: ListWaivers :many
+SELECT w.*, p.name AS profile_name, ri.name AS rule_name,
+       ei.name AS entity_name, ei.entity_type
+FROM waivers AS w
+JOIN profiles AS p ON p.id = w.profile_id
+JOIN rule_instances AS ri ON ri.id = w.rule_instance_id
+JOIN entity_instances AS ei ON ei.id = w.entity_instance_id
+WHERE w.project_id = $1
+  AND (sqlc.narg(profile_id)::uuid IS NULL OR w.profile_id = sqlc.narg(profile_id)::uuid)
+  AND (sqlc.arg(include_expired)::bool OR w.expires_at > NOW())
+ORDER BY p.name, ri.name, ei.name;
+
+-- GetActiveWaiver returns the waiver covering a rule instance and an entity,
+-- if it hasn't expired yet.
+
+-- name: GetActiveWaiver :one
+SELECT * FROM waivers
+WHERE rule_instance_id = $1 AND entity_instance_id = $2 AND expires_at > NOW();
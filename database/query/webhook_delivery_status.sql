@@ -0,0 +1,40 @@
+-- RecordWebhookDelivery records that a webhook delivery was received for
+-- an entity, which is no longer quiet.
+
+-- name: RecordWebhookDelivery :exec
+INSERT INTO webhook_delivery_status (
+    entity_instance_id,
+    last_delivery_at
+) VALUES (
+    sqlc.arg(entity_instance_id),
+    NOW()
+) ON CONFLICT (entity_instance_id) DO UPDATE SET
+    last_delivery_at = NOW(),
+    quiet_since = NULL;
+
+-- UpsertWebhookReconciliation records the result of the reconciliation of
+-- the webhook of an entity. An empty last_repair keeps the previous repair.
+-- quiet_since keeps the time the entity was first found quiet.
+
+-- name: UpsertWebhookReconciliation :one
+INSERT INTO webhook_delivery_status (
+    entity_instance_id,
+    last_reconciled_at,
+    last_repair,
+    last_repaired_at,
+    quiet_since
+) VALUES (
+    sqlc.arg(entity_instance_id),
+    NOW(),
+    sqlc.arg(last_repair),
+    CASE WHEN sqlc.arg(last_repair)::TEXT <> '' THEN NOW() END,
+    CASE WHEN sqlc.arg(quiet)::BOOLEAN THEN NOW() END
+) ON CONFLICT (entity_instance_id) DO UPDATE SET
+    last_reconciled_at = NOW(),
+    last_repair = CASE WHEN EXCLUDED.last_repair <> '' THEN EXCLUDED.last_repair ELSE webhook_delivery_status.last_repair END,
+    last_repaired_at = COALESCE(EXCLUDED.last_repaired_at, webhook_delivery_status.last_repaired_at),
+    quiet_since = CASE WHEN EXCLUDED.quiet_since IS NOT NULL THEN COALESCE(webhook_delivery_status.quiet_since, EXCLUDED.quiet_since) END
+RETURNING *;
+
+-- name: GetWebhookDeliveryStatus :one
+SELECT * FROM webhook_delivery_status WHERE entity_instance_id = $1;
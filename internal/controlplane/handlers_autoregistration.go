@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/logger"
+	"github.com/mindersec/minder/internal/providers"
+	"github.com/mindersec/minder/internal/reconcilers/messages"
+	"github.com/mindersec/minder/internal/util"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+// CreateAutoRegistrationRule creates an auto-registration rule
+func (s *Server) CreateAutoRegistrationRule(
+	ctx context.Context,
+	in *pb.CreateAutoRegistrationRuleRequest,
+) (*pb.CreateAutoRegistrationRuleResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	rule := in.GetRule()
+	if rule == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "missing auto-registration rule")
+	}
+
+	ret, err := s.autoRegistration.CreateRule(ctx, entityCtx.Project.ID, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateAutoRegistrationRuleResponse{Rule: ret}, nil
+}
+
+// ListAutoRegistrationRules lists the auto-registration rules of a project
+func (s *Server) ListAutoRegistrationRules(
+	ctx context.Context,
+	_ *pb.ListAutoRegistrationRulesRequest,
+) (*pb.ListAutoRegistrationRulesResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	ret, err := s.autoRegistration.ListRules(ctx, entityCtx.Project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListAutoRegistrationRulesResponse{Rules: ret}, nil
+}
+
+// DeleteAutoRegistrationRule deletes an auto-registration rule by name
+func (s *Server) DeleteAutoRegistrationRule(
+	ctx context.Context,
+	in *pb.DeleteAutoRegistrationRuleRequest,
+) (*pb.DeleteAutoRegistrationRuleResponse, error) {
+	name := in.GetName()
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing auto-registration rule name")
+	}
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	if err := s.autoRegistration.DeleteRule(ctx, entityCtx.Project.ID, name); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteAutoRegistrationRuleResponse{Name: name}, nil
+}
+
+// ReconcileAutoRegistration applies the auto-registration rules of a project
+// to the repositories of its providers
+func (s *Server) ReconcileAutoRegistration(
+	ctx context.Context,
+	in *pb.ReconcileAutoRegistrationRequest,
+) (*pb.ReconcileAutoRegistrationResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	// Telemetry logging
+	logger.BusinessRecord(ctx).Project = entityCtx.Project.ID
+
+	report, err := s.autoRegistration.Reconcile(ctx, entityCtx.Project.ID, entityCtx.Provider.Name, in.GetDryRun())
+	if err != nil {
+		pErr := providers.ErrProviderNotFoundBy{}
+		if errors.As(err, &pErr) {
+			return nil, util.UserVisibleError(codes.NotFound, "no suitable provider found, please enroll a provider")
+		}
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error reconciling auto-registration")
+		return nil, status.Error(codes.Internal, "error reconciling auto-registration")
+	}
+
+	return &pb.ReconcileAutoRegistrationResponse{Report: report}, nil
+}
+
+// publishAutoRegistration schedules the reconciliation of the auto-registration
+// rules of a project for a newly enrolled provider. This is best-effort, as
+// the rules can also be reconciled on demand.
+func (s *Server) publishAutoRegistration(ctx context.Context, projectID uuid.UUID, providerID uuid.UUID) {
+	msg, err := messages.NewProviderMessage(providerID, projectID)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error creating auto-registration message")
+		return
+	}
+	if err := s.evt.Publish(constants.TopicQueueReconcileAutoRegistration, msg); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error publishing auto-registration message")
+	}
+}
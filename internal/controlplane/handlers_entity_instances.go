@@ -185,6 +185,57 @@ func (s *Server) DeleteEntityById(
 	}, nil
 }
 
+// ListEntityPropertyHistory returns the recorded property changes of an entity instance
+func (s *Server) ListEntityPropertyHistory(
+	ctx context.Context,
+	in *pb.ListEntityPropertyHistoryRequest,
+) (*pb.ListEntityPropertyHistoryResponse, error) {
+	// Parse entity ID
+	entityID, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid entity ID")
+	}
+
+	projectID := GetProjectID(ctx)
+
+	// Get limit from request
+	limit := in.GetCursor().GetSize()
+	if limit == 0 {
+		limit = 20 // Default limit
+	}
+	const maxFetchLimit = 100
+	if limit > maxFetchLimit {
+		limit = maxFetchLimit
+	}
+
+	// Call service to get the property changes
+	changes, nextCursor, err := s.entityService.ListEntityPropertyHistory(
+		ctx,
+		entityID,
+		projectID,
+		in.GetKey(),
+		in.GetCursor().GetCursor(),
+		int64(limit),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Telemetry logging
+	logger.BusinessRecord(ctx).Project = projectID
+	logger.BusinessRecord(ctx).Entity = entityID
+
+	return &pb.ListEntityPropertyHistoryResponse{
+		Results: changes,
+		Page: &pb.CursorPage{
+			Next: &pb.Cursor{
+				Cursor: nextCursor,
+				Size:   limit,
+			},
+		},
+	}, nil
+}
+
 // RegisterEntity creates a new entity instance
 func (s *Server) RegisterEntity(
 	ctx context.Context,
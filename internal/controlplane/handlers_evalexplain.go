@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
+	evalerrors "github.com/mindersec/minder/internal/engine/errors"
+	"github.com/mindersec/minder/internal/engine/eval/rego"
+	"github.com/mindersec/minder/internal/engine/ingestcache"
+	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	"github.com/mindersec/minder/internal/engine/limits"
+	eoptions "github.com/mindersec/minder/internal/engine/options"
+	"github.com/mindersec/minder/internal/engine/rtengine"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+const explainErrMsg = "error explaining evaluation"
+
+// ExplainEvaluation evaluates again the rule and entity of a past evaluation,
+// capturing the print output, matched rules and input of its Rego policy.
+// It is a re-run against the current state of the entity, not a replay, and
+// the input is only redacted by key, which is why it requires the permission
+// to update profiles. No actions are taken and the result of the new
+// evaluation isn't stored.
+func (s *Server) ExplainEvaluation(
+	ctx context.Context,
+	in *minderv1.ExplainEvaluationRequest,
+) (*minderv1.ExplainEvaluationResponse, error) {
+	eval, err := s.getEvaluationHistory(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	pbEval, err := evaluationHistoryFromDB(eval)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg(explainErrMsg)
+		return nil, status.Error(codes.Internal, explainErrMsg)
+	}
+
+	evalStatus, explanation, err := s.explainRuleEvaluation(ctx, eval.RuleID, eval.EntityID)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg(explainErrMsg)
+		return nil, status.Errorf(codes.Internal, "%s: %s", explainErrMsg, err)
+	}
+
+	input, err := structpb.NewStruct(explanation.Input)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg(explainErrMsg)
+		return nil, status.Error(codes.Internal, explainErrMsg)
+	}
+
+	pbExplanation := &minderv1.EvaluationExplanation{
+		Evaluation: pbEval,
+		Status:     evalStatus,
+		Prints:     explanation.Prints,
+		Input:      input,
+	}
+	for _, rule := range explanation.Rules {
+		pbExplanation.Rules = append(pbExplanation.Rules, &minderv1.EvaluationExplanation_Rule{
+			Name:     rule.Name,
+			Location: rule.Location,
+			Matched:  rule.Matched,
+		})
+	}
+	if in.GetTrace() {
+		pbExplanation.Trace = explanation.Trace
+	}
+
+	return &minderv1.ExplainEvaluationResponse{Explanation: pbExplanation}, nil
+}
+
+// explainRuleEvaluation evaluates the rule instance against the entity with
+// the same rule type engine as the executor, returning the status of the
+// evaluation along with its explanation
+func (s *Server) explainRuleEvaluation(
+	ctx context.Context,
+	ruleID, entityID uuid.UUID,
+) (*minderv1.EvaluationHistoryStatus, *rego.Explanation, error) {
+	dbRule, err := s.store.GetRuleInstanceByID(ctx, ruleID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting rule instance: %w", err)
+	}
+	rule, err := models.RuleFromDB(dbRule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing rule instance: %w", err)
+	}
+
+	ewp, err := s.props.EntityWithPropertiesByID(ctx, entityID, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting entity: %w", err)
+	}
+	entity, err := s.props.EntityWithPropertiesAsProto(ctx, ewp, s.providerManager)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error converting entity to protobuf: %w", err)
+	}
+
+	provider, err := s.providerManager.InstantiateFromID(ctx, ewp.Entity.ProviderID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not instantiate provider: %w", err)
+	}
+
+	evalLimits := limits.FromConfig(&s.cfg.Executor.Limits)
+	ruleEngineCache, err := rtengine.NewRuleEngineCache(
+		ctx,
+		s.store,
+		dbRule.EntityType,
+		ewp.Entity.ProjectID,
+		provider,
+		s.featureFlags,
+		ingestcache.NewNoopCache(),
+		datasourceservice.NewDataSourceService(s.store),
+		eoptions.WithFlagsClient(s.featureFlags),
+		eoptions.WithLimits(evalLimits),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to fetch rule types for project: %w", err)
+	}
+	ruleEngine, err := ruleEngineCache.GetRuleEngine(ctx, rule.RuleTypeID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating rule type engine: %w", err)
+	}
+
+	ruleTypeLimits, err := limits.FromRuleType(ruleEngine.GetRuleType())
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing rule type limits: %w", err)
+	}
+	if timeout := evalLimits.Stricter(ruleTypeLimits).Timeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	explanation := &rego.Explanation{}
+	evalParams := &engif.EvalStatusParams{Rule: &rule}
+	_, evalErr := ruleEngine.Eval(
+		rego.WithExplanation(ctx, explanation), entity, rule.Def, rule.Params, evalParams)
+	if evalErr != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		evalErr = evalerrors.NewErrEvaluationTimeout("rule evaluation exceeded its timeout")
+	}
+	return &minderv1.EvaluationHistoryStatus{
+		Status:  string(evalerrors.ErrorAsEvalStatus(evalErr)),
+		Details: evalerrors.ErrorAsEvalDetails(evalErr),
+	}, explanation, nil
+}
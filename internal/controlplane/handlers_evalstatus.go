@@ -43,10 +43,26 @@ func (s *Server) GetEvaluationHistory(
 	ctx context.Context,
 	in *minderv1.GetEvaluationHistoryRequest,
 ) (*minderv1.GetEvaluationHistoryResponse, error) {
+	eval, err := s.getEvaluationHistory(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	pbEval, err := evaluationHistoryFromDB(eval)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg(evalErrMsg)
+		return nil, status.Error(codes.Internal, evalErrMsg)
+	}
+
+	return &minderv1.GetEvaluationHistoryResponse{Evaluation: pbEval}, nil
+}
+
+// getEvaluationHistory retrieves an evaluation of the project in the context
+func (s *Server) getEvaluationHistory(ctx context.Context, id string) (*db.GetEvaluationHistoryRow, error) {
 	projectID := GetProjectID(ctx)
-	evalID, err := uuid.Parse(in.GetId())
+	evalID, err := uuid.Parse(id)
 	if err != nil {
-		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid evaluation id: %s", in.GetId())
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid evaluation id: %s", id)
 	}
 
 	eval, err := s.store.GetEvaluationHistory(ctx, db.GetEvaluationHistoryParams{
@@ -60,16 +76,16 @@ func (s *Server) GetEvaluationHistory(
 		zerolog.Ctx(ctx).Error().Err(err).Msg(evalErrMsg)
 		return nil, status.Error(codes.Internal, evalErrMsg)
 	}
+	return &eval, nil
+}
 
-	// Convert response to protobuf
-
+func evaluationHistoryFromDB(eval *db.GetEvaluationHistoryRow) (*minderv1.EvaluationHistory, error) {
 	ruleSeverity, err := dbSeverityToSeverity(eval.RuleSeverity)
 	if err != nil {
-		zerolog.Ctx(ctx).Error().Err(err).Msg(evalErrMsg)
-		return nil, status.Error(codes.Internal, evalErrMsg)
+		return nil, err
 	}
 
-	pbEval := &minderv1.EvaluationHistory{
+	return &minderv1.EvaluationHistory{
 		Id:          eval.EvaluationID.String(),
 		EvaluatedAt: timestamppb.New(eval.EvaluatedAt),
 		Entity: &minderv1.EvaluationHistoryEntity{
@@ -89,9 +105,7 @@ func (s *Server) GetEvaluationHistory(
 		},
 		Alert:       getAlert(eval.AlertStatus, eval.AlertDetails.String),
 		Remediation: getRemediation(eval.RemediationStatus, eval.RemediationDetails.String),
-	}
-
-	return &minderv1.GetEvaluationHistoryResponse{Evaluation: pbEval}, nil
+	}, nil
 }
 
 // ListEvaluationHistory lists current and past evaluation results for
@@ -127,14 +141,7 @@ func (s *Server) ListEvaluationHistory(
 	}
 
 	// process filter
-	opts := []history.FilterOpt{}
-	opts = append(opts, FilterOptsFromStrings(in.GetEntityType(), history.WithEntityType)...)
-	opts = append(opts, FilterOptsFromStrings(in.GetEntityName(), history.WithEntityName)...)
-	opts = append(opts, FilterOptsFromStrings(in.GetProfileName(), history.WithProfileName)...)
-	opts = append(opts, FilterOptsFromStrings(in.GetLabelFilter(), history.WithLabel)...)
-	opts = append(opts, FilterOptsFromStrings(in.GetStatus(), history.WithStatus)...)
-	opts = append(opts, FilterOptsFromStrings(in.GetRemediation(), history.WithRemediation)...)
-	opts = append(opts, FilterOptsFromStrings(in.GetAlert(), history.WithAlert)...)
+	opts := evaluationFilterOpts(in)
 
 	if in.GetFrom() != nil {
 		opts = append(opts, history.WithFrom(in.GetFrom().AsTime()))
@@ -205,6 +212,31 @@ func (s *Server) ListEvaluationHistory(
 	return resp, nil
 }
 
+// evaluationFilterRequest is a request filtering evaluations
+type evaluationFilterRequest interface {
+	GetEntityType() []string
+	GetEntityName() []string
+	GetProfileName() []string
+	GetLabelFilter() []string
+	GetStatus() []string
+	GetRemediation() []string
+	GetAlert() []string
+}
+
+// evaluationFilterOpts returns the options of the evaluation history
+// filter requested by the request
+func evaluationFilterOpts(in evaluationFilterRequest) []history.FilterOpt {
+	opts := []history.FilterOpt{}
+	opts = append(opts, FilterOptsFromStrings(in.GetEntityType(), history.WithEntityType)...)
+	opts = append(opts, FilterOptsFromStrings(in.GetEntityName(), history.WithEntityName)...)
+	opts = append(opts, FilterOptsFromStrings(in.GetProfileName(), history.WithProfileName)...)
+	opts = append(opts, FilterOptsFromStrings(in.GetLabelFilter(), history.WithLabel)...)
+	opts = append(opts, FilterOptsFromStrings(in.GetStatus(), history.WithStatus)...)
+	opts = append(opts, FilterOptsFromStrings(in.GetRemediation(), history.WithRemediation)...)
+	opts = append(opts, FilterOptsFromStrings(in.GetAlert(), history.WithAlert)...)
+	return opts
+}
+
 func fromEvaluationHistoryRows(
 	rows []*history.OneEvalHistoryAndEntity,
 ) ([]*minderv1.EvaluationHistory, error) {
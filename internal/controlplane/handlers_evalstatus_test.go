@@ -4,15 +4,21 @@
 package controlplane
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	mockdb "github.com/mindersec/minder/database/mock"
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
 	entmodels "github.com/mindersec/minder/internal/entities/models"
 	"github.com/mindersec/minder/internal/history"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -378,3 +384,57 @@ func nullRemediationStatusTypesSuccess() db.NullRemediationStatusTypes {
 		Valid:                  true,
 	}
 }
+
+func TestExplainEvaluationErrors(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	evalID := uuid.New()
+
+	tests := []struct {
+		name              string
+		id                string
+		setupMocks        func(store *mockdb.MockStore)
+		expectedErrorCode codes.Code
+	}{
+		{
+			name:              "invalid evaluation id",
+			id:                "not-a-uuid",
+			expectedErrorCode: codes.InvalidArgument,
+		},
+		{
+			name: "evaluation not found",
+			id:   evalID.String(),
+			setupMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetEvaluationHistory(gomock.Any(), db.GetEvaluationHistoryParams{
+					EvaluationID: evalID,
+					ProjectID:    projectID,
+				}).Return(db.GetEvaluationHistoryRow{}, sql.ErrNoRows)
+			},
+			expectedErrorCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mockdb.NewMockStore(ctrl)
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockStore)
+			}
+			srv := newDefaultServer(t, mockStore, nil, nil, nil)
+
+			ctx := engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+				Project: engcontext.Project{ID: projectID},
+			})
+			_, err := srv.ExplainEvaluation(ctx, &minderv1.ExplainEvaluationRequest{Id: tt.id})
+			require.Error(t, err)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, tt.expectedErrorCode, st.Code())
+		})
+	}
+}
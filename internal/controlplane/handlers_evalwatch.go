@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/internal/history"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// watchPollInterval is how often the evaluation history is polled for
+	// new evaluations
+	watchPollInterval = 2 * time.Second
+	// watchLookback is how far back each poll looks for evaluations. The
+	// evaluation time is set when the evaluation's transaction starts, so
+	// evaluations can become visible after newer ones.
+	watchLookback = 10 * time.Second
+	// watchPageSize is the number of evaluations fetched at once by a poll
+	watchPageSize uint32 = 100
+	watchErrMsg   string = "error watching evaluations"
+)
+
+// WatchEvaluations streams the evaluations of the project as they are
+// recorded in the evaluation history. The history is shared by all the
+// servers, so the evaluations are polled from it rather than received from
+// the executor.
+func (s *Server) WatchEvaluations(
+	in *minderv1.WatchEvaluationsRequest,
+	stream grpc.ServerStreamingServer[minderv1.WatchEvaluationsResponse],
+) error {
+	ctx := stream.Context()
+
+	opts := evaluationFilterOpts(in)
+	// we always filter by project id
+	opts = append(opts, history.WithProjectID(GetProjectID(ctx)))
+	filter, err := history.NewListEvaluationFilter(opts...)
+	if err != nil {
+		return util.UserVisibleError(codes.InvalidArgument, "invalid filter: %s", err)
+	}
+
+	w := &evaluationWatcher{
+		since: time.Now().UTC(),
+		seen:  map[uuid.UUID]time.Time{},
+	}
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+	for {
+		evals, err := s.pollEvaluations(ctx, w, filter)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg(watchErrMsg)
+			return status.Error(codes.Internal, watchErrMsg)
+		}
+		for _, eval := range evals {
+			if err := stream.Send(&minderv1.WatchEvaluationsResponse{Evaluation: eval}); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// evaluationWatcher tracks the evaluations already streamed to a watcher
+type evaluationWatcher struct {
+	// since is the time the watch started, older evaluations are not streamed
+	since time.Time
+	// newest is the time of the newest evaluation streamed
+	newest time.Time
+	// seen are the evaluations streamed within the lookback window
+	seen map[uuid.UUID]time.Time
+}
+
+// cutoff returns the time after which the next poll looks for evaluations
+func (w *evaluationWatcher) cutoff() time.Time {
+	cutoff := w.newest.Add(-watchLookback)
+	if cutoff.Before(w.since) {
+		return w.since
+	}
+	return cutoff
+}
+
+// pollEvaluations returns the evaluations recorded since the last poll,
+// from the oldest to the newest
+func (s *Server) pollEvaluations(
+	ctx context.Context,
+	w *evaluationWatcher,
+	filter history.ListEvaluationFilter,
+) ([]*minderv1.EvaluationHistory, error) {
+	tx, err := s.store.BeginTransaction()
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer s.store.Rollback(tx)
+	qtx := s.store.GetQuerierWithTransaction(tx)
+
+	var rows []*history.OneEvalHistoryAndEntity
+	cursor := &history.ListEvaluationCursor{Time: w.cutoff(), Direction: history.Prev}
+	for {
+		result, err := s.history.ListEvaluationHistory(ctx, qtx, cursor, watchPageSize, filter)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving evaluations: %w", err)
+		}
+		// Pages of newer records are returned from the newest to the oldest
+		page := slices.Clone(result.Data)
+		slices.Reverse(page)
+		for _, row := range page {
+			if _, ok := w.seen[row.EvalHistoryRow.EvaluationID]; ok {
+				continue
+			}
+			rows = append(rows, row)
+		}
+		if len(page) < int(watchPageSize) {
+			break
+		}
+		cursor = &history.ListEvaluationCursor{
+			Time:      page[len(page)-1].EvalHistoryRow.EvaluatedAt,
+			Direction: history.Prev,
+		}
+	}
+
+	evals, err := fromEvaluationHistoryRows(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		evaluatedAt := row.EvalHistoryRow.EvaluatedAt
+		w.seen[row.EvalHistoryRow.EvaluationID] = evaluatedAt
+		if evaluatedAt.After(w.newest) {
+			w.newest = evaluatedAt
+		}
+	}
+	// Forget the evaluations which the next poll won't return again
+	cutoff := w.cutoff()
+	for id, evaluatedAt := range w.seen {
+		if !evaluatedAt.After(cutoff) {
+			delete(w.seen, id)
+		}
+	}
+
+	return evals, nil
+}
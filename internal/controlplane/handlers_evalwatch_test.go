@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	entmodels "github.com/mindersec/minder/internal/entities/models"
+	"github.com/mindersec/minder/internal/history"
+	mock_history "github.com/mindersec/minder/internal/history/mock"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestWatchEvaluations(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	now := time.Now().UTC()
+	older := historyRow(now.Add(time.Second), "older")
+	newer := historyRow(now.Add(2*time.Second), "newer")
+
+	ctrl := gomock.NewController(t)
+	mockStore := mockdb.NewMockStore(ctrl)
+	mockHistory := mock_history.NewMockEvaluationHistoryService(ctrl)
+	mockStore.EXPECT().BeginTransaction().Return(&sql.Tx{}, nil).AnyTimes()
+	mockStore.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(mockStore).AnyTimes()
+	mockStore.EXPECT().Rollback(gomock.Any()).Return(nil).AnyTimes()
+	// Newer records are returned from the newest to the oldest
+	mockHistory.EXPECT().
+		ListEvaluationHistory(gomock.Any(), gomock.Any(), gomock.Any(), watchPageSize, gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context, _ db.ExtendQuerier, cursor *history.ListEvaluationCursor,
+			_ uint32, filter history.ListEvaluationFilter,
+		) (*history.ListEvaluationHistoryResult, error) {
+			require.Equal(t, history.Prev, cursor.Direction)
+			require.Equal(t, projectID, filter.GetProjectID())
+			require.Equal(t, []string{"failure"}, filter.IncludedStatuses())
+			return &history.ListEvaluationHistoryResult{
+				Data: []*history.OneEvalHistoryAndEntity{newer, older},
+			}, nil
+		})
+
+	srv := &Server{store: mockStore, history: mockHistory}
+
+	ctx, cancel := context.WithCancel(engcontext.WithEntityContext(context.Background(), &engcontext.EntityContext{
+		Project: engcontext.Project{ID: projectID},
+	}))
+	defer cancel()
+	stream := &fakeWatchStream{ctx: ctx}
+	stream.onSend = func() {
+		if len(stream.sent) == 2 {
+			cancel()
+		}
+	}
+
+	err := srv.WatchEvaluations(&minderv1.WatchEvaluationsRequest{Status: []string{"failure"}}, stream)
+	require.NoError(t, err)
+	require.Len(t, stream.sent, 2)
+	require.Equal(t, "older", stream.sent[0].GetEvaluation().GetEntity().GetName())
+	require.Equal(t, "newer", stream.sent[1].GetEvaluation().GetEntity().GetName())
+}
+
+func TestPollEvaluationsSkipsSeenEvaluations(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	first := historyRow(now.Add(time.Second), "first")
+	second := historyRow(now.Add(2*time.Second), "second")
+
+	ctrl := gomock.NewController(t)
+	mockStore := mockdb.NewMockStore(ctrl)
+	mockHistory := mock_history.NewMockEvaluationHistoryService(ctrl)
+	mockStore.EXPECT().BeginTransaction().Return(&sql.Tx{}, nil).AnyTimes()
+	mockStore.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(mockStore).AnyTimes()
+	mockStore.EXPECT().Rollback(gomock.Any()).Return(nil).AnyTimes()
+
+	w := &evaluationWatcher{since: now, seen: map[uuid.UUID]time.Time{}}
+	gomock.InOrder(
+		mockHistory.EXPECT().
+			ListEvaluationHistory(gomock.Any(), gomock.Any(), gomock.Any(), watchPageSize, gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context, _ db.ExtendQuerier, cursor *history.ListEvaluationCursor,
+				_ uint32, _ history.ListEvaluationFilter,
+			) (*history.ListEvaluationHistoryResult, error) {
+				require.Equal(t, now, cursor.Time)
+				return &history.ListEvaluationHistoryResult{
+					Data: []*history.OneEvalHistoryAndEntity{first},
+				}, nil
+			}),
+		// The evaluation committed late shows up along with the one
+		// already streamed, as the poll looks back in time
+		mockHistory.EXPECT().
+			ListEvaluationHistory(gomock.Any(), gomock.Any(), gomock.Any(), watchPageSize, gomock.Any()).
+			Return(&history.ListEvaluationHistoryResult{
+				Data: []*history.OneEvalHistoryAndEntity{second, first},
+			}, nil),
+	)
+
+	srv := &Server{store: mockStore, history: mockHistory}
+
+	evals, err := srv.pollEvaluations(context.Background(), w, nil)
+	require.NoError(t, err)
+	require.Len(t, evals, 1)
+	require.Equal(t, "first", evals[0].GetEntity().GetName())
+
+	evals, err = srv.pollEvaluations(context.Background(), w, nil)
+	require.NoError(t, err)
+	require.Len(t, evals, 1)
+	require.Equal(t, "second", evals[0].GetEntity().GetName())
+}
+
+func historyRow(evaluatedAt time.Time, entityName string) *history.OneEvalHistoryAndEntity {
+	entityID := uuid.New()
+	return &history.OneEvalHistoryAndEntity{
+		EntityWithProperties: entmodels.NewEntityWithPropertiesFromInstance(
+			entmodels.EntityInstance{
+				ID:   entityID,
+				Type: minderv1.Entity_ENTITY_REPOSITORIES,
+				Name: entityName,
+			}, nil),
+		EvalHistoryRow: db.ListEvaluationHistoryRow{
+			EvaluationID:     uuid.New(),
+			EvaluatedAt:      evaluatedAt,
+			EntityType:       db.EntitiesRepository,
+			EntityID:         entityID,
+			RuleType:         "rule_type",
+			RuleName:         "rule_name",
+			RuleSeverity:     "unknown",
+			ProfileName:      "profile_name",
+			EvaluationStatus: db.EvalStatusTypesFailure,
+		},
+	}
+}
+
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	sent   []*minderv1.WatchEvaluationsResponse
+	onSend func()
+}
+
+func (s *fakeWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchStream) Send(resp *minderv1.WatchEvaluationsResponse) error {
+	s.sent = append(s.sent, resp)
+	s.onSend()
+	return nil
+}
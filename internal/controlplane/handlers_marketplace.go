@@ -0,0 +1,262 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/marketplaces"
+	"github.com/mindersec/minder/internal/marketplaces/subscriptions"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/mindpak"
+)
+
+// ListBundles lists the bundles available in the marketplace
+func (s *Server) ListBundles(
+	ctx context.Context,
+	_ *minderv1.ListBundlesRequest,
+) (*minderv1.ListBundlesResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	bundles, err := s.marketplace.ListBundles()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing bundles: %v", err)
+	}
+
+	resp := &minderv1.ListBundlesResponse{
+		Bundles: make([]*minderv1.BundleInfo, 0, len(bundles)),
+	}
+	for _, bundle := range bundles {
+		resp.Bundles = append(resp.Bundles, &minderv1.BundleInfo{
+			Namespace: bundle.ID.Namespace,
+			Name:      bundle.ID.Name,
+			Versions:  bundle.Versions,
+		})
+	}
+	return resp, nil
+}
+
+// ListBundleSubscriptions lists the bundle subscriptions of a project and
+// its child projects
+func (s *Server) ListBundleSubscriptions(
+	ctx context.Context,
+	_ *minderv1.ListBundleSubscriptionsRequest,
+) (*minderv1.ListBundleSubscriptionsResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	projects, err := s.store.GetChildrenProjects(ctx, entityCtx.Project.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting child projects: %v", err)
+	}
+	projectIDs := make([]uuid.UUID, 0, len(projects))
+	for _, project := range projects {
+		projectIDs = append(projectIDs, project.ID)
+	}
+
+	subs, err := s.store.ListSubscriptionsByProjects(ctx, projectIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing subscriptions: %v", err)
+	}
+
+	latest, err := s.latestBundleVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &minderv1.ListBundleSubscriptionsResponse{
+		Subscriptions: make([]*minderv1.BundleSubscription, 0, len(subs)),
+	}
+	for _, sub := range subs {
+		resp.Subscriptions = append(resp.Subscriptions, &minderv1.BundleSubscription{
+			ProjectId:     sub.ProjectID.String(),
+			ProjectName:   sub.ProjectName,
+			Namespace:     sub.Namespace,
+			Name:          sub.Name,
+			Version:       sub.CurrentVersion,
+			LatestVersion: latest[mindpak.ID(sub.Namespace, sub.Name)],
+		})
+	}
+	return resp, nil
+}
+
+// SubscribeBundle subscribes a project to a bundle, adding the rule types
+// and data sources of the bundle to the project
+func (s *Server) SubscribeBundle(
+	ctx context.Context,
+	in *minderv1.SubscribeBundleRequest,
+) (*minderv1.SubscribeBundleResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+	projectID := entityCtx.Project.ID
+	bundleID := mindpak.ID(in.GetNamespace(), in.GetName())
+
+	// the marketplace silently ignores subscriptions to unknown bundles when
+	// it is disabled, so check the bundle is available first
+	latest, err := s.latestBundleVersions()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := latest[bundleID]; !ok {
+		return nil, util.UserVisibleError(codes.NotFound, "bundle %s not found", bundleID)
+	}
+
+	tx, err := s.store.BeginTransaction()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error starting transaction: %v", err)
+	}
+	defer s.store.Rollback(tx)
+	qtx := s.store.GetQuerierWithTransaction(tx)
+
+	if err := s.marketplace.Subscribe(ctx, projectID, bundleID, qtx); err != nil {
+		return nil, marketplaceError(err)
+	}
+
+	subs, err := qtx.ListSubscriptionsByProjects(ctx, []uuid.UUID{projectID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing subscriptions: %v", err)
+	}
+
+	if err := s.store.Commit(tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "error committing transaction: %v", err)
+	}
+
+	resp := &minderv1.SubscribeBundleResponse{}
+	for _, sub := range subs {
+		if sub.Namespace == bundleID.Namespace && sub.Name == bundleID.Name {
+			resp.Subscription = &minderv1.BundleSubscription{
+				ProjectId:     projectID.String(),
+				ProjectName:   sub.ProjectName,
+				Namespace:     sub.Namespace,
+				Name:          sub.Name,
+				Version:       sub.CurrentVersion,
+				LatestVersion: latest[bundleID],
+			}
+		}
+	}
+	return resp, nil
+}
+
+// UnsubscribeBundle removes the subscription of a project to a bundle,
+// deleting the rule types, profiles and data sources of the bundle from the
+// project
+func (s *Server) UnsubscribeBundle(
+	ctx context.Context,
+	in *minderv1.UnsubscribeBundleRequest,
+) (*minderv1.UnsubscribeBundleResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	tx, err := s.store.BeginTransaction()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error starting transaction: %v", err)
+	}
+	defer s.store.Rollback(tx)
+	qtx := s.store.GetQuerierWithTransaction(tx)
+
+	bundleID := mindpak.ID(in.GetNamespace(), in.GetName())
+	if err := s.marketplace.Unsubscribe(ctx, entityCtx.Project.ID, bundleID, qtx); err != nil {
+		return nil, marketplaceError(err)
+	}
+
+	if err := s.store.Commit(tx); err != nil {
+		return nil, status.Errorf(codes.Internal, "error committing transaction: %v", err)
+	}
+
+	return &minderv1.UnsubscribeBundleResponse{}, nil
+}
+
+// UpgradeBundle upgrades the subscription of a project to a newer version of
+// a bundle, or previews the changes the upgrade would make
+func (s *Server) UpgradeBundle(
+	ctx context.Context,
+	in *minderv1.UpgradeBundleRequest,
+) (*minderv1.UpgradeBundleResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	tx, err := s.store.BeginTransaction()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error starting transaction: %v", err)
+	}
+	defer s.store.Rollback(tx)
+	qtx := s.store.GetQuerierWithTransaction(tx)
+
+	bundleID := mindpak.ID(in.GetNamespace(), in.GetName())
+	upgrade := s.marketplace.Upgrade
+	if in.GetDryRun() {
+		upgrade = s.marketplace.PreviewUpgrade
+	}
+	result, err := upgrade(ctx, entityCtx.Project.ID, bundleID, in.GetVersion(), qtx)
+	if err != nil {
+		return nil, marketplaceError(err)
+	}
+
+	if !in.GetDryRun() {
+		if err := s.store.Commit(tx); err != nil {
+			return nil, status.Errorf(codes.Internal, "error committing transaction: %v", err)
+		}
+	}
+
+	return &minderv1.UpgradeBundleResponse{
+		FromVersion: result.FromVersion,
+		ToVersion:   result.ToVersion,
+		Diff:        result.Diff,
+		Applied:     !in.GetDryRun(),
+	}, nil
+}
+
+// latestBundleVersions returns the latest version of each bundle available
+// in the marketplace
+func (s *Server) latestBundleVersions() (map[mindpak.BundleID]string, error) {
+	bundles, err := s.marketplace.ListBundles()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing bundles: %v", err)
+	}
+	latest := make(map[mindpak.BundleID]string, len(bundles))
+	for _, bundle := range bundles {
+		if len(bundle.Versions) > 0 {
+			latest[bundle.ID] = bundle.Versions[0]
+		}
+	}
+	return latest, nil
+}
+
+// marketplaceError converts the errors of the marketplace into user-visible
+// errors where possible
+func marketplaceError(err error) error {
+	var nice *util.NiceStatus
+	switch {
+	case errors.Is(err, marketplaces.ErrBundleNotFound),
+		errors.Is(err, marketplaces.ErrVersionNotFound),
+		errors.Is(err, subscriptions.ErrNotSubscribed):
+		return util.UserVisibleError(codes.NotFound, "%s", err)
+	case errors.Is(err, marketplaces.ErrDowngrade):
+		return util.UserVisibleError(codes.InvalidArgument, "%s", err)
+	case errors.Is(err, subscriptions.ErrInUse):
+		return util.UserVisibleError(codes.FailedPrecondition, "%s", err)
+	case errors.As(err, &nice):
+		return nice
+	default:
+		return status.Errorf(codes.Internal, "marketplace operation failed: %v", err)
+	}
+}
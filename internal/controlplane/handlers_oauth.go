@@ -74,6 +74,10 @@ func (s *Server) GetAuthorizationURL(ctx context.Context,
 		!flags.Bool(ctx, s.featureFlags, flags.GitLabProvider) {
 		return nil, util.UserVisibleError(codes.Unimplemented, "GitLab provider is not enabled")
 	}
+	if providerClass == string(db.ProviderClassAzureDevops) &&
+		!flags.Bool(ctx, s.featureFlags, flags.AzureDevOpsProvider) {
+		return nil, util.UserVisibleError(codes.Unimplemented, "Azure DevOps provider is not enabled")
+	}
 
 	// Telemetry logging
 	logger.BusinessRecord(ctx).Provider = providerName
@@ -221,6 +225,9 @@ func (s *Server) alllowedRedirectURL(redirectUrl *url.URL) bool {
 	if providerCfg.GitLab != nil && strings.HasPrefix(providerCfg.GitLab.RedirectURI, hostUrlString) {
 		return true
 	}
+	if providerCfg.AzureDevOps != nil && strings.HasPrefix(providerCfg.AzureDevOps.RedirectURI, hostUrlString) {
+		return true
+	}
 
 	if slices.ContainsFunc(s.cfg.HTTPServer.CORS.AllowOrigins, func(u string) bool {
 		return u == hostUrlString || u+"/" == hostUrlString
@@ -350,6 +357,9 @@ func (s *Server) processOAuthCallback(ctx context.Context, w http.ResponseWriter
 	}
 
 	logger.BusinessRecord(ctx).ProviderID = p.ID
+	if err == nil {
+		s.publishAutoRegistration(ctx, stateData.ProjectID, p.ID)
+	}
 
 	if stateData.RedirectUrl.Valid || stateData.EncryptedRedirect.Valid {
 		redirectURL, err := s.decryptRedirect(&stateData)
@@ -431,7 +441,7 @@ func (s *Server) processAppCallback(ctx context.Context, w http.ResponseWriter,
 		logger.BusinessRecord(ctx).Project = stateData.ProjectID
 
 		var confErr providers.ErrProviderInvalidConfig
-		_, err = s.ghProviders.CreateGitHubAppProvider(ctx, *token, stateData, installationID, state)
+		dbProv, err := s.ghProviders.CreateGitHubAppProvider(ctx, *token, stateData, installationID, state)
 		if err != nil {
 			if errors.As(err, &confErr) {
 				return newHttpError(http.StatusBadRequest, "Invalid provider config").SetContents(
@@ -443,6 +453,7 @@ func (s *Server) processAppCallback(ctx context.Context, w http.ResponseWriter,
 			}
 			return fmt.Errorf("error creating GitHub App provider: %w", err)
 		}
+		s.publishAutoRegistration(ctx, stateData.ProjectID, dbProv.ID)
 
 		if stateData.RedirectUrl.Valid || stateData.EncryptedRedirect.Valid {
 			redirectURL, err := s.decryptRedirect(&stateData)
@@ -47,6 +47,7 @@ import (
 	mockprovsvc "github.com/mindersec/minder/internal/providers/github/service/mock"
 	"github.com/mindersec/minder/internal/providers/manager"
 	mockmanager "github.com/mindersec/minder/internal/providers/manager/mock"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	"github.com/mindersec/minder/internal/providers/session"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	serverconfig "github.com/mindersec/minder/pkg/config/server"
@@ -141,6 +142,7 @@ func Test_NewOAuthConfig(t *testing.T) {
 			githubProviderManager := ghmanager.NewGitHubProviderClassManager(
 				nil,
 				nil,
+				&ratebudget.NoopTracker{},
 				&serverconfig.ProviderConfig{
 					GitHub: &serverconfig.GitHubConfig{
 						OAuthClientConfig: serverconfig.OAuthClientConfig{
@@ -665,6 +667,7 @@ func TestProviderCallback(t *testing.T) {
 			githubProviderManager := ghmanager.NewGitHubProviderClassManager(
 				nil,
 				nil,
+				&ratebudget.NoopTracker{},
 				&serverconfig.ProviderConfig{
 					GitHub: &serverconfig.GitHubConfig{
 						OAuthClientConfig: serverconfig.OAuthClientConfig{
@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/mindersec/minder/internal/crypto"
 	"github.com/mindersec/minder/internal/db"
@@ -290,6 +291,15 @@ func protobufProviderFromDB(
 		zerolog.Ctx(ctx).Error().Err(err).Str("provider", p.Name).Msg("error getting credential")
 	}
 
+	var health *minderv1.ProviderHealth
+	dbHealth, err := store.GetProviderHealth(ctx, p.ID)
+	if err == nil {
+		health = protobufProviderHealthFromDB(dbHealth)
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		// This is non-fatal, the provider was likely not checked yet
+		zerolog.Ctx(ctx).Error().Err(err).Str("provider", p.Name).Msg("error getting provider health")
+	}
+
 	return &minderv1.Provider{
 		Id:               p.ID.String(),
 		Name:             p.Name,
@@ -300,9 +310,35 @@ func protobufProviderFromDB(
 		Config:           cfg,
 		CredentialsState: state,
 		Class:            string(p.Class),
+		Health:           health,
 	}, nil
 }
 
+func protobufProviderHealthFromDB(h db.ProviderHealth) *minderv1.ProviderHealth {
+	status := minderv1.ProviderHealthStatus_PROVIDER_HEALTH_STATUS_UNSPECIFIED
+	switch h.Status {
+	case db.ProviderHealthStatusHealthy:
+		status = minderv1.ProviderHealthStatus_PROVIDER_HEALTH_STATUS_HEALTHY
+	case db.ProviderHealthStatusDegraded:
+		status = minderv1.ProviderHealthStatus_PROVIDER_HEALTH_STATUS_DEGRADED
+	case db.ProviderHealthStatusUnhealthy:
+		status = minderv1.ProviderHealthStatus_PROVIDER_HEALTH_STATUS_UNHEALTHY
+	}
+
+	health := &minderv1.ProviderHealth{
+		Status:    status.ToString(),
+		LastError: h.LastError,
+		CheckedAt: timestamppb.New(h.CheckedAt),
+	}
+	if h.LastHealthyAt.Valid {
+		health.LastHealthyAt = timestamppb.New(h.LastHealthyAt.Time)
+	}
+	if h.CredentialExpiresAt.Valid {
+		health.CredentialExpiresAt = timestamppb.New(h.CredentialExpiresAt.Time)
+	}
+	return health
+}
+
 func protobufProviderImplementsFromDB(ctx context.Context, p db.Provider) []minderv1.ProviderType {
 	impls := make([]minderv1.ProviderType, 0, len(p.Implements))
 	for _, i := range p.Implements {
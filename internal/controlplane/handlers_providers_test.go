@@ -41,6 +41,7 @@ import (
 	mockgh "github.com/mindersec/minder/internal/providers/github/mock"
 	mockprovsvc "github.com/mindersec/minder/internal/providers/github/service/mock"
 	"github.com/mindersec/minder/internal/providers/manager"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	"github.com/mindersec/minder/internal/providers/ratecache"
 	minder "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	serverconfig "github.com/mindersec/minder/pkg/config/server"
@@ -78,6 +79,7 @@ func testServer(t *testing.T, ctrl *gomock.Controller) *mockServer {
 	githubProviderManager := ghmanager.NewGitHubProviderClassManager(
 		clientCache,
 		nil,
+		&ratebudget.NoopTracker{},
 		&serverconfig.ProviderConfig{},
 		&serverconfig.WebhookConfig{},
 		nil,
@@ -265,6 +267,8 @@ func TestCreateProvider(t *testing.T) {
 				Return(db.ProviderAccessToken{}, sql.ErrNoRows)
 			fakeServer.mockStore.EXPECT().GetInstallationIDByProviderID(gomock.Any(), gomock.Any()).
 				Return(db.ProviderGithubAppInstallation{}, sql.ErrNoRows)
+			fakeServer.mockStore.EXPECT().GetProviderHealth(gomock.Any(), gomock.Any()).
+				Return(db.ProviderHealth{}, sql.ErrNoRows)
 
 			resp, err := fakeServer.server.CreateProvider(ctx, &minder.CreateProviderRequest{
 				Context: &minder.Context{
@@ -576,6 +580,7 @@ func TestDeleteProvider(t *testing.T) {
 	githubProviderManager := ghmanager.NewGitHubProviderClassManager(
 		clientCache,
 		nil,
+		&ratebudget.NoopTracker{},
 		&serverconfig.ProviderConfig{},
 		&serverconfig.WebhookConfig{},
 		nil,
@@ -701,6 +706,7 @@ func TestDeleteProviderByID(t *testing.T) {
 	githubProviderManager := ghmanager.NewGitHubProviderClassManager(
 		clientCache,
 		nil,
+		&ratebudget.NoopTracker{},
 		&serverconfig.ProviderConfig{},
 		&serverconfig.WebhookConfig{},
 		nil,
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/logger"
+	"github.com/mindersec/minder/internal/reports"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// GenerateComplianceReport builds a compliance report of the latest
+// evaluation results of a project and its sub-projects
+func (s *Server) GenerateComplianceReport(
+	ctx context.Context,
+	in *minderv1.GenerateComplianceReportRequest,
+) (*minderv1.GenerateComplianceReportResponse, error) {
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	// Telemetry logging
+	logger.BusinessRecord(ctx).Project = entityCtx.Project.ID
+
+	to := time.Now()
+	if in.GetTo() != nil {
+		to = in.GetTo().AsTime()
+	}
+	from := to.Add(-reports.DefaultTrendRange)
+	if in.GetFrom() != nil {
+		from = in.GetFrom().AsTime()
+	}
+	if !from.Before(to) {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "the start of the time range must be before its end")
+	}
+
+	report, err := reports.GenerateComplianceReport(ctx, s.store, entityCtx.Project.ID, from, to)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error generating compliance report")
+		return nil, status.Error(codes.Internal, "error generating compliance report")
+	}
+
+	rendered, contentType, err := reports.RenderComplianceReport(ctx, report, in.GetFormat())
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error rendering compliance report")
+		return nil, status.Error(codes.Internal, "error rendering compliance report")
+	}
+
+	return &minderv1.GenerateComplianceReportResponse{
+		Report:      report,
+		Rendered:    rendered,
+		ContentType: contentType,
+	}, nil
+}
@@ -239,9 +239,16 @@ func (s *Server) UpdateRuleType(
 		return nil, err
 	}
 
-	updatedRuleType, err := db.WithTransaction(s.store, func(qtx db.ExtendQuerier) (*minderv1.RuleType, error) {
-		return s.ruleTypes.UpdateRuleType(ctx, projectID, uuid.Nil, urt.GetRuleType(), qtx)
-	})
+	// The update runs in a transaction which is only committed if this is
+	// not a dry run, so that a dry run lists the rules it would migrate.
+	tx, err := s.store.BeginTransaction()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error starting transaction: %v", err)
+	}
+	defer s.store.Rollback(tx)
+	qtx := s.store.GetQuerierWithTransaction(tx)
+
+	updatedRuleType, migrations, err := s.ruleTypes.UpdateRuleType(ctx, projectID, uuid.Nil, urt.GetRuleType(), qtx)
 	if err != nil {
 		if errors.Is(err, ruletypes.ErrRuleTypeInvalid) {
 			return nil, util.UserVisibleError(codes.InvalidArgument, "invalid rule type definition: %s", err)
@@ -251,8 +258,15 @@ func (s *Server) UpdateRuleType(
 		return nil, status.Errorf(codes.Unknown, "failed to update rule type: %s", err)
 	}
 
+	if !urt.GetDryRun() {
+		if err := s.store.Commit(tx); err != nil {
+			return nil, status.Errorf(codes.Internal, "error committing transaction: %v", err)
+		}
+	}
+
 	return &minderv1.UpdateRuleTypeResponse{
-		RuleType: updatedRuleType,
+		RuleType:   updatedRuleType,
+		Migrations: migrations,
 	}, nil
 }
 
@@ -185,6 +185,20 @@ func TestUpdateRuleType(t *testing.T) {
 				RuleType: &minderv1.RuleType{},
 			},
 		},
+		{
+			name: "dry run is not committed",
+			mockStoreFunc: df.NewMockStore(
+				df.WithRollbackTransaction(),
+				WithSuccessfulGetProjectByID(projectID),
+			),
+			ruleTypeServiceFunc: sf.NewRuleTypeServiceMock(
+				sf.WithSuccessfulUpdateRuleType,
+			),
+			request: &minderv1.UpdateRuleTypeRequest{
+				RuleType: &minderv1.RuleType{},
+				DryRun:   true,
+			},
+		},
 		{
 			name: "guidance sanitize error",
 			mockStoreFunc: df.NewMockStore(
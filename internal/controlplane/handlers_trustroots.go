@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// CreateTrustRoot creates a trust root
+func (s *Server) CreateTrustRoot(ctx context.Context,
+	in *minderv1.CreateTrustRootRequest) (*minderv1.CreateTrustRootResponse, error) {
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	// Get the trust root from the request
+	trReq := in.GetTrustRoot()
+	if trReq == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "missing trust root")
+	}
+
+	// Process the request
+	ret, err := s.trustRoots.Create(ctx, entityCtx.Project.ID, trReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response
+	return &minderv1.CreateTrustRootResponse{TrustRoot: ret}, nil
+}
+
+// GetTrustRootByName retrieves a trust root by name
+func (s *Server) GetTrustRootByName(ctx context.Context,
+	in *minderv1.GetTrustRootByNameRequest) (*minderv1.GetTrustRootByNameResponse, error) {
+
+	// Get the trust root name from the request
+	trName := in.GetName()
+	if trName == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing trust root name")
+	}
+
+	// Get the project ID from the request context
+	entityCtx := engcontext.EntityFromContext(ctx)
+
+	// Ensure the project is valid and exist in the db
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	// Get the trust root by name
+	tr, err := s.trustRoots.GetByName(ctx, trName, entityCtx.Project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response
+	return &minderv1.GetTrustRootByNameResponse{TrustRoot: tr}, nil
+}
+
+// ListTrustRoots lists all trust roots
+func (s *Server) ListTrustRoots(ctx context.Context,
+	_ *minderv1.ListTrustRootsRequest) (*minderv1.ListTrustRootsResponse, error) {
+
+	// Get the project ID from the request context
+	entityCtx := engcontext.EntityFromContext(ctx)
+
+	// Ensure the project is valid and exist in the db
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	// Get all trust roots
+	ret, err := s.trustRoots.List(ctx, entityCtx.Project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response
+	return &minderv1.ListTrustRootsResponse{TrustRoots: ret}, nil
+}
+
+// UpdateTrustRoot updates a trust root
+func (s *Server) UpdateTrustRoot(ctx context.Context,
+	in *minderv1.UpdateTrustRootRequest) (*minderv1.UpdateTrustRootResponse, error) {
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	// Get the trust root from the request
+	trReq := in.GetTrustRoot()
+	if trReq == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "missing trust root")
+	}
+
+	// Process the request
+	ret, err := s.trustRoots.Update(ctx, entityCtx.Project.ID, trReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response
+	return &minderv1.UpdateTrustRootResponse{TrustRoot: ret}, nil
+}
+
+// DeleteTrustRootByName deletes a trust root by name
+func (s *Server) DeleteTrustRootByName(ctx context.Context,
+	in *minderv1.DeleteTrustRootByNameRequest) (*minderv1.DeleteTrustRootByNameResponse, error) {
+
+	// Get the trust root name from the request
+	trName := in.GetName()
+	if trName == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing trust root name")
+	}
+
+	// Get the project ID from the request context
+	entityCtx := engcontext.EntityFromContext(ctx)
+
+	// Ensure the project is valid and exist in the db
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	// Delete the trust root
+	if err := s.trustRoots.Delete(ctx, trName, entityCtx.Project.ID); err != nil {
+		return nil, err
+	}
+
+	// Return the response
+	return &minderv1.DeleteTrustRootByNameResponse{Name: trName}, nil
+}
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/mindersec/minder/internal/auth"
+	"github.com/mindersec/minder/internal/engine/engcontext"
+	"github.com/mindersec/minder/internal/logger"
+	"github.com/mindersec/minder/internal/util"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// CreateWaiver creates a waiver for a rule of a profile and an entity
+func (s *Server) CreateWaiver(ctx context.Context,
+	in *minderv1.CreateWaiverRequest) (*minderv1.CreateWaiverResponse, error) {
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	// Telemetry logging
+	logger.BusinessRecord(ctx).Project = entityCtx.Project.ID
+
+	waiver := in.GetWaiver()
+	if waiver == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "missing waiver")
+	}
+
+	// The user creating the waiver is the one approving it
+	ret, err := s.waivers.Create(ctx, entityCtx.Project.ID, auth.IdentityFromContext(ctx).Human(), waiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.CreateWaiverResponse{Waiver: ret}, nil
+}
+
+// UpdateWaiver updates the justification and the expiry of a waiver
+func (s *Server) UpdateWaiver(ctx context.Context,
+	in *minderv1.UpdateWaiverRequest) (*minderv1.UpdateWaiverResponse, error) {
+
+	id, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid waiver id")
+	}
+	if in.GetExpiresAt() == nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "waiver expiry must be set")
+	}
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	// Telemetry logging
+	logger.BusinessRecord(ctx).Project = entityCtx.Project.ID
+
+	ret, err := s.waivers.Update(ctx, entityCtx.Project.ID, id,
+		auth.IdentityFromContext(ctx).Human(), in.GetJustification(), in.GetExpiresAt().AsTime())
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.UpdateWaiverResponse{Waiver: ret}, nil
+}
+
+// GetWaiverById retrieves a waiver by ID
+func (s *Server) GetWaiverById(ctx context.Context,
+	in *minderv1.GetWaiverByIdRequest) (*minderv1.GetWaiverByIdResponse, error) {
+
+	id, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid waiver id")
+	}
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	ret, err := s.waivers.GetByID(ctx, entityCtx.Project.ID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.GetWaiverByIdResponse{Waiver: ret}, nil
+}
+
+// ListWaivers lists the waivers of a project
+func (s *Server) ListWaivers(ctx context.Context,
+	in *minderv1.ListWaiversRequest) (*minderv1.ListWaiversResponse, error) {
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	ret, err := s.waivers.List(ctx, entityCtx.Project.ID, in.GetProfile(), in.GetIncludeExpired())
+	if err != nil {
+		return nil, err
+	}
+
+	return &minderv1.ListWaiversResponse{Waivers: ret}, nil
+}
+
+// DeleteWaiver deletes a waiver by ID, which enforces the rule again
+func (s *Server) DeleteWaiver(ctx context.Context,
+	in *minderv1.DeleteWaiverRequest) (*minderv1.DeleteWaiverResponse, error) {
+
+	id, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid waiver id")
+	}
+
+	entityCtx := engcontext.EntityFromContext(ctx)
+	if err := entityCtx.ValidateProject(ctx, s.store); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error in entity context: %v", err)
+	}
+
+	// Telemetry logging
+	logger.BusinessRecord(ctx).Project = entityCtx.Project.ID
+
+	if err := s.waivers.Delete(ctx, entityCtx.Project.ID, id); err != nil {
+		return nil, err
+	}
+
+	return &minderv1.DeleteWaiverResponse{Id: id.String()}, nil
+}
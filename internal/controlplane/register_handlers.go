@@ -84,6 +84,16 @@ func RegisterGatewayHTTPHandlers(ctx context.Context, gwmux *runtime.ServeMux, g
 	if err := pb.RegisterEntityInstanceServiceHandlerFromEndpoint(ctx, gwmux, grpcAddress, opts); err != nil {
 		log.Fatal().Err(err).Msg("failed to register gateway")
 	}
+
+	// Register the TrustRoot service
+	if err := pb.RegisterTrustRootServiceHandlerFromEndpoint(ctx, gwmux, grpcAddress, opts); err != nil {
+		log.Fatal().Err(err).Msg("failed to register gateway")
+	}
+
+	// Register the Marketplace service
+	if err := pb.RegisterMarketplaceServiceHandlerFromEndpoint(ctx, gwmux, grpcAddress, opts); err != nil {
+		log.Fatal().Err(err).Msg("failed to register gateway")
+	}
 }
 
 // RegisterGRPCServices registers the GRPC services
@@ -129,4 +139,10 @@ func RegisterGRPCServices(s *Server) {
 
 	// Register the EntityInstance service
 	pb.RegisterEntityInstanceServiceServer(s.grpcServer, s)
+
+	// Register the TrustRoot service
+	pb.RegisterTrustRootServiceServer(s.grpcServer, s)
+
+	// Register the Marketplace service
+	pb.RegisterMarketplaceServiceServer(s.grpcServer, s)
 }
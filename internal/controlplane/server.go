@@ -52,6 +52,7 @@ import (
 	"github.com/mindersec/minder/internal/history"
 	"github.com/mindersec/minder/internal/invites"
 	"github.com/mindersec/minder/internal/logger"
+	"github.com/mindersec/minder/internal/marketplaces"
 	"github.com/mindersec/minder/internal/projects"
 	"github.com/mindersec/minder/internal/providers"
 	ghprov "github.com/mindersec/minder/internal/providers/github"
@@ -60,8 +61,11 @@ import (
 	"github.com/mindersec/minder/internal/providers/manager"
 	"github.com/mindersec/minder/internal/providers/session"
 	reposvc "github.com/mindersec/minder/internal/repositories"
+	"github.com/mindersec/minder/internal/repositories/autoregistration"
 	"github.com/mindersec/minder/internal/roles"
+	"github.com/mindersec/minder/internal/trustroots"
 	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/waivers"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	serverconfig "github.com/mindersec/minder/pkg/config/server"
 	"github.com/mindersec/minder/pkg/eventer/interfaces"
@@ -99,7 +103,11 @@ type Server struct {
 	invites             invites.InviteService
 	ruleTypes           ruletypes.RuleTypeService
 	dataSourcesService  datasourcessvc.DataSourcesService
+	trustRoots          trustroots.TrustRootService
+	waivers             waivers.WaiverService
+	marketplace         marketplaces.Marketplace
 	repos               reposvc.RepositoryService
+	autoRegistration    autoregistration.AutoRegistrationService
 	entityService       entitySvc.EntityService
 	entityCreator       entitySvc.EntityCreator
 	roles               roles.RoleService
@@ -129,6 +137,8 @@ type Server struct {
 	pb.UnimplementedInviteServiceServer
 	pb.UnimplementedDataSourceServiceServer
 	pb.UnimplementedEntityInstanceServiceServer
+	pb.UnimplementedTrustRootServiceServer
+	pb.UnimplementedMarketplaceServiceServer
 }
 
 // NewServer creates a new server instance
@@ -143,12 +153,16 @@ func NewServer(
 	idClient auth.Resolver,
 	inviteService invites.InviteService,
 	repoService reposvc.RepositoryService,
+	autoRegistrationService autoregistration.AutoRegistrationService,
 	propertyService propSvc.PropertiesService,
 	roleService roles.RoleService,
 	profileService profiles.ProfileService,
 	historyService history.EvaluationHistoryService,
 	ruleService ruletypes.RuleTypeService,
 	dataSourcesService datasourcessvc.DataSourcesService,
+	trustRootService trustroots.TrustRootService,
+	waiverService waivers.WaiverService,
+	marketplace marketplaces.Marketplace,
 	ghProviders service.GitHubProviderService,
 	providerManager manager.ProviderManager,
 	providerAuthManager manager.AuthManager,
@@ -171,6 +185,9 @@ func NewServer(
 		history:             historyService,
 		ruleTypes:           ruleService,
 		dataSourcesService:  dataSourcesService,
+		trustRoots:          trustRootService,
+		waivers:             waiverService,
+		marketplace:         marketplace,
 		providerStore:       providerStore,
 		featureFlags:        featureFlagClient,
 		ghClient:            &ghprov.ClientServiceImplementation{},
@@ -179,6 +196,7 @@ func NewServer(
 		sessionService:      sessionService,
 		invites:             inviteService,
 		repos:               repoService,
+		autoRegistration:    autoRegistrationService,
 		entityService:       entityService,
 		entityCreator:       entityCreator,
 		props:               propertyService,
@@ -271,6 +289,10 @@ func (s *Server) StartGRPCServer(ctx context.Context) error {
 	options := []grpc.ServerOption{
 		grpc.Creds(insecure.NewCredentials()),
 		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(
+			recovery.StreamServerInterceptor(recovery.WithRecoveryHandlerContext(recoveryHandler)),
+			StreamInterceptorFromUnary(interceptors...),
+		),
 	}
 
 	otelGRPCOpts := s.getOTELGRPCInterceptorOpts()
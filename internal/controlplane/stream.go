@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// StreamInterceptorFromUnary applies unary interceptors to the request of
+// server-streaming RPCs, so that they are authenticated, authorized and
+// validated like unary RPCs. The interceptors run when the handler receives
+// the request, and the context they return is the context of the stream.
+func StreamInterceptorFromUnary(interceptors ...grpc.UnaryServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &interceptedStream{
+			ServerStream: ss,
+			ctx:          ss.Context(),
+			info:         &grpc.UnaryServerInfo{Server: srv, FullMethod: info.FullMethod},
+			interceptors: interceptors,
+		})
+	}
+}
+
+// interceptedStream is a server stream whose first received message goes
+// through a chain of unary interceptors
+type interceptedStream struct {
+	grpc.ServerStream
+	ctx          context.Context
+	info         *grpc.UnaryServerInfo
+	interceptors []grpc.UnaryServerInterceptor
+	received     bool
+}
+
+// Context returns the context of the stream, as set by the interceptors
+func (s *interceptedStream) Context() context.Context {
+	return s.ctx
+}
+
+// RecvMsg receives a message from the stream, running the interceptors on
+// the first one
+func (s *interceptedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.received {
+		return nil
+	}
+	s.received = true
+
+	_, err := s.intercept(0)(s.ctx, m)
+	return err
+}
+
+// intercept returns the handler calling the interceptors from the i-th one,
+// which ends by keeping the context they built
+func (s *interceptedStream) intercept(i int) grpc.UnaryHandler {
+	if i == len(s.interceptors) {
+		return func(ctx context.Context, _ any) (any, error) {
+			s.ctx = ctx
+			return nil, nil
+		}
+	}
+	return func(ctx context.Context, req any) (any, error) {
+		return s.interceptors[i](ctx, req, s.info, s.intercept(i+1))
+	}
+}
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type ctxKey struct{}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (*fakeServerStream) RecvMsg(_ any) error {
+	return nil
+}
+
+func TestStreamInterceptorFromUnary(t *testing.T) {
+	t.Parallel()
+
+	errDenied := errors.New("denied")
+
+	tests := []struct {
+		name         string
+		interceptors []grpc.UnaryServerInterceptor
+		wantErr      error
+		wantValue    any
+	}{
+		{
+			name: "interceptors set the stream context",
+			interceptors: []grpc.UnaryServerInterceptor{
+				func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+					return handler(context.WithValue(ctx, ctxKey{}, "first"), req)
+				},
+				func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+					require.Equal(t, "first", ctx.Value(ctxKey{}))
+					return handler(context.WithValue(ctx, ctxKey{}, "second"), req)
+				},
+			},
+			wantValue: "second",
+		},
+		{
+			name: "interceptor errors are returned when receiving",
+			interceptors: []grpc.UnaryServerInterceptor{
+				func(_ context.Context, _ any, _ *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (any, error) {
+					return nil, errDenied
+				},
+			},
+			wantErr: errDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			interceptor := StreamInterceptorFromUnary(tt.interceptors...)
+			ss := &fakeServerStream{ctx: context.Background()}
+			info := &grpc.StreamServerInfo{FullMethod: "/test/Method"}
+
+			err := interceptor(nil, ss, info, func(_ any, stream grpc.ServerStream) error {
+				if err := stream.RecvMsg(nil); err != nil {
+					return err
+				}
+				require.Equal(t, tt.wantValue, stream.Context().Value(ctxKey{}))
+				return nil
+			})
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
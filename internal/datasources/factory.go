@@ -6,6 +6,7 @@ package datasources
 
 import (
 	"fmt"
+	"net/http"
 
 	"github.com/mindersec/minder/internal/datasources/rest"
 	"github.com/mindersec/minder/internal/datasources/structured"
@@ -14,9 +15,26 @@ import (
 	provinfv1 "github.com/mindersec/minder/pkg/providers/v1"
 )
 
+// Option is a functional option for building data sources
+type Option func(*buildOptions)
+
+type buildOptions struct {
+	transport http.RoundTripper
+}
+
+// WithHTTPTransport sets the transport of the HTTP requests which data
+// sources make without going through the provider.
+func WithHTTPTransport(transport http.RoundTripper) Option {
+	return func(o *buildOptions) {
+		o.transport = transport
+	}
+}
+
 // BuildFromProtobuf is a factory function that builds a new data source based on the given
 // data source type.
-func BuildFromProtobuf(ds *minderv1.DataSource, provider provinfv1.Provider) (v1datasources.DataSource, error) {
+func BuildFromProtobuf(
+	ds *minderv1.DataSource, provider provinfv1.Provider, opts ...Option,
+) (v1datasources.DataSource, error) {
 	if ds == nil {
 		return nil, fmt.Errorf("data source is nil")
 	}
@@ -29,7 +47,15 @@ func BuildFromProtobuf(ds *minderv1.DataSource, provider provinfv1.Provider) (v1
 	case *minderv1.DataSource_Structured:
 		return structured.NewStructDataSource(ds.GetStructured())
 	case *minderv1.DataSource_Rest:
-		return rest.NewRestDataSource(ds.GetRest(), provider)
+		o := &buildOptions{}
+		for _, opt := range opts {
+			opt(o)
+		}
+		var restOpts []rest.Option
+		if o.transport != nil {
+			restOpts = append(restOpts, rest.WithTransport(o.transport))
+		}
+		return rest.NewRestDataSource(ds.GetRest(), provider, restOpts...)
 	default:
 		return nil, fmt.Errorf("unknown data source type: %T", ds)
 	}
@@ -51,8 +51,9 @@ type restHandler struct {
 	inputSchema    *jsonschema.Schema
 	endpointTmpl   string
 	method         string
-	// used only to allow requests to localhost during tests
-	testOnlyTransport http.RoundTripper
+	// overrides the default transport, which only allows requests to
+	// public addresses. Used by tests and to record and replay requests.
+	transport http.RoundTripper
 	// contains the request body or the key
 	body          string
 	bodyFromInput bool
@@ -78,7 +79,9 @@ func initMetrics() {
 	})
 }
 
-func newHandlerFromDef(def *minderv1.RestDataSource_Def, provider provinfv1.Provider) (*restHandler, error) {
+func newHandlerFromDef(
+	def *minderv1.RestDataSource_Def, provider provinfv1.Provider, opts ...Option,
+) (*restHandler, error) {
 	if def == nil {
 		return nil, errors.New("rest data source handler definition is nil")
 	}
@@ -99,7 +102,7 @@ func newHandlerFromDef(def *minderv1.RestDataSource_Def, provider provinfv1.Prov
 	// If this is not a RESTProvider, restProvider will be nil, which we already need to handle.
 	restProvider, _ := interfaces.As[interfaces.RESTProvider](provider)
 
-	return &restHandler{
+	h := &restHandler{
 		rawInputSchema: def.GetInputSchema(),
 		inputSchema:    schema,
 		endpointTmpl:   def.GetEndpoint(),
@@ -109,7 +112,11 @@ func newHandlerFromDef(def *minderv1.RestDataSource_Def, provider provinfv1.Prov
 		bodyFromInput:  bodyFromInput,
 		parse:          def.GetParse(),
 		provider:       restProvider,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h, nil
 }
 
 func (h *restHandler) GetArgsSchema() *structpb.Struct {
@@ -151,7 +158,7 @@ func (h *restHandler) Call(ctx context.Context, _ *interfaces.Ingested, args any
 		return nil, err
 	}
 
-	transport := h.testOnlyTransport
+	transport := h.transport
 	if transport == nil {
 		transport = rego.LimitedDialer(nil)
 	}
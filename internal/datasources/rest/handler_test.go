@@ -231,13 +231,13 @@ func Test_restHandler_HTTPCall(t *testing.T) {
 			tt.fields.endpointTmpl = server.URL + tt.fields.endpointTmpl
 
 			h := &restHandler{
-				endpointTmpl:      tt.fields.endpointTmpl,
-				method:            tt.fields.method,
-				body:              tt.fields.body,
-				headers:           tt.fields.headers,
-				parse:             tt.fields.parse,
-				testOnlyTransport: http.DefaultTransport,
-				provider:          provider,
+				endpointTmpl: tt.fields.endpointTmpl,
+				method:       tt.fields.method,
+				body:         tt.fields.body,
+				headers:      tt.fields.headers,
+				parse:        tt.fields.parse,
+				transport:    http.DefaultTransport,
+				provider:     provider,
 			}
 			initMetrics()
 
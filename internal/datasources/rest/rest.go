@@ -23,6 +23,7 @@ package rest
 
 import (
 	"errors"
+	"net/http"
 
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
@@ -41,8 +42,21 @@ func (r *restDataSource) GetFuncs() map[v1datasources.DataSourceFuncKey]v1dataso
 	return r.handlers
 }
 
+// Option is a functional option for the REST data source
+type Option func(*restHandler)
+
+// WithTransport sets the transport of the requests which are not made
+// through the provider, e.g. to record or replay them.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(h *restHandler) {
+		h.transport = transport
+	}
+}
+
 // NewRestDataSource builds a new REST data source.
-func NewRestDataSource(rest *minderv1.RestDataSource, provider provinfv1.Provider) (v1datasources.DataSource, error) {
+func NewRestDataSource(
+	rest *minderv1.RestDataSource, provider provinfv1.Provider, opts ...Option,
+) (v1datasources.DataSource, error) {
 	if rest == nil {
 		return nil, errors.New("rest data source is nil")
 	}
@@ -61,7 +75,7 @@ func NewRestDataSource(rest *minderv1.RestDataSource, provider provinfv1.Provide
 	}
 
 	for key, handlerCfg := range rest.GetDef() {
-		handler, err := newHandlerFromDef(handlerCfg, provider)
+		handler, err := newHandlerFromDef(handlerCfg, provider, opts...)
 		if err != nil {
 			return nil, err
 		}
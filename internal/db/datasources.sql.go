@@ -374,6 +374,43 @@ func (q *Queries) ListDataSources(ctx context.Context, projects []uuid.UUID) ([]
 	return items, nil
 }
 
+const listDataSourcesBySubscription = `-- name: ListDataSourcesBySubscription :many
+SELECT id, name, display_name, project_id, created_at, updated_at, subscription_id, metadata FROM data_sources
+WHERE subscription_id = $1
+`
+
+func (q *Queries) ListDataSourcesBySubscription(ctx context.Context, subscriptionID uuid.NullUUID) ([]DataSource, error) {
+	rows, err := q.db.QueryContext(ctx, listDataSourcesBySubscription, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []DataSource{}
+	for rows.Next() {
+		var i DataSource
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.DisplayName,
+			&i.ProjectID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SubscriptionID,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listRuleTypesReferencesByDataSource = `-- name: ListRuleTypesReferencesByDataSource :many
 SELECT rule_type_id, data_sources_id, project_id FROM rule_type_data_sources
 WHERE data_sources_id = $1
@@ -590,6 +590,52 @@ func (q *Queries) ListEntitiesAfterID(ctx context.Context, arg ListEntitiesAfter
 	return items, nil
 }
 
+const listEntitiesByProjectAndName = `-- name: ListEntitiesByProjectAndName :many
+SELECT id, entity_type, name, project_id, provider_id, created_at, originated_from FROM entity_instances
+WHERE entity_instances.project_id = $1
+    AND entity_instances.entity_type = $2
+    AND entity_instances.name = $3
+`
+
+type ListEntitiesByProjectAndNameParams struct {
+	ProjectID  uuid.UUID `json:"project_id"`
+	EntityType Entities  `json:"entity_type"`
+	Name       string    `json:"name"`
+}
+
+// ListEntitiesByProjectAndName retrieves the entities of a given type and name
+// in a project, across all the providers of the project.
+func (q *Queries) ListEntitiesByProjectAndName(ctx context.Context, arg ListEntitiesByProjectAndNameParams) ([]EntityInstance, error) {
+	rows, err := q.db.QueryContext(ctx, listEntitiesByProjectAndName, arg.ProjectID, arg.EntityType, arg.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []EntityInstance{}
+	for rows.Next() {
+		var i EntityInstance
+		if err := rows.Scan(
+			&i.ID,
+			&i.EntityType,
+			&i.Name,
+			&i.ProjectID,
+			&i.ProviderID,
+			&i.CreatedAt,
+			&i.OriginatedFrom,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const upsertProperty = `-- name: UpsertProperty :one
 INSERT INTO properties (
     entity_id,
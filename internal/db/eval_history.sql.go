@@ -15,6 +15,87 @@ import (
 	"github.com/lib/pq"
 )
 
+const countEvaluationHistoryByDay = `-- name: CountEvaluationHistoryByDay :many
+SELECT date_trunc('day', s.evaluation_time)::timestamp AS day,
+       count(*) AS total,
+       count(*) FILTER (WHERE s.status = 'success') AS success,
+       count(*) FILTER (WHERE s.status = 'failure') AS failure,
+       count(*) FILTER (WHERE s.status IN ('error', 'timeout')) AS error,
+       count(*) FILTER (WHERE s.status = 'skipped') AS skipped,
+       count(*) FILTER (WHERE s.status = 'pending') AS pending,
+       count(*) FILTER (WHERE s.status = 'waived') AS waived,
+       count(*) FILTER (WHERE ae.status = 'on') AS alerts_on,
+       count(*) FILTER (WHERE re.status = 'success') AS remediations_success,
+       count(*) FILTER (WHERE re.status IN ('failure', 'error')) AS remediations_failed
+  FROM evaluation_statuses s
+  JOIN evaluation_rule_entities ere ON ere.id = s.rule_entity_id
+  JOIN entity_instances ei ON ere.entity_instance_id = ei.id
+  LEFT JOIN remediation_events re ON re.evaluation_id = s.id
+  LEFT JOIN alert_events ae ON ae.evaluation_id = s.id
+ WHERE ei.project_id = ANY($1::uuid[])
+   AND s.evaluation_time >= $2::timestamp without time zone
+   AND s.evaluation_time < $3::timestamp without time zone
+ GROUP BY 1
+ ORDER BY 1
+`
+
+type CountEvaluationHistoryByDayParams struct {
+	Projectids []uuid.UUID `json:"projectids"`
+	Fromts     time.Time   `json:"fromts"`
+	Tots       time.Time   `json:"tots"`
+}
+
+type CountEvaluationHistoryByDayRow struct {
+	Day                 time.Time `json:"day"`
+	Total               int64     `json:"total"`
+	Success             int64     `json:"success"`
+	Failure             int64     `json:"failure"`
+	Error               int64     `json:"error"`
+	Skipped             int64     `json:"skipped"`
+	Pending             int64     `json:"pending"`
+	Waived              int64     `json:"waived"`
+	AlertsOn            int64     `json:"alerts_on"`
+	RemediationsSuccess int64     `json:"remediations_success"`
+	RemediationsFailed  int64     `json:"remediations_failed"`
+}
+
+// CountEvaluationHistoryByDay counts the evaluations of entities in the
+// given projects by day and outcome, within a time range.
+func (q *Queries) CountEvaluationHistoryByDay(ctx context.Context, arg CountEvaluationHistoryByDayParams) ([]CountEvaluationHistoryByDayRow, error) {
+	rows, err := q.db.QueryContext(ctx, countEvaluationHistoryByDay, pq.Array(arg.Projectids), arg.Fromts, arg.Tots)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountEvaluationHistoryByDayRow{}
+	for rows.Next() {
+		var i CountEvaluationHistoryByDayRow
+		if err := rows.Scan(
+			&i.Day,
+			&i.Total,
+			&i.Success,
+			&i.Failure,
+			&i.Error,
+			&i.Skipped,
+			&i.Pending,
+			&i.Waived,
+			&i.AlertsOn,
+			&i.RemediationsSuccess,
+			&i.RemediationsFailed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const deleteEvaluationHistoryByIDs = `-- name: DeleteEvaluationHistoryByIDs :execrows
 DELETE FROM evaluation_statuses s
  WHERE s.id = ANY($1::uuid[])
@@ -50,7 +131,9 @@ SELECT s.id::uuid AS evaluation_id,
     re.details AS remediation_details,
     -- alert status and details
     ae.status AS alert_status,
-    ae.details AS alert_details
+    ae.details AS alert_details,
+    -- rule instance
+    ri.id AS rule_id
 FROM evaluation_statuses s
     JOIN evaluation_rule_entities ere ON ere.id = s.rule_entity_id
     JOIN rule_instances ri ON ere.rule_id = ri.id
@@ -85,6 +168,7 @@ type GetEvaluationHistoryRow struct {
 	RemediationDetails sql.NullString             `json:"remediation_details"`
 	AlertStatus        NullAlertStatusTypes       `json:"alert_status"`
 	AlertDetails       sql.NullString             `json:"alert_details"`
+	RuleID             uuid.UUID                  `json:"rule_id"`
 }
 
 func (q *Queries) GetEvaluationHistory(ctx context.Context, arg GetEvaluationHistoryParams) (GetEvaluationHistoryRow, error) {
@@ -107,6 +191,7 @@ func (q *Queries) GetEvaluationHistory(ctx context.Context, arg GetEvaluationHis
 		&i.RemediationDetails,
 		&i.AlertStatus,
 		&i.AlertDetails,
+		&i.RuleID,
 	)
 	return i, err
 }
@@ -494,6 +579,50 @@ func (q *Queries) ListEvaluationHistoryStaleRecords(ctx context.Context, arg Lis
 	return items, nil
 }
 
+const listLatestEvalStatusesForEntityAndProfile = `-- name: ListLatestEvalStatusesForEntityAndProfile :many
+
+SELECT ri.name AS rule_name, es.status FROM latest_evaluation_statuses AS les
+JOIN evaluation_rule_entities AS ere ON ere.id = les.rule_entity_id
+JOIN evaluation_statuses AS es ON es.id = les.evaluation_history_id
+JOIN rule_instances AS ri ON ri.id = ere.rule_id
+WHERE les.profile_id = $1 AND ere.entity_instance_id = $2
+`
+
+type ListLatestEvalStatusesForEntityAndProfileParams struct {
+	ProfileID uuid.UUID `json:"profile_id"`
+	EntityID  uuid.UUID `json:"entity_id"`
+}
+
+type ListLatestEvalStatusesForEntityAndProfileRow struct {
+	RuleName string          `json:"rule_name"`
+	Status   EvalStatusTypes `json:"status"`
+}
+
+// ListLatestEvalStatusesForEntityAndProfile returns the status of the latest
+// evaluation of each rule of a profile against an entity.
+func (q *Queries) ListLatestEvalStatusesForEntityAndProfile(ctx context.Context, arg ListLatestEvalStatusesForEntityAndProfileParams) ([]ListLatestEvalStatusesForEntityAndProfileRow, error) {
+	rows, err := q.db.QueryContext(ctx, listLatestEvalStatusesForEntityAndProfile, arg.ProfileID, arg.EntityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListLatestEvalStatusesForEntityAndProfileRow{}
+	for rows.Next() {
+		var i ListLatestEvalStatusesForEntityAndProfileRow
+		if err := rows.Scan(&i.RuleName, &i.Status); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const upsertLatestEvaluationStatus = `-- name: UpsertLatestEvaluationStatus :exec
 INSERT INTO latest_evaluation_statuses(
     rule_entity_id,
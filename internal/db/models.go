@@ -203,6 +203,8 @@ const (
 	EvalStatusTypesError   EvalStatusTypes = "error"
 	EvalStatusTypesSkipped EvalStatusTypes = "skipped"
 	EvalStatusTypesPending EvalStatusTypes = "pending"
+	EvalStatusTypesTimeout EvalStatusTypes = "timeout"
+	EvalStatusTypesWaived  EvalStatusTypes = "waived"
 )
 
 func (e *EvalStatusTypes) Scan(src interface{}) error {
@@ -240,14 +242,59 @@ func (ns NullEvalStatusTypes) Value() (driver.Value, error) {
 	return string(ns.EvalStatusTypes), nil
 }
 
+type PropertyChangeSource string
+
+const (
+	PropertyChangeSourceWebhook      PropertyChangeSource = "webhook"
+	PropertyChangeSourceRefresh      PropertyChangeSource = "refresh"
+	PropertyChangeSourceReconcile    PropertyChangeSource = "reconcile"
+	PropertyChangeSourceRegistration PropertyChangeSource = "registration"
+)
+
+func (e *PropertyChangeSource) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PropertyChangeSource(s)
+	case string:
+		*e = PropertyChangeSource(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PropertyChangeSource: %T", src)
+	}
+	return nil
+}
+
+type NullPropertyChangeSource struct {
+	PropertyChangeSource PropertyChangeSource `json:"property_change_source"`
+	Valid                bool                 `json:"valid"` // Valid is true if PropertyChangeSource is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPropertyChangeSource) Scan(value interface{}) error {
+	if value == nil {
+		ns.PropertyChangeSource, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PropertyChangeSource.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPropertyChangeSource) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PropertyChangeSource), nil
+}
+
 type ProviderClass string
 
 const (
-	ProviderClassGithub    ProviderClass = "github"
-	ProviderClassGithubApp ProviderClass = "github-app"
-	ProviderClassGhcr      ProviderClass = "ghcr"
-	ProviderClassDockerhub ProviderClass = "dockerhub"
-	ProviderClassGitlab    ProviderClass = "gitlab"
+	ProviderClassGithub      ProviderClass = "github"
+	ProviderClassGithubApp   ProviderClass = "github-app"
+	ProviderClassGhcr        ProviderClass = "ghcr"
+	ProviderClassDockerhub   ProviderClass = "dockerhub"
+	ProviderClassGitlab      ProviderClass = "gitlab"
+	ProviderClassAzureDevops ProviderClass = "azure-devops"
 )
 
 func (e *ProviderClass) Scan(src interface{}) error {
@@ -285,6 +332,49 @@ func (ns NullProviderClass) Value() (driver.Value, error) {
 	return string(ns.ProviderClass), nil
 }
 
+type ProviderHealthStatus string
+
+const (
+	ProviderHealthStatusHealthy   ProviderHealthStatus = "healthy"
+	ProviderHealthStatusDegraded  ProviderHealthStatus = "degraded"
+	ProviderHealthStatusUnhealthy ProviderHealthStatus = "unhealthy"
+)
+
+func (e *ProviderHealthStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ProviderHealthStatus(s)
+	case string:
+		*e = ProviderHealthStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ProviderHealthStatus: %T", src)
+	}
+	return nil
+}
+
+type NullProviderHealthStatus struct {
+	ProviderHealthStatus ProviderHealthStatus `json:"provider_health_status"`
+	Valid                bool                 `json:"valid"` // Valid is true if ProviderHealthStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullProviderHealthStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ProviderHealthStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ProviderHealthStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullProviderHealthStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ProviderHealthStatus), nil
+}
+
 type ProviderType string
 
 const (
@@ -476,6 +566,23 @@ type AlertEvent struct {
 	CreatedAt    time.Time        `json:"created_at"`
 }
 
+type AutoRegisteredEntity struct {
+	EntityInstanceID uuid.UUID `json:"entity_instance_id"`
+	ProjectID        uuid.UUID `json:"project_id"`
+	RuleName         string    `json:"rule_name"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+type AutoRegistrationRule struct {
+	ID         uuid.UUID     `json:"id"`
+	ProjectID  uuid.UUID     `json:"project_id"`
+	ProviderID uuid.NullUUID `json:"provider_id"`
+	Name       string        `json:"name"`
+	EntityType Entities      `json:"entity_type"`
+	Selector   string        `json:"selector"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
 type Bundle struct {
 	ID        uuid.UUID `json:"id"`
 	Namespace string    `json:"namespace"`
@@ -635,6 +742,16 @@ type Property struct {
 	UpdatedAt time.Time       `json:"updated_at"`
 }
 
+type PropertyHistory struct {
+	ID        int64                 `json:"id"`
+	EntityID  uuid.UUID             `json:"entity_id"`
+	Key       string                `json:"key"`
+	OldValue  pqtype.NullRawMessage `json:"old_value"`
+	NewValue  pqtype.NullRawMessage `json:"new_value"`
+	Source    PropertyChangeSource  `json:"source"`
+	ChangedAt time.Time             `json:"changed_at"`
+}
+
 type Provider struct {
 	ID         uuid.UUID           `json:"id"`
 	Name       string              `json:"name"`
@@ -673,6 +790,16 @@ type ProviderGithubAppInstallation struct {
 	IsOrg             bool           `json:"is_org"`
 }
 
+type ProviderHealth struct {
+	ProviderID          uuid.UUID            `json:"provider_id"`
+	Status              ProviderHealthStatus `json:"status"`
+	LastError           string               `json:"last_error"`
+	CheckedAt           time.Time            `json:"checked_at"`
+	LastHealthyAt       sql.NullTime         `json:"last_healthy_at"`
+	CredentialExpiresAt sql.NullTime         `json:"credential_expires_at"`
+	ExpiryNotifiedAt    sql.NullTime         `json:"expiry_notified_at"`
+}
+
 type RemediationEvent struct {
 	ID           uuid.UUID              `json:"id"`
 	EvaluationID uuid.UUID              `json:"evaluation_id"`
@@ -740,6 +867,15 @@ type Subscription struct {
 	CurrentVersion string    `json:"current_version"`
 }
 
+type TrustRoot struct {
+	ID         uuid.UUID       `json:"id"`
+	Name       string          `json:"name"`
+	ProjectID  uuid.UUID       `json:"project_id"`
+	Definition json.RawMessage `json:"definition"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
+
 type User struct {
 	ID              int32     `json:"id"`
 	IdentitySubject string    `json:"identity_subject"`
@@ -756,3 +892,25 @@ type UserInvite struct {
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
+
+type Waiver struct {
+	ID               uuid.UUID `json:"id"`
+	ProjectID        uuid.UUID `json:"project_id"`
+	ProfileID        uuid.UUID `json:"profile_id"`
+	RuleInstanceID   uuid.UUID `json:"rule_instance_id"`
+	EntityInstanceID uuid.UUID `json:"entity_instance_id"`
+	Justification    string    `json:"justification"`
+	Approver         string    `json:"approver"`
+	ExpiresAt        time.Time `json:"expires_at"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+type WebhookDeliveryStatus struct {
+	EntityInstanceID uuid.UUID    `json:"entity_instance_id"`
+	LastDeliveryAt   sql.NullTime `json:"last_delivery_at"`
+	LastReconciledAt sql.NullTime `json:"last_reconciled_at"`
+	LastRepair       string       `json:"last_repair"`
+	LastRepairedAt   sql.NullTime `json:"last_repaired_at"`
+	QuietSince       sql.NullTime `json:"quiet_since"`
+}
@@ -571,6 +571,46 @@ func (q *Queries) ListProfilesByProjectIDAndLabel(ctx context.Context, arg ListP
 	return items, nil
 }
 
+const listProfilesBySubscription = `-- name: ListProfilesBySubscription :many
+SELECT id, name, provider, project_id, remediate, alert, created_at, updated_at, provider_id, subscription_id, display_name, labels FROM profiles WHERE subscription_id = $1
+`
+
+func (q *Queries) ListProfilesBySubscription(ctx context.Context, subscriptionID uuid.NullUUID) ([]Profile, error) {
+	rows, err := q.db.QueryContext(ctx, listProfilesBySubscription, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Profile{}
+	for rows.Next() {
+		var i Profile
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Provider,
+			&i.ProjectID,
+			&i.Remediate,
+			&i.Alert,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ProviderID,
+			&i.SubscriptionID,
+			&i.DisplayName,
+			pq.Array(&i.Labels),
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listProfilesInstantiatingRuleType = `-- name: ListProfilesInstantiatingRuleType :many
 SELECT DISTINCT(p.name)
 FROM profiles AS p
@@ -8,6 +8,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -24,10 +25,16 @@ type Querier interface {
 	CountEntitiesByType(ctx context.Context, entityType Entities) (int64, error)
 	// CountEntitiesByTypeAndProject counts entities of a given type for a specific project.
 	CountEntitiesByTypeAndProject(ctx context.Context, arg CountEntitiesByTypeAndProjectParams) (int64, error)
+	// CountEvaluationHistoryByDay counts the evaluations of entities in the
+	// given projects by day and outcome, within a time range.
+	CountEvaluationHistoryByDay(ctx context.Context, arg CountEvaluationHistoryByDayParams) ([]CountEvaluationHistoryByDayRow, error)
 	CountProfilesByEntityType(ctx context.Context) ([]CountProfilesByEntityTypeRow, error)
 	CountProfilesByName(ctx context.Context, name string) (int64, error)
 	CountProfilesByProjectID(ctx context.Context, projectID uuid.UUID) (int64, error)
 	CountUsers(ctx context.Context) (int64, error)
+	CreateAutoRegisteredEntity(ctx context.Context, arg CreateAutoRegisteredEntityParams) (AutoRegisteredEntity, error)
+	// CreateAutoRegistrationRule creates a new auto-registration rule in a given project.
+	CreateAutoRegistrationRule(ctx context.Context, arg CreateAutoRegistrationRuleParams) (AutoRegistrationRule, error)
 	// CreateDataSource creates a new datasource in a given project.
 	CreateDataSource(ctx context.Context, arg CreateDataSourceParams) (DataSource, error)
 	CreateEntitlements(ctx context.Context, arg CreateEntitlementsParams) error
@@ -53,8 +60,14 @@ type Querier interface {
 	CreateSessionState(ctx context.Context, arg CreateSessionStateParams) (SessionStore, error)
 	// Subscriptions --
 	CreateSubscription(ctx context.Context, arg CreateSubscriptionParams) (Subscription, error)
+	// CreateTrustRoot creates a new trust root in a given project.
+	CreateTrustRoot(ctx context.Context, arg CreateTrustRootParams) (TrustRoot, error)
 	CreateUser(ctx context.Context, identitySubject string) (User, error)
+	// CreateWaiver creates a waiver for a rule instance and an entity. Creating
+	// a waiver for a pair which already has one replaces it.
+	CreateWaiver(ctx context.Context, arg CreateWaiverParams) (Waiver, error)
 	DeleteAllPropertiesForEntity(ctx context.Context, entityID uuid.UUID) error
+	DeleteAutoRegistrationRule(ctx context.Context, arg DeleteAutoRegistrationRuleParams) (AutoRegistrationRule, error)
 	DeleteDataSource(ctx context.Context, arg DeleteDataSourceParams) (DataSource, error)
 	DeleteDataSourceFunction(ctx context.Context, arg DeleteDataSourceFunctionParams) (DataSourcesFunction, error)
 	// DeleteDataSourceFunctions deletes all functions associated with a given datasource
@@ -74,6 +87,7 @@ type Querier interface {
 	DeleteProfileForEntity(ctx context.Context, arg DeleteProfileForEntityParams) error
 	DeleteProject(ctx context.Context, id uuid.UUID) ([]DeleteProjectRow, error)
 	DeleteProperty(ctx context.Context, arg DeletePropertyParams) error
+	DeletePropertyHistoryBefore(ctx context.Context, before time.Time) (int64, error)
 	DeleteProvider(ctx context.Context, arg DeleteProviderParams) error
 	DeleteRuleInstanceOfProfileInProject(ctx context.Context, arg DeleteRuleInstanceOfProfileInProjectParams) error
 	DeleteRuleType(ctx context.Context, id uuid.UUID) error
@@ -81,7 +95,10 @@ type Querier interface {
 	DeleteSelector(ctx context.Context, id uuid.UUID) error
 	DeleteSelectorsByProfileID(ctx context.Context, profileID uuid.UUID) error
 	DeleteSessionStateByProjectID(ctx context.Context, arg DeleteSessionStateByProjectIDParams) error
+	DeleteSubscription(ctx context.Context, id uuid.UUID) error
+	DeleteTrustRoot(ctx context.Context, arg DeleteTrustRootParams) (TrustRoot, error)
 	DeleteUser(ctx context.Context, id int32) error
+	DeleteWaiver(ctx context.Context, arg DeleteWaiverParams) (Waiver, error)
 	EnqueueFlush(ctx context.Context, arg EnqueueFlushParams) (FlushCache, error)
 	// EntityExistsAfterID checks if any entity of a given type exists after a cursor ID.
 	EntityExistsAfterID(ctx context.Context, arg EntityExistsAfterIDParams) (bool, error)
@@ -94,7 +111,11 @@ type Querier interface {
 	GetAccessTokenByProjectID(ctx context.Context, arg GetAccessTokenByProjectIDParams) (ProviderAccessToken, error)
 	GetAccessTokenByProvider(ctx context.Context, provider string) ([]ProviderAccessToken, error)
 	GetAccessTokenSinceDate(ctx context.Context, arg GetAccessTokenSinceDateParams) (ProviderAccessToken, error)
+	// GetActiveWaiver returns the waiver covering a rule instance and an entity,
+	// if it hasn't expired yet.
+	GetActiveWaiver(ctx context.Context, arg GetActiveWaiverParams) (Waiver, error)
 	GetAllPropertiesForEntity(ctx context.Context, entityID uuid.UUID) ([]Property, error)
+	GetAutoRegistrationRuleByName(ctx context.Context, arg GetAutoRegistrationRuleByNameParams) (AutoRegistrationRule, error)
 	GetBundle(ctx context.Context, arg GetBundleParams) (Bundle, error)
 	GetChildrenProjects(ctx context.Context, id uuid.UUID) ([]GetChildrenProjectsRow, error)
 	// GetDataSource retrieves a datasource by its id and a project hierarchy.
@@ -169,7 +190,10 @@ type Querier interface {
 	// if it exists in the project or any of its ancestors. It'll return the first
 	// provider that matches the name.
 	GetProviderByName(ctx context.Context, arg GetProviderByNameParams) (Provider, error)
+	GetProviderHealth(ctx context.Context, providerID uuid.UUID) (ProviderHealth, error)
 	GetRootProjectByID(ctx context.Context, id uuid.UUID) (Project, error)
+	GetRuleInstanceByID(ctx context.Context, id uuid.UUID) (RuleInstance, error)
+	GetRuleInstanceByProfileAndName(ctx context.Context, arg GetRuleInstanceByProfileAndNameParams) (RuleInstance, error)
 	GetRuleInstancesEntityInProjects(ctx context.Context, arg GetRuleInstancesEntityInProjectsParams) ([]RuleInstance, error)
 	GetRuleInstancesForProfile(ctx context.Context, profileID uuid.UUID) ([]RuleInstance, error)
 	GetRuleTypeByID(ctx context.Context, id uuid.UUID) (RuleType, error)
@@ -184,17 +208,36 @@ type Querier interface {
 	GetSelectorByID(ctx context.Context, id uuid.UUID) (ProfileSelector, error)
 	GetSelectorsByProfileID(ctx context.Context, profileID uuid.UUID) ([]ProfileSelector, error)
 	GetSubscriptionByProjectBundle(ctx context.Context, arg GetSubscriptionByProjectBundleParams) (Subscription, error)
+	// GetTrustRootByName retrieves a trust root by its name and a project
+	// hierarchy.
+	//
+	// Note that to get a trust root for a given project, one can simply
+	// pass one project id in the project_id array.
+	GetTrustRootByName(ctx context.Context, arg GetTrustRootByNameParams) (TrustRoot, error)
 	GetTypedEntitiesByProperty(ctx context.Context, arg GetTypedEntitiesByPropertyParams) ([]EntityInstance, error)
 	GetUnclaimedInstallationsByUser(ctx context.Context, ghID sql.NullString) ([]ProviderGithubAppInstallation, error)
 	GetUserByID(ctx context.Context, id int32) (User, error)
 	GetUserBySubject(ctx context.Context, identitySubject string) (User, error)
+	GetWaiverByID(ctx context.Context, arg GetWaiverByIDParams) (GetWaiverByIDRow, error)
+	GetWebhookDeliveryStatus(ctx context.Context, entityInstanceID uuid.UUID) (WebhookDeliveryStatus, error)
 	GlobalListProviders(ctx context.Context) ([]Provider, error)
 	GlobalListProvidersByClass(ctx context.Context, class ProviderClass) ([]Provider, error)
 	InsertAlertEvent(ctx context.Context, arg InsertAlertEventParams) error
 	InsertEvaluationRuleEntity(ctx context.Context, arg InsertEvaluationRuleEntityParams) (uuid.UUID, error)
 	InsertEvaluationStatus(ctx context.Context, arg InsertEvaluationStatusParams) (uuid.UUID, error)
+	InsertPropertyHistory(ctx context.Context, arg InsertPropertyHistoryParams) error
 	InsertRemediationEvent(ctx context.Context, arg InsertRemediationEventParams) error
 	ListAllRootProjects(ctx context.Context) ([]Project, error)
+	// ListAutoRegisteredEntities lists the entities of a provider which were
+	// registered by an auto-registration rule.
+	ListAutoRegisteredEntities(ctx context.Context, arg ListAutoRegisteredEntitiesParams) ([]ListAutoRegisteredEntitiesRow, error)
+	// ListAutoRegistrationRules lists the auto-registration rules of a project.
+	// When a provider is given, only the rules which apply to it are returned.
+	ListAutoRegistrationRules(ctx context.Context, arg ListAutoRegistrationRulesParams) ([]AutoRegistrationRule, error)
+	// ListAutoRegistrationTargets lists the providers that auto-registration
+	// must be reconciled for, that is the providers with rules applying to them
+	// or with entities registered by a rule.
+	ListAutoRegistrationTargets(ctx context.Context) ([]ListAutoRegistrationTargetsRow, error)
 	// ListDataSourceFunctions retrieves all functions for a datasource.
 	ListDataSourceFunctions(ctx context.Context, arg ListDataSourceFunctionsParams) ([]DataSourcesFunction, error)
 	// ListDataSources retrieves all datasources for project hierarchy.
@@ -202,9 +245,13 @@ type Querier interface {
 	// Note that to get a datasource for a given project, one can simply
 	// pass one project id in the project_id array.
 	ListDataSources(ctx context.Context, projects []uuid.UUID) ([]DataSource, error)
+	ListDataSourcesBySubscription(ctx context.Context, subscriptionID uuid.NullUUID) ([]DataSource, error)
 	// ListEntitiesAfterID retrieves entities of a given type after a cursor ID, for pagination.
 	// This is used for cursor-based iteration over all entities (e.g., in the reminder service).
 	ListEntitiesAfterID(ctx context.Context, arg ListEntitiesAfterIDParams) ([]EntityInstance, error)
+	// ListEntitiesByProjectAndName retrieves the entities of a given type and name
+	// in a project, across all the providers of the project.
+	ListEntitiesByProjectAndName(ctx context.Context, arg ListEntitiesByProjectAndNameParams) ([]EntityInstance, error)
 	ListEvaluationHistory(ctx context.Context, arg ListEvaluationHistoryParams) ([]ListEvaluationHistoryRow, error)
 	ListEvaluationHistoryStaleRecords(ctx context.Context, arg ListEvaluationHistoryStaleRecordsParams) ([]ListEvaluationHistoryStaleRecordsRow, error)
 	ListFlushCache(ctx context.Context) ([]FlushCache, error)
@@ -213,6 +260,9 @@ type Querier interface {
 	// *does not* report the invitation code, which is a secret intended for
 	// the invitee.
 	ListInvitationsForProject(ctx context.Context, project uuid.UUID) ([]ListInvitationsForProjectRow, error)
+	// ListLatestEvalStatusesForEntityAndProfile returns the status of the latest
+	// evaluation of each rule of a profile against an entity.
+	ListLatestEvalStatusesForEntityAndProfile(ctx context.Context, arg ListLatestEvalStatusesForEntityAndProfileParams) ([]ListLatestEvalStatusesForEntityAndProfileRow, error)
 	// ListOldestRuleEvaluationsByEntityID returns the oldest evaluation time for each entity.
 	// cast after MIN is required due to a known bug in sqlc: https://github.com/sqlc-dev/sqlc/issues/1965
 	ListOldestRuleEvaluationsByEntityID(ctx context.Context, entityIds []uuid.UUID) ([]ListOldestRuleEvaluationsByEntityIDRow, error)
@@ -221,7 +271,12 @@ type Querier interface {
 	// DEPRECATED: Use ListOldestRuleEvaluationsByEntityID instead
 	ListOldestRuleEvaluationsByRepositoryId(ctx context.Context, repositoryIds []uuid.UUID) ([]ListOldestRuleEvaluationsByRepositoryIdRow, error)
 	ListProfilesByProjectIDAndLabel(ctx context.Context, arg ListProfilesByProjectIDAndLabelParams) ([]ListProfilesByProjectIDAndLabelRow, error)
+	ListProfilesBySubscription(ctx context.Context, subscriptionID uuid.NullUUID) ([]Profile, error)
 	ListProfilesInstantiatingRuleType(ctx context.Context, ruleTypeID uuid.UUID) ([]string, error)
+	// ListPropertyHistory lists the changes of the properties of an entity,
+	// newest first. The cursor is the ID of the last change of the previous
+	// page, zero starts from the newest change.
+	ListPropertyHistory(ctx context.Context, arg ListPropertyHistoryParams) ([]PropertyHistory, error)
 	// ListProvidersByProjectID allows us to list all providers
 	// for a given array of projects.
 	ListProvidersByProjectID(ctx context.Context, projects []uuid.UUID) ([]Provider, error)
@@ -229,11 +284,14 @@ type Querier interface {
 	// with pagination taken into account. In this case, the cursor is the creation date.
 	ListProvidersByProjectIDPaginated(ctx context.Context, arg ListProvidersByProjectIDPaginatedParams) ([]Provider, error)
 	ListRuleEvaluationsByProfileId(ctx context.Context, arg ListRuleEvaluationsByProfileIdParams) ([]ListRuleEvaluationsByProfileIdRow, error)
+	ListRuleInstancesForRuleType(ctx context.Context, ruleTypeID uuid.UUID) ([]ListRuleInstancesForRuleTypeRow, error)
 	ListRuleTypesByProject(ctx context.Context, projectID uuid.UUID) ([]RuleType, error)
+	ListRuleTypesBySubscription(ctx context.Context, subscriptionID uuid.NullUUID) ([]RuleType, error)
 	// ListRuleTypesReferencesByDataSource retrieves all rule types
 	// referencing a given data source in a given project.
 	//
 	ListRuleTypesReferencesByDataSource(ctx context.Context, dataSourcesID uuid.UUID) ([]RuleTypeDataSource, error)
+	ListSubscriptionsByProjects(ctx context.Context, projects []uuid.UUID) ([]ListSubscriptionsByProjectsRow, error)
 	// When doing a key/algorithm rotation, identify the secrets which need to be
 	// rotated. The criteria for rotation are:
 	// 1) The encrypted_access_token is NULL (this should be removed when we make
@@ -243,7 +301,15 @@ type Querier interface {
 	// This query accepts the default key version/algorithm as arguments since
 	// that information is not known to the database.
 	ListTokensToMigrate(ctx context.Context, arg ListTokensToMigrateParams) ([]ProviderAccessToken, error)
+	// ListTrustRoots retrieves all trust roots for a project hierarchy.
+	//
+	// Note that to get the trust roots of a given project, one can simply
+	// pass one project id in the project_id array.
+	ListTrustRoots(ctx context.Context, projects []uuid.UUID) ([]TrustRoot, error)
 	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	// ListWaivers lists the waivers of a project, optionally restricted to a
+	// profile. Expired waivers are only listed when requested.
+	ListWaivers(ctx context.Context, arg ListWaiversParams) ([]ListWaiversRow, error)
 	// LockIfThresholdNotExceeded is used to lock an entity for execution. It will
 	// attempt to insert or update the entity_execution_lock table only if the
 	// last_lock_time is older than the threshold. If the lock is successful, it
@@ -252,11 +318,15 @@ type Querier interface {
 	LockIfThresholdNotExceeded(ctx context.Context, arg LockIfThresholdNotExceededParams) (EntityExecutionLock, error)
 	// OrphanProject is a query that sets the parent_id of a project to NULL.
 	OrphanProject(ctx context.Context, arg OrphanProjectParams) (Project, error)
+	// RecordWebhookDelivery records that a webhook delivery was received for
+	// an entity, which is no longer quiet.
+	RecordWebhookDelivery(ctx context.Context, entityInstanceID uuid.UUID) error
 	// ReleaseLock is used to release a lock on an entity. It will delete the
 	// entity_execution_lock record if the lock is held by the given locked_by
 	// value.
 	ReleaseLock(ctx context.Context, arg ReleaseLockParams) error
 	SetSubscriptionBundleVersion(ctx context.Context, arg SetSubscriptionBundleVersionParams) error
+	SetSubscriptionVersion(ctx context.Context, arg SetSubscriptionVersionParams) error
 	// UpdateDataSource updates a datasource in a given project.
 	UpdateDataSource(ctx context.Context, arg UpdateDataSourceParams) (DataSource, error)
 	// UpdateDataSourceFunction updates a function in a datasource. We're
@@ -271,8 +341,12 @@ type Querier interface {
 	UpdateProfile(ctx context.Context, arg UpdateProfileParams) (Profile, error)
 	UpdateProjectMeta(ctx context.Context, arg UpdateProjectMetaParams) (Project, error)
 	UpdateProvider(ctx context.Context, arg UpdateProviderParams) error
+	UpdateRuleInstanceDefinition(ctx context.Context, arg UpdateRuleInstanceDefinitionParams) error
 	UpdateRuleType(ctx context.Context, arg UpdateRuleTypeParams) (RuleType, error)
 	UpdateSelector(ctx context.Context, arg UpdateSelectorParams) (ProfileSelector, error)
+	// UpdateTrustRoot updates the definition of a trust root in a given project.
+	UpdateTrustRoot(ctx context.Context, arg UpdateTrustRootParams) (TrustRoot, error)
+	UpdateWaiver(ctx context.Context, arg UpdateWaiverParams) (Waiver, error)
 	UpsertAccessToken(ctx context.Context, arg UpsertAccessTokenParams) (ProviderAccessToken, error)
 	// SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
 	// SPDX-License-Identifier: Apache-2.0
@@ -282,9 +356,16 @@ type Querier interface {
 	UpsertLatestEvaluationStatus(ctx context.Context, arg UpsertLatestEvaluationStatusParams) error
 	UpsertProfileForEntity(ctx context.Context, arg UpsertProfileForEntityParams) (EntityProfile, error)
 	UpsertProperty(ctx context.Context, arg UpsertPropertyParams) (Property, error)
+	// UpsertProviderHealth records the result of a health check of a provider.
+	// A NULL last_healthy_at keeps the time the provider was last found healthy.
+	UpsertProviderHealth(ctx context.Context, arg UpsertProviderHealthParams) (ProviderHealth, error)
 	// SPDX-FileCopyrightText: Copyright 2024 The Minder Authors
 	// SPDX-License-Identifier: Apache-2.0
 	UpsertRuleInstance(ctx context.Context, arg UpsertRuleInstanceParams) (uuid.UUID, error)
+	// UpsertWebhookReconciliation records the result of the reconciliation of
+	// the webhook of an entity. An empty last_repair keeps the previous repair.
+	// quiet_since keeps the time the entity was first found quiet.
+	UpsertWebhookReconciliation(ctx context.Context, arg UpsertWebhookReconciliationParams) (WebhookDeliveryStatus, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -46,6 +46,57 @@ func (q *Queries) DeleteRuleInstanceOfProfileInProject(ctx context.Context, arg
 	return err
 }
 
+const getRuleInstanceByID = `-- name: GetRuleInstanceByID :one
+SELECT id, profile_id, rule_type_id, name, entity_type, def, params, created_at, updated_at, project_id FROM rule_instances WHERE id = $1
+`
+
+func (q *Queries) GetRuleInstanceByID(ctx context.Context, id uuid.UUID) (RuleInstance, error) {
+	row := q.db.QueryRowContext(ctx, getRuleInstanceByID, id)
+	var i RuleInstance
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.RuleTypeID,
+		&i.Name,
+		&i.EntityType,
+		&i.Def,
+		&i.Params,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ProjectID,
+	)
+	return i, err
+}
+
+const getRuleInstanceByProfileAndName = `-- name: GetRuleInstanceByProfileAndName :one
+SELECT id, profile_id, rule_type_id, name, entity_type, def, params, created_at, updated_at, project_id FROM rule_instances
+WHERE profile_id = $1 AND entity_type = $2 AND lower(name) = lower($3)
+`
+
+type GetRuleInstanceByProfileAndNameParams struct {
+	ProfileID  uuid.UUID `json:"profile_id"`
+	EntityType Entities  `json:"entity_type"`
+	Name       string    `json:"name"`
+}
+
+func (q *Queries) GetRuleInstanceByProfileAndName(ctx context.Context, arg GetRuleInstanceByProfileAndNameParams) (RuleInstance, error) {
+	row := q.db.QueryRowContext(ctx, getRuleInstanceByProfileAndName, arg.ProfileID, arg.EntityType, arg.Name)
+	var i RuleInstance
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.RuleTypeID,
+		&i.Name,
+		&i.EntityType,
+		&i.Def,
+		&i.Params,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ProjectID,
+	)
+	return i, err
+}
+
 const getRuleInstancesEntityInProjects = `-- name: GetRuleInstancesEntityInProjects :many
 SELECT id, profile_id, rule_type_id, name, entity_type, def, params, created_at, updated_at, project_id FROM rule_instances
 WHERE entity_type = $1
@@ -151,6 +202,76 @@ func (q *Queries) GetRuleTypeIDByRuleNameEntityProfile(ctx context.Context, arg
 	return rule_type_id, err
 }
 
+const listRuleInstancesForRuleType = `-- name: ListRuleInstancesForRuleType :many
+SELECT ri.id, ri.profile_id, ri.name, ri.entity_type, ri.def, ri.params, ri.project_id,
+    p.name AS profile_name
+FROM rule_instances AS ri
+JOIN profiles AS p ON p.id = ri.profile_id
+WHERE ri.rule_type_id = $1
+ORDER BY p.name, ri.entity_type, ri.name
+FOR UPDATE OF ri
+`
+
+type ListRuleInstancesForRuleTypeRow struct {
+	ID          uuid.UUID       `json:"id"`
+	ProfileID   uuid.UUID       `json:"profile_id"`
+	Name        string          `json:"name"`
+	EntityType  Entities        `json:"entity_type"`
+	Def         json.RawMessage `json:"def"`
+	Params      json.RawMessage `json:"params"`
+	ProjectID   uuid.UUID       `json:"project_id"`
+	ProfileName string          `json:"profile_name"`
+}
+
+func (q *Queries) ListRuleInstancesForRuleType(ctx context.Context, ruleTypeID uuid.UUID) ([]ListRuleInstancesForRuleTypeRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRuleInstancesForRuleType, ruleTypeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListRuleInstancesForRuleTypeRow{}
+	for rows.Next() {
+		var i ListRuleInstancesForRuleTypeRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProfileID,
+			&i.Name,
+			&i.EntityType,
+			&i.Def,
+			&i.Params,
+			&i.ProjectID,
+			&i.ProfileName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateRuleInstanceDefinition = `-- name: UpdateRuleInstanceDefinition :exec
+UPDATE rule_instances
+SET def = $2, params = $3, updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateRuleInstanceDefinitionParams struct {
+	ID     uuid.UUID       `json:"id"`
+	Def    json.RawMessage `json:"def"`
+	Params json.RawMessage `json:"params"`
+}
+
+func (q *Queries) UpdateRuleInstanceDefinition(ctx context.Context, arg UpdateRuleInstanceDefinitionParams) error {
+	_, err := q.db.ExecContext(ctx, updateRuleInstanceDefinition, arg.ID, arg.Def, arg.Params)
+	return err
+}
+
 const upsertRuleInstance = `-- name: UpsertRuleInstance :one
 
 INSERT INTO rule_instances (
@@ -262,6 +262,49 @@ func (q *Queries) ListRuleTypesByProject(ctx context.Context, projectID uuid.UUI
 	return items, nil
 }
 
+const listRuleTypesBySubscription = `-- name: ListRuleTypesBySubscription :many
+SELECT id, name, provider, project_id, description, guidance, definition, created_at, updated_at, severity_value, provider_id, subscription_id, display_name, release_phase, short_failure_message FROM rule_type WHERE subscription_id = $1
+`
+
+func (q *Queries) ListRuleTypesBySubscription(ctx context.Context, subscriptionID uuid.NullUUID) ([]RuleType, error) {
+	rows, err := q.db.QueryContext(ctx, listRuleTypesBySubscription, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RuleType{}
+	for rows.Next() {
+		var i RuleType
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Provider,
+			&i.ProjectID,
+			&i.Description,
+			&i.Guidance,
+			&i.Definition,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SeverityValue,
+			&i.ProviderID,
+			&i.SubscriptionID,
+			&i.DisplayName,
+			&i.ReleasePhase,
+			&i.ShortFailureMessage,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateRuleType = `-- name: UpdateRuleType :one
 UPDATE rule_type
     SET description = $2, definition = $3::jsonb, severity_value = $4, display_name = $5, release_phase = $6, short_failure_message = $7
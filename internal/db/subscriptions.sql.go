@@ -9,6 +9,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const createSubscription = `-- name: CreateSubscription :one
@@ -37,6 +38,15 @@ func (q *Queries) CreateSubscription(ctx context.Context, arg CreateSubscription
 	return i, err
 }
 
+const deleteSubscription = `-- name: DeleteSubscription :exec
+DELETE FROM subscriptions WHERE id = $1
+`
+
+func (q *Queries) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteSubscription, id)
+	return err
+}
+
 const getBundle = `-- name: GetBundle :one
 SELECT id, namespace, name FROM bundles WHERE namespace = $1 AND name = $2
 `
@@ -77,6 +87,55 @@ func (q *Queries) GetSubscriptionByProjectBundle(ctx context.Context, arg GetSub
 	return i, err
 }
 
+const listSubscriptionsByProjects = `-- name: ListSubscriptionsByProjects :many
+SELECT su.id, su.project_id, su.bundle_id, su.current_version, bu.namespace, bu.name, pr.name AS project_name FROM subscriptions AS su
+JOIN bundles AS bu ON bu.id = su.bundle_id
+JOIN projects AS pr ON pr.id = su.project_id
+WHERE su.project_id = ANY($1::uuid[])
+ORDER BY pr.name, bu.namespace, bu.name
+`
+
+type ListSubscriptionsByProjectsRow struct {
+	ID             uuid.UUID `json:"id"`
+	ProjectID      uuid.UUID `json:"project_id"`
+	BundleID       uuid.UUID `json:"bundle_id"`
+	CurrentVersion string    `json:"current_version"`
+	Namespace      string    `json:"namespace"`
+	Name           string    `json:"name"`
+	ProjectName    string    `json:"project_name"`
+}
+
+func (q *Queries) ListSubscriptionsByProjects(ctx context.Context, projects []uuid.UUID) ([]ListSubscriptionsByProjectsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSubscriptionsByProjects, pq.Array(projects))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSubscriptionsByProjectsRow{}
+	for rows.Next() {
+		var i ListSubscriptionsByProjectsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.BundleID,
+			&i.CurrentVersion,
+			&i.Namespace,
+			&i.Name,
+			&i.ProjectName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const setSubscriptionBundleVersion = `-- name: SetSubscriptionBundleVersion :exec
 UPDATE subscriptions SET current_version = $2 WHERE project_id = $1
 `
@@ -91,6 +150,20 @@ func (q *Queries) SetSubscriptionBundleVersion(ctx context.Context, arg SetSubsc
 	return err
 }
 
+const setSubscriptionVersion = `-- name: SetSubscriptionVersion :exec
+UPDATE subscriptions SET current_version = $2 WHERE id = $1
+`
+
+type SetSubscriptionVersionParams struct {
+	ID             uuid.UUID `json:"id"`
+	CurrentVersion string    `json:"current_version"`
+}
+
+func (q *Queries) SetSubscriptionVersion(ctx context.Context, arg SetSubscriptionVersionParams) error {
+	_, err := q.db.ExecContext(ctx, setSubscriptionVersion, arg.ID, arg.CurrentVersion)
+	return err
+}
+
 const upsertBundle = `-- name: UpsertBundle :exec
 
 
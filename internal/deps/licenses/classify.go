@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package licenses classifies license texts into SPDX license identifiers
+package licenses
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// spdxIdentifierRe matches SPDX short-form identifiers, e.g. in source file headers
+var spdxIdentifierRe = regexp.MustCompile(`SPDX-License-Identifier:\s*([^\r\n*]+)`)
+
+// nonWordRe matches everything we ignore when comparing license texts
+var nonWordRe = regexp.MustCompile(`[^a-z0-9.]+`)
+
+// titleLines is the number of non-empty lines at the start of a license text
+// which are searched for its title
+const titleLines = 5
+
+// licenseSignature identifies a license by its title or, for licenses whose
+// text has no title, by phrases that must all appear in its text
+type licenseSignature struct {
+	id string
+	// title is the name and version of the license as stated in the first
+	// lines of its text. Only the title is matched because the body of
+	// many licenses (e.g. the GPL family) refers to other licenses.
+	title string
+	// phrases are matched anywhere in the text if the license has no title
+	phrases []string
+	// unless are phrases of more specific licenses sharing the same phrases
+	unless []string
+}
+
+var signatures = []licenseSignature{
+	{id: "AGPL-3.0", title: "gnu affero general public license version 3"},
+	{id: "LGPL-2.1", title: "gnu lesser general public license version 2.1"},
+	{id: "LGPL-3.0", title: "gnu lesser general public license version 3"},
+	{id: "LGPL-2.0", title: "gnu library general public license version 2"},
+	{id: "GPL-3.0", title: "gnu general public license version 3"},
+	{id: "GPL-2.0", title: "gnu general public license version 2"},
+	{id: "Apache-2.0", title: "apache license version 2.0"},
+	{id: "MPL-2.0", title: "mozilla public license version 2.0"},
+	{id: "EPL-2.0", title: "eclipse public license v 2.0"},
+	{id: "BSL-1.0", title: "boost software license version 1.0"},
+	{id: "CC0-1.0", title: "cc0 1.0 universal"},
+	{
+		id: "BSD-3-Clause",
+		phrases: []string{
+			"redistribution and use in source and binary forms",
+			"neither the name of",
+		},
+	},
+	{
+		id:      "BSD-2-Clause",
+		phrases: []string{"redistribution and use in source and binary forms"},
+		unless:  []string{"neither the name of"},
+	},
+	{id: "MIT", phrases: []string{"permission is hereby granted free of charge to any person obtaining a copy"}},
+	{id: "ISC", phrases: []string{"permission to use copy modify and or distribute this software for any purpose"}},
+	{id: "Unlicense", phrases: []string{"this is free and unencumbered software released into the public domain"}},
+}
+
+func init() {
+	for i := range signatures {
+		if signatures[i].title != "" {
+			signatures[i].title = normalize(signatures[i].title)
+		}
+		for j := range signatures[i].phrases {
+			signatures[i].phrases[j] = normalize(signatures[i].phrases[j])
+		}
+		for j := range signatures[i].unless {
+			signatures[i].unless[j] = normalize(signatures[i].unless[j])
+		}
+	}
+}
+
+// Classify returns the sorted SPDX identifiers of the licenses found in the
+// given text. SPDX-License-Identifier tags take precedence over the license
+// text. It returns an empty list if no license was recognized.
+func Classify(text string) []string {
+	var ids []string
+	for _, m := range spdxIdentifierRe.FindAllStringSubmatch(text, -1) {
+		ids = append(ids, parseExpression(m[1])...)
+	}
+
+	if len(ids) == 0 {
+		// Pad with spaces so that titles only match whole words, e.g.
+		// "version 2" doesn't match "version 2.1"
+		header := " " + normalize(firstLines(text, titleLines)) + " "
+		normalized := normalize(text)
+		for _, sig := range signatures {
+			if matchesSignature(header, normalized, &sig) {
+				ids = append(ids, sig.id)
+			}
+		}
+	}
+
+	slices.Sort(ids)
+	return slices.Compact(ids)
+}
+
+// parseExpression returns the license identifiers of an SPDX license expression,
+// e.g. "(MIT OR Apache-2.0) AND BSD-3-Clause"
+func parseExpression(expr string) []string {
+	var ids []string
+	afterWith := false
+	for _, tok := range strings.Fields(strings.NewReplacer("(", " ", ")", " ").Replace(expr)) {
+		switch strings.ToUpper(tok) {
+		case "AND", "OR":
+			continue
+		case "WITH":
+			// The next token is a license exception rather than a license
+			afterWith = true
+			continue
+		}
+		if afterWith {
+			afterWith = false
+			continue
+		}
+		ids = append(ids, tok)
+	}
+	return ids
+}
+
+func matchesSignature(header, normalized string, sig *licenseSignature) bool {
+	if sig.title != "" {
+		return strings.Contains(header, " "+sig.title+" ")
+	}
+	for _, p := range sig.phrases {
+		if !strings.Contains(normalized, p) {
+			return false
+		}
+	}
+	for _, p := range sig.unless {
+		if strings.Contains(normalized, p) {
+			return false
+		}
+	}
+	return true
+}
+
+// firstLines returns the first n non-empty lines of text
+func firstLines(text string, n int) string {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		lines = append(lines, line)
+		if len(lines) == n {
+			break
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func normalize(text string) string {
+	return strings.TrimSpace(nonWordRe.ReplaceAllString(strings.ToLower(text), " "))
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package licenses
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{
+			name:     "spdx identifier",
+			text:     "// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors\n// SPDX-License-Identifier: Apache-2.0\n",
+			expected: []string{"Apache-2.0"},
+		},
+		{
+			name:     "spdx expression",
+			text:     "/* SPDX-License-Identifier: (MIT OR GPL-2.0-only WITH Classpath-exception-2.0) */",
+			expected: []string{"GPL-2.0-only", "MIT"},
+		},
+		{
+			name: "mit text",
+			text: `MIT License
+
+Copyright (c) 2025 Example
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction.`,
+			expected: []string{"MIT"},
+		},
+		{
+			name: "apache text",
+			text: `                                 Apache License
+                           Version 2.0, January 2004
+                        http://www.apache.org/licenses/`,
+			expected: []string{"Apache-2.0"},
+		},
+		{
+			name: "bsd-3-clause text",
+			text: `Redistribution and use in source and binary forms, with or without
+modification, are permitted provided that the following conditions are met:
+3. Neither the name of the copyright holder nor the names of its
+   contributors may be used to endorse or promote products derived from
+   this software without specific prior written permission.`,
+			expected: []string{"BSD-3-Clause"},
+		},
+		{
+			name: "bsd-2-clause text",
+			text: `Redistribution and use in source and binary forms, with or without
+modification, are permitted provided that the following conditions are met:`,
+			expected: []string{"BSD-2-Clause"},
+		},
+		{
+			name: "lgpl is not gpl",
+			text: `GNU LESSER GENERAL PUBLIC LICENSE
+Version 3, 29 June 2007`,
+			expected: []string{"LGPL-3.0"},
+		},
+		{
+			name: "gpl-3.0 text",
+			text: `GNU GENERAL PUBLIC LICENSE
+Version 3, 29 June 2007`,
+			expected: []string{"GPL-3.0"},
+		},
+		{
+			name:     "unknown",
+			text:     "All rights reserved.",
+			expected: []string{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := Classify(tc.text)
+			if len(tc.expected) == 0 {
+				require.Empty(t, got)
+				return
+			}
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+// TestClassifyFullText classifies the full license texts in testdata, which
+// are named after their SPDX identifier
+func TestClassifyFullText(t *testing.T) {
+	t.Parallel()
+
+	files, err := filepath.Glob(filepath.Join("testdata", "*.txt"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		id := strings.TrimSuffix(filepath.Base(file), ".txt")
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+			text, err := os.ReadFile(file)
+			require.NoError(t, err)
+			require.Equal(t, []string{id}, Classify(string(text)))
+		})
+	}
+}
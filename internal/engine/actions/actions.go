@@ -170,8 +170,15 @@ func shouldRemediate(prevEvalFromDb *db.ListRuleEvaluationsByProfileIdRow, evalE
 		}
 		// Do nothing if the Remediation is something else other than skipped, i.e. pending, success, error, etc.
 		return engif.ActionCmdDoNothing
+	case db.EvalStatusTypesWaived:
+		// Case 5 - Evaluation failed, but the failure is waived -> Remediation should be OFF
+		// Once the waiver expires the evaluation fails again and the remediation is turned back ON
+		if db.RemediationStatusTypesSkipped != prevRemediation {
+			return engif.ActionCmdOff
+		}
+		return engif.ActionCmdDoNothing
 	case db.EvalStatusTypesSkipped:
-	case db.EvalStatusTypesPending:
+	case db.EvalStatusTypesPending, db.EvalStatusTypesTimeout:
 		return engif.ActionCmdDoNothing
 	}
 
@@ -228,8 +235,15 @@ func shouldAlert(
 		}
 		// We should do nothing if the Alert is already OFF
 		return engif.ActionCmdDoNothing
+	case db.EvalStatusTypesWaived:
+		// Case 6 - Evaluation failed, but the failure is waived -> Alert should be OFF
+		// Once the waiver expires the evaluation fails again and the alert is turned back ON
+		if db.AlertStatusTypesOff != prevAlert {
+			return engif.ActionCmdOff
+		}
+		return engif.ActionCmdDoNothing
 	case db.EvalStatusTypesSkipped:
-	case db.EvalStatusTypesPending:
+	case db.EvalStatusTypesPending, db.EvalStatusTypesTimeout:
 		return engif.ActionCmdDoNothing
 	}
 
@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package gh_ruleset provides the github repository ruleset remediation engine
+package gh_ruleset
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/google/go-github/v63/github"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	engerrors "github.com/mindersec/minder/internal/engine/errors"
+	"github.com/mindersec/minder/internal/engine/interfaces"
+	"github.com/mindersec/minder/internal/util"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/profiles/models"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	// RemediateType is the type of the ruleset remediation engine
+	RemediateType = "gh_ruleset"
+
+	// PatchTypeMerge applies the patch as a JSON merge patch (RFC 7396)
+	PatchTypeMerge = "merge"
+	// PatchTypeJSON applies the patch as a JSON patch (RFC 6902)
+	PatchTypeJSON = "json"
+
+	// PatchTemplateLimit is the maximum number of bytes for the patch template
+	PatchTemplateLimit = 4096
+)
+
+// GhRulesetRemediator keeps the status for a rule type that uses GH API to remediate repository rulesets
+type GhRulesetRemediator struct {
+	actionType    interfaces.ActionType
+	cli           provifv1.GitHub
+	name          string
+	patchType     string
+	patchTemplate *util.SafeTemplate
+	setting       models.ActionOpt
+}
+
+// NewGhRulesetRemediator creates a new remediation engine that uses the GitHub API for repository rulesets
+func NewGhRulesetRemediator(
+	actionType interfaces.ActionType,
+	ghr *pb.RuleType_Definition_Remediate_GhRulesetType,
+	cli provifv1.GitHub,
+	setting models.ActionOpt,
+) (*GhRulesetRemediator, error) {
+	if actionType == "" {
+		return nil, fmt.Errorf("action type cannot be empty")
+	}
+
+	if ghr.GetName() == "" {
+		return nil, fmt.Errorf("ruleset name cannot be empty")
+	}
+
+	patchType := ghr.GetPatchType()
+	switch patchType {
+	case "":
+		patchType = PatchTypeMerge
+	case PatchTypeMerge, PatchTypeJSON:
+	default:
+		return nil, fmt.Errorf("unknown patch type: %s", patchType)
+	}
+
+	patchTemplate, err := util.NewSafeTextTemplate(&ghr.Patch, "patch")
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse patch template: %w", err)
+	}
+
+	return &GhRulesetRemediator{
+		actionType:    actionType,
+		cli:           cli,
+		name:          ghr.GetName(),
+		patchType:     patchType,
+		patchTemplate: patchTemplate,
+		setting:       setting,
+	}, nil
+}
+
+// PatchTemplateParams is the parameters for the patch template
+type PatchTemplateParams struct {
+	// Entity is the entity to be evaluated
+	Entity any
+	// Profile are the parameters to be used in the template
+	Profile map[string]any
+	// Params are the rule parameters to be used in the template
+	Params map[string]any
+}
+
+// Class returns the action type of the remediation engine
+func (r *GhRulesetRemediator) Class() interfaces.ActionType {
+	return r.actionType
+}
+
+// Type returns the action subtype of the remediation engine
+func (*GhRulesetRemediator) Type() string {
+	return RemediateType
+}
+
+// GetOnOffState returns the alert action state read from the profile
+func (r *GhRulesetRemediator) GetOnOffState() models.ActionOpt {
+	return models.ActionOptOrDefault(r.setting, models.ActionOptOff)
+}
+
+// Do perform the remediation
+func (r *GhRulesetRemediator) Do(
+	ctx context.Context,
+	cmd interfaces.ActionCmd,
+	ent protoreflect.ProtoMessage,
+	params interfaces.ActionsParams,
+	_ *json.RawMessage,
+) (json.RawMessage, error) {
+	// Like branch protection, rulesets are remediated through REST calls
+	// and have no turn-off behavior, so only proceed with the remediation
+	// if the command is to turn on the action
+	if cmd != interfaces.ActionCmdOn {
+		return nil, engerrors.ErrActionSkipped
+	}
+
+	repo, ok := ent.(*pb.Repository)
+	if !ok {
+		return nil, fmt.Errorf("expected repository, got %T", ent)
+	}
+
+	current, err := r.findRuleset(ctx, repo.Owner, repo.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var patch bytes.Buffer
+	err = r.patchTemplate.Execute(ctx, &patch, &PatchTemplateParams{
+		Entity:  ent,
+		Profile: params.GetRule().Def,
+		Params:  params.GetRule().Params,
+	}, PatchTemplateLimit)
+	if err != nil {
+		return nil, fmt.Errorf("cannot execute patch template: %w", err)
+	}
+
+	zerolog.Ctx(ctx).Debug().Str("patch", patch.String()).Msg("patch")
+
+	var req *github.Ruleset
+	if current != nil {
+		req, err = patchRuleset(rulesetToRequest(current), r.patchType, patch.Bytes())
+	} else {
+		// this will create a new ruleset targeting branches, which is what
+		// the classic branch protection would cover
+		req, err = patchRuleset(&github.Ruleset{
+			Name:        r.name,
+			Target:      github.String("branch"),
+			Enforcement: "active",
+		}, r.patchType, patch.Bytes())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error patching ruleset: %w", err)
+	}
+	// the ruleset is looked up by name, renaming it would create
+	// a new one on the next remediation
+	req.Name = r.name
+
+	switch r.setting {
+	case models.ActionOptOn:
+		if current != nil {
+			_, err = r.cli.UpdateRuleset(ctx, repo.Owner, repo.Name, current.GetID(), req)
+		} else {
+			_, err = r.cli.CreateRuleset(ctx, repo.Owner, repo.Name, req)
+		}
+	case models.ActionOptDryRun:
+		err = dryRun(ctx, r.cli.GetBaseURL(), repo.Owner, repo.Name, current, req)
+	case models.ActionOptOff, models.ActionOptUnknown:
+		err = errors.New("unexpected action")
+	}
+	return nil, err
+}
+
+// findRuleset returns the repository ruleset managed by the remediation,
+// or nil if it doesn't exist yet
+func (r *GhRulesetRemediator) findRuleset(ctx context.Context, owner, repo string) (*github.Ruleset, error) {
+	rulesets, err := r.cli.ListRulesets(ctx, owner, repo)
+	if err != nil {
+		return nil, fmt.Errorf("error listing rulesets: %w", err)
+	}
+
+	for _, rs := range rulesets {
+		if rs.Name != r.name {
+			continue
+		}
+
+		// listing rulesets doesn't return their rules
+		ruleset, err := r.cli.GetRuleset(ctx, owner, repo, rs.GetID())
+		if err != nil {
+			return nil, fmt.Errorf("error getting ruleset: %w", err)
+		}
+		return ruleset, nil
+	}
+
+	return nil, nil
+}
+
+func dryRun(ctx context.Context, baseUrl, owner, repo string, current, req *github.Ruleset) error {
+	jsonReq, err := json.Marshal(req)
+	if err != nil {
+		// this should not be fatal
+		log.Err(err).Msg("Error marshalling data")
+		return fmt.Errorf("error marshalling data: %w", err)
+	}
+
+	method := http.MethodPost
+	endpoint := fmt.Sprintf("repos/%v/%v/rulesets", owner, repo)
+	if current != nil {
+		method = http.MethodPut
+		endpoint = fmt.Sprintf("%s/%d", endpoint, current.GetID())
+	}
+
+	curlCmd, err := util.GenerateCurlCommand(ctx, method, baseUrl, endpoint, string(jsonReq))
+	if err != nil {
+		return fmt.Errorf("cannot generate curl command: %w", err)
+	}
+
+	log.Printf("run the following curl command: \n%s\n", curlCmd)
+	return nil
+}
+
+func patchRuleset(req *github.Ruleset, patchType string, patch []byte) (*github.Ruleset, error) {
+	jReq, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling request: %w", err)
+	}
+
+	var patchedBytes []byte
+	switch patchType {
+	case PatchTypeJSON:
+		p, err := jsonpatch.DecodePatch(patch)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding patch: %w", err)
+		}
+		patchedBytes, err = p.Apply(jReq)
+		if err != nil {
+			return nil, fmt.Errorf("error applying patch: %w", err)
+		}
+	default:
+		patchedBytes, err = jsonpatch.MergePatch(jReq, patch)
+		if err != nil {
+			return nil, fmt.Errorf("error merging patch: %w", err)
+		}
+	}
+
+	patched := &github.Ruleset{}
+	err = json.Unmarshal(patchedBytes, patched)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling patched request: %w", err)
+	}
+
+	return patched, nil
+}
+
+// rulesetToRequest drops the read-only fields of a ruleset, which
+// the API refuses when creating or updating it
+func rulesetToRequest(res *github.Ruleset) *github.Ruleset {
+	return &github.Ruleset{
+		Name:         res.Name,
+		Target:       res.Target,
+		Enforcement:  res.Enforcement,
+		BypassActors: res.BypassActors,
+		Conditions:   res.Conditions,
+		Rules:        res.Rules,
+	}
+}
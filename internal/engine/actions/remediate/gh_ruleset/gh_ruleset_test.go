@@ -0,0 +1,277 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gh_ruleset
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	engerrors "github.com/mindersec/minder/internal/engine/errors"
+	"github.com/mindersec/minder/internal/engine/interfaces"
+	mock_ghclient "github.com/mindersec/minder/internal/providers/github/mock"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+const (
+	rulesetName = "minder"
+
+	repoOwner = "mindersec"
+	repoName  = "minder"
+
+	reviewCountMergePatch = `{
+  "rules": [
+    {"type": "pull_request", "parameters": {
+      "required_approving_review_count": {{ .Profile.required_approving_review_count }},
+      "dismiss_stale_reviews_on_push": false,
+      "require_code_owner_review": false,
+      "require_last_push_approval": false,
+      "required_review_thread_resolution": false
+    }}
+  ]
+}`
+	deletionJSONPatch = `[{"op": "add", "path": "/rules/-", "value": {"type": "deletion"}}]`
+)
+
+var testActionType interfaces.ActionType = "remediate-test"
+
+// rulesetMatcher compares rulesets by their JSON representation, as the
+// rules keep their parameters as raw JSON
+type rulesetMatcher struct {
+	exp *github.Ruleset
+}
+
+func (m *rulesetMatcher) Matches(x any) bool {
+	rs, ok := x.(*github.Ruleset)
+	if !ok {
+		return false
+	}
+	got, err := json.Marshal(rs)
+	if err != nil {
+		return false
+	}
+	exp, err := json.Marshal(m.exp)
+	if err != nil {
+		return false
+	}
+	var gotAny, expAny any
+	if json.Unmarshal(got, &gotAny) != nil || json.Unmarshal(exp, &expAny) != nil {
+		return false
+	}
+	return gomock.Eq(expAny).Matches(gotAny)
+}
+
+func (m *rulesetMatcher) String() string {
+	exp, _ := json.Marshal(m.exp)
+	return "is equivalent to ruleset " + string(exp)
+}
+
+func eqRuleset(exp *github.Ruleset) gomock.Matcher {
+	return &rulesetMatcher{exp}
+}
+
+func pullRequestRule(count int) *github.RepositoryRule {
+	return github.NewPullRequestRule(&github.PullRequestRuleParameters{
+		RequiredApprovingReviewCount: count,
+	})
+}
+
+func existingRuleset() *github.Ruleset {
+	return &github.Ruleset{
+		ID:          github.Int64(42),
+		Name:        rulesetName,
+		Target:      github.String("branch"),
+		SourceType:  github.String("Repository"),
+		Source:      repoOwner + "/" + repoName,
+		Enforcement: "active",
+		NodeID:      github.String("RRS_42"),
+		Conditions: &github.RulesetConditions{
+			RefName: &github.RulesetRefConditionParameters{
+				Include: []string{"~DEFAULT_BRANCH"},
+				Exclude: []string{},
+			},
+		},
+		Rules: []*github.RepositoryRule{pullRequestRule(1)},
+	}
+}
+
+func TestRulesetRemediate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		ghr       *pb.RuleType_Definition_Remediate_GhRulesetType
+		setting   models.ActionOpt
+		cmd       interfaces.ActionCmd
+		mockSetup func(*mock_ghclient.MockGitHub)
+		wantErr   error
+	}{
+		{
+			name: "no ruleset was in place",
+			ghr: &pb.RuleType_Definition_Remediate_GhRulesetType{
+				Name:  rulesetName,
+				Patch: reviewCountMergePatch,
+			},
+			setting: models.ActionOptOn,
+			cmd:     interfaces.ActionCmdOn,
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					ListRulesets(gomock.Any(), repoOwner, repoName).
+					Return([]*github.Ruleset{{ID: github.Int64(7), Name: "other"}}, nil)
+				mockGitHub.EXPECT().
+					CreateRuleset(gomock.Any(), repoOwner, repoName, eqRuleset(&github.Ruleset{
+						Name:        rulesetName,
+						Target:      github.String("branch"),
+						Enforcement: "active",
+						Rules:       []*github.RepositoryRule{pullRequestRule(2)},
+					})).
+					Return(&github.Ruleset{}, nil)
+			},
+		},
+		{
+			name: "ruleset was in place, remediator merges the patch",
+			ghr: &pb.RuleType_Definition_Remediate_GhRulesetType{
+				Name:  rulesetName,
+				Patch: reviewCountMergePatch,
+			},
+			setting: models.ActionOptOn,
+			cmd:     interfaces.ActionCmdOn,
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					ListRulesets(gomock.Any(), repoOwner, repoName).
+					Return([]*github.Ruleset{{ID: github.Int64(42), Name: rulesetName}}, nil)
+				mockGitHub.EXPECT().
+					GetRuleset(gomock.Any(), repoOwner, repoName, int64(42)).
+					Return(existingRuleset(), nil)
+
+				expected := rulesetToRequest(existingRuleset())
+				expected.Rules = []*github.RepositoryRule{pullRequestRule(2)}
+				mockGitHub.EXPECT().
+					UpdateRuleset(gomock.Any(), repoOwner, repoName, int64(42), eqRuleset(expected)).
+					Return(&github.Ruleset{}, nil)
+			},
+		},
+		{
+			name: "ruleset was in place, remediator applies the JSON patch",
+			ghr: &pb.RuleType_Definition_Remediate_GhRulesetType{
+				Name:      rulesetName,
+				Patch:     deletionJSONPatch,
+				PatchType: PatchTypeJSON,
+			},
+			setting: models.ActionOptOn,
+			cmd:     interfaces.ActionCmdOn,
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					ListRulesets(gomock.Any(), repoOwner, repoName).
+					Return([]*github.Ruleset{{ID: github.Int64(42), Name: rulesetName}}, nil)
+				mockGitHub.EXPECT().
+					GetRuleset(gomock.Any(), repoOwner, repoName, int64(42)).
+					Return(existingRuleset(), nil)
+
+				expected := rulesetToRequest(existingRuleset())
+				expected.Rules = append(expected.Rules, github.NewDeletionRule())
+				mockGitHub.EXPECT().
+					UpdateRuleset(gomock.Any(), repoOwner, repoName, int64(42), eqRuleset(expected)).
+					Return(&github.Ruleset{}, nil)
+			},
+		},
+		{
+			name: "dry run does not change the ruleset",
+			ghr: &pb.RuleType_Definition_Remediate_GhRulesetType{
+				Name:  rulesetName,
+				Patch: reviewCountMergePatch,
+			},
+			setting: models.ActionOptDryRun,
+			cmd:     interfaces.ActionCmdOn,
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					ListRulesets(gomock.Any(), repoOwner, repoName).
+					Return(nil, nil)
+				mockGitHub.EXPECT().
+					GetBaseURL().
+					Return("https://api.github.com/")
+			},
+		},
+		{
+			name: "error listing rulesets",
+			ghr: &pb.RuleType_Definition_Remediate_GhRulesetType{
+				Name:  rulesetName,
+				Patch: reviewCountMergePatch,
+			},
+			setting: models.ActionOptOn,
+			cmd:     interfaces.ActionCmdOn,
+			mockSetup: func(mockGitHub *mock_ghclient.MockGitHub) {
+				mockGitHub.EXPECT().
+					ListRulesets(gomock.Any(), repoOwner, repoName).
+					Return(nil, errors.New("boom"))
+			},
+			wantErr: errors.New("boom"),
+		},
+		{
+			name: "turning off is skipped",
+			ghr: &pb.RuleType_Definition_Remediate_GhRulesetType{
+				Name:  rulesetName,
+				Patch: reviewCountMergePatch,
+			},
+			setting:   models.ActionOptOn,
+			cmd:       interfaces.ActionCmdOff,
+			mockSetup: func(_ *mock_ghclient.MockGitHub) {},
+			wantErr:   engerrors.ErrActionSkipped,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockClient := mock_ghclient.NewMockGitHub(ctrl)
+			tt.mockSetup(mockClient)
+
+			engine, err := NewGhRulesetRemediator(testActionType, tt.ghr, mockClient, tt.setting)
+			require.NoError(t, err)
+
+			evalParams := &interfaces.EvalStatusParams{
+				Rule: &models.RuleInstance{
+					Def: map[string]any{
+						"required_approving_review_count": 2,
+					},
+				},
+			}
+
+			_, err = engine.Do(context.Background(), tt.cmd,
+				&pb.Repository{Owner: repoOwner, Name: repoName}, evalParams, nil)
+			if tt.wantErr != nil {
+				require.ErrorContains(t, err, tt.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNewRulesetRemediatorInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewGhRulesetRemediator("", &pb.RuleType_Definition_Remediate_GhRulesetType{
+		Name: rulesetName, Patch: "{}",
+	}, nil, models.ActionOptOn)
+	require.Error(t, err)
+
+	_, err = NewGhRulesetRemediator(testActionType, &pb.RuleType_Definition_Remediate_GhRulesetType{
+		Patch: "{}",
+	}, nil, models.ActionOptOn)
+	require.Error(t, err)
+
+	_, err = NewGhRulesetRemediator(testActionType, &pb.RuleType_Definition_Remediate_GhRulesetType{
+		Name: rulesetName, Patch: "{}", PatchType: "strategic",
+	}, nil, models.ActionOptOn)
+	require.Error(t, err)
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/mindersec/minder/internal/providers/github/clients"
 	mockghclient "github.com/mindersec/minder/internal/providers/github/mock"
 	"github.com/mindersec/minder/internal/providers/github/properties"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	"github.com/mindersec/minder/internal/providers/ratecache"
 	"github.com/mindersec/minder/internal/providers/telemetry"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -94,7 +95,7 @@ func testGithubProvider() (provifv1.GitHub, error) {
 		nil,
 		&ratecache.NoopRestClientCache{},
 		credentials.NewGitHubTokenCredential("token"),
-		clients.NewGitHubClientFactory(telemetry.NewNoopMetrics()),
+		clients.NewGitHubClientFactory(telemetry.NewNoopMetrics(), &ratebudget.NoopTracker{}),
 		properties.NewPropertyFetcherFactory(),
 		"",
 	)
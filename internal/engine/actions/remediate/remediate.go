@@ -10,6 +10,7 @@ import (
 	"fmt"
 
 	"github.com/mindersec/minder/internal/engine/actions/remediate/gh_branch_protect"
+	"github.com/mindersec/minder/internal/engine/actions/remediate/gh_ruleset"
 	"github.com/mindersec/minder/internal/engine/actions/remediate/noop"
 	"github.com/mindersec/minder/internal/engine/actions/remediate/pull_request"
 	"github.com/mindersec/minder/internal/engine/actions/remediate/rest"
@@ -56,6 +57,17 @@ func NewRuleRemediator(
 		return gh_branch_protect.NewGhBranchProtectRemediator(
 			ActionType, remediate.GetGhBranchProtection(), client, setting)
 
+	case gh_ruleset.RemediateType:
+		client, err := provinfv1.As[provinfv1.GitHub](provider)
+		if err != nil {
+			return nil, errors.New("provider does not implement github trait")
+		}
+		if remediate.GetGhRuleset() == nil {
+			return nil, fmt.Errorf("remediations engine missing gh_ruleset configuration")
+		}
+		return gh_ruleset.NewGhRulesetRemediator(
+			ActionType, remediate.GetGhRuleset(), client, setting)
+
 	case pull_request.RemediateType:
 		client, err := provinfv1.As[provinfv1.GitHub](provider)
 		if err != nil {
@@ -135,6 +135,41 @@ func NewErrEvaluationSkipSilently(sfmt string, args ...any) error {
 	return fmt.Errorf("%w: %s", ErrEvaluationSkipSilently, msg)
 }
 
+// ErrEvaluationTimeout specifies that the evaluation was aborted because it exceeded
+// its time limit.
+var ErrEvaluationTimeout = errors.New("evaluation timed out")
+
+// NewErrEvaluationTimeout creates a new evaluation timeout error
+func NewErrEvaluationTimeout(sfmt string, args ...any) error {
+	msg := fmt.Sprintf(sfmt, args...)
+	return fmt.Errorf("%w: %s", ErrEvaluationTimeout, msg)
+}
+
+// ErrEvaluationBudgetExceeded specifies that the evaluation was aborted because
+// it exceeded one of its resource budgets, e.g. its number of data source calls.
+// It is recorded as an error rather than a timeout, as retrying won't help.
+var ErrEvaluationBudgetExceeded = errors.New("evaluation exceeded its resource budget")
+
+// NewErrEvaluationBudgetExceeded creates a new evaluation budget exceeded error
+func NewErrEvaluationBudgetExceeded(sfmt string, args ...any) error {
+	msg := fmt.Sprintf(sfmt, args...)
+	return fmt.Errorf("%w: %s", ErrEvaluationBudgetExceeded, msg)
+}
+
+// ErrEvaluationWaived specifies that the rule failed, but that the failure was
+// accepted by a waiver for the evaluated entity.
+var ErrEvaluationWaived = errors.New("evaluation failure waived")
+
+// NewErrEvaluationWaived creates a new evaluation error replacing a failure
+// covered by a waiver
+func NewErrEvaluationWaived(sfmt string, args ...any) error {
+	msg := fmt.Sprintf(sfmt, args...)
+	return &EvaluationError{
+		Base: ErrEvaluationWaived,
+		Msg:  msg,
+	}
+}
+
 // ErrActionSkipped is an error code that indicates that the action was not performed at all because
 // the evaluation passed and the action was not needed
 var ErrActionSkipped = errors.New("action skipped")
@@ -184,6 +219,10 @@ func ErrorAsEvalStatus(err error) db.EvalStatusTypes {
 		return db.EvalStatusTypesFailure
 	} else if errors.Is(err, interfaces.ErrEvaluationSkipped) {
 		return db.EvalStatusTypesSkipped
+	} else if errors.Is(err, ErrEvaluationTimeout) {
+		return db.EvalStatusTypesTimeout
+	} else if errors.Is(err, ErrEvaluationWaived) {
+		return db.EvalStatusTypesWaived
 	} else if err != nil {
 		return db.EvalStatusTypesError
 	}
@@ -4,10 +4,14 @@
 package errors
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/db"
 )
 
 func TestLegacyEvaluationDetailRendering(t *testing.T) {
@@ -121,3 +125,37 @@ func TestEvaluationDetailRendering(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorAsEvalStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		err    error
+		status db.EvalStatusTypes
+	}{
+		{name: "success", err: nil, status: db.EvalStatusTypesSuccess},
+		{name: "failure", err: NewErrEvaluationFailed("denied"), status: db.EvalStatusTypesFailure},
+		{name: "skipped", err: NewErrEvaluationSkipped("not applicable"), status: db.EvalStatusTypesSkipped},
+		{name: "timeout", err: NewErrEvaluationTimeout("took too long"), status: db.EvalStatusTypesTimeout},
+		{
+			name:   "wrapped timeout",
+			err:    fmt.Errorf("error ingesting data: %w", NewErrEvaluationTimeout("took too long")),
+			status: db.EvalStatusTypesTimeout,
+		},
+		{
+			name:   "budget exceeded",
+			err:    NewErrEvaluationBudgetExceeded("exceeded the limit of 10 data source calls"),
+			status: db.EvalStatusTypesError,
+		},
+		{name: "waived", err: NewErrEvaluationWaived("accepted risk"), status: db.EvalStatusTypesWaived},
+		{name: "error", err: errors.New("boom"), status: db.EvalStatusTypesError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.status, ErrorAsEvalStatus(tt.err))
+		})
+	}
+}
@@ -21,15 +21,18 @@ func (e *Evaluator) RegisterDataSources(dsr *v1datasources.DataSourceRegistry) {
 }
 
 // buildDataSourceOptions creates an options set from the functions available in
-// a data source registry.
-func buildDataSourceOptions(res *interfaces.Ingested, dsr *v1datasources.DataSourceRegistry) []func(*rego.Rego) {
+// a data source registry. The calls to the functions count against the budget
+// of the evaluation.
+func buildDataSourceOptions(
+	res *interfaces.Ingested, dsr *v1datasources.DataSourceRegistry, budget *evalBudget,
+) []func(*rego.Rego) {
 	opts := []func(*rego.Rego){}
 	if dsr == nil {
 		return opts
 	}
 
 	for key, dsf := range dsr.GetFuncs() {
-		opts = append(opts, buildFromDataSource(res, key, dsf))
+		opts = append(opts, buildFromDataSource(res, key, dsf, budget))
 	}
 
 	return opts
@@ -40,6 +43,7 @@ func buildDataSourceOptions(res *interfaces.Ingested, dsr *v1datasources.DataSou
 // register the function with the rego engine.
 func buildFromDataSource(
 	res *interfaces.Ingested, key v1datasources.DataSourceFuncKey, dsf v1datasources.DataSourceFuncDef,
+	budget *evalBudget,
 ) func(*rego.Rego) {
 	k := normalizeKey(key)
 	return rego.Function1(
@@ -59,6 +63,10 @@ func buildFromDataSource(
 				return nil, err
 			}
 
+			if err := budget.countDataSourceCall(); err != nil {
+				return nil, err
+			}
+
 			ret, err := dsf.Call(bctx.Context, res, jsonObj)
 			if err != nil {
 				return nil, err
@@ -11,10 +11,13 @@ import (
 
 	"github.com/open-policy-agent/opa/v1/ast"
 	"github.com/open-policy-agent/opa/v1/rego"
+	"github.com/open-policy-agent/opa/v1/topdown"
 	"github.com/open-policy-agent/opa/v1/topdown/print"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/structpb"
 
+	"github.com/mindersec/minder/internal/engine/eval/vulncheck"
+	"github.com/mindersec/minder/internal/engine/limits"
 	eoptions "github.com/mindersec/minder/internal/engine/options"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
@@ -45,6 +48,10 @@ type Evaluator struct {
 	regoOpts     []func(*rego.Rego)
 	reseval      resultEvaluator
 	datasources  *v1datasources.DataSourceRegistry
+	packages     PackageDataSource
+	offline      bool
+	limits       limits.Limits
+	tracers      []topdown.QueryTracer
 }
 
 // Input is the input for the rego evaluator
@@ -83,8 +90,9 @@ func NewRegoEvaluator(
 	re := c.getEvalType()
 
 	eval := &Evaluator{
-		cfg:     c,
-		reseval: re,
+		cfg:      c,
+		reseval:  re,
+		packages: vulncheck.NewPackageLookup(),
 		regoOpts: []func(*rego.Rego){
 			rego.Query(RegoQueryPrefix),
 			rego.Module(MinderRegoFile, c.Def),
@@ -110,6 +118,8 @@ func NewRegoEvaluator(
 }
 
 var _ eoptions.SupportsFlags = (*Evaluator)(nil)
+var _ eoptions.SupportsOfflineMode = (*Evaluator)(nil)
+var _ eoptions.SupportsLimits = (*Evaluator)(nil)
 
 func (e *Evaluator) newRegoFromOptions(opts ...func(*rego.Rego)) *rego.Rego {
 	return rego.New(append(e.regoOpts, opts...)...)
@@ -121,6 +131,12 @@ func (e *Evaluator) SetFlagsClient(client flags.Interface) error {
 	return nil
 }
 
+// SetOfflineMode implements the SupportsOfflineMode interface. In offline mode,
+// the functions querying package data and http.send fail.
+func (e *Evaluator) SetOfflineMode(offline bool) {
+	e.offline = offline
+}
+
 // SetShortFailureMessage sets the short failure message for deny-by-default evaluations.
 func (e *Evaluator) SetShortFailureMessage(msg string) error {
 	if dbd, ok := e.reseval.(*denyByDefaultEvaluator); ok {
@@ -138,6 +154,10 @@ func (e *Evaluator) Eval(
 	// this explicitly.
 	obj := res.Object
 
+	// Abort the evaluation if it exceeds its resource budget
+	ctx, budget, done := newEvalBudget(ctx, e.limits)
+	defer done()
+
 	// Register options to expose functions
 	regoFuncOptions := []func(*rego.Rego){
 		// TODO: figure out a Rego V1 migration path (https://github.com/mindersec/minder/issues/5262)
@@ -147,8 +167,18 @@ func (e *Evaluator) Eval(
 	// Initialize the built-in minder library rego functions
 	regoFuncOptions = append(regoFuncOptions, instantiateRegoLib(ctx, e.featureFlags, res)...)
 
+	// Expose the functions querying package registries and vulnerability databases
+	regoFuncOptions = append(regoFuncOptions, packageLib(e.packages, e.offline)...)
+
 	// If the evaluator has data sources defined, expose their functions
-	regoFuncOptions = append(regoFuncOptions, buildDataSourceOptions(res, e.datasources)...)
+	regoFuncOptions = append(regoFuncOptions, buildDataSourceOptions(res, e.datasources, budget)...)
+
+	// Capture the print output and trace of the policy if the evaluation is explained
+	var exp *explainer
+	if explanation := explanationFromContext(ctx); explanation != nil {
+		exp = newExplainer(explanation)
+		regoFuncOptions = append(regoFuncOptions, exp.regoOptions()...)
+	}
 
 	// Create the rego object
 	r := e.newRegoFromOptions(
@@ -167,7 +197,26 @@ func (e *Evaluator) Eval(
 	}
 
 	enrichInputWithEntityProps(input, entity)
-	rs, err := pq.Eval(ctx, rego.EvalInput(input), rego.EvalHTTPRoundTripper(LimitedDialer))
+	roundTripper := LimitedDialer
+	if e.offline {
+		roundTripper = offlineRoundTripper
+	}
+	evalOpts := []rego.EvalOption{rego.EvalInput(input), rego.EvalHTTPRoundTripper(roundTripper)}
+	if budget.tracesInstructions() {
+		evalOpts = append(evalOpts, rego.EvalQueryTracer(budget))
+	}
+	for _, tracer := range e.tracers {
+		evalOpts = append(evalOpts, rego.EvalQueryTracer(tracer))
+	}
+	if exp != nil {
+		exp.setInput(input)
+		evalOpts = append(evalOpts, rego.EvalQueryTracer(exp.tracer))
+		defer exp.finish()
+	}
+	rs, err := pq.Eval(ctx, evalOpts...)
+	if exceededErr := budget.exceeded(ctx); exceededErr != nil {
+		return nil, exceededErr
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error evaluating profile. Might be wrong input: %w", err)
 	}
@@ -204,3 +253,27 @@ func WithShortFailureMessage(msg string) interfaces.Option {
 		return nil
 	}
 }
+
+// WithPackageDataSource returns an Option that sets the source of the package data
+// queried by the osv.query and pkg.latest functions. By default, they query the
+// vulnerability databases and package registries used by the vulncheck evaluator.
+func WithPackageDataSource(source PackageDataSource) interfaces.Option {
+	return func(eval interfaces.Evaluator) error {
+		if e, ok := eval.(*Evaluator); ok {
+			e.packages = source
+		}
+		return nil
+	}
+}
+
+// WithQueryTracer returns an Option that traces the evaluation of the policy,
+// e.g. to find out which of its rules were evaluated. The tracer is called by
+// every evaluation, so it must be safe for concurrent use.
+func WithQueryTracer(tracer topdown.QueryTracer) interfaces.Option {
+	return func(eval interfaces.Evaluator) error {
+		if e, ok := eval.(*Evaluator); ok {
+			e.tracers = append(e.tracers, tracer)
+		}
+		return nil
+	}
+}
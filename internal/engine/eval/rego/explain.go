@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rego
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/rego"
+	"github.com/open-policy-agent/opa/v1/topdown"
+	"github.com/open-policy-agent/opa/v1/topdown/print"
+)
+
+// redactedValue replaces the sensitive values of the input of an explained evaluation
+const redactedValue = "REDACTED"
+
+// sensitiveKeys are the suffixes of the keys of the input whose values are
+// redacted, e.g. access_token or client_secret
+var sensitiveKeys = []string{"token", "secret", "password", "credential", "authorization", "private_key", "api_key"}
+
+// Explanation describes how a policy came to its result, to help policy
+// authors debug evaluations
+type Explanation struct {
+	// Prints is the output of the print statements of the policy, with the
+	// sensitive values of the input redacted
+	Prints []string `json:"prints,omitempty"`
+	// Rules are the rules of the policy that were evaluated
+	Rules []*ExplainedRule `json:"rules,omitempty"`
+	// Trace is the trace of the evaluation, one expression per line, with
+	// the sensitive values of the input redacted
+	Trace []string `json:"trace,omitempty"`
+	// Input is the input the policy was evaluated with, with its sensitive
+	// values redacted
+	Input map[string]any `json:"input,omitempty"`
+}
+
+// ExplainedRule is a rule of the policy that was evaluated
+type ExplainedRule struct {
+	// Name is the name of the rule, e.g. deny or allow
+	Name string `json:"name"`
+	// Location is the location of the rule in the policy
+	Location string `json:"location"`
+	// Matched is true if the body of the rule was satisfied at least once
+	Matched bool `json:"matched"`
+}
+
+type explanationKey struct{}
+
+// WithExplanation returns a context which makes the Rego evaluations running
+// with it fill the given explanation. Explaining an evaluation has a cost, so
+// it is only meant to be used when debugging policies.
+func WithExplanation(ctx context.Context, exp *Explanation) context.Context {
+	return context.WithValue(ctx, explanationKey{}, exp)
+}
+
+func explanationFromContext(ctx context.Context) *Explanation {
+	exp, _ := ctx.Value(explanationKey{}).(*Explanation)
+	return exp
+}
+
+// explainer collects the explanation of a single evaluation
+type explainer struct {
+	exp    *Explanation
+	tracer *topdown.BufferTracer
+	// secrets are the sensitive values of the input, which are redacted
+	// wherever they show up in the prints and the trace
+	secrets []string
+}
+
+var _ print.Hook = (*explainer)(nil)
+
+func newExplainer(exp *Explanation) *explainer {
+	return &explainer{
+		exp:    exp,
+		tracer: topdown.NewBufferTracer(),
+	}
+}
+
+// Print implements the print.Hook interface
+func (e *explainer) Print(pctx print.Context, msg string) error {
+	if pctx.Location != nil {
+		msg = fmt.Sprintf("%s: %s", pctx.Location, msg)
+	}
+	e.exp.Prints = append(e.exp.Prints, e.scrub(msg))
+	return nil
+}
+
+// regoOptions returns the options capturing the print output of the policy
+func (e *explainer) regoOptions() []func(*rego.Rego) {
+	return []func(*rego.Rego){
+		rego.EnablePrintStatements(true),
+		rego.PrintHook(e),
+	}
+}
+
+// setInput records the input of the evaluation, redacting its sensitive values
+func (e *explainer) setInput(input *Input) {
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return
+	}
+	var snapshot map[string]any
+	if err := json.Unmarshal(raw, &snapshot); err != nil {
+		return
+	}
+	redact(snapshot, &e.secrets)
+	// Replace the longest values first, in case one contains another
+	slices.SortFunc(e.secrets, func(a, b string) int { return len(b) - len(a) })
+	e.exp.Input = snapshot
+}
+
+// scrub replaces the sensitive values of the input found in the given text
+func (e *explainer) scrub(text string) string {
+	for _, secret := range e.secrets {
+		text = strings.ReplaceAll(text, secret, redactedValue)
+		// Values are quoted and escaped in the trace
+		if quoted, err := json.Marshal(secret); err == nil {
+			text = strings.ReplaceAll(text, string(quoted[1:len(quoted)-1]), redactedValue)
+		}
+	}
+	return text
+}
+
+// finish fills the explanation with the rules and trace of the evaluation
+func (e *explainer) finish() {
+	byLocation := map[string]*ExplainedRule{}
+	for _, evt := range *e.tracer {
+		rule, ok := evt.Node.(*ast.Rule)
+		if !ok || (evt.Op != topdown.EnterOp && evt.Op != topdown.ExitOp) {
+			continue
+		}
+		location := rule.Location.String()
+		explained, ok := byLocation[location]
+		if !ok {
+			explained = &ExplainedRule{
+				Name:     rule.Head.Ref().String(),
+				Location: location,
+			}
+			byLocation[location] = explained
+			e.exp.Rules = append(e.exp.Rules, explained)
+		}
+		if evt.Op == topdown.ExitOp {
+			explained.Matched = true
+		}
+	}
+
+	var buf bytes.Buffer
+	topdown.PrettyTraceWithLocation(&buf, *e.tracer)
+	if trace := strings.TrimRight(e.scrub(buf.String()), "\n"); trace != "" {
+		e.exp.Trace = strings.Split(trace, "\n")
+	}
+}
+
+// redact replaces the values of the sensitive keys of the given object,
+// adding the strings they contained to secrets
+func redact(obj map[string]any, secrets *[]string) {
+	for k, v := range obj {
+		if isSensitiveKey(k) {
+			collectStrings(v, secrets)
+			obj[k] = redactedValue
+			continue
+		}
+		redactValue(v, secrets)
+	}
+}
+
+func redactValue(v any, secrets *[]string) {
+	switch val := v.(type) {
+	case map[string]any:
+		redact(val, secrets)
+	case []any:
+		for _, item := range val {
+			redactValue(item, secrets)
+		}
+	}
+}
+
+// collectStrings adds the non-empty strings found in the given value to out
+func collectStrings(v any, out *[]string) {
+	switch val := v.(type) {
+	case string:
+		if val != "" {
+			*out = append(*out, val)
+		}
+	case map[string]any:
+		for _, item := range val {
+			collectStrings(item, out)
+		}
+	case []any:
+		for _, item := range val {
+			collectStrings(item, out)
+		}
+	}
+}
+
+func isSensitiveKey(k string) bool {
+	lower := strings.TrimSuffix(strings.ToLower(k), "s")
+	for _, s := range sensitiveKeys {
+		if strings.HasSuffix(lower, s) {
+			return true
+		}
+	}
+	return false
+}
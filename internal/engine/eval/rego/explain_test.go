@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rego_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/engine/eval/rego"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+func TestEvalExplanation(t *testing.T) {
+	t.Parallel()
+
+	e, err := rego.NewRegoEvaluator(
+		&minderv1.RuleType_Definition_Eval_Rego{
+			Type: rego.ConstraintsEvaluationType.String(),
+			Def: `
+package minder
+
+violations[{"msg": msg}] {
+	print("checking", input.ingested.name, input.profile.api_token)
+	token := input.ingested.config.client_secret
+	token != "foo"
+	input.ingested.name != "foo"
+	msg := "name is not foo"
+}
+
+violations[{"msg": msg}] {
+	input.ingested.private
+	msg := "repository is private"
+}
+`,
+		},
+	)
+	require.NoError(t, err)
+
+	var exp rego.Explanation
+	ctx := rego.WithExplanation(context.Background(), &exp)
+	_, err = e.Eval(ctx, map[string]any{"api_token": "s3cr3t"}, nil, &interfaces.Ingested{
+		Object: map[string]any{
+			"name":    "bar",
+			"private": false,
+			"config":  map[string]any{"client_secret": "s3cr3t", "secret_scanning": "enabled"},
+		},
+	})
+	require.ErrorIs(t, err, interfaces.ErrEvaluationFailed)
+
+	require.Len(t, exp.Prints, 1)
+	require.Contains(t, exp.Prints[0], "checking bar REDACTED")
+
+	require.Len(t, exp.Rules, 2)
+	require.True(t, exp.Rules[0].Matched)
+	require.Equal(t, "violations", exp.Rules[0].Name)
+	require.False(t, exp.Rules[1].Matched)
+	require.NotEmpty(t, exp.Trace)
+	for _, line := range exp.Trace {
+		require.NotContains(t, line, "s3cr3t")
+	}
+
+	require.Equal(t, "REDACTED", exp.Input["profile"].(map[string]any)["api_token"])
+	config := exp.Input["ingested"].(map[string]any)["config"].(map[string]any)
+	require.Equal(t, "REDACTED", config["client_secret"])
+	require.Equal(t, "enabled", config["secret_scanning"])
+}
+
+func TestEvalWithoutExplanation(t *testing.T) {
+	t.Parallel()
+
+	e, err := rego.NewRegoEvaluator(
+		&minderv1.RuleType_Definition_Eval_Rego{
+			Type: rego.DenyByDefaultEvaluationType.String(),
+			Def: `
+package minder
+
+default allow = false
+
+allow {
+	print("checking")
+	input.ingested.name == "foo"
+}
+`,
+		},
+	)
+	require.NoError(t, err)
+
+	// Policies with print statements evaluate normally when they aren't explained
+	_, err = e.Eval(context.Background(), map[string]any{}, nil, &interfaces.Ingested{
+		Object: map[string]any{"name": "foo"},
+	})
+	require.NoError(t, err)
+}
@@ -6,21 +6,19 @@ package rego
 
 import (
 	"context"
-	"fmt"
-	"net"
 	"net/http"
 	"sync"
 
 	"github.com/rs/zerolog"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/metric"
+
+	"github.com/mindersec/minder/internal/util/netutil"
 )
 
 var blockedRequests metric.Int64Counter
 var metricsInit sync.Once
 
-type dialContextFunc = func(ctx context.Context, network, addr string) (net.Conn, error)
-
 // LimitedDialer is an HTTP Dialer (Rego topdowmn.CustomizeRoundTripper) which
 // allows us to limit the destination of dialed requests to block specific
 // network ranges (such as RFC1918 space).  It operates by attempting to dial
@@ -38,40 +36,25 @@ func LimitedDialer(transport *http.Transport) http.RoundTripper {
 			zerolog.Ctx(context.Background()).Warn().Err(err).Msg("Creating counter for blocked requests failed")
 		}
 	})
-	if transport == nil {
-		var ok bool
-		transport, ok = http.DefaultTransport.(*http.Transport)
-		if !ok {
-			transport = &http.Transport{}
+	return netutil.PublicOnlyTransport(transport, func(ctx context.Context) {
+		// We do not need to lock because blockedRequests is initialized in a sync.Once
+		// which is called before this method
+		if blockedRequests != nil {
+			blockedRequests.Add(ctx, 1)
 		}
-	}
-	transport = transport.Clone()
-	transport.DialContext = publicOnlyDialer(transport.DialContext)
-	return transport
+	})
 }
 
-func publicOnlyDialer(baseDialer dialContextFunc) dialContextFunc {
-	if baseDialer == nil {
-		baseDialer = (&net.Dialer{}).DialContext
-	}
-	return func(ctx context.Context, network, addr string) (net.Conn, error) {
-		conn, err := baseDialer(ctx, network, addr)
-		if err != nil {
-			return nil, err
-		}
-		remote, ok := conn.RemoteAddr().(*net.TCPAddr)
-		if !ok {
-			return nil, fmt.Errorf("remote address is not a TCP address")
-		}
-		if !remote.IP.IsGlobalUnicast() || remote.IP.IsLoopback() || remote.IP.IsPrivate() {
-			// We do not need to lock because blockedRequests is initialized in a sync.Once
-			// which is called before this method
-			if blockedRequests != nil {
-				blockedRequests.Add(ctx, 1)
-			}
-			// Intentionally do not leak address resolution information
-			return nil, fmt.Errorf("remote address is not public")
-		}
-		return conn, err
-	}
+// offlineRoundTripper is an HTTP Dialer (Rego topdown.CustomizeRoundTripper)
+// which fails all requests, used when evaluating in offline mode.
+func offlineRoundTripper(*http.Transport) http.RoundTripper {
+	return roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errOffline
+	})
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
 }
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rego
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"github.com/open-policy-agent/opa/v1/topdown"
+
+	engerrors "github.com/mindersec/minder/internal/engine/errors"
+	"github.com/mindersec/minder/internal/engine/limits"
+)
+
+// SetLimits implements the SupportsLimits interface. The stricter value of
+// the limits already set and the given ones applies.
+func (e *Evaluator) SetLimits(l limits.Limits) {
+	e.limits = e.limits.Stricter(l)
+}
+
+// evalBudget tracks the resources used by a single evaluation, and aborts
+// the evaluation when it exceeds one of its limits
+type evalBudget struct {
+	limits       limits.Limits
+	cancel       context.CancelCauseFunc
+	instructions atomic.Int64
+	dsCalls      atomic.Int64
+}
+
+var _ topdown.QueryTracer = (*evalBudget)(nil)
+
+// newEvalBudget returns the budget of an evaluation along with the context the
+// evaluation must run with, which is canceled when the budget is exceeded
+func newEvalBudget(ctx context.Context, l limits.Limits) (context.Context, *evalBudget, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	b := &evalBudget{
+		limits: l,
+		cancel: cancel,
+	}
+	return ctx, b, func() { cancel(nil) }
+}
+
+// exceeded returns the error the evaluation was aborted with, if it exceeded its budget
+func (*evalBudget) exceeded(ctx context.Context) error {
+	if cause := context.Cause(ctx); errors.Is(cause, engerrors.ErrEvaluationBudgetExceeded) {
+		return cause
+	}
+	return nil
+}
+
+// countDataSourceCall accounts for a data source function call, returning an
+// error if the evaluation made too many of them
+func (b *evalBudget) countDataSourceCall() error {
+	if b == nil || b.limits.MaxDataSourceCalls <= 0 {
+		return nil
+	}
+	if b.dsCalls.Add(1) > b.limits.MaxDataSourceCalls {
+		err := engerrors.NewErrEvaluationBudgetExceeded(
+			"exceeded the limit of %d data source calls", b.limits.MaxDataSourceCalls)
+		b.cancel(err)
+		return err
+	}
+	return nil
+}
+
+// tracesInstructions returns whether the evaluation needs to count the Rego
+// expressions it evaluates. Tracing has a cost, so it is only enabled if needed.
+func (b *evalBudget) tracesInstructions() bool {
+	return b.limits.MaxRegoInstructions > 0
+}
+
+// Enabled implements the topdown.QueryTracer interface
+func (*evalBudget) Enabled() bool {
+	return true
+}
+
+// Config implements the topdown.QueryTracer interface
+func (*evalBudget) Config() topdown.TraceConfig {
+	return topdown.TraceConfig{}
+}
+
+// TraceEvent implements the topdown.QueryTracer interface. Every evaluated
+// expression counts as an instruction.
+func (b *evalBudget) TraceEvent(ev topdown.Event) {
+	if ev.Op != topdown.EvalOp {
+		return
+	}
+	if b.instructions.Add(1) == b.limits.MaxRegoInstructions+1 {
+		b.cancel(engerrors.NewErrEvaluationBudgetExceeded(
+			"exceeded the limit of %d Rego instructions", b.limits.MaxRegoInstructions))
+	}
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rego_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	engerrors "github.com/mindersec/minder/internal/engine/errors"
+	"github.com/mindersec/minder/internal/engine/eval/rego"
+	"github.com/mindersec/minder/internal/engine/limits"
+	"github.com/mindersec/minder/internal/engine/options"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
+	v1mockds "github.com/mindersec/minder/pkg/datasources/v1/mock"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+const loopingPolicy = `
+package minder
+
+default allow = false
+
+allow {
+	count([x | numbers.range(1, input.ingested.n)[_] = x; x % 2 == 0]) > 0
+}`
+
+func TestRegoInstructionLimit(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		opts     []interfaces.Option
+		n        int
+		exceeded bool
+	}{
+		{
+			name: "no limit",
+			n:    1000,
+		},
+		{
+			name: "within limit",
+			opts: []interfaces.Option{options.WithLimits(limits.Limits{MaxRegoInstructions: 10000})},
+			n:    10,
+		},
+		{
+			name:     "exceeds limit",
+			opts:     []interfaces.Option{options.WithLimits(limits.Limits{MaxRegoInstructions: 10000})},
+			n:        100000,
+			exceeded: true,
+		},
+		{
+			name: "stricter limit applies",
+			opts: []interfaces.Option{
+				options.WithLimits(limits.Limits{MaxRegoInstructions: 10000}),
+				options.WithLimits(limits.Limits{MaxRegoInstructions: 50}),
+			},
+			n:        100,
+			exceeded: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			e, err := rego.NewRegoEvaluator(
+				&minderv1.RuleType_Definition_Eval_Rego{
+					Type: rego.DenyByDefaultEvaluationType.String(),
+					Def:  loopingPolicy,
+				},
+				tc.opts...,
+			)
+			require.NoError(t, err)
+
+			_, err = e.Eval(context.Background(), map[string]any{}, nil, &interfaces.Ingested{
+				Object: map[string]any{"n": tc.n},
+			})
+			if tc.exceeded {
+				require.ErrorIs(t, err, engerrors.ErrEvaluationBudgetExceeded)
+				require.ErrorContains(t, err, "Rego instructions")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDataSourceCallLimit(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	fds := v1mockds.NewMockDataSource(ctrl)
+	fdsf := v1mockds.NewMockDataSourceFuncDef(ctrl)
+	fds.EXPECT().GetFuncs().Return(map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef{
+		"source": fdsf,
+	}).AnyTimes()
+	fdsf.EXPECT().ValidateArgs(gomock.Any()).Return(nil).AnyTimes()
+	fdsf.EXPECT().Call(gomock.Any(), gomock.Any(), gomock.Any()).Return("foo", nil).Times(2)
+
+	fdsr := v1datasources.NewDataSourceRegistry()
+	require.NoError(t, fdsr.RegisterDataSource("fake", fds))
+
+	e, err := rego.NewRegoEvaluator(
+		&minderv1.RuleType_Definition_Eval_Rego{
+			Type: rego.DenyByDefaultEvaluationType.String(),
+			Def: `
+package minder
+
+default allow = false
+
+allow {
+	results := [r | name := input.ingested.names[_]; r := minder.datasource.fake.source({"name": name})]
+	count(results) == count(input.ingested.names)
+}`,
+		},
+		options.WithDataSources(fdsr),
+		options.WithLimits(limits.Limits{MaxDataSourceCalls: 2}),
+	)
+	require.NoError(t, err)
+
+	_, err = e.Eval(context.Background(), map[string]any{}, nil, &interfaces.Ingested{
+		Object: map[string]any{"names": []any{"a", "b", "c"}},
+	})
+	require.ErrorIs(t, err, engerrors.ErrEvaluationBudgetExceeded)
+	require.ErrorContains(t, err, "data source calls")
+}
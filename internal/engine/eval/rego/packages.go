@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rego
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/rego"
+	"github.com/open-policy-agent/opa/v1/types"
+
+	"github.com/mindersec/minder/internal/deps/licenses"
+	"github.com/mindersec/minder/internal/engine/eval/vulncheck"
+)
+
+// errOffline is returned by the functions that need network access when the
+// evaluator runs in offline mode
+var errOffline = errors.New("not available in offline mode")
+
+// PackageDataSource looks up vulnerabilities and metadata of packages
+type PackageDataSource interface {
+	// Vulnerabilities returns the known vulnerabilities of the given version of a package
+	Vulnerabilities(ctx context.Context, ecosystem, name, version string) ([]vulncheck.Vulnerability, error)
+	// LatestVersion returns the latest version of a package in its package registry
+	LatestVersion(ctx context.Context, ecosystem, name string) (string, error)
+}
+
+var _ PackageDataSource = (*vulncheck.PackageLookup)(nil)
+
+// packageLib instantiates the functions querying package data. Their results
+// are memoized for the duration of a single evaluation.
+func packageLib(source PackageDataSource, offline bool) []func(*rego.Rego) {
+	return []func(*rego.Rego){
+		OSVQuery(source, offline),
+		PkgLatest(source, offline),
+		LicenseClassify(),
+	}
+}
+
+// OSVQuery adds the `osv.query` function to the Rego engine.
+func OSVQuery(source PackageDataSource, offline bool) func(*rego.Rego) {
+	return rego.Function3(
+		&rego.Function{
+			Name: "osv.query",
+			Description: `osv.query looks up the known vulnerabilities of a package version
+			in the OSV database. It takes three arguments: the package ecosystem (npm,
+			go or pypi), the package name and the version, and returns an array of
+			vulnerabilities with their "id", "summary", "details", and the "introduced"
+			and "fixed" versions of the affected range.`,
+			Decl:             types.NewFunction(types.Args(types.S, types.S, types.S), types.NewArray(nil, types.A)),
+			Memoize:          true,
+			Nondeterministic: true,
+		},
+		func(bctx rego.BuiltinContext, op1, op2, op3 *ast.Term) (*ast.Term, error) {
+			if offline {
+				return nil, errOffline
+			}
+			var ecosystem, name, version string
+			if err := ast.As(op1.Value, &ecosystem); err != nil {
+				return nil, err
+			}
+			if err := ast.As(op2.Value, &name); err != nil {
+				return nil, err
+			}
+			if err := ast.As(op3.Value, &version); err != nil {
+				return nil, err
+			}
+
+			vulns, err := source.Vulnerabilities(bctx.Context, ecosystem, name, version)
+			if err != nil {
+				return nil, fmt.Errorf("error querying vulnerabilities: %w", err)
+			}
+			if vulns == nil {
+				vulns = []vulncheck.Vulnerability{}
+			}
+
+			value, err := ast.InterfaceToValue(vulns)
+			if err != nil {
+				return nil, fmt.Errorf("error converting to AST value: %w", err)
+			}
+			return ast.NewTerm(value), nil
+		},
+	)
+}
+
+// PkgLatest adds the `pkg.latest` function to the Rego engine.
+func PkgLatest(source PackageDataSource, offline bool) func(*rego.Rego) {
+	return rego.Function2(
+		&rego.Function{
+			Name: "pkg.latest",
+			Description: `pkg.latest looks up the latest version of a package in its
+			package registry. It takes two arguments: the package ecosystem (npm, go
+			or pypi) and the package name, and returns the version as a string. It is
+			undefined if the package does not exist.`,
+			Decl:             types.NewFunction(types.Args(types.S, types.S), types.S),
+			Memoize:          true,
+			Nondeterministic: true,
+		},
+		func(bctx rego.BuiltinContext, op1, op2 *ast.Term) (*ast.Term, error) {
+			if offline {
+				return nil, errOffline
+			}
+			var ecosystem, name string
+			if err := ast.As(op1.Value, &ecosystem); err != nil {
+				return nil, err
+			}
+			if err := ast.As(op2.Value, &name); err != nil {
+				return nil, err
+			}
+
+			latest, err := source.LatestVersion(bctx.Context, ecosystem, name)
+			if errors.Is(err, vulncheck.ErrPkgNotFound) {
+				return nil, nil
+			} else if err != nil {
+				return nil, fmt.Errorf("error getting latest version: %w", err)
+			}
+			return ast.StringTerm(latest), nil
+		},
+	)
+}
+
+// LicenseClassify adds the `license.classify` function to the Rego engine.
+func LicenseClassify() func(*rego.Rego) {
+	return rego.Function1(
+		&rego.Function{
+			Name: "license.classify",
+			Description: `license.classify identifies the licenses in a text, such as the
+			contents of a LICENSE file or a source file header. It takes one argument:
+			the text, and returns the sorted SPDX identifiers of the licenses found as
+			an array of strings. SPDX-License-Identifier tags take precedence over the
+			license text.`,
+			Decl:    types.NewFunction(types.Args(types.S), types.NewArray(nil, types.S)),
+			Memoize: true,
+		},
+		func(_ rego.BuiltinContext, op1 *ast.Term) (*ast.Term, error) {
+			var text string
+			if err := ast.As(op1.Value, &text); err != nil {
+				return nil, err
+			}
+
+			ids := licenses.Classify(text)
+			terms := make([]*ast.Term, 0, len(ids))
+			for _, id := range ids {
+				terms = append(terms, ast.StringTerm(id))
+			}
+			return ast.ArrayTerm(terms...), nil
+		},
+	)
+}
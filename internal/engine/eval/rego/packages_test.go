@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rego_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	engerrors "github.com/mindersec/minder/internal/engine/errors"
+	"github.com/mindersec/minder/internal/engine/eval/rego"
+	"github.com/mindersec/minder/internal/engine/eval/vulncheck"
+	"github.com/mindersec/minder/internal/engine/options"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+type fakePackageData struct {
+	vulnQueries   int
+	latestQueries int
+}
+
+func (f *fakePackageData) Vulnerabilities(_ context.Context, ecosystem, name, version string) ([]vulncheck.Vulnerability, error) {
+	f.vulnQueries++
+	if ecosystem == "npm" && name == "lodash" && version == "4.17.20" {
+		return []vulncheck.Vulnerability{{
+			ID:         "GHSA-35jh-r3h4-6jhm",
+			Summary:    "Command Injection in lodash",
+			Introduced: "0",
+			Fixed:      "4.17.21",
+			Type:       "SEMVER",
+		}}, nil
+	}
+	return nil, nil
+}
+
+func (f *fakePackageData) LatestVersion(_ context.Context, ecosystem, name string) (string, error) {
+	f.latestQueries++
+	if ecosystem == "npm" && name == "lodash" {
+		return "4.17.21", nil
+	}
+	return "", vulncheck.ErrPkgNotFound
+}
+
+func TestPackageFunctions(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		def     string
+		offline bool
+		mustErr bool
+	}{
+		{
+			name: "vulnerable version",
+			def: `
+package minder
+
+default allow = false
+
+allow {
+	vulns := osv.query("npm", "lodash", "4.17.20")
+	vulns[0].id == "GHSA-35jh-r3h4-6jhm"
+	vulns[0].fixed == pkg.latest("npm", "lodash")
+}`,
+		},
+		{
+			name: "no vulnerabilities",
+			def: `
+package minder
+
+default allow = false
+
+allow {
+	count(osv.query("npm", "lodash", "4.17.21")) == 0
+}`,
+		},
+		{
+			name: "unknown package",
+			def: `
+package minder
+
+default allow = false
+
+allow {
+	not pkg.latest("npm", "no-such-package")
+}`,
+		},
+		{
+			name: "offline",
+			def: `
+package minder
+
+default allow = false
+
+allow {
+	count(osv.query("npm", "lodash", "4.17.21")) == 0
+}`,
+			offline: true,
+			mustErr: true,
+		},
+		{
+			name: "license is classified offline",
+			def: `
+package minder
+
+default allow = false
+
+allow {
+	license.classify("// SPDX-License-Identifier: MIT OR Apache-2.0") == ["Apache-2.0", "MIT"]
+}`,
+			offline: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			e, err := rego.NewRegoEvaluator(
+				&minderv1.RuleType_Definition_Eval_Rego{
+					Type: rego.DenyByDefaultEvaluationType.String(),
+					Def:  tc.def,
+				},
+				rego.WithPackageDataSource(&fakePackageData{}),
+				options.WithOfflineMode(tc.offline),
+			)
+			require.NoError(t, err, "could not create evaluator")
+
+			_, err = e.Eval(context.Background(), map[string]any{}, nil, &interfaces.Ingested{})
+			if tc.mustErr {
+				var evalErr *engerrors.EvaluationError
+				require.ErrorAs(t, err, &evalErr, "expected the policy to be denied")
+				return
+			}
+			require.NoError(t, err, "could not evaluate")
+		})
+	}
+}
+
+func TestPackageFunctionsAreMemoized(t *testing.T) {
+	t.Parallel()
+
+	source := &fakePackageData{}
+	e, err := rego.NewRegoEvaluator(
+		&minderv1.RuleType_Definition_Eval_Rego{
+			Type: rego.DenyByDefaultEvaluationType.String(),
+			Def: `
+package minder
+
+default allow = false
+
+allow {
+	count(osv.query("npm", "lodash", "4.17.20")) == 1
+	count(osv.query("npm", "lodash", "4.17.20")) > 0
+	pkg.latest("npm", "lodash") == pkg.latest("npm", "lodash")
+}`,
+		},
+		rego.WithPackageDataSource(source),
+	)
+	require.NoError(t, err, "could not create evaluator")
+
+	_, err = e.Eval(context.Background(), map[string]any{}, nil, &interfaces.Ingested{})
+	require.NoError(t, err, "could not evaluate")
+	require.Equal(t, 1, source.vulnQueries)
+	require.Equal(t, 1, source.latestQueries)
+}
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vulncheck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+)
+
+// PackageLookup queries the vulnerability databases and package registries
+// used by the vulncheck evaluator about single packages, e.g. on behalf of
+// a Rego policy.
+type PackageLookup struct {
+	ecosystems []ecosystemConfig
+	repoCache  *repoCache
+}
+
+// NewPackageLookup creates a package lookup using the default ecosystem
+// configuration of the vulncheck evaluator
+func NewPackageLookup() *PackageLookup {
+	return newPackageLookup(defaultEcosystemConfig)
+}
+
+func newPackageLookup(ecosystems []ecosystemConfig) *PackageLookup {
+	return &PackageLookup{
+		ecosystems: ecosystems,
+		repoCache:  newRepoCache(),
+	}
+}
+
+// Vulnerabilities returns the known vulnerabilities of the given version of a package
+func (l *PackageLookup) Vulnerabilities(
+	ctx context.Context, ecosystem, name, version string,
+) ([]Vulnerability, error) {
+	eco, ecoConfig, err := l.getEcosystemConfig(ecosystem)
+	if err != nil {
+		return nil, err
+	}
+
+	var vdb vulnDb
+	switch ecoConfig.DbType {
+	case vulnDbTypeOsv:
+		vdb = newOsvDb(ecoConfig.DbEndpoint)
+	default:
+		return nil, fmt.Errorf("unsupported vulncheck db type: %s", ecoConfig.DbType)
+	}
+
+	dep := &pbinternal.Dependency{Ecosystem: eco, Name: name, Version: version}
+	req, err := vdb.NewQuery(ctx, dep, eco)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vulncheck request: %w", err)
+	}
+
+	response, err := vdb.SendRecvRequest(req, dep)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send vulncheck request: %w", err)
+	}
+
+	return response.Vulns, nil
+}
+
+// LatestVersion returns the latest version of a package in its package registry.
+// It returns ErrPkgNotFound if the package doesn't exist.
+func (l *PackageLookup) LatestVersion(ctx context.Context, ecosystem, name string) (string, error) {
+	eco, ecoConfig, err := l.getEcosystemConfig(ecosystem)
+	if err != nil {
+		return "", err
+	}
+
+	repo, err := l.repoCache.newRepository(ecoConfig)
+	if err != nil {
+		return "", fmt.Errorf("failed to create package repository: %w", err)
+	}
+
+	reply, err := repo.SendRecvRequest(ctx, &pbinternal.Dependency{Ecosystem: eco, Name: name}, "", true)
+	if err != nil {
+		return "", err
+	}
+
+	return reply.GetPatchedVersion(), nil
+}
+
+func (l *PackageLookup) getEcosystemConfig(ecosystem string) (pbinternal.DepEcosystem, *ecosystemConfig, error) {
+	var eco pbinternal.DepEcosystem
+	switch strings.ToLower(ecosystem) {
+	case "npm":
+		eco = pbinternal.DepEcosystem_DEP_ECOSYSTEM_NPM
+	case "go":
+		eco = pbinternal.DepEcosystem_DEP_ECOSYSTEM_GO
+	case "pypi":
+		eco = pbinternal.DepEcosystem_DEP_ECOSYSTEM_PYPI
+	default:
+		return eco, nil, fmt.Errorf("unsupported ecosystem: %s", ecosystem)
+	}
+
+	cfg := &config{EcosystemConfig: l.ecosystems}
+	ecoConfig := cfg.getEcosystemConfig(eco)
+	if ecoConfig == nil {
+		return eco, nil, fmt.Errorf("ecosystem %s is not configured", ecosystem)
+	}
+
+	return eco, ecoConfig, nil
+}
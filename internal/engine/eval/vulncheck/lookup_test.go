@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package vulncheck
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageLookup(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/query", func(w http.ResponseWriter, r *http.Request) {
+		var query struct {
+			Version string `json:"version"`
+			Package struct {
+				Name      string `json:"name"`
+				Ecosystem string `json:"ecosystem"`
+			} `json:"package"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&query))
+		assert.Equal(t, "npm", query.Package.Ecosystem)
+		assert.Equal(t, "lodash", query.Package.Name)
+		assert.Equal(t, "4.17.20", query.Version)
+		_, _ = w.Write([]byte(`{"vulns": [{"id": "GHSA-35jh-r3h4-6jhm", "summary": "Command Injection in lodash",
+			"affected": [{"ranges": [{"type": "SEMVER", "events": [{"introduced": "0"}, {"fixed": "4.17.21"}]}]}]}]}`))
+	})
+	mux.HandleFunc("/lodash/latest", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"name": "lodash", "version": "4.17.21"}`))
+	})
+	mux.HandleFunc("/no-such-package/latest", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	lookup := newPackageLookup([]ecosystemConfig{{
+		Name:       "npm",
+		DbType:     vulnDbTypeOsv,
+		DbEndpoint: server.URL + "/v1/query",
+		PackageRepository: packageRepository{
+			Url: server.URL,
+		},
+	}})
+	ctx := context.Background()
+
+	vulns, err := lookup.Vulnerabilities(ctx, "NPM", "lodash", "4.17.20")
+	require.NoError(t, err)
+	require.Len(t, vulns, 1)
+	require.Equal(t, "GHSA-35jh-r3h4-6jhm", vulns[0].ID)
+	require.Equal(t, "4.17.21", vulns[0].Fixed)
+
+	latest, err := lookup.LatestVersion(ctx, "npm", "lodash")
+	require.NoError(t, err)
+	require.Equal(t, "4.17.21", latest)
+
+	_, err = lookup.LatestVersion(ctx, "npm", "no-such-package")
+	require.ErrorIs(t, err, ErrPkgNotFound)
+
+	_, err = lookup.LatestVersion(ctx, "pypi", "requests")
+	require.ErrorContains(t, err, "not configured")
+
+	_, err = lookup.Vulnerabilities(ctx, "cargo", "serde", "1.0.0")
+	require.ErrorContains(t, err, "unsupported ecosystem")
+}
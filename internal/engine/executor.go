@@ -5,6 +5,7 @@ package engine
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -20,16 +21,20 @@ import (
 	evalerrors "github.com/mindersec/minder/internal/engine/errors"
 	"github.com/mindersec/minder/internal/engine/ingestcache"
 	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	"github.com/mindersec/minder/internal/engine/limits"
 	eoptions "github.com/mindersec/minder/internal/engine/options"
 	"github.com/mindersec/minder/internal/engine/rtengine"
+	entmodels "github.com/mindersec/minder/internal/entities/models"
 	"github.com/mindersec/minder/internal/entities/properties/service"
 	"github.com/mindersec/minder/internal/history"
 	minderlogger "github.com/mindersec/minder/internal/logger"
+	internalpb "github.com/mindersec/minder/internal/proto"
 	"github.com/mindersec/minder/internal/providers/manager"
 	provsel "github.com/mindersec/minder/internal/providers/selectors"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/engine/selectors"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	rtengine2 "github.com/mindersec/minder/pkg/engine/v1/rtengine"
 	"github.com/mindersec/minder/pkg/flags"
 	"github.com/mindersec/minder/pkg/profiles"
 	"github.com/mindersec/minder/pkg/profiles/models"
@@ -52,6 +57,7 @@ type executor struct {
 	profileStore    profiles.ProfileStore
 	selBuilder      selectors.SelectionBuilder
 	propService     service.PropertiesService
+	evalLimits      limits.Limits
 }
 
 // NewExecutor creates a new executor
@@ -64,6 +70,7 @@ func NewExecutor(
 	profileStore profiles.ProfileStore,
 	selBuilder selectors.SelectionBuilder,
 	propService service.PropertiesService,
+	evalLimits limits.Limits,
 ) Executor {
 	return &executor{
 		querier:         querier,
@@ -74,6 +81,7 @@ func NewExecutor(
 		profileStore:    profileStore,
 		selBuilder:      selBuilder,
 		propService:     propService,
+		evalLimits:      evalLimits,
 	}
 }
 
@@ -128,6 +136,7 @@ func (e *executor) EvalEntityEvent(ctx context.Context, inf *entities.EntityInfo
 		ingestCache,
 		dssvc,
 		eoptions.WithFlagsClient(e.featureFlags),
+		eoptions.WithLimits(e.evalLimits),
 	)
 	if err != nil {
 		return fmt.Errorf("unable to fetch rule type instances for project: %w", err)
@@ -199,10 +208,16 @@ func (e *executor) evaluateRule(
 			Str("entity_type", inf.Type.ToString()).
 			Str("execution_id", inf.ExecutionID.String()).
 			Logger().WithContext(ctx)
-		result, evalErr = ruleEngine.Eval(ctx, inf.Entity, evalParams.GetRule().Def, evalParams.GetRule().Params, evalParams)
+		result, evalErr = e.evalWithTimeout(ctx, inf, ruleEngine, evalParams)
 		evalParams.SetEvalResult(result)
 	}
+	// A failure covered by an active waiver is recorded as waived, which
+	// turns off remediations and alerts
+	evalErr = waiveFailure(ctx, e.querier, evalParams, evalErr)
 	evalParams.SetEvalErr(evalErr)
+	if errors.Is(evalErr, evalerrors.ErrEvaluationTimeout) {
+		e.metrics.CountEvalTimeout(ctx, ruleEngine.GetRuleType().GetName(), evalParams.EntityType)
+	}
 
 	// Perform actionEngine, if any
 	actionsErr := actionEngine.DoActions(ctx, inf.Entity, evalParams)
@@ -215,6 +230,34 @@ func (e *executor) evaluateRule(
 	return e.createOrUpdateEvalStatus(ctx, evalParams)
 }
 
+// evalWithTimeout ingests and evaluates the rule, aborting it if it takes longer
+// than the timeout of the rule type, so that a single rule can't block the
+// evaluation of the whole entity while holding its lock.
+func (e *executor) evalWithTimeout(
+	ctx context.Context,
+	inf *entities.EntityInfoWrapper,
+	ruleEngine *rtengine2.RuleTypeEngine,
+	evalParams *engif.EvalStatusParams,
+) (*interfaces.EvaluationResult, error) {
+	ruleTypeLimits, err := limits.FromRuleType(ruleEngine.GetRuleType())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing rule type limits: %w", err)
+	}
+	timeout := e.evalLimits.Stricter(ruleTypeLimits).Timeout
+	if timeout <= 0 {
+		return ruleEngine.Eval(ctx, inf.Entity, evalParams.GetRule().Def, evalParams.GetRule().Params, evalParams)
+	}
+
+	evalCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result, err := ruleEngine.Eval(evalCtx, inf.Entity, evalParams.GetRule().Def, evalParams.GetRule().Params, evalParams)
+	if err != nil && errors.Is(evalCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+		return nil, evalerrors.NewErrEvaluationTimeout("rule evaluation exceeded the timeout of %s", timeout)
+	}
+	return result, err
+}
+
 func (e *executor) profileEvalStatus(
 	ctx context.Context,
 	eiw *entities.EntityInfoWrapper,
@@ -250,7 +293,15 @@ func (e *executor) profileEvalStatus(
 		return fmt.Errorf("error converting entity to selector entity")
 	}
 
-	selected, matchedSelector, err := selection.Select(selEnt)
+	// The originating entity and the evaluation status are only fetched if the selectors
+	// actually need them. Evaluate with both marked as unknown first and only resolve them
+	// if the result depends on them.
+	selected, matchedSelector, err := selection.Select(selEnt,
+		selectors.WithUnknownPaths(provsel.OriginatedFromPath, provsel.EvaluationPath))
+	if errors.Is(err, selectors.ErrResultUnknown) {
+		unknownPaths := e.fillLazySelectorAttributes(ctx, selEnt, ewp, aggregate.ID)
+		selected, matchedSelector, err = selection.Select(selEnt, selectors.WithUnknownPaths(unknownPaths...))
+	}
 	if err != nil {
 		return fmt.Errorf("error selecting entity: %w", err)
 	}
@@ -262,6 +313,30 @@ func (e *executor) profileEvalStatus(
 	return nil
 }
 
+// fillLazySelectorAttributes resolves the selector attributes that require additional
+// lookups. It returns the paths of the attributes that could not be resolved so that the
+// selection can treat them as unknown.
+func (e *executor) fillLazySelectorAttributes(
+	ctx context.Context,
+	selEnt *internalpb.SelectorEntity,
+	ewp *entmodels.EntityWithProperties,
+	profileID uuid.UUID,
+) []string {
+	var unknownPaths []string
+
+	if err := provsel.FillOriginatingEntity(ctx, e.querier, selEnt, ewp); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error resolving originating entity for selectors")
+		unknownPaths = append(unknownPaths, provsel.OriginatedFromPath)
+	}
+
+	if err := provsel.FillEvaluationStatus(ctx, e.querier, selEnt, ewp.Entity.ID, profileID); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error resolving evaluation status for selectors")
+		unknownPaths = append(unknownPaths, provsel.EvaluationPath)
+	}
+
+	return unknownPaths
+}
+
 func (e *executor) updateLockLease(
 	ctx context.Context,
 	executionID uuid.UUID,
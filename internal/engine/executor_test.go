@@ -28,6 +28,7 @@ import (
 	"github.com/mindersec/minder/internal/engine/actions/alert"
 	"github.com/mindersec/minder/internal/engine/actions/remediate"
 	"github.com/mindersec/minder/internal/engine/entities"
+	"github.com/mindersec/minder/internal/engine/limits"
 	"github.com/mindersec/minder/internal/entities/models"
 	mockprops "github.com/mindersec/minder/internal/entities/properties/service/mock"
 	mockhistory "github.com/mindersec/minder/internal/history/mock"
@@ -38,6 +39,7 @@ import (
 	ghmanager "github.com/mindersec/minder/internal/providers/github/manager"
 	ghService "github.com/mindersec/minder/internal/providers/github/service"
 	"github.com/mindersec/minder/internal/providers/manager"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	"github.com/mindersec/minder/internal/providers/ratecache"
 	"github.com/mindersec/minder/internal/providers/telemetry"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -267,14 +269,15 @@ default allow = true`,
 		// These nil dependencies do not matter for the current tests
 		nil,
 		nil,
-		clients.NewGitHubClientFactory(telemetry.NewNoopMetrics()),
+		clients.NewGitHubClientFactory(telemetry.NewNoopMetrics(), &ratebudget.NoopTracker{}),
 	)
 
 	propssvc := mockprops.NewMockPropertiesService(ctrl)
 
 	githubProviderManager := ghmanager.NewGitHubProviderClassManager(
 		&ratecache.NoopRestClientCache{},
-		clients.NewGitHubClientFactory(telemetry.NewNoopMetrics()),
+		clients.NewGitHubClientFactory(telemetry.NewNoopMetrics(), &ratebudget.NoopTracker{}),
+		&ratebudget.NoopTracker{},
 		&serverconfig.ProviderConfig{},
 		&serverconfig.WebhookConfig{},
 		nil,
@@ -337,6 +340,7 @@ default allow = true`,
 		profiles.NewProfileStore(mockStore),
 		selectors.NewEnv(),
 		mockPropSvc,
+		limits.Limits{},
 	)
 
 	eiw := entities.NewEntityInfoWrapper().
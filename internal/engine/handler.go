@@ -16,6 +16,7 @@ import (
 	"github.com/mindersec/minder/internal/engine/engcontext"
 	"github.com/mindersec/minder/internal/engine/entities"
 	minderlogger "github.com/mindersec/minder/internal/logger"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/eventer/constants"
 	"github.com/mindersec/minder/pkg/eventer/interfaces"
@@ -28,6 +29,9 @@ const (
 	// ArtifactSignatureWaitPeriod is the waiting period for potential artifact signature to be available
 	// before proceeding with evaluation.
 	ArtifactSignatureWaitPeriod = 10 * time.Second
+	// MaxRateBudgetWait is the maximum time an evaluation is held back
+	// waiting for the rate limit budget of its provider to be replenished.
+	MaxRateBudgetWait = 5 * time.Minute
 )
 
 // ExecutorEventHandler is responsible for consuming entity events, passing
@@ -37,6 +41,7 @@ type ExecutorEventHandler struct {
 	handlerMiddleware      []message.HandlerMiddleware
 	wgEntityEventExecution *sync.WaitGroup
 	executor               Executor
+	budgets                ratebudget.Tracker
 	// cancels are a set of cancel functions for current entity events in flight.
 	// This allows us to cancel rule evaluation directly when terminationContext
 	// is cancelled.
@@ -50,12 +55,14 @@ func NewExecutorEventHandler(
 	evt interfaces.Publisher,
 	handlerMiddleware []message.HandlerMiddleware,
 	executor Executor,
+	budgets ratebudget.Tracker,
 ) *ExecutorEventHandler {
 	eh := &ExecutorEventHandler{
 		evt:                    evt,
 		wgEntityEventExecution: &sync.WaitGroup{},
 		handlerMiddleware:      handlerMiddleware,
 		executor:               executor,
+		budgets:                budgets,
 	}
 	go func() {
 		<-ctx.Done()
@@ -113,6 +120,7 @@ func (e *ExecutorEventHandler) HandleEntityEvent(msg *message.Message) error {
 		if inf.Type == pb.Entity_ENTITY_ARTIFACTS {
 			time.Sleep(ArtifactSignatureWaitPeriod)
 		}
+		e.waitForRateBudget(msgCtx, inf)
 
 		ctx, cancel := context.WithTimeout(msgCtx, DefaultExecutionTimeout)
 		defer cancel()
@@ -177,3 +185,23 @@ func (e *ExecutorEventHandler) HandleEntityEvent(msg *message.Message) error {
 
 	return nil
 }
+
+// waitForRateBudget holds back the evaluation while the rate limit budget of
+// the entity's provider is exhausted, rather than failing its API calls.
+// Evaluations reaching the executor are treated as high priority, as
+// reminder-driven work is already deferred before being refreshed.
+func (e *ExecutorEventHandler) waitForRateBudget(ctx context.Context, inf *entities.EntityInfoWrapper) {
+	delay := e.budgets.Admit(ctx, inf.ProviderID, ratebudget.PriorityHigh)
+	if delay <= 0 {
+		return
+	}
+	if delay > MaxRateBudgetWait {
+		// evaluate anyway, the provider client will retry on rate limit errors
+		delay = MaxRateBudgetWait
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(delay):
+	}
+}
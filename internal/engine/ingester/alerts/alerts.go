@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package alerts provides the security alerts rule data ingest engine
+package alerts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v63/github"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	"github.com/mindersec/minder/pkg/entities/v1/checkpoints"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	// AlertsRuleDataIngestType is the type of the security alerts rule data ingest engine
+	AlertsRuleDataIngestType = "alerts"
+
+	// KindCodeScanning is the kind of code scanning alerts
+	KindCodeScanning = "code_scanning"
+	// KindSecretScanning is the kind of secret scanning alerts
+	KindSecretScanning = "secret_scanning"
+	// KindDependabot is the kind of Dependabot alerts
+	KindDependabot = "dependabot"
+)
+
+var allKinds = []string{KindCodeScanning, KindSecretScanning, KindDependabot}
+
+// Alerts is the engine for a rule type that uses security alerts data ingest
+type Alerts struct {
+	cfg  *pb.AlertsType
+	prov interfaces.GitHubSecurityAlerts
+}
+
+// NewAlertsIngester creates a new security alerts rule data ingest engine
+func NewAlertsIngester(cfg *pb.AlertsType, prov interfaces.GitHubSecurityAlerts) (*Alerts, error) {
+	if prov == nil {
+		return nil, fmt.Errorf("provider is nil")
+	}
+
+	if cfg == nil {
+		cfg = &pb.AlertsType{}
+	}
+
+	return &Alerts{
+		cfg:  cfg,
+		prov: prov,
+	}, nil
+}
+
+// GetType returns the type of the security alerts rule data ingest engine
+func (*Alerts) GetType() string {
+	return AlertsRuleDataIngestType
+}
+
+// GetConfig returns the config for the security alerts rule data ingest engine
+func (a *Alerts) GetConfig() protoreflect.ProtoMessage {
+	return a.cfg
+}
+
+// Ingest lists the open security alerts of a repository. Each kind of
+// alerts is exposed as an object with an "enabled" flag, which is false
+// when the kind of alerts is disabled for the repository, and the list
+// of "alerts" normalized to the same fields across kinds.
+func (a *Alerts) Ingest(ctx context.Context, ent protoreflect.ProtoMessage, _ map[string]any) (*interfaces.Ingested, error) {
+	repo, ok := ent.(*pb.Repository)
+	if !ok {
+		return nil, fmt.Errorf("alerts is only supported for repositories")
+	}
+
+	kinds := a.cfg.GetKinds()
+	if len(kinds) == 0 {
+		kinds = allKinds
+	}
+
+	out := make(map[string]any, len(kinds))
+	for _, kind := range kinds {
+		alerts, err := a.listAlerts(ctx, kind, repo.GetOwner(), repo.GetName())
+		if errors.Is(err, provifv1.ErrSecurityAlertsDisabled) {
+			out[kind] = map[string]any{
+				"enabled": false,
+				"alerts":  []any{},
+			}
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("error listing %s alerts: %w", kind, err)
+		}
+
+		out[kind] = map[string]any{
+			"enabled": true,
+			"alerts":  alerts,
+		}
+	}
+
+	return &interfaces.Ingested{
+		Object:     out,
+		Checkpoint: checkpoints.NewCheckpointV1Now(),
+	}, nil
+}
+
+func (a *Alerts) listAlerts(ctx context.Context, kind, owner, name string) ([]any, error) {
+	switch kind {
+	case KindCodeScanning:
+		alerts, err := a.prov.ListCodeScanningAlerts(ctx, owner, name)
+		if err != nil {
+			return nil, err
+		}
+		return convertAlerts(alerts, codeScanningAlertToMap), nil
+	case KindSecretScanning:
+		alerts, err := a.prov.ListSecretScanningAlerts(ctx, owner, name)
+		if err != nil {
+			return nil, err
+		}
+		return convertAlerts(alerts, secretScanningAlertToMap), nil
+	case KindDependabot:
+		alerts, err := a.prov.ListDependabotAlerts(ctx, owner, name)
+		if err != nil {
+			return nil, err
+		}
+		return convertAlerts(alerts, dependabotAlertToMap), nil
+	default:
+		return nil, fmt.Errorf("unknown kind of alerts: %s", kind)
+	}
+}
+
+// convertAlerts converts alerts to untyped maps, as the jq evaluator
+// can't traverse structs
+func convertAlerts[T any](alerts []T, convert func(T) map[string]any) []any {
+	out := make([]any, 0, len(alerts))
+	for _, alert := range alerts {
+		out = append(out, convert(alert))
+	}
+	return out
+}
+
+func codeScanningAlertToMap(alert *github.Alert) map[string]any {
+	// The security severity is only set for security queries, other
+	// queries only have a severity of error, warning or note.
+	severity := alert.GetRule().GetSecuritySeverityLevel()
+	if severity == "" {
+		severity = alert.GetRule().GetSeverity()
+	}
+
+	return map[string]any{
+		"number":     alert.GetNumber(),
+		"severity":   severity,
+		"created_at": formatTimestamp(alert.CreatedAt),
+		"url":        alert.GetHTMLURL(),
+		"summary":    alert.GetRule().GetDescription(),
+		"rule_id":    alert.GetRule().GetID(),
+		"tool":       alert.GetTool().GetName(),
+	}
+}
+
+func secretScanningAlertToMap(alert *github.SecretScanningAlert) map[string]any {
+	// Secret scanning alerts carry no severity
+	return map[string]any{
+		"number":      alert.GetNumber(),
+		"severity":    "",
+		"created_at":  formatTimestamp(alert.CreatedAt),
+		"url":         alert.GetHTMLURL(),
+		"summary":     alert.GetSecretTypeDisplayName(),
+		"secret_type": alert.GetSecretType(),
+	}
+}
+
+func dependabotAlertToMap(alert *github.DependabotAlert) map[string]any {
+	return map[string]any{
+		"number":     alert.GetNumber(),
+		"severity":   alert.GetSecurityAdvisory().GetSeverity(),
+		"created_at": formatTimestamp(alert.CreatedAt),
+		"url":        alert.GetHTMLURL(),
+		"summary":    alert.GetSecurityAdvisory().GetSummary(),
+		"package":    alert.GetDependency().GetPackage().GetName(),
+		"ecosystem":  alert.GetDependency().GetPackage().GetEcosystem(),
+		"manifest":   alert.GetDependency().GetManifestPath(),
+		"ghsa_id":    alert.GetSecurityAdvisory().GetGHSAID(),
+		"cve_id":     alert.GetSecurityAdvisory().GetCVEID(),
+	}
+}
+
+func formatTimestamp(ts *github.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.Format(time.RFC3339)
+}
@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package alerts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+var alertCreatedAt = &github.Timestamp{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+// fakeAlertsProvider returns one alert of each kind, unless the kind is disabled
+type fakeAlertsProvider struct {
+	disabled map[string]error
+}
+
+func (f *fakeAlertsProvider) ListCodeScanningAlerts(_ context.Context, _, _ string) ([]*github.Alert, error) {
+	if err := f.disabled[KindCodeScanning]; err != nil {
+		return nil, err
+	}
+	return []*github.Alert{{
+		Number:    github.Int(1),
+		CreatedAt: alertCreatedAt,
+		HTMLURL:   github.String("https://github.com/mindersec/minder/security/code-scanning/1"),
+		Rule: &github.Rule{
+			ID:                    github.String("go/sql-injection"),
+			Severity:              github.String("error"),
+			SecuritySeverityLevel: github.String("critical"),
+			Description:           github.String("Database query built from user-controlled sources"),
+		},
+		Tool: &github.Tool{Name: github.String("CodeQL")},
+	}}, nil
+}
+
+func (f *fakeAlertsProvider) ListSecretScanningAlerts(_ context.Context, _, _ string) ([]*github.SecretScanningAlert, error) {
+	if err := f.disabled[KindSecretScanning]; err != nil {
+		return nil, err
+	}
+	return []*github.SecretScanningAlert{{
+		Number:                github.Int(2),
+		CreatedAt:             alertCreatedAt,
+		HTMLURL:               github.String("https://github.com/mindersec/minder/security/secret-scanning/2"),
+		SecretType:            github.String("github_personal_access_token"),
+		SecretTypeDisplayName: github.String("GitHub Personal Access Token"),
+	}}, nil
+}
+
+func (f *fakeAlertsProvider) ListDependabotAlerts(_ context.Context, _, _ string) ([]*github.DependabotAlert, error) {
+	if err := f.disabled[KindDependabot]; err != nil {
+		return nil, err
+	}
+	return []*github.DependabotAlert{{
+		Number:    github.Int(3),
+		CreatedAt: alertCreatedAt,
+		HTMLURL:   github.String("https://github.com/mindersec/minder/security/dependabot/3"),
+		Dependency: &github.Dependency{
+			Package: &github.VulnerabilityPackage{
+				Ecosystem: github.String("go"),
+				Name:      github.String("golang.org/x/net"),
+			},
+			ManifestPath: github.String("go.mod"),
+		},
+		SecurityAdvisory: &github.DependabotSecurityAdvisory{
+			GHSAID:   github.String("GHSA-xxxx-xxxx-xxxx"),
+			CVEID:    github.String("CVE-2025-0001"),
+			Summary:  github.String("HTTP/2 rapid reset"),
+			Severity: github.String("high"),
+		},
+	}}, nil
+}
+
+func TestAlertsIngest(t *testing.T) {
+	t.Parallel()
+
+	repo := &pb.Repository{Owner: "mindersec", Name: "minder"}
+
+	tests := []struct {
+		name     string
+		kinds    []string
+		disabled map[string]error
+		want     map[string]any
+		wantErr  bool
+	}{
+		{
+			name: "all kinds by default",
+			want: map[string]any{
+				KindCodeScanning: map[string]any{
+					"enabled": true,
+					"alerts": []any{map[string]any{
+						"number":     1,
+						"severity":   "critical",
+						"created_at": "2025-01-01T00:00:00Z",
+						"url":        "https://github.com/mindersec/minder/security/code-scanning/1",
+						"summary":    "Database query built from user-controlled sources",
+						"rule_id":    "go/sql-injection",
+						"tool":       "CodeQL",
+					}},
+				},
+				KindSecretScanning: map[string]any{
+					"enabled": true,
+					"alerts": []any{map[string]any{
+						"number":      2,
+						"severity":    "",
+						"created_at":  "2025-01-01T00:00:00Z",
+						"url":         "https://github.com/mindersec/minder/security/secret-scanning/2",
+						"summary":     "GitHub Personal Access Token",
+						"secret_type": "github_personal_access_token",
+					}},
+				},
+				KindDependabot: map[string]any{
+					"enabled": true,
+					"alerts": []any{map[string]any{
+						"number":     3,
+						"severity":   "high",
+						"created_at": "2025-01-01T00:00:00Z",
+						"url":        "https://github.com/mindersec/minder/security/dependabot/3",
+						"summary":    "HTTP/2 rapid reset",
+						"package":    "golang.org/x/net",
+						"ecosystem":  "go",
+						"manifest":   "go.mod",
+						"ghsa_id":    "GHSA-xxxx-xxxx-xxxx",
+						"cve_id":     "CVE-2025-0001",
+					}},
+				},
+			},
+		},
+		{
+			name:  "disabled kind",
+			kinds: []string{KindSecretScanning},
+			disabled: map[string]error{
+				KindSecretScanning: fmt.Errorf("%w: 404 Not Found", provifv1.ErrSecurityAlertsDisabled),
+			},
+			want: map[string]any{
+				KindSecretScanning: map[string]any{
+					"enabled": false,
+					"alerts":  []any{},
+				},
+			},
+		},
+		{
+			name:  "error listing alerts",
+			kinds: []string{KindDependabot},
+			disabled: map[string]error{
+				KindDependabot: errors.New("500 Internal Server Error"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ing, err := NewAlertsIngester(&pb.AlertsType{Kinds: tt.kinds}, &fakeAlertsProvider{disabled: tt.disabled})
+			require.NoError(t, err)
+
+			got, err := ing.Ingest(context.Background(), repo, nil)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.Object)
+		})
+	}
+}
+
+func TestAlertsIngestNotRepository(t *testing.T) {
+	t.Parallel()
+
+	ing, err := NewAlertsIngester(nil, &fakeAlertsProvider{})
+	require.NoError(t, err)
+
+	_, err = ing.Ingest(context.Background(), &pb.Artifact{}, nil)
+	require.Error(t, err)
+}
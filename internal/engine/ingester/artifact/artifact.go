@@ -16,7 +16,7 @@ import (
 
 	evalerrors "github.com/mindersec/minder/internal/engine/errors"
 	artif "github.com/mindersec/minder/internal/providers/artifact"
-	"github.com/mindersec/minder/internal/verifier"
+	"github.com/mindersec/minder/internal/verifier/sigstore"
 	"github.com/mindersec/minder/internal/verifier/sigstore/container"
 	"github.com/mindersec/minder/internal/verifier/verifyif"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -43,6 +43,9 @@ type Ingest struct {
 	// artifactVerifier is the verifier for sigstore. It's only used in the Ingest method
 	// but we store it in the Ingest structure to allow tests to set a custom artifactVerifier
 	artifactVerifier verifyif.ArtifactVerifier
+
+	// trustRoots resolves the custom trust roots referenced by the rule parameters
+	trustRoots sigstore.TrustRootResolver
 }
 
 type verification struct {
@@ -54,6 +57,39 @@ type verification struct {
 	RunnerEnvironment string               `json:"runner_environment"`
 	CertIssuer        string               `json:"cert_issuer"`
 	Attestation       *verifiedAttestation `json:"attestation,omitempty"`
+	Signature         *verifiedSignature   `json:"signature,omitempty"`
+	// Attestations are the attestations attached to the artifact version as OCI referrers
+	Attestations []ingestedAttestation `json:"attestations,omitempty"`
+}
+
+// verifiedSignature holds the details of a verified signature
+type verifiedSignature struct {
+	// KeyID is the ID of the trusted public key which verified a key-based signature
+	KeyID string `json:"key_id,omitempty"`
+	// MatchedIdentity is the name of the identity policy the signing certificate matched
+	MatchedIdentity string `json:"matched_identity,omitempty"`
+	// Certificate holds the identity and extensions of the signing certificate of a keyless signature
+	Certificate *signingCertificate `json:"certificate,omitempty"`
+	// Timestamps are the verified timestamps of the signature
+	Timestamps []verifiedTimestamp `json:"timestamps,omitempty"`
+}
+
+type signingCertificate struct {
+	Issuer                 string `json:"issuer"`
+	Subject                string `json:"subject"`
+	WorkflowRef            string `json:"workflow_ref,omitempty"`
+	BuildConfigURI         string `json:"build_config_uri,omitempty"`
+	BuildTrigger           string `json:"build_trigger,omitempty"`
+	SourceRepositoryURI    string `json:"source_repository_uri,omitempty"`
+	SourceRepositoryRef    string `json:"source_repository_ref,omitempty"`
+	SourceRepositoryDigest string `json:"source_repository_digest,omitempty"`
+	RunnerEnvironment      string `json:"runner_environment,omitempty"`
+}
+
+type verifiedTimestamp struct {
+	Type      string    `json:"type"`
+	URI       string    `json:"uri,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
 }
 
 type verifiedAttestation struct {
@@ -73,6 +109,12 @@ func (*Ingest) GetType() string {
 	return ArtifactRuleDataIngestType
 }
 
+// SetTrustRootResolver sets the resolver for the custom trust roots
+// referenced by the rule parameters. Implements options.SupportsTrustRoots.
+func (i *Ingest) SetTrustRootResolver(resolver sigstore.TrustRootResolver) {
+	i.trustRoots = resolver
+}
+
 // GetConfig returns the config for the artifact rule data ingest engine
 func (*Ingest) GetConfig() proto.Message {
 	return nil
@@ -184,7 +226,7 @@ func (i *Ingest) getVerificationResult(
 ) ([]verification, error) {
 	var versionResults []verification
 	// Get the verifier for sigstore
-	artifactVerifier, err := getVerifier(i, cfg)
+	artifactVerifier, err := getVerifier(ctx, i, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("error getting verifier: %w", err)
 	}
@@ -200,6 +242,15 @@ func (i *Ingest) getVerificationResult(
 			zerolog.Ctx(ctx).Debug().Err(err).Str("name", artifactName).Msg("failed getting signature information")
 			return nil, fmt.Errorf("failed getting signature information: %w", err)
 		}
+
+		var attestations []ingestedAttestation
+		if cfg.Attestations != nil {
+			attestations, err = i.getAttestations(ctx, cfg, artifactVerifier, artifact, artifactChecksum)
+			if err != nil {
+				return nil, fmt.Errorf("failed getting attestations: %w", err)
+			}
+		}
+
 		// Loop through all results and build the verification result for each
 		for _, res := range results {
 			// Log a debug message in case we failed to find or verify any signature information for the artifact version
@@ -210,12 +261,16 @@ func (i *Ingest) getVerificationResult(
 
 			// Begin building the verification result
 			verResult := &verification{
-				IsSigned:   res.IsSigned,
-				IsVerified: res.IsVerified,
+				IsSigned:     res.IsSigned,
+				IsVerified:   res.IsVerified,
+				Attestations: attestations,
 			}
 
 			// If we got verified provenance info for the artifact version, populate the rest of the verification result
-			if res.IsVerified {
+			if res.IsVerified && res.Signature.Certificate == nil {
+				// Key-based signatures carry no signing certificate
+				verResult.Signature = signatureFromResult(&res)
+			} else if res.IsVerified {
 				siIdentity, err := signerIdentityFromCertificate(res.Signature.Certificate)
 				if err != nil {
 					zerolog.Ctx(ctx).Err(err).Msg("error parsing signer identity")
@@ -226,6 +281,7 @@ func (i *Ingest) getVerificationResult(
 				verResult.SignerIdentity = siIdentity
 				verResult.RunnerEnvironment = res.Signature.Certificate.RunnerEnvironment
 				verResult.CertIssuer = res.Signature.Certificate.Issuer
+				verResult.Signature = signatureFromResult(&res)
 			}
 
 			if res.Statement != nil {
@@ -241,7 +297,7 @@ func (i *Ingest) getVerificationResult(
 	return versionResults, nil
 }
 
-func getVerifier(i *Ingest, cfg *ingesterConfig) (verifyif.ArtifactVerifier, error) {
+func getVerifier(ctx context.Context, i *Ingest, cfg *ingesterConfig) (verifyif.ArtifactVerifier, error) {
 	if i.artifactVerifier != nil {
 		return i.artifactVerifier, nil
 	}
@@ -249,20 +305,34 @@ func getVerifier(i *Ingest, cfg *ingesterConfig) (verifyif.ArtifactVerifier, err
 	verifieropts := []container.AuthMethod{}
 	if ghcli, err := interfaces.As[provifv1.GitHub](i.prov); err == nil {
 		verifieropts = append(verifieropts, container.WithGitHubClient(ghcli))
-	} else if ocicli, err := interfaces.As[provifv1.OCI](i.prov); err == nil {
-		cauthn, err := ocicli.GetAuthenticator()
+	} else if regcli, err := interfaces.As[provifv1.ContainerRegistry](i.prov); err == nil {
+		cauthn, err := regcli.GetAuthenticator()
 		if err != nil {
-			return nil, fmt.Errorf("unable to get oci authenticator: %w", err)
+			return nil, fmt.Errorf("unable to get registry authenticator: %w", err)
 		}
-		verifieropts = append(verifieropts, container.WithRegistry(ocicli.GetRegistry()),
+		verifieropts = append(verifieropts, container.WithRegistry(regcli.GetRegistry()),
 			container.WithAuthenticator(cauthn))
 	}
 
-	artifactVerifier, err := verifier.NewVerifier(
-		verifier.VerifierSigstore,
-		cfg.Sigstore,
-		verifieropts...,
-	)
+	opts := &sigstore.Options{
+		TUFRepoURL: cfg.Sigstore,
+		Policy:     container.Policy{Identities: cfg.Identities},
+		AuthOpts:   verifieropts,
+	}
+
+	// A custom trust root takes precedence over the well-known sigstore instances
+	if cfg.TrustRoot != "" {
+		if i.trustRoots == nil {
+			return nil, fmt.Errorf("custom trust roots are not available")
+		}
+		trustRoot, err := i.trustRoots.ResolveTrustRoot(ctx, cfg.TrustRoot)
+		if err != nil {
+			return nil, fmt.Errorf("error resolving trust root: %w", err)
+		}
+		opts.TrustRoot = trustRoot
+	}
+
+	artifactVerifier, err := sigstore.NewWithOptions(opts)
 	if err != nil {
 		return nil, fmt.Errorf("error getting sigstore verifier: %w", err)
 	}
@@ -270,6 +340,35 @@ func getVerifier(i *Ingest, cfg *ingesterConfig) (verifyif.ArtifactVerifier, err
 	return artifactVerifier, nil
 }
 
+// signatureFromResult builds the signature details exposed to rules from the verification result
+func signatureFromResult(res *verifyif.Result) *verifiedSignature {
+	sig := &verifiedSignature{
+		KeyID:           res.KeyID,
+		MatchedIdentity: res.MatchedIdentity,
+	}
+	if c := res.Signature.Certificate; c != nil {
+		sig.Certificate = &signingCertificate{
+			Issuer:                 c.Issuer,
+			Subject:                c.SubjectAlternativeName,
+			WorkflowRef:            c.BuildSignerURI,
+			BuildConfigURI:         c.BuildConfigURI,
+			BuildTrigger:           c.BuildTrigger,
+			SourceRepositoryURI:    c.SourceRepositoryURI,
+			SourceRepositoryRef:    c.SourceRepositoryRef,
+			SourceRepositoryDigest: c.SourceRepositoryDigest,
+			RunnerEnvironment:      c.RunnerEnvironment,
+		}
+	}
+	for _, ts := range res.VerifiedTimestamps {
+		sig.Timestamps = append(sig.Timestamps, verifiedTimestamp{
+			Type:      ts.Type,
+			URI:       ts.URI,
+			Timestamp: ts.Timestamp,
+		})
+	}
+	return sig
+}
+
 // getAndFilterArtifactVersions fetches the available versions and filters the
 // ones that apply to the rule. Note that this returns the checksums of the
 // applicable artifact versions.
@@ -5,10 +5,15 @@ package artifact
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"testing"
 	"time"
 
 	"github.com/sigstore/sigstore-go/pkg/fulcio/certificate"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -18,8 +23,11 @@ import (
 	"github.com/mindersec/minder/internal/providers/github/clients"
 	mockghclient "github.com/mindersec/minder/internal/providers/github/mock"
 	"github.com/mindersec/minder/internal/providers/github/properties"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	"github.com/mindersec/minder/internal/providers/ratecache"
 	"github.com/mindersec/minder/internal/providers/telemetry"
+	"github.com/mindersec/minder/internal/verifier/sigstore"
+	"github.com/mindersec/minder/internal/verifier/sigstore/container"
 	"github.com/mindersec/minder/internal/verifier/verifyif"
 	mockverify "github.com/mindersec/minder/internal/verifier/verifyif/mock"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -41,7 +49,7 @@ func testGithubProvider() (provinfv1.GitHub, error) {
 		nil,
 		&ratecache.NoopRestClientCache{},
 		credentials.NewGitHubTokenCredential("token"),
-		clients.NewGitHubClientFactory(telemetry.NewNoopMetrics()),
+		clients.NewGitHubClientFactory(telemetry.NewNoopMetrics(), &ratebudget.NoopTracker{}),
 		properties.NewPropertyFetcherFactory(),
 		"",
 	)
@@ -325,3 +333,142 @@ func TestSignerIdentityFromCertificate(t *testing.T) {
 		})
 	}
 }
+
+func TestSignatureFromResult(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	for _, tc := range []struct {
+		name     string
+		sut      *verifyif.Result
+		expected *verifiedSignature
+	}{
+		{
+			name: "keyless",
+			sut: &verifyif.Result{
+				IsSigned:        true,
+				IsVerified:      true,
+				MatchedIdentity: "release",
+				VerificationResult: verify.VerificationResult{
+					Signature: &verify.SignatureVerificationResult{
+						Certificate: &certificate.Summary{
+							SubjectAlternativeName: "https://github.com/openvex/vexctl/.github/workflows/release.yaml@refs/tags/v0.2.6",
+							Extensions: certificate.Extensions{
+								Issuer:              githubTokenIssuer,
+								BuildSignerURI:      "https://github.com/openvex/vexctl/.github/workflows/release.yaml@refs/tags/v0.2.6",
+								SourceRepositoryURI: "https://github.com/openvex/vexctl",
+								SourceRepositoryRef: "refs/tags/v0.2.6",
+							},
+						},
+					},
+					VerifiedTimestamps: []verify.TimestampVerificationResult{
+						{Type: "Tlog", URI: "https://rekor.example.com", Timestamp: now},
+					},
+				},
+			},
+			expected: &verifiedSignature{
+				MatchedIdentity: "release",
+				Certificate: &signingCertificate{
+					Issuer:              githubTokenIssuer,
+					Subject:             "https://github.com/openvex/vexctl/.github/workflows/release.yaml@refs/tags/v0.2.6",
+					WorkflowRef:         "https://github.com/openvex/vexctl/.github/workflows/release.yaml@refs/tags/v0.2.6",
+					SourceRepositoryURI: "https://github.com/openvex/vexctl",
+					SourceRepositoryRef: "refs/tags/v0.2.6",
+				},
+				Timestamps: []verifiedTimestamp{
+					{Type: "Tlog", URI: "https://rekor.example.com", Timestamp: now},
+				},
+			},
+		},
+		{
+			name: "key-based",
+			sut: &verifyif.Result{
+				IsSigned:   true,
+				IsVerified: true,
+				KeyID:      "release-key",
+				VerificationResult: verify.VerificationResult{
+					Signature: &verify.SignatureVerificationResult{},
+				},
+			},
+			expected: &verifiedSignature{
+				KeyID: "release-key",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, signatureFromResult(tc.sut))
+		})
+	}
+}
+
+type fakeTrustRootResolver struct {
+	roots map[string]*sigstore.TrustRoot
+}
+
+func (f *fakeTrustRootResolver) ResolveTrustRoot(_ context.Context, name string) (*sigstore.TrustRoot, error) {
+	if tr, ok := f.roots[name]; ok {
+		return tr, nil
+	}
+	return nil, sigstore.ErrTrustRootNotFound
+}
+
+func TestGetVerifierWithTrustRoot(t *testing.T) {
+	t.Parallel()
+
+	// A trust root with a single public key can be built without network access
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pemKey, err := cryptoutils.MarshalPublicKeyToPEM(key.Public())
+	require.NoError(t, err)
+
+	resolver := &fakeTrustRootResolver{
+		roots: map[string]*sigstore.TrustRoot{
+			"release-keys": {PublicKeys: map[string][]byte{"release": pemKey}},
+		},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		resolver sigstore.TrustRootResolver
+		cfg      *ingesterConfig
+		mustErr  bool
+	}{
+		{
+			name:     "existing-trust-root",
+			resolver: resolver,
+			cfg:      &ingesterConfig{TrustRoot: "release-keys"},
+		},
+		{
+			name:     "unknown-trust-root",
+			resolver: resolver,
+			cfg:      &ingesterConfig{TrustRoot: "unknown"},
+			mustErr:  true,
+		},
+		{
+			name:    "no-resolver",
+			cfg:     &ingesterConfig{TrustRoot: "release-keys"},
+			mustErr: true,
+		},
+		{
+			name:     "invalid-identity-policy",
+			resolver: resolver,
+			cfg: &ingesterConfig{
+				TrustRoot:  "release-keys",
+				Identities: []container.IdentityPolicy{{SubjectRegex: "("}},
+			},
+			mustErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ing := &Ingest{trustRoots: tc.resolver}
+			v, err := getVerifier(context.Background(), ing, tc.cfg)
+			if tc.mustErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, v)
+		})
+	}
+}
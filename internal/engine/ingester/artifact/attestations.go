@@ -0,0 +1,310 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/providers/oci"
+	"github.com/mindersec/minder/internal/verifier/sigstore/container"
+	"github.com/mindersec/minder/internal/verifier/verifyif"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	predicateKindProvenance = "provenance"
+	predicateKindSBOM       = "sbom"
+	predicateKindVulns      = "vulns"
+	predicateKindOther      = "other"
+
+	slsaProvenancePrefix  = "https://slsa.dev/provenance/"
+	slsaProvenanceV02     = "https://slsa.dev/provenance/v0.2"
+	inTotoVulnsPrefix     = "https://in-toto.io/attestation/vulns"
+	cosignVulnPredicate   = "https://cosign.sigstore.dev/attestation/vuln/v1"
+	spdxPredicatePrefix   = "https://spdx.dev/Document"
+	cyclonedxPredicateURI = "https://cyclonedx.org/bom"
+
+	// ghcrRegistry is the registry of the container images of GitHub
+	ghcrRegistry = "ghcr.io"
+)
+
+// attestationsConfig configures the ingestion of the in-toto attestations
+// attached to the artifact as OCI referrers
+type attestationsConfig struct {
+	// PredicateTypes limits the ingested attestations to the given predicate
+	// types or kinds (provenance, sbom, vulns). All attestations are ingested if empty.
+	PredicateTypes []string `yaml:"predicate_types" json:"predicate_types" mapstructure:"predicate_types"`
+}
+
+func (c *attestationsConfig) wants(predicateType string) bool {
+	if len(c.PredicateTypes) == 0 {
+		return true
+	}
+	return slices.Contains(c.PredicateTypes, predicateType) ||
+		slices.Contains(c.PredicateTypes, predicateKind(predicateType))
+}
+
+// ingestedAttestation is an in-toto attestation about an artifact version
+type ingestedAttestation struct {
+	PredicateType string `json:"predicate_type"`
+	// Kind is the kind of the predicate: provenance, sbom, vulns or other
+	Kind       string             `json:"kind"`
+	IsVerified bool               `json:"is_verified"`
+	Signature  *verifiedSignature `json:"signature,omitempty"`
+	// Predicate is the decoded predicate, only set if the attestation was verified
+	Predicate map[string]any `json:"predicate,omitempty"`
+	// Provenance summarizes SLSA provenance predicates
+	Provenance *provenance `json:"provenance,omitempty"`
+}
+
+// provenance holds the fields of a SLSA provenance predicate rules usually check
+type provenance struct {
+	BuilderID string `json:"builder_id"`
+	BuildType string `json:"build_type"`
+	// SourceRepository is the URI of the source the artifact was built from, without the git ref
+	SourceRepository string            `json:"source_repository,omitempty"`
+	SourceRef        string            `json:"source_ref,omitempty"`
+	SourceDigest     map[string]string `json:"source_digest,omitempty"`
+	// Materials are all the resolved dependencies of the build
+	Materials []provenanceMaterial `json:"materials,omitempty"`
+}
+
+type provenanceMaterial struct {
+	URI    string            `json:"uri"`
+	Digest map[string]string `json:"digest,omitempty"`
+}
+
+// getAttestations fetches the sigstore bundles attached to the artifact version as OCI
+// referrers and verifies the attestations they carry
+func (i *Ingest) getAttestations(
+	ctx context.Context,
+	cfg *ingesterConfig,
+	artifactVerifier verifyif.ArtifactVerifier,
+	artifact *pb.Artifact,
+	checksum string,
+) ([]ingestedAttestation, error) {
+	logger := zerolog.Ctx(ctx).With().Str("name", artifact.GetName()).Str("digest", checksum).Logger()
+
+	ocicli, err := i.getReferrerClient(artifact)
+	if err != nil {
+		logger.Debug().Err(err).Msg("cannot fetch OCI referrers, skipping attestations")
+		return nil, nil
+	}
+
+	// Registries which don't support the referrers API, or an artifact
+	// without referrers, leave the artifact without attestations rather
+	// than failing the evaluation.
+	idx, err := ocicli.GetReferrer(ctx, artifact.GetName(), checksum, container.SigstoreBundleArtifactType)
+	if err != nil {
+		logger.Err(err).Msg("error getting referrers, skipping attestations")
+		return nil, nil
+	}
+
+	var out []ingestedAttestation
+	for _, desc := range idx.Manifests {
+		// Skip the attestations we don't want early if the referrer tells us the predicate type
+		if pt, ok := desc.Annotations[container.PredicateTypeAnnotation]; ok && !cfg.Attestations.wants(pt) {
+			continue
+		}
+
+		bundles, err := getReferrerBundles(ctx, ocicli, artifact.GetName(), desc.Digest.String())
+		if err != nil {
+			logger.Err(err).Str("referrer", desc.Digest.String()).Msg("error fetching referrer")
+			continue
+		}
+
+		for _, b := range bundles {
+			res, err := artifactVerifier.VerifyAttestation(ctx, b, checksum)
+			if err != nil {
+				logger.Err(err).Str("referrer", desc.Digest.String()).Msg("error reading attestation")
+				continue
+			}
+
+			att := attestationFromResult(&res, desc.Annotations[container.PredicateTypeAnnotation])
+			if !cfg.Attestations.wants(att.PredicateType) {
+				continue
+			}
+			out = append(out, att)
+		}
+	}
+
+	return out, nil
+}
+
+// referrerClient is the subset of the OCI provider needed to fetch the
+// attestations of an artifact
+type referrerClient interface {
+	GetReferrer(ctx context.Context, name, tag, artifactType string) (*v1.IndexManifest, error)
+	GetManifest(ctx context.Context, name, tag string) (*v1.Manifest, error)
+	GetBlob(ctx context.Context, name, digest string, maxBytes int64) ([]byte, error)
+}
+
+// getReferrerClient returns the client to fetch the referrers of the artifact
+// with. Providers which host a registry without being an OCI provider, such
+// as GitHub (GHCR) and GitLab, get a registry client authenticated the same
+// way as the signature verification.
+func (i *Ingest) getReferrerClient(artifact *pb.Artifact) (referrerClient, error) {
+	if ocicli, err := interfaces.As[provifv1.OCI](i.prov); err == nil {
+		return ocicli, nil
+	}
+
+	var registry string
+	var auth authn.Authenticator
+	if ghcli, err := interfaces.As[provifv1.GitHub](i.prov); err == nil {
+		registry = ghcrRegistry
+		auth = ghcli.GetCredential().GetAsContainerAuthenticator(artifact.GetOwner())
+	} else if regcli, err := interfaces.As[provifv1.ContainerRegistry](i.prov); err == nil {
+		registry = regcli.GetRegistry()
+		auth, err = regcli.GetAuthenticator()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get registry authenticator: %w", err)
+		}
+	} else {
+		return nil, errors.New("provider does not host a container registry")
+	}
+
+	return oci.NewWithAuthenticator(auth, registry, path.Join(registry, artifact.GetOwner())), nil
+}
+
+// getReferrerBundles returns the sigstore bundles in the layers of the referrer manifest
+func getReferrerBundles(ctx context.Context, ocicli referrerClient, name, digest string) ([][]byte, error) {
+	man, err := ocicli.GetManifest(ctx, name, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	var bundles [][]byte
+	for _, layer := range man.Layers {
+		if layer.MediaType != container.SigstoreBundleArtifactType {
+			continue
+		}
+		b, err := ocicli.GetBlob(ctx, name, layer.Digest.String(), container.MaxAttestationsBytesLimit)
+		if err != nil {
+			return nil, err
+		}
+		bundles = append(bundles, b)
+	}
+	return bundles, nil
+}
+
+// attestationFromResult builds the attestation exposed to rules from its verification result.
+// The predicate type is taken from the referrer annotation if the attestation couldn't be verified.
+func attestationFromResult(res *verifyif.Result, annotatedType string) ingestedAttestation {
+	att := ingestedAttestation{
+		PredicateType: annotatedType,
+		IsVerified:    res.IsVerified,
+	}
+
+	// Don't expose the content of attestations we can't trust
+	if res.IsVerified && res.Statement != nil {
+		att.PredicateType = res.Statement.GetPredicateType()
+		att.Signature = signatureFromResult(res)
+		att.Predicate = res.Statement.GetPredicate().AsMap()
+		if predicateKind(att.PredicateType) == predicateKindProvenance {
+			att.Provenance = provenanceFromPredicate(att.PredicateType, att.Predicate)
+		}
+	}
+
+	att.Kind = predicateKind(att.PredicateType)
+	return att
+}
+
+func predicateKind(predicateType string) string {
+	switch {
+	case strings.HasPrefix(predicateType, slsaProvenancePrefix):
+		return predicateKindProvenance
+	case strings.HasPrefix(predicateType, spdxPredicatePrefix),
+		strings.HasPrefix(predicateType, cyclonedxPredicateURI):
+		return predicateKindSBOM
+	case predicateType == cosignVulnPredicate,
+		strings.HasPrefix(predicateType, inTotoVulnsPrefix):
+		return predicateKindVulns
+	default:
+		return predicateKindOther
+	}
+}
+
+// slsaPredicate holds the fields we read from SLSA provenance predicates, both v1 and v0.2
+type slsaPredicate struct {
+	// v1
+	BuildDefinition struct {
+		BuildType            string               `json:"buildType"`
+		ResolvedDependencies []provenanceMaterial `json:"resolvedDependencies"`
+	} `json:"buildDefinition"`
+	RunDetails struct {
+		Builder struct {
+			ID string `json:"id"`
+		} `json:"builder"`
+	} `json:"runDetails"`
+
+	// v0.2
+	Builder struct {
+		ID string `json:"id"`
+	} `json:"builder"`
+	BuildType  string `json:"buildType"`
+	Invocation struct {
+		ConfigSource provenanceMaterial `json:"configSource"`
+	} `json:"invocation"`
+	Materials []provenanceMaterial `json:"materials"`
+}
+
+// provenanceFromPredicate summarizes a SLSA provenance predicate. The source is the
+// first resolved dependency, which is the convention followed by the common builders.
+func provenanceFromPredicate(predicateType string, predicate map[string]any) *provenance {
+	raw, err := json.Marshal(predicate)
+	if err != nil {
+		return nil
+	}
+	var slsa slsaPredicate
+	if err := json.Unmarshal(raw, &slsa); err != nil {
+		return nil
+	}
+
+	var prov provenance
+	var source *provenanceMaterial
+	if predicateType == slsaProvenanceV02 {
+		prov.BuilderID = slsa.Builder.ID
+		prov.BuildType = slsa.BuildType
+		prov.Materials = slsa.Materials
+		source = &slsa.Invocation.ConfigSource
+		if source.URI == "" && len(slsa.Materials) > 0 {
+			source = &slsa.Materials[0]
+		}
+	} else {
+		prov.BuilderID = slsa.RunDetails.Builder.ID
+		prov.BuildType = slsa.BuildDefinition.BuildType
+		prov.Materials = slsa.BuildDefinition.ResolvedDependencies
+		if len(prov.Materials) > 0 {
+			source = &prov.Materials[0]
+		}
+	}
+
+	if source != nil && source.URI != "" {
+		prov.SourceRepository, prov.SourceRef = splitSourceURI(source.URI)
+		prov.SourceDigest = source.Digest
+	}
+	return &prov
+}
+
+// splitSourceURI splits a source URI such as git+https://github.com/org/repo@refs/heads/main
+// into the repository URI and the git ref
+func splitSourceURI(uri string) (string, string) {
+	uri = strings.TrimPrefix(uri, "git+")
+	if idx := strings.LastIndex(uri, "@"); idx > strings.Index(uri, "://")+2 {
+		return uri[:idx], uri[idx+1:]
+	}
+	return uri, ""
+}
@@ -0,0 +1,320 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/partial"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	intoto "github.com/in-toto/attestation/go/v1"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/providers/credentials"
+	mockghclient "github.com/mindersec/minder/internal/providers/github/mock"
+	"github.com/mindersec/minder/internal/providers/gitlab"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
+	"github.com/mindersec/minder/internal/verifier/sigstore/container"
+	"github.com/mindersec/minder/internal/verifier/verifyif"
+	mockverify "github.com/mindersec/minder/internal/verifier/verifyif/mock"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	testDigest    = "sha256:8d4a3c5f3e1ef0e0a1d1b2b9a2c0d8a6c1f0e3b8b0e2f9a4d7c6b5a4f3e2d1c0"
+	slsaV1        = "https://slsa.dev/provenance/v1"
+	spdxPredicate = "https://spdx.dev/Document"
+)
+
+func slsaV1Predicate(t *testing.T) *structpb.Struct {
+	t.Helper()
+	predicate, err := structpb.NewStruct(map[string]any{
+		"buildDefinition": map[string]any{
+			"buildType": "https://actions.github.io/buildtypes/workflow/v1",
+			"resolvedDependencies": []any{
+				map[string]any{
+					"uri":    "git+https://github.com/stacklok/demo@refs/heads/main",
+					"digest": map[string]any{"gitCommit": "0123456789abcdef"},
+				},
+			},
+		},
+		"runDetails": map[string]any{
+			"builder": map[string]any{
+				"id": "https://github.com/actions/runner/github-hosted",
+			},
+		},
+	})
+	require.NoError(t, err)
+	return predicate
+}
+
+func TestProvenanceFromPredicate(t *testing.T) {
+	t.Parallel()
+
+	v02, err := structpb.NewStruct(map[string]any{
+		"builder":   map[string]any{"id": "https://github.com/slsa-framework/slsa-github-generator/.github/workflows/generator_container_slsa3.yml@refs/tags/v1.9.0"},
+		"buildType": "https://github.com/slsa-framework/slsa-github-generator/container@v1",
+		"invocation": map[string]any{
+			"configSource": map[string]any{
+				"uri":    "git+https://github.com/stacklok/demo@refs/tags/v1.0.0",
+				"digest": map[string]any{"sha1": "fedcba9876543210"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name          string
+		predicateType string
+		predicate     map[string]any
+		expected      *provenance
+	}{
+		{
+			name:          "slsa-v1",
+			predicateType: slsaV1,
+			predicate:     slsaV1Predicate(t).AsMap(),
+			expected: &provenance{
+				BuilderID:        "https://github.com/actions/runner/github-hosted",
+				BuildType:        "https://actions.github.io/buildtypes/workflow/v1",
+				SourceRepository: "https://github.com/stacklok/demo",
+				SourceRef:        "refs/heads/main",
+				SourceDigest:     map[string]string{"gitCommit": "0123456789abcdef"},
+				Materials: []provenanceMaterial{{
+					URI:    "git+https://github.com/stacklok/demo@refs/heads/main",
+					Digest: map[string]string{"gitCommit": "0123456789abcdef"},
+				}},
+			},
+		},
+		{
+			name:          "slsa-v0.2",
+			predicateType: slsaProvenanceV02,
+			predicate:     v02.AsMap(),
+			expected: &provenance{
+				BuilderID:        "https://github.com/slsa-framework/slsa-github-generator/.github/workflows/generator_container_slsa3.yml@refs/tags/v1.9.0",
+				BuildType:        "https://github.com/slsa-framework/slsa-github-generator/container@v1",
+				SourceRepository: "https://github.com/stacklok/demo",
+				SourceRef:        "refs/tags/v1.0.0",
+				SourceDigest:     map[string]string{"sha1": "fedcba9876543210"},
+			},
+		},
+		{
+			name:          "empty",
+			predicateType: slsaV1,
+			predicate:     map[string]any{},
+			expected:      &provenance{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expected, provenanceFromPredicate(tc.predicateType, tc.predicate))
+		})
+	}
+}
+
+func TestAttestationFromResult(t *testing.T) {
+	t.Parallel()
+
+	statement := &intoto.Statement{
+		PredicateType: slsaV1,
+		Predicate:     slsaV1Predicate(t),
+	}
+
+	// Unverified attestations don't expose their content
+	att := attestationFromResult(&verifyif.Result{
+		IsSigned: true,
+		VerificationResult: verify.VerificationResult{
+			Statement: statement,
+		},
+	}, spdxPredicate)
+	require.Equal(t, ingestedAttestation{
+		PredicateType: spdxPredicate,
+		Kind:          predicateKindSBOM,
+	}, att)
+
+	// The predicate type of the statement takes precedence over the annotation
+	att = attestationFromResult(&verifyif.Result{
+		IsSigned:   true,
+		IsVerified: true,
+		KeyID:      "release",
+		VerificationResult: verify.VerificationResult{
+			Statement: statement,
+			Signature: &verify.SignatureVerificationResult{},
+		},
+	}, spdxPredicate)
+	require.Equal(t, slsaV1, att.PredicateType)
+	require.Equal(t, predicateKindProvenance, att.Kind)
+	require.True(t, att.IsVerified)
+	require.Equal(t, "release", att.Signature.KeyID)
+	require.Equal(t, "https://github.com/stacklok/demo", att.Provenance.SourceRepository)
+	require.Contains(t, att.Predicate, "buildDefinition")
+}
+
+func TestArtifactIngestAttestations(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockOCI := mockghclient.NewMockOCI(ctrl)
+	mockVerifier := mockverify.NewMockArtifactVerifier(ctrl)
+
+	mockOCI.EXPECT().
+		GetArtifactVersions(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]*pb.ArtifactVersion{{
+			Sha:       testDigest,
+			Tags:      []string{"latest"},
+			CreatedAt: timestamppb.New(time.Now()),
+		}}, nil)
+	mockVerifier.EXPECT().
+		Verify(gomock.Any(), verifyif.ArtifactTypeContainer, "stacklok", "demo", testDigest).
+		Return([]verifyif.Result{{IsSigned: false, IsVerified: false}}, nil)
+
+	provenanceRef := v1.Hash{Algorithm: "sha256", Hex: "aaaa"}
+	sbomRef := v1.Hash{Algorithm: "sha256", Hex: "bbbb"}
+	bundleLayer := v1.Hash{Algorithm: "sha256", Hex: "cccc"}
+	mockOCI.EXPECT().
+		GetReferrer(gomock.Any(), "demo", testDigest, container.SigstoreBundleArtifactType).
+		Return(&v1.IndexManifest{
+			Manifests: []v1.Descriptor{
+				{
+					Digest:      provenanceRef,
+					Annotations: map[string]string{container.PredicateTypeAnnotation: slsaV1},
+				},
+				{
+					Digest:      sbomRef,
+					Annotations: map[string]string{container.PredicateTypeAnnotation: spdxPredicate},
+				},
+			},
+		}, nil)
+	// The SBOM is filtered out by its annotation, so only the provenance is fetched
+	mockOCI.EXPECT().
+		GetManifest(gomock.Any(), "demo", provenanceRef.String()).
+		Return(&v1.Manifest{
+			Layers: []v1.Descriptor{{
+				MediaType: container.SigstoreBundleArtifactType,
+				Digest:    bundleLayer,
+			}},
+		}, nil)
+	mockOCI.EXPECT().
+		GetBlob(gomock.Any(), "demo", bundleLayer.String(), container.MaxAttestationsBytesLimit).
+		Return([]byte(`{}`), nil)
+	mockVerifier.EXPECT().
+		VerifyAttestation(gomock.Any(), []byte(`{}`), testDigest).
+		Return(verifyif.Result{
+			IsSigned:   true,
+			IsVerified: true,
+			VerificationResult: verify.VerificationResult{
+				Statement: &intoto.Statement{PredicateType: slsaV1, Predicate: slsaV1Predicate(t)},
+				Signature: &verify.SignatureVerificationResult{},
+			},
+		}, nil)
+
+	ing, err := NewArtifactDataIngest(mockOCI)
+	require.NoError(t, err)
+	ing.artifactVerifier = mockVerifier
+
+	got, err := ing.Ingest(context.Background(), &pb.Artifact{
+		Type:  "container",
+		Name:  "demo",
+		Owner: "stacklok",
+	}, map[string]any{
+		"name": "demo",
+		"attestations": map[string]any{
+			"predicate_types": []string{"provenance"},
+		},
+	})
+	require.NoError(t, err)
+
+	results, ok := got.Object.([]map[string]any)
+	require.True(t, ok)
+	require.Len(t, results, 1)
+	ver, ok := results[0]["Verification"].(verification)
+	require.True(t, ok)
+	require.Len(t, ver.Attestations, 1)
+	require.True(t, ver.Attestations[0].IsVerified)
+	require.Equal(t, "https://github.com/actions/runner/github-hosted", ver.Attestations[0].Provenance.BuilderID)
+}
+
+func TestGetAttestationsFromProviderRegistry(t *testing.T) {
+	t.Parallel()
+
+	regsrv := httptest.NewServer(registry.New(
+		registry.WithReferrersSupport(true),
+		registry.Logger(log.New(io.Discard, "", 0)),
+	))
+	t.Cleanup(regsrv.Close)
+	reg := strings.TrimPrefix(regsrv.URL, "http://")
+
+	// Push an image and a sigstore bundle referring to it
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	imgRef, err := name.ParseReference(reg + "/stacklok/demo:latest")
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(imgRef, img))
+	imgDesc, err := partial.Descriptor(img)
+	require.NoError(t, err)
+
+	bundle, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer: static.NewLayer([]byte(`{}`), container.SigstoreBundleArtifactType),
+	})
+	require.NoError(t, err)
+	bundle = mutate.ConfigMediaType(bundle, container.SigstoreBundleArtifactType)
+	bundle = mutate.Subject(bundle, *imgDesc).(v1.Image)
+	bundleDigest, err := bundle.Digest()
+	require.NoError(t, err)
+	bundleRef, err := name.ParseReference(reg + "/stacklok/demo@" + bundleDigest.String())
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(bundleRef, bundle))
+
+	prov, err := gitlab.New(credentials.NewGitLabTokenCredential("token"), &pb.GitLabProviderConfig{
+		Registry: reg,
+	}, "https://minder.example.com/api/v1/webhook/gitlab", "secret", &ratebudget.NoopTracker{})
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	mockVerifier := mockverify.NewMockArtifactVerifier(ctrl)
+	mockVerifier.EXPECT().
+		VerifyAttestation(gomock.Any(), []byte(`{}`), imgDesc.Digest.String()).
+		Return(verifyif.Result{
+			IsSigned:   true,
+			IsVerified: true,
+			VerificationResult: verify.VerificationResult{
+				Statement: &intoto.Statement{PredicateType: slsaV1, Predicate: slsaV1Predicate(t)},
+				Signature: &verify.SignatureVerificationResult{},
+			},
+		}, nil)
+
+	ing, err := NewArtifactDataIngest(prov)
+	require.NoError(t, err)
+
+	artifact := &pb.Artifact{Type: "container", Name: "demo", Owner: "stacklok"}
+	cfg := &ingesterConfig{Attestations: &attestationsConfig{}}
+
+	got, err := ing.getAttestations(context.Background(), cfg, mockVerifier, artifact, imgDesc.Digest.String())
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, slsaV1, got[0].PredicateType)
+
+	// Artifacts the registry doesn't know about are left without attestations
+	got, err = ing.getAttestations(context.Background(), cfg, mockVerifier, &pb.Artifact{
+		Type: "container", Name: "unknown", Owner: "stacklok",
+	}, imgDesc.Digest.String())
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
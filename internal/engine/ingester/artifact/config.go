@@ -9,6 +9,8 @@ import (
 	"strings"
 
 	"github.com/go-viper/mapstructure/v2"
+
+	"github.com/mindersec/minder/internal/verifier/sigstore/container"
 )
 
 type artifactType string
@@ -33,6 +35,13 @@ type ingesterConfig struct {
 	Sigstore string       `yaml:"sigstore" json:"sigstore" mapstructure:"sigstore"`
 	TagRegex string       `yaml:"tag_regex" json:"tag_regex" mapstructure:"tag_regex"`
 	Type     artifactType `yaml:"type" json:"type" mapstructure:"type"`
+	// TrustRoot is the name of a custom trust root of the project hierarchy to
+	// verify signatures against. It takes precedence over Sigstore.
+	TrustRoot string `yaml:"trust_root" json:"trust_root" mapstructure:"trust_root"`
+	// Identities are the keyless signing identities trusted to sign the artifact
+	Identities []container.IdentityPolicy `yaml:"identities" json:"identities" mapstructure:"identities"`
+	// Attestations enables the ingestion of the attestations attached to the artifact as OCI referrers
+	Attestations *attestationsConfig `yaml:"attestations" json:"attestations" mapstructure:"attestations"`
 }
 
 func configFromParams(params map[string]any) (*ingesterConfig, error) {
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/mindersec/minder/internal/engine/ingester/alerts"
 	"github.com/mindersec/minder/internal/engine/ingester/artifact"
 	"github.com/mindersec/minder/internal/engine/ingester/builtin"
 	"github.com/mindersec/minder/internal/engine/ingester/deps"
@@ -27,6 +28,7 @@ var _ interfaces.Ingester = (*rest.Ingestor)(nil)
 var _ interfaces.Ingester = (*git.Git)(nil)
 var _ interfaces.Ingester = (*diff.Diff)(nil)
 var _ interfaces.Ingester = (*deps.Deps)(nil)
+var _ interfaces.Ingester = (*alerts.Alerts)(nil)
 
 // NewRuleDataIngest creates a new rule data ingest based no the given rule
 // type definition.
@@ -74,6 +76,12 @@ func NewRuleDataIngest(rt *pb.RuleType, provider interfaces.Provider) (interface
 			return nil, errors.New("provider does not implement git trait")
 		}
 		return deps.NewDepsIngester(ing.GetDeps(), client)
+	case alerts.AlertsRuleDataIngestType:
+		client, err := interfaces.As[interfaces.GitHubSecurityAlerts](provider)
+		if err != nil {
+			return nil, errors.New("provider does not implement github security alerts trait")
+		}
+		return alerts.NewAlertsIngester(ing.GetAlerts(), client)
 	default:
 		return nil, fmt.Errorf("unsupported rule type engine: %s", rt.Def.Ingest.Type)
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/mindersec/minder/internal/engine/ingester/alerts"
 	"github.com/mindersec/minder/internal/engine/ingester/artifact"
 	"github.com/mindersec/minder/internal/engine/ingester/builtin"
 	"github.com/mindersec/minder/internal/engine/ingester/git"
@@ -17,6 +18,7 @@ import (
 	"github.com/mindersec/minder/internal/providers/credentials"
 	"github.com/mindersec/minder/internal/providers/github/clients"
 	"github.com/mindersec/minder/internal/providers/github/properties"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	"github.com/mindersec/minder/internal/providers/ratecache"
 	"github.com/mindersec/minder/internal/providers/telemetry"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -128,6 +130,21 @@ func TestNewRuleDataIngest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "alerts",
+			args: args{
+				rt: &pb.RuleType{
+					Def: &pb.RuleType_Definition{
+						Ingest: &pb.RuleType_Definition_Ingest{
+							Type: alerts.AlertsRuleDataIngestType,
+							Alerts: &pb.AlertsType{
+								Kinds: []string{alerts.KindDependabot},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "unsupported",
 			args: args{
@@ -152,7 +169,7 @@ func TestNewRuleDataIngest(t *testing.T) {
 				nil,
 				&ratecache.NoopRestClientCache{},
 				credentials.NewGitHubTokenCredential("token"),
-				clients.NewGitHubClientFactory(telemetry.NewNoopMetrics()),
+				clients.NewGitHubClientFactory(telemetry.NewNoopMetrics(), &ratebudget.NoopTracker{}),
 				properties.NewPropertyFetcherFactory(),
 				"",
 			)
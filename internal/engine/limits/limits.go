@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package limits provides the limits bounding the resources a single rule
+// evaluation can use
+package limits
+
+import (
+	"fmt"
+	"time"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+// Limits bounds the resources a single rule evaluation can use.
+// Zero values mean no limit.
+type Limits struct {
+	// Timeout is the maximum time the ingestion and evaluation of a rule can take
+	Timeout time.Duration
+	// MaxRegoInstructions is the maximum number of expressions a Rego policy can evaluate
+	MaxRegoInstructions int64
+	// MaxDataSourceCalls is the maximum number of data source function calls
+	MaxDataSourceCalls int64
+}
+
+// FromConfig returns the server-wide limits set in the configuration
+func FromConfig(cfg *serverconfig.EvaluationLimitsConfig) Limits {
+	if cfg == nil {
+		return Limits{}
+	}
+	return Limits{
+		Timeout:             cfg.Timeout,
+		MaxRegoInstructions: cfg.MaxRegoInstructions,
+		MaxDataSourceCalls:  cfg.MaxDataSourceCalls,
+	}
+}
+
+// FromRuleType returns the limits set in the definition of the rule type
+func FromRuleType(rt *minderv1.RuleType) (Limits, error) {
+	l := rt.GetDef().GetLimits()
+	if l == nil {
+		return Limits{}, nil
+	}
+
+	var timeout time.Duration
+	if l.GetTimeout() != "" {
+		var err error
+		timeout, err = time.ParseDuration(l.GetTimeout())
+		if err != nil {
+			return Limits{}, fmt.Errorf("invalid timeout: %w", err)
+		}
+	}
+
+	return Limits{
+		Timeout:             timeout,
+		MaxRegoInstructions: l.GetMaxRegoInstructions(),
+		MaxDataSourceCalls:  l.GetMaxDataSourceCalls(),
+	}, nil
+}
+
+// Stricter combines both limits, keeping the stricter value of each one
+func (l Limits) Stricter(other Limits) Limits {
+	return Limits{
+		Timeout:             stricter(l.Timeout, other.Timeout),
+		MaxRegoInstructions: stricter(l.MaxRegoInstructions, other.MaxRegoInstructions),
+		MaxDataSourceCalls:  stricter(l.MaxDataSourceCalls, other.MaxDataSourceCalls),
+	}
+}
+
+// stricter returns the lowest positive value, or zero if neither is set
+func stricter[T time.Duration | int64](a, b T) T {
+	switch {
+	case a <= 0:
+		return max(b, 0)
+	case b <= 0:
+		return a
+	default:
+		return min(a, b)
+	}
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package limits
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestStricter(t *testing.T) {
+	t.Parallel()
+
+	global := Limits{Timeout: time.Minute, MaxRegoInstructions: 1000}
+	ruleType := Limits{Timeout: 10 * time.Second, MaxRegoInstructions: 5000, MaxDataSourceCalls: 3}
+
+	require.Equal(t, Limits{
+		Timeout:             10 * time.Second,
+		MaxRegoInstructions: 1000,
+		MaxDataSourceCalls:  3,
+	}, global.Stricter(ruleType))
+	require.Equal(t, global, global.Stricter(Limits{}))
+	require.Equal(t, global, Limits{}.Stricter(global))
+}
+
+func TestFromRuleType(t *testing.T) {
+	t.Parallel()
+
+	l, err := FromRuleType(&minderv1.RuleType{Def: &minderv1.RuleType_Definition{}})
+	require.NoError(t, err)
+	require.Equal(t, Limits{}, l)
+
+	l, err = FromRuleType(&minderv1.RuleType{Def: &minderv1.RuleType_Definition{
+		Limits: &minderv1.RuleType_Definition_Limits{
+			Timeout:            "1m30s",
+			MaxDataSourceCalls: 5,
+		},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, Limits{Timeout: 90 * time.Second, MaxDataSourceCalls: 5}, l)
+
+	_, err = FromRuleType(&minderv1.RuleType{Def: &minderv1.RuleType_Definition{
+		Limits: &minderv1.RuleType_Definition_Limits{Timeout: "soon"},
+	}})
+	require.Error(t, err)
+}
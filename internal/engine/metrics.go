@@ -20,6 +20,7 @@ type ExecutorMetrics struct {
 	evalCounter        metric.Int64Counter
 	remediationCounter metric.Int64Counter
 	alertCounter       metric.Int64Counter
+	timeoutCounter     metric.Int64Counter
 	entityDuration     metric.Int64Histogram
 	profileDuration    metric.Int64Histogram
 }
@@ -48,6 +49,13 @@ func NewExecutorMetrics(meterFactory meters.MeterFactory) (*ExecutorMetrics, err
 		return nil, fmt.Errorf("failed to create alert counter: %w", err)
 	}
 
+	timeoutCounter, err := meter.Int64Counter("eval.timeout",
+		metric.WithDescription("Number of rule evaluations aborted for exceeding their limits"),
+		metric.WithUnit("evaluations"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create timeout counter: %w", err)
+	}
+
 	profileDuration, err := meter.Int64Histogram("eval.entity.duration",
 		metric.WithDescription("Time taken to evaluate all profiles against an entity"),
 		metric.WithUnit("milliseconds"))
@@ -66,6 +74,7 @@ func NewExecutorMetrics(meterFactory meters.MeterFactory) (*ExecutorMetrics, err
 		evalCounter:        evalCounter,
 		remediationCounter: remediationCounter,
 		alertCounter:       alertCounter,
+		timeoutCounter:     timeoutCounter,
 		profileDuration:    profileDuration,
 		entityDuration:     entityDuration,
 	}, nil
@@ -103,6 +112,18 @@ func (e *ExecutorMetrics) CountAlertStatus(
 	))
 }
 
+// CountEvalTimeout counts the evaluations aborted for exceeding their limits by rule type.
+func (e *ExecutorMetrics) CountEvalTimeout(
+	ctx context.Context,
+	ruleType string,
+	entityType db.Entities,
+) {
+	e.timeoutCounter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("eval_entity_type", string(entityType)),
+		attribute.String("rule_type", ruleType),
+	))
+}
+
 // TimeEntityEvaluation records how long it took to evaluate a profile.
 func (e *ExecutorMetrics) TimeEntityEvaluation(ctx context.Context, startTime time.Time) {
 	e.entityDuration.Record(ctx, time.Since(startTime).Milliseconds())
@@ -6,6 +6,8 @@
 package options
 
 import (
+	"github.com/mindersec/minder/internal/engine/limits"
+	"github.com/mindersec/minder/internal/verifier/sigstore"
 	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
 	"github.com/mindersec/minder/pkg/flags"
@@ -53,3 +55,50 @@ func WithDataSources(ds *v1datasources.DataSourceRegistry) interfaces.Option {
 		return nil
 	}
 }
+
+// SupportsTrustRoots interface advertises the fact that the implementer
+// can verify signatures against the custom trust roots of a project.
+type SupportsTrustRoots interface {
+	SetTrustRootResolver(resolver sigstore.TrustRootResolver)
+}
+
+// SupportsOfflineMode interface advertises the fact that the implementer
+// can run without network access, disabling the functionality that needs it.
+type SupportsOfflineMode interface {
+	SetOfflineMode(offline bool)
+}
+
+// WithOfflineMode disables the evaluation functionality that needs network
+// access. In case the given evaluator does not support offline mode,
+// WithOfflineMode silently ignores the error.
+func WithOfflineMode(offline bool) interfaces.Option {
+	return func(e interfaces.Evaluator) error {
+		inner, ok := e.(SupportsOfflineMode)
+		if !ok {
+			return nil
+		}
+		inner.SetOfflineMode(offline)
+		return nil
+	}
+}
+
+// SupportsLimits interface advertises the fact that the implementer
+// can bound the resources a single evaluation uses.
+type SupportsLimits interface {
+	SetLimits(l limits.Limits)
+}
+
+// WithLimits bounds the resources a single evaluation can use. When
+// given several times, the stricter value of each limit applies. In case
+// the given evaluator does not support limits, WithLimits silently
+// ignores the error.
+func WithLimits(l limits.Limits) interfaces.Option {
+	return func(e interfaces.Evaluator) error {
+		inner, ok := e.(SupportsLimits)
+		if !ok {
+			return nil
+		}
+		inner.SetLimits(l)
+		return nil
+	}
+}
@@ -14,7 +14,10 @@ import (
 	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/engine/ingestcache"
+	"github.com/mindersec/minder/internal/engine/limits"
 	eoptions "github.com/mindersec/minder/internal/engine/options"
+	"github.com/mindersec/minder/internal/trustroots"
+	"github.com/mindersec/minder/internal/verifier/sigstore"
 	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
 	rtengine2 "github.com/mindersec/minder/pkg/engine/v1/rtengine"
 	"github.com/mindersec/minder/pkg/flags"
@@ -37,6 +40,7 @@ type ruleEngineCache struct {
 	ingestCache  ingestcache.Cache
 	engines      cacheType
 	dssvc        datasourceservice.DataSourcesService
+	trustRoots   sigstore.TrustRootResolver
 	opts         []interfaces.Option
 }
 
@@ -70,11 +74,14 @@ func NewRuleEngineCache(
 		return nil, fmt.Errorf("error while retrieving rule types from db: %w", err)
 	}
 
+	// Trust roots are looked up lazily, only by the rules verifying signatures.
+	trustRoots := trustroots.NewResolver(store, hierarchy)
+
 	// Populate the cache with rule type engines for the rule types we found.
 	engines := make(cacheType, len(ruleTypes))
 	for _, ruleType := range ruleTypes {
 		ruleEngine, err := cacheRuleEngine(
-			ctx, &ruleType, provider, featureFlags, ingestCache, engines, dssvc, opts...)
+			ctx, &ruleType, provider, featureFlags, ingestCache, engines, dssvc, trustRoots, opts...)
 		if err != nil {
 			return nil, err
 		}
@@ -89,6 +96,7 @@ func NewRuleEngineCache(
 		engines:      engines,
 		opts:         opts,
 		dssvc:        dssvc,
+		trustRoots:   trustRoots,
 	}, nil
 }
 
@@ -117,7 +125,7 @@ func (r *ruleEngineCache) GetRuleEngine(ctx context.Context, ruleTypeID uuid.UUI
 
 	// If we find the rule type, insert into the cache and return.
 	ruleTypeEngine, err := cacheRuleEngine(
-		ctx, &ruleType, r.provider, r.featureFlags, r.ingestCache, r.engines, r.dssvc, r.opts...)
+		ctx, &ruleType, r.provider, r.featureFlags, r.ingestCache, r.engines, r.dssvc, r.trustRoots, r.opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error while caching rule type engine: %w", err)
 	}
@@ -132,6 +140,7 @@ func cacheRuleEngine(
 	ingestCache ingestcache.Cache,
 	engineCache cacheType,
 	dssvc datasourceservice.DataSourcesService,
+	trustRoots sigstore.TrustRootResolver,
 	opts ...interfaces.Option,
 ) (*rtengine2.RuleTypeEngine, error) {
 	// Parse the rule type
@@ -162,7 +171,17 @@ func cacheRuleEngine(
 		return nil, fmt.Errorf("error building data source registry: %w", err)
 	}
 
-	opts = append(opts, eoptions.WithDataSources(dsreg), eoptions.WithFlagsClient(featureFlags))
+	// The limits set in the rule type apply in addition to the ones passed in the options
+	ruleTypeLimits, err := limits.FromRuleType(pbRuleType)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing limits of rule type %s: %w", ruleType.ID, err)
+	}
+
+	opts = append(opts,
+		eoptions.WithDataSources(dsreg),
+		eoptions.WithFlagsClient(featureFlags),
+		eoptions.WithLimits(ruleTypeLimits),
+	)
 
 	// Create the rule type engine
 	ruleEngine, err := rtengine2.NewRuleTypeEngine(ctx, pbRuleType, provider, opts...)
@@ -170,6 +189,11 @@ func cacheRuleEngine(
 		return nil, fmt.Errorf("error creating rule type engine: %w", err)
 	}
 
+	// Let ingesters verifying signatures use the trust roots of the project hierarchy
+	if trIngester, ok := ruleEngine.GetIngester().(eoptions.SupportsTrustRoots); ok {
+		trIngester.SetTrustRootResolver(trustRoots)
+	}
+
 	// Add the rule type engine to the cache
 	ruleEngine = ruleEngine.WithIngesterCache(ingestCache)
 	engineCache[ruleType.ID] = ruleEngine
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	evalerrors "github.com/mindersec/minder/internal/engine/errors"
+	engif "github.com/mindersec/minder/internal/engine/interfaces"
+)
+
+// waiveFailure replaces the failure of a rule with a waived evaluation if
+// the entity is covered by an active waiver for the rule. Any other outcome
+// of the evaluation is returned as-is, and so are failures once the waiver
+// expired, which re-enables remediations and alerts.
+func waiveFailure(
+	ctx context.Context,
+	querier db.Querier,
+	params *engif.EvalStatusParams,
+	evalErr error,
+) error {
+	if evalerrors.ErrorAsEvalStatus(evalErr) != db.EvalStatusTypesFailure {
+		return evalErr
+	}
+
+	waiver, err := querier.GetActiveWaiver(ctx, db.GetActiveWaiverParams{
+		RuleInstanceID:   params.Rule.ID,
+		EntityInstanceID: params.EntityID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return evalErr
+	} else if err != nil {
+		// Failing to look up the waiver must not hide the failure
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error looking up waiver, recording failure")
+		return evalErr
+	}
+
+	return evalerrors.NewErrEvaluationWaived(
+		"Failure waived by %s until %s: %s\n\n%s",
+		waiver.Approver,
+		waiver.ExpiresAt.UTC().Format(time.RFC3339),
+		waiver.Justification,
+		evalerrors.ErrorAsEvalDetails(evalErr),
+	)
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	evalerrors "github.com/mindersec/minder/internal/engine/errors"
+	engif "github.com/mindersec/minder/internal/engine/interfaces"
+	"github.com/mindersec/minder/pkg/profiles/models"
+)
+
+func TestWaiveFailure(t *testing.T) {
+	t.Parallel()
+
+	ruleID := uuid.New()
+	entityID := uuid.New()
+	expiry := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	failure := evalerrors.NewErrEvaluationFailed("branch protection is disabled")
+
+	tests := []struct {
+		name       string
+		evalErr    error
+		setupMocks func(*mockdb.MockStore)
+		wantStatus db.EvalStatusTypes
+		wantDetail string
+	}{
+		{
+			name:       "success is not looked up",
+			evalErr:    nil,
+			wantStatus: db.EvalStatusTypesSuccess,
+		},
+		{
+			name:       "error is not waived",
+			evalErr:    errors.New("boom"),
+			wantStatus: db.EvalStatusTypesError,
+		},
+		{
+			name:    "failure without waiver",
+			evalErr: failure,
+			setupMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetActiveWaiver(gomock.Any(), db.GetActiveWaiverParams{
+					RuleInstanceID:   ruleID,
+					EntityInstanceID: entityID,
+				}).Return(db.Waiver{}, sql.ErrNoRows)
+			},
+			wantStatus: db.EvalStatusTypesFailure,
+		},
+		{
+			name:    "failure with waiver",
+			evalErr: failure,
+			setupMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetActiveWaiver(gomock.Any(), gomock.Any()).
+					Return(db.Waiver{
+						Approver:      "alice",
+						Justification: "legacy repository",
+						ExpiresAt:     expiry,
+					}, nil)
+			},
+			wantStatus: db.EvalStatusTypesWaived,
+			wantDetail: "Failure waived by alice until 2030-01-02T03:04:05Z: legacy repository\n\nbranch protection is disabled",
+		},
+		{
+			name:    "failure when the lookup fails",
+			evalErr: failure,
+			setupMocks: func(store *mockdb.MockStore) {
+				store.EXPECT().GetActiveWaiver(gomock.Any(), gomock.Any()).
+					Return(db.Waiver{}, errors.New("connection reset"))
+			},
+			wantStatus: db.EvalStatusTypesFailure,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			if tt.setupMocks != nil {
+				tt.setupMocks(store)
+			}
+
+			params := &engif.EvalStatusParams{
+				Rule:     &models.RuleInstance{ID: ruleID},
+				EntityID: entityID,
+			}
+			err := waiveFailure(context.Background(), store, params, tt.evalErr)
+			require.Equal(t, tt.wantStatus, evalerrors.ErrorAsEvalStatus(err))
+			if tt.wantDetail != "" {
+				require.Equal(t, tt.wantDetail, evalerrors.ErrorAsEvalDetails(err))
+			}
+		})
+	}
+}
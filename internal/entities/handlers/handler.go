@@ -7,8 +7,10 @@ package handlers
 import (
 	"context"
 	"errors"
+	"slices"
 
 	watermill "github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 
 	"github.com/mindersec/minder/internal/db"
@@ -31,6 +33,7 @@ var (
 	errPrivateRepoNotAllowed  = errors.New("private repositories are not allowed in this project")
 	errArchivedRepoNotAllowed = errors.New("archived repositories are not evaluated")
 	errPropsDoNotMatch        = errors.New("properties do not match")
+	errPropsDidNotChange      = errors.New("relevant properties did not change")
 )
 
 type handleEntityAndDoBase struct {
@@ -89,6 +92,7 @@ func (b *handleEntityAndDoBase) handleRefreshEntityAndDo(msg *watermill.Message)
 			Str("entityID", ewp.Entity.ID.String()).
 			Str("providerID", ewp.Entity.ProviderID.String()).
 			Msg("entity refreshed")
+		b.recordWebhookDelivery(ctx, msg, ewp)
 	} else {
 		l.Debug().Msg("entity not retrieved")
 	}
@@ -121,6 +125,34 @@ func (b *handleEntityAndDoBase) handleRefreshEntityAndDo(msg *watermill.Message)
 	return nil
 }
 
+// recordWebhookDelivery records that a webhook delivery was received for the
+// repository the entity belongs to, which is the entity the webhook is
+// registered for. Messages which do not come from a webhook are ignored.
+func (b *handleEntityAndDoBase) recordWebhookDelivery(
+	ctx context.Context,
+	msg *watermill.Message,
+	ewp *models.EntityWithProperties) {
+	if b.store == nil || msg.Metadata.Get(constants.ProviderDeliveryIdKey) == "" {
+		return
+	}
+
+	entityID := ewp.Entity.ID
+	if ewp.Entity.Type != v1.Entity_ENTITY_REPOSITORIES {
+		entityID = ewp.Entity.OriginatedFrom
+	}
+	if entityID == uuid.Nil {
+		return
+	}
+
+	// A missed delivery only makes the entity look quiet for a while,
+	// so it is not worth failing the handler for.
+	if err := b.store.RecordWebhookDelivery(ctx, entityID); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).
+			Str("entityID", entityID.String()).
+			Msg("error recording webhook delivery")
+	}
+}
+
 func (b *handleEntityAndDoBase) forwardEntityCheck(
 	ctx context.Context,
 	entMsg *message.HandleEntityAndDoMessage,
@@ -137,6 +169,14 @@ func (b *handleEntityAndDoBase) forwardEntityCheck(
 		return false, err
 	}
 
+	err = b.propertiesChangedCheck(entMsg, ewp)
+	if errors.Is(err, errPropsDidNotChange) {
+		zerolog.Ctx(ctx).Debug().Err(err).Msg("properties did not change")
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
 	err = b.repoPrivateOrArchivedCheck(ctx, ewp)
 	if errors.Is(err, errPrivateRepoNotAllowed) || errors.Is(err, errArchivedRepoNotAllowed) {
 		zerolog.Ctx(ctx).Debug().Err(err).Msg("private or archived repo")
@@ -172,6 +212,25 @@ func (*handleEntityAndDoBase) matchPropertiesCheck(
 	return nil
 }
 
+// propertiesChangedCheck checks if any of the properties the message is interested in
+// changed when refreshing the entity.
+func (*handleEntityAndDoBase) propertiesChangedCheck(
+	entMsg *message.HandleEntityAndDoMessage,
+	ewp *models.EntityWithProperties) error {
+	// no restriction, so we're good
+	if len(entMsg.OnPropertyChange) == 0 {
+		return nil
+	}
+
+	for _, key := range ewp.ChangedProperties() {
+		if slices.Contains(entMsg.OnPropertyChange, key) {
+			return nil
+		}
+	}
+
+	return errPropsDidNotChange
+}
+
 func (b *handleEntityAndDoBase) repoPrivateOrArchivedCheck(
 	ctx context.Context,
 	ewp *models.EntityWithProperties) error {
@@ -196,6 +196,7 @@ func TestRefreshEntityAndDoHandler_HandleRefreshEntityAndEval(t *testing.T) {
 	tests := []struct {
 		name                 string
 		messageBuilder       func() *message.HandleEntityAndDoMessage
+		deliveryID           string
 		setupPropSvcMocks    func() fixtures.MockPropertyServiceBuilder
 		mockStoreFunc        df.MockStoreBuilder
 		providerManagerSetup func(prov provifv1.Provider) provManFixtures.ProviderManagerMockBuilder
@@ -230,6 +231,33 @@ func TestRefreshEntityAndDoHandler_HandleRefreshEntityAndEval(t *testing.T) {
 			topic:           constants.TopicQueueEntityEvaluate,
 			checkWmMsg:      checkRepoMessage,
 		},
+		{
+			name:             "NewRefreshByIDAndEvaluateHandler: webhook delivery is recorded",
+			handlerBuilderFn: refreshByIDHandlerBuilder,
+			messageBuilder: func() *message.HandleEntityAndDoMessage {
+				return message.NewEntityRefreshAndDoMessage().
+					WithEntityID(repoID)
+			},
+			deliveryID: "delivery-id",
+			setupPropSvcMocks: func() fixtures.MockPropertyServiceBuilder {
+				ewp := buildEwp(t, repoEwp, repoPropMap)
+				protoEnt, err := ghprops.RepoV1FromProperties(ewp.Properties)
+				require.NoError(t, err)
+
+				return fixtures.NewMockPropertiesService(
+					fixtures.WithSuccessfulEntityWithPropertiesByID(repoID, ewp),
+					fixtures.WithSuccessfulRetrieveAllPropertiesForEntity(),
+					fixtures.WithSuccessfulEntityWithPropertiesAsProto(protoEnt),
+				)
+			},
+			mockStoreFunc: df.NewMockStore(
+				df.WithSuccessfulRecordWebhookDelivery(repoID),
+				df.WithTransaction(),
+			),
+			expectedPublish: true,
+			topic:           constants.TopicQueueEntityEvaluate,
+			checkWmMsg:      checkRepoMessage,
+		},
 		{
 			name:             "NewRefreshByIDAndEvaluateHandler: nil UUID does not publish",
 			handlerBuilderFn: refreshByIDHandlerBuilder,
@@ -340,6 +368,71 @@ func TestRefreshEntityAndDoHandler_HandleRefreshEntityAndEval(t *testing.T) {
 			),
 			expectedPublish: false,
 		},
+		{
+			name:             "NewRefreshEntityAndEvaluateHandler: if on_property_change props changed, publish",
+			handlerBuilderFn: refreshEntityHandlerBuilder,
+			messageBuilder: func() *message.HandleEntityAndDoMessage {
+				getByProps := properties.NewProperties(map[string]any{
+					properties.PropertyUpstreamID: "123",
+				})
+
+				return message.NewEntityRefreshAndDoMessage().
+					WithEntity(minderv1.Entity_ENTITY_REPOSITORIES, getByProps).
+					WithOnPropertyChange(properties.RepoPropertyIsFork).
+					WithProviderImplementsHint("github")
+			},
+			setupPropSvcMocks: func() fixtures.MockPropertyServiceBuilder {
+				ewp := buildEwp(t, repoEwp, repoPropMap)
+				protoEnt, err := ghprops.RepoV1FromProperties(ewp.Properties)
+				require.NoError(t, err)
+
+				forkRepoMap := maps.Clone(repoPropMap)
+				forkRepoMap[properties.RepoPropertyIsFork] = true
+
+				return fixtures.NewMockPropertiesService(
+					fixtures.WithSuccessfulEntityByUpstreamHint(ewp, githubHint),
+					fixtures.WithSuccessfulRetrieveAllPropertiesForEntityUpdating(
+						properties.NewProperties(forkRepoMap)),
+					fixtures.WithSuccessfulEntityWithPropertiesAsProto(protoEnt),
+				)
+			},
+			mockStoreFunc: df.NewMockStore(
+				df.WithTransaction(),
+			),
+			expectedPublish: true,
+			topic:           constants.TopicQueueEntityEvaluate,
+			checkWmMsg:      checkRepoMessage,
+		},
+		{
+			name:             "NewRefreshEntityAndEvaluateHandler: if on_property_change props didn't change, don't publish",
+			handlerBuilderFn: refreshEntityHandlerBuilder,
+			messageBuilder: func() *message.HandleEntityAndDoMessage {
+				getByProps := properties.NewProperties(map[string]any{
+					properties.PropertyUpstreamID: "123",
+				})
+
+				return message.NewEntityRefreshAndDoMessage().
+					WithEntity(minderv1.Entity_ENTITY_REPOSITORIES, getByProps).
+					WithOnPropertyChange(properties.RepoPropertyIsFork).
+					WithProviderImplementsHint("github")
+			},
+			setupPropSvcMocks: func() fixtures.MockPropertyServiceBuilder {
+				ewp := buildEwp(t, repoEwp, repoPropMap)
+
+				renamedRepoMap := maps.Clone(repoPropMap)
+				renamedRepoMap[ghprops.RepoPropertyName] = "renamed"
+
+				return fixtures.NewMockPropertiesService(
+					fixtures.WithSuccessfulEntityByUpstreamHint(ewp, githubHint),
+					fixtures.WithSuccessfulRetrieveAllPropertiesForEntityUpdating(
+						properties.NewProperties(renamedRepoMap)),
+				)
+			},
+			mockStoreFunc: df.NewMockStore(
+				df.WithTransaction(),
+			),
+			expectedPublish: false,
+		},
 		{
 			name:             "NewRefreshEntityAndEvaluateHandler: private repo publishes if feature enabled",
 			handlerBuilderFn: refreshEntityHandlerBuilder,
@@ -680,6 +773,9 @@ func TestRefreshEntityAndDoHandler_HandleRefreshEntityAndEval(t *testing.T) {
 			require.NotNil(t, entityMsg)
 
 			handlerMsg := watermill.NewMessage(uuid.New().String(), nil)
+			if tt.deliveryID != "" {
+				handlerMsg.Metadata.Set(constants.ProviderDeliveryIdKey, tt.deliveryID)
+			}
 			err := entityMsg.ToMessage(handlerMsg)
 			require.NoError(t, err)
 
@@ -41,6 +41,11 @@ type HandleEntityAndDoMessage struct {
 	// use-case is to include the hook ID in the MatchProps to match against
 	// the entity's hook ID to avoid forwading the message to the wrong entity.
 	MatchProps map[string]any `json:"match_props"`
+	// OnPropertyChange restricts the action to the cases where any of
+	// these properties changed when refreshing the entity. This avoids
+	// evaluating an entity for events which only matter when they
+	// change specific properties. An empty list always performs the action.
+	OnPropertyChange []string `json:"on_property_change,omitempty"`
 }
 
 // NewEntityRefreshAndDoMessage creates a new HandleEntityAndDoMessage struct.
@@ -117,3 +122,66 @@ func (e *HandleEntityAndDoMessage) WithMatchProps(matchProps *properties.Propert
 	e.MatchProps = matchProps.ToProtoStruct().AsMap()
 	return e
 }
+
+// WithOnPropertyChange sets the properties of which at least one must have changed
+// when refreshing the entity in order to perform the action.
+func (e *HandleEntityAndDoMessage) WithOnPropertyChange(keys ...string) *HandleEntityAndDoMessage {
+	e.OnPropertyChange = keys
+	return e
+}
+
+// PrefetchPropertiesMessage is a message that is sent to the entity handler to refresh
+// the properties of a batch of entities of a provider ahead of their individual refresh.
+type PrefetchPropertiesMessage struct {
+	ProviderID uuid.UUID   `json:"provider_id"`
+	EntityIDs  []uuid.UUID `json:"entity_ids"`
+	// FollowUpTopic is the topic the FollowUps are published to
+	FollowUpTopic string `json:"follow_up_topic,omitempty"`
+	// FollowUps are the payloads of the messages which go on to handle the
+	// entities individually. They are only published once the properties
+	// were prefetched, so that they find them fresh instead of racing the
+	// prefetch.
+	FollowUps []json.RawMessage `json:"follow_ups,omitempty"`
+}
+
+// NewPrefetchPropertiesMessage creates a new PrefetchPropertiesMessage struct.
+func NewPrefetchPropertiesMessage(providerID uuid.UUID, entityIDs []uuid.UUID) *PrefetchPropertiesMessage {
+	return &PrefetchPropertiesMessage{
+		ProviderID: providerID,
+		EntityIDs:  entityIDs,
+	}
+}
+
+// WithFollowUps sets the messages to publish to topic once the properties
+// were prefetched. Only their payloads are kept.
+func (p *PrefetchPropertiesMessage) WithFollowUps(topic string, msgs ...*message.Message) *PrefetchPropertiesMessage {
+	p.FollowUpTopic = topic
+	p.FollowUps = make([]json.RawMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		p.FollowUps = append(p.FollowUps, json.RawMessage(msg.Payload))
+	}
+	return p
+}
+
+// ToPrefetchProperties converts a Watermill message to a PrefetchPropertiesMessage struct.
+func ToPrefetchProperties(msg *message.Message) (*PrefetchPropertiesMessage, error) {
+	prefetchMsg := &PrefetchPropertiesMessage{}
+
+	err := json.Unmarshal(msg.Payload, prefetchMsg)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling prefetch message: %w", err)
+	}
+
+	return prefetchMsg, nil
+}
+
+// ToMessage converts the PrefetchPropertiesMessage struct to a Watermill message.
+func (p *PrefetchPropertiesMessage) ToMessage(msg *message.Message) error {
+	payloadBytes, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("error marshalling prefetch message: %w", err)
+	}
+
+	msg.Payload = payloadBytes
+	return nil
+}
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	watermill "github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/entities/models"
+	propertyService "github.com/mindersec/minder/internal/entities/properties/service"
+	"github.com/mindersec/minder/internal/providers/manager"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+	"github.com/mindersec/minder/pkg/eventer/interfaces"
+)
+
+type prefetchPropertiesHandler struct {
+	evt     interfaces.Publisher
+	store   db.Store
+	propSvc propertyService.PropertiesService
+	provMgr manager.ProviderManager
+
+	handlerMiddleware []watermill.HandlerMiddleware
+}
+
+// NewPrefetchPropertiesHandler creates a new handler that refreshes the properties
+// of a batch of entities with as few provider calls as possible. The follow-up
+// messages of the batch, which refresh the entities individually, are published
+// afterwards and find their properties fresh in the cache.
+func NewPrefetchPropertiesHandler(
+	evt interfaces.Publisher,
+	store db.Store,
+	propSvc propertyService.PropertiesService,
+	provMgr manager.ProviderManager,
+	handlerMiddleware ...watermill.HandlerMiddleware,
+) interfaces.Consumer {
+	return &prefetchPropertiesHandler{
+		evt:               evt,
+		store:             store,
+		propSvc:           propSvc,
+		provMgr:           provMgr,
+		handlerMiddleware: handlerMiddleware,
+	}
+}
+
+// Register satisfies the events.Consumer interface.
+func (p *prefetchPropertiesHandler) Register(r interfaces.Registrar) {
+	r.Register(constants.TopicQueuePrefetchEntityProperties, p.handlePrefetchProperties, p.handlerMiddleware...)
+}
+
+// handlePrefetchProperties prefetches the properties of the entities in the message
+// and then publishes its follow-up messages. Prefetching is an optimization, so its
+// errors are logged and the follow-ups are published regardless.
+func (p *prefetchPropertiesHandler) handlePrefetchProperties(msg *watermill.Message) error {
+	ctx := msg.Context()
+
+	prefetchMsg, err := message.ToPrefetchProperties(msg)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("error unpacking message")
+		return nil
+	}
+
+	p.prefetch(ctx, prefetchMsg)
+
+	if len(prefetchMsg.FollowUps) == 0 {
+		return nil
+	}
+
+	followUps := make([]*watermill.Message, 0, len(prefetchMsg.FollowUps))
+	for _, payload := range prefetchMsg.FollowUps {
+		m := watermill.NewMessage(uuid.New().String(), watermill.Payload(payload))
+		m.SetContext(ctx)
+		followUps = append(followUps, m)
+	}
+
+	if err := p.evt.Publish(prefetchMsg.FollowUpTopic, followUps...); err != nil {
+		// we retry in case watermill is having a bad day, the properties
+		// are fresh by now so the prefetch is cheap
+		return fmt.Errorf("error publishing follow-up messages: %w", err)
+	}
+
+	return nil
+}
+
+func (p *prefetchPropertiesHandler) prefetch(ctx context.Context, prefetchMsg *message.PrefetchPropertiesMessage) {
+	l := zerolog.Ctx(ctx).With().
+		Str("provider_id", prefetchMsg.ProviderID.String()).
+		Int("entities", len(prefetchMsg.EntityIDs)).
+		Logger()
+
+	provider, err := p.provMgr.InstantiateFromID(ctx, prefetchMsg.ProviderID)
+	if err != nil {
+		l.Error().Err(err).Msg("error instantiating provider")
+		return
+	}
+
+	efps := make([]*models.EntityWithProperties, 0, len(prefetchMsg.EntityIDs))
+	for _, entityID := range prefetchMsg.EntityIDs {
+		efp, err := p.propSvc.EntityWithPropertiesByID(ctx, entityID, nil)
+		if err != nil {
+			l.Debug().Err(err).Str("entity_id", entityID.String()).Msg("error getting entity, skipping")
+			continue
+		}
+		if efp.Entity.ProviderID != prefetchMsg.ProviderID {
+			l.Debug().Str("entity_id", entityID.String()).Msg("entity belongs to another provider, skipping")
+			continue
+		}
+		efps = append(efps, efp)
+	}
+
+	err = p.propSvc.PrefetchAllPropertiesForEntities(ctx, provider, efps,
+		propertyService.ReadBuilder().WithChangeSource(db.PropertyChangeSourceReconcile))
+	if err != nil {
+		l.Error().Err(err).Msg("error prefetching properties")
+		return
+	}
+
+	l.Debug().Msg("properties prefetched")
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	watermill "github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/entities/models"
+	"github.com/mindersec/minder/internal/entities/properties/service"
+	mockSvc "github.com/mindersec/minder/internal/entities/properties/service/mock"
+	stubeventer "github.com/mindersec/minder/internal/events/stubs"
+	mockgithub "github.com/mindersec/minder/internal/providers/github/mock"
+	mock_manager "github.com/mindersec/minder/internal/providers/manager/mock"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+func TestPrefetchPropertiesHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		prefetchErr error
+	}{
+		{
+			name: "follow-ups are published after the prefetch",
+		},
+		{
+			name:        "follow-ups are published when the prefetch fails",
+			prefetchErr: errors.New("boom"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			evt := &stubeventer.StubEventer{}
+			propSvc := mockSvc.NewMockPropertiesService(ctrl)
+			provMgr := mock_manager.NewMockProviderManager(ctrl)
+
+			entity := buildEwp(t, repoEwp, repoPropMap)
+			provMgr.EXPECT().InstantiateFromID(gomock.Any(), providerID).
+				Return(mockgithub.NewMockGitHub(ctrl), nil)
+			propSvc.EXPECT().EntityWithPropertiesByID(gomock.Any(), repoID, gomock.Any()).
+				Return(entity, nil)
+			propSvc.EXPECT().PrefetchAllPropertiesForEntities(gomock.Any(), gomock.Any(),
+				[]*models.EntityWithProperties{entity}, gomock.Any()).
+				DoAndReturn(func(context.Context, provifv1.Provider, []*models.EntityWithProperties, *service.ReadOptions) error {
+					// the follow-ups must not race the prefetch
+					require.Empty(t, evt.Sent)
+					return tt.prefetchErr
+				})
+
+			refresh := watermill.NewMessage(uuid.New().String(), nil)
+			require.NoError(t, message.NewEntityRefreshAndDoMessage().WithEntityID(repoID).ToMessage(refresh))
+
+			msg := watermill.NewMessage(uuid.New().String(), nil)
+			require.NoError(t, message.NewPrefetchPropertiesMessage(providerID, []uuid.UUID{repoID}).
+				WithFollowUps(constants.TopicQueueRefreshEntityByIDAndEvaluate, refresh).
+				ToMessage(msg))
+
+			handler := NewPrefetchPropertiesHandler(evt, nil, propSvc, provMgr)
+			require.NoError(t, handler.(*prefetchPropertiesHandler).handlePrefetchProperties(msg))
+
+			require.Equal(t, []string{constants.TopicQueueRefreshEntityByIDAndEvaluate}, evt.Topics)
+			require.Len(t, evt.Sent, 1)
+			require.JSONEq(t, string(refresh.Payload), string(evt.Sent[0].Payload))
+		})
+	}
+}
@@ -5,6 +5,7 @@ package entity
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/mindersec/minder/internal/db"
@@ -39,12 +40,31 @@ func NewAddOriginatingEntityStrategy(
 	}
 }
 
-// GetEntity adds an originating entity.
+// GetEntity adds an originating entity. Providers which can't tell whether
+// an entity is new may ask to add it more than once, so an entity which
+// already exists is refreshed instead.
 func (a *addOriginatingEntityStrategy) GetEntity(
 	ctx context.Context, entMsg *message.HandleEntityAndDoMessage,
 ) (*models.EntityWithProperties, error) {
 	childProps := properties.NewProperties(entMsg.Entity.GetByProps)
 
+	existing, err := getEntityInner(
+		ctx,
+		entMsg.Entity.Type, entMsg.Entity.GetByProps, entMsg.Hint,
+		a.propSvc,
+		nil)
+	if err == nil {
+		err = a.propSvc.RetrieveAllPropertiesForEntity(
+			ctx, existing, a.provMgr,
+			propertyService.ReadBuilder().WithChangeSource(db.PropertyChangeSourceWebhook))
+		if err != nil {
+			return nil, fmt.Errorf("error fetching entity: %w", err)
+		}
+		return existing, nil
+	} else if !errors.Is(err, propertyService.ErrEntityNotFound) {
+		return nil, fmt.Errorf("error getting entity: %w", err)
+	}
+
 	// Get parent entity (originator)
 	parentEwp, err := getEntityInner(
 		ctx,
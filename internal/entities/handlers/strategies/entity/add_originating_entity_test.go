@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package entity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/entities/models"
+	propertyService "github.com/mindersec/minder/internal/entities/properties/service"
+	mockPropSvc "github.com/mindersec/minder/internal/entities/properties/service/mock"
+	mockEntSvc "github.com/mindersec/minder/internal/entities/service/mock"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+func TestAddOriginatingEntityStrategy(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	providerID := uuid.New()
+	repoEwp := &models.EntityWithProperties{
+		Entity: models.EntityInstance{
+			ID:         uuid.New(),
+			Type:       minderv1.Entity_ENTITY_REPOSITORIES,
+			ProjectID:  projectID,
+			ProviderID: providerID,
+		},
+	}
+	runEwp := &models.EntityWithProperties{
+		Entity: models.EntityInstance{
+			ID:         uuid.New(),
+			Type:       minderv1.Entity_ENTITY_PIPELINE_RUN,
+			ProjectID:  projectID,
+			ProviderID: providerID,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(*mockPropSvc.MockPropertiesService, *mockdb.MockStore, *mockEntSvc.MockEntityCreator)
+		wantErr bool
+	}{
+		{
+			name: "new entity is created",
+			setup: func(propSvc *mockPropSvc.MockPropertiesService, store *mockdb.MockStore, creator *mockEntSvc.MockEntityCreator) {
+				propSvc.EXPECT().EntityWithPropertiesByUpstreamHint(gomock.Any(), minderv1.Entity_ENTITY_PIPELINE_RUN,
+					gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, propertyService.ErrEntityNotFound)
+				propSvc.EXPECT().EntityWithPropertiesByUpstreamHint(gomock.Any(), minderv1.Entity_ENTITY_REPOSITORIES,
+					gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(repoEwp, nil)
+				store.EXPECT().GetProviderByID(gomock.Any(), providerID).Return(db.Provider{ID: providerID}, nil)
+				creator.EXPECT().CreateEntity(gomock.Any(), gomock.Any(), projectID,
+					minderv1.Entity_ENTITY_PIPELINE_RUN, gomock.Any(), gomock.Any()).
+					Return(runEwp, nil)
+			},
+		},
+		{
+			name: "existing entity is refreshed",
+			setup: func(propSvc *mockPropSvc.MockPropertiesService, _ *mockdb.MockStore, _ *mockEntSvc.MockEntityCreator) {
+				propSvc.EXPECT().EntityWithPropertiesByUpstreamHint(gomock.Any(), minderv1.Entity_ENTITY_PIPELINE_RUN,
+					gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(runEwp, nil)
+				propSvc.EXPECT().RetrieveAllPropertiesForEntity(gomock.Any(), runEwp, gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+		},
+		{
+			name: "lookup error is returned",
+			setup: func(propSvc *mockPropSvc.MockPropertiesService, _ *mockdb.MockStore, _ *mockEntSvc.MockEntityCreator) {
+				propSvc.EXPECT().EntityWithPropertiesByUpstreamHint(gomock.Any(), minderv1.Entity_ENTITY_PIPELINE_RUN,
+					gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("boom"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			propSvc := mockPropSvc.NewMockPropertiesService(ctrl)
+			store := mockdb.NewMockStore(ctrl)
+			creator := mockEntSvc.NewMockEntityCreator(ctrl)
+			tt.setup(propSvc, store, creator)
+
+			strategy := NewAddOriginatingEntityStrategy(propSvc, nil, store, creator)
+			entMsg := message.NewEntityRefreshAndDoMessage().
+				WithEntity(minderv1.Entity_ENTITY_PIPELINE_RUN, properties.NewProperties(map[string]any{
+					properties.PropertyUpstreamID: "1",
+				})).
+				WithOriginator(minderv1.Entity_ENTITY_REPOSITORIES, properties.NewProperties(map[string]any{
+					properties.PropertyUpstreamID: "2",
+				})).
+				WithProviderClassHint("gitlab")
+
+			ewp, err := strategy.GetEntity(context.Background(), entMsg)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, runEwp, ewp)
+		})
+	}
+}
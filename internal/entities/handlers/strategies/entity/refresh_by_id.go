@@ -54,7 +54,8 @@ func (r *refreshEntityByIDStrategy) GetEntity(
 
 		err = r.propSvc.RetrieveAllPropertiesForEntity(
 			ctx, ewp, r.provMgr,
-			propertyService.ReadBuilder().WithStoreOrTransaction(t))
+			propertyService.ReadBuilder().WithStoreOrTransaction(t).
+				WithChangeSource(db.PropertyChangeSourceReconcile))
 		if err != nil {
 			return nil, fmt.Errorf("error retrieving properties for entity: %w", err)
 		}
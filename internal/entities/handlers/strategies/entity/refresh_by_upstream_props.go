@@ -49,7 +49,8 @@ func (r *refreshEntityByUpstreamIDStrategy) GetEntity(
 
 		err = r.propSvc.RetrieveAllPropertiesForEntity(
 			ctx, ewp, r.provMgr,
-			propertyService.ReadBuilder().WithStoreOrTransaction(t))
+			propertyService.ReadBuilder().WithStoreOrTransaction(t).
+				WithChangeSource(db.PropertyChangeSourceWebhook))
 		if err != nil {
 			return nil, fmt.Errorf("error fetching entity: %w", err)
 		}
@@ -6,6 +6,8 @@ package models
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
 
@@ -34,6 +36,10 @@ func (ei EntityInstance) String() string {
 type EntityWithProperties struct {
 	Entity     EntityInstance
 	Properties *properties.Properties
+
+	// changedProperties are the keys of the properties changed by
+	// the last call to UpdateProperties
+	changedProperties []string
 }
 
 // NewEntityWithProperties creates a new EntityWithProperties instance
@@ -98,10 +104,60 @@ func DbPropToModel(dbProp db.Property) (*properties.Property, error) {
 }
 
 // UpdateProperties updates the properties for the "entity for properties" instance
+// and keeps track of the properties that changed
 func (e *EntityWithProperties) UpdateProperties(props *properties.Properties) {
+	changes := DiffProperties(e.Properties, props, true)
+	e.changedProperties = make([]string, 0, len(changes))
+	for _, change := range changes {
+		e.changedProperties = append(e.changedProperties, change.Key)
+	}
 	e.Properties = props
 }
 
+// ChangedProperties returns the keys of the properties changed by the last
+// call to UpdateProperties, sorted.
+func (e *EntityWithProperties) ChangedProperties() []string {
+	return e.changedProperties
+}
+
+// PropertyChange is the change of a single property of an entity. A nil
+// Old property means the property was added, a nil New one that it was removed.
+type PropertyChange struct {
+	Key string
+	Old *properties.Property
+	New *properties.Property
+}
+
+// DiffProperties returns the changes from the old to the new properties,
+// sorted by key. Properties missing from the new ones are only reported
+// as removed if removeMissing is set, as partial updates leave them as
+// they are.
+func DiffProperties(oldProps, newProps *properties.Properties, removeMissing bool) []PropertyChange {
+	var changes []PropertyChange
+
+	if newProps != nil {
+		for key, newProp := range newProps.Iterate() {
+			oldProp := oldProps.GetProperty(key)
+			if !oldProp.Equal(newProp) {
+				changes = append(changes, PropertyChange{Key: key, Old: oldProp, New: newProp})
+			}
+		}
+	}
+
+	if removeMissing && oldProps != nil {
+		for key, oldProp := range oldProps.Iterate() {
+			if newProps.GetProperty(key) == nil {
+				changes = append(changes, PropertyChange{Key: key, Old: oldProp})
+			}
+		}
+	}
+
+	slices.SortFunc(changes, func(a, b PropertyChange) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return changes
+}
+
 // NeedsPropertyLoad returns true if the entity instance needs properties loaded
 // This is handy to determine if entities exist in the database without their
 // properties being migrated to the central table yet.
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+func TestDiffProperties(t *testing.T) {
+	t.Parallel()
+
+	oldProps := properties.NewProperties(map[string]any{
+		"is_private": false,
+		"name":       "minder",
+		"stale":      "gone",
+	})
+	newProps := properties.NewProperties(map[string]any{
+		"is_private": true,
+		"name":       "minder",
+		"added":      "value",
+	})
+
+	tests := []struct {
+		name          string
+		removeMissing bool
+		expectedKeys  []string
+	}{
+		{
+			name:          "partial update keeps missing properties",
+			removeMissing: false,
+			expectedKeys:  []string{"added", "is_private"},
+		},
+		{
+			name:          "full update removes missing properties",
+			removeMissing: true,
+			expectedKeys:  []string{"added", "is_private", "stale"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			changes := DiffProperties(oldProps, newProps, tt.removeMissing)
+			keys := make([]string, 0, len(changes))
+			for _, change := range changes {
+				keys = append(keys, change.Key)
+				switch change.Key {
+				case "added":
+					require.Nil(t, change.Old)
+					require.Equal(t, "value", change.New.GetString())
+				case "is_private":
+					require.False(t, change.Old.GetBool())
+					require.True(t, change.New.GetBool())
+				case "stale":
+					require.Equal(t, "gone", change.Old.GetString())
+					require.Nil(t, change.New)
+				}
+			}
+			require.Equal(t, tt.expectedKeys, keys)
+		})
+	}
+}
+
+func TestUpdatePropertiesTracksChanges(t *testing.T) {
+	t.Parallel()
+
+	ewp := NewEntityWithPropertiesFromInstance(EntityInstance{}, properties.NewProperties(map[string]any{
+		"is_private": false,
+	}))
+	require.Empty(t, ewp.ChangedProperties())
+
+	ewp.UpdateProperties(properties.NewProperties(map[string]any{
+		"is_private": false,
+	}))
+	require.Empty(t, ewp.ChangedProperties())
+
+	ewp.UpdateProperties(properties.NewProperties(map[string]any{
+		"is_private": true,
+	}))
+	require.Equal(t, []string{"is_private"}, ewp.ChangedProperties())
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/entities/models"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+func (ps *propertiesService) PrefetchAllPropertiesForEntities(
+	ctx context.Context, provider provifv1.Provider, efps []*models.EntityWithProperties,
+	opts *ReadOptions,
+) error {
+	batcher, err := provifv1.As[provifv1.BatchPropertyFetcher](provider)
+	if err != nil {
+		return nil
+	}
+
+	qtx := ps.getStoreOrTransaction(opts)
+
+	// only the entities whose properties expired are fetched, grouped by type
+	var entTypes []minderv1.Entity
+	expired := make(map[minderv1.Entity][]*models.EntityWithProperties)
+	for _, efp := range efps {
+		dbProps, err := qtx.GetAllPropertiesForEntity(ctx, efp.Entity.ID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to get properties: %w", err)
+		}
+		if len(dbProps) > 0 && ps.areDatabasePropertiesValid(dbProps, opts) {
+			continue
+		}
+
+		if _, ok := expired[efp.Entity.Type]; !ok {
+			entTypes = append(entTypes, efp.Entity.Type)
+		}
+		expired[efp.Entity.Type] = append(expired[efp.Entity.Type], efp)
+	}
+
+	for _, entType := range entTypes {
+		batch := expired[entType]
+		lookupProperties := make([]*properties.Properties, 0, len(batch))
+		for _, efp := range batch {
+			lookupProperties = append(lookupProperties, efp.Properties)
+		}
+
+		results := batcher.FetchAllPropertiesBatch(ctx, lookupProperties, entType, lookupProperties)
+		for i, res := range results {
+			efp := batch[i]
+			if res.Err != nil {
+				// not fatal, the entity fetches its properties on its own when retrieved
+				zerolog.Ctx(ctx).Debug().Err(res.Err).
+					Str("entityID", efp.Entity.ID.String()).
+					Msg("failed to prefetch properties")
+				continue
+			}
+
+			err := ps.ReplaceAllProperties(ctx, efp.Entity.ID, res.Properties, opts.getPropertiesServiceCallOptions())
+			if err != nil {
+				return fmt.Errorf("failed to update properties: %w", err)
+			}
+			efp.UpdateProperties(res.Properties)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/entities/models"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+// getCurrentProperties returns the properties of an entity as stored in the
+// database, before they are changed
+func getCurrentProperties(
+	ctx context.Context, entityID uuid.UUID, qtx db.ExtendQuerier,
+) (*properties.Properties, error) {
+	dbProps, err := qtx.GetAllPropertiesForEntity(ctx, entityID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get properties: %w", err)
+	}
+
+	props, err := models.DbPropsToModel(dbProps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert properties: %w", err)
+	}
+	return props, nil
+}
+
+func upsertProperties(
+	ctx context.Context, entityID uuid.UUID, props *properties.Properties, qtx db.ExtendQuerier,
+) error {
+	for key, prop := range props.Iterate() {
+		_, err := qtx.UpsertPropertyValueV1(ctx, db.UpsertPropertyValueV1Params{
+			EntityID: entityID,
+			Key:      key,
+			Value:    prop.RawValue(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordPropertyChanges appends the changes of the properties of an entity
+// to its property history
+func recordPropertyChanges(
+	ctx context.Context, entityID uuid.UUID, changes []models.PropertyChange,
+	source db.PropertyChangeSource, qtx db.ExtendQuerier,
+) error {
+	for _, change := range changes {
+		oldValue, err := propertyHistoryValue(change.Old)
+		if err != nil {
+			return err
+		}
+		newValue, err := propertyHistoryValue(change.New)
+		if err != nil {
+			return err
+		}
+
+		err = qtx.InsertPropertyHistory(ctx, db.InsertPropertyHistoryParams{
+			EntityID: entityID,
+			Key:      change.Key,
+			OldValue: oldValue,
+			NewValue: newValue,
+			Source:   source,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record change of property %s: %w", change.Key, err)
+		}
+	}
+
+	return nil
+}
+
+// propertyHistoryValue serializes a property value in the same format
+// as the properties table, a nil property is stored as NULL
+func propertyHistoryValue(prop *properties.Property) (pqtype.NullRawMessage, error) {
+	if prop == nil {
+		return pqtype.NullRawMessage{}, nil
+	}
+
+	value, err := db.PropValueToDbV1(prop.RawValue())
+	if err != nil {
+		return pqtype.NullRawMessage{}, fmt.Errorf("failed to serialize property value: %w", err)
+	}
+	return pqtype.NullRawMessage{RawMessage: value, Valid: true}, nil
+}
@@ -9,6 +9,8 @@
 package fixtures
 
 import (
+	"context"
+
 	"github.com/google/uuid"
 	minder "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/entities/properties"
@@ -18,6 +20,7 @@ import (
 	"github.com/mindersec/minder/internal/entities/models"
 	"github.com/mindersec/minder/internal/entities/properties/service"
 	mockSvc "github.com/mindersec/minder/internal/entities/properties/service/mock"
+	"github.com/mindersec/minder/internal/providers/manager"
 )
 
 type (
@@ -77,6 +80,20 @@ func WithSuccessfulRetrieveAllPropertiesForEntity() MockPropertyServiceOption {
 	}
 }
 
+func WithSuccessfulRetrieveAllPropertiesForEntityUpdating(
+	props *properties.Properties,
+) MockPropertyServiceOption {
+	return func(mockPropSvc *mockSvc.MockPropertiesService) {
+		mockPropSvc.EXPECT().
+			RetrieveAllPropertiesForEntity(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, ewp *models.EntityWithProperties, _ manager.ProviderManager,
+				_ *service.ReadOptions) error {
+				ewp.UpdateProperties(props)
+				return nil
+			})
+	}
+}
+
 func WithFailedRetrieveAllPropertiesForEntity(
 	err error,
 ) MockPropertyServiceOption {
@@ -93,6 +93,20 @@ func (mr *MockPropertiesServiceMockRecorder) EntityWithPropertiesByUpstreamHint(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EntityWithPropertiesByUpstreamHint", reflect.TypeOf((*MockPropertiesService)(nil).EntityWithPropertiesByUpstreamHint), ctx, entType, getByProps, hint, opts)
 }
 
+// PrefetchAllPropertiesForEntities mocks base method.
+func (m *MockPropertiesService) PrefetchAllPropertiesForEntities(ctx context.Context, provider v10.Provider, efps []*models.EntityWithProperties, opts *service.ReadOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrefetchAllPropertiesForEntities", ctx, provider, efps, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrefetchAllPropertiesForEntities indicates an expected call of PrefetchAllPropertiesForEntities.
+func (mr *MockPropertiesServiceMockRecorder) PrefetchAllPropertiesForEntities(ctx, provider, efps, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrefetchAllPropertiesForEntities", reflect.TypeOf((*MockPropertiesService)(nil).PrefetchAllPropertiesForEntities), ctx, provider, efps, opts)
+}
+
 // ReplaceAllProperties mocks base method.
 func (m *MockPropertiesService) ReplaceAllProperties(ctx context.Context, entityID uuid.UUID, props *properties.Properties, opts *service.CallOptions) error {
 	m.ctrl.T.Helper()
@@ -10,6 +10,7 @@ import "github.com/mindersec/minder/internal/db"
 // to ensure we can pass in a transaction if needed.
 type CallOptions struct {
 	storeOrTransaction db.ExtendQuerier
+	changeSource       db.PropertyChangeSource
 }
 
 // CallBuilder is a function that returns a new CallOptions struct
@@ -26,6 +27,16 @@ func (psco *CallOptions) WithStoreOrTransaction(storeOrTransaction db.ExtendQuer
 	return psco
 }
 
+// WithChangeSource is a function that sets the source recorded in the property history
+// for the changes made by the call. Changes are attributed to a refresh by default.
+func (psco *CallOptions) WithChangeSource(source db.PropertyChangeSource) *CallOptions {
+	if psco == nil {
+		return nil
+	}
+	psco.changeSource = source
+	return psco
+}
+
 func (psco *CallOptions) getStoreOrTransaction() db.ExtendQuerier {
 	if psco == nil {
 		return nil
@@ -33,6 +44,13 @@ func (psco *CallOptions) getStoreOrTransaction() db.ExtendQuerier {
 	return psco.storeOrTransaction
 }
 
+func (psco *CallOptions) getChangeSource() db.PropertyChangeSource {
+	if psco == nil || psco.changeSource == "" {
+		return db.PropertyChangeSourceRefresh
+	}
+	return psco.changeSource
+}
+
 // ReadOptions is a struct that contains the options for a read service call
 // This extends the PropertiesServiceCallOptions struct and adds a TolerateStaleData field.
 // This field is used to determine if the service call can return stale data or not.
@@ -65,6 +83,16 @@ func (psco *ReadOptions) WithStoreOrTransaction(storeOrTransaction db.ExtendQuer
 	return psco
 }
 
+// WithChangeSource is a function that sets the source recorded in the property history
+// for the changes made when the properties are refreshed
+func (psco *ReadOptions) WithChangeSource(source db.PropertyChangeSource) *ReadOptions {
+	if psco == nil {
+		return nil
+	}
+	psco.changeSource = source
+	return psco
+}
+
 func (psco *ReadOptions) canTolerateStaleData() bool {
 	if psco == nil {
 		return false
@@ -81,6 +81,16 @@ type PropertiesService interface {
 	RetrieveAllPropertiesForEntity(ctx context.Context, efp *models.EntityWithProperties,
 		provMan manager.ProviderManager, opts *ReadOptions,
 	) error
+	// PrefetchAllPropertiesForEntities refreshes the expired properties of the
+	// given entities, which must belong to the given provider, with as few
+	// upstream calls as the provider allows, and saves them. Retrieving the
+	// properties of any of the entities afterwards is then served from the
+	// database. Providers which can't fetch properties in batches are left
+	// to fetch them one by one when they are retrieved.
+	PrefetchAllPropertiesForEntities(
+		ctx context.Context, provider provifv1.Provider, efps []*models.EntityWithProperties,
+		opts *ReadOptions,
+	) error
 	// ReplaceAllProperties saves all properties for the given entity
 	ReplaceAllProperties(
 		ctx context.Context, entityID uuid.UUID, props *properties.Properties, opts *CallOptions,
@@ -180,12 +190,22 @@ func (ps *propertiesService) ReplaceAllProperties(
 	qtx := ps.getStoreOrTransaction(opts)
 	zerolog.Ctx(ctx).Debug().Str("entityID", entityID.String()).Msg("replacing all properties")
 
-	err := qtx.DeleteAllPropertiesForEntity(ctx, entityID)
+	oldProps, err := getCurrentProperties(ctx, entityID, qtx)
+	if err != nil {
+		return err
+	}
+
+	err = qtx.DeleteAllPropertiesForEntity(ctx, entityID)
 	if err != nil {
 		return fmt.Errorf("failed to delete properties: %w", err)
 	}
 
-	return ps.SaveAllProperties(ctx, entityID, props, opts)
+	if err := upsertProperties(ctx, entityID, props, qtx); err != nil {
+		return err
+	}
+
+	return recordPropertyChanges(ctx, entityID, models.DiffProperties(oldProps, props, true),
+		opts.getChangeSource(), qtx)
 }
 
 func (ps *propertiesService) SaveAllProperties(
@@ -193,18 +213,18 @@ func (ps *propertiesService) SaveAllProperties(
 	opts *CallOptions,
 ) error {
 	qtx := ps.getStoreOrTransaction(opts)
-	for key, prop := range props.Iterate() {
-		_, err := qtx.UpsertPropertyValueV1(ctx, db.UpsertPropertyValueV1Params{
-			EntityID: entityID,
-			Key:      key,
-			Value:    prop.RawValue(),
-		})
-		if err != nil {
-			return err
-		}
+
+	oldProps, err := getCurrentProperties(ctx, entityID, qtx)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	if err := upsertProperties(ctx, entityID, props, qtx); err != nil {
+		return err
+	}
+
+	return recordPropertyChanges(ctx, entityID, models.DiffProperties(oldProps, props, false),
+		opts.getChangeSource(), qtx)
 }
 
 func (ps *propertiesService) ReplaceProperty(
@@ -212,19 +232,42 @@ func (ps *propertiesService) ReplaceProperty(
 	opts *CallOptions,
 ) error {
 	qtx := ps.getStoreOrTransaction(opts)
+
+	var oldProp *properties.Property
+	dbProp, err := qtx.GetProperty(ctx, db.GetPropertyParams{
+		EntityID: entityID,
+		Key:      key,
+	})
+	if err == nil {
+		oldProp, err = models.DbPropToModel(dbProp)
+		if err != nil {
+			return fmt.Errorf("failed to convert property: %w", err)
+		}
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to get property: %w", err)
+	}
+
 	if prop == nil {
-		return qtx.DeleteProperty(ctx, db.DeletePropertyParams{
+		err = qtx.DeleteProperty(ctx, db.DeletePropertyParams{
+			EntityID: entityID,
+			Key:      key,
+		})
+	} else {
+		_, err = qtx.UpsertPropertyValueV1(ctx, db.UpsertPropertyValueV1Params{
 			EntityID: entityID,
 			Key:      key,
+			Value:    prop.RawValue(),
 		})
 	}
+	if err != nil {
+		return err
+	}
 
-	_, err := qtx.UpsertPropertyValueV1(ctx, db.UpsertPropertyValueV1Params{
-		EntityID: entityID,
-		Key:      key,
-		Value:    prop.RawValue(),
-	})
-	return err
+	if oldProp.Equal(prop) {
+		return nil
+	}
+	return recordPropertyChanges(ctx, entityID, []models.PropertyChange{{Key: key, Old: oldProp, New: prop}},
+		opts.getChangeSource(), qtx)
 }
 
 func (ps *propertiesService) getEntityWithProperties(
@@ -169,7 +169,8 @@ func (e *entityCreator) CreateEntity(
 		// Replace properties - use Replace to ensure a clean slate
 		// (removes any stale properties from previous failed attempts)
 		if err := e.propSvc.ReplaceAllProperties(ctx, ent.ID, registeredProps,
-			propService.CallBuilder().WithStoreOrTransaction(t)); err != nil {
+			propService.CallBuilder().WithStoreOrTransaction(t).
+				WithChangeSource(db.PropertyChangeSourceRegistration)); err != nil {
 			return nil, fmt.Errorf("error saving properties: %w", err)
 		}
 
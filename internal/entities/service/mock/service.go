@@ -101,3 +101,19 @@ func (mr *MockEntityServiceMockRecorder) ListEntities(ctx, projectID, providerID
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntities", reflect.TypeOf((*MockEntityService)(nil).ListEntities), ctx, projectID, providerID, entityType, cursor, limit)
 }
+
+// ListEntityPropertyHistory mocks base method.
+func (m *MockEntityService) ListEntityPropertyHistory(ctx context.Context, entityID, projectID uuid.UUID, key, cursor string, limit int64) ([]*v1.EntityPropertyChange, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntityPropertyHistory", ctx, entityID, projectID, key, cursor, limit)
+	ret0, _ := ret[0].([]*v1.EntityPropertyChange)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListEntityPropertyHistory indicates an expected call of ListEntityPropertyHistory.
+func (mr *MockEntityServiceMockRecorder) ListEntityPropertyHistory(ctx, entityID, projectID, key, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntityPropertyHistory", reflect.TypeOf((*MockEntityService)(nil).ListEntityPropertyHistory), ctx, entityID, projectID, key, cursor, limit)
+}
@@ -12,8 +12,11 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
+	"github.com/sqlc-dev/pqtype"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/engine/entities"
@@ -21,6 +24,7 @@ import (
 	propService "github.com/mindersec/minder/internal/entities/properties/service"
 	"github.com/mindersec/minder/internal/providers/manager"
 	"github.com/mindersec/minder/internal/util"
+	cursorutil "github.com/mindersec/minder/internal/util/cursor"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 )
 
@@ -63,6 +67,17 @@ type EntityService interface {
 		entityID uuid.UUID,
 		projectID uuid.UUID,
 	) error
+
+	// ListEntityPropertyHistory retrieves the recorded property changes of an entity,
+	// newest first, optionally filtered by property key
+	ListEntityPropertyHistory(
+		ctx context.Context,
+		entityID uuid.UUID,
+		projectID uuid.UUID,
+		key string,
+		cursor string,
+		limit int64,
+	) ([]*pb.EntityPropertyChange, string, error)
 }
 
 type entityService struct {
@@ -313,8 +328,98 @@ func (s *entityService) DeleteEntityByID(
 	return nil
 }
 
+func (s *entityService) ListEntityPropertyHistory(
+	ctx context.Context,
+	entityID uuid.UUID,
+	projectID uuid.UUID,
+	key string,
+	cursor string,
+	limit int64,
+) ([]*pb.EntityPropertyChange, string, error) {
+	// Get entity to verify it exists and belongs to the project
+	entity, err := s.store.GetEntityByID(ctx, entityID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, "", status.Errorf(codes.NotFound, "entity not found")
+		}
+		return nil, "", fmt.Errorf("error fetching entity: %w", err)
+	}
+
+	if entity.ProjectID != projectID {
+		return nil, "", status.Errorf(codes.NotFound, "entity not found in project")
+	}
+
+	historyCursor, err := cursorutil.NewPropertyHistoryCursor(cursor)
+	if err != nil {
+		return nil, "", util.UserVisibleError(codes.InvalidArgument, "invalid cursor format")
+	}
+
+	// fetch one more row than requested to know whether there is a next page
+	rows, err := s.store.ListPropertyHistory(ctx, db.ListPropertyHistoryParams{
+		EntityID: entityID,
+		Key:      sql.NullString{String: key, Valid: key != ""},
+		Cursor:   historyCursor.ID,
+		Size:     limit + 1,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("error listing property history: %w", err)
+	}
+
+	nextCursor := ""
+	if int64(len(rows)) > limit {
+		rows = rows[:limit]
+		nextCursor = (&cursorutil.PropertyHistoryCursor{ID: rows[len(rows)-1].ID}).String()
+	}
+
+	changes := make([]*pb.EntityPropertyChange, 0, len(rows))
+	for _, row := range rows {
+		change, err := propertyChangeToProto(row)
+		if err != nil {
+			return nil, "", fmt.Errorf("error converting property change: %w", err)
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nextCursor, nil
+}
+
 // Helper functions
 
+// propertyChangeToProto converts a db.PropertyHistory row to a pb.EntityPropertyChange
+func propertyChangeToProto(row db.PropertyHistory) (*pb.EntityPropertyChange, error) {
+	oldValue, err := propertyHistoryValueToProto(row.OldValue)
+	if err != nil {
+		return nil, err
+	}
+	newValue, err := propertyHistoryValueToProto(row.NewValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EntityPropertyChange{
+		Key:       row.Key,
+		OldValue:  oldValue,
+		NewValue:  newValue,
+		Source:    string(row.Source),
+		ChangedAt: timestamppb.New(row.ChangedAt),
+	}, nil
+}
+
+// propertyHistoryValueToProto converts a stored property value to a structpb.Value,
+// a missing value (property added or removed) is returned as nil
+func propertyHistoryValueToProto(raw pqtype.NullRawMessage) (*structpb.Value, error) {
+	if !raw.Valid {
+		return nil, nil
+	}
+
+	value, err := db.PropValueFromDbV1(raw.RawMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	return structpb.NewValue(value)
+}
+
 // entityInstanceToProto converts an EntityWithProperties to a pb.EntityInstance
 func entityInstanceToProto(ewp *models.EntityWithProperties) *pb.EntityInstance {
 	// Convert properties to structpb.Struct
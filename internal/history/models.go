@@ -37,7 +37,7 @@ var (
 
 var (
 	allowedEntityTypes         = []string{"repository", "build_environment", "artifact", "pull_request"}
-	allowedEvaluationStatuses  = []string{"success", "failure", "error", "skipped", "pending"}
+	allowedEvaluationStatuses  = []string{"success", "failure", "error", "skipped", "pending", "timeout", "waived"}
 	allowedRemediationStatuses = []string{"success", "failure", "error", "skipped", "not_available", "pending"}
 	allowedAlertStatuses       = []string{"on", "off", "error", "skipped", "not_available"}
 )
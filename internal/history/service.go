@@ -578,6 +578,10 @@ func mapEvalStatusTypes(
 		return db.EvalStatusTypesSkipped, nil
 	case "pending":
 		return db.EvalStatusTypesPending, nil
+	case "timeout":
+		return db.EvalStatusTypesTimeout, nil
+	case "waived":
+		return db.EvalStatusTypesWaived, nil
 	default:
 		return db.EvalStatusTypes("invalid"),
 			fmt.Errorf("invalid evaluation status: %s", value)
@@ -4,12 +4,22 @@
 package marketplaces
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"path"
 	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/rs/zerolog"
 
 	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
 	sub "github.com/mindersec/minder/internal/marketplaces/subscriptions"
+	"github.com/mindersec/minder/internal/verifier/sigstore"
+	"github.com/mindersec/minder/internal/verifier/sigstore/container"
 	"github.com/mindersec/minder/pkg/config/server"
 	"github.com/mindersec/minder/pkg/mindpak"
 	src "github.com/mindersec/minder/pkg/mindpak/sources"
@@ -20,9 +30,12 @@ import (
 // NewMarketplaceFromServiceConfig takes the Minder service config and
 // instantiates the object graph needed for the Marketplace. If the marketplace
 // functionality is disabled in the config or missing, this returns a no-op
-// implementation of Marketplace. Otherwise, it loads the bundle specified in
-// the service config and builds a single-source Marketplace for it.
+// implementation of Marketplace. Otherwise, it loads the bundles specified in
+// the service config and builds a Marketplace for them. Bundles pulled from
+// OCI registries and git repositories are refreshed in the background until
+// the context is canceled.
 func NewMarketplaceFromServiceConfig(
+	ctx context.Context,
 	config server.MarketplaceConfig,
 	profile profiles.ProfileService,
 	ruleType ruletypes.RuleTypeService,
@@ -41,18 +54,14 @@ func NewMarketplaceFromServiceConfig(
 
 	newSources := make([]src.BundleSource, len(cfgSources))
 	for i, cfgSource := range cfgSources {
-		// This is just used for validation
-		// TODO: support other sources
-		if t, err := cfgSource.GetType(); err != nil || t != server.TgzSource {
-			return nil, fmt.Errorf("unexpected source type: %s", cfgSource.Type)
-		}
-
-		tarPath := filepath.Clean(cfgSource.Location)
-		source, err := src.NewSourceFromTarGZ(tarPath)
+		source, err := newSourceFromConfig(ctx, &cfgSource)
 		if err != nil {
-			return nil, fmt.Errorf("unable to load tar from path %s: %w", tarPath, err)
+			return nil, err
 		}
 
+		if refreshable, ok := source.(src.RefreshableSource); ok && cfgSource.RefreshInterval > 0 {
+			go refreshPeriodically(ctx, refreshable, cfgSource.Location, cfgSource.RefreshInterval)
+		}
 		newSources[i] = source
 	}
 
@@ -64,16 +73,121 @@ func NewMarketplaceFromServiceConfig(
 	return marketplace, nil
 }
 
-// NewMarketplace creates an instance of Marketplace with a single source
+func newSourceFromConfig(ctx context.Context, cfgSource *server.BundleSourceConfig) (src.BundleSource, error) {
+	t, err := cfgSource.GetType()
+	if err != nil {
+		return nil, fmt.Errorf("unexpected source type: %s", cfgSource.Type)
+	}
+
+	switch t {
+	case server.TgzSource:
+		tarPath := filepath.Clean(cfgSource.Location)
+		source, err := src.NewSourceFromTarGZ(tarPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load tar from path %s: %w", tarPath, err)
+		}
+		return source, nil
+	case server.OCISource:
+		var opts []src.OCIOption
+		if cfgSource.Signature.Required {
+			verifier, err := newSignatureVerifier(&cfgSource.Signature, cfgSource.Location)
+			if err != nil {
+				return nil, fmt.Errorf("unable to create signature verifier for %s: %w", cfgSource.Location, err)
+			}
+			opts = append(opts, src.WithSignatureVerifier(verifier))
+		}
+		source, err := src.NewSourceFromOCI(ctx, cfgSource.Location, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load OCI bundle %s: %w", cfgSource.Location, err)
+		}
+		return source, nil
+	case server.GitSource:
+		source, err := src.NewSourceFromGit(ctx, cfgSource.Location, cfgSource.Ref, cfgSource.Path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load bundle from git repository %s: %w", cfgSource.Location, err)
+		}
+		return source, nil
+	default:
+		return nil, fmt.Errorf("unexpected source type: %s", cfgSource.Type)
+	}
+}
+
+// newSignatureVerifier returns a verifier which requires the bundle to carry
+// a sigstore signature verified against the configured trusted root and
+// signed by one of the configured identities
+func newSignatureVerifier(cfg *server.BundleSignatureConfig, location string) (src.SignatureVerifier, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	ref, err := name.ParseReference(location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OCI reference %s: %w", location, err)
+	}
+	auth, err := authn.DefaultKeychain.Resolve(ref.Context())
+	if err != nil {
+		return nil, fmt.Errorf("error resolving registry credentials: %w", err)
+	}
+
+	verifier, err := sigstore.NewWithOptions(&sigstore.Options{
+		TUFRepoURL: cfg.TUFRepoURL,
+		Policy:     container.Policy{Identities: cfg.Identities},
+		AuthOpts: []container.AuthMethod{
+			container.WithRegistry(ref.Context().RegistryStr()),
+			container.WithAuthenticator(auth),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, repo name.Repository, digest string) error {
+		owner, artifact := path.Split(repo.RepositoryStr())
+		results, err := verifier.VerifyContainer(ctx, strings.TrimSuffix(owner, "/"), artifact, digest)
+		if err != nil {
+			return err
+		}
+		for _, res := range results {
+			if res.IsVerified {
+				return nil
+			}
+		}
+		return errors.New("no verified signature found")
+	}, nil
+}
+
+// refreshPeriodically pulls the bundle of a source again on every interval.
+// Failures are logged, and the source keeps serving the last bundle it
+// loaded.
+func refreshPeriodically(ctx context.Context, source src.RefreshableSource, location string, interval time.Duration) {
+	logger := zerolog.Ctx(ctx).With().Str("bundle_source", location).Logger()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := source.Refresh(ctx); err != nil {
+				logger.Error().Err(err).Msg("error refreshing bundle source")
+				continue
+			}
+			logger.Debug().Msg("refreshed bundle source")
+		}
+	}
+}
+
+// NewMarketplace creates an instance of Marketplace from a list of sources.
+// Sources may provide different versions of the same bundle.
 func NewMarketplace(sources []src.BundleSource, subscriptions sub.SubscriptionService) (Marketplace, error) {
-	sourceMapping := make(map[mindpak.BundleID]src.BundleSource)
+	sourceMapping := make(map[mindpak.BundleID][]src.BundleSource)
 	for _, source := range sources {
 		bundles, err := source.ListBundles()
 		if err != nil {
 			return nil, fmt.Errorf("error while listing bundles: %w", err)
 		}
 		for _, id := range bundles {
-			sourceMapping[id] = source
+			sourceMapping[id] = append(sourceMapping[id], source)
 		}
 	}
 	return &marketplace{
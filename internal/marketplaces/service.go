@@ -7,12 +7,18 @@ package marketplaces
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
+	"golang.org/x/mod/semver"
 
 	"github.com/mindersec/minder/internal/db"
 	sub "github.com/mindersec/minder/internal/marketplaces/subscriptions"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/mindpak"
 	"github.com/mindersec/minder/pkg/mindpak/reader"
 	"github.com/mindersec/minder/pkg/mindpak/sources"
@@ -38,14 +44,70 @@ type Marketplace interface {
 		profileName string,
 		qtx db.Querier,
 	) error
+	// ListBundles lists the bundles available in the marketplace, along
+	// with their versions.
+	ListBundles() ([]BundleInfo, error)
+	// Unsubscribe removes the subscription between the specified project
+	// and bundle, and deletes the rule types, profiles and data sources of
+	// the bundle from the project.
+	Unsubscribe(
+		ctx context.Context,
+		projectID uuid.UUID,
+		bundleID mindpak.BundleID,
+		qtx db.ExtendQuerier,
+	) error
+	// PreviewUpgrade returns the changes upgrading the subscription of the
+	// project to the specified version of the bundle would make. The latest
+	// version is used if version is empty.
+	PreviewUpgrade(
+		ctx context.Context,
+		projectID uuid.UUID,
+		bundleID mindpak.BundleID,
+		version string,
+		qtx db.ExtendQuerier,
+	) (*UpgradeResult, error)
+	// Upgrade upgrades the subscription of the project to the specified
+	// version of the bundle. The latest version is used if version is empty.
+	Upgrade(
+		ctx context.Context,
+		projectID uuid.UUID,
+		bundleID mindpak.BundleID,
+		version string,
+		qtx db.ExtendQuerier,
+	) (*UpgradeResult, error)
+}
+
+// BundleInfo describes a bundle available in the marketplace
+type BundleInfo struct {
+	ID mindpak.BundleID
+	// Versions lists the available versions of the bundle, newest first
+	Versions []string
 }
 
-// trivial implementation of Marketplace with a single source
+// UpgradeResult describes the upgrade of a subscription to a bundle version
+type UpgradeResult struct {
+	FromVersion string
+	ToVersion   string
+	Diff        *minderv1.BundleDiff
+}
+
+var (
+	// ErrBundleNotFound is returned when the marketplace does not know the bundle
+	ErrBundleNotFound = errors.New("bundle not found")
+	// ErrVersionNotFound is returned when the requested version of a bundle
+	// is not available in the marketplace
+	ErrVersionNotFound = errors.New("bundle version not found")
+	// ErrDowngrade is returned when upgrading a subscription to an older version
+	ErrDowngrade = errors.New("cannot upgrade to an older bundle version")
+)
+
+// implementation of Marketplace over a fixed set of sources
 type marketplace struct {
 	// ASSUMPTION: all sources are known at application startup
 	// This will need more complex logic if external sources can be added
 	// dynamically by customers.
-	sources       map[mindpak.BundleID]sources.BundleSource
+	// Different sources may provide different versions of the same bundle.
+	sources       map[mindpak.BundleID][]sources.BundleSource
 	subscriptions sub.SubscriptionService
 }
 
@@ -84,16 +146,161 @@ func (s *marketplace) AddProfile(
 	return nil
 }
 
+func (s *marketplace) ListBundles() ([]BundleInfo, error) {
+	result := make([]BundleInfo, 0, len(s.sources))
+	for id := range s.sources {
+		bundles, err := s.getBundleVersions(id)
+		if err != nil {
+			return nil, err
+		}
+		info := BundleInfo{ID: id}
+		for _, bundle := range bundles {
+			info.Versions = append(info.Versions, bundle.GetMetadata().Version)
+		}
+		result = append(result, info)
+	}
+	slices.SortFunc(result, func(a, b BundleInfo) int {
+		return strings.Compare(a.ID.String(), b.ID.String())
+	})
+	return result, nil
+}
+
+func (s *marketplace) Unsubscribe(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundleID mindpak.BundleID,
+	qtx db.ExtendQuerier,
+) error {
+	// the bundle may no longer be available in the sources, which does not
+	// prevent unsubscribing from it
+	if err := s.subscriptions.Unsubscribe(ctx, projectID, bundleID, qtx); err != nil {
+		return fmt.Errorf("error while removing subscription: %w", err)
+	}
+	return nil
+}
+
+func (s *marketplace) PreviewUpgrade(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundleID mindpak.BundleID,
+	version string,
+	qtx db.ExtendQuerier,
+) (*UpgradeResult, error) {
+	return s.upgrade(ctx, projectID, bundleID, version, qtx, true)
+}
+
+func (s *marketplace) Upgrade(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundleID mindpak.BundleID,
+	version string,
+	qtx db.ExtendQuerier,
+) (*UpgradeResult, error) {
+	return s.upgrade(ctx, projectID, bundleID, version, qtx, false)
+}
+
+func (s *marketplace) upgrade(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundleID mindpak.BundleID,
+	version string,
+	qtx db.ExtendQuerier,
+	dryRun bool,
+) (*UpgradeResult, error) {
+	subscription, err := qtx.GetSubscriptionByProjectBundle(ctx, db.GetSubscriptionByProjectBundleParams{
+		Namespace: bundleID.Namespace,
+		Name:      bundleID.Name,
+		ProjectID: projectID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: project %s, bundle %s", sub.ErrNotSubscribed, projectID, bundleID)
+	} else if err != nil {
+		return nil, fmt.Errorf("error while querying subscriptions: %w", err)
+	}
+
+	var bundle reader.BundleReader
+	if version == "" {
+		bundle, err = s.getBundle(bundleID)
+	} else {
+		bundle, err = s.getBundleVersion(bundleID, version)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	target := bundle.GetMetadata().Version
+	if compareVersions(target, subscription.CurrentVersion) < 0 {
+		return nil, fmt.Errorf("%w: %s is older than %s", ErrDowngrade, target, subscription.CurrentVersion)
+	}
+
+	var diff *minderv1.BundleDiff
+	if dryRun {
+		diff, err = s.subscriptions.Diff(ctx, projectID, bundle, qtx)
+	} else {
+		diff, err = s.subscriptions.Upgrade(ctx, projectID, bundle, qtx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while upgrading subscription: %w", err)
+	}
+	return &UpgradeResult{
+		FromVersion: subscription.CurrentVersion,
+		ToVersion:   target,
+		Diff:        diff,
+	}, nil
+}
+
+// getBundle returns the latest version of the bundle
 func (s *marketplace) getBundle(bundleID mindpak.BundleID) (reader.BundleReader, error) {
-	source, ok := s.sources[bundleID]
-	if !ok {
-		return nil, fmt.Errorf("unknown bundle: %s", bundleID)
+	bundles, err := s.getBundleVersions(bundleID)
+	if err != nil {
+		return nil, err
 	}
-	bundle, err := source.GetBundle(bundleID)
+	return bundles[0], nil
+}
+
+// getBundleVersion returns the specified version of the bundle
+func (s *marketplace) getBundleVersion(bundleID mindpak.BundleID, version string) (reader.BundleReader, error) {
+	bundles, err := s.getBundleVersions(bundleID)
 	if err != nil {
-		return nil, fmt.Errorf("error while retrieving bundle: %w", err)
+		return nil, err
+	}
+	for _, bundle := range bundles {
+		if bundle.GetMetadata().Version == version {
+			return bundle, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s@%s", ErrVersionNotFound, bundleID, version)
+}
+
+// getBundleVersions returns the versions of the bundle provided by all
+// sources, newest first
+func (s *marketplace) getBundleVersions(bundleID mindpak.BundleID) ([]reader.BundleReader, error) {
+	bundleSources, ok := s.sources[bundleID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrBundleNotFound, bundleID)
+	}
+	bundles := make([]reader.BundleReader, 0, len(bundleSources))
+	for _, source := range bundleSources {
+		bundle, err := source.GetBundle(bundleID)
+		if err != nil {
+			return nil, fmt.Errorf("error while retrieving bundle: %w", err)
+		}
+		bundles = append(bundles, bundle)
+	}
+	slices.SortStableFunc(bundles, func(a, b reader.BundleReader) int {
+		return compareVersions(b.GetMetadata().Version, a.GetMetadata().Version)
+	})
+	return bundles, nil
+}
+
+// compareVersions compares two bundle versions. Semantic versions are
+// compared by precedence, any other versions are compared lexically.
+func compareVersions(a, b string) int {
+	va, vb := "v"+strings.TrimPrefix(a, "v"), "v"+strings.TrimPrefix(b, "v")
+	if semver.IsValid(va) && semver.IsValid(vb) {
+		return semver.Compare(va, vb)
 	}
-	return bundle, nil
+	return strings.Compare(a, b)
 }
 
 // noopMarketplace is an instance of Marketplace which does nothing.
@@ -113,3 +320,31 @@ func (*noopMarketplace) AddProfile(
 ) error {
 	return nil
 }
+
+func (*noopMarketplace) ListBundles() ([]BundleInfo, error) {
+	return nil, nil
+}
+
+func (*noopMarketplace) Unsubscribe(_ context.Context, _ uuid.UUID, bundleID mindpak.BundleID, _ db.ExtendQuerier) error {
+	return fmt.Errorf("%w: %s", ErrBundleNotFound, bundleID)
+}
+
+func (*noopMarketplace) PreviewUpgrade(
+	_ context.Context,
+	_ uuid.UUID,
+	bundleID mindpak.BundleID,
+	_ string,
+	_ db.ExtendQuerier,
+) (*UpgradeResult, error) {
+	return nil, fmt.Errorf("%w: %s", ErrBundleNotFound, bundleID)
+}
+
+func (*noopMarketplace) Upgrade(
+	_ context.Context,
+	_ uuid.UUID,
+	bundleID mindpak.BundleID,
+	_ string,
+	_ db.ExtendQuerier,
+) (*UpgradeResult, error) {
+	return nil, fmt.Errorf("%w: %s", ErrBundleNotFound, bundleID)
+}
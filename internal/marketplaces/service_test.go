@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/mindersec/minder/internal/db"
 	dbf "github.com/mindersec/minder/internal/db/fixtures"
 	"github.com/mindersec/minder/internal/marketplaces"
 	mockbundle "github.com/mindersec/minder/internal/marketplaces/bundles/mock"
@@ -73,6 +74,114 @@ func TestMarketplace_AddProfile(t *testing.T) {
 	})
 }
 
+func TestMarketplace_ListBundles(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var bundleSources []sources.BundleSource
+	for _, version := range []string{"1.2.0", "1.10.0", "1.9.1"} {
+		bundleSources = append(bundleSources, versionedSource(ctrl, version))
+	}
+
+	marketplace, err := marketplaces.NewMarketplace(bundleSources, nil)
+	require.NoError(t, err)
+
+	bundles, err := marketplace.ListBundles()
+	require.NoError(t, err)
+	require.Equal(t, []marketplaces.BundleInfo{{
+		ID:       bundleID,
+		Versions: []string{"1.10.0", "1.9.1", "1.2.0"},
+	}}, bundles)
+}
+
+func TestMarketplace_Upgrade(t *testing.T) {
+	t.Parallel()
+
+	scenarios := []struct {
+		Name              string
+		CurrentVersion    string
+		Version           string
+		SubscriptionSetup ssf.SubscriptionMockBuilder
+		ExpectedError     error
+	}{
+		{
+			Name:              "Upgrade upgrades to the latest version by default",
+			CurrentVersion:    "1.0.0",
+			SubscriptionSetup: ssf.NewSubscriptionServiceMock(ssf.WithSuccessfulUpgrade),
+		},
+		{
+			Name:              "Upgrade upgrades to the requested version",
+			CurrentVersion:    "1.0.0",
+			Version:           "1.1.0",
+			SubscriptionSetup: ssf.NewSubscriptionServiceMock(ssf.WithSuccessfulUpgrade),
+		},
+		{
+			Name:           "Upgrade returns error when the version is not available",
+			CurrentVersion: "1.0.0",
+			Version:        "3.0.0",
+			ExpectedError:  marketplaces.ErrVersionNotFound,
+		},
+		{
+			Name:           "Upgrade refuses to downgrade",
+			CurrentVersion: "2.0.0",
+			ExpectedError:  marketplaces.ErrDowngrade,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var subSvc subscriptions.SubscriptionService
+			if scenario.SubscriptionSetup != nil {
+				subSvc = scenario.SubscriptionSetup(ctrl)
+			}
+
+			marketplace, err := marketplaces.NewMarketplace([]sources.BundleSource{
+				versionedSource(ctrl, "1.0.0"),
+				versionedSource(ctrl, "1.1.0"),
+				versionedSource(ctrl, "1.2.0"),
+			}, subSvc)
+			require.NoError(t, err)
+
+			store := dbf.NewDBMock(func(mock dbf.DBMock) {
+				mock.EXPECT().
+					GetSubscriptionByProjectBundle(gomock.Any(), gomock.Any()).
+					Return(db.Subscription{CurrentVersion: scenario.CurrentVersion}, nil)
+			})(ctrl)
+
+			result, err := marketplace.Upgrade(context.Background(), projectID, bundleID, scenario.Version, store)
+			if scenario.ExpectedError != nil {
+				require.ErrorIs(t, err, scenario.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, scenario.CurrentVersion, result.FromVersion)
+			if scenario.Version == "" {
+				require.Equal(t, "1.2.0", result.ToVersion)
+			} else {
+				require.Equal(t, scenario.Version, result.ToVersion)
+			}
+		})
+	}
+}
+
+// versionedSource returns a source providing the given version of the bundle
+func versionedSource(ctrl *gomock.Controller, version string) sources.BundleSource {
+	reader := mockbundle.NewMockBundleReader(ctrl)
+	reader.EXPECT().
+		GetMetadata().
+		Return(&mindpak.Metadata{Namespace: bundleID.Namespace, Name: bundleID.Name, Version: version}).
+		AnyTimes()
+	source := mockbundle.NewMockBundleSource(ctrl)
+	source.EXPECT().ListBundles().Return([]mindpak.BundleID{bundleID}, nil)
+	source.EXPECT().GetBundle(bundleID).Return(reader, nil).AnyTimes()
+	return source
+}
+
 func testHarness(t *testing.T, method testMethod, scenarios []testScenario) {
 	t.Helper()
 	for _, scenario := range scenarios {
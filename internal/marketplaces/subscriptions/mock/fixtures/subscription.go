@@ -11,6 +11,7 @@ import (
 	"errors"
 
 	mocksubscription "github.com/mindersec/minder/internal/marketplaces/subscriptions/mock"
+	v1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"go.uber.org/mock/gomock"
 )
 
@@ -56,3 +57,9 @@ func WithFailedCreateProfile(mock SubscriptionMock) {
 		CreateProfile(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(errDefault)
 }
+
+func WithSuccessfulUpgrade(mock SubscriptionMock) {
+	mock.EXPECT().
+		Upgrade(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&v1.BundleDiff{}, nil)
+}
@@ -15,6 +15,8 @@ import (
 
 	uuid "github.com/google/uuid"
 	db "github.com/mindersec/minder/internal/db"
+	v1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	mindpak "github.com/mindersec/minder/pkg/mindpak"
 	reader "github.com/mindersec/minder/pkg/mindpak/reader"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -57,6 +59,21 @@ func (mr *MockSubscriptionServiceMockRecorder) CreateProfile(ctx, projectID, bun
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProfile", reflect.TypeOf((*MockSubscriptionService)(nil).CreateProfile), ctx, projectID, bundle, profileName, qtx)
 }
 
+// Diff mocks base method.
+func (m *MockSubscriptionService) Diff(ctx context.Context, projectID uuid.UUID, bundle reader.BundleReader, qtx db.ExtendQuerier) (*v1.BundleDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Diff", ctx, projectID, bundle, qtx)
+	ret0, _ := ret[0].(*v1.BundleDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Diff indicates an expected call of Diff.
+func (mr *MockSubscriptionServiceMockRecorder) Diff(ctx, projectID, bundle, qtx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Diff", reflect.TypeOf((*MockSubscriptionService)(nil).Diff), ctx, projectID, bundle, qtx)
+}
+
 // Subscribe mocks base method.
 func (m *MockSubscriptionService) Subscribe(ctx context.Context, projectID uuid.UUID, bundle reader.BundleReader, qtx db.ExtendQuerier) error {
 	m.ctrl.T.Helper()
@@ -70,3 +87,32 @@ func (mr *MockSubscriptionServiceMockRecorder) Subscribe(ctx, projectID, bundle,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockSubscriptionService)(nil).Subscribe), ctx, projectID, bundle, qtx)
 }
+
+// Unsubscribe mocks base method.
+func (m *MockSubscriptionService) Unsubscribe(ctx context.Context, projectID uuid.UUID, bundleID mindpak.BundleID, qtx db.ExtendQuerier) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", ctx, projectID, bundleID, qtx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockSubscriptionServiceMockRecorder) Unsubscribe(ctx, projectID, bundleID, qtx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockSubscriptionService)(nil).Unsubscribe), ctx, projectID, bundleID, qtx)
+}
+
+// Upgrade mocks base method.
+func (m *MockSubscriptionService) Upgrade(ctx context.Context, projectID uuid.UUID, bundle reader.BundleReader, qtx db.ExtendQuerier) (*v1.BundleDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upgrade", ctx, projectID, bundle, qtx)
+	ret0, _ := ret[0].(*v1.BundleDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upgrade indicates an expected call of Upgrade.
+func (mr *MockSubscriptionServiceMockRecorder) Upgrade(ctx, projectID, bundle, qtx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upgrade", reflect.TypeOf((*MockSubscriptionService)(nil).Upgrade), ctx, projectID, bundle, qtx)
+}
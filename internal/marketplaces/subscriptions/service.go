@@ -11,6 +11,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 
@@ -46,8 +47,40 @@ type SubscriptionService interface {
 		profileName string,
 		qtx db.Querier,
 	) error
+	// Unsubscribe deletes the subscription of the project to the bundle,
+	// along with the rule types, profiles and data sources it created.
+	Unsubscribe(
+		ctx context.Context,
+		projectID uuid.UUID,
+		bundleID mindpak.BundleID,
+		qtx db.ExtendQuerier,
+	) error
+	// Diff returns the changes which upgrading the subscription of the
+	// project to the specified version of the bundle would make.
+	Diff(
+		ctx context.Context,
+		projectID uuid.UUID,
+		bundle reader.BundleReader,
+		qtx db.ExtendQuerier,
+	) (*minderv1.BundleDiff, error)
+	// Upgrade upgrades the subscription of the project to the specified
+	// version of the bundle, and returns the changes it made.
+	Upgrade(
+		ctx context.Context,
+		projectID uuid.UUID,
+		bundle reader.BundleReader,
+		qtx db.ExtendQuerier,
+	) (*minderv1.BundleDiff, error)
 }
 
+var (
+	// ErrNotSubscribed is returned when the project is not subscribed to the bundle
+	ErrNotSubscribed = errors.New("project is not subscribed to bundle")
+	// ErrInUse is returned when a rule type or data source of a bundle
+	// cannot be removed because resources outside the bundle use it
+	ErrInUse = errors.New("bundle resource is in use")
+)
+
 type subscriptionService struct {
 	profiles    profsvc.ProfileService
 	rules       ruletypes.RuleTypeService
@@ -128,7 +161,7 @@ func (s *subscriptionService) CreateProfile(
 	qtx db.Querier,
 ) error {
 	// ensure project is subscribed to this bundle
-	subscription, err := s.findSubscription(ctx, qtx, projectID, bundle.GetMetadata())
+	subscription, err := findSubscription(ctx, qtx, projectID, bundleIDOf(bundle))
 	if err != nil {
 		return err
 	}
@@ -145,29 +178,82 @@ func (s *subscriptionService) CreateProfile(
 	return nil
 }
 
-func (*subscriptionService) findSubscription(
+func (*subscriptionService) Unsubscribe(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundleID mindpak.BundleID,
+	qtx db.ExtendQuerier,
+) error {
+	subscription, err := findSubscription(ctx, qtx, projectID, bundleID)
+	if err != nil {
+		return err
+	}
+	subscriptionID := uuid.NullUUID{UUID: subscription.ID, Valid: true}
+
+	// profiles go first, as they instantiate the rule types
+	profiles, err := qtx.ListProfilesBySubscription(ctx, subscriptionID)
+	if err != nil {
+		return fmt.Errorf("error while listing profiles: %w", err)
+	}
+	for _, profile := range profiles {
+		if err := deleteProfile(ctx, qtx, profile); err != nil {
+			return err
+		}
+	}
+
+	ruleTypes, err := qtx.ListRuleTypesBySubscription(ctx, subscriptionID)
+	if err != nil {
+		return fmt.Errorf("error while listing rule types: %w", err)
+	}
+	for _, ruleType := range ruleTypes {
+		if err := deleteRuleType(ctx, qtx, ruleType); err != nil {
+			return err
+		}
+	}
+
+	dataSources, err := qtx.ListDataSourcesBySubscription(ctx, subscriptionID)
+	if err != nil {
+		return fmt.Errorf("error while listing data sources: %w", err)
+	}
+	for _, dataSource := range dataSources {
+		if err := deleteDataSource(ctx, qtx, dataSource); err != nil {
+			return err
+		}
+	}
+
+	if err := qtx.DeleteSubscription(ctx, subscription.ID); err != nil {
+		return fmt.Errorf("error while deleting subscription: %w", err)
+	}
+	return nil
+}
+
+func findSubscription(
 	ctx context.Context,
 	qtx db.Querier,
 	projectID uuid.UUID,
-	metadata *mindpak.Metadata,
+	bundleID mindpak.BundleID,
 ) (result db.Subscription, err error) {
 	result, err = qtx.GetSubscriptionByProjectBundle(ctx,
 		db.GetSubscriptionByProjectBundleParams{
-			Namespace: metadata.Namespace,
-			Name:      metadata.Name,
+			Namespace: bundleID.Namespace,
+			Name:      bundleID.Name,
 			ProjectID: projectID,
 		},
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return result, fmt.Errorf("project %s is not subscribed to bundle %s/%s",
-				projectID, metadata.Namespace, metadata.Name)
+			return result, fmt.Errorf("%w: project %s, bundle %s", ErrNotSubscribed, projectID, bundleID)
 		}
 		return result, fmt.Errorf("error while querying subscriptions: %w", err)
 	}
 	return result, nil
 }
 
+func bundleIDOf(bundle reader.BundleReader) mindpak.BundleID {
+	metadata := bundle.GetMetadata()
+	return mindpak.ID(metadata.Namespace, metadata.Name)
+}
+
 func ensureBundleExists(
 	ctx context.Context,
 	qtx db.Querier,
@@ -218,3 +304,51 @@ func (s *subscriptionService) upsertBundleDataSources(
 		return s.dataSources.Upsert(ctx, projectID, subscriptionID, dataSource, datasourceservice.OptionsBuilder().WithTransaction(qtx))
 	})
 }
+
+func deleteProfile(ctx context.Context, qtx db.Querier, profile db.Profile) error {
+	err := qtx.DeleteProfile(ctx, db.DeleteProfileParams{
+		ID:        profile.ID,
+		ProjectID: profile.ProjectID,
+	})
+	if err != nil {
+		return fmt.Errorf("error while deleting profile %s: %w", profile.Name, err)
+	}
+	return nil
+}
+
+// deleteRuleType deletes a rule type of a bundle, provided that the profiles
+// of the bundle have already been deleted and no other profile uses it
+func deleteRuleType(ctx context.Context, qtx db.Querier, ruleType db.RuleType) error {
+	profiles, err := qtx.ListProfilesInstantiatingRuleType(ctx, ruleType.ID)
+	if err != nil {
+		return fmt.Errorf("error while listing profiles using rule type %s: %w", ruleType.Name, err)
+	}
+	if len(profiles) > 0 {
+		return fmt.Errorf("%w: rule type %s is used by profiles %s",
+			ErrInUse, ruleType.Name, strings.Join(profiles, ", "))
+	}
+	if err := qtx.DeleteRuleType(ctx, ruleType.ID); err != nil {
+		return fmt.Errorf("error while deleting rule type %s: %w", ruleType.Name, err)
+	}
+	return nil
+}
+
+// deleteDataSource deletes a data source of a bundle, provided that no rule
+// type uses it
+func deleteDataSource(ctx context.Context, qtx db.Querier, dataSource db.DataSource) error {
+	refs, err := qtx.ListRuleTypesReferencesByDataSource(ctx, dataSource.ID)
+	if err != nil {
+		return fmt.Errorf("error while listing rule types using data source %s: %w", dataSource.Name, err)
+	}
+	if len(refs) > 0 {
+		return fmt.Errorf("%w: data source %s is used by %d rule types", ErrInUse, dataSource.Name, len(refs))
+	}
+	_, err = qtx.DeleteDataSource(ctx, db.DeleteDataSourceParams{
+		ID:        dataSource.ID,
+		ProjectID: dataSource.ProjectID,
+	})
+	if err != nil {
+		return fmt.Errorf("error while deleting data source %s: %w", dataSource.Name, err)
+	}
+	return nil
+}
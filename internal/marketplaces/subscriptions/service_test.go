@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
@@ -19,6 +20,8 @@ import (
 	dbf "github.com/mindersec/minder/internal/db/fixtures"
 	brf "github.com/mindersec/minder/internal/marketplaces/bundles/mock/fixtures"
 	"github.com/mindersec/minder/internal/marketplaces/subscriptions"
+	v1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/mindpak"
 	"github.com/mindersec/minder/pkg/mindpak/reader"
 	"github.com/mindersec/minder/pkg/profiles"
 	psf "github.com/mindersec/minder/pkg/profiles/mock/fixtures"
@@ -179,6 +182,153 @@ func TestSubscriptionService_CreateProfile(t *testing.T) {
 	}
 }
 
+func TestSubscriptionService_Unsubscribe(t *testing.T) {
+	t.Parallel()
+	scenarios := []struct {
+		Name          string
+		DBSetup       dbf.DBMockBuilder
+		ExpectedError error
+	}{
+		{
+			Name:          "Unsubscribe returns error when project is not subscribed to bundle",
+			DBSetup:       dbf.NewDBMock(withNotFoundFindSubscription),
+			ExpectedError: subscriptions.ErrNotSubscribed,
+		},
+		{
+			Name: "Unsubscribe returns error when a rule type is used outside the bundle",
+			DBSetup: dbf.NewDBMock(withSuccessfulFindSubscription, withBundleProfiles, withBundleRuleTypes,
+				withProfilesInstantiatingRuleType([]string{"my-profile"})),
+			ExpectedError: subscriptions.ErrInUse,
+		},
+		{
+			Name: "Unsubscribe deletes the bundle resources and the subscription",
+			DBSetup: dbf.NewDBMock(withSuccessfulFindSubscription, withBundleProfiles, withBundleRuleTypes,
+				withProfilesInstantiatingRuleType(nil), withBundleDataSources, withDeletedBundleResources),
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ctx := context.Background()
+
+			querier := getQuerier(ctrl, scenario.DBSetup)
+
+			svc := createService(ctrl, nil, nil, nil)
+			err := svc.Unsubscribe(ctx, projectID, mindpak.ID("stacklok", "healthcheck"), querier)
+			if scenario.ExpectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, scenario.ExpectedError)
+			}
+		})
+	}
+}
+
+func TestSubscriptionService_Diff(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	bundle := brf.NewBundleReaderMock(brf.WithMetadata)(ctrl)
+	bundle.EXPECT().
+		ForEachRuleType(gomock.Any()).
+		DoAndReturn(func(fn func(*v1.RuleType) error) error {
+			for _, rt := range []*v1.RuleType{
+				{Name: "stacklok/unchanged", Def: &v1.RuleType_Definition{InEntity: "repository"}},
+				{Name: "stacklok/updated", Def: &v1.RuleType_Definition{InEntity: "artifact"}},
+				{Name: "stacklok/added", Def: &v1.RuleType_Definition{InEntity: "repository"}},
+			} {
+				if err := fn(rt); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	bundle.EXPECT().ForEachDataSource(gomock.Any()).Return(nil)
+	bundle.EXPECT().
+		GetProfile(gomock.Any()).
+		DoAndReturn(func(name string) (*v1.Profile, error) {
+			if name == "stacklok/removed-profile" {
+				return nil, reader.ErrProfileNotFound
+			}
+			// unnamed rules are named after their rule type when stored
+			return &v1.Profile{
+				Name:       name,
+				Repository: []*v1.Profile_Rule{{Type: "stacklok/unchanged"}},
+			}, nil
+		}).
+		Times(3)
+
+	unchangedProfile := db.Profile{ID: uuid.New(), Name: "stacklok/profile"}
+	updatedProfile := db.Profile{
+		ID:    uuid.New(),
+		Name:  "stacklok/updated-profile",
+		Alert: db.NullActionType{ActionType: db.ActionTypeOff, Valid: true},
+	}
+	storedProfileRows := func(profile db.Profile) []db.GetProfileByProjectAndIDRow {
+		return []db.GetProfileByProjectAndIDRow{{
+			Profile: profile,
+			ProfilesWithEntityProfile: db.ProfilesWithEntityProfile{
+				Entity: db.NullEntities{Entities: db.EntitiesRepository, Valid: true},
+				ContextualRules: pqtype.NullRawMessage{
+					RawMessage: []byte(`[{"type": "stacklok/unchanged", "name": "stacklok/unchanged"}]`),
+					Valid:      true,
+				},
+			},
+		}}
+	}
+
+	querier := getQuerier(ctrl, dbf.NewDBMock(withSuccessfulFindSubscription, func(mock dbf.DBMock) {
+		mock.EXPECT().
+			ListRuleTypesBySubscription(gomock.Any(), gomock.Any()).
+			Return([]db.RuleType{
+				dbRuleType("stacklok/unchanged", "repository"),
+				dbRuleType("stacklok/updated", "repository"),
+				dbRuleType("stacklok/removed", "repository"),
+			}, nil)
+		mock.EXPECT().
+			ListDataSourcesBySubscription(gomock.Any(), gomock.Any()).
+			Return(nil, nil)
+		mock.EXPECT().
+			ListProfilesBySubscription(gomock.Any(), gomock.Any()).
+			Return([]db.Profile{
+				{Name: "stacklok/removed-profile"},
+				unchangedProfile,
+				updatedProfile,
+			}, nil)
+		for _, profile := range []db.Profile{unchangedProfile, updatedProfile} {
+			mock.EXPECT().
+				GetProfileByProjectAndID(gomock.Any(), db.GetProfileByProjectAndIDParams{
+					ProjectID: projectID,
+					ID:        profile.ID,
+				}).
+				Return(storedProfileRows(profile), nil)
+		}
+	}))
+
+	svc := createService(ctrl, nil, nil, nil)
+	diff, err := svc.Diff(ctx, projectID, bundle, querier)
+	require.NoError(t, err)
+	require.Equal(t, []*v1.BundleDiffEntry{
+		{Name: "stacklok/added", Change: v1.BundleDiffEntry_CHANGE_ADDED},
+		{Name: "stacklok/removed", Change: v1.BundleDiffEntry_CHANGE_REMOVED},
+		{Name: "stacklok/unchanged", Change: v1.BundleDiffEntry_CHANGE_UNCHANGED},
+		{Name: "stacklok/updated", Change: v1.BundleDiffEntry_CHANGE_UPDATED},
+	}, diff.GetRuleTypes())
+	require.Equal(t, []*v1.BundleDiffEntry{
+		{Name: "stacklok/profile", Change: v1.BundleDiffEntry_CHANGE_UNCHANGED},
+		{Name: "stacklok/removed-profile", Change: v1.BundleDiffEntry_CHANGE_REMOVED},
+		{Name: "stacklok/updated-profile", Change: v1.BundleDiffEntry_CHANGE_UPDATED},
+	}, diff.GetProfiles())
+	require.Empty(t, diff.GetDataSources())
+}
+
 const (
 	profileName = "my_profile"
 )
@@ -236,6 +386,60 @@ func withFailedBundleUpsert(mock dbf.DBMock) {
 		Return(errDefault)
 }
 
+func withBundleProfiles(mock dbf.DBMock) {
+	mock.EXPECT().
+		ListProfilesBySubscription(gomock.Any(), gomock.Any()).
+		Return([]db.Profile{{ID: uuid.New(), Name: "stacklok/profile"}}, nil)
+	mock.EXPECT().
+		DeleteProfile(gomock.Any(), gomock.Any()).
+		Return(nil)
+}
+
+func withBundleRuleTypes(mock dbf.DBMock) {
+	mock.EXPECT().
+		ListRuleTypesBySubscription(gomock.Any(), gomock.Any()).
+		Return([]db.RuleType{{ID: uuid.New(), Name: "stacklok/rule"}}, nil)
+}
+
+func withProfilesInstantiatingRuleType(profiles []string) func(dbf.DBMock) {
+	return func(mock dbf.DBMock) {
+		mock.EXPECT().
+			ListProfilesInstantiatingRuleType(gomock.Any(), gomock.Any()).
+			Return(profiles, nil)
+	}
+}
+
+func withBundleDataSources(mock dbf.DBMock) {
+	mock.EXPECT().
+		ListDataSourcesBySubscription(gomock.Any(), gomock.Any()).
+		Return([]db.DataSource{{ID: uuid.New(), Name: "stacklok/ds"}}, nil)
+	mock.EXPECT().
+		ListRuleTypesReferencesByDataSource(gomock.Any(), gomock.Any()).
+		Return(nil, nil)
+}
+
+func withDeletedBundleResources(mock dbf.DBMock) {
+	mock.EXPECT().
+		DeleteRuleType(gomock.Any(), gomock.Any()).
+		Return(nil)
+	mock.EXPECT().
+		DeleteDataSource(gomock.Any(), gomock.Any()).
+		Return(db.DataSource{}, nil)
+	mock.EXPECT().
+		DeleteSubscription(gomock.Any(), subscriptionID).
+		Return(nil)
+}
+
+func dbRuleType(name, inEntity string) db.RuleType {
+	return db.RuleType{
+		ID:            uuid.New(),
+		Name:          name,
+		Definition:    []byte(`{"inEntity": "` + inEntity + `"}`),
+		SeverityValue: db.SeverityUnknown,
+		ReleasePhase:  db.ReleaseStatusGa,
+	}
+}
+
 func createService(
 	ctrl *gomock.Controller,
 	profileSetup psf.ProfileSvcMockBuilder,
@@ -0,0 +1,326 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package subscriptions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	datasourceservice "github.com/mindersec/minder/internal/datasources/service"
+	"github.com/mindersec/minder/internal/db"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/mindpak/reader"
+	profsvc "github.com/mindersec/minder/pkg/profiles"
+	"github.com/mindersec/minder/pkg/ruletypes"
+)
+
+// bundleState holds the resources a subscription created in a project,
+// indexed by name, along with the changes an upgrade makes to them
+type bundleState struct {
+	subscription db.Subscription
+	ruleTypes    map[string]db.RuleType
+	profiles     map[string]db.Profile
+	dataSources  map[string]db.DataSource
+	diff         *minderv1.BundleDiff
+}
+
+func (s *subscriptionService) Diff(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundle reader.BundleReader,
+	qtx db.ExtendQuerier,
+) (*minderv1.BundleDiff, error) {
+	state, err := s.diff(ctx, projectID, bundle, qtx)
+	if err != nil {
+		return nil, err
+	}
+	return state.diff, nil
+}
+
+func (s *subscriptionService) Upgrade(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundle reader.BundleReader,
+	qtx db.ExtendQuerier,
+) (*minderv1.BundleDiff, error) {
+	state, err := s.diff(ctx, projectID, bundle, qtx)
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := state.subscription.ID
+
+	// data sources go first, as rule types may depend on them
+	if err := s.upsertBundleDataSources(ctx, qtx, projectID, bundle, subscriptionID); err != nil {
+		return nil, fmt.Errorf("error while upgrading data sources: %w", err)
+	}
+	if err := s.upsertBundleRules(ctx, qtx, projectID, bundle, subscriptionID); err != nil {
+		return nil, fmt.Errorf("error while upgrading rule types: %w", err)
+	}
+
+	// then the profiles, which may stop using the rule types removed from
+	// the bundle
+	for _, entry := range state.diff.GetProfiles() {
+		switch entry.GetChange() {
+		case minderv1.BundleDiffEntry_CHANGE_REMOVED:
+			if err := deleteProfile(ctx, qtx, state.profiles[entry.GetName()]); err != nil {
+				return nil, err
+			}
+		case minderv1.BundleDiffEntry_CHANGE_UPDATED:
+			profile, err := bundle.GetProfile(entry.GetName())
+			if err != nil {
+				return nil, fmt.Errorf("error while retrieving profile from bundle: %w", err)
+			}
+			if _, err := s.profiles.UpdateProfile(ctx, projectID, subscriptionID, profile, qtx); err != nil {
+				return nil, fmt.Errorf("error while upgrading profile %s: %w", entry.GetName(), err)
+			}
+		default:
+		}
+	}
+
+	// finally, the rule types and data sources which are no longer in the bundle
+	for _, entry := range state.diff.GetRuleTypes() {
+		if entry.GetChange() != minderv1.BundleDiffEntry_CHANGE_REMOVED {
+			continue
+		}
+		if err := deleteRuleType(ctx, qtx, state.ruleTypes[entry.GetName()]); err != nil {
+			return nil, err
+		}
+	}
+	for _, entry := range state.diff.GetDataSources() {
+		if entry.GetChange() != minderv1.BundleDiffEntry_CHANGE_REMOVED {
+			continue
+		}
+		if err := deleteDataSource(ctx, qtx, state.dataSources[entry.GetName()]); err != nil {
+			return nil, err
+		}
+	}
+
+	err = qtx.SetSubscriptionVersion(ctx, db.SetSubscriptionVersionParams{
+		ID:             subscriptionID,
+		CurrentVersion: bundle.GetMetadata().Version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while setting subscription version: %w", err)
+	}
+	return state.diff, nil
+}
+
+// diff compares the resources the subscription created in the project with
+// the contents of the bundle. Only the profiles which were added to the
+// project are considered, as profiles are not added by subscribing, and only
+// the ones which differ from the bundle are updated.
+func (s *subscriptionService) diff(
+	ctx context.Context,
+	projectID uuid.UUID,
+	bundle reader.BundleReader,
+	qtx db.ExtendQuerier,
+) (*bundleState, error) {
+	subscription, err := findSubscription(ctx, qtx, projectID, bundleIDOf(bundle))
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := uuid.NullUUID{UUID: subscription.ID, Valid: true}
+
+	state := &bundleState{
+		subscription: subscription,
+		ruleTypes:    make(map[string]db.RuleType),
+		profiles:     make(map[string]db.Profile),
+		dataSources:  make(map[string]db.DataSource),
+		diff:         &minderv1.BundleDiff{},
+	}
+
+	ruleTypes, err := qtx.ListRuleTypesBySubscription(ctx, subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("error while listing rule types: %w", err)
+	}
+	current := make(map[string]*minderv1.RuleType, len(ruleTypes))
+	// the display names of the rule types in the bundle, which name the
+	// unnamed rules of its profiles
+	ruleTypeDisplayNames := make(map[string]string)
+	for _, ruleType := range ruleTypes {
+		pbRuleType, err := ruletypes.RuleTypePBFromDB(&ruleType)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing rule type %s: %w", ruleType.Name, err)
+		}
+		state.ruleTypes[ruleType.Name] = ruleType
+		current[ruleType.Name] = pbRuleType
+	}
+	err = bundle.ForEachRuleType(func(ruleType *minderv1.RuleType) error {
+		ruleTypeDisplayNames[ruleType.GetName()] = normalizeRuleType(ruleType).GetDisplayName()
+		state.diff.RuleTypes = append(state.diff.RuleTypes,
+			diffEntry(ruleType.GetName(), current[ruleType.GetName()], ruleType, normalizeRuleType))
+		delete(current, ruleType.GetName())
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while reading rule types from bundle: %w", err)
+	}
+	state.diff.RuleTypes = appendRemoved(state.diff.RuleTypes, current)
+
+	dataSources, err := qtx.ListDataSourcesBySubscription(ctx, subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("error while listing data sources: %w", err)
+	}
+	currentDS := make(map[string]*minderv1.DataSource, len(dataSources))
+	for _, dataSource := range dataSources {
+		pbDataSource, err := s.dataSources.GetByName(ctx, dataSource.Name, projectID,
+			datasourceservice.ReadBuilder().WithTransaction(qtx))
+		if err != nil {
+			return nil, fmt.Errorf("error while retrieving data source %s: %w", dataSource.Name, err)
+		}
+		state.dataSources[dataSource.Name] = dataSource
+		currentDS[dataSource.Name] = pbDataSource
+	}
+	err = bundle.ForEachDataSource(func(dataSource *minderv1.DataSource) error {
+		state.diff.DataSources = append(state.diff.DataSources,
+			diffEntry(dataSource.GetName(), currentDS[dataSource.GetName()], dataSource, normalizeDataSource))
+		delete(currentDS, dataSource.GetName())
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while reading data sources from bundle: %w", err)
+	}
+	state.diff.DataSources = appendRemoved(state.diff.DataSources, currentDS)
+
+	profiles, err := qtx.ListProfilesBySubscription(ctx, subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("error while listing profiles: %w", err)
+	}
+	normalizeProfile := profileNormalizer(ruleTypeDisplayNames)
+	for _, profile := range profiles {
+		state.profiles[profile.Name] = profile
+		target, err := bundle.GetProfile(profile.Name)
+		if errors.Is(err, reader.ErrProfileNotFound) {
+			state.diff.Profiles = append(state.diff.Profiles, &minderv1.BundleDiffEntry{
+				Name:   profile.Name,
+				Change: minderv1.BundleDiffEntry_CHANGE_REMOVED,
+			})
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("error while retrieving profile from bundle: %w", err)
+		}
+
+		pbProfile, err := getProfilePB(ctx, qtx, projectID, profile)
+		if err != nil {
+			return nil, err
+		}
+		state.diff.Profiles = append(state.diff.Profiles,
+			diffEntry(profile.Name, pbProfile, target, normalizeProfile))
+	}
+
+	for _, entries := range [][]*minderv1.BundleDiffEntry{
+		state.diff.RuleTypes, state.diff.DataSources, state.diff.Profiles,
+	} {
+		slices.SortFunc(entries, func(a, b *minderv1.BundleDiffEntry) int {
+			return strings.Compare(a.GetName(), b.GetName())
+		})
+	}
+	return state, nil
+}
+
+// diffEntry compares the current version of a resource, if any, with the one
+// in the bundle
+func diffEntry[T proto.Message](name string, current, target T, normalize func(T) T) *minderv1.BundleDiffEntry {
+	change := minderv1.BundleDiffEntry_CHANGE_ADDED
+	if current.ProtoReflect().IsValid() {
+		change = minderv1.BundleDiffEntry_CHANGE_UPDATED
+		if proto.Equal(normalize(current), normalize(target)) {
+			change = minderv1.BundleDiffEntry_CHANGE_UNCHANGED
+		}
+	}
+	return &minderv1.BundleDiffEntry{Name: name, Change: change}
+}
+
+// getProfilePB returns the profile as stored, including its rules
+func getProfilePB(
+	ctx context.Context, qtx db.ExtendQuerier, projectID uuid.UUID, profile db.Profile,
+) (*minderv1.Profile, error) {
+	rows, err := qtx.GetProfileByProjectAndID(ctx, db.GetProfileByProjectAndIDParams{
+		ProjectID: projectID,
+		ID:        profile.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while retrieving profile %s: %w", profile.Name, err)
+	}
+	pbProfile, ok := profsvc.MergeDatabaseGetIntoProfiles(rows)[profile.Name]
+	if !ok {
+		return nil, fmt.Errorf("profile %s not found", profile.Name)
+	}
+	return pbProfile, nil
+}
+
+func appendRemoved[T any](entries []*minderv1.BundleDiffEntry, removed map[string]T) []*minderv1.BundleDiffEntry {
+	for name := range removed {
+		entries = append(entries, &minderv1.BundleDiffEntry{
+			Name:   name,
+			Change: minderv1.BundleDiffEntry_CHANGE_REMOVED,
+		})
+	}
+	return entries
+}
+
+// normalizeRuleType strips the fields of a rule type which are set when it
+// is stored, so that it can be compared to the one in the bundle
+func normalizeRuleType(ruleType *minderv1.RuleType) *minderv1.RuleType {
+	ret := proto.Clone(ruleType).(*minderv1.RuleType)
+	ret.Id = nil
+	ret.Context = nil
+	ret.Version = ""
+	ret.Type = ""
+	if ret.DisplayName == "" {
+		ret.DisplayName = ret.Name
+	}
+	ret.Severity = ret.Severity.EnsureDefault()
+	ret.ReleasePhase = *ret.ReleasePhase.EnsureDefault()
+	return ret
+}
+
+// normalizeDataSource strips the fields of a data source which are set when
+// it is stored, so that it can be compared to the one in the bundle
+func normalizeDataSource(dataSource *minderv1.DataSource) *minderv1.DataSource {
+	ret := proto.Clone(dataSource).(*minderv1.DataSource)
+	ret.Id = ""
+	ret.Context = nil
+	ret.Version = ""
+	ret.Type = ""
+	return ret
+}
+
+// profileNormalizer returns a function which strips the fields of a profile
+// which are set when it is stored, and names its unnamed rules the way
+// creating the profile does, so that it can be compared to the one in the
+// bundle
+func profileNormalizer(ruleTypeDisplayNames map[string]string) func(*minderv1.Profile) *minderv1.Profile {
+	return func(profile *minderv1.Profile) *minderv1.Profile {
+		ret := proto.Clone(profile).(*minderv1.Profile)
+		ret.Id = nil
+		ret.Context = nil
+		ret.Version = ""
+		ret.Type = ""
+		ret.Labels = nil
+		if ret.DisplayName == "" {
+			ret.DisplayName = ret.Name
+		}
+		if ret.Remediate == nil {
+			ret.Remediate = proto.String(string(db.ActionTypeOff))
+		}
+		if ret.Alert == nil {
+			ret.Alert = proto.String(string(db.ActionTypeOn))
+		}
+		for _, selector := range ret.Selection {
+			selector.Id = ""
+		}
+		_ = profsvc.TraverseAllRulesForPipeline(ret, func(rule *minderv1.Profile_Rule) error {
+			rule.Name = profsvc.ComputeRuleName(rule, ruleTypeDisplayNames[rule.GetType()])
+			return nil
+		})
+		return ret
+	}
+}
@@ -655,6 +655,63 @@ func (x *SelectorPullRequest) GetProperties() *structpb.Struct {
 	return nil
 }
 
+type SelectorEvaluation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the aggregated status of the latest evaluations of the entity against the
+	// rules of the profile, e.g. success, failure, error, skipped or pending.
+	// Empty if the entity has not been evaluated against the profile yet.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the status of the latest evaluation of each rule of the profile, keyed by
+	// the rule name
+	Rules         map[string]string `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectorEvaluation) Reset() {
+	*x = SelectorEvaluation{}
+	mi := &file_internal_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectorEvaluation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectorEvaluation) ProtoMessage() {}
+
+func (x *SelectorEvaluation) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectorEvaluation.ProtoReflect.Descriptor instead.
+func (*SelectorEvaluation) Descriptor() ([]byte, []int) {
+	return file_internal_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SelectorEvaluation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SelectorEvaluation) GetRules() map[string]string {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
 type SelectorEntity struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// one of repository, pull_request, artifact (see oneof entity)
@@ -667,14 +724,20 @@ type SelectorEntity struct {
 	//	*SelectorEntity_Repository
 	//	*SelectorEntity_Artifact
 	//	*SelectorEntity_PullRequest
-	Entity        isSelectorEntity_Entity `protobuf_oneof:"entity"`
+	Entity isSelectorEntity_Entity `protobuf_oneof:"entity"`
+	// the entity this entity originated from, e.g. the repository a pull request
+	// or an artifact belongs to. Not set if the entity has no parent.
+	OriginatedFrom *SelectorEntity `protobuf:"bytes,7,opt,name=originated_from,json=originatedFrom,proto3" json:"originated_from,omitempty"`
+	// read-only view of the latest evaluation status of the entity against the
+	// profile being evaluated
+	Evaluation    *SelectorEvaluation `protobuf:"bytes,8,opt,name=evaluation,proto3" json:"evaluation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SelectorEntity) Reset() {
 	*x = SelectorEntity{}
-	mi := &file_internal_proto_msgTypes[8]
+	mi := &file_internal_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -686,7 +749,7 @@ func (x *SelectorEntity) String() string {
 func (*SelectorEntity) ProtoMessage() {}
 
 func (x *SelectorEntity) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[8]
+	mi := &file_internal_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -699,7 +762,7 @@ func (x *SelectorEntity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SelectorEntity.ProtoReflect.Descriptor instead.
 func (*SelectorEntity) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{8}
+	return file_internal_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SelectorEntity) GetEntityType() v1.Entity {
@@ -757,6 +820,20 @@ func (x *SelectorEntity) GetPullRequest() *SelectorPullRequest {
 	return nil
 }
 
+func (x *SelectorEntity) GetOriginatedFrom() *SelectorEntity {
+	if x != nil {
+		return x.OriginatedFrom
+	}
+	return nil
+}
+
+func (x *SelectorEntity) GetEvaluation() *SelectorEvaluation {
+	if x != nil {
+		return x.Evaluation
+	}
+	return nil
+}
+
 type isSelectorEntity_Entity interface {
 	isSelectorEntity_Entity()
 }
@@ -789,7 +866,7 @@ type PrDependencies_ContextualDependency struct {
 
 func (x *PrDependencies_ContextualDependency) Reset() {
 	*x = PrDependencies_ContextualDependency{}
-	mi := &file_internal_proto_msgTypes[9]
+	mi := &file_internal_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -801,7 +878,7 @@ func (x *PrDependencies_ContextualDependency) String() string {
 func (*PrDependencies_ContextualDependency) ProtoMessage() {}
 
 func (x *PrDependencies_ContextualDependency) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[9]
+	mi := &file_internal_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -841,7 +918,7 @@ type PrDependencies_ContextualDependency_FilePatch struct {
 
 func (x *PrDependencies_ContextualDependency_FilePatch) Reset() {
 	*x = PrDependencies_ContextualDependency_FilePatch{}
-	mi := &file_internal_proto_msgTypes[10]
+	mi := &file_internal_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -853,7 +930,7 @@ func (x *PrDependencies_ContextualDependency_FilePatch) String() string {
 func (*PrDependencies_ContextualDependency_FilePatch) ProtoMessage() {}
 
 func (x *PrDependencies_ContextualDependency_FilePatch) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[10]
+	mi := &file_internal_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -894,7 +971,7 @@ type PrContents_File struct {
 
 func (x *PrContents_File) Reset() {
 	*x = PrContents_File{}
-	mi := &file_internal_proto_msgTypes[11]
+	mi := &file_internal_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -906,7 +983,7 @@ func (x *PrContents_File) String() string {
 func (*PrContents_File) ProtoMessage() {}
 
 func (x *PrContents_File) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[11]
+	mi := &file_internal_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -955,7 +1032,7 @@ type PrContents_File_Line struct {
 
 func (x *PrContents_File_Line) Reset() {
 	*x = PrContents_File_Line{}
-	mi := &file_internal_proto_msgTypes[12]
+	mi := &file_internal_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -967,7 +1044,7 @@ func (x *PrContents_File_Line) String() string {
 func (*PrContents_File_Line) ProtoMessage() {}
 
 func (x *PrContents_File_Line) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[12]
+	mi := &file_internal_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1076,7 +1153,14 @@ const file_internal_proto_rawDesc = "" +
 	"\bprovider\x18\x03 \x01(\v2\x1a.internal.SelectorProviderR\bprovider\x127\n" +
 	"\n" +
 	"properties\x18\x02 \x01(\v2\x17.google.protobuf.StructR\n" +
-	"properties\"\xd8\x02\n" +
+	"properties\"\xa5\x01\n" +
+	"\x12SelectorEvaluation\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12=\n" +
+	"\x05rules\x18\x02 \x03(\v2'.internal.SelectorEvaluation.RulesEntryR\x05rules\x1a8\n" +
+	"\n" +
+	"RulesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd9\x03\n" +
 	"\x0eSelectorEntity\x122\n" +
 	"\ventity_type\x18\x01 \x01(\x0e2\x11.minder.v1.EntityR\n" +
 	"entityType\x12\x12\n" +
@@ -1086,7 +1170,11 @@ const file_internal_proto_rawDesc = "" +
 	"repository\x18\x04 \x01(\v2\x1c.internal.SelectorRepositoryH\x00R\n" +
 	"repository\x128\n" +
 	"\bartifact\x18\x05 \x01(\v2\x1a.internal.SelectorArtifactH\x00R\bartifact\x12B\n" +
-	"\fpull_request\x18\x06 \x01(\v2\x1d.internal.SelectorPullRequestH\x00R\vpullRequestB\b\n" +
+	"\fpull_request\x18\x06 \x01(\v2\x1d.internal.SelectorPullRequestH\x00R\vpullRequest\x12A\n" +
+	"\x0foriginated_from\x18\a \x01(\v2\x18.internal.SelectorEntityR\x0eoriginatedFrom\x12<\n" +
+	"\n" +
+	"evaluation\x18\b \x01(\v2\x1c.internal.SelectorEvaluationR\n" +
+	"evaluationB\b\n" +
 	"\x06entity*r\n" +
 	"\fDepEcosystem\x12\x1d\n" +
 	"\x19DEP_ECOSYSTEM_UNSPECIFIED\x10\x00\x12\x15\n" +
@@ -1107,7 +1195,7 @@ func file_internal_proto_rawDescGZIP() []byte {
 }
 
 var file_internal_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_internal_proto_goTypes = []any{
 	(DepEcosystem)(0),                                     // 0: internal.DepEcosystem
 	(*Dependency)(nil),                                    // 1: internal.Dependency
@@ -1118,42 +1206,47 @@ var file_internal_proto_goTypes = []any{
 	(*SelectorRepository)(nil),                            // 6: internal.SelectorRepository
 	(*SelectorArtifact)(nil),                              // 7: internal.SelectorArtifact
 	(*SelectorPullRequest)(nil),                           // 8: internal.SelectorPullRequest
-	(*SelectorEntity)(nil),                                // 9: internal.SelectorEntity
-	(*PrDependencies_ContextualDependency)(nil),           // 10: internal.PrDependencies.ContextualDependency
-	(*PrDependencies_ContextualDependency_FilePatch)(nil), // 11: internal.PrDependencies.ContextualDependency.FilePatch
-	(*PrContents_File)(nil),                               // 12: internal.PrContents.File
-	(*PrContents_File_Line)(nil),                          // 13: internal.PrContents.File.Line
-	(*v1.Context)(nil),                                    // 14: minder.v1.Context
-	(*structpb.Struct)(nil),                               // 15: google.protobuf.Struct
-	(v1.Entity)(0),                                        // 16: minder.v1.Entity
+	(*SelectorEvaluation)(nil),                            // 9: internal.SelectorEvaluation
+	(*SelectorEntity)(nil),                                // 10: internal.SelectorEntity
+	(*PrDependencies_ContextualDependency)(nil),           // 11: internal.PrDependencies.ContextualDependency
+	(*PrDependencies_ContextualDependency_FilePatch)(nil), // 12: internal.PrDependencies.ContextualDependency.FilePatch
+	(*PrContents_File)(nil),                               // 13: internal.PrContents.File
+	(*PrContents_File_Line)(nil),                          // 14: internal.PrContents.File.Line
+	nil,                                                   // 15: internal.SelectorEvaluation.RulesEntry
+	(*v1.Context)(nil),                                    // 16: minder.v1.Context
+	(*structpb.Struct)(nil),                               // 17: google.protobuf.Struct
+	(v1.Entity)(0),                                        // 18: minder.v1.Entity
 }
 var file_internal_proto_depIdxs = []int32{
 	0,  // 0: internal.Dependency.ecosystem:type_name -> internal.DepEcosystem
-	14, // 1: internal.PullRequest.context:type_name -> minder.v1.Context
-	15, // 2: internal.PullRequest.properties:type_name -> google.protobuf.Struct
+	16, // 1: internal.PullRequest.context:type_name -> minder.v1.Context
+	17, // 2: internal.PullRequest.properties:type_name -> google.protobuf.Struct
 	2,  // 3: internal.PrDependencies.pr:type_name -> internal.PullRequest
-	10, // 4: internal.PrDependencies.deps:type_name -> internal.PrDependencies.ContextualDependency
+	11, // 4: internal.PrDependencies.deps:type_name -> internal.PrDependencies.ContextualDependency
 	2,  // 5: internal.PrContents.pr:type_name -> internal.PullRequest
-	12, // 6: internal.PrContents.files:type_name -> internal.PrContents.File
+	13, // 6: internal.PrContents.files:type_name -> internal.PrContents.File
 	5,  // 7: internal.SelectorRepository.provider:type_name -> internal.SelectorProvider
-	15, // 8: internal.SelectorRepository.properties:type_name -> google.protobuf.Struct
+	17, // 8: internal.SelectorRepository.properties:type_name -> google.protobuf.Struct
 	5,  // 9: internal.SelectorArtifact.provider:type_name -> internal.SelectorProvider
-	15, // 10: internal.SelectorArtifact.properties:type_name -> google.protobuf.Struct
+	17, // 10: internal.SelectorArtifact.properties:type_name -> google.protobuf.Struct
 	5,  // 11: internal.SelectorPullRequest.provider:type_name -> internal.SelectorProvider
-	15, // 12: internal.SelectorPullRequest.properties:type_name -> google.protobuf.Struct
-	16, // 13: internal.SelectorEntity.entity_type:type_name -> minder.v1.Entity
-	5,  // 14: internal.SelectorEntity.provider:type_name -> internal.SelectorProvider
-	6,  // 15: internal.SelectorEntity.repository:type_name -> internal.SelectorRepository
-	7,  // 16: internal.SelectorEntity.artifact:type_name -> internal.SelectorArtifact
-	8,  // 17: internal.SelectorEntity.pull_request:type_name -> internal.SelectorPullRequest
-	1,  // 18: internal.PrDependencies.ContextualDependency.dep:type_name -> internal.Dependency
-	11, // 19: internal.PrDependencies.ContextualDependency.file:type_name -> internal.PrDependencies.ContextualDependency.FilePatch
-	13, // 20: internal.PrContents.File.patch_lines:type_name -> internal.PrContents.File.Line
-	21, // [21:21] is the sub-list for method output_type
-	21, // [21:21] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	17, // 12: internal.SelectorPullRequest.properties:type_name -> google.protobuf.Struct
+	15, // 13: internal.SelectorEvaluation.rules:type_name -> internal.SelectorEvaluation.RulesEntry
+	18, // 14: internal.SelectorEntity.entity_type:type_name -> minder.v1.Entity
+	5,  // 15: internal.SelectorEntity.provider:type_name -> internal.SelectorProvider
+	6,  // 16: internal.SelectorEntity.repository:type_name -> internal.SelectorRepository
+	7,  // 17: internal.SelectorEntity.artifact:type_name -> internal.SelectorArtifact
+	8,  // 18: internal.SelectorEntity.pull_request:type_name -> internal.SelectorPullRequest
+	10, // 19: internal.SelectorEntity.originated_from:type_name -> internal.SelectorEntity
+	9,  // 20: internal.SelectorEntity.evaluation:type_name -> internal.SelectorEvaluation
+	1,  // 21: internal.PrDependencies.ContextualDependency.dep:type_name -> internal.Dependency
+	12, // 22: internal.PrDependencies.ContextualDependency.file:type_name -> internal.PrDependencies.ContextualDependency.FilePatch
+	14, // 23: internal.PrContents.File.patch_lines:type_name -> internal.PrContents.File.Line
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_internal_proto_init() }
@@ -1162,7 +1255,7 @@ func file_internal_proto_init() {
 		return
 	}
 	file_internal_proto_msgTypes[5].OneofWrappers = []any{}
-	file_internal_proto_msgTypes[8].OneofWrappers = []any{
+	file_internal_proto_msgTypes[9].OneofWrappers = []any{
 		(*SelectorEntity_Repository)(nil),
 		(*SelectorEntity_Artifact)(nil),
 		(*SelectorEntity_PullRequest)(nil),
@@ -1173,7 +1266,7 @@ func file_internal_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_proto_rawDesc), len(file_internal_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   13,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
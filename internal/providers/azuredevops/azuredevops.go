@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package azuredevops provides the Azure DevOps Repos provider implementation
+package azuredevops
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/mindersec/minder/internal/db"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	config "github.com/mindersec/minder/pkg/config/server"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// Class is the string that represents the Azure DevOps provider class
+const Class = "azure-devops"
+
+// DefaultEndpoint is the API endpoint of Azure DevOps Services
+const DefaultEndpoint = "https://dev.azure.com/"
+
+// apiVersion is the version of the Azure DevOps REST API the provider uses
+const apiVersion = "7.1"
+
+// Implements is the list of provider types that the Azure DevOps provider implements
+var Implements = []db.ProviderType{
+	db.ProviderTypeGit,
+	db.ProviderTypeRest,
+	db.ProviderTypeRepoLister,
+}
+
+// AuthorizationFlows is the list of authorization flows that the Azure DevOps provider supports
+var AuthorizationFlows = []db.AuthorizationFlow{
+	db.AuthorizationFlowUserInput,
+	db.AuthorizationFlowOauth2AuthorizationCodeFlow,
+}
+
+// Ensure that the Azure DevOps provider implements the right interfaces
+var _ provifv1.Git = (*azureDevOpsClient)(nil)
+var _ provifv1.REST = (*azureDevOpsClient)(nil)
+var _ provifv1.RepoLister = (*azureDevOpsClient)(nil)
+
+type azureDevOpsClient struct {
+	cred       provifv1.AzureDevOpsCredential
+	cli        *http.Client
+	adocfg     *minderv1.AzureDevOpsProviderConfig
+	baseURL    *url.URL
+	webhookURL string
+	gitConfig  config.GitConfig
+
+	// secret for the service hooks. This is stored in the
+	// structure to allow efficient fetching.
+	currentWebhookSecret string
+}
+
+// New creates a new Azure DevOps provider
+// Note that the webhook URL should already contain the provider class in the path
+func New(
+	cred provifv1.AzureDevOpsCredential,
+	cfg *minderv1.AzureDevOpsProviderConfig,
+	gitConfig config.GitConfig,
+	webhookURL string,
+	currentWebhookSecret string,
+) (*azureDevOpsClient, error) {
+	if cfg.GetOrganization() == "" {
+		return nil, errors.New("organization is required")
+	}
+
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = DefaultEndpoint
+	}
+
+	if webhookURL == "" {
+		return nil, errors.New("webhook URL is required")
+	}
+
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse endpoint: %w", err)
+	}
+
+	return &azureDevOpsClient{
+		cred:   cred,
+		cli:    &http.Client{},
+		adocfg: cfg,
+		// all the APIs the provider uses are scoped to the organization
+		baseURL:              endpoint.JoinPath(url.PathEscape(cfg.GetOrganization()), "/"),
+		webhookURL:           webhookURL,
+		gitConfig:            gitConfig,
+		currentWebhookSecret: currentWebhookSecret,
+	}, nil
+}
+
+type adoConfigWrapper struct {
+	AzureDevOps *minderv1.AzureDevOpsProviderConfig `json:"azure_devops" yaml:"azure_devops" mapstructure:"azure_devops" validate:"required"`
+}
+
+// ParseV1Config parses the raw configuration into an AzureDevOpsProviderConfig
+func ParseV1Config(rawCfg json.RawMessage) (*minderv1.AzureDevOpsProviderConfig, error) {
+	var w adoConfigWrapper
+	if err := provifv1.ParseAndValidate(rawCfg, &w); err != nil {
+		return nil, err
+	}
+
+	// Validate the config according to the protobuf validation rules.
+	if err := w.AzureDevOps.Validate(); err != nil {
+		return nil, fmt.Errorf("error validating Azure DevOps v1 provider config: %w", err)
+	}
+
+	return w.AzureDevOps, nil
+}
+
+// MarshalV1Config marshals and validates the given config
+// so it can safely be stored in the database
+func MarshalV1Config(rawCfg json.RawMessage) (json.RawMessage, error) {
+	var w adoConfigWrapper
+	if err := json.Unmarshal(rawCfg, &w); err != nil {
+		return nil, err
+	}
+
+	if err := w.AzureDevOps.Validate(); err != nil {
+		return nil, fmt.Errorf("error validating provider config: %w", err)
+	}
+
+	return json.Marshal(w)
+}
+
+// CanImplement returns true if the provider can implement the given trait
+func (*azureDevOpsClient) CanImplement(trait minderv1.ProviderType) bool {
+	return trait == minderv1.ProviderType_PROVIDER_TYPE_GIT ||
+		trait == minderv1.ProviderType_PROVIDER_TYPE_REST ||
+		trait == minderv1.ProviderType_PROVIDER_TYPE_REPO_LISTER
+}
+
+// GetCredential returns the credential used by the provider
+func (c *azureDevOpsClient) GetCredential() provifv1.AzureDevOpsCredential {
+	return c.cred
+}
+
+// SupportsEntity implements the Provider interface
+func (*azureDevOpsClient) SupportsEntity(entType minderv1.Entity) bool {
+	return entType == minderv1.Entity_ENTITY_REPOSITORIES ||
+		entType == minderv1.Entity_ENTITY_PULL_REQUESTS
+}
+
+// CreationOptions implements the Provider interface
+func (c *azureDevOpsClient) CreationOptions(entType minderv1.Entity) *provifv1.EntityCreationOptions {
+	if !c.SupportsEntity(entType) {
+		return nil
+	}
+
+	// Repositories need service hook registration and trigger policy evaluation
+	if entType == minderv1.Entity_ENTITY_REPOSITORIES {
+		return &provifv1.EntityCreationOptions{
+			RegisterWithProvider:       true,
+			PublishReconciliationEvent: true,
+		}
+	}
+
+	// Pull requests originate from repositories and don't need registration or events
+	return &provifv1.EntityCreationOptions{
+		RegisterWithProvider:       false,
+		PublishReconciliationEvent: false,
+	}
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// Do implements the REST provider interface
+func (c *azureDevOpsClient) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	req = req.WithContext(ctx)
+	return c.cli.Do(req)
+}
+
+// GetBaseURL implements the REST provider interface. The base URL already
+// contains the organization, e.g. https://dev.azure.com/myorg/
+func (c *azureDevOpsClient) GetBaseURL() string {
+	return c.baseURL.String()
+}
+
+// NewRequest implements the REST provider interface. The API version is
+// added to the query unless the request path already sets it.
+func (c *azureDevOpsClient) NewRequest(method, requestPath string, body any) (*http.Request, error) {
+	u, err := getParsedURL(c.baseURL, requestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf io.ReadWriter
+	if body != nil {
+		buf = &bytes.Buffer{}
+		enc := json.NewEncoder(buf)
+		enc.SetEscapeHTML(false)
+		err := enc.Encode(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequest(method, u.String(), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	req.Header.Set("Accept", "application/json")
+	// TODO: Get User-Agent from constants
+	req.Header.Set("User-Agent", "Minder")
+
+	c.cred.SetAuthorizationHeader(req)
+
+	return req, nil
+}
+
+type genericRESTClient interface {
+	// Do sends an HTTP request and returns an HTTP response
+	Do(ctx context.Context, req *http.Request) (*http.Response, error)
+	NewRequest(method, requestUrl string, body any) (*http.Request, error)
+}
+
+// adoRESTDo sends a request to the Azure DevOps API and decodes the response
+// into out, unless out is nil.
+func adoRESTDo[T any](ctx context.Context, cli genericRESTClient, method, path string, body any, out T) error {
+	// NewRequest already has the base URL configured, the path
+	// will get appended to it.
+	req, err := cli.NewRequest(method, path, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := cli.Do(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to request resource '%s': %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		if resp.StatusCode == http.StatusNotFound {
+			return provifv1.ErrEntityNotFound
+		}
+		return fmt.Errorf("failed to request resource '%s': %s", path, resp.Status)
+	}
+
+	if any(out) == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+// adoRESTGet gets a resource from the Azure DevOps API
+func adoRESTGet[T any](ctx context.Context, cli genericRESTClient, path string, out T) error {
+	return adoRESTDo(ctx, cli, http.MethodGet, path, nil, out)
+}
+
+// listResponse is the envelope Azure DevOps wraps collections in
+type listResponse[T any] struct {
+	Count int `json:"count"`
+	Value []T `json:"value"`
+}
+
+func getParsedURL(base *url.URL, path string) (*url.URL, error) {
+	// Explicitly parse path and query parameters. This is to ensure that
+	// the path is properly escaped and that the query parameters are
+	// properly encoded.
+	parsedPathAndQuery, err := url.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse path: %w", err)
+	}
+
+	u := base.JoinPath(parsedPathAndQuery.EscapedPath())
+
+	query := parsedPathAndQuery.Query()
+	if !query.Has("api-version") {
+		query.Set("api-version", apiVersion)
+	}
+	u.RawQuery = query.Encode()
+	u.Fragment = parsedPathAndQuery.Fragment
+
+	return u, nil
+}
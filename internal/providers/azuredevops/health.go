@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+var _ provifv1.HealthChecker = (*azureDevOpsClient)(nil)
+
+// connectionData is the subset of the connection data of an organization
+// the health check needs
+type connectionData struct {
+	AuthenticatedUser struct {
+		ID       string `json:"id"`
+		IsActive bool   `json:"isActive"`
+	} `json:"authenticatedUser"`
+}
+
+// CheckHealth checks that the credential of the provider is accepted by the
+// organization by fetching the connection data of the authenticated user.
+func (c *azureDevOpsClient) CheckHealth(ctx context.Context) (*provifv1.HealthReport, error) {
+	data := &connectionData{}
+	if err := adoRESTGet(ctx, c, "_apis/connectionData?api-version=7.1-preview.1", data); err != nil {
+		return nil, fmt.Errorf("error fetching connection data: %w", err)
+	}
+	if data.AuthenticatedUser.ID == "" {
+		return nil, errors.New("credential was not accepted by the organization")
+	}
+
+	report := &provifv1.HealthReport{}
+	if !data.AuthenticatedUser.IsActive {
+		report.Warnings = append(report.Warnings, "authenticated user is not active")
+	}
+	return report, nil
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/microsoft"
+
+	"github.com/mindersec/minder/internal/db"
+	m "github.com/mindersec/minder/internal/providers/manager"
+	provv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	// defaultTenant accepts the work and school accounts of any tenant
+	defaultTenant = "organizations"
+	// azureDevOpsScope is the scope of the Azure DevOps API. The resource ID
+	// is the same for every organization.
+	azureDevOpsScope = "499b84ac-1321-427f-aa17-267ca6975798/.default"
+	// offlineAccessScope is needed to get a refresh token
+	offlineAccessScope = "offline_access"
+)
+
+// NewOAuthConfig implements the providerClassOAuthManager interface
+func (g *providerClassManager) NewOAuthConfig(_ db.ProviderClass, cli bool) (*oauth2.Config, error) {
+	oauthClientConfig := &g.adocfg.OAuthClientConfig
+
+	tenant := g.adocfg.TenantID
+	if tenant == "" {
+		tenant = defaultTenant
+	}
+
+	scopes := g.adocfg.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{azureDevOpsScope, offlineAccessScope}
+	}
+
+	oauthConfig := getOauthConfig(oauthClientConfig.RedirectURI, cli, tenant, scopes)
+
+	clientId, err := oauthClientConfig.GetClientID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client ID: %w", err)
+	}
+
+	clientSecret, err := oauthClientConfig.GetClientSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client secret: %w", err)
+	}
+
+	// this is currently only used for testing
+	if oauthClientConfig.Endpoint != nil && oauthClientConfig.Endpoint.TokenURL != "" {
+		oauthConfig.Endpoint = oauth2.Endpoint{
+			TokenURL: oauthClientConfig.Endpoint.TokenURL,
+		}
+	}
+
+	oauthConfig.ClientID = clientId
+	oauthConfig.ClientSecret = clientSecret
+	return oauthConfig, nil
+}
+
+// ValidateCredentials implements the providerClassOAuthManager interface.
+// The credential is either an OAuth2 token from the authorization code flow,
+// or a personal access token entered by the user.
+func (*providerClassManager) ValidateCredentials(
+	_ context.Context, cred provv1.Credential, _ *m.CredentialVerifyParams,
+) error {
+	switch c := cred.(type) {
+	case provv1.OAuth2TokenCredential:
+		if _, err := c.GetAsOAuth2TokenSource().Token(); err != nil {
+			return fmt.Errorf("cannot get token from credential: %w", err)
+		}
+	case string:
+		// personal access tokens can be scoped to a single organization, so
+		// they can only be verified once the provider is used
+		if c == "" {
+			return errors.New("empty personal access token")
+		}
+	default:
+		return fmt.Errorf("invalid credential type: %T", cred)
+	}
+
+	return nil
+}
+
+func getOauthConfig(redirectUrlBase string, cli bool, tenant string, scopes []string) *oauth2.Config {
+	var redirectUrl string
+
+	if cli {
+		redirectUrl = fmt.Sprintf("%s/cli", redirectUrlBase)
+	} else {
+		redirectUrl = fmt.Sprintf("%s/web", redirectUrlBase)
+	}
+
+	return &oauth2.Config{
+		RedirectURL: redirectUrl,
+		Scopes:      scopes,
+		Endpoint:    microsoft.AzureADEndpoint(tenant),
+	}
+}
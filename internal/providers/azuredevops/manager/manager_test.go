@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/oauth2"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	v1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+func Test_tokenNeedsRefresh(t *testing.T) {
+	t.Parallel()
+
+	baseTime := time.Now()
+
+	tests := []struct {
+		name  string
+		token oauth2.Token
+		want  bool
+	}{
+		{
+			name:  "token is expired",
+			token: accessTokenWithExpiration(baseTime.Add(-1 * time.Minute)),
+			want:  true,
+		},
+		{
+			name:  "token is not expired and does not need refresh",
+			token: accessTokenWithExpiration(baseTime.Add(15 * time.Minute)),
+			want:  false,
+		},
+		{
+			name:  "token is not expired but needs refresh",
+			token: accessTokenWithExpiration(baseTime.Add(5 * time.Minute)),
+			want:  true,
+		},
+		{
+			name: "token is not valid",
+			token: oauth2.Token{
+				AccessToken: "",
+				Expiry:      baseTime.Add(15 * time.Minute),
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			needsRefresh := tokenNeedsRefresh(tt.token)
+			assert.Equal(t, tt.want, needsRefresh)
+		})
+	}
+}
+
+func accessTokenWithExpiration(exp time.Time) oauth2.Token {
+	return oauth2.Token{
+		AccessToken: "ozz-likes-beer",
+		Expiry:      exp,
+	}
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	prov := &db.Provider{
+		ID:        uuid.New(),
+		Name:      "azure-devops",
+		ProjectID: uuid.New(),
+		Class:     db.ProviderClassAzureDevops,
+		Version:   v1.V1,
+	}
+	repo := db.EntityInstance{ID: uuid.New(), EntityType: db.EntitiesRepository}
+
+	tests := []struct {
+		name    string
+		setup   func(store *mockdb.MockStore)
+		wantErr bool
+	}{
+		{
+			name: "no registered entities",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GetEntitiesByProvider(gomock.Any(), prov.ID).Return(nil, nil)
+			},
+		},
+		{
+			name: "no credentials",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GetEntitiesByProvider(gomock.Any(), prov.ID).
+					Return([]db.EntityInstance{repo}, nil)
+				store.EXPECT().GetAccessTokenByProjectID(gomock.Any(), gomock.Any()).
+					Return(db.ProviderAccessToken{}, sql.ErrNoRows)
+			},
+		},
+		{
+			name: "credential lookup fails",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GetEntitiesByProvider(gomock.Any(), prov.ID).
+					Return([]db.EntityInstance{repo}, nil)
+				store.EXPECT().GetAccessTokenByProjectID(gomock.Any(), gomock.Any()).
+					Return(db.ProviderAccessToken{}, errors.New("connection refused"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			tt.setup(store)
+
+			m := &providerClassManager{store: store}
+			err := m.Delete(context.Background(), prov)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
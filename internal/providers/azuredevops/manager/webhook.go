@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/providers/azuredevops"
+	"github.com/mindersec/minder/internal/providers/gitlab/webhooksecret"
+)
+
+const (
+	// MaxBytesLimit is the maximum number of bytes to read from the request body
+	// We limit to 1MB to prevent abuse
+	MaxBytesLimit int64 = 1 << 20
+)
+
+// serviceHookEvent is the envelope of the events sent by Azure DevOps service hooks
+type serviceHookEvent struct {
+	SubscriptionID string          `json:"subscriptionId"`
+	EventType      string          `json:"eventType"`
+	Resource       json.RawMessage `json:"resource"`
+}
+
+// GetWebhookHandler implements the ProviderManager interface
+// Note that this is where the whole webhook handler is defined and
+// will live.
+func (m *providerClassManager) GetWebhookHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		l := zerolog.Ctx(m.parentContext).With().
+			Str("webhook", "azure-devops").
+			Str("method", r.Method).
+			Str("path", r.URL.Path).
+			Str("remote", r.RemoteAddr).
+			Str("user-agent", r.UserAgent()).
+			Str("content-type", r.Header.Get("Content-Type")).
+			Logger()
+
+		// Validate the service hook credentials
+		if err := m.validateRequest(r); err != nil {
+			l.Error().Err(err).Msg("invalid webhook request")
+			http.Error(w, "invalid webhook request", http.StatusUnauthorized)
+			return
+		}
+
+		var event serviceHookEvent
+		if err := decodeJSONSafe(r.Body, &event); err != nil {
+			l.Error().Err(err).Msg("error decoding service hook event")
+			http.Error(w, "error decoding service hook event", http.StatusBadRequest)
+			return
+		}
+
+		if event.EventType == "" {
+			l.Error().Msg("missing event type")
+			http.Error(w, "missing event type", http.StatusBadRequest)
+			return
+		}
+
+		l = l.With().
+			Str("event", event.EventType).
+			Str("subscription", event.SubscriptionID).
+			Logger()
+
+		disp := m.getWebhookEventDispatcher(event.EventType)
+
+		if err := disp(l, event.Resource); err != nil {
+			l.Error().Err(err).Msg("error handling webhook event")
+			http.Error(w, "error handling webhook event", http.StatusInternalServerError)
+			return
+		}
+
+		l.Debug().Msg("processed webhook event successfully")
+	})
+}
+
+// getWebhookEventDispatcher returns the appropriate webhook event dispatcher for the given event type
+// It returns a function that is meant to do the actual handling of the event's resource.
+func (m *providerClassManager) getWebhookEventDispatcher(
+	eventType string,
+) func(l zerolog.Logger, resource json.RawMessage) error {
+	switch eventType {
+	case azuredevops.EventTypePush:
+		return m.handleRepoPush
+	case azuredevops.EventTypePullRequestCreated,
+		azuredevops.EventTypePullRequestUpdated,
+		azuredevops.EventTypePullRequestMerged:
+		return m.handlePullRequest
+	default:
+		return m.handleNoop
+	}
+}
+
+// handleNoop is a no-op handler for unhandled webhook events
+func (*providerClassManager) handleNoop(l zerolog.Logger, _ json.RawMessage) error {
+	l.Debug().Msg("unhandled webhook event")
+	return nil
+}
+
+func (m *providerClassManager) validateRequest(r *http.Request) error {
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		return errors.New("missing basic authentication")
+	}
+
+	if username != azuredevops.WebhookUsername {
+		return errors.New("invalid basic authentication username")
+	}
+
+	if err := m.validateToken(password, r); err != nil {
+		return fmt.Errorf("invalid basic authentication password: %w", err)
+	}
+
+	return nil
+}
+
+// validateToken validates the incoming service hook password
+// Validation takes the secret from the configuration and
+// appends the last element of the path to the URL (which is unique per entity)
+func (m *providerClassManager) validateToken(token string, req *http.Request) error {
+	// Extract the unique ID from the URL path
+	path := req.URL.Path
+	uniq := path[strings.LastIndex(path, "/")+1:]
+
+	// uniq must be a valid UUID
+	_, err := uuid.Parse(uniq)
+	if err != nil {
+		return errors.New("invalid unique ID")
+	}
+
+	// Generate the expected secret
+	if valid := webhooksecret.Verify(m.currentWebhookSecret, uniq, token); valid {
+		// If the secret is valid, we can return
+		return nil
+	}
+
+	// Check the previous secrets
+	for _, prev := range m.previousWebhookSecrets {
+		if valid := webhooksecret.Verify(prev, uniq, token); valid {
+			return nil
+		}
+	}
+
+	return errors.New("invalid webhook token")
+}
+
+func decodeJSONSafe[T any](r io.ReadCloser, v *T) error {
+	rs := wrapSafe(r)
+	defer r.Close()
+
+	dec := json.NewDecoder(rs)
+	return dec.Decode(v)
+}
+
+// wrapSafe wraps the io.Reader in a LimitReader to prevent abuse
+func wrapSafe(r io.Reader) io.Reader {
+	return io.LimitReader(r, MaxBytesLimit)
+}
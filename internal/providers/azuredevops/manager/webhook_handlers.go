@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	entmsg "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/providers/azuredevops"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+// Pull request statuses
+const (
+	pullRequestStatusActive    = "active"
+	pullRequestStatusAbandoned = "abandoned"
+	pullRequestStatusCompleted = "completed"
+)
+
+// repositoryRef is the reference to a repository in the service hook events
+type repositoryRef struct {
+	ID string `json:"id"`
+}
+
+// pushResource is the resource of a git.push event
+type pushResource struct {
+	Repository repositoryRef `json:"repository"`
+}
+
+// pullRequestResource is the resource of the git.pullrequest.* events
+type pullRequestResource struct {
+	PullRequestID int           `json:"pullRequestId"`
+	Status        string        `json:"status"`
+	Repository    repositoryRef `json:"repository"`
+}
+
+func (m *providerClassManager) handleRepoPush(l zerolog.Logger, resource json.RawMessage) error {
+	l.Debug().Msg("handling push event")
+
+	var push pushResource
+	if err := json.Unmarshal(resource, &push); err != nil {
+		l.Error().Err(err).Msg("error decoding push event")
+		return fmt.Errorf("error decoding push event: %w", err)
+	}
+
+	if push.Repository.ID == "" {
+		l.Error().Msg("push event missing repository ID")
+		return errors.New("push event missing repository ID")
+	}
+
+	// Form identifying properties
+	identifyingProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: push.Repository.ID,
+	})
+
+	// Form message to publish
+	outm := entmsg.NewEntityRefreshAndDoMessage()
+	outm.WithEntity(minderv1.Entity_ENTITY_REPOSITORIES, identifyingProps)
+	outm.WithProviderClassHint(azuredevops.Class)
+
+	return m.publish(l, outm, constants.TopicQueueRefreshEntityAndEvaluate)
+}
+
+func (m *providerClassManager) handlePullRequest(l zerolog.Logger, resource json.RawMessage) error {
+	l.Debug().Msg("handling pull request event")
+
+	var pr pullRequestResource
+	if err := json.Unmarshal(resource, &pr); err != nil {
+		l.Error().Err(err).Msg("error decoding pull request event")
+		return fmt.Errorf("error decoding pull request event: %w", err)
+	}
+
+	if pr.PullRequestID == 0 {
+		return errors.New("pull request event missing ID")
+	}
+
+	if pr.Repository.ID == "" {
+		return errors.New("pull request event missing repository ID")
+	}
+
+	// The status tells apart the pull requests which are still open from
+	// the ones which were closed by the event
+	var queueTopic string
+	switch pr.Status {
+	case pullRequestStatusActive:
+		queueTopic = constants.TopicQueueOriginatingEntityAdd
+	case pullRequestStatusAbandoned, pullRequestStatusCompleted:
+		queueTopic = constants.TopicQueueOriginatingEntityDelete
+	default:
+		l.Debug().Str("status", pr.Status).Msg("unhandled pull request status")
+		return nil
+	}
+
+	// Form identifying properties
+	identifyingProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: azuredevops.FormatPullRequestUpstreamID(pr.PullRequestID),
+		azuredevops.PullRequestRepoID: pr.Repository.ID,
+	})
+
+	repoIdentifyingProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: pr.Repository.ID,
+	})
+
+	// Form message to publish
+	outm := entmsg.NewEntityRefreshAndDoMessage()
+	outm.WithEntity(minderv1.Entity_ENTITY_PULL_REQUESTS, identifyingProps)
+	outm.WithOriginator(minderv1.Entity_ENTITY_REPOSITORIES, repoIdentifyingProps)
+	outm.WithProviderClassHint(azuredevops.Class)
+
+	return m.publish(l, outm, queueTopic)
+}
+
+func (m *providerClassManager) publish(
+	l zerolog.Logger, outm *entmsg.HandleEntityAndDoMessage, queueTopic string,
+) error {
+	// Convert message for publishing
+	msgID := uuid.New().String()
+	msg := message.NewMessage(msgID, nil)
+	if err := outm.ToMessage(msg); err != nil {
+		l.Error().Err(err).Msg("error converting message to protobuf")
+		return fmt.Errorf("error converting message to protobuf: %w", err)
+	}
+
+	// Publish message
+	l.Debug().Str("msg_id", msgID).Str("topic", queueTopic).Msg("publishing message")
+	if err := m.pub.Publish(queueTopic, msg); err != nil {
+		l.Error().Err(err).Msg("error publishing message")
+		return fmt.Errorf("error publishing message: %w", err)
+	}
+
+	return nil
+}
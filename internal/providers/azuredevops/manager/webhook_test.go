@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	entmsg "github.com/mindersec/minder/internal/entities/handlers/message"
+	stubeventer "github.com/mindersec/minder/internal/events/stubs"
+	"github.com/mindersec/minder/internal/providers/azuredevops"
+	"github.com/mindersec/minder/internal/providers/gitlab/webhooksecret"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+const (
+	testSecret   = "test-secret"
+	testRepoID   = "5febef5a-833d-4e14-b9c0-14cb638f91e6"
+	pushEvent    = `{"subscriptionId":"sub-1","eventType":"git.push","resource":{"repository":{"id":"` + testRepoID + `"}}}`
+	prEventShape = `{"subscriptionId":"sub-1","eventType":"%s","resource":{"pullRequestId":7,"status":"%s","repository":{"id":"` + testRepoID + `"}}}`
+)
+
+func prEvent(eventType, status string) string {
+	return fmt.Sprintf(prEventShape, eventType, status)
+}
+
+func TestGetWebhookHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		body           string
+		username       string
+		password       func(hookID string) string
+		wantStatus     int
+		wantTopic      string
+		wantEntityType minderv1.Entity
+	}{
+		{
+			name:           "push refreshes the repository",
+			body:           pushEvent,
+			wantStatus:     http.StatusOK,
+			wantTopic:      constants.TopicQueueRefreshEntityAndEvaluate,
+			wantEntityType: minderv1.Entity_ENTITY_REPOSITORIES,
+		},
+		{
+			name:           "created pull request is added",
+			body:           prEvent(azuredevops.EventTypePullRequestCreated, "active"),
+			wantStatus:     http.StatusOK,
+			wantTopic:      constants.TopicQueueOriginatingEntityAdd,
+			wantEntityType: minderv1.Entity_ENTITY_PULL_REQUESTS,
+		},
+		{
+			name:           "completed pull request is deleted",
+			body:           prEvent(azuredevops.EventTypePullRequestMerged, "completed"),
+			wantStatus:     http.StatusOK,
+			wantTopic:      constants.TopicQueueOriginatingEntityDelete,
+			wantEntityType: minderv1.Entity_ENTITY_PULL_REQUESTS,
+		},
+		{
+			name:           "abandoned pull request is deleted",
+			body:           prEvent(azuredevops.EventTypePullRequestUpdated, "abandoned"),
+			wantStatus:     http.StatusOK,
+			wantTopic:      constants.TopicQueueOriginatingEntityDelete,
+			wantEntityType: minderv1.Entity_ENTITY_PULL_REQUESTS,
+		},
+		{
+			name:       "unhandled event is ignored",
+			body:       `{"subscriptionId":"sub-1","eventType":"workitem.created","resource":{}}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "wrong username is rejected",
+			body:       pushEvent,
+			username:   "someone",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "wrong password is rejected",
+			body: pushEvent,
+			password: func(_ string) string {
+				return "not-the-secret"
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "missing event type is rejected",
+			body:       `{"subscriptionId":"sub-1","resource":{}}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "push without repository fails",
+			body:       `{"subscriptionId":"sub-1","eventType":"git.push","resource":{}}`,
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pub := &stubeventer.StubEventer{}
+			m := &providerClassManager{
+				parentContext:        context.Background(),
+				pub:                  pub,
+				currentWebhookSecret: testSecret,
+			}
+
+			hookID := uuid.New().String()
+			username := azuredevops.WebhookUsername
+			if tt.username != "" {
+				username = tt.username
+			}
+			password, err := webhooksecret.New(testSecret, hookID)
+			require.NoError(t, err)
+			if tt.password != nil {
+				password = tt.password(hookID)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/azure-devops/"+hookID,
+				strings.NewReader(tt.body))
+			req.SetBasicAuth(username, password)
+			rec := httptest.NewRecorder()
+
+			m.GetWebhookHandler().ServeHTTP(rec, req)
+			require.Equal(t, tt.wantStatus, rec.Code)
+
+			if tt.wantTopic == "" {
+				require.Empty(t, pub.Sent)
+				return
+			}
+
+			require.Equal(t, []string{tt.wantTopic}, pub.Topics)
+			require.Len(t, pub.Sent, 1)
+
+			msg, err := entmsg.ToEntityRefreshAndDo(pub.Sent[0])
+			require.NoError(t, err)
+			require.Equal(t, tt.wantEntityType, msg.Entity.Type)
+			require.Equal(t, azuredevops.Class, msg.Hint.ProviderClassHint)
+
+			if tt.wantEntityType == minderv1.Entity_ENTITY_REPOSITORIES {
+				require.Equal(t, testRepoID, msg.Entity.GetByProps[properties.PropertyUpstreamID])
+				return
+			}
+
+			require.Equal(t, "7", msg.Entity.GetByProps[properties.PropertyUpstreamID])
+			require.Equal(t, testRepoID, msg.Entity.GetByProps[azuredevops.PullRequestRepoID])
+			require.Equal(t, minderv1.Entity_ENTITY_REPOSITORIES, msg.Originator.Type)
+			require.Equal(t, testRepoID, msg.Originator.GetByProps[properties.PropertyUpstreamID])
+		})
+	}
+}
+
+func TestValidateTokenPreviousSecret(t *testing.T) {
+	t.Parallel()
+
+	m := &providerClassManager{
+		currentWebhookSecret:   "new-secret",
+		previousWebhookSecrets: []string{testSecret},
+	}
+
+	hookID := uuid.New().String()
+	password, err := webhooksecret.New(testSecret, hookID)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/webhook/azure-devops/"+hookID, nil)
+	require.NoError(t, m.validateToken(password, req))
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v1/webhook/azure-devops/not-a-uuid", nil)
+	require.Error(t, m.validateToken(password, req))
+}
@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+// Repository Properties
+const (
+	// RepoPropertyOrganization represents the azure devops organization
+	RepoPropertyOrganization = "azuredevops/organization"
+	// RepoPropertyProjectID represents the ID of the azure devops project
+	RepoPropertyProjectID = "azuredevops/project_id"
+	// RepoPropertyProjectName represents the name of the azure devops project
+	RepoPropertyProjectName = "azuredevops/project_name"
+	// RepoPropertyRepoName represents the azure devops repository name
+	RepoPropertyRepoName = "azuredevops/repo_name"
+	// RepoPropertyDefaultBranch represents the azure devops default branch
+	RepoPropertyDefaultBranch = "azuredevops/default_branch"
+	// RepoPropertyCloneURL represents the azure devops repo clone URL
+	RepoPropertyCloneURL = "azuredevops/clone_url"
+	// RepoPropertyWebURL represents the URL of the repository in the azure devops web UI
+	RepoPropertyWebURL = "azuredevops/web_url"
+	// RepoPropertySubscriptionIDs represents the comma separated IDs of the
+	// service hook subscriptions minder created for the repository
+	RepoPropertySubscriptionIDs = "azuredevops/subscription_ids"
+)
+
+// Pull Request Properties
+const (
+	// PullRequestID represents the azure devops pull request ID
+	PullRequestID = "azuredevops/pull_request_id"
+	// PullRequestRepoID represents the ID of the azure devops repository of the pull request
+	PullRequestRepoID = "azuredevops/repo_id"
+	// PullRequestStatus represents the status of the azure devops pull request
+	PullRequestStatus = "azuredevops/status"
+	// PullRequestAuthor represents the unique name of the author of the pull request
+	PullRequestAuthor = "azuredevops/author"
+)
+
+// FetchAllProperties implements the provider interface
+func (c *azureDevOpsClient) FetchAllProperties(
+	ctx context.Context, getByProps *properties.Properties, entType minderv1.Entity, _ *properties.Properties,
+) (*properties.Properties, error) {
+	if !c.SupportsEntity(entType) {
+		return nil, fmt.Errorf("entity type %s not supported", entType)
+	}
+
+	//nolint:exhaustive // We only support a subset of entity types for now.
+	switch entType {
+	case minderv1.Entity_ENTITY_REPOSITORIES:
+		return c.getPropertiesForRepo(ctx, getByProps)
+	case minderv1.Entity_ENTITY_PULL_REQUESTS:
+		return c.getPropertiesForPullRequest(ctx, getByProps)
+	default:
+		return nil, fmt.Errorf("entity type %s not supported", entType)
+	}
+}
+
+// FetchProperty implements the provider interface
+func (c *azureDevOpsClient) FetchProperty(
+	ctx context.Context, getByProps *properties.Properties, entType minderv1.Entity, key string,
+) (*properties.Property, error) {
+	props, err := c.FetchAllProperties(ctx, getByProps, entType, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return props.GetProperty(key), nil
+}
+
+// GetEntityName implements the provider interface
+func (c *azureDevOpsClient) GetEntityName(entityType minderv1.Entity, props *properties.Properties) (string, error) {
+	if props == nil {
+		return "", errors.New("properties are nil")
+	}
+
+	if !c.SupportsEntity(entityType) {
+		return "", fmt.Errorf("entity type %s not supported", entityType)
+	}
+
+	//nolint:exhaustive // We only support a subset of entity types for now.
+	switch entityType {
+	case minderv1.Entity_ENTITY_REPOSITORIES:
+		return getRepoNameFromProperties(props)
+	case minderv1.Entity_ENTITY_PULL_REQUESTS:
+		return getPullRequestNameFromProperties(props)
+	default:
+		return "", fmt.Errorf("entity type %s not supported", entityType)
+	}
+}
+
+// PropertiesToProtoMessage implements the ProtoMessageConverter interface
+func (c *azureDevOpsClient) PropertiesToProtoMessage(
+	entType minderv1.Entity, props *properties.Properties,
+) (protoreflect.ProtoMessage, error) {
+	if !c.SupportsEntity(entType) {
+		return nil, fmt.Errorf("entity type %s is not supported by the azure devops provider", entType)
+	}
+
+	//nolint:exhaustive // We only support a subset of entity types for now.
+	switch entType {
+	case minderv1.Entity_ENTITY_REPOSITORIES:
+		return repoV1FromProperties(props)
+	case minderv1.Entity_ENTITY_PULL_REQUESTS:
+		return pullRequestV1FromProperties(props)
+	default:
+		return nil, fmt.Errorf("entity type %s not supported", entType)
+	}
+}
+
+func getStringProp(props *properties.Properties, key string) (string, error) {
+	value, err := props.GetProperty(key).AsString()
+	if err != nil {
+		return "", fmt.Errorf("property %s not found or not a string", key)
+	}
+
+	return value, nil
+}
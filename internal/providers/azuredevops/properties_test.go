@@ -0,0 +1,205 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevops
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const testRepository = `{
+  "id": "5febef5a-833d-4e14-b9c0-14cb638f91e6",
+  "name": "service",
+  "defaultBranch": "refs/heads/main",
+  "remoteUrl": "https://myorg@dev.azure.com/myorg/proj/_git/service",
+  "webUrl": "https://dev.azure.com/myorg/proj/_git/service",
+  "isDisabled": false,
+  "isFork": false,
+  "project": {
+    "id": "6ce954b1-ce1f-45d1-b94d-e6bf2464ba2c",
+    "name": "proj",
+    "visibility": "private"
+  }
+}`
+
+const testPullRequest = `{
+  "pullRequestId": 42,
+  "status": "active",
+  "sourceRefName": "refs/heads/feature",
+  "targetRefName": "refs/heads/main",
+  "createdBy": {"uniqueName": "dev@example.com"},
+  "lastMergeSourceCommit": {"commitId": "abc123"},
+  "repository": {"id": "5febef5a-833d-4e14-b9c0-14cb638f91e6", "name": "service"},
+  "forkSource": {
+    "repository": {"remoteUrl": "https://myorg@dev.azure.com/myorg/proj/_git/service-fork"}
+  }
+}`
+
+// staticHandler serves fixed JSON bodies by request path
+func staticHandler(t *testing.T, bodies map[string]string) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, ok := bodies[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(body))
+		assert.NoError(t, err)
+	}
+}
+
+func TestFetchAllPropertiesRepository(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		props   *properties.Properties
+		wantErr error
+	}{
+		{
+			name: "by upstream ID",
+			props: properties.NewProperties(map[string]any{
+				properties.PropertyUpstreamID: testRepoID,
+			}),
+		},
+		{
+			name: "by name",
+			props: properties.NewProperties(map[string]any{
+				properties.PropertyName: "proj/service",
+			}),
+		},
+		{
+			name: "not found",
+			props: properties.NewProperties(map[string]any{
+				properties.PropertyName: "proj/missing",
+			}),
+			wantErr: provifv1.ErrEntityNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mocksrv := httptest.NewServer(staticHandler(t, map[string]string{
+				"/myorg/_apis/git/repositories/" + testRepoID: testRepository,
+				"/myorg/proj/_apis/git/repositories/service":  testRepository,
+			}))
+			defer mocksrv.Close()
+
+			c := newTestClient(t, mocksrv)
+
+			got, err := c.FetchAllProperties(context.Background(), tt.props, minderv1.Entity_ENTITY_REPOSITORIES, nil)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, testRepoID, got.GetProperty(properties.PropertyUpstreamID).GetString())
+			assert.Equal(t, "proj/service", got.GetProperty(properties.PropertyName).GetString())
+			assert.True(t, got.GetProperty(properties.RepoPropertyIsPrivate).GetBool())
+			assert.False(t, got.GetProperty(properties.RepoPropertyIsArchived).GetBool())
+			assert.Equal(t, "main", got.GetProperty(RepoPropertyDefaultBranch).GetString())
+			assert.Equal(t, testProjectID, got.GetProperty(RepoPropertyProjectID).GetString())
+			assert.Equal(t, testOrg, got.GetProperty(RepoPropertyOrganization).GetString())
+
+			name, err := c.GetEntityName(minderv1.Entity_ENTITY_REPOSITORIES, got)
+			require.NoError(t, err)
+			assert.Equal(t, "proj/service", name)
+
+			msg, err := c.PropertiesToProtoMessage(minderv1.Entity_ENTITY_REPOSITORIES, got)
+			require.NoError(t, err)
+			repo, ok := msg.(*minderv1.Repository)
+			require.True(t, ok)
+			assert.Equal(t, "proj", repo.GetOwner())
+			assert.Equal(t, "service", repo.GetName())
+			assert.Equal(t, "main", repo.GetDefaultBranch())
+		})
+	}
+}
+
+func TestFetchAllPropertiesPullRequest(t *testing.T) {
+	t.Parallel()
+
+	mocksrv := httptest.NewServer(staticHandler(t, map[string]string{
+		"/myorg/_apis/git/repositories/" + testRepoID:                      testRepository,
+		"/myorg/_apis/git/repositories/" + testRepoID + "/pullrequests/42": testPullRequest,
+	}))
+	defer mocksrv.Close()
+
+	c := newTestClient(t, mocksrv)
+
+	got, err := c.FetchAllProperties(context.Background(), properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: "42",
+		PullRequestRepoID:             testRepoID,
+	}), minderv1.Entity_ENTITY_PULL_REQUESTS, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "abc123", got.GetProperty(properties.PullRequestCommitSHA).GetString())
+	assert.Equal(t, "main", got.GetProperty(properties.PullRequestBaseBranch).GetString())
+	assert.Equal(t, "feature", got.GetProperty(properties.PullRequestTargetBranch).GetString())
+	assert.Equal(t, "https://myorg@dev.azure.com/myorg/proj/_git/service",
+		got.GetProperty(properties.PullRequestBaseCloneURL).GetString())
+	assert.Equal(t, "https://myorg@dev.azure.com/myorg/proj/_git/service-fork",
+		got.GetProperty(properties.PullRequestTargetCloneURL).GetString())
+	assert.Equal(t, "https://dev.azure.com/myorg/proj/_git/service/pullrequest/42",
+		got.GetProperty(properties.PullRequestUpstreamURL).GetString())
+	assert.Equal(t, "dev@example.com", got.GetProperty(PullRequestAuthor).GetString())
+
+	name, err := c.GetEntityName(minderv1.Entity_ENTITY_PULL_REQUESTS, got)
+	require.NoError(t, err)
+	assert.Equal(t, "proj/service/42", name)
+
+	msg, err := c.PropertiesToProtoMessage(minderv1.Entity_ENTITY_PULL_REQUESTS, got)
+	require.NoError(t, err)
+	pr, ok := msg.(*pbinternal.PullRequest)
+	require.True(t, ok)
+	assert.Equal(t, int64(42), pr.GetNumber())
+	assert.Equal(t, "proj", pr.GetRepoOwner())
+	assert.Equal(t, "service", pr.GetRepoName())
+}
+
+func TestListAllRepositories(t *testing.T) {
+	t.Parallel()
+
+	mocksrv := httptest.NewServer(staticHandler(t, map[string]string{
+		"/myorg/_apis/git/repositories": `{"count": 1, "value": [` + testRepository + `]}`,
+	}))
+	defer mocksrv.Close()
+
+	c := newTestClient(t, mocksrv)
+
+	repos, err := c.ListAllRepositories(context.Background())
+	require.NoError(t, err)
+	require.Len(t, repos, 1)
+	assert.Equal(t, "service", repos[0].GetName())
+	assert.Equal(t, "proj", repos[0].GetOwner())
+}
+
+func TestParseV1Config(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := ParseV1Config([]byte(`{"azure_devops": {"organization": "myorg"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "myorg", cfg.GetOrganization())
+
+	_, err = ParseV1Config([]byte(`{"azure_devops": {}}`))
+	require.Error(t, err)
+
+	_, err = ParseV1Config([]byte(`{}`))
+	require.Error(t, err)
+}
@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	pbinternal "github.com/mindersec/minder/internal/proto"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// FormatPullRequestUpstreamID returns the upstream ID for an azure devops pull request.
+// Pull request IDs are unique within an organization.
+func FormatPullRequestUpstreamID(id int) string {
+	return strconv.Itoa(id)
+}
+
+// gitPullRequest is the subset of the Azure DevOps GitPullRequest resource
+// the provider uses
+type gitPullRequest struct {
+	PullRequestID int    `json:"pullRequestId"`
+	Status        string `json:"status"`
+	SourceRefName string `json:"sourceRefName"`
+	TargetRefName string `json:"targetRefName"`
+	CreatedBy     struct {
+		UniqueName string `json:"uniqueName"`
+	} `json:"createdBy"`
+	LastMergeSourceCommit *struct {
+		CommitID string `json:"commitId"`
+	} `json:"lastMergeSourceCommit"`
+	Repository gitRepository `json:"repository"`
+	ForkSource *struct {
+		Repository gitRepository `json:"repository"`
+	} `json:"forkSource"`
+}
+
+func (c *azureDevOpsClient) getPropertiesForPullRequest(
+	ctx context.Context, getByProps *properties.Properties,
+) (*properties.Properties, error) {
+	uid, err := getByProps.GetProperty(properties.PropertyUpstreamID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("upstream ID not found or invalid: %w", err)
+	}
+
+	repoID, err := getByProps.GetProperty(PullRequestRepoID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("repository ID not found or invalid: %w", err)
+	}
+
+	prPath, err := url.JoinPath("_apis/git/repositories", url.PathEscape(repoID), "pullrequests", url.PathEscape(uid))
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for pull request: %w", err)
+	}
+
+	pr := &gitPullRequest{}
+	if err := adoRESTGet(ctx, c, prPath, pr); err != nil {
+		return nil, err
+	}
+
+	// Validate - pull request upstream ID must match the one we requested
+	if res := FormatPullRequestUpstreamID(pr.PullRequestID); res != uid {
+		return nil, fmt.Errorf("pull request ID mismatch: %s != %s", res, uid)
+	}
+
+	// the repository embedded in the pull request lacks some of the fields we need
+	repoPath, err := url.JoinPath("_apis/git/repositories", url.PathEscape(repoID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for repository: %w", err)
+	}
+
+	repo := &gitRepository{}
+	if err := adoRESTGet(ctx, c, repoPath, repo); err != nil {
+		return nil, fmt.Errorf("failed to get repository: %w", err)
+	}
+
+	return c.gitPullRequestToProperties(pr, repo), nil
+}
+
+func (c *azureDevOpsClient) gitPullRequestToProperties(pr *gitPullRequest, repo *gitRepository) *properties.Properties {
+	// pull requests from forks come from another repository
+	sourceCloneURL := repo.RemoteURL
+	if pr.ForkSource != nil && pr.ForkSource.Repository.RemoteURL != "" {
+		sourceCloneURL = pr.ForkSource.Repository.RemoteURL
+	}
+
+	var commitSHA string
+	if pr.LastMergeSourceCommit != nil {
+		commitSHA = pr.LastMergeSourceCommit.CommitID
+	}
+
+	prID := FormatPullRequestUpstreamID(pr.PullRequestID)
+
+	return properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID:           prID,
+		properties.PropertyName:                 formatPullRequestName(repo.Project.Name, repo.Name, prID),
+		properties.PullRequestCommitSHA:         commitSHA,
+		properties.PullRequestBaseCloneURL:      repo.RemoteURL,
+		properties.PullRequestBaseBranch:        strings.TrimPrefix(pr.TargetRefName, branchRefPrefix),
+		properties.PullRequestBaseDefaultBranch: strings.TrimPrefix(repo.DefaultBranch, branchRefPrefix),
+		properties.PullRequestTargetCloneURL:    sourceCloneURL,
+		properties.PullRequestTargetBranch:      strings.TrimPrefix(pr.SourceRefName, branchRefPrefix),
+		properties.PullRequestUpstreamURL:       repo.WebURL + "/pullrequest/" + prID,
+		RepoPropertyOrganization:                c.adocfg.GetOrganization(),
+		RepoPropertyProjectName:                 repo.Project.Name,
+		RepoPropertyRepoName:                    repo.Name,
+		PullRequestID:                           int64(pr.PullRequestID),
+		PullRequestRepoID:                       repo.ID,
+		PullRequestStatus:                       pr.Status,
+		PullRequestAuthor:                       pr.CreatedBy.UniqueName,
+	})
+}
+
+func pullRequestV1FromProperties(prProps *properties.Properties) (*pbinternal.PullRequest, error) {
+	_, err := prProps.GetProperty(properties.PropertyUpstreamID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upstream ID: %w", err)
+	}
+
+	id := prProps.GetProperty(PullRequestID).GetInt64()
+	if id == 0 {
+		return nil, fmt.Errorf("failed to get pull request ID: %w", provifv1.ErrEntityNotFound)
+	}
+
+	project, err := getStringProp(prProps, RepoPropertyProjectName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project name: %w", err)
+	}
+
+	repoName, err := getStringProp(prProps, RepoPropertyRepoName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository name: %w", err)
+	}
+
+	commitSha, err := getStringProp(prProps, properties.PullRequestCommitSHA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit SHA: %w", err)
+	}
+
+	prURL, err := getStringProp(prProps, properties.PullRequestUpstreamURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request URL: %w", err)
+	}
+
+	// Azure DevOps identities are UUIDs, so there is no numeric author ID
+	// to set. The author is available in the azuredevops/author property.
+	pbPR := &pbinternal.PullRequest{
+		Number:         id,
+		RepoOwner:      project,
+		RepoName:       repoName,
+		CommitSha:      commitSha,
+		Url:            prURL,
+		BaseCloneUrl:   prProps.GetProperty(properties.PullRequestBaseCloneURL).GetString(),
+		TargetCloneUrl: prProps.GetProperty(properties.PullRequestTargetCloneURL).GetString(),
+		BaseRef:        prProps.GetProperty(properties.PullRequestBaseBranch).GetString(),
+		TargetRef:      prProps.GetProperty(properties.PullRequestTargetBranch).GetString(),
+		Properties:     prProps.ToProtoStruct(),
+	}
+
+	return pbPR, nil
+}
+
+func getPullRequestNameFromProperties(props *properties.Properties) (string, error) {
+	project, err := getStringProp(props, RepoPropertyProjectName)
+	if err != nil {
+		return "", err
+	}
+
+	repoName, err := getStringProp(props, RepoPropertyRepoName)
+	if err != nil {
+		return "", err
+	}
+
+	prID := props.GetProperty(properties.PropertyUpstreamID).GetString()
+	if prID == "" {
+		return "", fmt.Errorf("property %s not found or not a string", properties.PropertyUpstreamID)
+	}
+
+	return formatPullRequestName(project, repoName, prID), nil
+}
+
+func formatPullRequestName(project, repoName, prID string) string {
+	return fmt.Sprintf("%s/%s/%s", project, repoName, prID)
+}
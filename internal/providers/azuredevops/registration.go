@@ -0,0 +1,241 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/providers/gitlab/webhooksecret"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// Service hook event types minder subscribes to
+const (
+	// EventTypePush is sent when code is pushed to a repository
+	EventTypePush = "git.push"
+	// EventTypePullRequestCreated is sent when a pull request is created
+	EventTypePullRequestCreated = "git.pullrequest.created"
+	// EventTypePullRequestUpdated is sent when a pull request is updated,
+	// including when it is abandoned or completed
+	EventTypePullRequestUpdated = "git.pullrequest.updated"
+	// EventTypePullRequestMerged is sent when a merge of a pull request is attempted
+	EventTypePullRequestMerged = "git.pullrequest.merged"
+)
+
+// WebhookUsername is the basic authentication username of the service hook
+// requests. The password is derived from the webhook secret.
+const WebhookUsername = "minder"
+
+const (
+	// tfsPublisherID is the service hook publisher of the Azure Repos events
+	tfsPublisherID = "tfs"
+	// webHooksConsumerID is the service hook consumer sending HTTP requests
+	webHooksConsumerID = "webHooks"
+	// httpRequestActionID is the action of the web hooks consumer posting the event
+	httpRequestActionID = "httpRequest"
+
+	subscriptionsPath = "_apis/hooks/subscriptions"
+)
+
+// subscribedEventTypes are the event types a service hook subscription is
+// created for when a repository is registered
+var subscribedEventTypes = []string{
+	EventTypePush,
+	EventTypePullRequestCreated,
+	EventTypePullRequestUpdated,
+	EventTypePullRequestMerged,
+}
+
+// subscription is the subset of the Azure DevOps service hook Subscription
+// resource the provider uses
+type subscription struct {
+	ID               string            `json:"id,omitempty"`
+	PublisherID      string            `json:"publisherId"`
+	EventType        string            `json:"eventType"`
+	ResourceVersion  string            `json:"resourceVersion"`
+	ConsumerID       string            `json:"consumerId"`
+	ConsumerActionID string            `json:"consumerActionId"`
+	PublisherInputs  map[string]string `json:"publisherInputs"`
+	ConsumerInputs   map[string]string `json:"consumerInputs"`
+}
+
+// RegisterEntity implements the Provider interface
+func (c *azureDevOpsClient) RegisterEntity(
+	ctx context.Context, entType minderv1.Entity, props *properties.Properties,
+) (*properties.Properties, error) {
+	if !c.SupportsEntity(entType) {
+		return nil, provifv1.ErrUnsupportedEntity
+	}
+
+	if entType != minderv1.Entity_ENTITY_REPOSITORIES {
+		// We only explicitly register repositories
+		// Pull requests are handled via origination
+		return props, nil
+	}
+
+	upstreamID := props.GetProperty(properties.PropertyUpstreamID).GetString()
+	if upstreamID == "" {
+		return nil, errors.New("missing upstream ID")
+	}
+
+	projectID := props.GetProperty(RepoPropertyProjectID).GetString()
+	if projectID == "" {
+		return nil, errors.New("missing project ID")
+	}
+
+	if err := c.cleanUpStaleSubscriptions(ctx, upstreamID); err != nil {
+		// This is a non-fatal error and may be transient. We log it and
+		// continue with the registration.
+		zerolog.Ctx(ctx).Error().
+			Str("upstreamID", upstreamID).
+			Str("provider-class", Class).
+			Err(err).Msg("failed to clean up stale service hook subscriptions")
+	}
+
+	subprops, err := c.createSubscriptions(ctx, projectID, upstreamID)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().
+			Str("upstreamID", upstreamID).
+			Str("provider-class", Class).
+			Err(err).Msg("failed to create service hook subscriptions")
+		return nil, errors.New("failed to create service hook subscriptions")
+	}
+
+	return props.Merge(subprops), nil
+}
+
+// DeregisterEntity implements the Provider interface
+func (c *azureDevOpsClient) DeregisterEntity(
+	ctx context.Context, entType minderv1.Entity, props *properties.Properties,
+) error {
+	if !c.SupportsEntity(entType) {
+		return errors.New("unsupported entity type")
+	}
+
+	if entType != minderv1.Entity_ENTITY_REPOSITORIES {
+		return nil
+	}
+
+	subIDs := props.GetProperty(RepoPropertySubscriptionIDs).GetString()
+	if subIDs == "" {
+		return errors.New("missing subscription IDs")
+	}
+
+	for _, subID := range strings.Split(subIDs, ",") {
+		if err := c.deleteSubscription(ctx, subID); err != nil {
+			// There is already enough context in the error message
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createSubscriptions creates a service hook subscription for each event type
+// minder handles. Azure DevOps requires a subscription per event type.
+func (c *azureDevOpsClient) createSubscriptions(
+	ctx context.Context, projectID, repoID string,
+) (*properties.Properties, error) {
+	hookUUID := uuid.New()
+	webhookUniqueURL, err := url.JoinPath(c.webhookURL, hookUUID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for webhook: %w", err)
+	}
+
+	sec, err := webhooksecret.New(c.currentWebhookSecret, hookUUID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook secret: %w", err)
+	}
+
+	subIDs := make([]string, 0, len(subscribedEventTypes))
+	for _, eventType := range subscribedEventTypes {
+		sub := &subscription{
+			PublisherID:      tfsPublisherID,
+			EventType:        eventType,
+			ResourceVersion:  "1.0",
+			ConsumerID:       webHooksConsumerID,
+			ConsumerActionID: httpRequestActionID,
+			PublisherInputs: map[string]string{
+				"projectId":  projectID,
+				"repository": repoID,
+			},
+			ConsumerInputs: map[string]string{
+				"url":                   webhookUniqueURL,
+				"basicAuthUsername":     WebhookUsername,
+				"basicAuthPassword":     sec,
+				"resourceDetailsToSend": "all",
+			},
+		}
+
+		created := &subscription{}
+		if err := adoRESTDo(ctx, c, http.MethodPost, subscriptionsPath, sub, created); err != nil {
+			c.rollbackSubscriptions(ctx, subIDs)
+			return nil, fmt.Errorf("failed to create subscription for %s: %w", eventType, err)
+		}
+
+		subIDs = append(subIDs, created.ID)
+	}
+
+	return properties.NewProperties(map[string]any{
+		// stored as a comma separated string, as properties don't support
+		// reading back lists
+		RepoPropertySubscriptionIDs: strings.Join(subIDs, ","),
+	}), nil
+}
+
+// rollbackSubscriptions deletes the subscriptions created for a registration
+// which failed half way
+func (c *azureDevOpsClient) rollbackSubscriptions(ctx context.Context, subIDs []string) {
+	for _, subID := range subIDs {
+		if err := c.deleteSubscription(ctx, subID); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("failed to roll back service hook subscription")
+		}
+	}
+}
+
+func (c *azureDevOpsClient) deleteSubscription(ctx context.Context, subID string) error {
+	deletePath, err := url.JoinPath(subscriptionsPath, url.PathEscape(subID))
+	if err != nil {
+		return fmt.Errorf("failed to join URL path for subscription: %w", err)
+	}
+
+	err = adoRESTDo[any](ctx, c, http.MethodDelete, deletePath, nil, nil)
+	if err != nil && !errors.Is(err, provifv1.ErrEntityNotFound) {
+		return fmt.Errorf("failed to delete subscription: %w", err)
+	}
+
+	return nil
+}
+
+// cleanUpStaleSubscriptions deletes the subscriptions minder created for the
+// repository in a previous registration
+func (c *azureDevOpsClient) cleanUpStaleSubscriptions(ctx context.Context, repoID string) error {
+	subs := &listResponse[*subscription]{}
+	if err := adoRESTGet(ctx, c, subscriptionsPath+"?publisherId="+tfsPublisherID, subs); err != nil {
+		return fmt.Errorf("failed to get subscriptions: %w", err)
+	}
+
+	for _, sub := range subs.Value {
+		if sub.PublisherInputs["repository"] != repoID ||
+			!strings.HasPrefix(sub.ConsumerInputs["url"], c.webhookURL) {
+			continue
+		}
+
+		if err := c.deleteSubscription(ctx, sub.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
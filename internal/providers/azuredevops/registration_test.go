@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/providers/credentials"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	testhelper "github.com/mindersec/minder/pkg/providers/v1/testing"
+)
+
+const (
+	testOrg       = "myorg"
+	testRepoID    = "5febef5a-833d-4e14-b9c0-14cb638f91e6"
+	testProjectID = "6ce954b1-ce1f-45d1-b94d-e6bf2464ba2c"
+	testHookURL   = "https://minder.example.com/api/v1/webhook/azure-devops"
+)
+
+func TestRegistration(t *testing.T) {
+	t.Parallel()
+	// We don't need a full constructor here, so we're naughty
+	c := &azureDevOpsClient{}
+	testhelper.CheckRegistrationExcept(t, c, minderv1.Entity_ENTITY_REPOSITORIES)
+}
+
+// newTestClient returns a client talking to the given mock server
+func newTestClient(t *testing.T, srv *httptest.Server) *azureDevOpsClient {
+	t.Helper()
+
+	c, err := New(
+		credentials.NewAzureDevOpsPATCredential("test-token"),
+		&minderv1.AzureDevOpsProviderConfig{
+			Endpoint:     srv.URL,
+			Organization: testOrg,
+		},
+		server.GitConfig{},
+		testHookURL,
+		"test-secret",
+	)
+	require.NoError(t, err)
+	c.cli = srv.Client()
+
+	return c
+}
+
+// subscriptionServer fakes the service hook subscriptions API. Creating a
+// subscription fails once failAfter subscriptions were created, if set.
+type subscriptionServer struct {
+	mu        sync.Mutex
+	existing  []*subscription
+	created   []*subscription
+	deleted   []string
+	failAfter int
+}
+
+func (s *subscriptionServer) handler(t *testing.T) http.HandlerFunc {
+	t.Helper()
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		// requests must be authenticated and versioned
+		_, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "test-token", pass)
+		assert.Equal(t, apiVersion, r.URL.Query().Get("api-version"))
+
+		prefix := "/" + testOrg + "/" + subscriptionsPath
+		switch {
+		case r.URL.Path == prefix && r.Method == http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			assert.NoError(t, json.NewEncoder(w).Encode(listResponse[*subscription]{
+				Count: len(s.existing),
+				Value: s.existing,
+			}))
+		case r.URL.Path == prefix && r.Method == http.MethodPost:
+			if s.failAfter > 0 && len(s.created) >= s.failAfter {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			sub := &subscription{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(sub))
+			sub.ID = "sub-" + sub.EventType
+			s.created = append(s.created, sub)
+			w.Header().Set("Content-Type", "application/json")
+			assert.NoError(t, json.NewEncoder(w).Encode(sub))
+		case strings.HasPrefix(r.URL.Path, prefix+"/") && r.Method == http.MethodDelete:
+			s.deleted = append(s.deleted, strings.TrimPrefix(r.URL.Path, prefix+"/"))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func TestRegisterEntity(t *testing.T) {
+	t.Parallel()
+
+	repoProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: testRepoID,
+		RepoPropertyProjectID:         testProjectID,
+	})
+
+	tests := []struct {
+		name        string
+		entityType  minderv1.Entity
+		props       *properties.Properties
+		srv         *subscriptionServer
+		wantErr     bool
+		wantSubs    string
+		wantDeleted []string
+	}{
+		{
+			name:       "creates a subscription per event type",
+			entityType: minderv1.Entity_ENTITY_REPOSITORIES,
+			props:      repoProps,
+			srv:        &subscriptionServer{},
+			wantSubs:   "sub-git.push,sub-git.pullrequest.created,sub-git.pullrequest.updated,sub-git.pullrequest.merged",
+		},
+		{
+			name:       "cleans up the stale subscriptions of the repository",
+			entityType: minderv1.Entity_ENTITY_REPOSITORIES,
+			props:      repoProps,
+			srv: &subscriptionServer{
+				existing: []*subscription{
+					{
+						ID:              "stale",
+						PublisherInputs: map[string]string{"repository": testRepoID},
+						ConsumerInputs:  map[string]string{"url": testHookURL + "/old"},
+					},
+					{
+						ID:              "other-repo",
+						PublisherInputs: map[string]string{"repository": "other"},
+						ConsumerInputs:  map[string]string{"url": testHookURL + "/old"},
+					},
+					{
+						ID:              "not-minder",
+						PublisherInputs: map[string]string{"repository": testRepoID},
+						ConsumerInputs:  map[string]string{"url": "https://elsewhere.example.com"},
+					},
+				},
+			},
+			wantSubs:    "sub-git.push,sub-git.pullrequest.created,sub-git.pullrequest.updated,sub-git.pullrequest.merged",
+			wantDeleted: []string{"stale"},
+		},
+		{
+			name:        "rolls back on failure",
+			entityType:  minderv1.Entity_ENTITY_REPOSITORIES,
+			props:       repoProps,
+			srv:         &subscriptionServer{failAfter: 2},
+			wantErr:     true,
+			wantDeleted: []string{"sub-git.push", "sub-git.pullrequest.created"},
+		},
+		{
+			name:       "missing project ID",
+			entityType: minderv1.Entity_ENTITY_REPOSITORIES,
+			props: properties.NewProperties(map[string]any{
+				properties.PropertyUpstreamID: testRepoID,
+			}),
+			srv:     &subscriptionServer{},
+			wantErr: true,
+		},
+		{
+			name:       "pull requests are not registered",
+			entityType: minderv1.Entity_ENTITY_PULL_REQUESTS,
+			props:      properties.NewProperties(map[string]any{}),
+			srv:        &subscriptionServer{},
+		},
+		{
+			name:       "unsupported entity type",
+			entityType: minderv1.Entity_ENTITY_ARTIFACTS,
+			props:      repoProps,
+			srv:        &subscriptionServer{},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mocksrv := httptest.NewServer(tt.srv.handler(t))
+			defer mocksrv.Close()
+
+			c := newTestClient(t, mocksrv)
+			ctx := zerolog.New(zerolog.NewTestWriter(t)).WithContext(context.Background())
+
+			got, err := c.RegisterEntity(ctx, tt.entityType, tt.props)
+			assert.Equal(t, tt.wantDeleted, tt.srv.deleted)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			if tt.wantSubs == "" {
+				require.Empty(t, tt.srv.created)
+				return
+			}
+
+			require.Equal(t, tt.wantSubs, got.GetProperty(RepoPropertySubscriptionIDs).GetString())
+
+			// all the subscriptions share the unique URL and secret
+			require.Len(t, tt.srv.created, len(subscribedEventTypes))
+			first := tt.srv.created[0]
+			require.True(t, strings.HasPrefix(first.ConsumerInputs["url"], testHookURL+"/"))
+			require.Equal(t, WebhookUsername, first.ConsumerInputs["basicAuthUsername"])
+			require.NotEmpty(t, first.ConsumerInputs["basicAuthPassword"])
+			require.Equal(t, testProjectID, first.PublisherInputs["projectId"])
+			require.Equal(t, testRepoID, first.PublisherInputs["repository"])
+			for _, sub := range tt.srv.created[1:] {
+				require.Equal(t, first.ConsumerInputs, sub.ConsumerInputs)
+			}
+		})
+	}
+}
+
+func TestDeregisterEntity(t *testing.T) {
+	t.Parallel()
+
+	srv := &subscriptionServer{}
+	mocksrv := httptest.NewServer(srv.handler(t))
+	defer mocksrv.Close()
+
+	c := newTestClient(t, mocksrv)
+
+	err := c.DeregisterEntity(context.Background(), minderv1.Entity_ENTITY_REPOSITORIES,
+		properties.NewProperties(map[string]any{
+			RepoPropertySubscriptionIDs: "sub-1,sub-2",
+		}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"sub-1", "sub-2"}, srv.deleted)
+
+	err = c.DeregisterEntity(context.Background(), minderv1.Entity_ENTITY_REPOSITORIES,
+		properties.NewProperties(map[string]any{}))
+	require.Error(t, err)
+}
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// ListAllRepositories implements the RepoLister interface. It lists the
+// repositories of all the projects of the organization.
+func (c *azureDevOpsClient) ListAllRepositories(ctx context.Context) ([]*minderv1.Repository, error) {
+	resp := &listResponse[*gitRepository]{}
+	if err := adoRESTGet(ctx, c, "_apis/git/repositories", resp); err != nil {
+		return nil, fmt.Errorf("failed to get repositories: %w", err)
+	}
+
+	repos := make([]*minderv1.Repository, 0, len(resp.Value))
+	for _, r := range resp.Value {
+		outRep, err := repoV1FromProperties(c.gitRepositoryToProperties(r))
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert properties to repository: %w", err)
+		}
+
+		repos = append(repos, outRep)
+	}
+
+	zerolog.Ctx(ctx).Debug().Int("num_repos", len(repos)).Msg("found repositories in azure devops provider")
+
+	return repos, nil
+}
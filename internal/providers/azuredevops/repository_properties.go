@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+const (
+	// branchRefPrefix is the prefix of the branch names in git refs,
+	// which Azure DevOps uses for branch names in its API
+	branchRefPrefix = "refs/heads/"
+	// publicVisibility is the visibility of public Azure DevOps projects
+	publicVisibility = "public"
+)
+
+// gitRepository is the subset of the Azure DevOps GitRepository resource
+// the provider uses
+type gitRepository struct {
+	ID            string               `json:"id"`
+	Name          string               `json:"name"`
+	DefaultBranch string               `json:"defaultBranch"`
+	RemoteURL     string               `json:"remoteUrl"`
+	WebURL        string               `json:"webUrl"`
+	IsDisabled    bool                 `json:"isDisabled"`
+	IsFork        bool                 `json:"isFork"`
+	Project       teamProjectReference `json:"project"`
+}
+
+// teamProjectReference is the subset of the Azure DevOps TeamProjectReference
+// resource the provider uses
+type teamProjectReference struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Visibility string `json:"visibility"`
+}
+
+func (c *azureDevOpsClient) getPropertiesForRepo(
+	ctx context.Context, getByProps *properties.Properties,
+) (*properties.Properties, error) {
+	repoPath, err := repositoryPathFromProperties(getByProps)
+	if err != nil {
+		return nil, err
+	}
+
+	repo := &gitRepository{}
+	if err := adoRESTGet(ctx, c, repoPath, repo); err != nil {
+		return nil, err
+	}
+
+	return getByProps.Merge(c.gitRepositoryToProperties(repo)), nil
+}
+
+// repositoryPathFromProperties returns the API path of a repository. The
+// repositories are looked up by their ID, or by the project and repository
+// names if the upstream ID isn't known (e.g. when registering by name).
+func repositoryPathFromProperties(props *properties.Properties) (string, error) {
+	upstreamID := props.GetProperty(properties.PropertyUpstreamID).GetString()
+	if _, err := uuid.Parse(upstreamID); err == nil {
+		return url.JoinPath("_apis/git/repositories", upstreamID)
+	}
+
+	name := props.GetProperty(properties.PropertyName).GetString()
+	project, repoName, ok := strings.Cut(name, "/")
+	if !ok || project == "" || repoName == "" || strings.Contains(repoName, "/") {
+		return "", errors.New("missing required properties, either upstream ID or name in the form project/repository")
+	}
+
+	return url.JoinPath(url.PathEscape(project), "_apis/git/repositories", url.PathEscape(repoName))
+}
+
+func (c *azureDevOpsClient) gitRepositoryToProperties(repo *gitRepository) *properties.Properties {
+	return properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID:     repo.ID,
+		properties.PropertyName:           formatRepoName(repo.Project.Name, repo.Name),
+		properties.RepoPropertyIsPrivate:  repo.Project.Visibility != publicVisibility,
+		properties.RepoPropertyIsArchived: repo.IsDisabled,
+		properties.RepoPropertyIsFork:     repo.IsFork,
+		RepoPropertyOrganization:          c.adocfg.GetOrganization(),
+		RepoPropertyProjectID:             repo.Project.ID,
+		RepoPropertyProjectName:           repo.Project.Name,
+		RepoPropertyRepoName:              repo.Name,
+		RepoPropertyDefaultBranch:         strings.TrimPrefix(repo.DefaultBranch, branchRefPrefix),
+		RepoPropertyCloneURL:              repo.RemoteURL,
+		RepoPropertyWebURL:                repo.WebURL,
+	})
+}
+
+func repoV1FromProperties(repoProperties *properties.Properties) (*minderv1.Repository, error) {
+	name, err := getStringProp(repoProperties, RepoPropertyRepoName)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching repo name property: %w", err)
+	}
+
+	project, err := getStringProp(repoProperties, RepoPropertyProjectName)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching project name property: %w", err)
+	}
+
+	isPrivate, err := repoProperties.GetProperty(properties.RepoPropertyIsPrivate).AsBool()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching is_private property: %w", err)
+	}
+
+	isFork, err := repoProperties.GetProperty(properties.RepoPropertyIsFork).AsBool()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching is_fork property: %w", err)
+	}
+
+	// Azure DevOps repositories are identified by a UUID, so there is
+	// no numeric repository ID to set.
+	pbRepo := &minderv1.Repository{
+		Name:          name,
+		Owner:         project,
+		CloneUrl:      repoProperties.GetProperty(RepoPropertyCloneURL).GetString(),
+		IsPrivate:     isPrivate,
+		IsFork:        isFork,
+		DefaultBranch: repoProperties.GetProperty(RepoPropertyDefaultBranch).GetString(),
+		Properties:    repoProperties.ToProtoStruct(),
+	}
+
+	return pbRepo, nil
+}
+
+func getRepoNameFromProperties(props *properties.Properties) (string, error) {
+	project, err := getStringProp(props, RepoPropertyProjectName)
+	if err != nil {
+		return "", err
+	}
+
+	repoName, err := getStringProp(props, RepoPropertyRepoName)
+	if err != nil {
+		return "", err
+	}
+
+	return formatRepoName(project, repoName), nil
+}
+
+func formatRepoName(project, repoName string) string {
+	return project + "/" + repoName
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package credentials
+
+import (
+	"net/http"
+
+	"github.com/go-git/go-git/v5"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// AzureDevOpsPATCredential is a credential that uses an Azure DevOps
+// personal access token. Azure DevOps expects personal access tokens
+// as the password of basic authentication.
+type AzureDevOpsPATCredential struct {
+	token string
+}
+
+// Ensure that the AzureDevOpsPATCredential implements the AzureDevOpsCredential interface
+var _ provifv1.AzureDevOpsCredential = (*AzureDevOpsPATCredential)(nil)
+
+// NewAzureDevOpsPATCredential creates a new AzureDevOpsPATCredential from the token
+func NewAzureDevOpsPATCredential(token string) *AzureDevOpsPATCredential {
+	return &AzureDevOpsPATCredential{
+		token: token,
+	}
+}
+
+// SetAuthorizationHeader sets the authorization header on the request
+func (t *AzureDevOpsPATCredential) SetAuthorizationHeader(req *http.Request) {
+	// the username is ignored, but the separator is required
+	req.SetBasicAuth("", t.token)
+}
+
+// AddToPushOptions adds the credential to the git push options
+func (t *AzureDevOpsPATCredential) AddToPushOptions(options *git.PushOptions, owner string) {
+	options.Auth = &githttp.BasicAuth{
+		Username: owner,
+		Password: t.token,
+	}
+}
+
+// AddToCloneOptions adds the credential to the git clone options
+func (t *AzureDevOpsPATCredential) AddToCloneOptions(options *git.CloneOptions) {
+	options.Auth = &githttp.BasicAuth{
+		// the username can be anything, but it can't be empty
+		Username: "minder-user",
+		Password: t.token,
+	}
+}
+
+// GetCacheKey returns the cache key used to look up the REST client
+func (t *AzureDevOpsPATCredential) GetCacheKey() string {
+	return t.token
+}
+
+// GetCredential implements the DirectCredential interface
+func (t *AzureDevOpsPATCredential) GetCredential() string {
+	return t.token
+}
+
+// AzureDevOpsOAuthCredential is a credential that uses a Microsoft Entra ID
+// OAuth access token, which Azure DevOps expects as a bearer token.
+type AzureDevOpsOAuthCredential struct {
+	token string
+}
+
+// Ensure that the AzureDevOpsOAuthCredential implements the AzureDevOpsCredential interface
+var _ provifv1.AzureDevOpsCredential = (*AzureDevOpsOAuthCredential)(nil)
+
+// NewAzureDevOpsOAuthCredential creates a new AzureDevOpsOAuthCredential from the access token
+func NewAzureDevOpsOAuthCredential(token string) *AzureDevOpsOAuthCredential {
+	return &AzureDevOpsOAuthCredential{
+		token: token,
+	}
+}
+
+// SetAuthorizationHeader sets the authorization header on the request
+func (t *AzureDevOpsOAuthCredential) SetAuthorizationHeader(req *http.Request) {
+	req.Header.Set("Authorization", "Bearer "+t.token)
+}
+
+// AddToPushOptions adds the credential to the git push options
+func (t *AzureDevOpsOAuthCredential) AddToPushOptions(options *git.PushOptions, _ string) {
+	options.Auth = &githttp.TokenAuth{
+		Token: t.token,
+	}
+}
+
+// AddToCloneOptions adds the credential to the git clone options
+func (t *AzureDevOpsOAuthCredential) AddToCloneOptions(options *git.CloneOptions) {
+	options.Auth = &githttp.TokenAuth{
+		Token: t.token,
+	}
+}
+
+// GetCacheKey returns the cache key used to look up the REST client
+func (t *AzureDevOpsOAuthCredential) GetCacheKey() string {
+	return t.token
+}
+
+// GetCredential implements the DirectCredential interface
+func (t *AzureDevOpsOAuthCredential) GetCredential() string {
+	return t.token
+}
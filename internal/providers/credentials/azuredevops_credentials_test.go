@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package credentials
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAzureDevOpsPATCredentialSetAuthorizationHeader(t *testing.T) {
+	t.Parallel()
+
+	req := &http.Request{
+		Header: http.Header{},
+	}
+	NewAzureDevOpsPATCredential("test_pat").SetAuthorizationHeader(req)
+
+	// base64 of ":test_pat"
+	require.Equal(t, "Basic OnRlc3RfcGF0", req.Header.Get("Authorization"))
+}
+
+func TestAzureDevOpsPATCredentialAddToClone(t *testing.T) {
+	t.Parallel()
+
+	cloneOptions := &git.CloneOptions{}
+	NewAzureDevOpsPATCredential("test_pat").AddToCloneOptions(cloneOptions)
+	require.Equal(t, &githttp.BasicAuth{
+		Username: "minder-user",
+		Password: "test_pat",
+	}, cloneOptions.Auth)
+}
+
+func TestAzureDevOpsOAuthCredentialSetAuthorizationHeader(t *testing.T) {
+	t.Parallel()
+
+	req := &http.Request{
+		Header: http.Header{},
+	}
+	NewAzureDevOpsOAuthCredential("test_token").SetAuthorizationHeader(req)
+	require.Equal(t, "Bearer test_token", req.Header.Get("Authorization"))
+}
+
+func TestAzureDevOpsOAuthCredentialAddToClone(t *testing.T) {
+	t.Parallel()
+
+	cloneOptions := &git.CloneOptions{}
+	NewAzureDevOpsOAuthCredential("test_token").AddToCloneOptions(cloneOptions)
+	require.Equal(t, &githttp.TokenAuth{Token: "test_token"}, cloneOptions.Auth)
+}
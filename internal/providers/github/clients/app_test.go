@@ -24,6 +24,7 @@ import (
 	"github.com/mindersec/minder/internal/providers/credentials"
 	github2 "github.com/mindersec/minder/internal/providers/github"
 	"github.com/mindersec/minder/internal/providers/github/properties"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	"github.com/mindersec/minder/internal/providers/ratecache"
 	provtelemetry "github.com/mindersec/minder/internal/providers/telemetry"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -185,7 +186,7 @@ func TestNewGitHubAppProvider(t *testing.T) {
 		nil,
 		credentials.NewGitHubTokenCredential("token"),
 		github.NewClient(http.DefaultClient),
-		NewGitHubClientFactory(provtelemetry.NewNoopMetrics()),
+		NewGitHubClientFactory(provtelemetry.NewNoopMetrics(), &ratebudget.NoopTracker{}),
 		properties.NewPropertyFetcherFactory(),
 		false,
 	)
@@ -215,7 +216,7 @@ func TestUserInfo(t *testing.T) {
 		ratecache.NewRestClientCache(context.Background()),
 		credentials.NewGitHubTokenCredential("token"),
 		github.NewClient(http.DefaultClient),
-		NewGitHubClientFactory(provtelemetry.NewNoopMetrics()),
+		NewGitHubClientFactory(provtelemetry.NewNoopMetrics(), &ratebudget.NoopTracker{}),
 		properties.NewPropertyFetcherFactory(),
 		false,
 	)
@@ -291,7 +292,7 @@ func TestArtifactAPIEscapesApp(t *testing.T) {
 				ratecache.NewRestClientCache(context.Background()),
 				credentials.NewGitHubTokenCredential("token"),
 				packageListingClient,
-				NewGitHubClientFactory(provtelemetry.NewNoopMetrics()),
+				NewGitHubClientFactory(provtelemetry.NewNoopMetrics(), &ratebudget.NoopTracker{}),
 				properties.NewPropertyFetcherFactory(),
 				true,
 			)
@@ -383,7 +384,7 @@ func TestListPackagesByRepository(t *testing.T) {
 				ratecache.NewRestClientCache(context.Background()),
 				credentials.NewGitHubTokenCredential(accessToken),
 				packageListingClient,
-				NewGitHubClientFactory(provtelemetry.NewNoopMetrics()),
+				NewGitHubClientFactory(provtelemetry.NewNoopMetrics(), &ratebudget.NoopTracker{}),
 				properties.NewPropertyFetcherFactory(),
 				true,
 			)
@@ -433,7 +434,7 @@ func TestWaitForRateLimitResetApp(t *testing.T) {
 		ratecache.NewRestClientCache(context.Background()),
 		credentials.NewGitHubTokenCredential(token),
 		packageListingClient,
-		NewGitHubClientFactory(provtelemetry.NewNoopMetrics()),
+		NewGitHubClientFactory(provtelemetry.NewNoopMetrics(), &ratebudget.NoopTracker{}),
 		properties.NewPropertyFetcherFactory(),
 		false,
 	)
@@ -492,7 +493,7 @@ func TestConcurrentWaitForRateLimitResetApp(t *testing.T) {
 			restClientCache,
 			credentials.NewGitHubTokenCredential(token),
 			packageListingClient,
-			NewGitHubClientFactory(provtelemetry.NewNoopMetrics()),
+			NewGitHubClientFactory(provtelemetry.NewNoopMetrics(), &ratebudget.NoopTracker{}),
 			properties.NewPropertyFetcherFactory(),
 			false,
 		)
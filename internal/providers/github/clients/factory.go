@@ -17,6 +17,7 @@ import (
 
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/providers/github"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	"github.com/mindersec/minder/internal/providers/telemetry"
 	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
 )
@@ -52,11 +53,13 @@ type GitHubClientFactory interface {
 
 type githubClientFactory struct {
 	metrics telemetry.HttpClientMetrics
+	budgets ratebudget.Tracker
 }
 
-// NewGitHubClientFactory creates a new instance of GitHubClientFactory
-func NewGitHubClientFactory(metrics telemetry.HttpClientMetrics) GitHubClientFactory {
-	return &githubClientFactory{metrics: metrics}
+// NewGitHubClientFactory creates a new instance of GitHubClientFactory.
+// The clients record their rate limit budget in the given tracker.
+func NewGitHubClientFactory(metrics telemetry.HttpClientMetrics, budgets ratebudget.Tracker) GitHubClientFactory {
+	return &githubClientFactory{metrics: metrics, budgets: budgets}
 }
 
 func (g *githubClientFactory) BuildOAuthClient(
@@ -108,6 +111,9 @@ func (g *githubClientFactory) buildClient(
 		return nil, fmt.Errorf("error creating duration round tripper: %w", err)
 	}
 
+	transport = g.budgets.NewRoundTripper(transport,
+		ratebudget.CredentialKey(db.ProviderClassGithub, credential.GetCacheKey()))
+
 	// If $MINDER_LOG_GITHUB_REQUESTS is set, wrap the transport in a logger
 	// to record all calls and responses to from GitHub:
 	if os.Getenv("MINDER_LOG_GITHUB_REQUESTS") != "" {
@@ -22,6 +22,7 @@ import (
 	"github.com/mindersec/minder/internal/providers/credentials"
 	github2 "github.com/mindersec/minder/internal/providers/github"
 	"github.com/mindersec/minder/internal/providers/github/properties"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	"github.com/mindersec/minder/internal/providers/ratecache"
 	provtelemetry "github.com/mindersec/minder/internal/providers/telemetry"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -89,7 +90,7 @@ func TestNewRestClient(t *testing.T) {
 		nil,
 		nil,
 		credentials.NewGitHubTokenCredential("token"),
-		NewGitHubClientFactory(provtelemetry.NewNoopMetrics()),
+		NewGitHubClientFactory(provtelemetry.NewNoopMetrics(), &ratebudget.NoopTracker{}),
 		properties.NewPropertyFetcherFactory(),
 		"",
 	)
@@ -145,7 +146,7 @@ func TestArtifactAPIEscapesOAuth(t *testing.T) {
 				nil,
 				nil,
 				credentials.NewGitHubTokenCredential("token"),
-				NewGitHubClientFactory(provtelemetry.NewNoopMetrics()),
+				NewGitHubClientFactory(provtelemetry.NewNoopMetrics(), &ratebudget.NoopTracker{}),
 				properties.NewPropertyFetcherFactory(),
 				"stacklok",
 			)
@@ -182,7 +183,7 @@ func TestWaitForRateLimitResetOAuth(t *testing.T) {
 		nil,
 		ratecache.NewRestClientCache(context.Background()),
 		credentials.NewGitHubTokenCredential(token),
-		NewGitHubClientFactory(provtelemetry.NewNoopMetrics()),
+		NewGitHubClientFactory(provtelemetry.NewNoopMetrics(), &ratebudget.NoopTracker{}),
 		properties.NewPropertyFetcherFactory(),
 		"mockOwner",
 	)
@@ -235,7 +236,7 @@ func TestConcurrentWaitForRateLimitResetOAuth(t *testing.T) {
 			nil,
 			restClientCache,
 			credentials.NewGitHubTokenCredential(token),
-			NewGitHubClientFactory(provtelemetry.NewNoopMetrics()),
+			NewGitHubClientFactory(provtelemetry.NewNoopMetrics(), &ratebudget.NoopTracker{}),
 			properties.NewPropertyFetcherFactory(),
 			owner,
 		)
@@ -77,6 +77,7 @@ type GitHub struct {
 
 // Ensure that the GitHub client implements the GitHub interface
 var _ provifv1.GitHub = (*GitHub)(nil)
+var _ provifv1.BatchPropertyFetcher = (*GitHub)(nil)
 
 // ClientService is an interface for GitHub operations
 // It is used to mock GitHub operations in tests, but in order to generate
@@ -535,6 +536,68 @@ func (c *GitHub) UpdateBranchProtection(
 	return err
 }
 
+// ListRulesets returns the rulesets defined on a repository. Rulesets
+// inherited from the organization are not included, as they can't be
+// changed through the repository.
+func (c *GitHub) ListRulesets(ctx context.Context, owner, repo string) ([]*github.Ruleset, error) {
+	// GetAllRulesets only returns the first page, so the pages are
+	// requested here until there are none left.
+	var allRulesets []*github.Ruleset
+	page := 1
+	for {
+		u := fmt.Sprintf("repos/%s/%s/rulesets?includes_parents=false&per_page=100&page=%d",
+			url.PathEscape(owner), url.PathEscape(repo), page)
+		req, err := c.client.NewRequest(http.MethodGet, u, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error listing rulesets: %w", err)
+		}
+
+		var rulesets []*github.Ruleset
+		resp, err := c.client.Do(ctx, req, &rulesets)
+		if err != nil {
+			return nil, fmt.Errorf("error listing rulesets: %w", err)
+		}
+		allRulesets = append(allRulesets, rulesets...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		page = resp.NextPage
+	}
+	return allRulesets, nil
+}
+
+// GetRuleset returns a repository ruleset, including its rules
+func (c *GitHub) GetRuleset(ctx context.Context, owner, repo string, id int64) (*github.Ruleset, error) {
+	ruleset, _, err := c.client.Repositories.GetRuleset(ctx, owner, repo, id, false)
+	if err != nil {
+		return nil, fmt.Errorf("error getting ruleset: %w", err)
+	}
+	return ruleset, nil
+}
+
+// CreateRuleset creates a repository ruleset
+func (c *GitHub) CreateRuleset(
+	ctx context.Context, owner, repo string, ruleset *github.Ruleset,
+) (*github.Ruleset, error) {
+	created, _, err := c.client.Repositories.CreateRuleset(ctx, owner, repo, ruleset)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ruleset: %w", err)
+	}
+	return created, nil
+}
+
+// UpdateRuleset updates a repository ruleset
+func (c *GitHub) UpdateRuleset(
+	ctx context.Context, owner, repo string, id int64, ruleset *github.Ruleset,
+) (*github.Ruleset, error) {
+	updated, _, err := c.client.Repositories.UpdateRuleset(ctx, owner, repo, id, ruleset)
+	if err != nil {
+		return nil, fmt.Errorf("error updating ruleset: %w", err)
+	}
+	return updated, nil
+}
+
 // GetBaseURL returns the base URL for the REST API.
 func (c *GitHub) GetBaseURL() string {
 	return c.client.BaseURL.String()
@@ -1065,3 +1128,106 @@ func (c *GitHub) UpdateCheckRun(
 	}
 	return run, nil
 }
+
+// ListCodeScanningAlerts lists the open code scanning alerts of a repository
+func (c *GitHub) ListCodeScanningAlerts(ctx context.Context, owner, repo string) ([]*github.Alert, error) {
+	opts := &github.AlertListOptions{
+		State:       "open",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	var out []*github.Alert
+	for {
+		alerts, resp, err := c.client.CodeScanning.ListAlertsForRepo(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, securityAlertsError(resp, err)
+		}
+
+		out = append(out, alerts...)
+		if resp.NextPage == 0 {
+			return out, nil
+		}
+		opts.ListOptions.Page = resp.NextPage
+	}
+}
+
+// ListSecretScanningAlerts lists the open secret scanning alerts of a repository
+func (c *GitHub) ListSecretScanningAlerts(ctx context.Context, owner, repo string) ([]*github.SecretScanningAlert, error) {
+	opts := &github.SecretScanningAlertListOptions{
+		State:       "open",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	var out []*github.SecretScanningAlert
+	for {
+		alerts, resp, err := c.client.SecretScanning.ListAlertsForRepo(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, securityAlertsError(resp, err)
+		}
+
+		out = append(out, alerts...)
+		if resp.NextPage == 0 {
+			return out, nil
+		}
+		opts.ListOptions.Page = resp.NextPage
+	}
+}
+
+// ListDependabotAlerts lists the open Dependabot alerts of a repository
+func (c *GitHub) ListDependabotAlerts(ctx context.Context, owner, repo string) ([]*github.DependabotAlert, error) {
+	// Dependabot alerts only support cursor based pagination
+	opts := &github.ListAlertsOptions{
+		State:             github.String("open"),
+		ListCursorOptions: github.ListCursorOptions{PerPage: 100},
+	}
+
+	var out []*github.DependabotAlert
+	for {
+		alerts, resp, err := c.client.Dependabot.ListRepoAlerts(ctx, owner, repo, opts)
+		if err != nil {
+			return nil, securityAlertsError(resp, err)
+		}
+
+		out = append(out, alerts...)
+		if resp.After == "" {
+			return out, nil
+		}
+		opts.After = resp.After
+	}
+}
+
+// securityAlertsError wraps the error of listing security alerts. GitHub
+// answers with a 404, or a 403 explaining that the feature is disabled,
+// when a kind of alerts is disabled for the repository. Other 403s mean we
+// lack the permissions to read the alerts and are returned as they are.
+func securityAlertsError(resp *github.Response, err error) error {
+	if isRateLimitError(err) || resp == nil {
+		return err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: %w", provifv1.ErrSecurityAlertsDisabled, err)
+	case http.StatusForbidden:
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && isFeatureDisabledMessage(ghErr.Message) {
+			return fmt.Errorf("%w: %w", provifv1.ErrSecurityAlertsDisabled, err)
+		}
+	}
+
+	return err
+}
+
+// isFeatureDisabledMessage returns true if the message of a 403 response
+// says that a security feature is disabled, e.g. "Dependabot alerts are
+// disabled for this repository." or "Advanced Security must be enabled
+// for this repository to use code scanning."
+func isFeatureDisabledMessage(msg string) bool {
+	msg = strings.ToLower(msg)
+	for _, phrase := range []string{"disabled", "must be enabled", "not enabled"} {
+		if strings.Contains(msg, phrase) {
+			return true
+		}
+	}
+	return false
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
@@ -1719,3 +1720,86 @@ func TestCloseSecurityAdvisory(t *testing.T) {
 		})
 	}
 }
+
+func TestListRulesets(t *testing.T) {
+	t.Parallel()
+
+	var srvURL string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/owner/repo/rulesets", r.URL.Path)
+		assert.Equal(t, "false", r.URL.Query().Get("includes_parents"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/repos/owner/repo/rulesets?page=2>; rel="next"`, srvURL))
+			_, _ = w.Write([]byte(`[{"id": 1, "name": "first"}]`))
+		case "2":
+			_, _ = w.Write([]byte(`[{"id": 2, "name": "second"}]`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	srvURL = srv.URL
+
+	baseURL, err := url.Parse(srv.URL + "/")
+	require.NoError(t, err)
+	th := setupTest(t)
+	th.gh.client.BaseURL = baseURL
+
+	rulesets, err := th.gh.ListRulesets(context.Background(), "owner", "repo")
+	require.NoError(t, err)
+	require.Len(t, rulesets, 2)
+	assert.Equal(t, "first", rulesets[0].Name)
+	assert.Equal(t, "second", rulesets[1].Name)
+}
+
+func TestListDependabotAlertsErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		statusCode   int
+		message      string
+		wantDisabled bool
+	}{
+		{
+			name:         "alerts disabled",
+			statusCode:   http.StatusForbidden,
+			message:      "Dependabot alerts are disabled for this repository.",
+			wantDisabled: true,
+		},
+		{
+			name:       "missing permissions",
+			statusCode: http.StatusForbidden,
+			message:    "Resource not accessible by integration",
+		},
+		{
+			name:         "not found",
+			statusCode:   http.StatusNotFound,
+			message:      "Not Found",
+			wantDisabled: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			th := setupTest(t)
+			th.gh.client = github.NewClient(&http.Client{
+				Transport: &mockTransport{
+					response: &http.Response{
+						StatusCode: tt.statusCode,
+						Body:       io.NopCloser(strings.NewReader(fmt.Sprintf(`{"message": %q}`, tt.message))),
+						Header:     make(http.Header),
+					},
+				},
+			})
+
+			_, err := th.gh.ListDependabotAlerts(context.Background(), "owner", "repo")
+			require.Error(t, err)
+			require.Equal(t, tt.wantDisabled, errors.Is(err, provifv1.ErrSecurityAlertsDisabled))
+		})
+	}
+}
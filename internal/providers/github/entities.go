@@ -18,6 +18,7 @@ import (
 	ghprop "github.com/mindersec/minder/internal/providers/github/properties"
 	"github.com/mindersec/minder/internal/util/ptr"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	config "github.com/mindersec/minder/pkg/config/server"
 	"github.com/mindersec/minder/pkg/entities/properties"
 	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
 )
@@ -140,11 +141,12 @@ func (c *GitHub) registerRepoWebhook(ctx context.Context, props *properties.Prop
 	}
 
 	whprops := properties.NewProperties(map[string]any{
-		ghprop.RepoPropertyHookUiid: hookUUID,
-		ghprop.RepoPropertyHookId:   webhook.GetID(),
-		ghprop.RepoPropertyHookUrl:  webhook.GetURL(),
-		ghprop.RepoPropertyHookName: webhook.GetName(),
-		ghprop.RepoPropertyHookType: webhook.GetType(),
+		ghprop.RepoPropertyHookUiid:              hookUUID,
+		ghprop.RepoPropertyHookId:                webhook.GetID(),
+		ghprop.RepoPropertyHookUrl:               webhook.GetURL(),
+		ghprop.RepoPropertyHookName:              webhook.GetName(),
+		ghprop.RepoPropertyHookType:              webhook.GetType(),
+		ghprop.RepoPropertyHookSecretFingerprint: config.WebhookSecretFingerprint(secret),
 	})
 
 	return props.Merge(whprops), nil
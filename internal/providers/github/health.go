@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mindersec/minder/internal/providers/credentials"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// tokenExpirationHeader is set by GitHub on responses to requests made with
+// a token which expires, e.g. a fine-grained personal access token.
+const tokenExpirationHeader = "GitHub-Authentication-Token-Expiration"
+
+// tokenExpirationLayout is the layout of the tokenExpirationHeader value
+const tokenExpirationLayout = "2006-01-02 15:04:05 MST"
+
+var _ provifv1.HealthChecker = (*GitHub)(nil)
+
+// CheckHealth checks that the credential of the provider is accepted by
+// GitHub. Fetching the rate limits does not count against them.
+func (c *GitHub) CheckHealth(ctx context.Context) (*provifv1.HealthReport, error) {
+	limits, resp, err := c.client.RateLimit.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching rate limits: %w", err)
+	}
+
+	report := &provifv1.HealthReport{}
+	if core := limits.GetCore(); core != nil && core.Remaining == 0 {
+		report.Warnings = append(report.Warnings,
+			fmt.Sprintf("rate limit exhausted until %s", core.Reset.Format(time.RFC3339)))
+	}
+
+	// Installation tokens are short-lived and refreshed on every use of the
+	// provider, their expiry is not a concern.
+	if _, ok := c.GetCredential().(*credentials.GitHubInstallationTokenCredential); ok {
+		return report, nil
+	}
+	if exp := resp.Header.Get(tokenExpirationHeader); exp != "" {
+		expiresAt, err := time.Parse(tokenExpirationLayout, exp)
+		if err != nil {
+			report.Warnings = append(report.Warnings, fmt.Sprintf("cannot parse token expiration %q", exp))
+		} else {
+			report.CredentialExpiresAt = expiresAt
+		}
+	}
+
+	return report, nil
+}
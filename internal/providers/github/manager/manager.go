@@ -28,6 +28,7 @@ import (
 	"github.com/mindersec/minder/internal/providers/github/properties"
 	"github.com/mindersec/minder/internal/providers/github/service"
 	m "github.com/mindersec/minder/internal/providers/manager"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	"github.com/mindersec/minder/internal/providers/ratecache"
 	"github.com/mindersec/minder/pkg/config/server"
 	"github.com/mindersec/minder/pkg/eventer/interfaces"
@@ -39,6 +40,7 @@ import (
 func NewGitHubProviderClassManager(
 	restClientCache ratecache.RestClientCache,
 	ghClientFactory clients.GitHubClientFactory,
+	budgets ratebudget.Tracker,
 	providerConfig *server.ProviderConfig,
 	webhookConfig *server.WebhookConfig,
 	fallbackTokenClient *gogithub.Client,
@@ -52,6 +54,7 @@ func NewGitHubProviderClassManager(
 	return &githubProviderManager{
 		restClientCache:     restClientCache,
 		ghClientFactory:     ghClientFactory,
+		budgets:             budgets,
 		config:              providerConfig,
 		whconfig:            webhookConfig,
 		fallbackTokenClient: fallbackTokenClient,
@@ -67,6 +70,7 @@ func NewGitHubProviderClassManager(
 type githubProviderManager struct {
 	restClientCache     ratecache.RestClientCache
 	ghClientFactory     clients.GitHubClientFactory
+	budgets             ratebudget.Tracker
 	config              *server.ProviderConfig
 	whconfig            *server.WebhookConfig
 	fallbackTokenClient *gogithub.Client
@@ -105,6 +109,9 @@ func (g *githubProviderManager) Build(ctx context.Context, config *db.Provider)
 		return nil, fmt.Errorf("unable to fetch credentials: %w", err)
 	}
 
+	// the budget is tracked per credential, work is scheduled per provider
+	g.budgets.BindProvider(config.ID, ratebudget.CredentialKey(db.ProviderClassGithub, creds.credential.GetCacheKey()))
+
 	client, ok := g.restClientCache.Get(creds.ownerFilter.String, creds.credential.GetCacheKey(), db.ProviderTypeGithub)
 	if ok {
 		return client.(v1.GitHub), nil
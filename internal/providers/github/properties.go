@@ -14,6 +14,7 @@ import (
 	properties2 "github.com/mindersec/minder/internal/providers/github/properties"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
 )
 
 // FetchProperty fetches a single property for the given entity
@@ -77,6 +78,60 @@ func (c *GitHub) FetchAllProperties(
 	return upstreamProps.Merge(operational), nil
 }
 
+// FetchAllPropertiesBatch fetches all properties for the given entities.
+// Repositories and pull requests are fetched with batched GraphQL queries and
+// releases by listing the releases of their repositories, falling back to
+// fetching the entities the batches failed for one by one. Other entity types
+// are fetched one by one.
+func (c *GitHub) FetchAllPropertiesBatch(
+	ctx context.Context, getByProps []*properties.Properties, entType minderv1.Entity,
+	cachedProps []*properties.Properties,
+) []provifv1.BatchPropertiesResult {
+	results := make([]provifv1.BatchPropertiesResult, len(getByProps))
+	cachedFor := func(i int) *properties.Properties {
+		if i < len(cachedProps) {
+			return cachedProps[i]
+		}
+		return nil
+	}
+
+	var batched []map[string]any
+	var batchErrs []error
+	if c.propertyFetchers != nil {
+		switch entType {
+		case minderv1.Entity_ENTITY_REPOSITORIES:
+			batched, batchErrs = properties2.FetchRepositoriesBatch(ctx, c.client, getByProps)
+		case minderv1.Entity_ENTITY_PULL_REQUESTS:
+			batched, batchErrs = properties2.FetchPullRequestsBatch(ctx, c.client, getByProps)
+		case minderv1.Entity_ENTITY_RELEASE:
+			batched, batchErrs = properties2.FetchReleasesBatch(ctx, c.client, getByProps)
+		default:
+			// fetched one by one below
+		}
+	}
+
+	for i, lookup := range getByProps {
+		if batched != nil {
+			switch {
+			case batchErrs[i] == nil:
+				fetcher := c.propertyFetchers.EntityPropertyFetcher(entType)
+				upstreamProps := properties.NewProperties(batched[i])
+				results[i].Properties = upstreamProps.Merge(filterOperational(cachedFor(i), fetcher))
+				continue
+			case errors.Is(batchErrs[i], provifv1.ErrEntityNotFound):
+				results[i].Err = fmt.Errorf("error fetching properties for entity %s: %w", entType, batchErrs[i])
+				continue
+			}
+			zerolog.Ctx(ctx).Debug().Err(batchErrs[i]).Msg("falling back to fetching properties one by one")
+		}
+
+		props, err := c.FetchAllProperties(ctx, lookup, entType, cachedFor(i))
+		results[i] = provifv1.BatchPropertiesResult{Properties: props, Err: err}
+	}
+
+	return results
+}
+
 func filterOperational(cachedProperties *properties.Properties, fetcher properties2.GhPropertyFetcher) *properties.Properties {
 	if cachedProperties == nil {
 		// Nothing to filter
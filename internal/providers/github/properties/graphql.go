@@ -0,0 +1,417 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package properties
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	go_github "github.com/google/go-github/v63/github"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/pkg/entities/properties"
+	v1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// GraphQLBatchSize is the maximum number of entities fetched by a single
+// GraphQL query. It keeps the queries well within the node limits of the API.
+const GraphQLBatchSize = 50
+
+// graphQLNotFound is the error type returned for an entity that doesn't exist
+const graphQLNotFound = "NOT_FOUND"
+
+// repoGraphQLFields are the fields of a repository needed to build the same
+// properties as getRepoWrapper does from the REST API
+const repoGraphQLFields = `fragment repoFields on Repository {
+  databaseId
+  name
+  owner { login }
+  isPrivate
+  isArchived
+  isFork
+  url
+  defaultBranchRef { name }
+  licenseInfo { spdxId }
+  primaryLanguage { name }
+  repositoryTopics(first: 100) { nodes { topic { name } } }
+}`
+
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+type graphQLError struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+	Path    []any  `json:"path"`
+}
+
+type graphQLResponse struct {
+	Data   map[string]json.RawMessage `json:"data"`
+	Errors []graphQLError             `json:"errors"`
+}
+
+// graphQLBatch describes how to fetch the entities of a type with batched
+// GraphQL queries
+type graphQLBatch struct {
+	// kind names the entity type in errors
+	kind string
+	// fragment holds the fields selected for each entity
+	fragment string
+	// selection adds the variables needed to look up an entity to variables,
+	// and returns their declarations and the selection of the entity as alias
+	selection func(
+		ctx context.Context, alias string, lookup *properties.Properties, variables map[string]any,
+	) (decls string, sel string, err error)
+	// toMap converts the field of an entity in the response to its properties,
+	// returning nil if the entity is missing from the response
+	toMap func(raw json.RawMessage, lookup *properties.Properties) (map[string]any, error)
+}
+
+type graphQLRepository struct {
+	DatabaseID int64  `json:"databaseId"`
+	Name       string `json:"name"`
+	Owner      struct {
+		Login string `json:"login"`
+	} `json:"owner"`
+	IsPrivate        bool   `json:"isPrivate"`
+	IsArchived       bool   `json:"isArchived"`
+	IsFork           bool   `json:"isFork"`
+	URL              string `json:"url"`
+	DefaultBranchRef *struct {
+		Name string `json:"name"`
+	} `json:"defaultBranchRef"`
+	LicenseInfo *struct {
+		SpdxID string `json:"spdxId"`
+	} `json:"licenseInfo"`
+	PrimaryLanguage *struct {
+		Name string `json:"name"`
+	} `json:"primaryLanguage"`
+	RepositoryTopics struct {
+		Nodes []struct {
+			Topic struct {
+				Name string `json:"name"`
+			} `json:"topic"`
+		} `json:"nodes"`
+	} `json:"repositoryTopics"`
+}
+
+// toGitHubRepository converts the GraphQL repository to its REST representation,
+// so that both APIs produce the same properties through GitHubRepoToMap
+func (r *graphQLRepository) toGitHubRepository(apiBaseURL *url.URL) *go_github.Repository {
+	repo := &go_github.Repository{
+		ID:       go_github.Int64(r.DatabaseID),
+		Name:     go_github.String(r.Name),
+		Owner:    &go_github.User{Login: go_github.String(r.Owner.Login)},
+		Private:  go_github.Bool(r.IsPrivate),
+		Archived: go_github.Bool(r.IsArchived),
+		Fork:     go_github.Bool(r.IsFork),
+		// GraphQL doesn't expose the API URLs of a repository
+		DeploymentsURL: go_github.String(apiBaseURL.JoinPath(
+			"repos", r.Owner.Login, r.Name, "deployments").String()),
+		CloneURL: go_github.String(r.URL + ".git"),
+	}
+
+	if r.DefaultBranchRef != nil {
+		repo.DefaultBranch = go_github.String(r.DefaultBranchRef.Name)
+	}
+	if r.LicenseInfo != nil {
+		repo.License = &go_github.License{SPDXID: go_github.String(r.LicenseInfo.SpdxID)}
+	}
+	if r.PrimaryLanguage != nil {
+		repo.Language = go_github.String(r.PrimaryLanguage.Name)
+	}
+	for _, node := range r.RepositoryTopics.Nodes {
+		repo.Topics = append(repo.Topics, node.Topic.Name)
+	}
+
+	return repo
+}
+
+// pullRequestGraphQLFields are the fields of a pull request needed to build
+// the same properties as getPrWrapper does from the REST API
+const pullRequestGraphQLFields = `fragment pullRequestFields on PullRequest {
+  fullDatabaseId
+  number
+  url
+  headRefOid
+  headRefName
+  baseRefName
+  author { login ... on User { databaseId } ... on Bot { databaseId } }
+  baseRepository { url defaultBranchRef { name } }
+  headRepository { url }
+}`
+
+type graphQLPullRequest struct {
+	// FullDatabaseID is a BigInt, which GraphQL serializes as a string
+	FullDatabaseID string `json:"fullDatabaseId"`
+	Number         int    `json:"number"`
+	URL            string `json:"url"`
+	HeadRefOid     string `json:"headRefOid"`
+	HeadRefName    string `json:"headRefName"`
+	BaseRefName    string `json:"baseRefName"`
+	Author         *struct {
+		Login      string `json:"login"`
+		DatabaseID int64  `json:"databaseId"`
+	} `json:"author"`
+	BaseRepository *struct {
+		URL              string `json:"url"`
+		DefaultBranchRef *struct {
+			Name string `json:"name"`
+		} `json:"defaultBranchRef"`
+	} `json:"baseRepository"`
+	HeadRepository *struct {
+		URL string `json:"url"`
+	} `json:"headRepository"`
+}
+
+// toGitHubPullRequest converts the GraphQL pull request to its REST
+// representation, so that both APIs produce the same properties through
+// pullRequestToMap
+func (pr *graphQLPullRequest) toGitHubPullRequest() (*go_github.PullRequest, error) {
+	id, err := strconv.ParseInt(pr.FullDatabaseID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pull request ID: %w", err)
+	}
+
+	reply := &go_github.PullRequest{
+		ID:      go_github.Int64(id),
+		Number:  go_github.Int(pr.Number),
+		HTMLURL: go_github.String(pr.URL),
+		Head: &go_github.PullRequestBranch{
+			SHA: go_github.String(pr.HeadRefOid),
+			Ref: go_github.String(pr.HeadRefName),
+		},
+		Base: &go_github.PullRequestBranch{
+			Ref: go_github.String(pr.BaseRefName),
+		},
+	}
+
+	if pr.Author != nil {
+		reply.User = &go_github.User{
+			ID:    go_github.Int64(pr.Author.DatabaseID),
+			Login: go_github.String(pr.Author.Login),
+		}
+	}
+	if pr.BaseRepository != nil {
+		reply.Base.Repo = &go_github.Repository{CloneURL: go_github.String(pr.BaseRepository.URL + ".git")}
+		if pr.BaseRepository.DefaultBranchRef != nil {
+			reply.Base.Repo.DefaultBranch = go_github.String(pr.BaseRepository.DefaultBranchRef.Name)
+		}
+	}
+	// the head repository is gone if the fork was deleted
+	if pr.HeadRepository != nil {
+		reply.Head.Repo = &go_github.Repository{CloneURL: go_github.String(pr.HeadRepository.URL + ".git")}
+	}
+
+	return reply, nil
+}
+
+// FetchRepositoriesBatch fetches the properties of many repositories with
+// GraphQL queries of up to GraphQLBatchSize repositories each, instead of a
+// REST call per repository. The results and errors are in the order of
+// lookupProperties. Repositories which don't exist have v1.ErrEntityNotFound
+// as their error, any other error means the repository could not be fetched
+// through GraphQL and the caller may fall back to the REST API.
+func FetchRepositoriesBatch(
+	ctx context.Context, ghCli *go_github.Client, lookupProperties []*properties.Properties,
+) ([]map[string]any, []error) {
+	return fetchGraphQLBatch(ctx, ghCli, &graphQLBatch{
+		kind:     "repository",
+		fragment: repoGraphQLFields,
+		selection: func(
+			ctx context.Context, alias string, lookup *properties.Properties, variables map[string]any,
+		) (string, string, error) {
+			name, owner, err := getNameOwnerFromProps(ctx, lookup)
+			if err != nil {
+				return "", "", fmt.Errorf("error getting name and owner from properties: %w", err)
+			}
+			variables["o"+alias] = owner
+			variables["n"+alias] = name
+			return fmt.Sprintf("$o%s: String!, $n%s: String!", alias, alias),
+				fmt.Sprintf("%s: repository(owner: $o%s, name: $n%s) { ...repoFields }", alias, alias, alias),
+				nil
+		},
+		toMap: func(raw json.RawMessage, _ *properties.Properties) (map[string]any, error) {
+			var repo *graphQLRepository
+			if err := json.Unmarshal(raw, &repo); err != nil || repo == nil {
+				return nil, err
+			}
+			return GitHubRepoToMap(repo.toGitHubRepository(ghCli.BaseURL)), nil
+		},
+	}, lookupProperties)
+}
+
+// FetchPullRequestsBatch fetches the properties of many pull requests with
+// GraphQL queries the same way FetchRepositoriesBatch does for repositories.
+func FetchPullRequestsBatch(
+	ctx context.Context, ghCli *go_github.Client, lookupProperties []*properties.Properties,
+) ([]map[string]any, []error) {
+	return fetchGraphQLBatch(ctx, ghCli, &graphQLBatch{
+		kind:     "pull request",
+		fragment: pullRequestGraphQLFields,
+		selection: func(
+			_ context.Context, alias string, lookup *properties.Properties, variables map[string]any,
+		) (string, string, error) {
+			owner, name, number, err := getPrWrapperAttrsFromProps(lookup)
+			if err != nil {
+				return "", "", fmt.Errorf("error getting pr wrapper attributes: %w", err)
+			}
+			if number > math.MaxInt32 {
+				return "", "", fmt.Errorf("pr number is too large")
+			}
+			variables["o"+alias] = owner
+			variables["n"+alias] = name
+			variables["p"+alias] = number
+			return fmt.Sprintf("$o%s: String!, $n%s: String!, $p%s: Int!", alias, alias, alias),
+				fmt.Sprintf("%s: repository(owner: $o%s, name: $n%s) { pullRequest(number: $p%s) { ...pullRequestFields } }",
+					alias, alias, alias, alias),
+				nil
+		},
+		toMap: func(raw json.RawMessage, lookup *properties.Properties) (map[string]any, error) {
+			var repo *struct {
+				PullRequest *graphQLPullRequest `json:"pullRequest"`
+			}
+			if err := json.Unmarshal(raw, &repo); err != nil || repo == nil || repo.PullRequest == nil {
+				return nil, err
+			}
+			reply, err := repo.PullRequest.toGitHubPullRequest()
+			if err != nil {
+				return nil, err
+			}
+			owner, name, _, err := getPrWrapperAttrsFromProps(lookup)
+			if err != nil {
+				return nil, err
+			}
+			return pullRequestToMap(owner, name, reply), nil
+		},
+	}, lookupProperties)
+}
+
+// fetchGraphQLBatch fetches the entities of lookupProperties with GraphQL
+// queries of up to GraphQLBatchSize entities each
+func fetchGraphQLBatch(
+	ctx context.Context, ghCli *go_github.Client, batch *graphQLBatch, lookupProperties []*properties.Properties,
+) ([]map[string]any, []error) {
+	results := make([]map[string]any, len(lookupProperties))
+	errs := make([]error, len(lookupProperties))
+
+	for start := 0; start < len(lookupProperties); start += GraphQLBatchSize {
+		end := min(start+GraphQLBatchSize, len(lookupProperties))
+		err := fetchGraphQLQuery(ctx, ghCli, batch, lookupProperties[start:end], results[start:end], errs[start:end])
+		if err != nil {
+			zerolog.Ctx(ctx).Debug().Err(err).Int("size", end-start).Msgf("GraphQL %s query failed", batch.kind)
+			for i := start; i < end; i++ {
+				if errs[i] == nil && results[i] == nil {
+					errs[i] = err
+				}
+			}
+		}
+	}
+
+	return results, errs
+}
+
+// fetchGraphQLQuery fetches a batch of entities with a single GraphQL query,
+// filling in results and errs. The returned error is set if the whole query
+// failed.
+func fetchGraphQLQuery(
+	ctx context.Context, ghCli *go_github.Client, batch *graphQLBatch, lookupProperties []*properties.Properties,
+	results []map[string]any, errs []error,
+) error {
+	var query strings.Builder
+	var selections strings.Builder
+	variables := make(map[string]any, 2*len(lookupProperties))
+	aliases := make(map[string]int, len(lookupProperties))
+
+	query.WriteString("query(")
+	for i, lookup := range lookupProperties {
+		alias := fmt.Sprintf("r%d", i)
+		decls, sel, err := batch.selection(ctx, alias, lookup, variables)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+
+		aliases[alias] = i
+		if len(aliases) > 1 {
+			query.WriteString(", ")
+		}
+		query.WriteString(decls)
+		fmt.Fprintf(&selections, "  %s\n", sel)
+	}
+	if len(aliases) == 0 {
+		return nil
+	}
+	query.WriteString(") {\n")
+	query.WriteString(selections.String())
+	query.WriteString("}\n")
+	query.WriteString(batch.fragment)
+
+	req, err := ghCli.NewRequest(http.MethodPost, graphQLURL(ghCli.BaseURL).String(), &graphQLRequest{
+		Query:     query.String(),
+		Variables: variables,
+	})
+	if err != nil {
+		return fmt.Errorf("error creating GraphQL request: %w", err)
+	}
+
+	var resp graphQLResponse
+	if _, err := ghCli.Do(ctx, req, &resp); err != nil {
+		return fmt.Errorf("error executing GraphQL query: %w", err)
+	}
+
+	// errors for a single entity carry its alias in their path
+	aliasErrors := make(map[string]graphQLError, len(resp.Errors))
+	for _, gqlErr := range resp.Errors {
+		if len(gqlErr.Path) == 0 {
+			return fmt.Errorf("GraphQL query failed: %s", gqlErr.Message)
+		}
+		if alias, ok := gqlErr.Path[0].(string); ok {
+			aliasErrors[alias] = gqlErr
+		}
+	}
+
+	for alias, i := range aliases {
+		if raw, ok := resp.Data[alias]; ok {
+			props, err := batch.toMap(raw, lookupProperties[i])
+			if err != nil {
+				errs[i] = fmt.Errorf("error decoding GraphQL %s: %w", batch.kind, err)
+				continue
+			}
+			if props != nil {
+				results[i] = props
+				continue
+			}
+		}
+
+		gqlErr, ok := aliasErrors[alias]
+		switch {
+		case ok && gqlErr.Type == graphQLNotFound:
+			errs[i] = v1.ErrEntityNotFound
+		case ok:
+			errs[i] = fmt.Errorf("GraphQL query failed for %s: %s", batch.kind, gqlErr.Message)
+		default:
+			errs[i] = fmt.Errorf("%s missing from GraphQL response", batch.kind)
+		}
+	}
+
+	return nil
+}
+
+// graphQLURL returns the URL of the GraphQL API. GitHub Enterprise Server
+// serves the REST API under /api/v3/ and the GraphQL API under /api/graphql.
+func graphQLURL(apiBaseURL *url.URL) *url.URL {
+	if strings.HasSuffix(apiBaseURL.Path, "/api/v3/") {
+		return apiBaseURL.ResolveReference(&url.URL{Path: "../graphql"})
+	}
+	return apiBaseURL.ResolveReference(&url.URL{Path: "graphql"})
+}
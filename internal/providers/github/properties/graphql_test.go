@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package properties
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	go_github "github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/pkg/entities/properties"
+	v1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const graphQLRepoResponse = `{
+  "data": {
+    "r0": {
+      "databaseId": 42,
+      "name": "minder",
+      "owner": {"login": "mindersec"},
+      "isPrivate": false,
+      "isArchived": false,
+      "isFork": true,
+      "url": "https://github.com/mindersec/minder",
+      "defaultBranchRef": {"name": "main"},
+      "licenseInfo": {"spdxId": "Apache-2.0"},
+      "primaryLanguage": {"name": "Go"},
+      "repositoryTopics": {"nodes": [{"topic": {"name": "security"}}, {"topic": {"name": "supply-chain"}}]}
+    },
+    "r1": null
+  },
+  "errors": [
+    {"type": "NOT_FOUND", "path": ["r1"], "message": "Could not resolve to a Repository"}
+  ]
+}`
+
+func TestFetchRepositoriesBatch(t *testing.T) {
+	t.Parallel()
+
+	var gotRequest graphQLRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/graphql", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotRequest))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(graphQLRepoResponse))
+	}))
+	defer srv.Close()
+
+	ghCli := go_github.NewClient(srv.Client())
+	baseURL, err := url.Parse(srv.URL + "/")
+	require.NoError(t, err)
+	ghCli.BaseURL = baseURL
+
+	lookups := []*properties.Properties{
+		properties.NewProperties(map[string]any{
+			RepoPropertyOwner: "mindersec",
+			RepoPropertyName:  "minder",
+		}),
+		properties.NewProperties(map[string]any{
+			properties.PropertyName: "mindersec/gone",
+		}),
+		properties.NewProperties(map[string]any{}),
+	}
+
+	results, errs := FetchRepositoriesBatch(context.Background(), ghCli, lookups)
+	require.Len(t, results, 3)
+	require.Len(t, errs, 3)
+
+	// the GraphQL properties are the same as the REST ones
+	require.NoError(t, errs[0])
+	require.Equal(t, GitHubRepoToMap(&go_github.Repository{
+		ID:             go_github.Int64(42),
+		Name:           go_github.String("minder"),
+		Owner:          &go_github.User{Login: go_github.String("mindersec")},
+		Private:        go_github.Bool(false),
+		Archived:       go_github.Bool(false),
+		Fork:           go_github.Bool(true),
+		DeploymentsURL: go_github.String(srv.URL + "/repos/mindersec/minder/deployments"),
+		CloneURL:       go_github.String("https://github.com/mindersec/minder.git"),
+		DefaultBranch:  go_github.String("main"),
+		License:        &go_github.License{SPDXID: go_github.String("Apache-2.0")},
+		Language:       go_github.String("Go"),
+		Topics:         []string{"security", "supply-chain"},
+	}), results[0])
+
+	require.ErrorIs(t, errs[1], v1.ErrEntityNotFound)
+	require.Nil(t, results[1])
+
+	// lookups without a name are not part of the query
+	require.Error(t, errs[2])
+	require.Nil(t, results[2])
+	require.Equal(t, map[string]any{
+		"or0": "mindersec", "nr0": "minder",
+		"or1": "mindersec", "nr1": "gone",
+	}, gotRequest.Variables)
+}
+
+func TestFetchRepositoriesBatchQueryFailure(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	ghCli := go_github.NewClient(srv.Client())
+	baseURL, err := url.Parse(srv.URL + "/")
+	require.NoError(t, err)
+	ghCli.BaseURL = baseURL
+
+	lookups := []*properties.Properties{
+		properties.NewProperties(map[string]any{properties.PropertyName: "mindersec/minder"}),
+	}
+
+	results, errs := FetchRepositoriesBatch(context.Background(), ghCli, lookups)
+	require.Nil(t, results[0])
+	require.Error(t, errs[0])
+	require.NotErrorIs(t, errs[0], v1.ErrEntityNotFound)
+}
+
+func TestGraphQLURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		baseURL string
+		want    string
+	}{
+		{
+			name:    "github.com",
+			baseURL: "https://api.github.com/",
+			want:    "https://api.github.com/graphql",
+		},
+		{
+			name:    "github enterprise server",
+			baseURL: "https://ghes.example.com/api/v3/",
+			want:    "https://ghes.example.com/api/graphql",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			baseURL, err := url.Parse(tt.baseURL)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, graphQLURL(baseURL).String())
+		})
+	}
+}
+
+const graphQLPullRequestResponse = `{
+  "data": {
+    "r0": {
+      "pullRequest": {
+        "fullDatabaseId": "3000000000",
+        "number": 7,
+        "url": "https://github.com/mindersec/minder/pull/7",
+        "headRefOid": "abc123",
+        "headRefName": "feature",
+        "baseRefName": "main",
+        "author": {"login": "octocat", "databaseId": 1},
+        "baseRepository": {"url": "https://github.com/mindersec/minder", "defaultBranchRef": {"name": "main"}},
+        "headRepository": {"url": "https://github.com/octocat/minder"}
+      }
+    },
+    "r1": {"pullRequest": null}
+  },
+  "errors": [
+    {"type": "NOT_FOUND", "path": ["r1", "pullRequest"], "message": "Could not resolve to a PullRequest"}
+  ]
+}`
+
+func TestFetchPullRequestsBatch(t *testing.T) {
+	t.Parallel()
+
+	var gotRequest graphQLRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/graphql", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotRequest))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(graphQLPullRequestResponse))
+	}))
+	defer srv.Close()
+
+	ghCli := go_github.NewClient(srv.Client())
+	baseURL, err := url.Parse(srv.URL + "/")
+	require.NoError(t, err)
+	ghCli.BaseURL = baseURL
+
+	lookups := []*properties.Properties{
+		properties.NewProperties(map[string]any{
+			PullPropertyRepoOwner: "mindersec",
+			PullPropertyRepoName:  "minder",
+			PullPropertyNumber:    int64(7),
+		}),
+		properties.NewProperties(map[string]any{
+			properties.PropertyName: "mindersec/minder/8",
+		}),
+	}
+
+	results, errs := FetchPullRequestsBatch(context.Background(), ghCli, lookups)
+	require.Len(t, results, 2)
+	require.Len(t, errs, 2)
+
+	// the GraphQL properties are the same as the REST ones
+	require.NoError(t, errs[0])
+	require.Equal(t, pullRequestToMap("mindersec", "minder", &go_github.PullRequest{
+		ID:      go_github.Int64(3000000000),
+		Number:  go_github.Int(7),
+		HTMLURL: go_github.String("https://github.com/mindersec/minder/pull/7"),
+		User:    &go_github.User{ID: go_github.Int64(1), Login: go_github.String("octocat")},
+		Head: &go_github.PullRequestBranch{
+			SHA:  go_github.String("abc123"),
+			Ref:  go_github.String("feature"),
+			Repo: &go_github.Repository{CloneURL: go_github.String("https://github.com/octocat/minder.git")},
+		},
+		Base: &go_github.PullRequestBranch{
+			Ref: go_github.String("main"),
+			Repo: &go_github.Repository{
+				CloneURL:      go_github.String("https://github.com/mindersec/minder.git"),
+				DefaultBranch: go_github.String("main"),
+			},
+		},
+	}), results[0])
+
+	require.ErrorIs(t, errs[1], v1.ErrEntityNotFound)
+	require.Nil(t, results[1])
+
+	require.Equal(t, map[string]any{
+		"or0": "mindersec", "nr0": "minder", "pr0": float64(7),
+		"or1": "mindersec", "nr1": "minder", "pr1": float64(8),
+	}, gotRequest.Variables)
+}
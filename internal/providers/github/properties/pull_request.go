@@ -154,10 +154,16 @@ func getPrWrapper(
 		return nil, err
 	}
 
-	prProps := map[string]any{
+	return pullRequestToMap(owner, name, prReply), nil
+}
+
+// pullRequestToMap converts a pull request of the repository owner/name to
+// its properties
+func pullRequestToMap(owner, name string, prReply *go_github.PullRequest) map[string]any {
+	return map[string]any{
 		// general entity
 		properties.PropertyUpstreamID:           properties.NumericalValueToUpstreamID(prReply.GetID()),
-		properties.PropertyName:                 fmt.Sprintf("%s/%s/%d", owner, name, intId),
+		properties.PropertyName:                 fmt.Sprintf("%s/%s/%d", owner, name, prReply.GetNumber()),
 		properties.PullRequestCommitSHA:         prReply.GetHead().GetSHA(),
 		properties.PullRequestBaseCloneURL:      prReply.GetBase().GetRepo().GetCloneURL(),
 		properties.PullRequestBaseBranch:        prReply.GetBase().GetRef(),
@@ -179,8 +185,6 @@ func getPrWrapper(
 		PullPropertyBaseRef:        prReply.GetBase().GetRef(),                // prefer base_branch
 		PullPropertyTargetRef:      prReply.GetHead().GetRef(),                // prefer target_branch
 	}
-
-	return prProps, nil
 }
 
 func getPrWrapperAttrsFromProps(props *properties.Properties) (string, string, int64, error) {
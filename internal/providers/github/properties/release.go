@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	go_github "github.com/google/go-github/v63/github"
+	"github.com/rs/zerolog"
 
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	"github.com/mindersec/minder/pkg/entities/properties"
@@ -79,36 +80,75 @@ func getReleaseNameFromParams(owner, repo, tag string) string {
 func getReleaseWrapper(
 	ctx context.Context, ghCli *go_github.Client, _ bool, getByProps *properties.Properties,
 ) (map[string]any, error) {
-	upstreamID, err := getByProps.GetProperty(properties.PropertyUpstreamID).AsInt64()
+	owner, repo, upstreamID, err := getReleaseWrapperAttrsFromProps(getByProps)
 	if err != nil {
-		return nil, fmt.Errorf("upstream ID not found or invalid: %w", err)
+		return nil, err
 	}
 
-	owner, err := getByProps.GetProperty(ReleasePropertyOwner).AsString()
+	release, err := getRelease(ctx, ghCli, owner, repo, upstreamID)
 	if err != nil {
-		return nil, fmt.Errorf("owner not found or invalid: %w", err)
+		return nil, err
 	}
 
-	repo, err := getByProps.GetProperty(ReleasePropertyRepo).AsString()
+	return releaseToMap(ctx, ghCli, owner, repo, release, nil)
+}
+
+func getReleaseWrapperAttrsFromProps(props *properties.Properties) (string, string, int64, error) {
+	upstreamID, err := props.GetProperty(properties.PropertyUpstreamID).AsInt64()
 	if err != nil {
-		return nil, fmt.Errorf("repo not found or invalid: %w", err)
+		return "", "", 0, fmt.Errorf("upstream ID not found or invalid: %w", err)
+	}
+
+	owner, err := props.GetProperty(ReleasePropertyOwner).AsString()
+	if err != nil {
+		return "", "", 0, fmt.Errorf("owner not found or invalid: %w", err)
+	}
+
+	repo, err := props.GetProperty(ReleasePropertyRepo).AsString()
+	if err != nil {
+		return "", "", 0, fmt.Errorf("repo not found or invalid: %w", err)
 	}
 
-	var fetchErr error
-	var release *go_github.RepositoryRelease
-	var result *go_github.Response
-	release, result, fetchErr = ghCli.Repositories.GetRelease(ctx, owner, repo,
-		upstreamID)
-	if fetchErr != nil {
+	return owner, repo, upstreamID, nil
+}
+
+func getRelease(
+	ctx context.Context, ghCli *go_github.Client, owner, repo string, upstreamID int64,
+) (*go_github.RepositoryRelease, error) {
+	release, result, err := ghCli.Repositories.GetRelease(ctx, owner, repo, upstreamID)
+	if err != nil {
 		if result != nil && result.StatusCode == http.StatusNotFound {
 			return nil, v1.ErrEntityNotFound
 		}
-		return nil, fmt.Errorf("failed to fetch release: %w", fetchErr)
+		return nil, fmt.Errorf("failed to fetch release: %w", err)
 	}
+	return release, nil
+}
 
-	branch, commitSha, err := getBranchAndCommit(ctx, owner, repo, release.GetTargetCommitish(), ghCli)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get branch and commit SHA: %w", err)
+// releaseTarget is the branch and commit a release targets
+type releaseTarget struct {
+	branch    string
+	commitSha string
+}
+
+// releaseToMap converts a release of the repository owner/repo to its
+// properties. branches, if not nil, caches the branches of the repository
+// the releases target.
+func releaseToMap(
+	ctx context.Context, ghCli *go_github.Client, owner, repo string,
+	release *go_github.RepositoryRelease, branches map[string]releaseTarget,
+) (map[string]any, error) {
+	commitish := release.GetTargetCommitish()
+	target, ok := branches[commitish]
+	if !ok {
+		branch, commitSha, err := getBranchAndCommit(ctx, owner, repo, commitish, ghCli)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get branch and commit SHA: %w", err)
+		}
+		target = releaseTarget{branch: branch, commitSha: commitSha}
+		if branches != nil {
+			branches[commitish] = target
+		}
 	}
 
 	return map[string]any{
@@ -117,11 +157,96 @@ func getReleaseWrapper(
 		ReleasePropertyOwner:             owner,
 		ReleasePropertyRepo:              repo,
 		properties.ReleasePropertyTag:    release.GetTagName(),
-		properties.ReleaseCommitSHA:      commitSha,
-		properties.ReleasePropertyBranch: branch,
+		properties.ReleaseCommitSHA:      target.commitSha,
+		properties.ReleasePropertyBranch: target.branch,
 	}, nil
 }
 
+// FetchReleasesBatch fetches the properties of many releases. Instead of a
+// REST call per release, the releases of each repository are listed, and the
+// branches they target are only fetched once per repository. A repository is
+// listed for at most as many pages as it has releases in the batch, the
+// releases not found there are fetched one by one. The results and errors
+// are in the order of lookupProperties, releases which don't exist have
+// v1.ErrEntityNotFound as their error.
+func FetchReleasesBatch(
+	ctx context.Context, ghCli *go_github.Client, lookupProperties []*properties.Properties,
+) ([]map[string]any, []error) {
+	results := make([]map[string]any, len(lookupProperties))
+	errs := make([]error, len(lookupProperties))
+
+	type repoKey struct{ owner, repo string }
+	var repos []repoKey
+	wanted := make(map[repoKey]map[int64]int)
+	for i, lookup := range lookupProperties {
+		owner, repo, upstreamID, err := getReleaseWrapperAttrsFromProps(lookup)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		key := repoKey{owner, repo}
+		if _, ok := wanted[key]; !ok {
+			repos = append(repos, key)
+			wanted[key] = make(map[int64]int)
+		}
+		wanted[key][upstreamID] = i
+	}
+
+	for _, key := range repos {
+		ids := wanted[key]
+		listed, err := listReleases(ctx, ghCli, key.owner, key.repo, ids)
+		if err != nil {
+			zerolog.Ctx(ctx).Debug().Err(err).Msg("failed to list releases, fetching them one by one")
+		}
+
+		branches := make(map[string]releaseTarget)
+		for id, i := range ids {
+			release, ok := listed[id]
+			if !ok {
+				release, err = getRelease(ctx, ghCli, key.owner, key.repo, id)
+				if err != nil {
+					errs[i] = err
+					continue
+				}
+			}
+			results[i], errs[i] = releaseToMap(ctx, ghCli, key.owner, key.repo, release, branches)
+		}
+	}
+
+	return results, errs
+}
+
+// listReleases lists the releases of a repository until all of ids are found,
+// for at most as many pages as there are ids. A single release is fetched on
+// its own instead.
+func listReleases(
+	ctx context.Context, ghCli *go_github.Client, owner, repo string, ids map[int64]int,
+) (map[int64]*go_github.RepositoryRelease, error) {
+	listed := make(map[int64]*go_github.RepositoryRelease, len(ids))
+	if len(ids) < 2 {
+		return listed, nil
+	}
+
+	opts := &go_github.ListOptions{PerPage: 100}
+	for pages := 0; pages < len(ids) && len(listed) < len(ids); pages++ {
+		releases, resp, err := ghCli.Repositories.ListReleases(ctx, owner, repo, opts)
+		if err != nil {
+			return listed, fmt.Errorf("failed to list releases: %w", err)
+		}
+		for _, release := range releases {
+			if _, ok := ids[release.GetID()]; ok {
+				listed[release.GetID()] = release
+			}
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return listed, nil
+}
+
 func getBranchAndCommit(
 	ctx context.Context,
 	owner string,
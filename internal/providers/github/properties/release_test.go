@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package properties
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	go_github "github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/pkg/entities/properties"
+	v1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+func TestFetchReleasesBatch(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/mindersec/minder/releases", func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		require.NoError(t, json.NewEncoder(w).Encode([]*go_github.RepositoryRelease{
+			{ID: go_github.Int64(1), TagName: go_github.String("v1"), TargetCommitish: go_github.String("main")},
+			{ID: go_github.Int64(2), TagName: go_github.String("v2"), TargetCommitish: go_github.String("main")},
+			{ID: go_github.Int64(3), TagName: go_github.String("v3"), TargetCommitish: go_github.String("main")},
+		}))
+	})
+	mux.HandleFunc("/repos/mindersec/minder/releases/4", func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/repos/mindersec/minder/branches/main", func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		require.NoError(t, json.NewEncoder(w).Encode(&go_github.Branch{
+			Name:   go_github.String("main"),
+			Commit: &go_github.RepositoryCommit{SHA: go_github.String("abc123")},
+		}))
+	})
+	mux.HandleFunc("/repos/mindersec/other/releases/5", func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		require.NoError(t, json.NewEncoder(w).Encode(&go_github.RepositoryRelease{
+			ID: go_github.Int64(5), TagName: go_github.String("v5"),
+		}))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	ghCli := go_github.NewClient(srv.Client())
+	baseURL, err := url.Parse(srv.URL + "/")
+	require.NoError(t, err)
+	ghCli.BaseURL = baseURL
+
+	lookup := func(repo string, id int64) *properties.Properties {
+		return properties.NewProperties(map[string]any{
+			properties.PropertyUpstreamID: properties.NumericalValueToUpstreamID(id),
+			ReleasePropertyOwner:          "mindersec",
+			ReleasePropertyRepo:           repo,
+		})
+	}
+	lookups := []*properties.Properties{
+		lookup("minder", 1),
+		lookup("minder", 3),
+		lookup("minder", 4),
+		lookup("other", 5),
+	}
+
+	results, errs := FetchReleasesBatch(context.Background(), ghCli, lookups)
+	require.Len(t, results, 4)
+	require.Len(t, errs, 4)
+
+	require.NoError(t, errs[0])
+	require.Equal(t, map[string]any{
+		properties.PropertyUpstreamID:    "1",
+		properties.PropertyName:          "mindersec/minder/v1",
+		ReleasePropertyOwner:             "mindersec",
+		ReleasePropertyRepo:              "minder",
+		properties.ReleasePropertyTag:    "v1",
+		properties.ReleaseCommitSHA:      "abc123",
+		properties.ReleasePropertyBranch: "main",
+	}, results[0])
+	require.NoError(t, errs[1])
+	require.Equal(t, "v3", results[1][properties.ReleasePropertyTag])
+
+	// a release missing from the listing is fetched on its own
+	require.ErrorIs(t, errs[2], v1.ErrEntityNotFound)
+
+	// a single release of a repository is fetched on its own
+	require.NoError(t, errs[3])
+	require.Equal(t, "v5", results[3][properties.ReleasePropertyTag])
+
+	// one listing, one branch and two single releases
+	require.Equal(t, int32(4), calls.Load())
+}
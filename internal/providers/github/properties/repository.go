@@ -35,6 +35,8 @@ const (
 	RepoPropertyLicense = "github/license"
 	// RepoPropertyPrimaryLanguage represents the github repository language
 	RepoPropertyPrimaryLanguage = "github/primary_language"
+	// RepoPropertyTopics represents the github repository topics
+	RepoPropertyTopics = "github/topics"
 
 	// RepoPropertyHookId represents the github repository hook ID
 	RepoPropertyHookId = "github/hook_id"
@@ -46,11 +48,15 @@ const (
 	RepoPropertyHookType = "github/hook_type"
 	// RepoPropertyHookUiid represents the github repository hook UIID
 	RepoPropertyHookUiid = "github/hook_uiid"
+	// RepoPropertyHookSecretFingerprint represents the fingerprint of the
+	// secret the github repository hook was configured with
+	RepoPropertyHookSecretFingerprint = "github/hook_secret_fingerprint"
 )
 
 var repoOperationalProperties = []string{
 	RepoPropertyHookId,
 	RepoPropertyHookUrl,
+	RepoPropertyHookSecretFingerprint,
 }
 
 var repoPropertyDefinitions = []propertyOrigin{
@@ -72,6 +78,7 @@ var repoPropertyDefinitions = []propertyOrigin{
 			RepoPropertyDefaultBranch,
 			RepoPropertyLicense,
 			RepoPropertyPrimaryLanguage,
+			RepoPropertyTopics,
 		},
 		wrapper: getRepoWrapper,
 	},
@@ -95,6 +102,7 @@ func GitHubRepoToMap(repo *go_github.Repository) map[string]any {
 		RepoPropertyDefaultBranch:   repo.GetDefaultBranch(),
 		RepoPropertyLicense:         repo.GetLicense().GetSPDXID(),
 		RepoPropertyPrimaryLanguage: repo.GetLanguage(),
+		RepoPropertyTopics:          topicsToList(repo.Topics),
 	}
 
 	repoProps[properties.PropertyName] = fmt.Sprintf("%s/%s", repo.GetOwner().GetLogin(), repo.GetName())
@@ -102,6 +110,16 @@ func GitHubRepoToMap(repo *go_github.Repository) map[string]any {
 	return repoProps
 }
 
+// topicsToList converts the topics of a repository to a list, which unlike
+// a slice of strings can be stored as a property
+func topicsToList(topics []string) []any {
+	list := make([]any, 0, len(topics))
+	for _, topic := range topics {
+		list = append(list, topic)
+	}
+	return list
+}
+
 func getRepoWrapper(
 	ctx context.Context, ghCli *go_github.Client, isOrg bool, getByProps *properties.Properties,
 ) (map[string]any, error) {
@@ -38,6 +38,7 @@ import (
 	"github.com/mindersec/minder/internal/providers/github/clients"
 	mockclients "github.com/mindersec/minder/internal/providers/github/clients/mock"
 	mockgh "github.com/mindersec/minder/internal/providers/github/mock"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	"github.com/mindersec/minder/internal/providers/telemetry"
 	"github.com/mindersec/minder/internal/util/rand"
 	"github.com/mindersec/minder/pkg/config/server"
@@ -81,7 +82,7 @@ func testNewGitHubProviderService(
 	packageListingClient.BaseURL = testServerUrl
 
 	if ghClientFactory == nil {
-		ghClientFactory = clients.NewGitHubClientFactory(telemetry.NewNoopMetrics())
+		ghClientFactory = clients.NewGitHubClientFactory(telemetry.NewNoopMetrics(), &ratebudget.NoopTracker{})
 	}
 
 	psi := NewGithubProviderService(
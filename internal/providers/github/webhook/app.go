@@ -89,6 +89,30 @@ func (i *installationRepositoriesEvent) GetInstallation() *installation {
 	return i.Installation
 }
 
+// appRepositoryEvent are "repository" events delivered to the GitHub
+// App, which, unlike those delivered to repository webhooks, include
+// repositories which were just created.
+type appRepositoryEvent struct {
+	Action       *string       `json:"action,omitempty"`
+	Repo         *repo         `json:"repository,omitempty"`
+	Installation *installation `json:"installation,omitempty"`
+}
+
+func (r *appRepositoryEvent) GetAction() string {
+	if r.Action != nil {
+		return *r.Action
+	}
+	return ""
+}
+
+func (r *appRepositoryEvent) GetRepo() *repo {
+	return r.Repo
+}
+
+func (r *appRepositoryEvent) GetInstallation() *installation {
+	return r.Installation
+}
+
 type installation struct {
 	ID *int64 `json:"id,omitempty"`
 }
@@ -161,6 +185,9 @@ func HandleGitHubAppWebhook(
 		case "installation_repositories":
 			wes.Accepted = true
 			results, processingErr = processInstallationRepositoriesAppEvent(ctx, store, rawWBPayload)
+		case "repository":
+			wes.Accepted = true
+			results, processingErr = processRepositoryAppEvent(ctx, store, rawWBPayload)
 		default:
 			l.Info().Msgf("webhook event %s not handled", wes.Typ)
 		}
@@ -281,48 +308,20 @@ func processInstallationRepositoriesAppEvent(
 		return nil, errors.New("invalid installation: id is 0")
 	}
 
-	installationID := event.GetInstallation().GetID()
-	installation, err := store.GetInstallationIDByAppID(ctx, installationID)
-	if errors.Is(err, sql.ErrNoRows) {
-		return nil, fmt.Errorf("no installation found for id %d", installationID)
-	}
-	if err != nil {
-		return nil, fmt.Errorf("could not determine provider id: %v", err)
-	}
-	if !installation.ProviderID.Valid {
-		return nil, errors.New("invalid provider id")
-	}
-	if !installation.ProjectID.Valid {
-		return nil, errors.New("invalid project id")
-	}
-
-	dbProv, err := store.GetProviderByID(ctx, installation.ProviderID.UUID)
+	installation, topic, err := repositoryAddedTopic(ctx, store, event.GetInstallation().GetID())
 	if err != nil {
-		return nil, fmt.Errorf("could not determine provider id: %v", err)
-	}
-
-	providerConfig, _, err := clients.ParseAndMergeV1AppConfig(dbProv.Definition)
-	if err != nil {
-		return nil, fmt.Errorf("could not parse provider config: %v", err)
-	}
-
-	addedRepos := make([]*repo, 0)
-	autoRegEntities := providerConfig.GetAutoRegistration().GetEntities()
-	repoAutoReg, ok := autoRegEntities[string(pb.RepositoryEntity)]
-	if ok && repoAutoReg.GetEnabled() {
-		addedRepos = event.GetRepositoriesAdded()
-	} else {
-		zerolog.Ctx(ctx).Info().Msg("auto-registration is disabled for repositories")
+		return nil, err
 	}
 
 	results := make([]*processingResult, 0)
-	for _, repo := range addedRepos {
+	for _, repo := range event.GetRepositoriesAdded() {
 		// caveat: we're accessing the database once for every
 		// repository, which might be inefficient at scale.
 		res, err := repositoryAdded(
 			ctx,
 			repo,
 			installation,
+			topic,
 		)
 		if err != nil {
 			return nil, err
@@ -343,6 +342,95 @@ func processInstallationRepositoriesAppEvent(
 	return results, nil
 }
 
+// processRepositoryAppEvent processes "repository" events delivered
+// to the GitHub App. Only the creation of repositories is handled
+// here, as events related to registered repositories are delivered
+// to their webhooks as well.
+func processRepositoryAppEvent(
+	ctx context.Context,
+	store db.Store,
+	payload []byte,
+) ([]*processingResult, error) {
+	var event *appRepositoryEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+
+	// Check fields mandatory for processing the event
+	if event.GetAction() == "" {
+		return nil, errors.New("invalid event: action is nil")
+	}
+	if event.GetAction() != webhookActionEventCreated {
+		return nil, newErrNotHandled(`event "repository" with action %s not handled`,
+			event.GetAction(),
+		)
+	}
+	if event.GetRepo() == nil {
+		return nil, errors.New("invalid event: repository is nil")
+	}
+	if event.GetInstallation() == nil {
+		return nil, errors.New("invalid event: installation is nil")
+	}
+	if event.GetInstallation().GetID() == 0 {
+		return nil, errors.New("invalid installation: id is 0")
+	}
+
+	installation, topic, err := repositoryAddedTopic(ctx, store, event.GetInstallation().GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := repositoryAdded(ctx, event.GetRepo(), installation, topic)
+	if err != nil {
+		return nil, err
+	}
+	return []*processingResult{res}, nil
+}
+
+// repositoryAddedTopic determines the installation and the topic
+// repositories made available to the given installation should be
+// sent to. When auto-registration is enabled for the whole provider,
+// repositories are registered unconditionally, otherwise they are
+// matched against the auto-registration rules of the project.
+func repositoryAddedTopic(
+	ctx context.Context,
+	store db.Store,
+	installationID int64,
+) (db.ProviderGithubAppInstallation, string, error) {
+	installation, err := store.GetInstallationIDByAppID(ctx, installationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return installation, "", fmt.Errorf("no installation found for id %d", installationID)
+	}
+	if err != nil {
+		return installation, "", fmt.Errorf("could not determine provider id: %v", err)
+	}
+	if !installation.ProviderID.Valid {
+		return installation, "", errors.New("invalid provider id")
+	}
+	if !installation.ProjectID.Valid {
+		return installation, "", errors.New("invalid project id")
+	}
+
+	dbProv, err := store.GetProviderByID(ctx, installation.ProviderID.UUID)
+	if err != nil {
+		return installation, "", fmt.Errorf("could not determine provider id: %v", err)
+	}
+
+	providerConfig, _, err := clients.ParseAndMergeV1AppConfig(dbProv.Definition)
+	if err != nil {
+		return installation, "", fmt.Errorf("could not parse provider config: %v", err)
+	}
+
+	autoRegEntities := providerConfig.GetAutoRegistration().GetEntities()
+	repoAutoReg, ok := autoRegEntities[string(pb.RepositoryEntity)]
+	if ok && repoAutoReg.GetEnabled() {
+		return installation, constants.TopicQueueReconcileEntityAdd, nil
+	}
+
+	zerolog.Ctx(ctx).Info().Msg("auto-registration is disabled for repositories, applying rules")
+	return installation, constants.TopicQueueAutoRegisterEntity, nil
+}
+
 func repositoryRemoved(repo *repo) *processingResult {
 	return sendEvaluateRepoMessage(repo, constants.TopicQueueGetEntityAndDelete)
 }
@@ -351,6 +439,7 @@ func repositoryAdded(
 	_ context.Context,
 	repo *repo,
 	installation db.ProviderGithubAppInstallation,
+	topic string,
 ) (*processingResult, error) {
 	if repo.GetName() == "" {
 		return nil, errors.New("invalid repository name")
@@ -368,7 +457,7 @@ func repositoryAdded(
 		WithProperties(addRepoProps)
 
 	return &processingResult{
-		topic:   constants.TopicQueueReconcileEntityAdd,
+		topic:   topic,
 		wrapper: event,
 	}, nil
 }
@@ -332,6 +332,75 @@ func (s *UnitTestSuite) TestHandleWebHookRepository() {
 	require.Equal(t, http.StatusBadRequest, resp.StatusCode, "unexpected status code")
 }
 
+func (s *UnitTestSuite) TestHandleWebHookRepositoryOnPropertyChange() {
+	t := s.T()
+	t.Parallel()
+
+	evt, err := eventer.New(context.Background(), nil, &serverconfig.EventConfig{
+		Driver:    "go-channel",
+		GoChannel: serverconfig.GoChannelEventConfig{},
+	})
+	require.NoError(t, err, "failed to setup eventer")
+	defer evt.Close()
+
+	pq := testqueue.NewPassthroughQueue(t)
+	queued := pq.GetQueue()
+
+	evt.Register(constants.TopicQueueRefreshEntityAndEvaluate, pq.Pass)
+
+	go func() {
+		err := evt.Run(context.Background())
+		require.NoError(t, err, "failed to run eventer")
+	}()
+
+	<-evt.Running()
+
+	cfg := &serverconfig.WebhookConfig{
+		EvaluateOnPropertyChange: map[string][]string{
+			"repository": {properties.RepoPropertyIsPrivate, properties.RepoPropertyIsArchived},
+		},
+	}
+	cfg.WebhookSecret = "test"
+
+	handler := HandleWebhookEvent(metrics.NewNoopMetrics(), evt, cfg)
+	ts := httptest.NewServer(handler)
+	defer ts.Close()
+
+	event := github.RepositoryEvent{
+		Action: github.String("edited"),
+		Repo: newGitHubRepo(
+			12345,
+			"minder",
+			"mindersec/minder",
+			"https://github.com/mindersec/minder",
+		),
+	}
+	payload, err := json.Marshal(event)
+	require.NoError(t, err, "failed to marshal repository event")
+
+	req, err := http.NewRequest("POST", ts.URL, bytes.NewBuffer(payload))
+	require.NoError(t, err, "failed to create request")
+
+	req.Header.Add("X-GitHub-Event", "repository")
+	req.Header.Add("X-GitHub-Delivery", "12345")
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-Hub-Signature-256", fmt.Sprintf("sha256=%s", sign(payload, "test")))
+
+	resp, err := ts.Client().Do(req)
+	require.NoError(t, err, "failed to make request")
+	require.Equal(t, http.StatusOK, resp.StatusCode, "unexpected status code")
+
+	received := <-queued
+
+	var inner entMsg.HandleEntityAndDoMessage
+	err = json.Unmarshal(received.Payload, &inner)
+	require.NoError(t, err)
+	require.Equal(t, "12345", inner.Entity.GetByProps[properties.PropertyUpstreamID])
+	require.Equal(t,
+		[]string{properties.RepoPropertyIsPrivate, properties.RepoPropertyIsArchived},
+		inner.OnPropertyChange)
+}
+
 // We should ignore events from packages from repositories that are not registered
 func (s *UnitTestSuite) TestHandleWebHookUnexistentRepoPackage() {
 	t := s.T()
@@ -1145,6 +1214,66 @@ func (s *UnitTestSuite) TestHandleGitHubWebHook() {
 				require.Nil(t, received)
 			},
 		},
+		{
+			name: "dependabot_alert",
+			// https://docs.github.com/en/webhooks/webhook-events-and-payloads#dependabot_alert
+			event: "dependabot_alert",
+			// https://pkg.go.dev/github.com/google/go-github/v63@v63.0.0/github#DependabotAlertEvent
+			payload: &github.DependabotAlertEvent{
+				Action: github.String("created"),
+				Repo: newGitHubRepo(
+					12345,
+					"minder",
+					"mindersec/minder",
+					"https://github.com/mindersec/minder",
+				),
+			},
+			topic:      constants.TopicQueueRefreshEntityAndEvaluate,
+			statusCode: http.StatusOK,
+			queued: func(t *testing.T, event string, ch <-chan *message.Message) {
+				t.Helper()
+				received := withTimeout(ch, timeout)
+				require.NotNilf(t, received, "no event received after waiting %s", timeout)
+				require.Equal(t, "12345", received.Metadata["id"])
+				require.Equal(t, event, received.Metadata["type"])
+				require.Equal(t, "https://api.github.com/", received.Metadata["source"])
+
+				received = withTimeout(ch, timeout)
+				require.Nil(t, received)
+			},
+		},
+		{
+			name: "repository_ruleset edited",
+			// https://docs.github.com/en/webhooks/webhook-events-and-payloads#repository_ruleset
+			event: "repository_ruleset",
+			payload: map[string]any{
+				"action": "edited",
+				"repository": newGitHubRepo(
+					12345,
+					"minder",
+					"mindersec/minder",
+					"https://github.com/mindersec/minder",
+				),
+				"repository_ruleset": &github.Ruleset{
+					ID:          github.Int64(42),
+					Name:        "minder",
+					Enforcement: "active",
+				},
+			},
+			topic:      constants.TopicQueueRefreshEntityAndEvaluate,
+			statusCode: http.StatusOK,
+			queued: func(t *testing.T, event string, ch <-chan *message.Message) {
+				t.Helper()
+				received := withTimeout(ch, timeout)
+				require.NotNilf(t, received, "no event received after waiting %s", timeout)
+				require.Equal(t, "12345", received.Metadata["id"])
+				require.Equal(t, event, received.Metadata["type"])
+				require.Equal(t, "https://api.github.com/", received.Metadata["source"])
+
+				received = withTimeout(ch, timeout)
+				require.Nil(t, received)
+			},
+		},
 		{
 			name: "create",
 			// https://docs.github.com/en/webhooks/webhook-events-and-payloads#create
@@ -3126,10 +3255,28 @@ func (s *UnitTestSuite) TestHandleGitHubAppWebHook() {
 					},
 					54321),
 			),
-			topic:      constants.TopicQueueReconcileEntityAdd,
+			topic:      constants.TopicQueueAutoRegisterEntity,
 			statusCode: http.StatusOK,
-			//nolint:thelper
-			queued: nil,
+			queued: func(t *testing.T, event string, ch <-chan *message.Message) {
+				t.Helper()
+
+				for range 2 {
+					var evt messages.MinderEvent
+
+					received := withTimeout(ch, timeout)
+					require.NotNilf(t, received, "no event received after waiting %s", timeout)
+					require.Equal(t, event, received.Metadata["type"])
+
+					err := json.Unmarshal(received.Payload, &evt)
+					require.NoError(t, err)
+					require.Equal(t, providerID, evt.ProviderID)
+					require.Equal(t, projectID, evt.ProjectID)
+					require.Contains(t, []string{"mindersec/minder", "stacklok/trusty"}, evt.Properties[properties.PropertyName])
+				}
+
+				received := withTimeout(ch, timeout)
+				require.Nil(t, received)
+			},
 		},
 		{
 			name: "installation_repositories removed",
@@ -3219,6 +3366,87 @@ func (s *UnitTestSuite) TestHandleGitHubAppWebHook() {
 			},
 		},
 
+		// repository events
+		{
+			name: "repository created",
+			// https://docs.github.com/en/webhooks/webhook-events-and-payloads#repository
+			event: "repository",
+			// https://pkg.go.dev/github.com/google/go-github/v62@v62.0.0/github#RepositoryEvent
+			payload: &github.RepositoryEvent{
+				Action: github.String("created"),
+				Repo: newGitHubRepo(
+					12345,
+					"minder",
+					"mindersec/minder",
+					"https://github.com/mindersec/minder",
+				),
+				Installation: &github.Installation{
+					ID: github.Int64(54321),
+				},
+			},
+			mockStoreFunc: df.NewMockStore(
+				df.WithSuccessfulGetProviderByID(
+					db.Provider{
+						ID:         providerID,
+						Definition: json.RawMessage(autoregConfigDisabled),
+					},
+					providerID,
+				),
+				df.WithSuccessfulGetInstallationIDByAppID(
+					db.ProviderGithubAppInstallation{
+						ProjectID: uuid.NullUUID{
+							UUID:  projectID,
+							Valid: true,
+						},
+						ProviderID: uuid.NullUUID{
+							UUID:  providerID,
+							Valid: true,
+						},
+					},
+					54321),
+			),
+			topic:      constants.TopicQueueAutoRegisterEntity,
+			statusCode: http.StatusOK,
+			queued: func(t *testing.T, event string, ch <-chan *message.Message) {
+				t.Helper()
+
+				var evt messages.MinderEvent
+
+				received := withTimeout(ch, timeout)
+				require.NotNilf(t, received, "no event received after waiting %s", timeout)
+				require.Equal(t, event, received.Metadata["type"])
+
+				err := json.Unmarshal(received.Payload, &evt)
+				require.NoError(t, err)
+				require.Equal(t, providerID, evt.ProviderID)
+				require.Equal(t, projectID, evt.ProjectID)
+				require.Equal(t, "mindersec/minder", evt.Properties[properties.PropertyName])
+
+				received = withTimeout(ch, timeout)
+				require.Nil(t, received)
+			},
+		},
+		{
+			name: "repository archived",
+			// https://docs.github.com/en/webhooks/webhook-events-and-payloads#repository
+			event: "repository",
+			// https://pkg.go.dev/github.com/google/go-github/v62@v62.0.0/github#RepositoryEvent
+			payload: &github.RepositoryEvent{
+				Action: github.String("archived"),
+				Repo: newGitHubRepo(
+					12345,
+					"minder",
+					"mindersec/minder",
+					"https://github.com/mindersec/minder",
+				),
+				Installation: &github.Installation{
+					ID: github.Int64(54321),
+				},
+			},
+			topic:      constants.TopicQueueAutoRegisterEntity,
+			statusCode: http.StatusOK,
+		},
+
 		// garbage
 		{
 			name:  "garbage",
@@ -30,6 +30,7 @@ import (
 )
 
 const (
+	webhookActionEventCreated     = "created"
 	webhookActionEventDeleted     = "deleted"
 	webhookActionEventOpened      = "opened"
 	webhookActionEventReopened    = "reopened"
@@ -137,6 +138,7 @@ func HandleWebhookEvent(
 			"branch_protection_rule",
 			"code_scanning_alert",
 			"create",
+			"dependabot_alert",
 			"member",
 			"public",
 			"push",
@@ -186,6 +188,7 @@ func HandleWebhookEvent(
 
 		// res is null only when a ping event occurred.
 		if res != nil && res.wrapper != nil {
+			restrictToPropertyChanges(res, wes.Typ, whconfig)
 			if err := res.wrapper.ToMessage(m); err != nil {
 				wes.Error = true
 				l.Error().Err(err).Msg("Error creating event")
@@ -213,6 +216,21 @@ func HandleWebhookEvent(
 	})
 }
 
+// restrictToPropertyChanges makes refreshing the entity only trigger an
+// evaluation if one of the properties configured for the event type changed
+func restrictToPropertyChanges(res *processingResult, eventType string, whconfig *server.WebhookConfig) {
+	if whconfig == nil || res.topic != constants.TopicQueueRefreshEntityAndEvaluate {
+		return
+	}
+	msg, ok := res.wrapper.(*entMsg.HandleEntityAndDoMessage)
+	if !ok {
+		return
+	}
+	if keys := whconfig.EvaluateOnPropertyChange[eventType]; len(keys) > 0 {
+		msg.WithOnPropertyChange(keys...)
+	}
+}
+
 func validatePayloadSignature(r *http.Request, wc *server.WebhookConfig) (payload []byte, err error) {
 	var br *bytes.Reader
 	br, err = readerFromRequest(r)
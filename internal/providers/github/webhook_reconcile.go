@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/google/go-github/v63/github"
+
+	"github.com/mindersec/minder/internal/db"
+	ghprop "github.com/mindersec/minder/internal/providers/github/properties"
+	"github.com/mindersec/minder/internal/util/ptr"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	config "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+var _ provifv1.WebhookReconciler = (*GitHub)(nil)
+
+// ReconcileWebhook implements the WebhookReconciler interface. GitHub does
+// not return the secret of a hook, only whether one is set, so the
+// fingerprint of the secret the hook was last configured with is kept in a
+// property and the current secret is sent again whenever it changed.
+func (c *GitHub) ReconcileWebhook(
+	ctx context.Context, entType minderv1.Entity, props *properties.Properties,
+) (*provifv1.WebhookReconcileResult, error) {
+	if entType != minderv1.Entity_ENTITY_REPOSITORIES {
+		return &provifv1.WebhookReconcileResult{}, nil
+	}
+
+	hookID := props.GetProperty(ghprop.RepoPropertyHookId).GetInt64()
+	hookUUID := props.GetProperty(ghprop.RepoPropertyHookUiid).GetString()
+	if hookID == 0 || hookUUID == "" {
+		return &provifv1.WebhookReconcileResult{}, nil
+	}
+
+	repoName := props.GetProperty(ghprop.RepoPropertyName).GetString()
+	repoOwner := props.GetProperty(ghprop.RepoPropertyOwner).GetString()
+	if repoName == "" || repoOwner == "" {
+		return nil, errors.New("repo name or owner property not found")
+	}
+
+	webhookURL, err := url.JoinPath(
+		c.webhookConfig.ExternalWebhookURL,
+		url.PathEscape(string(db.ProviderTypeGithub)),
+		hookUUID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error joining webhook URL: %w", err)
+	}
+	secret, err := c.webhookConfig.GetWebhookSecret()
+	if err != nil {
+		return nil, fmt.Errorf("error getting webhook secret: %w", err)
+	}
+	expected := getGitHubWebhook(webhookURL, c.webhookConfig.ExternalPingURL, secret)
+	expected.Active = ptr.Ptr(true)
+	fingerprint := config.WebhookSecretFingerprint(secret)
+
+	// Only a hook GitHub doesn't know about is missing, any other error
+	// leaves the hook alone until the next reconciliation.
+	hook, err := c.getHook(ctx, repoOwner, repoName, hookID)
+	if errors.Is(err, ErrNotFound) {
+		created, err := c.CreateHook(ctx, repoOwner, repoName, expected)
+		if err != nil {
+			return nil, fmt.Errorf("error creating hook: %w", err)
+		}
+		return &provifv1.WebhookReconcileResult{
+			Repaired: []string{"missing"},
+			Properties: properties.NewProperties(map[string]any{
+				ghprop.RepoPropertyHookId:                created.GetID(),
+				ghprop.RepoPropertyHookUrl:               created.GetURL(),
+				ghprop.RepoPropertyHookName:              created.GetName(),
+				ghprop.RepoPropertyHookType:              created.GetType(),
+				ghprop.RepoPropertyHookSecretFingerprint: fingerprint,
+			}),
+		}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error getting hook: %w", err)
+	}
+
+	secretCurrent := props.GetProperty(ghprop.RepoPropertyHookSecretFingerprint).GetString() == fingerprint
+	drift := githubHookDrift(hook, expected, secretCurrent)
+	if len(drift) == 0 {
+		return &provifv1.WebhookReconcileResult{}, nil
+	}
+
+	if _, err := c.EditHook(ctx, repoOwner, repoName, hookID, expected); err != nil {
+		return nil, fmt.Errorf("error editing hook: %w", err)
+	}
+
+	res := &provifv1.WebhookReconcileResult{Repaired: drift}
+	if !secretCurrent {
+		res.Properties = properties.NewProperties(map[string]any{
+			ghprop.RepoPropertyHookSecretFingerprint: fingerprint,
+		})
+	}
+	return res, nil
+}
+
+// getHook returns the hook with the given ID, or ErrNotFound if the
+// repository has no such hook
+func (c *GitHub) getHook(ctx context.Context, owner, repo string, id int64) (*github.Hook, error) {
+	hook, resp, err := c.client.Repositories.GetHook(ctx, owner, repo, id)
+	if err != nil && resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("hook %d not found for repository %s/%s: %w", id, owner, repo, ErrNotFound)
+	}
+	return hook, err
+}
+
+// githubHookDrift returns the settings of hook which differ from expected.
+// The secret drifted if the hook has none or was configured with a previous
+// one.
+func githubHookDrift(hook, expected *github.Hook, secretCurrent bool) []string {
+	var drift []string
+	if hook.GetConfig().GetURL() != expected.GetConfig().GetURL() {
+		drift = append(drift, "url")
+	}
+	if hook.GetConfig().GetContentType() != expected.GetConfig().GetContentType() {
+		drift = append(drift, "content_type")
+	}
+	if hook.GetConfig().GetSecret() == "" || !secretCurrent {
+		drift = append(drift, "secret")
+	}
+	events := slices.Clone(hook.Events)
+	slices.Sort(events)
+	if !slices.Equal(events, expected.Events) {
+		drift = append(drift, "events")
+	}
+	if !hook.GetActive() {
+		drift = append(drift, "active")
+	}
+	return drift
+}
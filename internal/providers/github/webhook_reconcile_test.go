@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/v63/github"
+	"github.com/stretchr/testify/require"
+
+	ghprop "github.com/mindersec/minder/internal/providers/github/properties"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	config "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+func TestReconcileWebhook(t *testing.T) {
+	t.Parallel()
+
+	const hookUUID = "5c1e1c4a-4a9b-4b8e-8f4c-2c1d2f3a4b5c"
+	hookURL := "https://minder.example.com/api/v1/webhook/github/" + hookUUID
+	syncedHook := &github.Hook{
+		ID: github.Int64(1),
+		Config: &github.HookConfig{
+			URL:         github.String(hookURL),
+			ContentType: github.String("json"),
+			Secret:      github.String("********"),
+		},
+		Events: []string{"*"},
+		Active: github.Bool(true),
+	}
+	currentFingerprint := config.WebhookSecretFingerprint("secret")
+
+	tests := []struct {
+		name          string
+		hook          *github.Hook
+		fingerprint   string
+		getStatus     int
+		wantErr       bool
+		wantRepaired  []string
+		wantMethod    string
+		wantNewHookID int64
+	}{
+		{
+			name:        "hook in sync",
+			hook:        syncedHook,
+			fingerprint: currentFingerprint,
+		},
+		{
+			name:         "hook with a previous secret is edited",
+			hook:         syncedHook,
+			fingerprint:  config.WebhookSecretFingerprint("previous-secret"),
+			wantRepaired: []string{"secret"},
+			wantMethod:   http.MethodPatch,
+		},
+		{
+			name:        "drifted hook is edited",
+			fingerprint: currentFingerprint,
+			hook: &github.Hook{
+				ID: github.Int64(1),
+				Config: &github.HookConfig{
+					URL:         github.String("https://old.example.com/api/v1/webhook/github/" + hookUUID),
+					ContentType: github.String("json"),
+				},
+				Events: []string{"push"},
+				Active: github.Bool(false),
+			},
+			wantRepaired: []string{"url", "secret", "events", "active"},
+			wantMethod:   http.MethodPatch,
+		},
+		{
+			name:          "missing hook is created",
+			getStatus:     http.StatusNotFound,
+			wantRepaired:  []string{"missing"},
+			wantMethod:    http.MethodPost,
+			wantNewHookID: 3,
+		},
+		{
+			name:      "hook which cannot be fetched is left alone",
+			getStatus: http.StatusInternalServerError,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotMethod string
+			var gotHook github.Hook
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodGet {
+					require.Equal(t, "/repos/owner/repo/hooks/1", r.URL.Path)
+					if tt.getStatus != 0 {
+						w.WriteHeader(tt.getStatus)
+						return
+					}
+					require.NoError(t, json.NewEncoder(w).Encode(tt.hook))
+					return
+				}
+				gotMethod = r.Method
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&gotHook))
+				gotHook.ID = github.Int64(tt.wantNewHookID)
+				require.NoError(t, json.NewEncoder(w).Encode(gotHook))
+			}))
+			t.Cleanup(srv.Close)
+
+			baseURL, err := url.Parse(srv.URL + "/")
+			require.NoError(t, err)
+			client := github.NewClient(nil)
+			client.BaseURL = baseURL
+
+			gh := &GitHub{
+				client: client,
+				webhookConfig: &config.WebhookConfig{
+					ExternalWebhookURL: "https://minder.example.com/api/v1/webhook/",
+					ExternalPingURL:    "https://minder.example.com/api/v1/ping",
+					WebhookSecrets:     config.WebhookSecrets{WebhookSecret: "secret"},
+				},
+			}
+
+			props := properties.NewProperties(map[string]any{
+				ghprop.RepoPropertyOwner:                 "owner",
+				ghprop.RepoPropertyName:                  "repo",
+				ghprop.RepoPropertyHookId:                int64(1),
+				ghprop.RepoPropertyHookUiid:              hookUUID,
+				ghprop.RepoPropertyHookSecretFingerprint: tt.fingerprint,
+			})
+
+			res, err := gh.ReconcileWebhook(context.Background(), minderv1.Entity_ENTITY_REPOSITORIES, props)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Empty(t, gotMethod)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantRepaired, res.Repaired)
+			require.Equal(t, tt.wantMethod, gotMethod)
+
+			if tt.wantMethod != "" {
+				require.Equal(t, hookURL, gotHook.GetConfig().GetURL())
+				require.Equal(t, "secret", gotHook.GetConfig().GetSecret())
+				require.Equal(t, []string{"*"}, gotHook.Events)
+				require.True(t, gotHook.GetActive())
+			}
+			if tt.wantNewHookID != 0 {
+				require.Equal(t, tt.wantNewHookID, res.Properties.GetProperty(ghprop.RepoPropertyHookId).GetInt64())
+			}
+			if tt.fingerprint != currentFingerprint {
+				require.Equal(t, currentFingerprint,
+					res.Properties.GetProperty(ghprop.RepoPropertyHookSecretFingerprint).GetString())
+			} else {
+				require.Nil(t, res.Properties)
+			}
+		})
+	}
+}
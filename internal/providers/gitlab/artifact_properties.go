@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	gitlablib "gitlab.com/gitlab-org/api/client-go"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// artifactTypeContainer is the only type of artifact the gitlab
+// container registry holds
+const artifactTypeContainer = "container"
+
+// FormatArtifactUpstreamID returns the upstream ID for a gitlab container registry repository
+// This is done so we don't have to deal with conversions in the provider
+// when dealing with entities
+func FormatArtifactUpstreamID(id int) string {
+	return fmt.Sprintf("%d", id)
+}
+
+// getPropertiesForArtifact fetches the properties of a container registry
+// repository of a project. The registry repository is looked up either by
+// its upstream ID or by its registry path, as registry push events only
+// carry the latter.
+func (c *gitlabClient) getPropertiesForArtifact(
+	ctx context.Context, getByProps *properties.Properties,
+) (*properties.Properties, error) {
+	pid, err := getByProps.GetProperty(ArtifactPropertyProjectID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("project ID not found or invalid: %w", err)
+	}
+
+	uid := getByProps.GetProperty(properties.PropertyUpstreamID).GetString()
+	registryPath := getByProps.GetProperty(ArtifactPropertyRegistryPath).GetString()
+	if uid == "" && registryPath == "" {
+		return nil, fmt.Errorf("either upstream ID or registry path is required")
+	}
+
+	repos, err := c.listRegistryRepositories(ctx, "projects", pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list registry repositories: %w", err)
+	}
+
+	var repo *gitlablib.RegistryRepository
+	for _, r := range repos {
+		if FormatArtifactUpstreamID(r.ID) == uid || (uid == "" && r.Path == registryPath) {
+			repo = r
+			break
+		}
+	}
+
+	if repo == nil {
+		return nil, provifv1.ErrEntityNotFound
+	}
+
+	proj, err := c.getGitLabProject(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	return gitlabRegistryRepositoryToProperties(repo, proj)
+}
+
+// listRegistryRepositories lists the container registry repositories of
+// a project or a group, depending on the given kind.
+func (c *gitlabClient) listRegistryRepositories(
+	ctx context.Context, kind string, id string,
+) ([]*gitlablib.RegistryRepository, error) {
+	reposPath, err := url.JoinPath(kind, url.PathEscape(id), "registry", "repositories")
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for registry repositories: %w", err)
+	}
+
+	var out []*gitlablib.RegistryRepository
+	for page := 1; ; page++ {
+		var repos []*gitlablib.RegistryRepository
+		pagePath := fmt.Sprintf("%s?per_page=%d&page=%d", reposPath, registryPageSize, page)
+		if err := glRESTGet(ctx, c, pagePath, &repos); err != nil {
+			return nil, err
+		}
+
+		out = append(out, repos...)
+		if len(repos) < registryPageSize {
+			return out, nil
+		}
+	}
+}
+
+func gitlabRegistryRepositoryToProperties(
+	repo *gitlablib.RegistryRepository, proj *gitlablib.Project,
+) (*properties.Properties, error) {
+	ns, err := getGitlabProjectNamespace(proj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace: %w", err)
+	}
+
+	var createdAt string
+	if repo.CreatedAt != nil {
+		createdAt = repo.CreatedAt.Format(time.RFC3339)
+	}
+
+	return properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID:   FormatArtifactUpstreamID(repo.ID),
+		properties.PropertyName:         repo.Path,
+		properties.ArtifactPropertyType: artifactTypeContainer,
+		ArtifactPropertyProjectID:       FormatRepositoryUpstreamID(proj.ID),
+		ArtifactPropertyRegistryPath:    repo.Path,
+		ArtifactPropertyLocation:        repo.Location,
+		ArtifactPropertyCreatedAt:       createdAt,
+		ArtifactPropertyVisibility:      string(proj.Visibility),
+		ArtifactPropertyRepo:            proj.PathWithNamespace,
+		RepoPropertyNamespace:           ns,
+		RepoPropertyProjectName:         proj.Name,
+	}), nil
+}
+
+// registryPathFromLocation strips the registry host from an image location,
+// e.g. registry.gitlab.com/group/project becomes group/project
+func registryPathFromLocation(location string) string {
+	_, registryPath, _ := strings.Cut(location, "/")
+	return registryPath
+}
+
+func artifactV1FromProperties(props *properties.Properties) (*minderv1.Artifact, error) {
+	upstreamID, err := getStringProp(props, properties.PropertyUpstreamID)
+	if err != nil {
+		return nil, err
+	}
+
+	registryPath, err := getStringProp(props, ArtifactPropertyRegistryPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var createdAt *timestamppb.Timestamp
+	if rawCreatedAt := props.GetProperty(ArtifactPropertyCreatedAt).GetString(); rawCreatedAt != "" {
+		parsedTime, err := time.Parse(time.RFC3339, rawCreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse created_at time: %w", err)
+		}
+		createdAt = timestamppb.New(parsedTime)
+	}
+
+	// The registry path is split so that the verifier can put together
+	// the image reference as registry/owner/name.
+	return &minderv1.Artifact{
+		ArtifactPk: upstreamID,
+		Owner:      path.Dir(registryPath),
+		Name:       path.Base(registryPath),
+		Type:       props.GetProperty(properties.ArtifactPropertyType).GetString(),
+		Repository: props.GetProperty(ArtifactPropertyRepo).GetString(),
+		Visibility: props.GetProperty(ArtifactPropertyVisibility).GetString(),
+		CreatedAt:  createdAt,
+	}, nil
+}
+
+func getArtifactNameFromProperties(props *properties.Properties) (string, error) {
+	return getStringProp(props, ArtifactPropertyRegistryPath)
+}
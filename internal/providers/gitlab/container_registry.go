@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/rs/zerolog"
+	gitlablib "gitlab.com/gitlab-org/api/client-go"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+const (
+	// defaultRegistry is the host of the container registry of gitlab.com
+	defaultRegistry = "registry.gitlab.com"
+
+	// registryPageSize is the number of items to request per page
+	// from the container registry API
+	registryPageSize = 100
+
+	// registryOAuth2Username is the username GitLab expects when logging
+	// into the container registry with an OAuth2 token. Access tokens
+	// are accepted with any username.
+	registryOAuth2Username = "oauth2"
+)
+
+// GetRegistry implements the ContainerRegistry interface
+func (c *gitlabClient) GetRegistry() string {
+	if c.glcfg.Registry == "" {
+		return defaultRegistry
+	}
+	return c.glcfg.Registry
+}
+
+// GetAuthenticator implements the ContainerRegistry interface
+func (c *gitlabClient) GetAuthenticator() (authn.Authenticator, error) {
+	t, err := c.cred.GetAsOAuth2TokenSource().Token()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token: %w", err)
+	}
+
+	return &authn.Basic{
+		Username: registryOAuth2Username,
+		Password: t.AccessToken,
+	}, nil
+}
+
+// GetNamespaceURL implements the ImageLister interface
+func (c *gitlabClient) GetNamespaceURL() string {
+	if c.glcfg.Group == "" {
+		return c.GetRegistry()
+	}
+	return path.Join(c.GetRegistry(), c.glcfg.Group)
+}
+
+// ListImages implements the ImageLister interface. It lists the paths of
+// the container registry repositories of the configured group, or of all
+// the groups the credential has access to if no group is configured.
+func (c *gitlabClient) ListImages(ctx context.Context) ([]string, error) {
+	groups := []string{c.glcfg.Group}
+	if c.glcfg.Group == "" {
+		var err error
+		groups, err = c.listGroups(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get groups: %w", err)
+		}
+	}
+
+	var images []string
+	for _, g := range groups {
+		repos, err := c.listRegistryRepositories(ctx, "groups", g)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list registry repositories for group %s: %w", g, err)
+		}
+
+		for _, r := range repos {
+			images = append(images, r.Path)
+		}
+	}
+
+	return images, nil
+}
+
+// listGroups lists the IDs of all the groups the credential has access to
+func (c *gitlabClient) listGroups(ctx context.Context) ([]string, error) {
+	var out []string
+	for page := 1; ; page++ {
+		var groups []*gitlablib.Group
+		pagePath := fmt.Sprintf("%s?per_page=%d&page=%d", "groups", registryPageSize, page)
+		if err := glRESTGet(ctx, c, pagePath, &groups); err != nil {
+			return nil, err
+		}
+
+		for _, g := range groups {
+			out = append(out, fmt.Sprintf("%d", g.ID))
+		}
+		if len(groups) < registryPageSize {
+			return out, nil
+		}
+	}
+}
+
+// GetArtifactVersions implements the ArtifactProvider interface. Each
+// version is an image digest with all the tags pointing to it.
+func (c *gitlabClient) GetArtifactVersions(
+	ctx context.Context, artifact *minderv1.Artifact,
+	filter provifv1.GetArtifactVersionsFilter,
+) ([]*minderv1.ArtifactVersion, error) {
+	repoPath, err := url.JoinPath("registry", "repositories", artifact.GetArtifactPk())
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for registry repository: %w", err)
+	}
+
+	repo := &gitlablib.RegistryRepository{}
+	if err := glRESTGet(ctx, c, repoPath, repo); err != nil {
+		return nil, fmt.Errorf("failed to get registry repository: %w", err)
+	}
+
+	tags, err := c.listRegistryRepositoryTags(ctx, repo)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving artifact versions: %w", err)
+	}
+
+	// Several tags may point to the same image
+	versions := make(map[string]*minderv1.ArtifactVersion)
+	var digests []string
+	for _, t := range tags {
+		if t.Digest == "" {
+			continue
+		}
+
+		var createdAt time.Time
+		if t.CreatedAt != nil {
+			createdAt = *t.CreatedAt
+		}
+
+		v, ok := versions[t.Digest]
+		if !ok {
+			v = &minderv1.ArtifactVersion{
+				Sha:       t.Digest,
+				CreatedAt: timestamppb.New(createdAt),
+			}
+			versions[t.Digest] = v
+			digests = append(digests, t.Digest)
+		} else if createdAt.Before(v.CreatedAt.AsTime()) {
+			v.CreatedAt = timestamppb.New(createdAt)
+		}
+		v.Tags = append(v.Tags, t.Name)
+	}
+
+	out := make([]*minderv1.ArtifactVersion, 0, len(versions))
+	for _, d := range digests {
+		v := versions[d]
+		if err := filter.IsSkippable(v.CreatedAt.AsTime(), v.Tags); err != nil {
+			zerolog.Ctx(ctx).Debug().Str("name", artifact.GetName()).Strs("tags", v.Tags).
+				Str("reason", err.Error()).Msg("skipping artifact version")
+			continue
+		}
+
+		sort.Strings(v.Tags)
+		out = append(out, v)
+	}
+
+	return out, nil
+}
+
+// registryTagsQuery lists the tags of a container registry repository
+// along with their digest and creation time, which the REST API only
+// returns when fetching the tags one by one
+const registryTagsQuery = `query($id: ContainerRepositoryID!, $first: Int!, $after: String) {
+  containerRepository(id: $id) {
+    tags(first: $first, after: $after) {
+      nodes {
+        name
+        digest
+        createdAt
+      }
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+    }
+  }
+}`
+
+// registryTag is a container registry tag as returned by the GraphQL API
+type registryTag struct {
+	Name      string     `json:"name"`
+	Digest    string     `json:"digest"`
+	CreatedAt *time.Time `json:"createdAt"`
+}
+
+type registryTagsData struct {
+	ContainerRepository *struct {
+		Tags struct {
+			Nodes    []*registryTag `json:"nodes"`
+			PageInfo struct {
+				HasNextPage bool   `json:"hasNextPage"`
+				EndCursor   string `json:"endCursor"`
+			} `json:"pageInfo"`
+		} `json:"tags"`
+	} `json:"containerRepository"`
+}
+
+// listRegistryRepositoryTags lists the tags of a container registry
+// repository with their details, a page of tags per request.
+func (c *gitlabClient) listRegistryRepositoryTags(
+	ctx context.Context, repo *gitlablib.RegistryRepository,
+) ([]*registryTag, error) {
+	variables := map[string]any{
+		"id":    fmt.Sprintf("gid://gitlab/ContainerRepository/%d", repo.ID),
+		"first": registryPageSize,
+	}
+
+	var out []*registryTag
+	for {
+		var data registryTagsData
+		if err := glGraphQLQuery(ctx, c, registryTagsQuery, variables, &data); err != nil {
+			return nil, fmt.Errorf("failed to list registry tags: %w", err)
+		}
+		if data.ContainerRepository == nil {
+			return nil, fmt.Errorf("registry repository %d not found", repo.ID)
+		}
+
+		tags := data.ContainerRepository.Tags
+		out = append(out, tags.Nodes...)
+		if !tags.PageInfo.HasNextPage {
+			return out, nil
+		}
+		variables["after"] = tags.PageInfo.EndCursor
+	}
+}
@@ -0,0 +1,275 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+var (
+	registryRepoCreatedAt = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	registryTagV1At       = time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	registryTagLatestAt   = time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	registryTagDevAt      = time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// skipTagsFilter skips the versions with any of the given tags
+type skipTagsFilter []string
+
+func (f skipTagsFilter) IsSkippable(_ time.Time, tags []string) error {
+	for _, t := range tags {
+		if slices.Contains(f, t) {
+			return errors.New("skipped tag")
+		}
+	}
+	return nil
+}
+
+func TestGetArtifactVersions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		pk      string
+		filter  skipTagsFilter
+		want    []*minderv1.ArtifactVersion
+		wantErr bool
+	}{
+		{
+			name: "groups tags by digest",
+			pk:   "5",
+			want: []*minderv1.ArtifactVersion{
+				{
+					Tags:      []string{"latest", "v1"},
+					Sha:       "sha256:aaaa",
+					CreatedAt: timestamppb.New(registryTagV1At),
+				},
+				{
+					Tags:      []string{"dev"},
+					Sha:       "sha256:bbbb",
+					CreatedAt: timestamppb.New(registryTagDevAt),
+				},
+			},
+		},
+		{
+			name:   "skips filtered versions",
+			pk:     "5",
+			filter: skipTagsFilter{"dev"},
+			want: []*minderv1.ArtifactVersion{
+				{
+					Tags:      []string{"latest", "v1"},
+					Sha:       "sha256:aaaa",
+					CreatedAt: timestamppb.New(registryTagV1At),
+				},
+			},
+		},
+		{
+			name:    "registry repository not found",
+			pk:      "6",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := httptest.NewServer(http.HandlerFunc(gitLabRegistryMockFunc))
+			defer ts.Close()
+
+			gitlabClient := newTestGitlabProvider(ts.URL)
+
+			got, err := gitlabClient.GetArtifactVersions(context.Background(),
+				&minderv1.Artifact{ArtifactPk: tt.pk, Name: "app"}, tt.filter)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestListImages(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(gitLabRegistryMockFunc))
+	defer ts.Close()
+
+	gitlabClient := newTestGitlabProvider(ts.URL)
+
+	got, err := gitlabClient.ListImages(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"group/project-1/app"}, got)
+	assert.Equal(t, "registry.gitlab.com", gitlabClient.GetNamespaceURL())
+
+	gitlabClient.glcfg.Registry = "registry.example.com"
+	gitlabClient.glcfg.Group = "group"
+	assert.Equal(t, "registry.example.com/group", gitlabClient.GetNamespaceURL())
+}
+
+func TestArtifactV1FromProperties(t *testing.T) {
+	t.Parallel()
+
+	repo := &gitlab.RegistryRepository{
+		ID:        5,
+		Path:      "group/project-1/app",
+		Location:  "registry.gitlab.com/group/project-1/app",
+		CreatedAt: &registryRepoCreatedAt,
+	}
+	proj := &gitlab.Project{
+		ID:                1,
+		Name:              "project-1",
+		PathWithNamespace: "group/project-1",
+		Visibility:        gitlab.PublicVisibility,
+		Namespace:         &gitlab.ProjectNamespace{Path: "group"},
+	}
+
+	props, err := gitlabRegistryRepositoryToProperties(repo, proj)
+	require.NoError(t, err)
+	assert.Equal(t, "container", props.GetProperty(properties.ArtifactPropertyType).GetString())
+
+	got, err := artifactV1FromProperties(props)
+	require.NoError(t, err)
+	assert.Equal(t, &minderv1.Artifact{
+		ArtifactPk: "5",
+		Owner:      "group/project-1",
+		Name:       "app",
+		Type:       "container",
+		Repository: "group/project-1",
+		Visibility: "public",
+		CreatedAt:  timestamppb.New(registryRepoCreatedAt),
+	}, got)
+}
+
+// gitLabRegistryMockFunc serves a project with a container registry
+// repository holding two images, one of them tagged twice.
+func gitLabRegistryMockFunc(w http.ResponseWriter, r *http.Request) {
+	repo := &gitlab.RegistryRepository{
+		ID:        5,
+		Path:      "group/project-1/app",
+		ProjectID: 1,
+		Location:  "registry.gitlab.com/group/project-1/app",
+		CreatedAt: &registryRepoCreatedAt,
+	}
+
+	var resp any
+	switch {
+	case r.URL.Path == "/graphql":
+		resp = gitLabRegistryTagsMock(w, r)
+		if resp == nil {
+			return
+		}
+	case r.URL.Path == "/groups":
+		// the group holding the registry repository is on the second page
+		var groups []*gitlab.Group
+		if r.URL.Query().Get("page") == "1" {
+			for i := 0; i < registryPageSize; i++ {
+				groups = append(groups, &gitlab.Group{ID: 100 + i})
+			}
+		} else if r.URL.Query().Get("page") == "2" {
+			groups = []*gitlab.Group{{ID: 10, FullPath: "group"}}
+		}
+		resp = groups
+	case r.URL.Path == "/groups/10/registry/repositories":
+		resp = []*gitlab.RegistryRepository{repo}
+	case strings.HasPrefix(r.URL.Path, "/groups/") && strings.HasSuffix(r.URL.Path, "/registry/repositories"):
+		resp = []*gitlab.RegistryRepository{}
+	default:
+		resp = gitLabRegistryRESTMock(r.URL.Path, repo)
+		if resp == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	//nolint:gosec // This is a test
+	json.NewEncoder(w).Encode(resp)
+}
+
+// gitLabRegistryRESTMock returns the REST API response of the given path,
+// or nil if it doesn't exist
+func gitLabRegistryRESTMock(path string, repo *gitlab.RegistryRepository) any {
+	switch path {
+	case "/projects/1":
+		return &gitlab.Project{
+			ID:                1,
+			Name:              "project-1",
+			PathWithNamespace: "group/project-1",
+			Visibility:        gitlab.PrivateVisibility,
+			Namespace: &gitlab.ProjectNamespace{
+				Path: "group",
+			},
+		}
+	case "/projects/1/registry/repositories":
+		return []*gitlab.RegistryRepository{repo}
+	case "/registry/repositories/5":
+		return repo
+	default:
+		return nil
+	}
+}
+
+// gitLabRegistryTagsMock answers the GraphQL query listing the tags of the
+// registry repository, two tags on the first page and one on the second.
+// It returns nil if the request was rejected.
+func gitLabRegistryTagsMock(w http.ResponseWriter, r *http.Request) any {
+	var req graphQLRequest
+	if r.Method != http.MethodPost || json.NewDecoder(r.Body).Decode(&req) != nil ||
+		req.Variables["id"] != "gid://gitlab/ContainerRepository/5" {
+		w.WriteHeader(http.StatusBadRequest)
+		return nil
+	}
+
+	type tag struct {
+		Name      string    `json:"name"`
+		Digest    string    `json:"digest"`
+		CreatedAt time.Time `json:"createdAt"`
+	}
+	type pageInfo struct {
+		HasNextPage bool   `json:"hasNextPage"`
+		EndCursor   string `json:"endCursor"`
+	}
+	type tags struct {
+		Nodes    []tag    `json:"nodes"`
+		PageInfo pageInfo `json:"pageInfo"`
+	}
+
+	page := tags{
+		Nodes: []tag{
+			{Name: "v1", Digest: "sha256:aaaa", CreatedAt: registryTagV1At},
+			{Name: "latest", Digest: "sha256:aaaa", CreatedAt: registryTagLatestAt},
+		},
+		PageInfo: pageInfo{HasNextPage: true, EndCursor: "cursor-1"},
+	}
+	if req.Variables["after"] == "cursor-1" {
+		page = tags{Nodes: []tag{{Name: "dev", Digest: "sha256:bbbb", CreatedAt: registryTagDevAt}}}
+	}
+
+	return map[string]any{
+		"data": map[string]any{
+			"containerRepository": map[string]any{"tags": page},
+		},
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"golang.org/x/oauth2"
 
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	config "github.com/mindersec/minder/pkg/config/server"
 	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
@@ -21,11 +22,15 @@ import (
 // Class is the string that represents the GitLab provider class
 const Class = "gitlab"
 
+// DefaultEndpoint is the REST API endpoint used when the provider config doesn't set one
+const DefaultEndpoint = "https://gitlab.com/api/v4/"
+
 // Implements is the list of provider types that the DockerHub provider implements
 var Implements = []db.ProviderType{
 	db.ProviderTypeGit,
 	db.ProviderTypeRest,
 	db.ProviderTypeRepoLister,
+	db.ProviderTypeImageLister,
 }
 
 // AuthorizationFlows is the list of authorization flows that the DockerHub provider supports
@@ -38,6 +43,9 @@ var AuthorizationFlows = []db.AuthorizationFlow{
 var _ provifv1.Git = (*gitlabClient)(nil)
 var _ provifv1.REST = (*gitlabClient)(nil)
 var _ provifv1.RepoLister = (*gitlabClient)(nil)
+var _ provifv1.ImageLister = (*gitlabClient)(nil)
+var _ provifv1.ArtifactProvider = (*gitlabClient)(nil)
+var _ provifv1.ContainerRegistry = (*gitlabClient)(nil)
 
 type gitlabClient struct {
 	cred       provifv1.GitLabCredential
@@ -53,17 +61,21 @@ type gitlabClient struct {
 
 // New creates a new GitLab provider
 // Note that the webhook URL should already contain the provider class in the path
+// and that the rate limit budget of the credential is recorded in budgets
 func New(
 	cred provifv1.GitLabCredential,
 	cfg *minderv1.GitLabProviderConfig,
 	webhookURL string,
 	currentWebhookSecret string,
+	budgets ratebudget.Tracker,
 ) (*gitlabClient, error) {
 	// TODO: We need a context here.
 	cli := oauth2.NewClient(context.Background(), cred.GetAsOAuth2TokenSource())
+	cli.Transport = budgets.NewRoundTripper(cli.Transport,
+		ratebudget.CredentialKey(db.ProviderClassGitlab, cred.GetCacheKey()))
 
 	if cfg.Endpoint == "" {
-		cfg.Endpoint = "https://gitlab.com/api/v4/"
+		cfg.Endpoint = DefaultEndpoint
 	}
 
 	if webhookURL == "" {
@@ -121,7 +133,8 @@ func MarshalV1Config(rawCfg json.RawMessage) (json.RawMessage, error) {
 // CanImplement returns true if the provider can implement the given trait
 func (*gitlabClient) CanImplement(trait minderv1.ProviderType) bool {
 	return trait == minderv1.ProviderType_PROVIDER_TYPE_GIT ||
-		trait == minderv1.ProviderType_PROVIDER_TYPE_REST
+		trait == minderv1.ProviderType_PROVIDER_TYPE_REST ||
+		trait == minderv1.ProviderType_PROVIDER_TYPE_IMAGE_LISTER
 }
 
 func (c *gitlabClient) GetCredential() provifv1.GitLabCredential {
@@ -132,7 +145,10 @@ func (c *gitlabClient) GetCredential() provifv1.GitLabCredential {
 func (*gitlabClient) SupportsEntity(entType minderv1.Entity) bool {
 	return entType == minderv1.Entity_ENTITY_REPOSITORIES ||
 		entType == minderv1.Entity_ENTITY_PULL_REQUESTS ||
-		entType == minderv1.Entity_ENTITY_RELEASE
+		entType == minderv1.Entity_ENTITY_RELEASE ||
+		entType == minderv1.Entity_ENTITY_PIPELINE_RUN ||
+		entType == minderv1.Entity_ENTITY_TASK_RUN ||
+		entType == minderv1.Entity_ENTITY_ARTIFACTS
 }
 
 // CreationOptions implements the Provider interface
@@ -149,7 +165,7 @@ func (c *gitlabClient) CreationOptions(entType minderv1.Entity) *provifv1.Entity
 		}
 	}
 
-	// Other entities (PRs, releases) don't need registration or events
+	// Other entities (PRs, releases, pipelines, jobs and artifacts) don't need registration or events
 	return &provifv1.EntityCreationOptions{
 		RegisterWithProvider:       false,
 		PublishReconciliationEvent: false,
@@ -11,6 +11,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 
 	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
 )
@@ -127,3 +128,67 @@ func getParsedURL(endpoint, path string) (*url.URL, error) {
 
 	return u, nil
 }
+
+// graphQLRequest is the body of a GraphQL API request
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+// graphQLResponse is the body of a GraphQL API response
+type graphQLResponse[T any] struct {
+	Data   T `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// getGraphQLURL returns the URL of the GraphQL API of the instance whose
+// REST API is served at the given endpoint, e.g. https://gitlab.com/api/graphql
+// for https://gitlab.com/api/v4/
+func getGraphQLURL(endpoint string) (*url.URL, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL: %w", err)
+	}
+	u.Path = strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), "/v4") + "/graphql"
+	u.RawQuery = ""
+	return u, nil
+}
+
+// glGraphQLQuery sends a query to the GraphQL API of the instance and
+// decodes the data of the response into out
+func glGraphQLQuery[T any](
+	ctx context.Context, c *gitlabClient, query string, variables map[string]any, out *T,
+) error {
+	req, err := c.NewRequest(http.MethodPost, "", &graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.URL, err = getGraphQLURL(c.glcfg.Endpoint)
+	if err != nil {
+		return err
+	}
+	req.Host = req.URL.Host
+
+	resp, err := c.Do(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to run GraphQL query: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to run GraphQL query: %s", resp.Status)
+	}
+
+	var gqlResp graphQLResponse[T]
+	if err := json.NewDecoder(resp.Body).Decode(&gqlResp); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	if len(gqlResp.Errors) > 0 {
+		return fmt.Errorf("GraphQL query failed: %s", gqlResp.Errors[0].Message)
+	}
+
+	*out = gqlResp.Data
+	return nil
+}
@@ -9,13 +9,18 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
 
+	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/providers/credentials"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	"github.com/mindersec/minder/internal/util/ptr"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 )
@@ -109,6 +114,37 @@ func Test_gitlabClient_Do(t *testing.T) {
 	}
 }
 
+func Test_gitlabClient_RecordsRateLimitBudget(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("RateLimit-Limit", "2000")
+		w.Header().Set("RateLimit-Remaining", "10")
+		w.Header().Set("RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	budgets, err := ratebudget.NewTracker(noop.NewMeterProvider().Meter("test"))
+	require.NoError(t, err)
+	providerID := uuid.New()
+	budgets.BindProvider(providerID, ratebudget.CredentialKey(db.ProviderClassGitlab, (&mockCredentials{}).GetCacheKey()))
+
+	client, err := New(&mockCredentials{}, &minderv1.GitLabProviderConfig{Endpoint: ts.URL}, "https://minder.example.com", "", budgets)
+	require.NoError(t, err)
+
+	req, err := client.NewRequest(http.MethodGet, "/projects", nil)
+	require.NoError(t, err)
+	resp, err := client.Do(context.Background(), req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	budget, ok := budgets.Budget(providerID, ratebudget.ResourceCore)
+	require.True(t, ok)
+	assert.Equal(t, 2000, budget.Limit)
+	assert.Equal(t, 10, budget.Remaining)
+}
+
 func Test_gitlabClient_GetBaseURL(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+var _ provifv1.HealthChecker = (*gitlabClient)(nil)
+
+// CheckHealth checks that the credential of the provider is accepted by
+// GitLab by fetching the authenticated user.
+func (c *gitlabClient) CheckHealth(ctx context.Context) (*provifv1.HealthReport, error) {
+	user := &gitlab.User{}
+	if err := glRESTGet(ctx, c, "user", user); err != nil {
+		return nil, fmt.Errorf("error fetching authenticated user: %w", err)
+	}
+
+	report := &provifv1.HealthReport{}
+	if user.State != "" && user.State != "active" {
+		report.Warnings = append(report.Warnings, fmt.Sprintf("user %s is %s", user.Username, user.State))
+	}
+
+	// Only personal access tokens can be introspected, OAuth tokens are
+	// refreshed when they expire.
+	pat := &gitlab.PersonalAccessToken{}
+	if err := glRESTGet(ctx, c, "personal_access_tokens/self", pat); err == nil && pat.ExpiresAt != nil {
+		report.CredentialExpiresAt = time.Time(*pat.ExpiresAt)
+	}
+
+	return report, nil
+}
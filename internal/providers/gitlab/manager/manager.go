@@ -22,6 +22,7 @@ import (
 	"github.com/mindersec/minder/internal/db"
 	"github.com/mindersec/minder/internal/providers/credentials"
 	"github.com/mindersec/minder/internal/providers/gitlab"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	"github.com/mindersec/minder/pkg/config/server"
 	"github.com/mindersec/minder/pkg/eventer/interfaces"
 	v1 "github.com/mindersec/minder/pkg/providers/v1"
@@ -39,6 +40,7 @@ type providerClassManager struct {
 	webhookURL    string
 	parentContext context.Context
 	pub           interfaces.Publisher
+	budgets       ratebudget.Tracker
 
 	// secrets for the webhook. These are stored in the
 	// structure to allow efficient fetching. Rotation
@@ -50,7 +52,7 @@ type providerClassManager struct {
 // NewGitLabProviderClassManager creates a new provider class manager for the dockerhub provider
 func NewGitLabProviderClassManager(
 	ctx context.Context, crypteng crypto.Engine, store db.Store, pub interfaces.Publisher,
-	cfg *server.GitLabConfig, wgCfg server.WebhookConfig,
+	cfg *server.GitLabConfig, wgCfg server.WebhookConfig, budgets ratebudget.Tracker,
 ) (*providerClassManager, error) {
 	webhookURLBase := wgCfg.ExternalWebhookURL
 	if webhookURLBase == "" {
@@ -80,6 +82,7 @@ func NewGitLabProviderClassManager(
 		store:                  store,
 		crypteng:               crypteng,
 		pub:                    pub,
+		budgets:                budgets,
 		glpcfg:                 cfg,
 		webhookURL:             webhookURL,
 		parentContext:          ctx,
@@ -115,10 +118,11 @@ func (g *providerClassManager) Build(ctx context.Context, config *db.Provider) (
 		return nil, fmt.Errorf("error parsing gitlab config: %w", err)
 	}
 
-	cli, err := gitlab.New(creds, cfg, g.webhookURL, g.currentWebhookSecret)
+	cli, err := gitlab.New(creds, cfg, g.webhookURL, g.currentWebhookSecret, g.budgets)
 	if err != nil {
 		return nil, fmt.Errorf("error creating gitlab client: %w", err)
 	}
+	g.budgets.BindProvider(config.ID, ratebudget.CredentialKey(db.ProviderClassGitlab, creds.GetCacheKey()))
 	return cli, nil
 }
 
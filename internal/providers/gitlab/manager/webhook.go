@@ -11,17 +11,22 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 	gitlablib "gitlab.com/gitlab-org/api/client-go"
 
 	"github.com/mindersec/minder/internal/providers/gitlab/webhooksecret"
+	"github.com/mindersec/minder/pkg/eventer/constants"
 )
 
 const (
 	// MaxBytesLimit is the maximum number of bytes to read from the response body
 	// We limit to 1MB to prevent abuse
 	MaxBytesLimit int64 = 1 << 20
+
+	// eventUUIDHeader is the header carrying the unique ID of a webhook delivery
+	eventUUIDHeader = "X-Gitlab-Event-UUID"
 )
 
 // GetWebhookHandler implements the ProviderManager interface
@@ -46,6 +51,17 @@ func (m *providerClassManager) GetWebhookHandler() http.Handler {
 		}
 
 		eventType := gitlablib.HookEventType(r)
+		if eventType == "" && isRegistryNotification(r) {
+			if err := m.handleRegistryEvents(l.With().Str("event", "registry").Logger(), r); err != nil {
+				l.Error().Err(err).Msg("error handling container registry events")
+				http.Error(w, "error handling container registry events", http.StatusInternalServerError)
+				return
+			}
+
+			l.Debug().Msg("processed container registry events successfully")
+			return
+		}
+
 		if eventType == "" {
 			l.Error().Msg("missing X-Gitlab-Event header")
 			http.Error(w, "missing X-Gitlab-Event header", http.StatusBadRequest)
@@ -83,11 +99,25 @@ func (m *providerClassManager) getWebhookEventDispatcher(
 		return m.handleMergeRequest
 	case gitlablib.EventTypeRelease:
 		return m.handleRelease
+	case gitlablib.EventTypePipeline:
+		return m.handlePipeline
+	case gitlablib.EventTypeJob:
+		return m.handleJob
 	default:
 		return m.handleNoop
 	}
 }
 
+// newWebhookMessage creates the message published for a webhook delivery,
+// carrying the ID of the delivery so that it can be tracked downstream.
+func newWebhookMessage(r *http.Request) *message.Message {
+	msg := message.NewMessage(uuid.New().String(), nil)
+	if deliveryID := r.Header.Get(eventUUIDHeader); deliveryID != "" {
+		msg.Metadata.Set(constants.ProviderDeliveryIdKey, deliveryID)
+	}
+	return msg
+}
+
 // handleNoop is a no-op handler for unhandled webhook events
 func (*providerClassManager) handleNoop(l zerolog.Logger, _ *http.Request) error {
 	l.Debug().Msg("unhandled webhook event")
@@ -7,8 +7,6 @@ import (
 	"fmt"
 	"net/http"
 
-	"github.com/ThreeDotsLabs/watermill/message"
-	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 	gitlablib "gitlab.com/gitlab-org/api/client-go"
 
@@ -45,13 +43,13 @@ func (m *providerClassManager) handleMergeRequest(l zerolog.Logger, r *http.Requ
 
 	switch mergeRequestEvent.ObjectAttributes.Action {
 	case "open", "reopen":
-		return m.publishMergeRequestMessage(mrID, mrIID, rawProjectID,
+		return m.publishMergeRequestMessage(r, mrID, mrIID, rawProjectID,
 			constants.TopicQueueOriginatingEntityAdd)
 	case "close":
-		return m.publishMergeRequestMessage(mrID, mrIID, rawProjectID,
+		return m.publishMergeRequestMessage(r, mrID, mrIID, rawProjectID,
 			constants.TopicQueueOriginatingEntityDelete)
 	case "update":
-		return m.publishMergeRequestMessage(mrID, mrIID, rawProjectID,
+		return m.publishMergeRequestMessage(r, mrID, mrIID, rawProjectID,
 			constants.TopicQueueRefreshEntityAndEvaluate)
 	default:
 		return nil
@@ -59,7 +57,7 @@ func (m *providerClassManager) handleMergeRequest(l zerolog.Logger, r *http.Requ
 }
 
 func (m *providerClassManager) publishMergeRequestMessage(
-	mrID, mrIID, rawProjectID int, queueTopic string) error {
+	r *http.Request, mrID, mrIID, rawProjectID int, queueTopic string) error {
 	mrUpstreamID := gitlab.FormatPullRequestUpstreamID(mrID)
 	mrformattedIID := gitlab.FormatPullRequestUpstreamID(mrIID)
 	mrProjectID := gitlab.FormatRepositoryUpstreamID(rawProjectID)
@@ -82,8 +80,7 @@ func (m *providerClassManager) publishMergeRequestMessage(
 	outm.WithProviderClassHint(gitlab.Class)
 
 	// Convert message for publishing
-	msgID := uuid.New().String()
-	msg := message.NewMessage(msgID, nil)
+	msg := newWebhookMessage(r)
 	if err := outm.ToMessage(msg); err != nil {
 		return fmt.Errorf("error converting message to protobuf: %w", err)
 	}
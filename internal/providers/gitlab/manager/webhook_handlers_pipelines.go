@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/rs/zerolog"
+	gitlablib "gitlab.com/gitlab-org/api/client-go"
+
+	entmsg "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/providers/gitlab"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+func (m *providerClassManager) handlePipeline(l zerolog.Logger, r *http.Request) error {
+	l.Debug().Msg("handling pipeline event")
+
+	pipelineEvent := gitlablib.PipelineEvent{}
+	if err := decodeJSONSafe(r.Body, &pipelineEvent); err != nil {
+		return fmt.Errorf("error decoding pipeline event: %w", err)
+	}
+
+	pipelineID := pipelineEvent.ObjectAttributes.ID
+	if pipelineID == 0 {
+		return fmt.Errorf("pipeline event missing ID")
+	}
+
+	rawProjectID := pipelineEvent.Project.ID
+	if rawProjectID == 0 {
+		return fmt.Errorf("pipeline event missing project ID")
+	}
+
+	identifyingProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID:       gitlab.FormatPipelineRunUpstreamID(pipelineID),
+		gitlab.PipelineRunPropertyProjectID: gitlab.FormatRepositoryUpstreamID(rawProjectID),
+	})
+
+	return m.publishCIRunMessage(r, minderv1.Entity_ENTITY_PIPELINE_RUN, identifyingProps, rawProjectID)
+}
+
+func (m *providerClassManager) handleJob(l zerolog.Logger, r *http.Request) error {
+	l.Debug().Msg("handling job event")
+
+	jobEvent := gitlablib.JobEvent{}
+	if err := decodeJSONSafe(r.Body, &jobEvent); err != nil {
+		return fmt.Errorf("error decoding job event: %w", err)
+	}
+
+	jobID := jobEvent.BuildID
+	if jobID == 0 {
+		return fmt.Errorf("job event missing ID")
+	}
+
+	rawProjectID := jobEvent.ProjectID
+	if rawProjectID == 0 {
+		return fmt.Errorf("job event missing project ID")
+	}
+
+	identifyingProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID:   gitlab.FormatTaskRunUpstreamID(jobID),
+		gitlab.TaskRunPropertyProjectID: gitlab.FormatRepositoryUpstreamID(rawProjectID),
+	})
+
+	return m.publishCIRunMessage(r, minderv1.Entity_ENTITY_TASK_RUN, identifyingProps, rawProjectID)
+}
+
+// publishCIRunMessage publishes a message for a pipeline or a job. Both
+// originate from the repository, as job events may be processed before
+// the event of their pipeline.
+//
+// GitLab sends an event on every status transition but doesn't tell whether
+// the pipeline or job is new: manual jobs and skipped pipelines never go
+// through "pending", and any delivery may be dropped. Every event therefore
+// asks to add the entity, which refreshes it if it already exists.
+func (m *providerClassManager) publishCIRunMessage(
+	r *http.Request, entType minderv1.Entity, identifyingProps *properties.Properties, rawProjectID int,
+) error {
+	repoIdentifyingProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: gitlab.FormatRepositoryUpstreamID(rawProjectID),
+	})
+
+	// Form message to publish
+	outm := entmsg.NewEntityRefreshAndDoMessage()
+	outm.WithEntity(entType, identifyingProps)
+	outm.WithOriginator(minderv1.Entity_ENTITY_REPOSITORIES, repoIdentifyingProps)
+	outm.WithProviderClassHint(gitlab.Class)
+
+	// Convert message for publishing
+	msg := newWebhookMessage(r)
+	if err := outm.ToMessage(msg); err != nil {
+		return fmt.Errorf("error converting message to protobuf: %w", err)
+	}
+
+	// Publish message
+	if err := m.pub.Publish(constants.TopicQueueOriginatingEntityAdd, msg); err != nil {
+		return fmt.Errorf("error publishing refresh and eval message: %w", err)
+	}
+
+	return nil
+}
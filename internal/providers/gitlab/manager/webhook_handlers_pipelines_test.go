@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	entmsg "github.com/mindersec/minder/internal/entities/handlers/message"
+	stubeventer "github.com/mindersec/minder/internal/events/stubs"
+	"github.com/mindersec/minder/internal/providers/gitlab"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+func TestHandleCIRunEvents(t *testing.T) {
+	t.Parallel()
+
+	// Manual jobs and skipped pipelines never go through "pending", so
+	// every status must add the entity if it doesn't exist yet.
+	for _, status := range []string{"pending", "running", "manual", "skipped", "success"} {
+		t.Run("pipeline "+status, func(t *testing.T) {
+			t.Parallel()
+
+			pub := &stubeventer.StubEventer{}
+			m := &providerClassManager{pub: pub}
+
+			body := fmt.Sprintf(`{"object_attributes": {"id": 7, "status": %q}, "project": {"id": 1}}`, status)
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+			require.NoError(t, m.handlePipeline(zerolog.Nop(), req))
+
+			checkCIRunMessage(t, pub, minderv1.Entity_ENTITY_PIPELINE_RUN, gitlab.FormatPipelineRunUpstreamID(7))
+		})
+
+		t.Run("job "+status, func(t *testing.T) {
+			t.Parallel()
+
+			pub := &stubeventer.StubEventer{}
+			m := &providerClassManager{pub: pub}
+
+			body := fmt.Sprintf(`{"build_id": 8, "build_status": %q, "project_id": 1}`, status)
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+			require.NoError(t, m.handleJob(zerolog.Nop(), req))
+
+			checkCIRunMessage(t, pub, minderv1.Entity_ENTITY_TASK_RUN, gitlab.FormatTaskRunUpstreamID(8))
+		})
+	}
+}
+
+func checkCIRunMessage(t *testing.T, pub *stubeventer.StubEventer, entType minderv1.Entity, upstreamID string) {
+	t.Helper()
+
+	require.Equal(t, []string{constants.TopicQueueOriginatingEntityAdd}, pub.Topics)
+	require.Len(t, pub.Sent, 1)
+
+	msg, err := entmsg.ToEntityRefreshAndDo(pub.Sent[0])
+	require.NoError(t, err)
+	require.Equal(t, entType, msg.Entity.Type)
+	require.Equal(t, upstreamID, msg.Entity.GetByProps[properties.PropertyUpstreamID])
+	require.Equal(t, minderv1.Entity_ENTITY_REPOSITORIES, msg.Originator.Type)
+	require.Equal(t, "1", msg.Originator.GetByProps[properties.PropertyUpstreamID])
+}
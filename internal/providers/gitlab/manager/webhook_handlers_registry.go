@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	entmsg "github.com/mindersec/minder/internal/entities/handlers/message"
+	"github.com/mindersec/minder/internal/providers/gitlab"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+// GitLab doesn't send project webhooks for container registry pushes.
+// Instead, the registry of a self-managed GitLab instance can be configured
+// to send its notifications to the GitLab webhook endpoint. These come
+// without the X-Gitlab-Event header, and with the media type of the
+// distribution events as content type. As the endpoint is shared by all
+// GitLab instances, the registry must be configured to send the
+// X-Gitlab-Instance header GitLab sends with its own webhooks.
+const (
+	registryEventsMediaType = "application/vnd.docker.distribution.events.v1+json"
+	registryActionPush      = "push"
+	gitlabInstanceHeader    = "X-Gitlab-Instance"
+)
+
+// registryEventEnvelope is the envelope of container registry notifications
+type registryEventEnvelope struct {
+	Events []registryEvent `json:"events"`
+}
+
+// registryEvent is a container registry notification event. Only the
+// fields we need are decoded.
+type registryEvent struct {
+	Action string `json:"action"`
+	Target struct {
+		MediaType  string `json:"mediaType"`
+		Digest     string `json:"digest"`
+		Repository string `json:"repository"`
+		Tag        string `json:"tag"`
+	} `json:"target"`
+}
+
+func isRegistryNotification(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == registryEventsMediaType
+}
+
+func (m *providerClassManager) handleRegistryEvents(l zerolog.Logger, r *http.Request) error {
+	l.Debug().Msg("handling container registry events")
+
+	envelope := registryEventEnvelope{}
+	if err := decodeJSONSafe(r.Body, &envelope); err != nil {
+		return fmt.Errorf("error decoding container registry events: %w", err)
+	}
+
+	instance := r.Header.Get(gitlabInstanceHeader)
+	if instance == "" {
+		return fmt.Errorf("container registry events missing %s header", gitlabInstanceHeader)
+	}
+	providerIDs, err := m.instanceProviders(r.Context(), instance)
+	if err != nil {
+		return fmt.Errorf("error finding providers of GitLab instance %s: %w", instance, err)
+	}
+	if len(providerIDs) == 0 {
+		l.Debug().Str("instance", instance).Msg("no provider for the GitLab instance")
+		return nil
+	}
+
+	for _, event := range envelope.Events {
+		// Blob pushes and manifest pushes by digest don't create
+		// a new version of the image we can evaluate.
+		if event.Action != registryActionPush || event.Target.Tag == "" {
+			continue
+		}
+
+		if event.Target.Repository == "" {
+			return fmt.Errorf("container registry event missing repository")
+		}
+
+		if err := m.handleRegistryPush(r.Context(), l, providerIDs, event.Target.Repository); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// handleRegistryPush publishes a message for the image pushed to the given
+// registry path, for every repository registered with the given providers
+// the image belongs to.
+func (m *providerClassManager) handleRegistryPush(
+	ctx context.Context, l zerolog.Logger, providerIDs []uuid.UUID, registryPath string,
+) error {
+	repos, err := m.findRegistryPathRepositories(ctx, providerIDs, registryPath)
+	if err != nil {
+		return fmt.Errorf("error finding repository for image %s: %w", registryPath, err)
+	}
+
+	if len(repos) == 0 {
+		l.Debug().Str("image", registryPath).Msg("image doesn't belong to a registered repository")
+		return nil
+	}
+
+	for _, repo := range repos {
+		pidProp, err := m.store.GetPropertyValueV1(ctx, repo.ID, properties.PropertyUpstreamID)
+		if err != nil {
+			return fmt.Errorf("error getting upstream ID of repository %s: %w", repo.Name, err)
+		}
+		pid, ok := pidProp.Value.(string)
+		if !ok {
+			return fmt.Errorf("upstream ID of repository %s is not a string", repo.Name)
+		}
+
+		artifacts, err := m.store.GetTypedEntitiesByPropertyV1(ctx, db.EntitiesArtifact,
+			gitlab.ArtifactPropertyRegistryPath, registryPath, db.GetTypedEntitiesOptions{
+				ProjectID:  repo.ProjectID,
+				ProviderID: repo.ProviderID,
+			})
+		if err != nil {
+			return fmt.Errorf("error getting artifacts for image %s: %w", registryPath, err)
+		}
+
+		// The first push of an image creates the artifact, and later
+		// pushes add new versions to it.
+		queueTopic := constants.TopicQueueOriginatingEntityAdd
+		if len(artifacts) > 0 {
+			queueTopic = constants.TopicQueueRefreshEntityAndEvaluate
+		}
+
+		if err := m.publishArtifactMessage(registryPath, pid, queueTopic); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// instanceProviders returns the IDs of the GitLab providers whose endpoint
+// is served by the given GitLab instance, e.g. https://gitlab.example.com
+func (m *providerClassManager) instanceProviders(ctx context.Context, instance string) ([]uuid.UUID, error) {
+	instanceURL, err := url.Parse(instance)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing instance URL: %w", err)
+	}
+
+	provs, err := m.store.GlobalListProvidersByClass(ctx, db.ProviderClassGitlab)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []uuid.UUID
+	for _, prov := range provs {
+		cfg, err := gitlab.ParseV1Config(prov.Definition)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Str("provider_id", prov.ID.String()).
+				Msg("error parsing gitlab provider config")
+			continue
+		}
+		endpoint := cfg.GetEndpoint()
+		if endpoint == "" {
+			endpoint = gitlab.DefaultEndpoint
+		}
+		endpointURL, err := url.Parse(endpoint)
+		if err != nil {
+			continue
+		}
+		if strings.EqualFold(endpointURL.Host, instanceURL.Host) {
+			ids = append(ids, prov.ID)
+		}
+	}
+	return ids, nil
+}
+
+// findRegistryPathRepositories returns the repositories registered with the
+// given providers an image belongs to. Images are stored under the registry
+// path of their project, and may be nested in it, so we look for the longest
+// matching prefix.
+func (m *providerClassManager) findRegistryPathRepositories(
+	ctx context.Context, providerIDs []uuid.UUID, registryPath string,
+) ([]db.EntityInstance, error) {
+	for prefix := registryPath; prefix != ""; {
+		var repos []db.EntityInstance
+		for _, providerID := range providerIDs {
+			provRepos, err := m.store.GetTypedEntitiesByPropertyV1(ctx, db.EntitiesRepository,
+				gitlab.RepoPropertyRegistryPath, prefix, db.GetTypedEntitiesOptions{ProviderID: providerID})
+			if err != nil {
+				return nil, err
+			}
+			repos = append(repos, provRepos...)
+		}
+
+		if len(repos) > 0 {
+			return repos, nil
+		}
+
+		idx := strings.LastIndex(prefix, "/")
+		if idx == -1 {
+			break
+		}
+		prefix = prefix[:idx]
+	}
+
+	return nil, nil
+}
+
+func (m *providerClassManager) publishArtifactMessage(registryPath string, pid string, queueTopic string) error {
+	// Registry events don't carry the ID of the registry repository,
+	// so the artifact is identified by its path.
+	identifyingProps := properties.NewProperties(map[string]any{
+		properties.PropertyName:             registryPath,
+		gitlab.ArtifactPropertyRegistryPath: registryPath,
+		gitlab.ArtifactPropertyProjectID:    pid,
+	})
+
+	repoIdentifyingProps := properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: pid,
+	})
+
+	// Form message to publish
+	outm := entmsg.NewEntityRefreshAndDoMessage()
+	outm.WithEntity(minderv1.Entity_ENTITY_ARTIFACTS, identifyingProps)
+	outm.WithOriginator(minderv1.Entity_ENTITY_REPOSITORIES, repoIdentifyingProps)
+	outm.WithProviderClassHint(gitlab.Class)
+
+	// Convert message for publishing
+	msgID := uuid.New().String()
+	msg := message.NewMessage(msgID, nil)
+	if err := outm.ToMessage(msg); err != nil {
+		return fmt.Errorf("error converting message to protobuf: %w", err)
+	}
+
+	// Publish message
+	if err := m.pub.Publish(queueTopic, msg); err != nil {
+		return fmt.Errorf("error publishing refresh and eval message: %w", err)
+	}
+
+	return nil
+}
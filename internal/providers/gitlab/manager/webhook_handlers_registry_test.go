@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	entmsg "github.com/mindersec/minder/internal/entities/handlers/message"
+	stubeventer "github.com/mindersec/minder/internal/events/stubs"
+	"github.com/mindersec/minder/internal/providers/gitlab"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+const registryPushEvents = `{
+  "events": [
+    {
+      "action": "push",
+      "target": {
+        "mediaType": "application/octet-stream",
+        "digest": "sha256:cccc",
+        "repository": "group/project-1/app"
+      }
+    },
+    {
+      "action": "push",
+      "target": {
+        "mediaType": "application/vnd.oci.image.manifest.v1+json",
+        "digest": "sha256:aaaa",
+        "repository": "group/project-1/app",
+        "tag": "v1"
+      }
+    },
+    {
+      "action": "pull",
+      "target": {
+        "mediaType": "application/vnd.oci.image.manifest.v1+json",
+        "digest": "sha256:aaaa",
+        "repository": "group/project-1/app",
+        "tag": "v1"
+      }
+    }
+  ]
+}`
+
+func TestHandleRegistryEvents(t *testing.T) {
+	t.Parallel()
+
+	repoID := uuid.New()
+	projectID := uuid.New()
+	providerID := uuid.New()
+
+	tests := []struct {
+		name      string
+		instance  string
+		setup     func(store *mockdb.MockStore)
+		wantTopic string
+		wantErr   bool
+	}{
+		{
+			name:     "first push adds the artifact",
+			instance: "https://gitlab.example.com",
+			setup: func(store *mockdb.MockStore) {
+				expectInstanceProviders(store, providerID)
+				expectRegistryRepository(store, repoID, projectID, providerID)
+				store.EXPECT().GetTypedEntitiesByPropertyV1(gomock.Any(), db.EntitiesArtifact,
+					gitlab.ArtifactPropertyRegistryPath, "group/project-1/app", db.GetTypedEntitiesOptions{
+						ProjectID:  projectID,
+						ProviderID: providerID,
+					}).Return(nil, nil)
+			},
+			wantTopic: constants.TopicQueueOriginatingEntityAdd,
+		},
+		{
+			name:     "later push refreshes the artifact",
+			instance: "https://gitlab.example.com",
+			setup: func(store *mockdb.MockStore) {
+				expectInstanceProviders(store, providerID)
+				expectRegistryRepository(store, repoID, projectID, providerID)
+				store.EXPECT().GetTypedEntitiesByPropertyV1(gomock.Any(), db.EntitiesArtifact,
+					gitlab.ArtifactPropertyRegistryPath, "group/project-1/app", gomock.Any()).
+					Return([]db.EntityInstance{{ID: uuid.New()}}, nil)
+			},
+			wantTopic: constants.TopicQueueRefreshEntityAndEvaluate,
+		},
+		{
+			name:     "image of an unregistered project",
+			instance: "https://gitlab.example.com",
+			setup: func(store *mockdb.MockStore) {
+				expectInstanceProviders(store, providerID)
+				store.EXPECT().GetTypedEntitiesByPropertyV1(gomock.Any(), db.EntitiesRepository,
+					gitlab.RepoPropertyRegistryPath, gomock.Any(), db.GetTypedEntitiesOptions{ProviderID: providerID}).
+					Return(nil, nil).Times(3)
+			},
+		},
+		{
+			name:     "instance without providers",
+			instance: "https://gitlab.other.example.com",
+			setup: func(store *mockdb.MockStore) {
+				expectInstanceProviders(store, providerID)
+			},
+		},
+		{
+			name:    "missing instance header",
+			setup:   func(_ *mockdb.MockStore) {},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			tt.setup(store)
+
+			pub := &stubeventer.StubEventer{}
+			m := &providerClassManager{store: store, pub: pub}
+
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(registryPushEvents))
+			req.Header.Set("Content-Type", registryEventsMediaType)
+			if tt.instance != "" {
+				req.Header.Set(gitlabInstanceHeader, tt.instance)
+			}
+			require.True(t, isRegistryNotification(req))
+
+			err := m.handleRegistryEvents(zerolog.Nop(), req)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.wantTopic == "" {
+				require.Empty(t, pub.Sent)
+				return
+			}
+
+			require.Equal(t, []string{tt.wantTopic}, pub.Topics)
+			require.Len(t, pub.Sent, 1)
+
+			msg, err := entmsg.ToEntityRefreshAndDo(pub.Sent[0])
+			require.NoError(t, err)
+			require.Equal(t, minderv1.Entity_ENTITY_ARTIFACTS, msg.Entity.Type)
+			require.Equal(t, "group/project-1/app", msg.Entity.GetByProps[properties.PropertyName])
+			require.Equal(t, "1", msg.Entity.GetByProps[gitlab.ArtifactPropertyProjectID])
+			require.Equal(t, minderv1.Entity_ENTITY_REPOSITORIES, msg.Originator.Type)
+			require.Equal(t, "1", msg.Originator.GetByProps[properties.PropertyUpstreamID])
+			require.Equal(t, gitlab.Class, msg.Hint.ProviderClassHint)
+		})
+	}
+}
+
+// expectInstanceProviders sets up a GitLab provider of gitlab.example.com
+// with the given ID, and a provider of gitlab.com
+func expectInstanceProviders(store *mockdb.MockStore, providerID uuid.UUID) {
+	store.EXPECT().GlobalListProvidersByClass(gomock.Any(), db.ProviderClassGitlab).
+		Return([]db.Provider{
+			{
+				ID:         providerID,
+				Definition: []byte(`{"gitlab": {"endpoint": "https://gitlab.example.com/api/v4/"}}`),
+			},
+			{
+				ID:         uuid.New(),
+				Definition: []byte(`{"gitlab": {}}`),
+			},
+		}, nil)
+}
+
+// expectRegistryRepository sets up a registered repository whose registry
+// path is a parent of the pushed image
+func expectRegistryRepository(store *mockdb.MockStore, repoID, projectID, providerID uuid.UUID) {
+	store.EXPECT().GetTypedEntitiesByPropertyV1(gomock.Any(), db.EntitiesRepository,
+		gitlab.RepoPropertyRegistryPath, "group/project-1/app", db.GetTypedEntitiesOptions{ProviderID: providerID}).
+		Return(nil, nil)
+	store.EXPECT().GetTypedEntitiesByPropertyV1(gomock.Any(), db.EntitiesRepository,
+		gitlab.RepoPropertyRegistryPath, "group/project-1", db.GetTypedEntitiesOptions{ProviderID: providerID}).
+		Return([]db.EntityInstance{{
+			ID:         repoID,
+			Name:       "group/project-1",
+			ProjectID:  projectID,
+			ProviderID: providerID,
+		}}, nil)
+	store.EXPECT().GetPropertyValueV1(gomock.Any(), repoID, properties.PropertyUpstreamID).
+		Return(db.PropertyValueV1{Value: "1"}, nil)
+}
+
+func TestIsRegistryNotification(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.Header.Set("Content-Type", "application/json")
+	require.False(t, isRegistryNotification(req))
+
+	req.Header.Set("Content-Type", registryEventsMediaType+"; charset=utf-8")
+	require.True(t, isRegistryNotification(req))
+}
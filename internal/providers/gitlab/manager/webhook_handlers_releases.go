@@ -7,8 +7,6 @@ import (
 	"fmt"
 	"net/http"
 
-	"github.com/ThreeDotsLabs/watermill/message"
-	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 	gitlablib "gitlab.com/gitlab-org/api/client-go"
 
@@ -46,13 +44,13 @@ func (m *providerClassManager) handleRelease(l zerolog.Logger, r *http.Request)
 
 	switch releaseEvent.Action {
 	case "create":
-		return m.publishReleaseMessage(releaseID, tag, rawProjectID,
+		return m.publishReleaseMessage(r, releaseID, tag, rawProjectID,
 			constants.TopicQueueOriginatingEntityAdd)
 	case "update":
-		return m.publishReleaseMessage(releaseID, tag, rawProjectID,
+		return m.publishReleaseMessage(r, releaseID, tag, rawProjectID,
 			constants.TopicQueueRefreshEntityAndEvaluate)
 	case "delete":
-		return m.publishReleaseMessage(releaseID, tag, rawProjectID,
+		return m.publishReleaseMessage(r, releaseID, tag, rawProjectID,
 			constants.TopicQueueOriginatingEntityDelete)
 	default:
 		return nil
@@ -60,7 +58,7 @@ func (m *providerClassManager) handleRelease(l zerolog.Logger, r *http.Request)
 }
 
 func (m *providerClassManager) publishReleaseMessage(
-	releaseID int, tag string, rawProjectID int, queueTopic string) error {
+	r *http.Request, releaseID int, tag string, rawProjectID int, queueTopic string) error {
 	mrUpstreamID := gitlab.FormatPullRequestUpstreamID(releaseID)
 	mrProjectID := gitlab.FormatRepositoryUpstreamID(rawProjectID)
 
@@ -82,8 +80,7 @@ func (m *providerClassManager) publishReleaseMessage(
 	outm.WithProviderClassHint(gitlab.Class)
 
 	// Convert message for publishing
-	msgID := uuid.New().String()
-	msg := message.NewMessage(msgID, nil)
+	msg := newWebhookMessage(r)
 	if err := outm.ToMessage(msg); err != nil {
 		return fmt.Errorf("error converting message to protobuf: %w", err)
 	}
@@ -7,8 +7,6 @@ import (
 	"fmt"
 	"net/http"
 
-	"github.com/ThreeDotsLabs/watermill/message"
-	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 	gitlablib "gitlab.com/gitlab-org/api/client-go"
 
@@ -34,7 +32,7 @@ func (m *providerClassManager) handleRepoPush(l zerolog.Logger, r *http.Request)
 		return fmt.Errorf("push event missing project ID")
 	}
 
-	return m.publishRefreshAndEvalForGitlabProject(l, rawID)
+	return m.publishRefreshAndEvalForGitlabProject(l, r, rawID)
 }
 
 func (m *providerClassManager) handleTagPush(l zerolog.Logger, r *http.Request) error {
@@ -52,11 +50,11 @@ func (m *providerClassManager) handleTagPush(l zerolog.Logger, r *http.Request)
 		return fmt.Errorf("tag push event missing project ID")
 	}
 
-	return m.publishRefreshAndEvalForGitlabProject(l, rawID)
+	return m.publishRefreshAndEvalForGitlabProject(l, r, rawID)
 }
 
 func (m *providerClassManager) publishRefreshAndEvalForGitlabProject(
-	l zerolog.Logger, rawProjectID int) error {
+	l zerolog.Logger, r *http.Request, rawProjectID int) error {
 	upstreamID := gitlab.FormatRepositoryUpstreamID(rawProjectID)
 
 	// Form identifying properties
@@ -70,15 +68,14 @@ func (m *providerClassManager) publishRefreshAndEvalForGitlabProject(
 	outm.WithProviderClassHint(gitlab.Class)
 
 	// Convert message for publishing
-	msgID := uuid.New().String()
-	msg := message.NewMessage(msgID, nil)
+	msg := newWebhookMessage(r)
 	if err := outm.ToMessage(msg); err != nil {
 		l.Error().Err(err).Msg("error converting message to protobuf")
 		return fmt.Errorf("error converting message to protobuf: %w", err)
 	}
 
 	// Publish message
-	l.Debug().Str("msg_id", msgID).Msg("publishing refresh and eval message")
+	l.Debug().Str("msg_id", msg.UUID).Msg("publishing refresh and eval message")
 	if err := m.pub.Publish(constants.TopicQueueRefreshEntityAndEvaluate, msg); err != nil {
 		l.Error().Err(err).Msg("error publishing refresh and eval message")
 		return fmt.Errorf("error publishing refresh and eval message: %w", err)
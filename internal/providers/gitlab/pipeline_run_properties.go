@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	gitlablib "gitlab.com/gitlab-org/api/client-go"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// FormatPipelineRunUpstreamID returns the upstream ID for a gitlab pipeline
+// This is done so we don't have to deal with conversions in the provider
+// when dealing with entities
+func FormatPipelineRunUpstreamID(id int) string {
+	return fmt.Sprintf("%d", id)
+}
+
+func (c *gitlabClient) getPropertiesForPipelineRun(
+	ctx context.Context, getByProps *properties.Properties,
+) (*properties.Properties, error) {
+	uid, err := getByProps.GetProperty(properties.PropertyUpstreamID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("upstream ID not found or invalid: %w", err)
+	}
+
+	pid, err := getByProps.GetProperty(PipelineRunPropertyProjectID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("project ID not found or invalid: %w", err)
+	}
+
+	pipelinePath, err := url.JoinPath("projects", pid, "pipelines", uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for pipeline using upstream ID: %w", err)
+	}
+
+	pipeline := &gitlablib.Pipeline{}
+	if err := glRESTGet(ctx, c, pipelinePath, pipeline); err != nil {
+		return nil, fmt.Errorf("failed to get pipeline: %w", err)
+	}
+
+	// Validate - pipeline upstream ID must match the one we requested
+	if res := FormatPipelineRunUpstreamID(pipeline.ID); res != uid {
+		return nil, fmt.Errorf("pipeline ID mismatch: %s != %s", res, uid)
+	}
+
+	proj, err := c.getGitLabProject(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	protected, err := c.isProtectedRef(ctx, pid, pipeline.Ref, pipeline.Tag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if ref is protected: %w", err)
+	}
+
+	return gitlabPipelineToProperties(pipeline, proj, protected)
+}
+
+func gitlabPipelineToProperties(
+	pipeline *gitlablib.Pipeline, proj *gitlablib.Project, protected bool,
+) (*properties.Properties, error) {
+	ns, err := getGitlabProjectNamespace(proj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace: %w", err)
+	}
+
+	var user string
+	if pipeline.User != nil {
+		user = pipeline.User.Username
+	}
+
+	return properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID:   FormatPipelineRunUpstreamID(pipeline.ID),
+		properties.PropertyName:         formatPipelineRunName(ns, proj.Name, FormatPipelineRunUpstreamID(pipeline.ID)),
+		PipelineRunPropertyProjectID:    FormatRepositoryUpstreamID(proj.ID),
+		PipelineRunPropertyStatus:       pipeline.Status,
+		PipelineRunPropertySource:       string(pipeline.Source),
+		PipelineRunPropertyRef:          pipeline.Ref,
+		PipelineRunPropertySHA:          pipeline.SHA,
+		PipelineRunPropertyUser:         user,
+		PipelineRunPropertyProtectedRef: protected,
+		RepoPropertyNamespace:           ns,
+		RepoPropertyProjectName:         proj.Name,
+	}), nil
+}
+
+// isProtectedRef returns whether a branch or tag of a project is protected.
+// Unlike the protected branches and tags APIs, the repository branches and
+// tags APIs take wildcard protection rules into account. Refs which are
+// neither a branch nor a tag, such as merge request refs, are not protected.
+func (c *gitlabClient) isProtectedRef(ctx context.Context, projID string, ref string, isTag bool) (bool, error) {
+	kind := "branches"
+	if isTag {
+		kind = "tags"
+	}
+
+	refsPath, err := url.JoinPath("projects", projID, "repository", kind)
+	if err != nil {
+		return false, fmt.Errorf("failed to join URL path for %s: %w", kind, err)
+	}
+
+	// Refs may contain slashes, so we search for the exact ref name instead
+	// of getting it by path.
+	query := url.Values{"search": []string{"^" + ref + "$"}}
+	refsPath = fmt.Sprintf("%s?%s", refsPath, query.Encode())
+
+	// Branches and tags decode into the same fields we need
+	var refs []*gitlablib.Branch
+	if err := glRESTGet(ctx, c, refsPath, &refs); errors.Is(err, provifv1.ErrEntityNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	for _, r := range refs {
+		if r.Name == ref {
+			return r.Protected, nil
+		}
+	}
+
+	return false, nil
+}
+
+func pipelineRunEntityV1FromProperties(props *properties.Properties) (*minderv1.EntityInstance, error) {
+	if _, err := getStringProp(props, properties.PropertyUpstreamID); err != nil {
+		return nil, err
+	}
+
+	if _, err := getStringProp(props, PipelineRunPropertyProjectID); err != nil {
+		return nil, err
+	}
+
+	name, err := getPipelineRunNameFromProperties(props)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pipeline name: %w", err)
+	}
+
+	return &minderv1.EntityInstance{
+		Type:       minderv1.Entity_ENTITY_PIPELINE_RUN,
+		Name:       name,
+		Properties: props.ToProtoStruct(),
+	}, nil
+}
+
+func getPipelineRunNameFromProperties(props *properties.Properties) (string, error) {
+	groupName, err := getStringProp(props, RepoPropertyNamespace)
+	if err != nil {
+		return "", err
+	}
+
+	projectName, err := getStringProp(props, RepoPropertyProjectName)
+	if err != nil {
+		return "", err
+	}
+
+	id, err := getStringProp(props, properties.PropertyUpstreamID)
+	if err != nil {
+		return "", err
+	}
+
+	return formatPipelineRunName(groupName, projectName, id), nil
+}
+
+func formatPipelineRunName(groupName, projectName, id string) string {
+	return fmt.Sprintf("%s/%s/pipelines/%s", groupName, projectName, id)
+}
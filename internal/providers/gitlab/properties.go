@@ -30,6 +30,11 @@ const (
 	RepoPropertyHookID = "gitlab/hook_id"
 	// RepoPropertyHookURL represents the gitlab repo hook URL
 	RepoPropertyHookURL = "gitlab/hook_url"
+	// RepoPropertyHookSecretFingerprint represents the fingerprint of the
+	// secret the gitlab repo hook token was derived from
+	RepoPropertyHookSecretFingerprint = "gitlab/hook_secret_fingerprint"
+	// RepoPropertyRegistryPath represents the path of the gitlab project in the container registry
+	RepoPropertyRegistryPath = "gitlab/registry_path"
 )
 
 // Pull Request Properties
@@ -53,6 +58,64 @@ const (
 	ReleasePropertyBranch = "gitlab/branch"
 )
 
+// Pipeline Run Properties
+const (
+	// PipelineRunPropertyProjectID represents the gitlab project ID
+	PipelineRunPropertyProjectID = "gitlab/project_id"
+	// PipelineRunPropertyStatus represents the gitlab pipeline status
+	PipelineRunPropertyStatus = "gitlab/status"
+	// PipelineRunPropertySource represents what triggered the gitlab pipeline
+	PipelineRunPropertySource = "gitlab/source"
+	// PipelineRunPropertyRef represents the branch or tag the gitlab pipeline ran for
+	PipelineRunPropertyRef = "gitlab/ref"
+	// PipelineRunPropertySHA represents the commit SHA the gitlab pipeline ran for
+	PipelineRunPropertySHA = "gitlab/sha"
+	// PipelineRunPropertyUser represents the user that triggered the gitlab pipeline
+	PipelineRunPropertyUser = "gitlab/user"
+	// PipelineRunPropertyProtectedRef represents whether the pipeline ref is protected
+	PipelineRunPropertyProtectedRef = "gitlab/protected_ref"
+)
+
+// Task Run Properties
+const (
+	// TaskRunPropertyProjectID represents the gitlab project ID
+	TaskRunPropertyProjectID = "gitlab/project_id"
+	// TaskRunPropertyPipelineID represents the ID of the gitlab pipeline the job belongs to
+	TaskRunPropertyPipelineID = "gitlab/pipeline_id"
+	// TaskRunPropertyJobName represents the gitlab job name
+	TaskRunPropertyJobName = "gitlab/job_name"
+	// TaskRunPropertyStage represents the gitlab job stage
+	TaskRunPropertyStage = "gitlab/stage"
+	// TaskRunPropertyStatus represents the gitlab job status
+	TaskRunPropertyStatus = "gitlab/status"
+	// TaskRunPropertySource represents what triggered the pipeline of the gitlab job
+	TaskRunPropertySource = "gitlab/source"
+	// TaskRunPropertyRef represents the branch or tag the gitlab job ran for
+	TaskRunPropertyRef = "gitlab/ref"
+	// TaskRunPropertyUser represents the user that triggered the gitlab job
+	TaskRunPropertyUser = "gitlab/user"
+	// TaskRunPropertyRunnerTags represents the runner tags the gitlab job requires
+	TaskRunPropertyRunnerTags = "gitlab/runner_tags"
+	// TaskRunPropertyProtectedRef represents whether the job ref is protected
+	TaskRunPropertyProtectedRef = "gitlab/protected_ref"
+)
+
+// Artifact Properties
+const (
+	// ArtifactPropertyProjectID represents the gitlab project ID
+	ArtifactPropertyProjectID = "gitlab/project_id"
+	// ArtifactPropertyRegistryPath represents the path of the image in the gitlab container registry
+	ArtifactPropertyRegistryPath = "gitlab/registry_path"
+	// ArtifactPropertyLocation represents the full image reference, including the registry host
+	ArtifactPropertyLocation = "gitlab/location"
+	// ArtifactPropertyCreatedAt represents the time the image repository was created
+	ArtifactPropertyCreatedAt = "gitlab/created_at"
+	// ArtifactPropertyVisibility represents the visibility of the gitlab project the image belongs to
+	ArtifactPropertyVisibility = "gitlab/visibility"
+	// ArtifactPropertyRepo represents the full path of the gitlab project the image belongs to
+	ArtifactPropertyRepo = "gitlab/repo"
+)
+
 // FetchAllProperties implements the provider interface
 func (c *gitlabClient) FetchAllProperties(
 	ctx context.Context, getByProps *properties.Properties, entType minderv1.Entity, _ *properties.Properties,
@@ -61,7 +124,7 @@ func (c *gitlabClient) FetchAllProperties(
 		return nil, fmt.Errorf("entity type %s not supported", entType)
 	}
 
-	//nolint:exhaustive // We only support two entity types for now.
+	//nolint:exhaustive // We only support a subset of entity types for now.
 	switch entType {
 	case minderv1.Entity_ENTITY_REPOSITORIES:
 		return c.getPropertiesForRepo(ctx, getByProps)
@@ -69,6 +132,12 @@ func (c *gitlabClient) FetchAllProperties(
 		return c.getPropertiesForPullRequest(ctx, getByProps)
 	case minderv1.Entity_ENTITY_RELEASE:
 		return c.getPropertiesForRelease(ctx, getByProps)
+	case minderv1.Entity_ENTITY_PIPELINE_RUN:
+		return c.getPropertiesForPipelineRun(ctx, getByProps)
+	case minderv1.Entity_ENTITY_TASK_RUN:
+		return c.getPropertiesForTaskRun(ctx, getByProps)
+	case minderv1.Entity_ENTITY_ARTIFACTS:
+		return c.getPropertiesForArtifact(ctx, getByProps)
 	default:
 		return nil, fmt.Errorf("entity type %s not supported", entType)
 	}
@@ -91,7 +160,7 @@ func (c *gitlabClient) GetEntityName(entityType minderv1.Entity, props *properti
 		return "", fmt.Errorf("entity type %s not supported", entityType)
 	}
 
-	//nolint:exhaustive // We only support two entity types for now.
+	//nolint:exhaustive // We only support a subset of entity types for now.
 	switch entityType {
 	case minderv1.Entity_ENTITY_REPOSITORIES:
 		return getRepoNameFromProperties(props)
@@ -99,6 +168,12 @@ func (c *gitlabClient) GetEntityName(entityType minderv1.Entity, props *properti
 		return getPullRequestNameFromProperties(props)
 	case minderv1.Entity_ENTITY_RELEASE:
 		return getReleaseNameFromProperties(props)
+	case minderv1.Entity_ENTITY_PIPELINE_RUN:
+		return getPipelineRunNameFromProperties(props)
+	case minderv1.Entity_ENTITY_TASK_RUN:
+		return getTaskRunNameFromProperties(props)
+	case minderv1.Entity_ENTITY_ARTIFACTS:
+		return getArtifactNameFromProperties(props)
 	default:
 		return "", fmt.Errorf("entity type %s not supported", entityType)
 	}
@@ -112,7 +187,7 @@ func (c *gitlabClient) PropertiesToProtoMessage(
 		return nil, fmt.Errorf("entity type %s is not supported by the gitlab provider", entType)
 	}
 
-	//nolint:exhaustive // We only support two entity types for now.
+	//nolint:exhaustive // We only support a subset of entity types for now.
 	switch entType {
 	case minderv1.Entity_ENTITY_REPOSITORIES:
 		return repoV1FromProperties(props)
@@ -120,6 +195,12 @@ func (c *gitlabClient) PropertiesToProtoMessage(
 		return pullRequestV1FromProperties(props)
 	case minderv1.Entity_ENTITY_RELEASE:
 		return releaseEntityV1FromProperties(props)
+	case minderv1.Entity_ENTITY_PIPELINE_RUN:
+		return pipelineRunEntityV1FromProperties(props)
+	case minderv1.Entity_ENTITY_TASK_RUN:
+		return taskRunEntityV1FromProperties(props)
+	case minderv1.Entity_ENTITY_ARTIFACTS:
+		return artifactV1FromProperties(props)
 	default:
 		return nil, fmt.Errorf("entity type %s not supported", entType)
 	}
@@ -75,6 +75,32 @@ func Test_gitlabClient_GetEntityName(t *testing.T) {
 			want:    "",
 			wantErr: true,
 		},
+		{
+			name: "valid properties for pipeline run succeeds",
+			args: args{
+				entityType: minderv1.Entity_ENTITY_PIPELINE_RUN,
+				props: properties.NewProperties(map[string]any{
+					properties.PropertyUpstreamID: "42",
+					RepoPropertyNamespace:         "group",
+					RepoPropertyProjectName:       "project",
+				}),
+			},
+			want:    "group/project/pipelines/42",
+			wantErr: false,
+		},
+		{
+			name: "valid properties for task run succeeds",
+			args: args{
+				entityType: minderv1.Entity_ENTITY_TASK_RUN,
+				props: properties.NewProperties(map[string]any{
+					properties.PropertyUpstreamID: "7",
+					RepoPropertyNamespace:         "group",
+					RepoPropertyProjectName:       "project",
+				}),
+			},
+			want:    "group/project/jobs/7",
+			wantErr: false,
+		},
 		{
 			name: "unsupported entity type fails",
 			args: args{
@@ -214,6 +240,101 @@ func Test_gitlabClient_FetchAllProperties(t *testing.T) {
 				w.Write([]byte("invalid json"))
 			},
 		},
+		{
+			name: "pipeline run succeeds",
+			args: args{
+				ctx: context.TODO(),
+				getByProps: properties.NewProperties(map[string]any{
+					properties.PropertyUpstreamID: "42",
+					PipelineRunPropertyProjectID:  "1",
+				}),
+				entType: minderv1.Entity_ENTITY_PIPELINE_RUN,
+			},
+			want: properties.NewProperties(map[string]any{
+				properties.PropertyName:         "group/project-1/pipelines/42",
+				PipelineRunPropertyStatus:       "success",
+				PipelineRunPropertySource:       "push",
+				PipelineRunPropertyRef:          "release/1.0",
+				PipelineRunPropertyUser:         "alice",
+				PipelineRunPropertyProtectedRef: true,
+			}),
+			wantErr:              false,
+			gitLabServerMockFunc: gitLabCIMockFunc,
+		},
+		{
+			name: "pipeline run not found",
+			args: args{
+				ctx: context.TODO(),
+				getByProps: properties.NewProperties(map[string]any{
+					properties.PropertyUpstreamID: "43",
+					PipelineRunPropertyProjectID:  "1",
+				}),
+				entType: minderv1.Entity_ENTITY_PIPELINE_RUN,
+			},
+			want:                 nil,
+			wantErr:              true,
+			gitLabServerMockFunc: gitLabCIMockFunc,
+		},
+		{
+			name: "task run succeeds",
+			args: args{
+				ctx: context.TODO(),
+				getByProps: properties.NewProperties(map[string]any{
+					properties.PropertyUpstreamID: "7",
+					TaskRunPropertyProjectID:      "1",
+				}),
+				entType: minderv1.Entity_ENTITY_TASK_RUN,
+			},
+			want: properties.NewProperties(map[string]any{
+				properties.PropertyName:     "group/project-1/jobs/7",
+				TaskRunPropertyPipelineID:   "42",
+				TaskRunPropertyJobName:      "build",
+				TaskRunPropertyStatus:       "running",
+				TaskRunPropertySource:       "push",
+				TaskRunPropertyRef:          "release/1.0",
+				TaskRunPropertyUser:         "alice",
+				TaskRunPropertyRunnerTags:   []any{"docker", "linux"},
+				TaskRunPropertyProtectedRef: true,
+			}),
+			wantErr:              false,
+			gitLabServerMockFunc: gitLabCIMockFunc,
+		},
+		{
+			name: "artifact by registry path succeeds",
+			args: args{
+				ctx: context.TODO(),
+				getByProps: properties.NewProperties(map[string]any{
+					ArtifactPropertyProjectID:    "1",
+					ArtifactPropertyRegistryPath: "group/project-1/app",
+				}),
+				entType: minderv1.Entity_ENTITY_ARTIFACTS,
+			},
+			want: properties.NewProperties(map[string]any{
+				properties.PropertyUpstreamID:   "5",
+				properties.PropertyName:         "group/project-1/app",
+				properties.ArtifactPropertyType: "container",
+				ArtifactPropertyLocation:        "registry.gitlab.com/group/project-1/app",
+				ArtifactPropertyCreatedAt:       "2025-01-01T00:00:00Z",
+				ArtifactPropertyVisibility:      "private",
+				ArtifactPropertyRepo:            "group/project-1",
+			}),
+			wantErr:              false,
+			gitLabServerMockFunc: gitLabRegistryMockFunc,
+		},
+		{
+			name: "artifact not found",
+			args: args{
+				ctx: context.TODO(),
+				getByProps: properties.NewProperties(map[string]any{
+					properties.PropertyUpstreamID: "6",
+					ArtifactPropertyProjectID:     "1",
+				}),
+				entType: minderv1.Entity_ENTITY_ARTIFACTS,
+			},
+			want:                 nil,
+			wantErr:              true,
+			gitLabServerMockFunc: gitLabRegistryMockFunc,
+		},
 	}
 
 	for _, tt := range tests {
@@ -397,3 +518,55 @@ func newTestGitlabProvider(endpoint string) *gitlabClient {
 		cli: &http.Client{},
 	}
 }
+
+// gitLabCIMockFunc serves a project with a pipeline and a job running for
+// a protected release branch.
+func gitLabCIMockFunc(w http.ResponseWriter, r *http.Request) {
+	var resp any
+	switch r.URL.Path {
+	case "/projects/1":
+		resp = &gitlab.Project{
+			ID:   1,
+			Name: "project-1",
+			Namespace: &gitlab.ProjectNamespace{
+				Path: "group",
+			},
+		}
+	case "/projects/1/pipelines/42":
+		resp = &gitlab.Pipeline{
+			ID:     42,
+			Status: "success",
+			Source: "push",
+			Ref:    "release/1.0",
+			User:   &gitlab.BasicUser{Username: "alice"},
+		}
+	case "/projects/1/jobs/7":
+		job := &gitlab.Job{
+			ID:      7,
+			Name:    "build",
+			Status:  "running",
+			Ref:     "release/1.0",
+			TagList: []string{"docker", "linux"},
+			User:    &gitlab.User{Username: "alice"},
+		}
+		job.Pipeline.ID = 42
+		resp = job
+	case "/projects/1/repository/branches":
+		if r.URL.Query().Get("search") != "^release/1.0$" {
+			resp = []*gitlab.Branch{}
+			break
+		}
+		resp = []*gitlab.Branch{
+			{Name: "release/1.0-rc", Protected: false},
+			{Name: "release/1.0", Protected: true},
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	//nolint:gosec // This is a test
+	json.NewEncoder(w).Encode(resp)
+}
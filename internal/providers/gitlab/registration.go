@@ -19,6 +19,7 @@ import (
 	"github.com/mindersec/minder/internal/providers/gitlab/webhooksecret"
 	"github.com/mindersec/minder/internal/util/ptr"
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	config "github.com/mindersec/minder/pkg/config/server"
 	"github.com/mindersec/minder/pkg/entities/properties"
 	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
 )
@@ -114,6 +115,8 @@ func (c *gitlabClient) createWebhook(ctx context.Context, upstreamID string) (*p
 		TagPushEvents:         trve,
 		MergeRequestsEvents:   trve,
 		ReleasesEvents:        trve,
+		PipelineEvents:        trve,
+		JobEvents:             trve,
 		EnableSSLVerification: trve,
 	}
 
@@ -125,8 +128,9 @@ func (c *gitlabClient) createWebhook(ctx context.Context, upstreamID string) (*p
 	outProps := properties.NewProperties(map[string]interface{}{
 		// we store as string to avoid any type issues. Note that we
 		// need to retrieve it as a string as well.
-		RepoPropertyHookID:  fmt.Sprintf("%d", hook.ID),
-		RepoPropertyHookURL: hook.URL,
+		RepoPropertyHookID:                fmt.Sprintf("%d", hook.ID),
+		RepoPropertyHookURL:               hook.URL,
+		RepoPropertyHookSecretFingerprint: config.WebhookSecretFingerprint(c.currentWebhookSecret),
 	})
 
 	return outProps, nil
@@ -353,3 +353,7 @@ func (*mockCredentials) AddToCloneOptions(_ *git.CloneOptions) {
 func (*mockCredentials) GetAsOAuth2TokenSource() oauth2.TokenSource {
 	return nil
 }
+
+func (*mockCredentials) GetCacheKey() string {
+	return ""
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
@@ -106,6 +107,7 @@ func gitlabProjectToProperties(proj *gitlab.Project) (*properties.Properties, er
 		RepoPropertyProjectName:           proj.Name,
 		RepoPropertyLicense:               license,
 		RepoPropertyCloneURL:              proj.HTTPURLToRepo,
+		RepoPropertyRegistryPath:          getGitlabProjectRegistryPath(proj),
 	})
 
 	return outProps, nil
@@ -119,6 +121,16 @@ func getGitlabProjectNamespace(proj *gitlab.Project) (string, error) {
 	return proj.Namespace.Path, nil
 }
 
+// getGitlabProjectRegistryPath returns the path under which the images of
+// the project are stored in the container registry. GitLab lowercases it,
+// so it may differ from the project path.
+func getGitlabProjectRegistryPath(proj *gitlab.Project) string {
+	if proj.ContainerRegistryImagePrefix == "" {
+		return strings.ToLower(proj.PathWithNamespace)
+	}
+	return registryPathFromLocation(proj.ContainerRegistryImagePrefix)
+}
+
 func repoV1FromProperties(repoProperties *properties.Properties) (*minderv1.Repository, error) {
 	upstreamID, err := repoProperties.GetProperty(properties.PropertyUpstreamID).AsString()
 	if err != nil {
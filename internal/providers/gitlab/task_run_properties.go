@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	gitlablib "gitlab.com/gitlab-org/api/client-go"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+// FormatTaskRunUpstreamID returns the upstream ID for a gitlab job
+// This is done so we don't have to deal with conversions in the provider
+// when dealing with entities
+func FormatTaskRunUpstreamID(id int) string {
+	return fmt.Sprintf("%d", id)
+}
+
+func (c *gitlabClient) getPropertiesForTaskRun(
+	ctx context.Context, getByProps *properties.Properties,
+) (*properties.Properties, error) {
+	uid, err := getByProps.GetProperty(properties.PropertyUpstreamID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("upstream ID not found or invalid: %w", err)
+	}
+
+	pid, err := getByProps.GetProperty(TaskRunPropertyProjectID).AsString()
+	if err != nil {
+		return nil, fmt.Errorf("project ID not found or invalid: %w", err)
+	}
+
+	jobPath, err := url.JoinPath("projects", pid, "jobs", uid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for job using upstream ID: %w", err)
+	}
+
+	job := &gitlablib.Job{}
+	if err := glRESTGet(ctx, c, jobPath, job); err != nil {
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	// Validate - job upstream ID must match the one we requested
+	if res := FormatTaskRunUpstreamID(job.ID); res != uid {
+		return nil, fmt.Errorf("job ID mismatch: %s != %s", res, uid)
+	}
+
+	// The source of a job is the source of its pipeline, which the
+	// jobs API doesn't return.
+	pipelinePath, err := url.JoinPath("projects", pid, "pipelines", FormatPipelineRunUpstreamID(job.Pipeline.ID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for pipeline of job: %w", err)
+	}
+
+	pipeline := &gitlablib.Pipeline{}
+	if err := glRESTGet(ctx, c, pipelinePath, pipeline); err != nil {
+		return nil, fmt.Errorf("failed to get pipeline of job: %w", err)
+	}
+
+	proj, err := c.getGitLabProject(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	protected, err := c.isProtectedRef(ctx, pid, job.Ref, job.Tag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if ref is protected: %w", err)
+	}
+
+	return gitlabJobToProperties(job, pipeline, proj, protected)
+}
+
+func gitlabJobToProperties(
+	job *gitlablib.Job, pipeline *gitlablib.Pipeline, proj *gitlablib.Project, protected bool,
+) (*properties.Properties, error) {
+	ns, err := getGitlabProjectNamespace(proj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace: %w", err)
+	}
+
+	var user string
+	if job.User != nil {
+		user = job.User.Username
+	}
+
+	// Properties only hold untyped lists
+	tags := make([]any, 0, len(job.TagList))
+	for _, tag := range job.TagList {
+		tags = append(tags, tag)
+	}
+
+	return properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: FormatTaskRunUpstreamID(job.ID),
+		properties.PropertyName:       formatTaskRunName(ns, proj.Name, FormatTaskRunUpstreamID(job.ID)),
+		TaskRunPropertyProjectID:      FormatRepositoryUpstreamID(proj.ID),
+		TaskRunPropertyPipelineID:     FormatPipelineRunUpstreamID(pipeline.ID),
+		TaskRunPropertyJobName:        job.Name,
+		TaskRunPropertyStage:          job.Stage,
+		TaskRunPropertyStatus:         job.Status,
+		TaskRunPropertySource:         string(pipeline.Source),
+		TaskRunPropertyRef:            job.Ref,
+		TaskRunPropertyUser:           user,
+		TaskRunPropertyRunnerTags:     tags,
+		TaskRunPropertyProtectedRef:   protected,
+		RepoPropertyNamespace:         ns,
+		RepoPropertyProjectName:       proj.Name,
+	}), nil
+}
+
+func taskRunEntityV1FromProperties(props *properties.Properties) (*minderv1.EntityInstance, error) {
+	if _, err := getStringProp(props, properties.PropertyUpstreamID); err != nil {
+		return nil, err
+	}
+
+	if _, err := getStringProp(props, TaskRunPropertyProjectID); err != nil {
+		return nil, err
+	}
+
+	name, err := getTaskRunNameFromProperties(props)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job name: %w", err)
+	}
+
+	return &minderv1.EntityInstance{
+		Type:       minderv1.Entity_ENTITY_TASK_RUN,
+		Name:       name,
+		Properties: props.ToProtoStruct(),
+	}, nil
+}
+
+func getTaskRunNameFromProperties(props *properties.Properties) (string, error) {
+	groupName, err := getStringProp(props, RepoPropertyNamespace)
+	if err != nil {
+		return "", err
+	}
+
+	projectName, err := getStringProp(props, RepoPropertyProjectName)
+	if err != nil {
+		return "", err
+	}
+
+	id, err := getStringProp(props, properties.PropertyUpstreamID)
+	if err != nil {
+		return "", err
+	}
+
+	return formatTaskRunName(groupName, projectName, id), nil
+}
+
+func formatTaskRunName(groupName, projectName, id string) string {
+	return fmt.Sprintf("%s/%s/jobs/%s", groupName, projectName, id)
+}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/mindersec/minder/internal/providers/gitlab/webhooksecret"
+	"github.com/mindersec/minder/internal/util/ptr"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	config "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+// hookAlertStatusExecutable is the alert status of a hook GitLab delivers to.
+// Hooks which fail repeatedly are temporarily or permanently disabled.
+const hookAlertStatusExecutable = "executable"
+
+var _ provifv1.WebhookReconciler = (*gitlabClient)(nil)
+
+// ReconcileWebhook implements the WebhookReconciler interface. GitLab never
+// returns the token of a hook, so the fingerprint of the secret the token was
+// derived from is kept in a property and the token is set again whenever the
+// secret changed or the hook is edited.
+func (c *gitlabClient) ReconcileWebhook(
+	ctx context.Context, entType minderv1.Entity, props *properties.Properties,
+) (*provifv1.WebhookReconcileResult, error) {
+	if entType != minderv1.Entity_ENTITY_REPOSITORIES {
+		return &provifv1.WebhookReconcileResult{}, nil
+	}
+
+	upstreamID := props.GetProperty(properties.PropertyUpstreamID).GetString()
+	if upstreamID == "" {
+		return nil, errors.New("missing upstream ID")
+	}
+
+	hookID := props.GetProperty(RepoPropertyHookID).GetString()
+	if hookID == "" {
+		return &provifv1.WebhookReconcileResult{}, nil
+	}
+
+	getHookPath, err := url.JoinPath("projects", upstreamID, "hooks", hookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for hook: %w", err)
+	}
+
+	// Only a hook GitLab doesn't know about is missing, any other error
+	// leaves the hook alone until the next reconciliation.
+	hook := &gitlab.ProjectHook{}
+	if err := glRESTGet(ctx, c, getHookPath, hook); errors.Is(err, provifv1.ErrEntityNotFound) {
+		whprops, err := c.createWebhook(ctx, upstreamID)
+		if err != nil {
+			return nil, err
+		}
+		return &provifv1.WebhookReconcileResult{
+			Repaired:   []string{"missing"},
+			Properties: whprops,
+		}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	// The hook URL ends with the UUID the token of the hook is derived from
+	hookUUID := path.Base(props.GetProperty(RepoPropertyHookURL).GetString())
+	if hookUUID == "" || hookUUID == "." || hookUUID == "/" {
+		hookUUID = path.Base(hook.URL)
+	}
+	webhookUniqueURL, err := url.JoinPath(c.webhookURL, hookUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for webhook: %w", err)
+	}
+
+	fingerprint := config.WebhookSecretFingerprint(c.currentWebhookSecret)
+	secretCurrent := props.GetProperty(RepoPropertyHookSecretFingerprint).GetString() == fingerprint
+	drift := gitlabHookDrift(hook, webhookUniqueURL)
+	if !secretCurrent {
+		drift = append(drift, "secret")
+	}
+	if len(drift) == 0 {
+		return &provifv1.WebhookReconcileResult{}, nil
+	}
+
+	sec, err := webhooksecret.New(c.currentWebhookSecret, hookUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook secret: %w", err)
+	}
+
+	trve := ptr.Ptr(true)
+	hreq := &gitlab.EditProjectHookOptions{
+		URL:                   &webhookUniqueURL,
+		Token:                 &sec,
+		PushEvents:            trve,
+		TagPushEvents:         trve,
+		MergeRequestsEvents:   trve,
+		ReleasesEvents:        trve,
+		PipelineEvents:        trve,
+		JobEvents:             trve,
+		EnableSSLVerification: trve,
+	}
+
+	edited, err := c.editWebhook(ctx, upstreamID, hookID, hreq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to edit webhook: %w", err)
+	}
+
+	res := &provifv1.WebhookReconcileResult{Repaired: drift}
+	if edited.URL != props.GetProperty(RepoPropertyHookURL).GetString() || !secretCurrent {
+		res.Properties = properties.NewProperties(map[string]any{
+			RepoPropertyHookURL:               edited.URL,
+			RepoPropertyHookSecretFingerprint: fingerprint,
+		})
+	}
+	return res, nil
+}
+
+// gitlabHookDrift returns the settings of hook which differ from the ones
+// minder registers it with
+func gitlabHookDrift(hook *gitlab.ProjectHook, webhookURL string) []string {
+	var drift []string
+	if hook.URL != webhookURL {
+		drift = append(drift, "url")
+	}
+	if !hook.PushEvents || !hook.TagPushEvents || !hook.MergeRequestsEvents ||
+		!hook.ReleasesEvents || !hook.PipelineEvents || !hook.JobEvents {
+		drift = append(drift, "events")
+	}
+	if !hook.EnableSSLVerification {
+		drift = append(drift, "ssl_verification")
+	}
+	if hook.AlertStatus != "" && hook.AlertStatus != hookAlertStatusExecutable {
+		drift = append(drift, "active")
+	}
+	return drift
+}
+
+func (c *gitlabClient) editWebhook(
+	ctx context.Context, upstreamID, hookID string, hreq *gitlab.EditProjectHookOptions,
+) (*gitlab.ProjectHook, error) {
+	editHookPath, err := url.JoinPath("projects", upstreamID, "hooks", hookID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join URL path for hook: %w", err)
+	}
+
+	req, err := c.NewRequest(http.MethodPut, editHookPath, hreq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.Do(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to edit hook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	hook := &gitlab.ProjectHook{}
+	if err := json.NewDecoder(resp.Body).Decode(hook); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return hook, nil
+}
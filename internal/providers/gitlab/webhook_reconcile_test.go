@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	config "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+func TestReconcileWebhook(t *testing.T) {
+	t.Parallel()
+
+	const (
+		webhookURL = "https://minder.example.com/api/v1/webhook/gitlab"
+		hookURL    = webhookURL + "/5c1e1c4a-4a9b-4b8e-8f4c-2c1d2f3a4b5c"
+	)
+
+	syncedHook := gitlab.ProjectHook{
+		ID:                    1,
+		URL:                   hookURL,
+		PushEvents:            true,
+		TagPushEvents:         true,
+		MergeRequestsEvents:   true,
+		ReleasesEvents:        true,
+		PipelineEvents:        true,
+		JobEvents:             true,
+		EnableSSLVerification: true,
+		AlertStatus:           hookAlertStatusExecutable,
+	}
+	disabledHook := syncedHook
+	disabledHook.PushEvents = false
+	disabledHook.AlertStatus = "temporarily_disabled"
+	currentFingerprint := config.WebhookSecretFingerprint("test-secret")
+
+	tests := []struct {
+		name         string
+		hook         *gitlab.ProjectHook
+		fingerprint  string
+		getStatus    int
+		wantErr      bool
+		wantRepaired []string
+		wantMethod   string
+		wantProps    bool
+	}{
+		{
+			name:        "hook in sync",
+			hook:        &syncedHook,
+			fingerprint: currentFingerprint,
+		},
+		{
+			name:         "hook with a previous secret is edited",
+			hook:         &syncedHook,
+			fingerprint:  config.WebhookSecretFingerprint("previous-secret"),
+			wantRepaired: []string{"secret"},
+			wantMethod:   http.MethodPut,
+		},
+		{
+			name:         "drifted hook is edited",
+			hook:         &disabledHook,
+			fingerprint:  currentFingerprint,
+			wantRepaired: []string{"events", "active"},
+			wantMethod:   http.MethodPut,
+		},
+		{
+			name:         "missing hook is created",
+			getStatus:    http.StatusNotFound,
+			wantRepaired: []string{"missing"},
+			wantMethod:   http.MethodPost,
+			wantProps:    true,
+		},
+		{
+			name:      "hook which cannot be fetched is left alone",
+			getStatus: http.StatusInternalServerError,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotMethod string
+			var gotHook map[string]any
+			mocksrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == fmt.Sprintf("/projects/%s/hooks/1", upstreamID):
+					if tt.getStatus != 0 {
+						w.WriteHeader(tt.getStatus)
+						return
+					}
+					assert.NoError(t, json.NewEncoder(w).Encode(tt.hook))
+				case r.Method == http.MethodPut && r.URL.Path == fmt.Sprintf("/projects/%s/hooks/1", upstreamID),
+					r.Method == http.MethodPost && r.URL.Path == fmt.Sprintf("/projects/%s/hooks", upstreamID):
+					gotMethod = r.Method
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&gotHook))
+					if r.Method == http.MethodPost {
+						w.WriteHeader(http.StatusCreated)
+					}
+					assert.NoError(t, json.NewEncoder(w).Encode(&gitlab.ProjectHook{ID: 2, URL: gotHook["url"].(string)}))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			t.Cleanup(mocksrv.Close)
+
+			glc := &gitlabClient{
+				cred: &mockCredentials{},
+				glcfg: &minderv1.GitLabProviderConfig{
+					Endpoint: mocksrv.URL,
+				},
+				cli:                  mocksrv.Client(),
+				webhookURL:           webhookURL,
+				currentWebhookSecret: "test-secret",
+			}
+
+			props := properties.NewProperties(map[string]any{
+				properties.PropertyUpstreamID:     upstreamID,
+				RepoPropertyHookID:                "1",
+				RepoPropertyHookURL:               hookURL,
+				RepoPropertyHookSecretFingerprint: tt.fingerprint,
+			})
+
+			res, err := glc.ReconcileWebhook(context.Background(), minderv1.Entity_ENTITY_REPOSITORIES, props)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Empty(t, gotMethod)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantRepaired, res.Repaired)
+			require.Equal(t, tt.wantMethod, gotMethod)
+
+			if tt.wantMethod == http.MethodPut {
+				require.Equal(t, hookURL, gotHook["url"])
+				require.Equal(t, true, gotHook["push_events"])
+				require.NotEmpty(t, gotHook["token"])
+			}
+			if tt.wantProps {
+				require.Equal(t, "2", res.Properties.GetProperty(RepoPropertyHookID).GetString())
+			}
+			if tt.fingerprint != currentFingerprint {
+				require.Equal(t, currentFingerprint,
+					res.Properties.GetProperty(RepoPropertyHookSecretFingerprint).GetString())
+			} else {
+				require.Nil(t, res.Properties)
+			}
+		})
+	}
+}
@@ -0,0 +1,293 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package health checks that providers can still be used: that their
+// credentials are accepted upstream and not about to expire, and that the
+// webhook endpoint of their class is reachable.
+package health
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/providers/manager"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+	"github.com/mindersec/minder/pkg/eventer/interfaces"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -package mock_$GOPACKAGE -destination=./mock/$GOFILE -source=./$GOFILE
+
+// webhookProbeTimeout bounds the request made to the webhook endpoint
+const webhookProbeTimeout = 10 * time.Second
+
+// NotificationEvent is the kind of a provider health notification
+type NotificationEvent string
+
+const (
+	// CredentialExpiringEvent is published once when the credential of a
+	// provider is about to expire
+	CredentialExpiringEvent NotificationEvent = "credential_expiring"
+	// StatusChangedEvent is published when the health status of a provider changes
+	StatusChangedEvent NotificationEvent = "status_changed"
+)
+
+// Notification is the payload of the messages published to
+// constants.TopicQueueProviderHealthNotification
+type Notification struct {
+	Event               NotificationEvent `json:"event"`
+	ProjectID           uuid.UUID         `json:"project_id"`
+	ProviderID          uuid.UUID         `json:"provider_id"`
+	ProviderName        string            `json:"provider_name"`
+	Status              string            `json:"status"`
+	PreviousStatus      string            `json:"previous_status,omitempty"`
+	LastError           string            `json:"last_error,omitempty"`
+	CredentialExpiresAt *time.Time        `json:"credential_expires_at,omitempty"`
+}
+
+// ProviderHealthService checks the health of providers
+type ProviderHealthService interface {
+	// CheckProvider checks the health of the given provider, records the
+	// result and publishes notifications about it.
+	CheckProvider(ctx context.Context, projectID uuid.UUID, providerID uuid.UUID) (*db.ProviderHealth, error)
+}
+
+type providerHealthService struct {
+	store           db.Store
+	providerManager manager.ProviderManager
+	evt             interfaces.Publisher
+	cfg             serverconfig.HealthConfig
+	webhookURL      string
+	cli             *http.Client
+}
+
+// NewProviderHealthService creates a new provider health service.
+func NewProviderHealthService(
+	store db.Store,
+	providerManager manager.ProviderManager,
+	evt interfaces.Publisher,
+	cfg serverconfig.HealthConfig,
+	webhookCfg serverconfig.WebhookConfig,
+) ProviderHealthService {
+	return &providerHealthService{
+		store:           store,
+		providerManager: providerManager,
+		evt:             evt,
+		cfg:             cfg,
+		webhookURL:      webhookCfg.ExternalWebhookURL,
+		cli:             &http.Client{Timeout: webhookProbeTimeout},
+	}
+}
+
+// result is the outcome of the checks of a provider
+type result struct {
+	status    db.ProviderHealthStatus
+	problems  []string
+	expiresAt time.Time
+}
+
+// degrade lowers the status to degraded, unless it is already worse
+func (r *result) degrade(problem string) {
+	if r.status == db.ProviderHealthStatusHealthy {
+		r.status = db.ProviderHealthStatusDegraded
+	}
+	r.problems = append(r.problems, problem)
+}
+
+func (r *result) fail(problem string) {
+	r.status = db.ProviderHealthStatusUnhealthy
+	r.problems = append(r.problems, problem)
+}
+
+func (s *providerHealthService) CheckProvider(
+	ctx context.Context, projectID uuid.UUID, providerID uuid.UUID,
+) (*db.ProviderHealth, error) {
+	dbProv, err := s.store.GetProviderByIDAndProject(ctx, db.GetProviderByIDAndProjectParams{
+		ID:        providerID,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting provider: %w", err)
+	}
+
+	previous, err := s.store.GetProviderHealth(ctx, providerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		previous = db.ProviderHealth{}
+	} else if err != nil {
+		return nil, fmt.Errorf("error getting provider health: %w", err)
+	}
+
+	res := s.check(ctx, &dbProv)
+
+	now := time.Now()
+	params := db.UpsertProviderHealthParams{
+		ProviderID: providerID,
+		Status:     res.status,
+		LastError:  strings.Join(res.problems, "; "),
+	}
+	if res.status == db.ProviderHealthStatusHealthy {
+		params.LastHealthyAt = sql.NullTime{Time: now, Valid: true}
+	}
+	if !res.expiresAt.IsZero() {
+		params.CredentialExpiresAt = sql.NullTime{Time: res.expiresAt, Valid: true}
+	}
+
+	var notifications []*Notification
+	// The expiry is notified once per credential: a renewed credential
+	// has a different expiry and is notified again when it is about to
+	// expire in turn.
+	if s.expiresSoon(res.expiresAt, now) {
+		if previous.ExpiryNotifiedAt.Valid && previous.CredentialExpiresAt.Valid &&
+			previous.CredentialExpiresAt.Time.Equal(res.expiresAt) {
+			params.ExpiryNotifiedAt = previous.ExpiryNotifiedAt
+		} else {
+			params.ExpiryNotifiedAt = sql.NullTime{Time: now, Valid: true}
+			notifications = append(notifications, &Notification{Event: CredentialExpiringEvent})
+		}
+	}
+	if previous.Status != "" && previous.Status != res.status {
+		notifications = append(notifications, &Notification{
+			Event:          StatusChangedEvent,
+			PreviousStatus: string(previous.Status),
+		})
+	}
+
+	health, err := s.store.UpsertProviderHealth(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("error recording provider health: %w", err)
+	}
+
+	for _, n := range notifications {
+		n.ProjectID = projectID
+		n.ProviderID = providerID
+		n.ProviderName = dbProv.Name
+		n.Status = string(health.Status)
+		n.LastError = health.LastError
+		if health.CredentialExpiresAt.Valid {
+			n.CredentialExpiresAt = &health.CredentialExpiresAt.Time
+		}
+		if err := s.publish(n); err != nil {
+			// The health is recorded, a missed notification is
+			// not worth failing the check for.
+			zerolog.Ctx(ctx).Error().Err(err).
+				Str("provider_id", providerID.String()).
+				Str("event", string(n.Event)).
+				Msg("error publishing provider health notification")
+		}
+	}
+
+	return &health, nil
+}
+
+// check runs the checks of a provider. It never fails, problems are
+// reported in the result.
+func (s *providerHealthService) check(ctx context.Context, dbProv *db.Provider) *result {
+	res := &result{status: db.ProviderHealthStatusHealthy}
+
+	prov, err := s.providerManager.InstantiateFromID(ctx, dbProv.ID)
+	if err != nil {
+		res.fail(fmt.Sprintf("cannot instantiate provider: %s", err))
+		return res
+	}
+
+	if checker, err := provifv1.As[provifv1.HealthChecker](prov); err == nil {
+		report, err := checker.CheckHealth(ctx)
+		if err != nil {
+			res.fail(err.Error())
+			return res
+		}
+		for _, w := range report.Warnings {
+			res.degrade(w)
+		}
+		res.expiresAt = report.CredentialExpiresAt
+	}
+
+	now := time.Now()
+	if !res.expiresAt.IsZero() && !res.expiresAt.After(now) {
+		res.fail(fmt.Sprintf("credential expired at %s", res.expiresAt.Format(time.RFC3339)))
+	} else if s.expiresSoon(res.expiresAt, now) {
+		res.degrade(fmt.Sprintf("credential expires at %s", res.expiresAt.Format(time.RFC3339)))
+	}
+
+	if err := s.probeWebhook(ctx, dbProv.Class); err != nil {
+		res.degrade(err.Error())
+	}
+
+	return res
+}
+
+// expiresSoon returns true if a credential expiring at expiresAt is within
+// the expiry warning window
+func (s *providerHealthService) expiresSoon(expiresAt time.Time, now time.Time) bool {
+	if expiresAt.IsZero() || s.cfg.ExpiryWarning <= 0 {
+		return false
+	}
+	return expiresAt.Before(now.Add(s.cfg.ExpiryWarning))
+}
+
+// webhookPath returns the path segment of the webhook endpoint of the
+// given class, or an empty string if the class does not receive webhooks
+func webhookPath(class db.ProviderClass) string {
+	switch class {
+	case db.ProviderClassGithub, db.ProviderClassGithubApp:
+		return string(db.ProviderTypeGithub)
+	case db.ProviderClassGitlab, db.ProviderClassAzureDevops:
+		return string(class)
+	default:
+		return ""
+	}
+}
+
+// probeWebhook checks that the webhook endpoint of the class is reachable
+// at its external URL. The endpoint only accepts signed deliveries, so any
+// response which does not come from a failing proxy or server will do.
+func (s *providerHealthService) probeWebhook(ctx context.Context, class db.ProviderClass) error {
+	path := webhookPath(class)
+	if !s.cfg.ProbeWebhooks || s.webhookURL == "" || path == "" {
+		return nil
+	}
+
+	hookURL, err := url.JoinPath(s.webhookURL, url.PathEscape(path))
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, hookURL, nil)
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL: %w", err)
+	}
+
+	resp, err := s.cli.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook endpoint %s is unreachable: %w", hookURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("webhook endpoint %s is unreachable: %s", hookURL, resp.Status)
+	}
+	return nil
+}
+
+func (s *providerHealthService) publish(n *Notification) error {
+	payload, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("error marshalling notification: %w", err)
+	}
+
+	msg := message.NewMessage(uuid.New().String(), payload)
+	return s.evt.Publish(constants.TopicQueueProviderHealthNotification, msg)
+}
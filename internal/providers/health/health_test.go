@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	mockmanager "github.com/mindersec/minder/internal/providers/manager/mock"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+	mockprov "github.com/mindersec/minder/pkg/providers/v1/mock"
+)
+
+type stubPublisher struct {
+	messages []*message.Message
+}
+
+func (s *stubPublisher) Publish(topic string, messages ...*message.Message) error {
+	if topic != constants.TopicQueueProviderHealthNotification {
+		return errors.New("unexpected topic")
+	}
+	s.messages = append(s.messages, messages...)
+	return nil
+}
+
+func (s *stubPublisher) notifications(t *testing.T) []NotificationEvent {
+	t.Helper()
+	var events []NotificationEvent
+	for _, msg := range s.messages {
+		var n Notification
+		require.NoError(t, json.Unmarshal(msg.Payload, &n))
+		events = append(events, n.Event)
+	}
+	return events
+}
+
+func TestCheckProvider(t *testing.T) {
+	t.Parallel()
+
+	soon := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	later := time.Now().Add(90 * 24 * time.Hour).Truncate(time.Second)
+
+	scenarios := []struct {
+		name            string
+		previous        *db.ProviderHealth
+		instantiateErr  error
+		report          *provifv1.HealthReport
+		checkErr        error
+		webhookStatus   int
+		expectedStatus  db.ProviderHealthStatus
+		expectedEvents  []NotificationEvent
+		expectNotified  bool
+		expectHealthyAt bool
+	}{
+		{
+			name:            "healthy provider",
+			report:          &provifv1.HealthReport{CredentialExpiresAt: later},
+			expectedStatus:  db.ProviderHealthStatusHealthy,
+			expectHealthyAt: true,
+		},
+		{
+			name:           "provider cannot be instantiated",
+			instantiateErr: errors.New("no credential"),
+			expectedStatus: db.ProviderHealthStatusUnhealthy,
+		},
+		{
+			name:           "credential rejected upstream",
+			previous:       &db.ProviderHealth{Status: db.ProviderHealthStatusHealthy},
+			checkErr:       errors.New("401 Unauthorized"),
+			expectedStatus: db.ProviderHealthStatusUnhealthy,
+			expectedEvents: []NotificationEvent{StatusChangedEvent},
+		},
+		{
+			name:           "warnings degrade the provider",
+			report:         &provifv1.HealthReport{Warnings: []string{"rate limit exhausted"}},
+			expectedStatus: db.ProviderHealthStatusDegraded,
+		},
+		{
+			name:           "expiring credential is notified",
+			report:         &provifv1.HealthReport{CredentialExpiresAt: soon},
+			expectedStatus: db.ProviderHealthStatusDegraded,
+			expectedEvents: []NotificationEvent{CredentialExpiringEvent},
+			expectNotified: true,
+		},
+		{
+			name: "expiring credential is notified once",
+			previous: &db.ProviderHealth{
+				Status:              db.ProviderHealthStatusDegraded,
+				CredentialExpiresAt: sql.NullTime{Time: soon, Valid: true},
+				ExpiryNotifiedAt:    sql.NullTime{Time: time.Now().Add(-time.Hour), Valid: true},
+			},
+			report:         &provifv1.HealthReport{CredentialExpiresAt: soon},
+			expectedStatus: db.ProviderHealthStatusDegraded,
+			expectNotified: true,
+		},
+		{
+			name:           "expired credential",
+			report:         &provifv1.HealthReport{CredentialExpiresAt: time.Now().Add(-time.Hour)},
+			expectedStatus: db.ProviderHealthStatusUnhealthy,
+			expectedEvents: []NotificationEvent{CredentialExpiringEvent},
+			expectNotified: true,
+		},
+		{
+			name:           "unreachable webhook endpoint",
+			report:         &provifv1.HealthReport{},
+			webhookStatus:  http.StatusBadGateway,
+			expectedStatus: db.ProviderHealthStatusDegraded,
+		},
+		{
+			name:            "reachable webhook endpoint",
+			report:          &provifv1.HealthReport{},
+			webhookStatus:   http.StatusMethodNotAllowed,
+			expectedStatus:  db.ProviderHealthStatusHealthy,
+			expectHealthyAt: true,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			provMgr := mockmanager.NewMockProviderManager(ctrl)
+
+			projectID := uuid.New()
+			providerID := uuid.New()
+
+			store.EXPECT().GetProviderByIDAndProject(gomock.Any(), db.GetProviderByIDAndProjectParams{
+				ID:        providerID,
+				ProjectID: projectID,
+			}).Return(db.Provider{
+				ID:        providerID,
+				ProjectID: projectID,
+				Name:      "test-provider",
+				Class:     db.ProviderClassGitlab,
+			}, nil)
+
+			if scenario.previous != nil {
+				prev := *scenario.previous
+				prev.ProviderID = providerID
+				store.EXPECT().GetProviderHealth(gomock.Any(), providerID).Return(prev, nil)
+			} else {
+				store.EXPECT().GetProviderHealth(gomock.Any(), providerID).
+					Return(db.ProviderHealth{}, sql.ErrNoRows)
+			}
+
+			if scenario.instantiateErr != nil {
+				provMgr.EXPECT().InstantiateFromID(gomock.Any(), providerID).
+					Return(nil, scenario.instantiateErr)
+			} else {
+				prov := mockprov.NewMockHealthChecker(ctrl)
+				prov.EXPECT().CheckHealth(gomock.Any()).Return(scenario.report, scenario.checkErr)
+				provMgr.EXPECT().InstantiateFromID(gomock.Any(), providerID).Return(prov, nil)
+			}
+
+			cfg := serverconfig.HealthConfig{ExpiryWarning: 7 * 24 * time.Hour}
+			whCfg := serverconfig.WebhookConfig{}
+			if scenario.webhookStatus != 0 {
+				srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					require.Equal(t, "/api/v1/webhook/gitlab", r.URL.Path)
+					w.WriteHeader(scenario.webhookStatus)
+				}))
+				t.Cleanup(srv.Close)
+				cfg.ProbeWebhooks = true
+				whCfg.ExternalWebhookURL = srv.URL + "/api/v1/webhook/"
+			}
+
+			var params db.UpsertProviderHealthParams
+			store.EXPECT().UpsertProviderHealth(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, arg db.UpsertProviderHealthParams) (db.ProviderHealth, error) {
+					params = arg
+					return db.ProviderHealth{
+						ProviderID:          arg.ProviderID,
+						Status:              arg.Status,
+						LastError:           arg.LastError,
+						CheckedAt:           time.Now(),
+						LastHealthyAt:       arg.LastHealthyAt,
+						CredentialExpiresAt: arg.CredentialExpiresAt,
+						ExpiryNotifiedAt:    arg.ExpiryNotifiedAt,
+					}, nil
+				})
+
+			pub := &stubPublisher{}
+			svc := NewProviderHealthService(store, provMgr, pub, cfg, whCfg)
+
+			health, err := svc.CheckProvider(context.Background(), projectID, providerID)
+			require.NoError(t, err)
+			require.Equal(t, scenario.expectedStatus, health.Status)
+			require.Equal(t, scenario.expectedStatus == db.ProviderHealthStatusHealthy, health.LastError == "")
+			require.Equal(t, scenario.expectHealthyAt, params.LastHealthyAt.Valid)
+			require.Equal(t, scenario.expectNotified, params.ExpiryNotifiedAt.Valid)
+			if scenario.previous != nil && scenario.previous.ExpiryNotifiedAt.Valid {
+				require.Equal(t, scenario.previous.ExpiryNotifiedAt, params.ExpiryNotifiedAt)
+			}
+			require.Equal(t, scenario.expectedEvents, pub.notifications(t))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./health.go
+//
+// Generated by this command:
+//
+//	mockgen -package mock_health -destination=./mock/health.go -source=./health.go
+//
+
+// Package mock_health is a generated GoMock package.
+package mock_health
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	db "github.com/mindersec/minder/internal/db"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProviderHealthService is a mock of ProviderHealthService interface.
+type MockProviderHealthService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProviderHealthServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockProviderHealthServiceMockRecorder is the mock recorder for MockProviderHealthService.
+type MockProviderHealthServiceMockRecorder struct {
+	mock *MockProviderHealthService
+}
+
+// NewMockProviderHealthService creates a new mock instance.
+func NewMockProviderHealthService(ctrl *gomock.Controller) *MockProviderHealthService {
+	mock := &MockProviderHealthService{ctrl: ctrl}
+	mock.recorder = &MockProviderHealthServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProviderHealthService) EXPECT() *MockProviderHealthServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckProvider mocks base method.
+func (m *MockProviderHealthService) CheckProvider(ctx context.Context, projectID, providerID uuid.UUID) (*db.ProviderHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckProvider", ctx, projectID, providerID)
+	ret0, _ := ret[0].(*db.ProviderHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckProvider indicates an expected call of CheckProvider.
+func (mr *MockProviderHealthServiceMockRecorder) CheckProvider(ctx, projectID, providerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckProvider", reflect.TypeOf((*MockProviderHealthService)(nil).CheckProvider), ctx, projectID, providerID)
+}
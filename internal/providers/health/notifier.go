@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+	"github.com/mindersec/minder/pkg/eventer/interfaces"
+)
+
+type logNotifier struct {
+	logger zerolog.Logger
+}
+
+// NewLogNotifier creates a consumer of the provider health notifications
+// which writes them to the given logger, so they can be alerted on.
+func NewLogNotifier(logger zerolog.Logger) interfaces.Consumer {
+	return &logNotifier{logger: logger}
+}
+
+// Register implements the Consumer interface.
+func (l *logNotifier) Register(reg interfaces.Registrar) {
+	reg.Register(constants.TopicQueueProviderHealthNotification, l.handleNotification)
+}
+
+func (l *logNotifier) handleNotification(msg *message.Message) error {
+	var n Notification
+	if err := json.Unmarshal(msg.Payload, &n); err != nil {
+		return fmt.Errorf("error unmarshalling provider health notification: %w", err)
+	}
+
+	var evt *zerolog.Event
+	switch {
+	case n.Status == string(db.ProviderHealthStatusUnhealthy):
+		evt = l.logger.Error()
+	case n.Event == CredentialExpiringEvent, n.Status == string(db.ProviderHealthStatusDegraded):
+		evt = l.logger.Warn()
+	default:
+		evt = l.logger.Info()
+	}
+
+	evt = evt.
+		Str("event", string(n.Event)).
+		Str("project_id", n.ProjectID.String()).
+		Str("provider_id", n.ProviderID.String()).
+		Str("provider_name", n.ProviderName).
+		Str("status", n.Status)
+	if n.PreviousStatus != "" {
+		evt = evt.Str("previous_status", n.PreviousStatus)
+	}
+	if n.LastError != "" {
+		evt = evt.Str("last_error", n.LastError)
+	}
+	if n.CredentialExpiresAt != nil {
+		evt = evt.Str("credential_expires_at", n.CredentialExpiresAt.Format(time.RFC3339))
+	}
+	evt.Msg("provider health notification")
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/db"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/eventer"
+)
+
+// chanWriter hands every log line over to the test
+type chanWriter chan []byte
+
+func (c chanWriter) Write(p []byte) (int, error) {
+	c <- append([]byte(nil), p...)
+	return len(p), nil
+}
+
+func TestLogNotifier(t *testing.T) {
+	t.Parallel()
+
+	evt, err := eventer.New(context.Background(), nil, &serverconfig.EventConfig{
+		Driver: "go-channel",
+		GoChannel: serverconfig.GoChannelEventConfig{
+			BlockPublishUntilSubscriberAck: true,
+		},
+	})
+	require.NoError(t, err, "failed to setup eventer")
+
+	lines := make(chanWriter, 1)
+	evt.ConsumeEvents(NewLogNotifier(zerolog.New(lines)))
+
+	go func() {
+		require.NoError(t, evt.Run(context.Background()), "failed to run eventer")
+	}()
+	t.Cleanup(func() { require.NoError(t, evt.Close()) })
+	<-evt.Running()
+
+	expiresAt := time.Now().Add(24 * time.Hour).Truncate(time.Second).UTC()
+	svc := &providerHealthService{evt: evt}
+	n := &Notification{
+		Event:               StatusChangedEvent,
+		ProjectID:           uuid.New(),
+		ProviderID:          uuid.New(),
+		ProviderName:        "github-app-acme",
+		Status:              string(db.ProviderHealthStatusUnhealthy),
+		PreviousStatus:      string(db.ProviderHealthStatusHealthy),
+		LastError:           "bad credentials",
+		CredentialExpiresAt: &expiresAt,
+	}
+	require.NoError(t, svc.publish(n))
+
+	var line map[string]any
+	select {
+	case raw := <-lines:
+		require.NoError(t, json.Unmarshal(raw, &line))
+	case <-time.After(5 * time.Second):
+		t.Fatal("notification was not delivered")
+	}
+
+	require.Equal(t, "error", line["level"])
+	require.Equal(t, "provider health notification", line["message"])
+	require.Equal(t, string(StatusChangedEvent), line["event"])
+	require.Equal(t, n.ProjectID.String(), line["project_id"])
+	require.Equal(t, n.ProviderID.String(), line["provider_id"])
+	require.Equal(t, "github-app-acme", line["provider_name"])
+	require.Equal(t, "unhealthy", line["status"])
+	require.Equal(t, "healthy", line["previous_status"])
+	require.Equal(t, "bad credentials", line["last_error"])
+	require.Equal(t, expiresAt.Format(time.RFC3339), line["credential_expires_at"])
+}
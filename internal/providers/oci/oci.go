@@ -7,6 +7,7 @@ package oci
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
@@ -26,6 +27,8 @@ import (
 // OCI is the struct that contains the OCI client
 type OCI struct {
 	cred provifv1.Credential
+	// auth takes precedence over cred when set
+	auth authn.Authenticator
 
 	registry string
 	baseURL  string
@@ -40,6 +43,17 @@ func New(cred provifv1.Credential, registry, baseURL string) *OCI {
 	}
 }
 
+// NewWithAuthenticator creates a new OCI client which authenticates to the
+// registry with the given authenticator, e.g. the one of another provider
+// which hosts a registry
+func NewWithAuthenticator(auth authn.Authenticator, registry, baseURL string) *OCI {
+	return &OCI{
+		auth:     auth,
+		registry: registry,
+		baseURL:  baseURL,
+	}
+}
+
 // CanImplement returns true/false depending on whether the OCI client can implement the specified trait
 func (*OCI) CanImplement(trait minderv1.ProviderType) bool {
 	return trait == minderv1.ProviderType_PROVIDER_TYPE_OCI
@@ -98,32 +112,84 @@ func (o *OCI) GetDigest(ctx context.Context, contname, tag string) (string, erro
 	return getDigestFromRef(ctx, ref)
 }
 
-// GetReferrer returns the referrer for the given tag of the given container in the given namespace
-// for the OCI provider. It returns the referrer as a golang struct given the OCI spec.
-func (o *OCI) GetReferrer(ctx context.Context, contname, tag, artifactType string) (any, error) {
+// GetReferrer returns the referrers of the given artifact type for the given tag or digest of the
+// given container in the given namespace for the OCI provider.
+func (o *OCI) GetReferrer(ctx context.Context, contname, tag, artifactType string) (*v1.IndexManifest, error) {
 	ref, err := o.getReference(contname, tag)
 	if err != nil {
-		return "", fmt.Errorf("failed to get reference: %w", err)
+		return nil, fmt.Errorf("failed to get reference: %w", err)
 	}
 
-	dig, err := getDigestFromRef(ctx, ref)
+	opts, err := o.remoteOptions(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to get digest: %w", err)
+		return nil, err
+	}
+
+	// Referrers are looked up by digest, so resolve tags first
+	digname, ok := ref.(name.Digest)
+	if !ok {
+		dig, err := getDigestFromRef(ctx, ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get digest: %w", err)
+		}
+
+		digname, err = name.NewDigest(fmt.Sprintf("%s@%s", ref.Context().Name(), dig))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get digest name: %w", err)
+		}
+	}
+
+	if artifactType != "" {
+		opts = append(opts, remote.WithFilter("artifactType", artifactType))
+	}
+	refer, err := remote.Referrers(digname, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get referrer: %w", err)
+	}
+
+	idx, err := refer.IndexManifest()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get referrer index: %w", err)
 	}
 
-	digname, err := name.NewDigest(fmt.Sprintf("%s@%s", ref.Context().RepositoryStr(), dig))
+	return idx, nil
+}
+
+// GetBlob returns the content of the blob with the given digest in the given container,
+// reading at most maxBytes bytes.
+func (o *OCI) GetBlob(ctx context.Context, contname, digest string, maxBytes int64) ([]byte, error) {
+	src := fmt.Sprintf("%s/%s@%s", o.baseURL, contname, digest)
+	digname, err := name.NewDigest(src)
 	if err != nil {
-		return "", fmt.Errorf("failed to get digest name: %w", err)
+		return nil, fmt.Errorf("failed to parse digest %q: %w", src, err)
 	}
 
-	refer, err := remote.Referrers(digname,
-		remote.WithContext(ctx), remote.WithUserAgent(constants.ServerUserAgent),
-		remote.WithFilter("artifactType", artifactType))
+	opts, err := o.remoteOptions(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to get referrer: %w", err)
+		return nil, err
+	}
+
+	layer, err := remote.Layer(digname, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blob: %w", err)
+	}
+
+	rc, err := layer.Compressed()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blob: %w", err)
+	}
+	defer rc.Close()
+
+	// Read one byte more than the limit to tell a truncated blob apart
+	content, err := io.ReadAll(io.LimitReader(rc, maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blob: %w", err)
+	}
+	if int64(len(content)) > maxBytes {
+		return nil, fmt.Errorf("blob %s exceeds the maximum size of %d bytes", digest, maxBytes)
 	}
 
-	return refer, nil
+	return content, nil
 }
 
 // GetManifest returns the manifest for the given tag of the given container in the given namespace
@@ -134,7 +200,12 @@ func (o *OCI) GetManifest(ctx context.Context, contname, tag string) (*v1.Manife
 		return nil, fmt.Errorf("failed to get reference: %w", err)
 	}
 
-	img, err := remote.Image(ref, remote.WithContext(ctx), remote.WithUserAgent(constants.ServerUserAgent))
+	opts, err := o.remoteOptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := remote.Image(ref, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get image: %w", err)
 	}
@@ -154,6 +225,9 @@ func (o *OCI) GetRegistry() string {
 
 // GetAuthenticator returns the authenticator for the OCI provider
 func (o *OCI) GetAuthenticator() (authn.Authenticator, error) {
+	if o.auth != nil {
+		return o.auth, nil
+	}
 	if o.cred == nil {
 		return authn.Anonymous, nil
 	}
@@ -231,11 +305,29 @@ func (ov *OCI) GetArtifactVersions(
 	return out, nil
 }
 
-// getReferenceString returns the reference string for a given container name and tag
+// getReferenceString returns the reference string for a given container name and tag or digest
 func (o *OCI) getReferenceString(contname, tag string) string {
+	if strings.Contains(tag, ":") {
+		// Digests are in the form algorithm:hex
+		return fmt.Sprintf("%s/%s@%s", o.baseURL, contname, tag)
+	}
 	return fmt.Sprintf("%s/%s:%s", o.baseURL, contname, tag)
 }
 
+// remoteOptions returns the options to access the registry on behalf of the provider
+func (o *OCI) remoteOptions(ctx context.Context) ([]remote.Option, error) {
+	auth, err := o.GetAuthenticator()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get authenticator: %w", err)
+	}
+
+	return []remote.Option{
+		remote.WithContext(ctx),
+		remote.WithUserAgent(constants.ServerUserAgent),
+		remote.WithAuth(auth),
+	}, nil
+}
+
 // getReference returns the reference for a given container name and tag
 func (o *OCI) getReference(contname, tag string) (name.Reference, error) {
 	ref, err := name.ParseReference(o.getReferenceString(contname, tag))
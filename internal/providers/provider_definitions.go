@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/providers/azuredevops"
 	"github.com/mindersec/minder/internal/providers/dockerhub"
 	ghclient "github.com/mindersec/minder/internal/providers/github/clients"
 	"github.com/mindersec/minder/internal/providers/gitlab"
@@ -35,6 +36,10 @@ var supportedProviderClassDefinitions = map[string]ProviderClassDefinition{
 		Traits:             gitlab.Implements,
 		AuthorizationFlows: gitlab.AuthorizationFlows,
 	},
+	azuredevops.Class: {
+		Traits:             azuredevops.Implements,
+		AuthorizationFlows: azuredevops.AuthorizationFlows,
+	},
 }
 
 // GetProviderClassDefinition returns the provider definition for the given provider class
@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./ratebudget.go
+//
+// Generated by this command:
+//
+//	mockgen -package mock_ratebudget -destination=./mock/ratebudget.go -source=./ratebudget.go
+//
+
+// Package mock_ratebudget is a generated GoMock package.
+package mock_ratebudget
+
+import (
+	context "context"
+	http "net/http"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	ratebudget "github.com/mindersec/minder/internal/providers/ratebudget"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTracker is a mock of Tracker interface.
+type MockTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrackerMockRecorder
+	isgomock struct{}
+}
+
+// MockTrackerMockRecorder is the mock recorder for MockTracker.
+type MockTrackerMockRecorder struct {
+	mock *MockTracker
+}
+
+// NewMockTracker creates a new mock instance.
+func NewMockTracker(ctrl *gomock.Controller) *MockTracker {
+	mock := &MockTracker{ctrl: ctrl}
+	mock.recorder = &MockTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTracker) EXPECT() *MockTrackerMockRecorder {
+	return m.recorder
+}
+
+// Admit mocks base method.
+func (m *MockTracker) Admit(ctx context.Context, providerID uuid.UUID, priority ratebudget.Priority, resources ...ratebudget.Resource) time.Duration {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, providerID, priority}
+	for _, a := range resources {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Admit", varargs...)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// Admit indicates an expected call of Admit.
+func (mr *MockTrackerMockRecorder) Admit(ctx, providerID, priority any, resources ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, providerID, priority}, resources...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Admit", reflect.TypeOf((*MockTracker)(nil).Admit), varargs...)
+}
+
+// BindProvider mocks base method.
+func (m *MockTracker) BindProvider(providerID uuid.UUID, credentialKey string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "BindProvider", providerID, credentialKey)
+}
+
+// BindProvider indicates an expected call of BindProvider.
+func (mr *MockTrackerMockRecorder) BindProvider(providerID, credentialKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BindProvider", reflect.TypeOf((*MockTracker)(nil).BindProvider), providerID, credentialKey)
+}
+
+// Budget mocks base method.
+func (m *MockTracker) Budget(providerID uuid.UUID, resource ratebudget.Resource) (ratebudget.Budget, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Budget", providerID, resource)
+	ret0, _ := ret[0].(ratebudget.Budget)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Budget indicates an expected call of Budget.
+func (mr *MockTrackerMockRecorder) Budget(providerID, resource any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Budget", reflect.TypeOf((*MockTracker)(nil).Budget), providerID, resource)
+}
+
+// NewRoundTripper mocks base method.
+func (m *MockTracker) NewRoundTripper(wrapped http.RoundTripper, credentialKey string) http.RoundTripper {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewRoundTripper", wrapped, credentialKey)
+	ret0, _ := ret[0].(http.RoundTripper)
+	return ret0
+}
+
+// NewRoundTripper indicates an expected call of NewRoundTripper.
+func (mr *MockTrackerMockRecorder) NewRoundTripper(wrapped, credentialKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewRoundTripper", reflect.TypeOf((*MockTracker)(nil).NewRoundTripper), wrapped, credentialKey)
+}
@@ -0,0 +1,314 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ratebudget tracks the API rate limit budget of provider credentials,
+// so that work can be deferred before the budget is exhausted
+package ratebudget
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/mindersec/minder/internal/db"
+)
+
+const (
+	// GitHub rate limit headers
+	headerLimit     = "X-RateLimit-Limit"
+	headerRemaining = "X-RateLimit-Remaining"
+	headerReset     = "X-RateLimit-Reset"
+	headerResource  = "X-RateLimit-Resource"
+
+	// GitLab rate limit headers, which have a single budget
+	gitlabHeaderLimit     = "RateLimit-Limit"
+	gitlabHeaderRemaining = "RateLimit-Remaining"
+	gitlabHeaderReset     = "RateLimit-Reset"
+
+	// LowPriorityReserve is the fraction of the budget which is kept for high
+	// priority work. Low priority work is deferred below it.
+	LowPriorityReserve = 0.2
+	// HighPriorityReserve is the fraction of the budget below which even high
+	// priority work is deferred, leaving room for interactive API calls.
+	HighPriorityReserve = 0.02
+)
+
+//go:generate go run go.uber.org/mock/mockgen -package mock_$GOPACKAGE -destination=./mock/$GOFILE -source=./$GOFILE
+
+// Priority is the priority of the work consuming a budget
+type Priority int
+
+const (
+	// PriorityLow is background work, such as reminder-driven reconciliations
+	PriorityLow Priority = iota
+	// PriorityHigh is work triggered by an upstream change, such as a webhook
+	PriorityHigh
+)
+
+// String returns the name of the priority, as used in metrics and logs
+func (p Priority) String() string {
+	if p == PriorityHigh {
+		return "high"
+	}
+	return "low"
+}
+
+// Resource is a rate limit resource of a provider API. Each resource of a
+// credential has its own budget.
+type Resource string
+
+const (
+	// ResourceCore is the REST API, and the only resource of providers which
+	// don't have separate budgets
+	ResourceCore Resource = "core"
+	// ResourceGraphQL is the GitHub GraphQL API
+	ResourceGraphQL Resource = "graphql"
+)
+
+// Budget is the last observed rate limit budget of a credential
+type Budget struct {
+	// Limit is the number of requests allowed in the current window
+	Limit int
+	// Remaining is the number of requests left in the current window
+	Remaining int
+	// Reset is when the current window ends and the budget is replenished
+	Reset time.Time
+}
+
+// below returns true if the remaining budget is below the given fraction of
+// the limit. A budget whose window has ended is always replenished.
+func (b Budget) below(reserve float64, now time.Time) bool {
+	if b.Limit <= 0 || !now.Before(b.Reset) {
+		return false
+	}
+	return float64(b.Remaining) < reserve*float64(b.Limit)
+}
+
+// Tracker keeps the rate limit budget of the credentials used by providers
+type Tracker interface {
+	// NewRoundTripper wraps a transport to record the rate limit headers of
+	// every response made with the credential identified by credentialKey
+	NewRoundTripper(wrapped http.RoundTripper, credentialKey string) http.RoundTripper
+	// BindProvider associates a provider with the key of its credential, so
+	// that work for the provider can be scheduled against its budgets
+	BindProvider(providerID uuid.UUID, credentialKey string)
+	// Budget returns the last observed budget of a resource of the
+	// provider's credential
+	Budget(providerID uuid.UUID, resource Resource) (Budget, bool)
+	// Admit returns how long work of the given priority for the provider
+	// should be deferred, given the resources the work consumes. A zero
+	// duration means the work can proceed.
+	Admit(ctx context.Context, providerID uuid.UUID, priority Priority, resources ...Resource) time.Duration
+}
+
+// CredentialKey returns the key a credential is tracked by. The cache key of
+// a credential may be the token itself, so it is hashed.
+func CredentialKey(providerClass db.ProviderClass, cacheKey string) string {
+	sum := sha256.Sum256([]byte(cacheKey))
+	return string(providerClass) + ":" + hex.EncodeToString(sum[:8])
+}
+
+type tracker struct {
+	mu        sync.RWMutex
+	budgets   map[string]map[Resource]Budget
+	providers map[uuid.UUID]string
+	now       func() time.Time
+
+	remainingRatio metric.Float64Histogram
+	deferred       metric.Int64Counter
+}
+
+var _ Tracker = (*tracker)(nil)
+
+// NewTracker creates a new budget tracker recording its metrics with the
+// given meter
+func NewTracker(meter metric.Meter) (Tracker, error) {
+	remainingRatio, err := meter.Float64Histogram(
+		"rate_budget.remaining_ratio",
+		metric.WithDescription("Fraction of the rate limit budget remaining after a provider API call"),
+		metric.WithExplicitBucketBoundaries(0, HighPriorityReserve, 0.1, LowPriorityReserve, 0.5, 0.8, 1),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create remaining ratio histogram: %w", err)
+	}
+
+	deferred, err := meter.Int64Counter(
+		"rate_budget.deferred",
+		metric.WithDescription("Number of units of work deferred because of a low rate limit budget"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create deferred counter: %w", err)
+	}
+
+	return &tracker{
+		budgets:        make(map[string]map[Resource]Budget),
+		providers:      make(map[uuid.UUID]string),
+		now:            time.Now,
+		remainingRatio: remainingRatio,
+		deferred:       deferred,
+	}, nil
+}
+
+func (t *tracker) NewRoundTripper(wrapped http.RoundTripper, credentialKey string) http.RoundTripper {
+	if wrapped == nil {
+		wrapped = http.DefaultTransport
+	}
+	return &budgetRoundTripper{
+		wrapped:       wrapped,
+		tracker:       t,
+		credentialKey: credentialKey,
+	}
+}
+
+func (t *tracker) BindProvider(providerID uuid.UUID, credentialKey string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.providers[providerID] = credentialKey
+}
+
+func (t *tracker) Budget(providerID uuid.UUID, resource Resource) (Budget, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	key, ok := t.providers[providerID]
+	if !ok {
+		return Budget{}, false
+	}
+	budget, ok := t.budgets[key][resource]
+	return budget, ok
+}
+
+// Admit defers the work until the budgets of all the resources it consumes
+// are above the reserve of its priority. Work which doesn't name the
+// resources it consumes is admitted against the REST API budget.
+func (t *tracker) Admit(
+	ctx context.Context, providerID uuid.UUID, priority Priority, resources ...Resource,
+) time.Duration {
+	if len(resources) == 0 {
+		resources = []Resource{ResourceCore}
+	}
+
+	reserve := LowPriorityReserve
+	if priority == PriorityHigh {
+		reserve = HighPriorityReserve
+	}
+
+	now := t.now()
+	var delay time.Duration
+	for _, resource := range resources {
+		budget, ok := t.Budget(providerID, resource)
+		if !ok || !budget.below(reserve, now) {
+			// nothing was observed yet, the first calls will tell
+			continue
+		}
+
+		zerolog.Ctx(ctx).Info().
+			Str("provider_id", providerID.String()).
+			Str("priority", priority.String()).
+			Str("resource", string(resource)).
+			Int("remaining", budget.Remaining).
+			Int("limit", budget.Limit).
+			Time("reset", budget.Reset).
+			Msg("deferring work until the rate limit budget is replenished")
+		delay = max(delay, budget.Reset.Sub(now))
+	}
+
+	if delay > 0 {
+		t.deferred.Add(ctx, 1, metric.WithAttributes(attribute.String("priority", priority.String())))
+	}
+	return delay
+}
+
+// observe records the rate limit headers of a response. GitHub tells the
+// resource a response was counted against, GitLab has a single budget.
+func (t *tracker) observe(ctx context.Context, credentialKey string, header http.Header) {
+	resource := ResourceCore
+	limitHeader, remainingHeader, resetHeader := headerLimit, headerRemaining, headerReset
+	if header.Get(headerLimit) == "" {
+		limitHeader, remainingHeader, resetHeader = gitlabHeaderLimit, gitlabHeaderRemaining, gitlabHeaderReset
+	} else if name := header.Get(headerResource); name != "" {
+		resource = Resource(name)
+	}
+
+	limit, err := strconv.Atoi(header.Get(limitHeader))
+	if err != nil || limit <= 0 {
+		return
+	}
+	remaining, err := strconv.Atoi(header.Get(remainingHeader))
+	if err != nil {
+		return
+	}
+	resetEpoch, err := strconv.ParseInt(header.Get(resetHeader), 10, 64)
+	if err != nil {
+		return
+	}
+
+	t.mu.Lock()
+	if t.budgets[credentialKey] == nil {
+		t.budgets[credentialKey] = make(map[Resource]Budget)
+	}
+	t.budgets[credentialKey][resource] = Budget{
+		Limit:     limit,
+		Remaining: remaining,
+		Reset:     time.Unix(resetEpoch, 0),
+	}
+	t.mu.Unlock()
+
+	providerClass, _, _ := strings.Cut(credentialKey, ":")
+	t.remainingRatio.Record(ctx, float64(remaining)/float64(limit),
+		metric.WithAttributes(
+			attribute.String("provider_class", providerClass),
+			attribute.String("resource", string(resource)),
+		))
+}
+
+type budgetRoundTripper struct {
+	wrapped       http.RoundTripper
+	tracker       *tracker
+	credentialKey string
+}
+
+func (b *budgetRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	resp, err := b.wrapped.RoundTrip(r)
+	if err == nil && resp != nil {
+		b.tracker.observe(r.Context(), b.credentialKey, resp.Header)
+	}
+	return resp, err
+}
+
+// NoopTracker is a no-op implementation of the interface used for testing
+// and for tools which don't schedule work
+type NoopTracker struct{}
+
+var _ Tracker = (*NoopTracker)(nil)
+
+// NewRoundTripper returns the wrapped transport
+func (*NoopTracker) NewRoundTripper(wrapped http.RoundTripper, _ string) http.RoundTripper {
+	return wrapped
+}
+
+// BindProvider does nothing
+func (*NoopTracker) BindProvider(_ uuid.UUID, _ string) {
+	// no-op
+}
+
+// Budget never knows the budget
+func (*NoopTracker) Budget(_ uuid.UUID, _ Resource) (Budget, bool) {
+	return Budget{}, false
+}
+
+// Admit always admits the work
+func (*NoopTracker) Admit(_ context.Context, _ uuid.UUID, _ Priority, _ ...Resource) time.Duration {
+	return 0
+}
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ratebudget
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/mindersec/minder/internal/db"
+)
+
+func TestTrackerAdmit(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	reset := now.Add(10 * time.Minute)
+
+	tests := []struct {
+		name         string
+		gitlab       bool
+		resource     Resource
+		remaining    int
+		reset        time.Time
+		expectLow    bool
+		expectHigh   bool
+		expectBudget bool
+	}{
+		{
+			name:         "plenty of budget admits all work",
+			remaining:    4000,
+			reset:        reset,
+			expectBudget: true,
+		},
+		{
+			name:         "low budget defers low priority work",
+			remaining:    500,
+			reset:        reset,
+			expectLow:    true,
+			expectBudget: true,
+		},
+		{
+			name:         "exhausted budget defers all work",
+			remaining:    10,
+			reset:        reset,
+			expectLow:    true,
+			expectHigh:   true,
+			expectBudget: true,
+		},
+		{
+			name:         "replenished budget admits all work",
+			remaining:    0,
+			reset:        now.Add(-time.Minute),
+			expectBudget: true,
+		},
+		{
+			name:         "graphql budget defers graphql work",
+			resource:     ResourceGraphQL,
+			remaining:    10,
+			reset:        reset,
+			expectLow:    true,
+			expectHigh:   true,
+			expectBudget: true,
+		},
+		{
+			name:         "search budget is tracked on its own",
+			resource:     "search",
+			remaining:    0,
+			reset:        reset,
+			expectLow:    true,
+			expectHigh:   true,
+			expectBudget: true,
+		},
+		{
+			name:         "gitlab budget defers low priority work",
+			gitlab:       true,
+			remaining:    500,
+			reset:        reset,
+			expectLow:    true,
+			expectBudget: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if tt.gitlab {
+					w.Header().Set(gitlabHeaderLimit, "5000")
+					w.Header().Set(gitlabHeaderRemaining, strconv.Itoa(tt.remaining))
+					w.Header().Set(gitlabHeaderReset, strconv.FormatInt(tt.reset.Unix(), 10))
+					return
+				}
+				w.Header().Set(headerLimit, "5000")
+				w.Header().Set(headerRemaining, strconv.Itoa(tt.remaining))
+				w.Header().Set(headerReset, strconv.FormatInt(tt.reset.Unix(), 10))
+				if tt.resource != "" {
+					w.Header().Set(headerResource, string(tt.resource))
+				}
+			}))
+			defer srv.Close()
+
+			tr, err := NewTracker(noop.NewMeterProvider().Meter("test"))
+			require.NoError(t, err)
+			tr.(*tracker).now = func() time.Time { return now }
+
+			providerID := uuid.New()
+			key := CredentialKey(db.ProviderClassGithub, "token")
+			tr.BindProvider(providerID, key)
+
+			client := &http.Client{Transport: tr.NewRoundTripper(http.DefaultTransport, key)}
+			resp, err := client.Get(srv.URL)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+
+			resource := tt.resource
+			if resource == "" {
+				resource = ResourceCore
+			}
+			budget, ok := tr.Budget(providerID, resource)
+			require.Equal(t, tt.expectBudget, ok)
+			if ok {
+				require.Equal(t, 5000, budget.Limit)
+				require.Equal(t, tt.remaining, budget.Remaining)
+			}
+
+			ctx := context.Background()
+			require.Equal(t, tt.expectLow, tr.Admit(ctx, providerID, PriorityLow, resource) > 0)
+			require.Equal(t, tt.expectHigh, tr.Admit(ctx, providerID, PriorityHigh, resource) > 0)
+
+			// the budgets of the other resources are not affected
+			if resource != ResourceCore {
+				_, ok := tr.Budget(providerID, ResourceCore)
+				require.False(t, ok)
+				require.Zero(t, tr.Admit(ctx, providerID, PriorityLow))
+			}
+		})
+	}
+}
+
+func TestTrackerAdmitUnknownProvider(t *testing.T) {
+	t.Parallel()
+
+	tr, err := NewTracker(noop.NewMeterProvider().Meter("test"))
+	require.NoError(t, err)
+
+	require.Zero(t, tr.Admit(context.Background(), uuid.New(), PriorityLow))
+}
+
+func TestCredentialKey(t *testing.T) {
+	t.Parallel()
+
+	key := CredentialKey(db.ProviderClassGithub, "ghp_secret")
+	require.NotContains(t, key, "ghp_secret")
+	require.Equal(t, key, CredentialKey(db.ProviderClassGithub, "ghp_secret"))
+	require.NotEqual(t, key, CredentialKey(db.ProviderClassGithub, "ghp_other"))
+}
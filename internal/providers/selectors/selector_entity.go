@@ -6,8 +6,10 @@ package selectors
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/proto"
 
@@ -19,6 +21,15 @@ import (
 	"github.com/mindersec/minder/pkg/entities/properties"
 )
 
+const (
+	// OriginatedFromPath is the selector path of the entity an entity originated from. The
+	// originating entity is resolved lazily, see FillOriginatingEntity.
+	OriginatedFromPath = "entity.originated_from"
+	// EvaluationPath is the selector path of the latest evaluation status of an entity. The
+	// status is resolved lazily, see FillEvaluationStatus.
+	EvaluationPath = "entity.evaluation"
+)
+
 func buildBaseSelectorEntity(
 	entityWithProps *models.EntityWithProperties, selProv *internalpb.SelectorProvider) *internalpb.SelectorEntity {
 	return &internalpb.SelectorEntity{
@@ -152,3 +163,123 @@ func EntityToSelectorEntity(
 	selEnt := converter(entityWithProps, selProv)
 	return selEnt
 }
+
+// EntityToSelectorEntityWithProvider converts an entity of a known provider to a SelectorEntity.
+// Unlike EntityToSelectorEntity, it doesn't look the provider up, which matters when converting
+// the many entities listed from a provider, e.g. the remote repositories considered for
+// auto-registration.
+func EntityToSelectorEntityWithProvider(
+	entType minderv1.Entity,
+	entityWithProps *models.EntityWithProperties,
+	provider *db.Provider,
+) *internalpb.SelectorEntity {
+	converter := newConverter(entType)
+	if converter == nil {
+		return nil
+	}
+
+	return converter(entityWithProps, &internalpb.SelectorProvider{
+		Name:  provider.Name,
+		Class: string(provider.Class),
+	})
+}
+
+// FillOriginatingEntity resolves the entity that the entity in entityWithProps originated from,
+// e.g. the repository of a pull request, and sets it as the originated_from attribute of the
+// selector entity. Entities that have no parent are left untouched.
+func FillOriginatingEntity(
+	ctx context.Context,
+	querier db.Store,
+	selEnt *internalpb.SelectorEntity,
+	entityWithProps *models.EntityWithProperties,
+) error {
+	if entityWithProps.Entity.OriginatedFrom == uuid.Nil {
+		return nil
+	}
+
+	if querier == nil {
+		return errors.New("no querier, cannot resolve the originating entity")
+	}
+
+	dbEntity, err := querier.GetEntityByID(ctx, entityWithProps.Entity.OriginatedFrom)
+	if err != nil {
+		return fmt.Errorf("failed to get originating entity %s: %w", entityWithProps.Entity.OriginatedFrom, err)
+	}
+
+	dbProps, err := querier.GetAllPropertiesForEntity(ctx, dbEntity.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get properties of originating entity %s: %w", dbEntity.ID, err)
+	}
+
+	props, err := models.DbPropsToModel(dbProps)
+	if err != nil {
+		return fmt.Errorf("failed to convert properties of originating entity %s: %w", dbEntity.ID, err)
+	}
+
+	origin := models.NewEntityWithProperties(dbEntity, props)
+	originSelEnt := EntityToSelectorEntity(ctx, querier, origin.Entity.Type, origin)
+	if originSelEnt == nil {
+		return fmt.Errorf("failed to convert originating entity %s to selector entity", dbEntity.ID)
+	}
+
+	selEnt.OriginatedFrom = originSelEnt
+	return nil
+}
+
+// evalStatusPrecedence orders the evaluation statuses in the same way the profile status is
+// aggregated in the database: a single rule in error state means the whole evaluation is in
+// error state, then failure, success, skipped and pending. Rules that timed out count as errors
+// and waived rules count as successful.
+var evalStatusPrecedence = map[db.EvalStatusTypes]int{
+	db.EvalStatusTypesError:   5,
+	db.EvalStatusTypesFailure: 4,
+	db.EvalStatusTypesSuccess: 3,
+	db.EvalStatusTypesSkipped: 2,
+	db.EvalStatusTypesPending: 1,
+}
+
+// FillEvaluationStatus sets the latest evaluation status of the entity against the rules of
+// the profile as the evaluation attribute of the selector entity. The status is read-only data
+// for the selectors, it is not updated by the evaluation currently in progress.
+func FillEvaluationStatus(
+	ctx context.Context,
+	querier db.Store,
+	selEnt *internalpb.SelectorEntity,
+	entityID uuid.UUID,
+	profileID uuid.UUID,
+) error {
+	if querier == nil {
+		return errors.New("no querier, cannot fill the evaluation status")
+	}
+
+	rows, err := querier.ListLatestEvalStatusesForEntityAndProfile(ctx, db.ListLatestEvalStatusesForEntityAndProfileParams{
+		ProfileID: profileID,
+		EntityID:  entityID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list latest evaluation statuses: %w", err)
+	}
+
+	evaluation := &internalpb.SelectorEvaluation{
+		Rules: make(map[string]string, len(rows)),
+	}
+	var aggregated db.EvalStatusTypes
+	for _, row := range rows {
+		evaluation.Rules[row.RuleName] = string(row.Status)
+		status := row.Status
+		switch status {
+		case db.EvalStatusTypesTimeout:
+			status = db.EvalStatusTypesError
+		case db.EvalStatusTypesWaived:
+			status = db.EvalStatusTypesSuccess
+		default:
+		}
+		if evalStatusPrecedence[status] > evalStatusPrecedence[aggregated] {
+			aggregated = status
+		}
+	}
+	evaluation.Status = string(aggregated)
+
+	selEnt.Evaluation = evaluation
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"database/sql"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -288,3 +289,187 @@ func TestEntityToSelectorEntity(t *testing.T) {
 		})
 	}
 }
+
+func TestFillOriginatingEntity(t *testing.T) {
+	t.Parallel()
+
+	repoID := uuid.New()
+	isPrivateVal, err := db.PropValueToDbV1(true)
+	require.NoError(t, err)
+
+	scenarios := []struct {
+		name           string
+		originatedFrom uuid.UUID
+		dbSetup        dbf.DBMockBuilder
+		expOrigin      bool
+		success        bool
+	}{
+		{
+			name:    "Entity without a parent is left untouched",
+			success: true,
+		},
+		{
+			name:           "Parent repository is resolved",
+			originatedFrom: repoID,
+			dbSetup: dbf.NewDBMock(
+				func(mock dbf.DBMock) {
+					mock.EXPECT().
+						GetEntityByID(gomock.Any(), repoID).
+						Return(db.EntityInstance{
+							ID:         repoID,
+							EntityType: db.EntitiesRepository,
+							Name:       "testorg/testrepo",
+						}, nil)
+					mock.EXPECT().
+						GetAllPropertiesForEntity(gomock.Any(), repoID).
+						Return([]db.Property{
+							{EntityID: repoID, Key: properties.RepoPropertyIsPrivate, Value: isPrivateVal},
+						}, nil)
+				},
+				withGetProviderByID(githubProvider, nil),
+			),
+			expOrigin: true,
+			success:   true,
+		},
+		{
+			name:           "Parent repository cannot be found",
+			originatedFrom: repoID,
+			dbSetup: dbf.NewDBMock(
+				func(mock dbf.DBMock) {
+					mock.EXPECT().
+						GetEntityByID(gomock.Any(), repoID).
+						Return(db.EntityInstance{}, sql.ErrNoRows)
+				},
+			),
+			success: false,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var mockQuerier db.Store
+			if scenario.dbSetup != nil {
+				mockQuerier = scenario.dbSetup(ctrl)
+			}
+
+			entity := buildEntityWithProperties(minderv1.Entity_ENTITY_PULL_REQUESTS, "testorg/testrepo/123", nil)
+			entity.Entity.OriginatedFrom = scenario.originatedFrom
+			selEnt := &internalpb.SelectorEntity{
+				EntityType: minderv1.Entity_ENTITY_PULL_REQUESTS,
+				Name:       "testorg/testrepo/123",
+			}
+
+			err := FillOriginatingEntity(context.Background(), mockQuerier, selEnt, entity)
+			if !scenario.success {
+				require.Error(t, err)
+				require.Nil(t, selEnt.GetOriginatedFrom())
+				return
+			}
+
+			require.NoError(t, err)
+			if !scenario.expOrigin {
+				require.Nil(t, selEnt.GetOriginatedFrom())
+				return
+			}
+
+			origin := selEnt.GetOriginatedFrom()
+			require.NotNil(t, origin)
+			require.Equal(t, minderv1.Entity_ENTITY_REPOSITORIES, origin.GetEntityType())
+			require.Equal(t, "testorg/testrepo", origin.GetName())
+			require.Equal(t, githubProvider.Name, origin.GetProvider().GetName())
+			require.True(t, origin.GetRepository().GetIsPrivate())
+		})
+	}
+}
+
+func TestFillEvaluationStatus(t *testing.T) {
+	t.Parallel()
+
+	entityID := uuid.New()
+	profileID := uuid.New()
+
+	scenarios := []struct {
+		name      string
+		rows      []db.ListLatestEvalStatusesForEntityAndProfileRow
+		dbErr     error
+		expStatus string
+		expRules  map[string]string
+		success   bool
+	}{
+		{
+			name:      "Not evaluated yet",
+			rows:      []db.ListLatestEvalStatusesForEntityAndProfileRow{},
+			expStatus: "",
+			expRules:  map[string]string{},
+			success:   true,
+		},
+		{
+			name: "Failure takes precedence over success",
+			rows: []db.ListLatestEvalStatusesForEntityAndProfileRow{
+				{RuleName: "secret_scanning", Status: db.EvalStatusTypesSuccess},
+				{RuleName: "branch_protection", Status: db.EvalStatusTypesFailure},
+				{RuleName: "license", Status: db.EvalStatusTypesSkipped},
+			},
+			expStatus: "failure",
+			expRules: map[string]string{
+				"secret_scanning":   "success",
+				"branch_protection": "failure",
+				"license":           "skipped",
+			},
+			success: true,
+		},
+		{
+			name: "Error takes precedence over failure",
+			rows: []db.ListLatestEvalStatusesForEntityAndProfileRow{
+				{RuleName: "secret_scanning", Status: db.EvalStatusTypesError},
+				{RuleName: "branch_protection", Status: db.EvalStatusTypesFailure},
+			},
+			expStatus: "error",
+			expRules: map[string]string{
+				"secret_scanning":   "error",
+				"branch_protection": "failure",
+			},
+			success: true,
+		},
+		{
+			name:    "Database error",
+			dbErr:   sql.ErrConnDone,
+			success: false,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockQuerier := dbf.NewDBMock(func(mock dbf.DBMock) {
+				mock.EXPECT().
+					ListLatestEvalStatusesForEntityAndProfile(gomock.Any(), db.ListLatestEvalStatusesForEntityAndProfileParams{
+						ProfileID: profileID,
+						EntityID:  entityID,
+					}).
+					Return(scenario.rows, scenario.dbErr)
+			})(ctrl)
+
+			selEnt := &internalpb.SelectorEntity{}
+			err := FillEvaluationStatus(context.Background(), mockQuerier, selEnt, entityID, profileID)
+			if !scenario.success {
+				require.Error(t, err)
+				require.Nil(t, selEnt.GetEvaluation())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, scenario.expStatus, selEnt.GetEvaluation().GetStatus())
+			require.Equal(t, scenario.expRules, selEnt.GetEvaluation().GetRules())
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcilers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/go-playground/validator/v10"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/logger"
+	"github.com/mindersec/minder/internal/reconcilers/messages"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/entities/properties"
+)
+
+// handleAutoRegistrationEvent applies the auto-registration rules of a
+// project to all the repositories of one of its providers.
+func (r *Reconciler) handleAutoRegistrationEvent(msg *message.Message) error {
+	ctx := msg.Context()
+
+	var evt messages.ProviderEvent
+	if err := json.Unmarshal(msg.Payload, &evt); err != nil {
+		return fmt.Errorf("error unmarshalling payload: %w", err)
+	}
+
+	l := zerolog.Ctx(ctx).With().
+		Str("provider_id", evt.Provider.String()).
+		Str("project_id", evt.Project.String()).
+		Logger()
+
+	// Telemetry logging
+	logger.BusinessRecord(ctx).ProviderID = evt.Provider
+	logger.BusinessRecord(ctx).Project = evt.Project
+
+	report, err := r.autoRegistration.ReconcileProvider(ctx, evt.Project, evt.Provider)
+	if err != nil {
+		// The next reconciliation will pick up any change missed here,
+		// so there is no use retrying the event.
+		l.Error().Err(err).Msg("error reconciling auto-registration rules")
+		return nil
+	}
+
+	l.Info().
+		Int("registered", len(report.GetRegistered())).
+		Int("deregistered", len(report.GetDeregistered())).
+		Msg("reconciled auto-registration rules")
+	return nil
+}
+
+// handleAutoRegisterEntityEvent registers a single repository, typically one
+// which was just created upstream, if an auto-registration rule selects it.
+func (r *Reconciler) handleAutoRegisterEntityEvent(msg *message.Message) error {
+	ctx := msg.Context()
+	l := zerolog.Ctx(ctx).With().Logger()
+
+	var event messages.MinderEvent
+	if err := json.Unmarshal(msg.Payload, &event); err != nil {
+		return fmt.Errorf("error unmarshalling payload: %w", err)
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(&event); err != nil {
+		// We don't return the event since there's no use
+		// retrying it if it's invalid.
+		l.Error().Err(err).Msg("error validating event")
+		return nil
+	}
+
+	if event.EntityType != pb.Entity_ENTITY_REPOSITORIES {
+		l.Debug().Str("entity_type", event.EntityType.String()).Msg("unsupported entity type")
+		return nil
+	}
+
+	fetchByProps := properties.NewProperties(event.Properties)
+
+	l = l.With().
+		Str("provider_id", event.ProviderID.String()).
+		Str("project_id", event.ProjectID.String()).
+		Dict("properties", fetchByProps.ToLogDict()).
+		Logger()
+
+	// Telemetry logging
+	logger.BusinessRecord(ctx).ProviderID = event.ProviderID
+	logger.BusinessRecord(ctx).Project = event.ProjectID
+
+	rule, err := r.autoRegistration.RegisterIfSelected(ctx, event.ProjectID, event.ProviderID, fetchByProps)
+	if err != nil {
+		return fmt.Errorf("error auto-registering repository: %w", err)
+	}
+	if rule != "" {
+		l.Info().Str("rule", rule).Msg("auto-registered repository")
+	}
+	return nil
+}
@@ -171,6 +171,9 @@ func setUp(t *testing.T, tt testCase, ctrl *gomock.Controller) *Reconciler {
 		nil, // crypto.Engine not used in these tests
 		nil, // manager.ProviderManager not used in these tests
 		repoService,
+		nil, // autoregistration.AutoRegistrationService not used in these tests
+		nil, // health.ProviderHealthService not used in these tests
+		nil, // webhooks.WebhookReconcileService not used in these tests
 	)
 	require.NoError(t, err)
 
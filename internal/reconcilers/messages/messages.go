@@ -43,6 +43,32 @@ func NewRepoReconcilerMessage(providerID uuid.UUID, entityID uuid.UUID, projectI
 	return msg, nil
 }
 
+// ProviderEvent is an event that is sent to reconcile a provider as a
+// whole: its auto-registration rules, its health or its webhooks,
+// depending on the topic it is sent to
+type ProviderEvent struct {
+	// Project is the project the provider belongs to
+	Project uuid.UUID `json:"project"`
+	// Provider is the provider to reconcile
+	Provider uuid.UUID `json:"provider"`
+}
+
+// NewProviderMessage creates a new provider reconcile event
+func NewProviderMessage(providerID uuid.UUID, projectID uuid.UUID) (*message.Message, error) {
+	evt := &ProviderEvent{
+		Project:  projectID,
+		Provider: providerID,
+	}
+
+	evtStr, err := json.Marshal(evt)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling provider event: %w", err)
+	}
+
+	msg := message.NewMessage(uuid.New().String(), evtStr)
+	return msg, nil
+}
+
 // CoreContext contains information necessary to further process
 // events inside Minder Core.
 type CoreContext struct {
@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcilers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/logger"
+	"github.com/mindersec/minder/internal/reconcilers/messages"
+)
+
+// handleProviderHealthEvent checks the health of a provider and records it.
+func (r *Reconciler) handleProviderHealthEvent(msg *message.Message) error {
+	ctx := msg.Context()
+
+	var evt messages.ProviderEvent
+	if err := json.Unmarshal(msg.Payload, &evt); err != nil {
+		return fmt.Errorf("error unmarshalling payload: %w", err)
+	}
+
+	l := zerolog.Ctx(ctx).With().
+		Str("provider_id", evt.Provider.String()).
+		Str("project_id", evt.Project.String()).
+		Logger()
+
+	// Telemetry logging
+	logger.BusinessRecord(ctx).ProviderID = evt.Provider
+	logger.BusinessRecord(ctx).Project = evt.Project
+
+	health, err := r.providerHealth.CheckProvider(ctx, evt.Project, evt.Provider)
+	if err != nil {
+		// The provider is checked again on the next reminder,
+		// so there is no use retrying the event.
+		l.Error().Err(err).Msg("error checking provider health")
+		return nil
+	}
+
+	l.Info().
+		Str("status", string(health.Status)).
+		Str("last_error", health.LastError).
+		Msg("checked provider health")
+	return nil
+}
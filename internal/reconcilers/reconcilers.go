@@ -8,19 +8,25 @@ package reconcilers
 import (
 	"github.com/mindersec/minder/internal/crypto"
 	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/providers/health"
 	"github.com/mindersec/minder/internal/providers/manager"
 	"github.com/mindersec/minder/internal/repositories"
+	"github.com/mindersec/minder/internal/repositories/autoregistration"
+	"github.com/mindersec/minder/internal/repositories/webhooks"
 	"github.com/mindersec/minder/pkg/eventer/constants"
 	"github.com/mindersec/minder/pkg/eventer/interfaces"
 )
 
 // Reconciler is a helper that reconciles entities
 type Reconciler struct {
-	store           db.Store
-	evt             interfaces.Publisher
-	crypteng        crypto.Engine
-	providerManager manager.ProviderManager
-	repos           repositories.RepositoryService
+	store            db.Store
+	evt              interfaces.Publisher
+	crypteng         crypto.Engine
+	providerManager  manager.ProviderManager
+	repos            repositories.RepositoryService
+	autoRegistration autoregistration.AutoRegistrationService
+	providerHealth   health.ProviderHealthService
+	webhooks         webhooks.WebhookReconcileService
 }
 
 // NewReconciler creates a new reconciler object
@@ -30,13 +36,19 @@ func NewReconciler(
 	cryptoEngine crypto.Engine,
 	providerManager manager.ProviderManager,
 	repositoryService repositories.RepositoryService,
+	autoRegistration autoregistration.AutoRegistrationService,
+	providerHealth health.ProviderHealthService,
+	webhookReconcile webhooks.WebhookReconcileService,
 ) (*Reconciler, error) {
 	return &Reconciler{
-		store:           store,
-		evt:             evt,
-		crypteng:        cryptoEngine,
-		providerManager: providerManager,
-		repos:           repositoryService,
+		store:            store,
+		evt:              evt,
+		crypteng:         cryptoEngine,
+		providerManager:  providerManager,
+		repos:            repositoryService,
+		autoRegistration: autoRegistration,
+		providerHealth:   providerHealth,
+		webhooks:         webhookReconcile,
 	}, nil
 }
 
@@ -46,4 +58,8 @@ func (r *Reconciler) Register(reg interfaces.Registrar) {
 	reg.Register(constants.TopicQueueReconcileProfileInit, r.handleProfileInitEvent)
 	reg.Register(constants.TopicQueueReconcileEntityDelete, r.handleEntityDeleteEvent)
 	reg.Register(constants.TopicQueueReconcileEntityAdd, r.handleEntityAddEvent)
+	reg.Register(constants.TopicQueueReconcileAutoRegistration, r.handleAutoRegistrationEvent)
+	reg.Register(constants.TopicQueueAutoRegisterEntity, r.handleAutoRegisterEntityEvent)
+	reg.Register(constants.TopicQueueReconcileProviderHealth, r.handleProviderHealthEvent)
+	reg.Register(constants.TopicQueueReconcileWebhooks, r.handleWebhookReconcileEvent)
 }
@@ -17,6 +17,10 @@ import (
 	"github.com/mindersec/minder/pkg/eventer/constants"
 )
 
+// prefetchBatchSize is the maximum number of entities in a single
+// properties prefetch message
+const prefetchBatchSize = 100
+
 // ProfileInitEvent is an event that is sent to the reconciler topic
 // when a new profile is created. It is used to initialize the profile
 // by iterating over all registered entities for the relevant project
@@ -83,22 +87,49 @@ func (r *Reconciler) publishProfileInitEvents(
 		return fmt.Errorf("cannot get entities: %w", err)
 	}
 
+	// The entities are refreshed by the follow-ups of the properties
+	// prefetches of their provider, so that they find their properties fresh
+	var providers []uuid.UUID
+	entityIDs := make(map[uuid.UUID][]uuid.UUID)
+	refreshes := make(map[uuid.UUID][]*message.Message)
 	for _, ent := range ents {
 		entRefresh := entityMessage.NewEntityRefreshAndDoMessage().
 			WithEntityID(ent.ID)
 
 		m := message.NewMessage(uuid.New().String(), nil)
-		m.SetContext(ctx)
-
 		if err := entRefresh.ToMessage(m); err != nil {
 			zerolog.Ctx(ctx).Error().Err(err).Msg("error marshalling message")
 			// no point in retrying, so we return nil
 			return nil
 		}
 
-		if err := r.evt.Publish(constants.TopicQueueRefreshEntityByIDAndEvaluate, m); err != nil {
-			// we retry in case watermill is having a bad day
-			return fmt.Errorf("error publishing message: %w", err)
+		if _, ok := entityIDs[ent.ProviderID]; !ok {
+			providers = append(providers, ent.ProviderID)
+		}
+		entityIDs[ent.ProviderID] = append(entityIDs[ent.ProviderID], ent.ID)
+		refreshes[ent.ProviderID] = append(refreshes[ent.ProviderID], m)
+	}
+
+	for _, providerID := range providers {
+		ids := entityIDs[providerID]
+		for start := 0; start < len(ids); start += prefetchBatchSize {
+			end := min(start+prefetchBatchSize, len(ids))
+
+			prefetch := entityMessage.NewPrefetchPropertiesMessage(providerID, ids[start:end]).
+				WithFollowUps(constants.TopicQueueRefreshEntityByIDAndEvaluate, refreshes[providerID][start:end]...)
+
+			m := message.NewMessage(uuid.New().String(), nil)
+			m.SetContext(ctx)
+			if err := prefetch.ToMessage(m); err != nil {
+				zerolog.Ctx(ctx).Error().Err(err).Msg("error marshalling message")
+				// no point in retrying, so we return nil
+				return nil
+			}
+
+			if err := r.evt.Publish(constants.TopicQueuePrefetchEntityProperties, m); err != nil {
+				// we retry in case watermill is having a bad day
+				return fmt.Errorf("error publishing message: %w", err)
+			}
 		}
 	}
 
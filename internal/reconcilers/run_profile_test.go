@@ -14,6 +14,7 @@ import (
 
 	df "github.com/mindersec/minder/database/mock/fixtures"
 	"github.com/mindersec/minder/internal/db"
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
 	stubeventer "github.com/mindersec/minder/internal/events/stubs"
 	"github.com/mindersec/minder/pkg/eventer/constants"
 )
@@ -53,7 +54,8 @@ func Test_handleProfileInitEvent(t *testing.T) {
 				)
 			},
 			expectedErr: false,
-			numPublish:  3,
+			// one prefetch for the provider, followed by a refresh per entity
+			numPublish: 1,
 		},
 		{
 			name: "error getting entities",
@@ -81,7 +83,7 @@ func Test_handleProfileInitEvent(t *testing.T) {
 			stubEventer := &stubeventer.StubEventer{}
 			mockStore := scenario.setupDbMocks()(ctrl)
 
-			reconciler, err := NewReconciler(mockStore, stubEventer, nil, nil, nil)
+			reconciler, err := NewReconciler(mockStore, stubEventer, nil, nil, nil, nil, nil, nil)
 			require.NoError(t, err)
 			require.NotNil(t, reconciler)
 
@@ -94,7 +96,13 @@ func Test_handleProfileInitEvent(t *testing.T) {
 
 			require.Equal(t, scenario.numPublish, len(stubEventer.Sent))
 			if scenario.numPublish > 0 {
-				require.Contains(t, stubEventer.Topics, constants.TopicQueueRefreshEntityByIDAndEvaluate)
+				require.Equal(t, []string{constants.TopicQueuePrefetchEntityProperties}, stubEventer.Topics)
+
+				prefetch, err := entityMessage.ToPrefetchProperties(stubEventer.Sent[0])
+				require.NoError(t, err)
+				require.Len(t, prefetch.EntityIDs, 3)
+				require.Equal(t, constants.TopicQueueRefreshEntityByIDAndEvaluate, prefetch.FollowUpTopic)
+				require.Len(t, prefetch.FollowUps, 3)
 			}
 		})
 	}
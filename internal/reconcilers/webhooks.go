@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcilers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/logger"
+	"github.com/mindersec/minder/internal/reconcilers/messages"
+)
+
+// handleWebhookReconcileEvent checks and repairs the webhooks of the
+// repositories registered with a provider.
+func (r *Reconciler) handleWebhookReconcileEvent(msg *message.Message) error {
+	ctx := msg.Context()
+
+	var evt messages.ProviderEvent
+	if err := json.Unmarshal(msg.Payload, &evt); err != nil {
+		return fmt.Errorf("error unmarshalling payload: %w", err)
+	}
+
+	l := zerolog.Ctx(ctx).With().
+		Str("provider_id", evt.Provider.String()).
+		Str("project_id", evt.Project.String()).
+		Logger()
+
+	// Telemetry logging
+	logger.BusinessRecord(ctx).ProviderID = evt.Provider
+	logger.BusinessRecord(ctx).Project = evt.Project
+
+	report, err := r.webhooks.ReconcileProvider(l.WithContext(ctx), evt.Project, evt.Provider)
+	if err != nil {
+		// The webhooks are reconciled again on the next reminder,
+		// so there is no use retrying the event.
+		l.Error().Err(err).Msg("error reconciling webhooks")
+		return nil
+	}
+
+	l.Info().
+		Int("checked", report.Checked).
+		Int("repaired", report.Repaired).
+		Int("quiet", report.Quiet).
+		Int("failed", report.Failed).
+		Msg("reconciled webhooks")
+	return nil
+}
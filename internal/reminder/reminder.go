@@ -17,6 +17,8 @@ import (
 	"go.opentelemetry.io/otel"
 
 	"github.com/mindersec/minder/internal/db"
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	reconcilermessages "github.com/mindersec/minder/internal/reconcilers/messages"
 	remindermessages "github.com/mindersec/minder/internal/reminder/messages"
 	"github.com/mindersec/minder/internal/reminder/metrics"
 	reminderconfig "github.com/mindersec/minder/pkg/config/reminder"
@@ -41,7 +43,10 @@ type reminder struct {
 
 	repositoryCursor uuid.UUID
 
-	ticker *time.Ticker
+	ticker        *time.Ticker
+	autoRegTicker *time.Ticker
+	healthTicker  *time.Ticker
+	webhookTicker *time.Ticker
 
 	eventPublisher message.Publisher
 
@@ -106,6 +111,26 @@ func (r *reminder) Start(ctx context.Context) error {
 
 	r.ticker = time.NewTicker(interval)
 
+	// A nil channel blocks forever, which disables the
+	// auto-registration reminders
+	var autoRegTick <-chan time.Time
+	if autoRegInterval := r.cfg.RecurrenceConfig.AutoRegistrationInterval; autoRegInterval > 0 {
+		r.autoRegTicker = time.NewTicker(autoRegInterval)
+		autoRegTick = r.autoRegTicker.C
+	}
+
+	var healthTick <-chan time.Time
+	if healthInterval := r.cfg.RecurrenceConfig.ProviderHealthInterval; healthInterval > 0 {
+		r.healthTicker = time.NewTicker(healthInterval)
+		healthTick = r.healthTicker.C
+	}
+
+	var webhookTick <-chan time.Time
+	if webhookInterval := r.cfg.RecurrenceConfig.WebhookReconcileInterval; webhookInterval > 0 {
+		r.webhookTicker = time.NewTicker(webhookInterval)
+		webhookTick = r.webhookTicker.C
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -123,6 +148,21 @@ func (r *reminder) Start(ctx context.Context) error {
 			if err := r.sendReminders(ctx); err != nil {
 				logger.Error().Err(err).Msg("reconciliation request unsuccessful")
 			}
+			if err := r.purgePropertyHistory(ctx); err != nil {
+				logger.Error().Err(err).Msg("property history purge unsuccessful")
+			}
+		case <-autoRegTick:
+			if err := r.sendAutoRegistrationReminders(ctx); err != nil {
+				logger.Error().Err(err).Msg("auto-registration request unsuccessful")
+			}
+		case <-healthTick:
+			if err := r.sendProviderHealthReminders(ctx); err != nil {
+				logger.Error().Err(err).Msg("provider health request unsuccessful")
+			}
+		case <-webhookTick:
+			if err := r.sendWebhookReminders(ctx); err != nil {
+				logger.Error().Err(err).Msg("webhook reconciliation request unsuccessful")
+			}
 		}
 	}
 }
@@ -138,6 +178,15 @@ func (r *reminder) Stop() {
 	if r.ticker != nil {
 		defer r.ticker.Stop()
 	}
+	if r.autoRegTicker != nil {
+		defer r.autoRegTicker.Stop()
+	}
+	if r.healthTicker != nil {
+		defer r.healthTicker.Stop()
+	}
+	if r.webhookTicker != nil {
+		defer r.webhookTicker.Stop()
+	}
 	r.stopOnce.Do(func() {
 		close(r.stop)
 		err := r.eventPublisher.Close()
@@ -174,7 +223,15 @@ func (r *reminder) sendReminders(ctx context.Context) error {
 		r.metrics.BatchSize.Record(ctx, int64(len(repos)))
 	}
 
-	err = r.eventPublisher.Publish(constants.TopicQueueRepoReminder, messages...)
+	// The reminders are published once the properties of the batch were
+	// prefetched, so that providers supporting it refresh them with a few
+	// batched calls instead of one call per repository.
+	prefetchMessages, err := createPrefetchMessages(repos, messages)
+	if err != nil {
+		return fmt.Errorf("error creating prefetch messages: %w", err)
+	}
+
+	err = r.eventPublisher.Publish(constants.TopicQueuePrefetchEntityProperties, prefetchMessages...)
 	if err != nil {
 		return fmt.Errorf("error publishing messages: %w", err)
 	}
@@ -197,6 +254,89 @@ func (r *reminder) sendReminders(ctx context.Context) error {
 	return nil
 }
 
+// sendAutoRegistrationReminders requests the reconciliation of the
+// auto-registration rules of every provider they apply to
+func (r *reminder) sendAutoRegistrationReminders(ctx context.Context) error {
+	targets, err := r.store.ListAutoRegistrationTargets(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing auto-registration targets: %w", err)
+	}
+
+	providers := make([]db.Provider, 0, len(targets))
+	for _, target := range targets {
+		providers = append(providers, db.Provider{ID: target.ProviderID, ProjectID: target.ProjectID})
+	}
+
+	return r.sendProviderReminders(ctx, constants.TopicQueueReconcileAutoRegistration, "auto-registration", providers)
+}
+
+// sendProviderHealthReminders requests a health check of every provider
+func (r *reminder) sendProviderHealthReminders(ctx context.Context) error {
+	providers, err := r.store.GlobalListProviders(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing providers: %w", err)
+	}
+
+	return r.sendProviderReminders(ctx, constants.TopicQueueReconcileProviderHealth, "provider health", providers)
+}
+
+// sendWebhookReminders requests a reconciliation of the webhooks of the
+// entities of every provider
+func (r *reminder) sendWebhookReminders(ctx context.Context) error {
+	providers, err := r.store.GlobalListProviders(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing providers: %w", err)
+	}
+
+	return r.sendProviderReminders(ctx, constants.TopicQueueReconcileWebhooks, "webhook reconciliation", providers)
+}
+
+// sendProviderReminders publishes a provider reconcile event to the topic
+// for each of the given providers. The kind names the reminders in the logs.
+func (r *reminder) sendProviderReminders(ctx context.Context, topic string, kind string, providers []db.Provider) error {
+	if len(providers) == 0 {
+		zerolog.Ctx(ctx).Debug().Msgf("no providers to send %s reminders for", kind)
+		return nil
+	}
+
+	messages := make([]*message.Message, 0, len(providers))
+	for _, prov := range providers {
+		msg, err := reconcilermessages.NewProviderMessage(prov.ID, prov.ProjectID)
+		if err != nil {
+			return fmt.Errorf("error creating %s message: %w", kind, err)
+		}
+		messages = append(messages, msg)
+	}
+
+	zerolog.Ctx(ctx).Info().Msgf("sending %d %s reminders", len(messages), kind)
+
+	if err := r.eventPublisher.Publish(topic, messages...); err != nil {
+		return fmt.Errorf("error publishing messages: %w", err)
+	}
+
+	return nil
+}
+
+// purgePropertyHistory deletes the property changes older than the
+// configured retention
+func (r *reminder) purgePropertyHistory(ctx context.Context) error {
+	retention := r.cfg.RecurrenceConfig.PropertyHistoryRetention
+	if retention <= 0 {
+		return nil
+	}
+
+	deleted, err := r.store.DeletePropertyHistoryBefore(ctx, time.Now().Add(-retention))
+	if err != nil {
+		return fmt.Errorf("error deleting property history: %w", err)
+	}
+
+	if deleted > 0 {
+		zerolog.Ctx(ctx).Info().Int64("deleted", deleted).Msg("purged property history")
+	}
+
+	return nil
+}
+
 func (r *reminder) getRepositoryBatch(ctx context.Context) ([]db.EntityInstance, map[uuid.UUID]time.Time, error) {
 	logger := zerolog.Ctx(ctx)
 
@@ -310,3 +450,33 @@ func createReminderMessages(ctx context.Context, repos []db.EntityInstance) ([]*
 
 	return messages, nil
 }
+
+// createPrefetchMessages creates a properties prefetch message for each
+// provider of the repositories, followed by the reminders of its repositories.
+// The reminders are in the order of the repositories.
+func createPrefetchMessages(repos []db.EntityInstance, reminders []*message.Message) ([]*message.Message, error) {
+	var providers []uuid.UUID
+	entityIDs := make(map[uuid.UUID][]uuid.UUID)
+	followUps := make(map[uuid.UUID][]*message.Message)
+	for i, repo := range repos {
+		if _, ok := entityIDs[repo.ProviderID]; !ok {
+			providers = append(providers, repo.ProviderID)
+		}
+		entityIDs[repo.ProviderID] = append(entityIDs[repo.ProviderID], repo.ID)
+		followUps[repo.ProviderID] = append(followUps[repo.ProviderID], reminders[i])
+	}
+
+	messages := make([]*message.Message, 0, len(providers))
+	for _, providerID := range providers {
+		msg := message.NewMessage(uuid.New().String(), nil)
+		err := entityMessage.NewPrefetchPropertiesMessage(providerID, entityIDs[providerID]).
+			WithFollowUps(constants.TopicQueueRepoReminder, followUps[providerID]...).
+			ToMessage(msg)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, msg)
+	}
+
+	return messages, nil
+}
@@ -6,17 +6,22 @@ package reminder
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	mockdb "github.com/mindersec/minder/database/mock"
 	"github.com/mindersec/minder/internal/db"
+	entityMessage "github.com/mindersec/minder/internal/entities/handlers/message"
+	reconcilermessages "github.com/mindersec/minder/internal/reconcilers/messages"
 	reminderconfig "github.com/mindersec/minder/pkg/config/reminder"
+	"github.com/mindersec/minder/pkg/eventer/constants"
 )
 
 func Test_getRepositoryBatch(t *testing.T) {
@@ -170,6 +175,169 @@ func Test_getRepositoryBatch(t *testing.T) {
 	}
 }
 
+func Test_sendProviderReminders(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	providerID := uuid.New()
+
+	tests := []struct {
+		name      string
+		setup     func(store *mockdb.MockStore)
+		send      func(r *reminder, ctx context.Context) error
+		wantTopic string
+	}{
+		{
+			name: "auto-registration",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAutoRegistrationTargets(gomock.Any()).Return([]db.ListAutoRegistrationTargetsRow{
+					{ProjectID: projectID, ProviderID: providerID},
+				}, nil)
+			},
+			send:      (*reminder).sendAutoRegistrationReminders,
+			wantTopic: constants.TopicQueueReconcileAutoRegistration,
+		},
+		{
+			name: "provider health",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GlobalListProviders(gomock.Any()).Return([]db.Provider{
+					{ID: providerID, ProjectID: projectID},
+				}, nil)
+			},
+			send:      (*reminder).sendProviderHealthReminders,
+			wantTopic: constants.TopicQueueReconcileProviderHealth,
+		},
+		{
+			name: "webhook reconciliation",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GlobalListProviders(gomock.Any()).Return([]db.Provider{
+					{ID: providerID, ProjectID: projectID},
+				}, nil)
+			},
+			send:      (*reminder).sendWebhookReminders,
+			wantTopic: constants.TopicQueueReconcileWebhooks,
+		},
+		{
+			name: "no providers",
+			setup: func(store *mockdb.MockStore) {
+				store.EXPECT().GlobalListProviders(gomock.Any()).Return(nil, nil)
+			},
+			send: (*reminder).sendWebhookReminders,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			tt.setup(store)
+
+			pub := &stubPublisher{}
+			r := createTestReminder(t, store, &reminderconfig.Config{})
+			r.eventPublisher = pub
+
+			require.NoError(t, tt.send(r, context.Background()))
+			require.Equal(t, tt.wantTopic, pub.topic)
+			if tt.wantTopic == "" {
+				require.Empty(t, pub.messages)
+				return
+			}
+			require.Len(t, pub.messages, 1)
+
+			var evt reconcilermessages.ProviderEvent
+			require.NoError(t, json.Unmarshal(pub.messages[0].Payload, &evt))
+			require.Equal(t, projectID, evt.Project)
+			require.Equal(t, providerID, evt.Provider)
+		})
+	}
+}
+
+func Test_createPrefetchMessages(t *testing.T) {
+	t.Parallel()
+
+	providerA := generateUUIDFromNum(t, 1)
+	providerB := generateUUIDFromNum(t, 2)
+	repos := []db.EntityInstance{
+		{ID: generateUUIDFromNum(t, 10), ProviderID: providerA},
+		{ID: generateUUIDFromNum(t, 11), ProviderID: providerB},
+		{ID: generateUUIDFromNum(t, 12), ProviderID: providerA},
+	}
+
+	reminders, err := createReminderMessages(context.Background(), repos)
+	require.NoError(t, err)
+
+	messages, err := createPrefetchMessages(repos, reminders)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+
+	// the reminders of each provider follow its prefetch
+	prefetchA, err := entityMessage.ToPrefetchProperties(messages[0])
+	require.NoError(t, err)
+	require.Equal(t, providerA, prefetchA.ProviderID)
+	require.Equal(t, []uuid.UUID{repos[0].ID, repos[2].ID}, prefetchA.EntityIDs)
+	require.Equal(t, constants.TopicQueueRepoReminder, prefetchA.FollowUpTopic)
+	require.Len(t, prefetchA.FollowUps, 2)
+	require.JSONEq(t, string(reminders[0].Payload), string(prefetchA.FollowUps[0]))
+	require.JSONEq(t, string(reminders[2].Payload), string(prefetchA.FollowUps[1]))
+
+	prefetchB, err := entityMessage.ToPrefetchProperties(messages[1])
+	require.NoError(t, err)
+	require.Equal(t, providerB, prefetchB.ProviderID)
+	require.Equal(t, []uuid.UUID{repos[1].ID}, prefetchB.EntityIDs)
+	require.Len(t, prefetchB.FollowUps, 1)
+	require.JSONEq(t, string(reminders[1].Payload), string(prefetchB.FollowUps[0]))
+}
+
+func Test_purgePropertyHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("purges changes older than the retention", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		store := mockdb.NewMockStore(ctrl)
+
+		retention := 48 * time.Hour
+		store.EXPECT().DeletePropertyHistoryBefore(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, before time.Time) (int64, error) {
+				require.WithinDuration(t, time.Now().Add(-retention), before, time.Minute)
+				return 3, nil
+			})
+
+		r := createTestReminder(t, store, &reminderconfig.Config{
+			RecurrenceConfig: reminderconfig.RecurrenceConfig{PropertyHistoryRetention: retention},
+		})
+		require.NoError(t, r.purgePropertyHistory(context.Background()))
+	})
+
+	t.Run("zero retention keeps the history", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		store := mockdb.NewMockStore(ctrl)
+
+		r := createTestReminder(t, store, &reminderconfig.Config{})
+		require.NoError(t, r.purgePropertyHistory(context.Background()))
+	})
+}
+
+type stubPublisher struct {
+	topic    string
+	messages []*message.Message
+}
+
+func (s *stubPublisher) Publish(topic string, messages ...*message.Message) error {
+	s.topic = topic
+	s.messages = append(s.messages, messages...)
+	return nil
+}
+
+func (*stubPublisher) Close() error {
+	return nil
+}
+
 func generateUUIDFromNum(t *testing.T, num int) uuid.UUID {
 	t.Helper()
 
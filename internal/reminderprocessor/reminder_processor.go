@@ -6,10 +6,13 @@ package reminderprocessor
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	reconcilermessages "github.com/mindersec/minder/internal/reconcilers/messages"
 	remindermessages "github.com/mindersec/minder/internal/reminder/messages"
 	"github.com/mindersec/minder/pkg/eventer/constants"
@@ -18,12 +21,21 @@ import (
 
 // ReminderProcessor processes the incoming reminders
 type ReminderProcessor struct {
-	evt interfaces.Interface
+	evt     interfaces.Interface
+	budgets ratebudget.Tracker
+	// afterFunc runs f once the delay has elapsed, time.AfterFunc outside of tests
+	afterFunc func(delay time.Duration, f func())
 }
 
 // NewReminderProcessor creates a new ReminderProcessor
-func NewReminderProcessor(evt interfaces.Interface) *ReminderProcessor {
-	return &ReminderProcessor{evt: evt}
+func NewReminderProcessor(evt interfaces.Interface, budgets ratebudget.Tracker) *ReminderProcessor {
+	return &ReminderProcessor{
+		evt:     evt,
+		budgets: budgets,
+		afterFunc: func(delay time.Duration, f func()) {
+			time.AfterFunc(delay, f)
+		},
+	}
 }
 
 // Register implements the Consumer interface.
@@ -39,6 +51,23 @@ func (rp *ReminderProcessor) reminderMessageHandler(msg *message.Message) error
 
 	log.Info().Msgf("Received reminder event: %v", evt)
 
+	// Reminders are background work, so they give way to webhook-driven work
+	// when the provider's rate limit budget runs low. They are published again
+	// when the budget's window resets and go through admission once more. A
+	// deferred reminder lost in between, e.g. on restart, is sent again on a
+	// later round, as the entity keeps its old evaluation. Refreshing the
+	// entity may use the GraphQL API, evaluating it the REST API.
+	delay := rp.budgets.Admit(msg.Context(), evt.ProviderID, ratebudget.PriorityLow,
+		ratebudget.ResourceCore, ratebudget.ResourceGraphQL)
+	if delay > 0 {
+		log.Info().
+			Str("entity_id", evt.EntityID.String()).
+			Dur("delay", delay).
+			Msg("rate limit budget is low, deferring reminder")
+		rp.deferReminder(msg, delay)
+		return nil
+	}
+
 	repoReconcileMsg, err := reconcilermessages.NewRepoReconcilerMessage(evt.ProviderID, evt.EntityID, evt.Project)
 	if err != nil {
 		return fmt.Errorf("error creating repo reconcile event: %w", err)
@@ -50,3 +79,13 @@ func (rp *ReminderProcessor) reminderMessageHandler(msg *message.Message) error
 	}
 	return nil
 }
+
+// deferReminder publishes the reminder again after the delay
+func (rp *ReminderProcessor) deferReminder(msg *message.Message, delay time.Duration) {
+	deferred := message.NewMessage(uuid.New().String(), msg.Payload)
+	rp.afterFunc(delay, func() {
+		if err := rp.evt.Publish(constants.TopicQueueRepoReminder, deferred); err != nil {
+			log.Error().Err(err).Msg("error publishing deferred reminder")
+		}
+	})
+}
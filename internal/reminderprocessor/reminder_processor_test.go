@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reminderprocessor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	stubeventer "github.com/mindersec/minder/internal/events/stubs"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
+	mock_ratebudget "github.com/mindersec/minder/internal/providers/ratebudget/mock"
+	remindermessages "github.com/mindersec/minder/internal/reminder/messages"
+	"github.com/mindersec/minder/pkg/eventer/constants"
+)
+
+func TestReminderMessageHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		delay     time.Duration
+		wantTopic string
+	}{
+		{
+			name:      "admitted reminder reconciles the entity",
+			wantTopic: constants.TopicQueueReconcileRepoInit,
+		},
+		{
+			name:      "deferred reminder is published again after the delay",
+			delay:     time.Minute,
+			wantTopic: constants.TopicQueueRepoReminder,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			providerID := uuid.New()
+			budgets := mock_ratebudget.NewMockTracker(ctrl)
+			budgets.EXPECT().Admit(gomock.Any(), providerID, ratebudget.PriorityLow,
+				ratebudget.ResourceCore, ratebudget.ResourceGraphQL).Return(tt.delay)
+
+			evt := &stubeventer.StubEventer{}
+			rp := NewReminderProcessor(evt, budgets)
+			var deferredBy time.Duration
+			rp.afterFunc = func(delay time.Duration, f func()) {
+				deferredBy = delay
+				f()
+			}
+
+			msg, err := remindermessages.NewEntityReminderMessage(providerID, uuid.New(), uuid.New())
+			require.NoError(t, err)
+			require.NoError(t, rp.reminderMessageHandler(msg))
+
+			require.Equal(t, tt.delay, deferredBy)
+			require.Equal(t, []string{tt.wantTopic}, evt.Topics)
+			require.Len(t, evt.Sent, 1)
+			if tt.delay > 0 {
+				require.Equal(t, msg.Payload, evt.Sent[0].Payload)
+			}
+		})
+	}
+}
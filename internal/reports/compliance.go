@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package reports builds and renders reports over the evaluation results
+// of projects.
+package reports
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/entities"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// DefaultTrendRange is the time range of the trends of a compliance report
+// when none is requested
+const DefaultTrendRange = 30 * 24 * time.Hour
+
+// GenerateComplianceReport builds a compliance report of the latest
+// evaluation results of a project and its sub-projects, with the trends of
+// their evaluation history between from and to.
+func GenerateComplianceReport(
+	ctx context.Context,
+	store db.Store,
+	projectID uuid.UUID,
+	from, to time.Time,
+) (*pb.ComplianceReport, error) {
+	// The project itself is the first of the list
+	projects, err := store.GetChildrenProjects(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing projects: %w", err)
+	}
+	if len(projects) == 0 {
+		return nil, fmt.Errorf("project %s not found", projectID)
+	}
+
+	report := &pb.ComplianceReport{
+		Project:     projects[0].Name,
+		ProjectId:   projectID.String(),
+		GeneratedAt: timestamppb.Now(),
+		From:        timestamppb.New(from),
+		To:          timestamppb.New(to),
+		Totals:      &pb.ComplianceReport_Counts{},
+	}
+
+	byProject := groups{}
+	byProfile := groups{}
+	byRuleType := groups{}
+	bySeverity := groups{}
+	byEntity := groups{}
+
+	// All profiles are reported, including those created by bundles
+	listParams := db.ListProfilesByProjectIDAndLabelParams{}
+	listParams.LabelsFromFilter("*")
+
+	projectIDs := make([]uuid.UUID, 0, len(projects))
+	for _, project := range projects {
+		projectIDs = append(projectIDs, project.ID)
+
+		listParams.ProjectID = project.ID
+		profiles, err := store.ListProfilesByProjectIDAndLabel(ctx, listParams)
+		if err != nil {
+			return nil, fmt.Errorf("error listing profiles: %w", err)
+		}
+
+		for _, profile := range profiles {
+			evals, err := store.ListRuleEvaluationsByProfileId(
+				ctx, db.ListRuleEvaluationsByProfileIdParams{ProfileID: profile.Profile.ID},
+			)
+			if err != nil {
+				return nil, fmt.Errorf("error listing evaluations of profile %s: %w", profile.Profile.ID, err)
+			}
+
+			for _, eval := range evals {
+				entityType := entities.EntityTypeFromDB(eval.EntityType).ToString()
+				severity := string(eval.RuleTypeSeverityValue)
+
+				report.Findings = append(report.Findings, &pb.ComplianceReport_Finding{
+					Project:           project.Name,
+					Profile:           profile.Profile.Name,
+					RuleType:          eval.RuleTypeName,
+					RuleName:          eval.RuleName,
+					Severity:          severity,
+					EntityType:        entityType,
+					EntityName:        eval.EntityName,
+					Status:            string(eval.EvalStatus),
+					Details:           eval.EvalDetails,
+					RemediationStatus: string(eval.RemStatus),
+					AlertStatus:       string(eval.AlertStatus),
+					LastUpdated:       timestamppb.New(eval.EvalLastUpdated),
+				})
+
+				addEvaluation(report.Totals, eval)
+				addEvaluation(byProject.get(project.Name), eval)
+				addEvaluation(byProfile.get(profile.Profile.Name), eval)
+				addEvaluation(byRuleType.get(eval.RuleTypeName), eval)
+				addEvaluation(bySeverity.get(severity), eval)
+				addEvaluation(byEntity.get(fmt.Sprintf("%s/%s", entityType, eval.EntityName)), eval)
+			}
+		}
+	}
+
+	report.ByProject = byProject.sorted()
+	report.ByProfile = byProfile.sorted()
+	report.ByRuleType = byRuleType.sorted()
+	report.BySeverity = bySeverity.sorted()
+	report.ByEntity = byEntity.sorted()
+	slices.SortFunc(report.Findings, compareFindings)
+
+	days, err := store.CountEvaluationHistoryByDay(ctx, db.CountEvaluationHistoryByDayParams{
+		Projectids: projectIDs,
+		Fromts:     from.UTC(),
+		Tots:       to.UTC(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error counting evaluation history: %w", err)
+	}
+	for _, day := range days {
+		report.Trend = append(report.Trend, &pb.ComplianceReport_TrendPoint{
+			Day: timestamppb.New(day.Day),
+			Counts: &pb.ComplianceReport_Counts{
+				Total:               day.Total,
+				Success:             day.Success,
+				Failure:             day.Failure,
+				Error:               day.Error,
+				Skipped:             day.Skipped,
+				Pending:             day.Pending,
+				Waived:              day.Waived,
+				AlertsOn:            day.AlertsOn,
+				RemediationsSuccess: day.RemediationsSuccess,
+				RemediationsFailed:  day.RemediationsFailed,
+			},
+		})
+	}
+
+	return report, nil
+}
+
+// groups aggregates evaluation results by name
+type groups map[string]*pb.ComplianceReport_Counts
+
+func (g groups) get(name string) *pb.ComplianceReport_Counts {
+	counts, ok := g[name]
+	if !ok {
+		counts = &pb.ComplianceReport_Counts{}
+		g[name] = counts
+	}
+	return counts
+}
+
+func (g groups) sorted() []*pb.ComplianceReport_Group {
+	out := make([]*pb.ComplianceReport_Group, 0, len(g))
+	for name, counts := range g {
+		out = append(out, &pb.ComplianceReport_Group{Name: name, Counts: counts})
+	}
+	slices.SortFunc(out, func(a, b *pb.ComplianceReport_Group) int {
+		return cmp.Compare(a.GetName(), b.GetName())
+	})
+	return out
+}
+
+func addEvaluation(counts *pb.ComplianceReport_Counts, eval db.ListRuleEvaluationsByProfileIdRow) {
+	counts.Total++
+	switch eval.EvalStatus {
+	case db.EvalStatusTypesSuccess:
+		counts.Success++
+	case db.EvalStatusTypesFailure:
+		counts.Failure++
+	case db.EvalStatusTypesError, db.EvalStatusTypesTimeout:
+		counts.Error++
+	case db.EvalStatusTypesSkipped:
+		counts.Skipped++
+	case db.EvalStatusTypesPending:
+		counts.Pending++
+	case db.EvalStatusTypesWaived:
+		counts.Waived++
+	default:
+	}
+
+	if eval.AlertStatus == db.AlertStatusTypesOn {
+		counts.AlertsOn++
+	}
+
+	switch eval.RemStatus {
+	case db.RemediationStatusTypesSuccess:
+		counts.RemediationsSuccess++
+	case db.RemediationStatusTypesFailure, db.RemediationStatusTypesError:
+		counts.RemediationsFailed++
+	default:
+	}
+}
+
+func compareFindings(a, b *pb.ComplianceReport_Finding) int {
+	return cmp.Or(
+		cmp.Compare(a.GetProject(), b.GetProject()),
+		cmp.Compare(a.GetProfile(), b.GetProfile()),
+		cmp.Compare(a.GetRuleName(), b.GetRuleName()),
+		cmp.Compare(a.GetEntityType(), b.GetEntityType()),
+		cmp.Compare(a.GetEntityName(), b.GetEntityName()),
+	)
+}
@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reports
+
+import (
+	"context"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func TestGenerateComplianceReport(t *testing.T) {
+	t.Parallel()
+
+	rootID := uuid.New()
+	childID := uuid.New()
+	rootProfileID := uuid.New()
+	childProfileID := uuid.New()
+	to := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	from := to.Add(-DefaultTrendRange)
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().GetChildrenProjects(gomock.Any(), rootID).Return([]db.GetChildrenProjectsRow{
+		{ID: rootID, Name: "root"},
+		{ID: childID, Name: "child"},
+	}, nil)
+	store.EXPECT().
+		ListProfilesByProjectIDAndLabel(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, params db.ListProfilesByProjectIDAndLabelParams) (
+			[]db.ListProfilesByProjectIDAndLabelRow, error,
+		) {
+			// All profiles are included
+			require.Equal(t, []string{"*"}, params.IncludeLabels)
+			if params.ProjectID == rootID {
+				return []db.ListProfilesByProjectIDAndLabelRow{
+					{Profile: db.Profile{ID: rootProfileID, Name: "baseline"}},
+				}, nil
+			}
+			return []db.ListProfilesByProjectIDAndLabelRow{
+				{Profile: db.Profile{ID: childProfileID, Name: "strict"}},
+			}, nil
+		}).Times(2)
+	store.EXPECT().
+		ListRuleEvaluationsByProfileId(gomock.Any(), db.ListRuleEvaluationsByProfileIdParams{ProfileID: rootProfileID}).
+		Return([]db.ListRuleEvaluationsByProfileIdRow{
+			evalRow("secret_scanning", "org/a", db.SeverityHigh, db.EvalStatusTypesFailure,
+				db.RemediationStatusTypesFailure, db.AlertStatusTypesOn),
+			evalRow("secret_scanning", "org/b", db.SeverityHigh, db.EvalStatusTypesSuccess,
+				db.RemediationStatusTypesSuccess, db.AlertStatusTypesOff),
+		}, nil)
+	store.EXPECT().
+		ListRuleEvaluationsByProfileId(gomock.Any(), db.ListRuleEvaluationsByProfileIdParams{ProfileID: childProfileID}).
+		Return([]db.ListRuleEvaluationsByProfileIdRow{
+			evalRow("branch_protection", "org/a", db.SeverityLow, db.EvalStatusTypesSkipped,
+				db.RemediationStatusTypesSkipped, db.AlertStatusTypesSkipped),
+		}, nil)
+	store.EXPECT().
+		CountEvaluationHistoryByDay(gomock.Any(), db.CountEvaluationHistoryByDayParams{
+			Projectids: []uuid.UUID{rootID, childID},
+			Fromts:     from,
+			Tots:       to,
+		}).
+		Return([]db.CountEvaluationHistoryByDayRow{
+			{Day: to.Add(-24 * time.Hour), Total: 3, Success: 1, Failure: 2, AlertsOn: 2},
+		}, nil)
+
+	report, err := GenerateComplianceReport(context.Background(), store, rootID, from, to)
+	require.NoError(t, err)
+
+	require.Equal(t, "root", report.GetProject())
+	require.Equal(t, int64(3), report.GetTotals().GetTotal())
+	require.Equal(t, int64(1), report.GetTotals().GetSuccess())
+	require.Equal(t, int64(1), report.GetTotals().GetFailure())
+	require.Equal(t, int64(1), report.GetTotals().GetSkipped())
+	require.Equal(t, int64(1), report.GetTotals().GetAlertsOn())
+	require.Equal(t, int64(1), report.GetTotals().GetRemediationsSuccess())
+	require.Equal(t, int64(1), report.GetTotals().GetRemediationsFailed())
+
+	require.Equal(t, []string{"child", "root"}, groupNames(report.GetByProject()))
+	require.Equal(t, []string{"baseline", "strict"}, groupNames(report.GetByProfile()))
+	require.Equal(t, []string{"branch_protection", "secret_scanning"}, groupNames(report.GetByRuleType()))
+	require.Equal(t, []string{"high", "low"}, groupNames(report.GetBySeverity()))
+	require.Equal(t, []string{"repository/org/a", "repository/org/b"}, groupNames(report.GetByEntity()))
+	require.Equal(t, int64(2), report.GetByEntity()[0].GetCounts().GetTotal())
+
+	require.Len(t, report.GetFindings(), 3)
+	require.Equal(t, "child", report.GetFindings()[0].GetProject())
+	require.Equal(t, "repository", report.GetFindings()[0].GetEntityType())
+
+	require.Len(t, report.GetTrend(), 1)
+	require.Equal(t, int64(2), report.GetTrend()[0].GetCounts().GetFailure())
+}
+
+func TestRenderComplianceReport(t *testing.T) {
+	t.Parallel()
+
+	report := &pb.ComplianceReport{
+		Project: "root",
+		Totals:  &pb.ComplianceReport_Counts{Total: 2, Success: 1, Failure: 1},
+		ByProfile: []*pb.ComplianceReport_Group{
+			{Name: "baseline", Counts: &pb.ComplianceReport_Counts{Total: 2, Success: 1, Failure: 1}},
+		},
+		Findings: []*pb.ComplianceReport_Finding{
+			{Project: "root", RuleName: "rule", EntityName: "org/a", Status: "failure", Details: "=HYPERLINK(\"x\")"},
+			{Project: "root", RuleName: "rule", EntityName: "org/<b>", Status: "success"},
+		},
+	}
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+		out, contentType, err := RenderComplianceReport(context.Background(), report, FormatJSON)
+		require.NoError(t, err)
+		require.Equal(t, "application/json", contentType)
+
+		parsed := &pb.ComplianceReport{}
+		require.NoError(t, protojson.Unmarshal([]byte(out), parsed))
+		require.Equal(t, "root", parsed.GetProject())
+		require.Len(t, parsed.GetFindings(), 2)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		t.Parallel()
+		out, contentType, err := RenderComplianceReport(context.Background(), report, FormatCSV)
+		require.NoError(t, err)
+		require.Equal(t, "text/csv", contentType)
+
+		records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		require.Equal(t, csvHeader, records[0])
+		// Values which spreadsheets would interpret as formulas are escaped
+		require.Equal(t, `'=HYPERLINK("x")`, records[1][len(csvHeader)-1])
+	})
+
+	t.Run("html", func(t *testing.T) {
+		t.Parallel()
+		out, contentType, err := RenderComplianceReport(context.Background(), report, FormatHTML)
+		require.NoError(t, err)
+		require.Equal(t, "text/html; charset=utf-8", contentType)
+		require.Contains(t, out, "<title>Compliance report: root</title>")
+		require.Contains(t, out, "50%")
+		require.Contains(t, out, "org/&lt;b&gt;")
+		require.NotContains(t, out, "org/<b>")
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		t.Parallel()
+		_, _, err := RenderComplianceReport(context.Background(), report, "pdf")
+		require.Error(t, err)
+	})
+}
+
+func evalRow(
+	ruleType, entityName string, severity db.Severity, status db.EvalStatusTypes,
+	remStatus db.RemediationStatusTypes, alertStatus db.AlertStatusTypes,
+) db.ListRuleEvaluationsByProfileIdRow {
+	return db.ListRuleEvaluationsByProfileIdRow{
+		EvalStatus:            status,
+		RemStatus:             remStatus,
+		AlertStatus:           alertStatus,
+		EntityType:            db.EntitiesRepository,
+		EntityName:            entityName,
+		RuleName:              ruleType,
+		RuleTypeName:          ruleType,
+		RuleTypeSeverityValue: severity,
+	}
+}
+
+func groupNames(groups []*pb.ComplianceReport_Group) []string {
+	names := make([]string, 0, len(groups))
+	for _, g := range groups {
+		names = append(names, g.GetName())
+	}
+	return names
+}
@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package reports
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/util"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+const (
+	// FormatJSON renders a report to JSON
+	FormatJSON = "json"
+	// FormatCSV renders the findings of a report to CSV
+	FormatCSV = "csv"
+	// FormatHTML renders a report to a self-contained HTML document,
+	// which can be printed to PDF
+	FormatHTML = "html"
+
+	// maxRenderedSize is the maximum size of a rendered HTML report
+	maxRenderedSize = 32 << 20
+)
+
+var (
+	//go:embed templates/compliance.html.tmpl
+	complianceHTMLTemplate string
+)
+
+// RenderComplianceReport renders a compliance report to the given format,
+// returning the rendered report and its media type.
+func RenderComplianceReport(ctx context.Context, report *pb.ComplianceReport, format string) (string, string, error) {
+	switch format {
+	case "", FormatJSON:
+		out, err := util.GetJsonFromProto(report)
+		if err != nil {
+			return "", "", fmt.Errorf("error rendering report to JSON: %w", err)
+		}
+		return out, "application/json", nil
+	case FormatCSV:
+		out, err := renderCSV(report)
+		if err != nil {
+			return "", "", fmt.Errorf("error rendering report to CSV: %w", err)
+		}
+		return out, "text/csv", nil
+	case FormatHTML:
+		out, err := renderHTML(ctx, report)
+		if err != nil {
+			return "", "", fmt.Errorf("error rendering report to HTML: %w", err)
+		}
+		return out, "text/html; charset=utf-8", nil
+	default:
+		return "", "", fmt.Errorf("unsupported report format %q", format)
+	}
+}
+
+var csvHeader = []string{
+	"project", "profile", "rule_type", "rule_name", "severity", "entity_type", "entity_name",
+	"status", "remediation_status", "alert_status", "last_updated", "details",
+}
+
+// renderCSV renders one line per finding
+func renderCSV(report *pb.ComplianceReport) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(csvHeader); err != nil {
+		return "", err
+	}
+	for _, f := range report.GetFindings() {
+		record := []string{
+			f.GetProject(), f.GetProfile(), f.GetRuleType(), f.GetRuleName(), f.GetSeverity(),
+			f.GetEntityType(), f.GetEntityName(), f.GetStatus(), f.GetRemediationStatus(),
+			f.GetAlertStatus(), formatTime(f.GetLastUpdated(), time.RFC3339), f.GetDetails(),
+		}
+		for i := range record {
+			record[i] = escapeCSVFormula(record[i])
+		}
+		if err := w.Write(record); err != nil {
+			return "", err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// escapeCSVFormula prevents spreadsheets from interpreting values coming
+// from evaluation details or entity names as formulas
+func escapeCSVFormula(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// htmlReport is the data the HTML template is rendered with
+type htmlReport struct {
+	Project     string
+	ProjectID   string
+	GeneratedAt string
+	From        string
+	To          string
+	Totals      htmlCounts
+	Sections    []htmlSection
+	Trend       []htmlTrendPoint
+	Findings    []htmlFinding
+}
+
+type htmlFinding struct {
+	*pb.ComplianceReport_Finding
+	// LastUpdated shadows the timestamp of the finding
+	LastUpdated string
+}
+
+type htmlSection struct {
+	Title  string
+	Groups []htmlGroup
+}
+
+type htmlGroup struct {
+	Name   string
+	Counts htmlCounts
+}
+
+type htmlTrendPoint struct {
+	Day    string
+	Counts htmlCounts
+}
+
+type htmlCounts struct {
+	*pb.ComplianceReport_Counts
+	// PassRate is the percentage of evaluations which passed, out of
+	// those which were not skipped
+	PassRate int
+}
+
+func newHTMLCounts(counts *pb.ComplianceReport_Counts) htmlCounts {
+	if counts == nil {
+		counts = &pb.ComplianceReport_Counts{}
+	}
+	rate := 0
+	if evaluated := counts.GetTotal() - counts.GetSkipped(); evaluated > 0 {
+		rate = int(counts.GetSuccess() * 100 / evaluated)
+	}
+	return htmlCounts{ComplianceReport_Counts: counts, PassRate: rate}
+}
+
+func renderHTML(ctx context.Context, report *pb.ComplianceReport) (string, error) {
+	tmpl, err := util.NewSafeHTMLTemplate(&complianceHTMLTemplate, "compliance")
+	if err != nil {
+		return "", err
+	}
+
+	data := htmlReport{
+		Project:     report.GetProject(),
+		ProjectID:   report.GetProjectId(),
+		GeneratedAt: formatTime(report.GetGeneratedAt(), time.RFC1123),
+		From:        formatTime(report.GetFrom(), time.DateOnly),
+		To:          formatTime(report.GetTo(), time.DateOnly),
+		Totals:      newHTMLCounts(report.GetTotals()),
+	}
+	for _, f := range report.GetFindings() {
+		data.Findings = append(data.Findings, htmlFinding{
+			ComplianceReport_Finding: f,
+			LastUpdated:              formatTime(f.GetLastUpdated(), time.DateTime),
+		})
+	}
+	for _, section := range []struct {
+		title  string
+		groups []*pb.ComplianceReport_Group
+	}{
+		{"Projects", report.GetByProject()},
+		{"Profiles", report.GetByProfile()},
+		{"Rule types", report.GetByRuleType()},
+		{"Severities", report.GetBySeverity()},
+		{"Entities", report.GetByEntity()},
+	} {
+		s := htmlSection{Title: section.title}
+		for _, g := range section.groups {
+			s.Groups = append(s.Groups, htmlGroup{Name: g.GetName(), Counts: newHTMLCounts(g.GetCounts())})
+		}
+		data.Sections = append(data.Sections, s)
+	}
+	for _, point := range report.GetTrend() {
+		data.Trend = append(data.Trend, htmlTrendPoint{
+			Day:    formatTime(point.GetDay(), time.DateOnly),
+			Counts: newHTMLCounts(point.GetCounts()),
+		})
+	}
+
+	return tmpl.Render(ctx, data, maxRenderedSize)
+}
+
+func formatTime(ts *timestamppb.Timestamp, layout string) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().UTC().Format(layout)
+}
@@ -0,0 +1,133 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service.go
+//
+// Generated by this command:
+//
+//	mockgen -package mock_autoregistration -destination=./mock/service.go -source=./service.go
+//
+
+// Package mock_autoregistration is a generated GoMock package.
+package mock_autoregistration
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	v1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	properties "github.com/mindersec/minder/pkg/entities/properties"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAutoRegistrationService is a mock of AutoRegistrationService interface.
+type MockAutoRegistrationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAutoRegistrationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAutoRegistrationServiceMockRecorder is the mock recorder for MockAutoRegistrationService.
+type MockAutoRegistrationServiceMockRecorder struct {
+	mock *MockAutoRegistrationService
+}
+
+// NewMockAutoRegistrationService creates a new mock instance.
+func NewMockAutoRegistrationService(ctrl *gomock.Controller) *MockAutoRegistrationService {
+	mock := &MockAutoRegistrationService{ctrl: ctrl}
+	mock.recorder = &MockAutoRegistrationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAutoRegistrationService) EXPECT() *MockAutoRegistrationServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateRule mocks base method.
+func (m *MockAutoRegistrationService) CreateRule(ctx context.Context, projectID uuid.UUID, rule *v1.AutoRegistrationRule) (*v1.AutoRegistrationRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRule", ctx, projectID, rule)
+	ret0, _ := ret[0].(*v1.AutoRegistrationRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRule indicates an expected call of CreateRule.
+func (mr *MockAutoRegistrationServiceMockRecorder) CreateRule(ctx, projectID, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRule", reflect.TypeOf((*MockAutoRegistrationService)(nil).CreateRule), ctx, projectID, rule)
+}
+
+// DeleteRule mocks base method.
+func (m *MockAutoRegistrationService) DeleteRule(ctx context.Context, projectID uuid.UUID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRule", ctx, projectID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRule indicates an expected call of DeleteRule.
+func (mr *MockAutoRegistrationServiceMockRecorder) DeleteRule(ctx, projectID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRule", reflect.TypeOf((*MockAutoRegistrationService)(nil).DeleteRule), ctx, projectID, name)
+}
+
+// ListRules mocks base method.
+func (m *MockAutoRegistrationService) ListRules(ctx context.Context, projectID uuid.UUID) ([]*v1.AutoRegistrationRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRules", ctx, projectID)
+	ret0, _ := ret[0].([]*v1.AutoRegistrationRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRules indicates an expected call of ListRules.
+func (mr *MockAutoRegistrationServiceMockRecorder) ListRules(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRules", reflect.TypeOf((*MockAutoRegistrationService)(nil).ListRules), ctx, projectID)
+}
+
+// Reconcile mocks base method.
+func (m *MockAutoRegistrationService) Reconcile(ctx context.Context, projectID uuid.UUID, providerName string, dryRun bool) (*v1.AutoRegistrationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx, projectID, providerName, dryRun)
+	ret0, _ := ret[0].(*v1.AutoRegistrationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockAutoRegistrationServiceMockRecorder) Reconcile(ctx, projectID, providerName, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockAutoRegistrationService)(nil).Reconcile), ctx, projectID, providerName, dryRun)
+}
+
+// ReconcileProvider mocks base method.
+func (m *MockAutoRegistrationService) ReconcileProvider(ctx context.Context, projectID, providerID uuid.UUID) (*v1.AutoRegistrationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileProvider", ctx, projectID, providerID)
+	ret0, _ := ret[0].(*v1.AutoRegistrationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileProvider indicates an expected call of ReconcileProvider.
+func (mr *MockAutoRegistrationServiceMockRecorder) ReconcileProvider(ctx, projectID, providerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileProvider", reflect.TypeOf((*MockAutoRegistrationService)(nil).ReconcileProvider), ctx, projectID, providerID)
+}
+
+// RegisterIfSelected mocks base method.
+func (m *MockAutoRegistrationService) RegisterIfSelected(ctx context.Context, projectID, providerID uuid.UUID, fetchByProps *properties.Properties) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterIfSelected", ctx, projectID, providerID, fetchByProps)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterIfSelected indicates an expected call of RegisterIfSelected.
+func (mr *MockAutoRegistrationServiceMockRecorder) RegisterIfSelected(ctx, projectID, providerID, fetchByProps any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterIfSelected", reflect.TypeOf((*MockAutoRegistrationService)(nil).RegisterIfSelected), ctx, projectID, providerID, fetchByProps)
+}
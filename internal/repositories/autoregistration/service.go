@@ -0,0 +1,524 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package autoregistration registers the remote repositories of a project
+// which are selected by its auto-registration rules, and deregisters the
+// ones which are no longer selected.
+package autoregistration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/entities"
+	"github.com/mindersec/minder/internal/entities/models"
+	"github.com/mindersec/minder/internal/projects/features"
+	"github.com/mindersec/minder/internal/providers/github"
+	"github.com/mindersec/minder/internal/providers/manager"
+	provsel "github.com/mindersec/minder/internal/providers/selectors"
+	"github.com/mindersec/minder/internal/repositories"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/ptr"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/engine/selectors"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	profmodels "github.com/mindersec/minder/pkg/profiles/models"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -package mock_$GOPACKAGE -destination=./mock/$GOFILE -source=./$GOFILE
+
+var (
+	// ErrRuleAlreadyExists is returned when a rule with the same name exists in the project
+	ErrRuleAlreadyExists = util.UserVisibleError(codes.AlreadyExists, "auto-registration rule already exists")
+	// ErrRuleNotFound is returned when a rule is not found in the project
+	ErrRuleNotFound = util.UserVisibleError(codes.NotFound, "auto-registration rule not found")
+)
+
+// AutoRegistrationService manages the auto-registration rules of projects
+// and applies them to the repositories of their providers.
+type AutoRegistrationService interface {
+	// CreateRule creates an auto-registration rule in the given project.
+	CreateRule(ctx context.Context, projectID uuid.UUID, rule *pb.AutoRegistrationRule) (*pb.AutoRegistrationRule, error)
+	// ListRules lists the auto-registration rules of the given project.
+	ListRules(ctx context.Context, projectID uuid.UUID) ([]*pb.AutoRegistrationRule, error)
+	// DeleteRule deletes an auto-registration rule by name in the given project.
+	// The repositories it registered are deregistered on the next reconciliation,
+	// unless another rule selects them.
+	DeleteRule(ctx context.Context, projectID uuid.UUID, name string) error
+
+	// Reconcile registers the remote repositories selected by the rules of
+	// the project and deregisters the auto-registered repositories which are
+	// no longer selected. When providerName is set, only the repositories of
+	// that provider are reconciled. With dryRun, the changes are only reported.
+	Reconcile(
+		ctx context.Context, projectID uuid.UUID, providerName string, dryRun bool,
+	) (*pb.AutoRegistrationReport, error)
+	// ReconcileProvider is Reconcile for the provider with the given ID.
+	ReconcileProvider(
+		ctx context.Context, projectID uuid.UUID, providerID uuid.UUID,
+	) (*pb.AutoRegistrationReport, error)
+
+	// RegisterIfSelected registers a single remote repository, such as one
+	// which was just created, if a rule of the project selects it. It returns
+	// the name of the rule which selected the repository, if any.
+	RegisterIfSelected(
+		ctx context.Context, projectID uuid.UUID, providerID uuid.UUID, fetchByProps *properties.Properties,
+	) (string, error)
+}
+
+type autoRegistrationService struct {
+	store           db.Store
+	repos           repositories.RepositoryService
+	providerManager manager.ProviderManager
+	selEnv          *selectors.Env
+}
+
+// NewAutoRegistrationService creates a new auto-registration service.
+func NewAutoRegistrationService(
+	store db.Store,
+	repos repositories.RepositoryService,
+	providerManager manager.ProviderManager,
+	selEnv *selectors.Env,
+) AutoRegistrationService {
+	return &autoRegistrationService{
+		store:           store,
+		repos:           repos,
+		providerManager: providerManager,
+		selEnv:          selEnv,
+	}
+}
+
+func (s *autoRegistrationService) CreateRule(
+	ctx context.Context, projectID uuid.UUID, rule *pb.AutoRegistrationRule,
+) (*pb.AutoRegistrationRule, error) {
+	entityType := pb.Entity_ENTITY_REPOSITORIES
+	if rule.GetEntity() != "" {
+		entityType = pb.EntityFromString(rule.GetEntity())
+	}
+	if entityType != pb.Entity_ENTITY_REPOSITORIES {
+		return nil, util.UserVisibleError(codes.InvalidArgument,
+			"auto-registration is only supported for repositories")
+	}
+
+	if err := s.selEnv.CheckSelector(&pb.Profile_Selector{
+		Entity:   entityType.ToString(),
+		Selector: rule.GetSelector(),
+	}); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid selector: %s", err)
+	}
+
+	return db.WithTransaction(s.store, func(qtx db.ExtendQuerier) (*pb.AutoRegistrationRule, error) {
+		_, err := qtx.GetAutoRegistrationRuleByName(ctx, db.GetAutoRegistrationRuleByNameParams{
+			ProjectID: projectID,
+			Name:      rule.GetName(),
+		})
+		if err == nil {
+			return nil, ErrRuleAlreadyExists
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to check for existing rule: %w", err)
+		}
+
+		var providerID uuid.NullUUID
+		if providerName := rule.GetContext().GetProvider(); providerName != "" {
+			prov, err := qtx.GetProviderByName(ctx, db.GetProviderByNameParams{
+				Name:     providerName,
+				Projects: []uuid.UUID{projectID},
+			})
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, util.UserVisibleError(codes.NotFound, "provider %s not found", providerName)
+			} else if err != nil {
+				return nil, fmt.Errorf("failed to get provider: %w", err)
+			}
+			providerID = uuid.NullUUID{UUID: prov.ID, Valid: true}
+		}
+
+		dbRule, err := qtx.CreateAutoRegistrationRule(ctx, db.CreateAutoRegistrationRuleParams{
+			ProjectID:  projectID,
+			ProviderID: providerID,
+			Name:       rule.GetName(),
+			EntityType: db.EntitiesRepository,
+			Selector:   rule.GetSelector(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create rule: %w", err)
+		}
+
+		return ruleDBToProtobuf(dbRule, rule.GetContext().GetProvider()), nil
+	})
+}
+
+func (s *autoRegistrationService) ListRules(
+	ctx context.Context, projectID uuid.UUID,
+) ([]*pb.AutoRegistrationRule, error) {
+	dbRules, err := s.store.ListAutoRegistrationRules(ctx, db.ListAutoRegistrationRulesParams{
+		ProjectID: projectID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rules: %w", err)
+	}
+
+	providerNames := make(map[uuid.UUID]string)
+	rules := make([]*pb.AutoRegistrationRule, 0, len(dbRules))
+	for _, dbRule := range dbRules {
+		var providerName string
+		if dbRule.ProviderID.Valid {
+			name, ok := providerNames[dbRule.ProviderID.UUID]
+			if !ok {
+				prov, err := s.store.GetProviderByID(ctx, dbRule.ProviderID.UUID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get provider: %w", err)
+				}
+				name = prov.Name
+				providerNames[dbRule.ProviderID.UUID] = name
+			}
+			providerName = name
+		}
+		rules = append(rules, ruleDBToProtobuf(dbRule, providerName))
+	}
+
+	return rules, nil
+}
+
+func (s *autoRegistrationService) DeleteRule(ctx context.Context, projectID uuid.UUID, name string) error {
+	_, err := s.store.DeleteAutoRegistrationRule(ctx, db.DeleteAutoRegistrationRuleParams{
+		ProjectID: projectID,
+		Name:      name,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrRuleNotFound
+	} else if err != nil {
+		return fmt.Errorf("failed to delete rule: %w", err)
+	}
+	return nil
+}
+
+func (s *autoRegistrationService) Reconcile(
+	ctx context.Context, projectID uuid.UUID, providerName string, dryRun bool,
+) (*pb.AutoRegistrationReport, error) {
+	provs, errorProvs, err := s.providerManager.BulkInstantiateByTrait(
+		ctx, projectID, db.ProviderTypeRepoLister, providerName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate providers: %w", err)
+	}
+
+	report := &pb.AutoRegistrationReport{
+		DryRun:          dryRun,
+		FailedProviders: errorProvs,
+	}
+	for providerID, prov := range provs {
+		dbProv, err := s.store.GetProviderByID(ctx, providerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get provider: %w", err)
+		}
+		if err := s.reconcileProvider(ctx, projectID, &dbProv, prov.Provider, dryRun, report); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).
+				Str("provider_id", providerID.String()).
+				Str("project_id", projectID.String()).
+				Msg("cannot reconcile auto-registration")
+			report.FailedProviders = append(report.FailedProviders, prov.Name)
+		}
+	}
+
+	return report, nil
+}
+
+func (s *autoRegistrationService) ReconcileProvider(
+	ctx context.Context, projectID uuid.UUID, providerID uuid.UUID,
+) (*pb.AutoRegistrationReport, error) {
+	dbProv, err := s.store.GetProviderByID(ctx, providerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get provider: %w", err)
+	}
+	return s.Reconcile(ctx, projectID, dbProv.Name, false)
+}
+
+// reconcileProvider applies the rules of the project to the repositories of
+// a provider, adding the changes to the report
+//
+//nolint:gocyclo
+func (s *autoRegistrationService) reconcileProvider(
+	ctx context.Context,
+	projectID uuid.UUID,
+	dbProv *db.Provider,
+	provider provifv1.Provider,
+	dryRun bool,
+	report *pb.AutoRegistrationReport,
+) error {
+	rules, brokenRules, err := s.rulesForProvider(ctx, projectID, dbProv.ID)
+	if err != nil {
+		return err
+	}
+
+	autoRegistered, err := s.store.ListAutoRegisteredEntities(ctx, db.ListAutoRegisteredEntitiesParams{
+		ProjectID:  projectID,
+		ProviderID: dbProv.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list auto-registered repositories: %w", err)
+	}
+	if len(rules) == 0 && len(autoRegistered) == 0 {
+		return nil
+	}
+
+	repoLister, err := provifv1.As[provifv1.RepoLister](provider)
+	if err != nil {
+		return fmt.Errorf("provider cannot list repositories: %w", err)
+	}
+	listCtx, cancel := context.WithTimeout(ctx, github.ExpensiveRestCallTimeout)
+	defer cancel()
+	// Nothing is deregistered unless the full list of repositories is known
+	remoteRepos, err := repoLister.ListAllRepositories(listCtx)
+	if err != nil {
+		return fmt.Errorf("failed to list remote repositories: %w", err)
+	}
+
+	registeredRepos, err := s.repos.ListRepositories(ctx, projectID, dbProv.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list registered repositories: %w", err)
+	}
+	registered := make(map[string]bool, len(registeredRepos))
+	upstreamIDs := make(map[uuid.UUID]string, len(registeredRepos))
+	for _, repo := range registeredRepos {
+		upstreamID := repo.Properties.GetProperty(properties.PropertyUpstreamID).GetString()
+		registered[upstreamID] = true
+		upstreamIDs[repo.Entity.ID] = upstreamID
+	}
+
+	allowsPrivateRepos := features.ProjectAllowsPrivateRepos(ctx, s.store, projectID)
+	selected := make(map[string]bool, len(remoteRepos))
+	// Repositories for which a rule could not be evaluated are neither
+	// registered nor deregistered, as we don't know whether they are selected
+	undecided := make(map[string]bool)
+	for _, remoteRepo := range remoteRepos {
+		if remoteRepo.GetIsPrivate() && !allowsPrivateRepos {
+			continue
+		}
+
+		props := properties.NewProperties(remoteRepo.GetProperties().AsMap())
+		upstreamID := props.GetProperty(properties.PropertyUpstreamID).GetString()
+		name := props.GetProperty(properties.PropertyName).GetString()
+		rule, evalErr := s.selectingRule(ctx, rules, dbProv, name, props)
+		if rule == "" {
+			if evalErr {
+				undecided[upstreamID] = true
+			}
+			continue
+		}
+		selected[upstreamID] = true
+		if registered[upstreamID] {
+			continue
+		}
+
+		change := &pb.AutoRegistrationReport_Change{Provider: dbProv.Name, Name: name, Rule: rule}
+		if !dryRun {
+			fetchByProps := properties.NewProperties(map[string]any{
+				properties.PropertyUpstreamID: upstreamID,
+				properties.PropertyName:       name,
+			})
+			if err := s.register(ctx, dbProv, projectID, fetchByProps, rule); err != nil {
+				change.Error = err.Error()
+			}
+		}
+		report.Registered = append(report.Registered, change)
+	}
+
+	for _, entity := range autoRegistered {
+		upstreamID := upstreamIDs[entity.EntityInstanceID]
+		if selected[upstreamID] {
+			continue
+		}
+		if undecided[upstreamID] || brokenRules[entity.RuleName] {
+			zerolog.Ctx(ctx).Warn().
+				Str("rule", entity.RuleName).
+				Str("repository", entity.Name).
+				Str("project_id", projectID.String()).
+				Msg("not deregistering repository as its auto-registration rules could not be evaluated")
+			continue
+		}
+
+		change := &pb.AutoRegistrationReport_Change{Provider: dbProv.Name, Name: entity.Name, Rule: entity.RuleName}
+		if !dryRun {
+			if err := s.repos.DeleteByID(ctx, entity.EntityInstanceID, projectID); err != nil {
+				change.Error = err.Error()
+			}
+		}
+		report.Deregistered = append(report.Deregistered, change)
+	}
+
+	return nil
+}
+
+func (s *autoRegistrationService) RegisterIfSelected(
+	ctx context.Context, projectID uuid.UUID, providerID uuid.UUID, fetchByProps *properties.Properties,
+) (string, error) {
+	rules, _, err := s.rulesForProvider(ctx, projectID, providerID)
+	if err != nil {
+		return "", err
+	}
+	if len(rules) == 0 {
+		return "", nil
+	}
+
+	// The repository may have been registered already, e.g. by hand
+	upstreamID := fetchByProps.GetProperty(properties.PropertyUpstreamID).GetString()
+	jsonUpstreamID, err := db.PropValueToDbV1(upstreamID)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode upstream ID: %w", err)
+	}
+	existing, err := s.store.GetTypedEntitiesByProperty(ctx, db.GetTypedEntitiesByPropertyParams{
+		EntityType: db.EntitiesRepository,
+		ProjectID:  projectID,
+		ProviderID: providerID,
+		Key:        properties.PropertyUpstreamID,
+		Value:      jsonUpstreamID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to look up repository: %w", err)
+	}
+	if len(existing) > 0 {
+		return "", nil
+	}
+
+	dbProv, err := s.store.GetProviderByID(ctx, providerID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get provider: %w", err)
+	}
+	provider, err := s.providerManager.InstantiateFromID(ctx, providerID)
+	if err != nil {
+		return "", fmt.Errorf("failed to instantiate provider: %w", err)
+	}
+	props, err := provider.FetchAllProperties(ctx, fetchByProps, pb.Entity_ENTITY_REPOSITORIES, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch repository properties: %w", err)
+	}
+
+	name := props.GetProperty(properties.PropertyName).GetString()
+	rule, _ := s.selectingRule(ctx, rules, &dbProv, name, props)
+	if rule == "" {
+		return "", nil
+	}
+
+	if err := s.register(ctx, &dbProv, projectID, fetchByProps, rule); err != nil {
+		return "", err
+	}
+	return rule, nil
+}
+
+// register registers a repository, recording that it was registered by the rule
+func (s *autoRegistrationService) register(
+	ctx context.Context, dbProv *db.Provider, projectID uuid.UUID, fetchByProps *properties.Properties, rule string,
+) error {
+	repo, err := s.repos.CreateRepository(ctx, dbProv, projectID, fetchByProps)
+	if err != nil {
+		return err
+	}
+	repoID, err := uuid.Parse(repo.GetId())
+	if err != nil {
+		return fmt.Errorf("repository id is not a UUID: %w", err)
+	}
+	_, err = s.store.CreateAutoRegisteredEntity(ctx, db.CreateAutoRegisteredEntityParams{
+		EntityInstanceID: repoID,
+		ProjectID:        projectID,
+		RuleName:         rule,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record auto-registered repository: %w", err)
+	}
+	return nil
+}
+
+// namedSelection is the compiled selector of a rule
+type namedSelection struct {
+	name      string
+	selection selectors.Selection
+}
+
+// rulesForProvider compiles the selectors of the rules of the project which
+// apply to the provider. It also returns the names of the rules whose
+// selector could not be compiled.
+func (s *autoRegistrationService) rulesForProvider(
+	ctx context.Context, projectID uuid.UUID, providerID uuid.UUID,
+) ([]namedSelection, map[string]bool, error) {
+	dbRules, err := s.store.ListAutoRegistrationRules(ctx, db.ListAutoRegistrationRulesParams{
+		ProjectID:  projectID,
+		ProviderID: uuid.NullUUID{UUID: providerID, Valid: true},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list rules: %w", err)
+	}
+
+	rules := make([]namedSelection, 0, len(dbRules))
+	broken := make(map[string]bool)
+	for _, dbRule := range dbRules {
+		entityType := entities.EntityTypeFromDB(dbRule.EntityType)
+		selection, err := s.selEnv.NewSelectionFromProfile(entityType, []profmodels.ProfileSelector{{
+			Entity:   entityType,
+			Selector: dbRule.Selector,
+		}})
+		if err != nil {
+			// The selectors are checked when the rules are created, so this
+			// should only happen if the selector language changed since
+			zerolog.Ctx(ctx).Error().Err(err).
+				Str("rule", dbRule.Name).
+				Str("project_id", projectID.String()).
+				Msg("cannot compile auto-registration rule selector")
+			broken[dbRule.Name] = true
+			continue
+		}
+		rules = append(rules, namedSelection{name: dbRule.Name, selection: selection})
+	}
+	return rules, broken, nil
+}
+
+// selectingRule returns the name of the first rule which selects the
+// repository, or an empty string if none does. If no rule selects the
+// repository, it also reports whether any of the rules failed to evaluate.
+func (*autoRegistrationService) selectingRule(
+	ctx context.Context, rules []namedSelection, dbProv *db.Provider, name string, props *properties.Properties,
+) (string, bool) {
+	ewp := models.NewEntityWithProperties(db.EntityInstance{
+		EntityType: db.EntitiesRepository,
+		Name:       name,
+		ProviderID: dbProv.ID,
+	}, props)
+	selEnt := provsel.EntityToSelectorEntityWithProvider(pb.Entity_ENTITY_REPOSITORIES, ewp, dbProv)
+
+	evalErr := false
+	for _, rule := range rules {
+		selected, _, err := rule.selection.Select(selEnt)
+		if err != nil {
+			zerolog.Ctx(ctx).Debug().Err(err).
+				Str("rule", rule.name).
+				Str("repository", name).
+				Msg("cannot evaluate auto-registration rule")
+			evalErr = true
+			continue
+		}
+		if selected {
+			return rule.name, false
+		}
+	}
+	return "", evalErr
+}
+
+func ruleDBToProtobuf(dbRule db.AutoRegistrationRule, providerName string) *pb.AutoRegistrationRule {
+	rule := &pb.AutoRegistrationRule{
+		Id:       dbRule.ID.String(),
+		Context:  &pb.Context{Project: ptr.Ptr(dbRule.ProjectID.String())},
+		Name:     dbRule.Name,
+		Entity:   entities.EntityTypeFromDB(dbRule.EntityType).ToString(),
+		Selector: dbRule.Selector,
+	}
+	if providerName != "" {
+		rule.Context.Provider = &providerName
+	}
+	return rule
+}
@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package autoregistration
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/entities/models"
+	mockrepo "github.com/mindersec/minder/internal/repositories/mock"
+	"github.com/mindersec/minder/internal/util/ptr"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	"github.com/mindersec/minder/pkg/engine/selectors"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	mockprov "github.com/mindersec/minder/pkg/providers/v1/mock"
+)
+
+var (
+	projectID  = uuid.New()
+	providerID = uuid.New()
+	// prod-b is registered already, dev was registered by a rule which no
+	// longer selects it, and manual was registered by hand
+	prodAID  = uuid.New()
+	prodBID  = uuid.New()
+	devID    = uuid.New()
+	manualID = uuid.New()
+)
+
+func TestReconcileProvider(t *testing.T) {
+	t.Parallel()
+
+	prodRule := db.AutoRegistrationRule{
+		ProjectID:  projectID,
+		Name:       "prod",
+		EntityType: db.EntitiesRepository,
+		Selector:   "repository.name.glob('org/prod-*')",
+	}
+	// registerProdA expects org/prod-a to be registered by the prod rule
+	registerProdA := func(store *mockdb.MockStore, repos *mockrepo.MockRepositoryService) {
+		repos.EXPECT().
+			CreateRepository(gomock.Any(), gomock.Any(), projectID, gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context, _ *db.Provider, _ uuid.UUID, fetchByProps *properties.Properties,
+			) (*pb.Repository, error) {
+				require.Equal(t, "org/prod-a", fetchByProps.GetProperty(properties.PropertyName).GetString())
+				return &pb.Repository{Id: ptr.Ptr(prodAID.String())}, nil
+			})
+		store.EXPECT().
+			CreateAutoRegisteredEntity(gomock.Any(), db.CreateAutoRegisteredEntityParams{
+				EntityInstanceID: prodAID,
+				ProjectID:        projectID,
+				RuleName:         "prod",
+			}).
+			Return(db.AutoRegisteredEntity{}, nil)
+	}
+
+	tests := []struct {
+		name   string
+		dryRun bool
+		// extraRules are evaluated after the prod rule
+		extraRules   []db.AutoRegistrationRule
+		deregistered bool
+		setup        func(store *mockdb.MockStore, repos *mockrepo.MockRepositoryService)
+	}{
+		{
+			name:         "registers selected and deregisters unselected repositories",
+			deregistered: true,
+			setup: func(store *mockdb.MockStore, repos *mockrepo.MockRepositoryService) {
+				registerProdA(store, repos)
+				repos.EXPECT().DeleteByID(gomock.Any(), devID, projectID).Return(nil)
+			},
+		},
+		{
+			name:         "dry run only reports the changes",
+			dryRun:       true,
+			deregistered: true,
+			setup:        func(_ *mockdb.MockStore, _ *mockrepo.MockRepositoryService) {},
+		},
+		{
+			name: "keeps repositories whose rules fail to evaluate",
+			extraRules: []db.AutoRegistrationRule{{
+				ProjectID:  projectID,
+				Name:       "other",
+				EntityType: db.EntitiesRepository,
+				Selector:   "repository.properties['not_a_property'] == 'x'",
+			}},
+			setup: registerProdA,
+		},
+		{
+			name: "keeps repositories of rules which fail to compile",
+			extraRules: []db.AutoRegistrationRule{{
+				ProjectID:  projectID,
+				Name:       "dev",
+				EntityType: db.EntitiesRepository,
+				Selector:   "repository.name ==",
+			}},
+			setup: registerProdA,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			repos := mockrepo.NewMockRepositoryService(ctrl)
+			lister := mockprov.NewMockRepoLister(ctrl)
+
+			store.EXPECT().
+				ListAutoRegistrationRules(gomock.Any(), gomock.Any()).
+				Return(append([]db.AutoRegistrationRule{prodRule}, tt.extraRules...), nil)
+			store.EXPECT().
+				ListAutoRegisteredEntities(gomock.Any(), gomock.Any()).
+				Return([]db.ListAutoRegisteredEntitiesRow{{
+					EntityInstanceID: devID,
+					RuleName:         "dev",
+					Name:             "org/dev",
+					EntityType:       db.EntitiesRepository,
+				}}, nil)
+			store.EXPECT().
+				GetFeatureInProject(gomock.Any(), gomock.Any()).
+				Return(nil, sql.ErrNoRows)
+			lister.EXPECT().
+				ListAllRepositories(gomock.Any()).
+				Return([]*pb.Repository{
+					remoteRepo(t, "1", "org/prod-a", false),
+					remoteRepo(t, "2", "org/prod-b", false),
+					remoteRepo(t, "3", "org/dev", false),
+					remoteRepo(t, "4", "org/manual", false),
+					remoteRepo(t, "5", "org/prod-private", true),
+				}, nil)
+			repos.EXPECT().
+				ListRepositories(gomock.Any(), projectID, providerID).
+				Return([]*models.EntityWithProperties{
+					registeredRepo(prodBID, "2", "org/prod-b"),
+					registeredRepo(devID, "3", "org/dev"),
+					registeredRepo(manualID, "4", "org/manual"),
+				}, nil)
+			tt.setup(store, repos)
+
+			svc := &autoRegistrationService{
+				store:  store,
+				repos:  repos,
+				selEnv: selectors.NewEnv(),
+			}
+			report := &pb.AutoRegistrationReport{DryRun: tt.dryRun}
+			err := svc.reconcileProvider(context.Background(), projectID, &db.Provider{
+				ID:   providerID,
+				Name: "github-app",
+			}, lister, tt.dryRun, report)
+			require.NoError(t, err)
+
+			require.Len(t, report.GetRegistered(), 1)
+			require.Equal(t, "org/prod-a", report.GetRegistered()[0].GetName())
+			require.Equal(t, "prod", report.GetRegistered()[0].GetRule())
+			require.Empty(t, report.GetRegistered()[0].GetError())
+			if !tt.deregistered {
+				require.Empty(t, report.GetDeregistered())
+				return
+			}
+			require.Len(t, report.GetDeregistered(), 1)
+			require.Equal(t, "org/dev", report.GetDeregistered()[0].GetName())
+			require.Equal(t, "dev", report.GetDeregistered()[0].GetRule())
+		})
+	}
+}
+
+func remoteRepo(t *testing.T, upstreamID, name string, private bool) *pb.Repository {
+	t.Helper()
+
+	props, err := structpb.NewStruct(map[string]any{
+		properties.PropertyUpstreamID:    upstreamID,
+		properties.PropertyName:          name,
+		properties.RepoPropertyIsPrivate: private,
+	})
+	require.NoError(t, err)
+	return &pb.Repository{
+		Name:       name,
+		IsPrivate:  private,
+		Properties: props,
+	}
+}
+
+func registeredRepo(id uuid.UUID, upstreamID, name string) *models.EntityWithProperties {
+	return models.NewEntityWithPropertiesFromInstance(models.EntityInstance{
+		ID:   id,
+		Type: pb.Entity_ENTITY_REPOSITORIES,
+		Name: name,
+	}, properties.NewProperties(map[string]any{
+		properties.PropertyUpstreamID: upstreamID,
+		properties.PropertyName:       name,
+	}))
+}
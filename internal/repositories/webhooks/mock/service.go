@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service.go
+//
+// Generated by this command:
+//
+//	mockgen -package mock_webhooks -destination=./mock/service.go -source=./service.go
+//
+
+// Package mock_webhooks is a generated GoMock package.
+package mock_webhooks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	webhooks "github.com/mindersec/minder/internal/repositories/webhooks"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookReconcileService is a mock of WebhookReconcileService interface.
+type MockWebhookReconcileService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookReconcileServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookReconcileServiceMockRecorder is the mock recorder for MockWebhookReconcileService.
+type MockWebhookReconcileServiceMockRecorder struct {
+	mock *MockWebhookReconcileService
+}
+
+// NewMockWebhookReconcileService creates a new mock instance.
+func NewMockWebhookReconcileService(ctrl *gomock.Controller) *MockWebhookReconcileService {
+	mock := &MockWebhookReconcileService{ctrl: ctrl}
+	mock.recorder = &MockWebhookReconcileServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookReconcileService) EXPECT() *MockWebhookReconcileServiceMockRecorder {
+	return m.recorder
+}
+
+// ReconcileProvider mocks base method.
+func (m *MockWebhookReconcileService) ReconcileProvider(ctx context.Context, projectID, providerID uuid.UUID) (*webhooks.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileProvider", ctx, projectID, providerID)
+	ret0, _ := ret[0].(*webhooks.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileProvider indicates an expected call of ReconcileProvider.
+func (mr *MockWebhookReconcileServiceMockRecorder) ReconcileProvider(ctx, projectID, providerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileProvider", reflect.TypeOf((*MockWebhookReconcileService)(nil).ReconcileProvider), ctx, projectID, providerID)
+}
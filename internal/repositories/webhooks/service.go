@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package webhooks keeps the webhooks of registered repositories working: it
+// repairs the webhooks which were deleted or changed upstream, and flags the
+// repositories which have not received a webhook delivery for a while.
+package webhooks
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+
+	"github.com/mindersec/minder/internal/db"
+	propService "github.com/mindersec/minder/internal/entities/properties/service"
+	"github.com/mindersec/minder/internal/providers/manager"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -package mock_$GOPACKAGE -destination=./mock/$GOFILE -source=./$GOFILE
+
+// Report summarizes the reconciliation of the webhooks of a provider
+type Report struct {
+	// Checked is the number of repositories whose webhook was checked
+	Checked int
+	// Repaired is the number of repositories whose webhook was repaired
+	Repaired int
+	// Quiet is the number of repositories which have gone quiet
+	Quiet int
+	// Failed is the number of repositories whose webhook could not be checked
+	Failed int
+}
+
+// WebhookReconcileService reconciles the webhooks of registered repositories
+type WebhookReconcileService interface {
+	// ReconcileProvider checks the webhooks of the repositories registered
+	// with the given provider, repairs the ones which drifted and flags the
+	// repositories which have gone quiet. Providers which cannot reconcile
+	// webhooks are skipped.
+	ReconcileProvider(ctx context.Context, projectID uuid.UUID, providerID uuid.UUID) (*Report, error)
+}
+
+type webhookReconcileService struct {
+	store           db.Store
+	propSvc         propService.PropertiesService
+	providerManager manager.ProviderManager
+	quietAfter      time.Duration
+}
+
+// NewWebhookReconcileService creates a new webhook reconcile service.
+func NewWebhookReconcileService(
+	store db.Store,
+	propSvc propService.PropertiesService,
+	providerManager manager.ProviderManager,
+	webhookCfg serverconfig.WebhookConfig,
+) WebhookReconcileService {
+	return &webhookReconcileService{
+		store:           store,
+		propSvc:         propSvc,
+		providerManager: providerManager,
+		quietAfter:      webhookCfg.QuietAfter,
+	}
+}
+
+func (s *webhookReconcileService) ReconcileProvider(
+	ctx context.Context, projectID uuid.UUID, providerID uuid.UUID,
+) (*Report, error) {
+	prov, err := s.providerManager.InstantiateFromID(ctx, providerID)
+	if err != nil {
+		return nil, fmt.Errorf("error instantiating provider: %w", err)
+	}
+
+	reconciler, err := provifv1.As[provifv1.WebhookReconciler](prov)
+	if err != nil {
+		zerolog.Ctx(ctx).Debug().
+			Str("provider_id", providerID.String()).
+			Msg("provider does not reconcile webhooks")
+		return &Report{}, nil
+	}
+
+	repos, err := s.store.GetEntitiesByType(ctx, db.GetEntitiesByTypeParams{
+		EntityType: db.EntitiesRepository,
+		ProviderID: providerID,
+		Projects:   []uuid.UUID{projectID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching repositories: %w", err)
+	}
+
+	report := &Report{}
+	for _, repo := range repos {
+		l := zerolog.Ctx(ctx).With().
+			Str("entity_id", repo.ID.String()).
+			Str("repository", repo.Name).
+			Logger()
+
+		// One broken repository should not keep the others from being
+		// reconciled, it is checked again on the next reminder.
+		repaired, quiet, err := s.reconcileRepository(l.WithContext(ctx), reconciler, &repo)
+		if err != nil {
+			l.Error().Err(err).Msg("error reconciling repository webhook")
+			report.Failed++
+			continue
+		}
+
+		report.Checked++
+		if repaired {
+			report.Repaired++
+		}
+		if quiet {
+			report.Quiet++
+		}
+	}
+
+	return report, nil
+}
+
+// reconcileRepository reconciles the webhook of a repository and records the
+// result. It returns whether the webhook was repaired and whether the
+// repository is quiet.
+func (s *webhookReconcileService) reconcileRepository(
+	ctx context.Context, reconciler provifv1.WebhookReconciler, repo *db.EntityInstance,
+) (bool, bool, error) {
+	l := zerolog.Ctx(ctx)
+
+	ewp, err := s.propSvc.EntityWithPropertiesByID(ctx, repo.ID, nil)
+	if err != nil {
+		return false, false, fmt.Errorf("error fetching properties for repository: %w", err)
+	}
+
+	res, err := reconciler.ReconcileWebhook(ctx, pb.Entity_ENTITY_REPOSITORIES, ewp.Properties)
+	if err != nil {
+		return false, false, fmt.Errorf("error reconciling webhook: %w", err)
+	}
+
+	if res.Properties != nil {
+		if err := s.propSvc.SaveAllProperties(ctx, repo.ID, res.Properties,
+			propService.CallBuilder().WithChangeSource(db.PropertyChangeSourceReconcile)); err != nil {
+			return false, false, fmt.Errorf("error saving webhook properties: %w", err)
+		}
+	}
+	if len(res.Repaired) > 0 {
+		l.Info().Strs("repaired", res.Repaired).Msg("repaired repository webhook")
+	}
+
+	previous, err := s.store.GetWebhookDeliveryStatus(ctx, repo.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		previous = db.WebhookDeliveryStatus{}
+	} else if err != nil {
+		return false, false, fmt.Errorf("error getting webhook delivery status: %w", err)
+	}
+
+	quiet := s.isQuiet(repo, &previous, time.Now())
+	_, err = s.store.UpsertWebhookReconciliation(ctx, db.UpsertWebhookReconciliationParams{
+		EntityInstanceID: repo.ID,
+		LastRepair:       strings.Join(res.Repaired, ","),
+		Quiet:            quiet,
+	})
+	if err != nil {
+		return false, false, fmt.Errorf("error recording webhook reconciliation: %w", err)
+	}
+
+	if quiet && !previous.QuietSince.Valid {
+		ev := l.Warn()
+		if previous.LastDeliveryAt.Valid {
+			ev = ev.Time("last_delivery_at", previous.LastDeliveryAt.Time)
+		}
+		ev.Msg("repository webhook has gone quiet")
+	}
+
+	return len(res.Repaired) > 0, quiet, nil
+}
+
+// isQuiet returns true if no webhook delivery was received for the
+// repository within the quiet threshold. Repositories which never received
+// one are measured from their registration.
+func (s *webhookReconcileService) isQuiet(
+	repo *db.EntityInstance, status *db.WebhookDeliveryStatus, now time.Time,
+) bool {
+	if s.quietAfter <= 0 {
+		return false
+	}
+
+	lastSeen := repo.CreatedAt
+	if status.LastDeliveryAt.Valid {
+		lastSeen = status.LastDeliveryAt.Time
+	}
+	return lastSeen.Before(now.Add(-s.quietAfter))
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webhooks
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/entities/models"
+	mockprops "github.com/mindersec/minder/internal/entities/properties/service/mock"
+	mockmanager "github.com/mindersec/minder/internal/providers/manager/mock"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+	"github.com/mindersec/minder/pkg/entities/properties"
+	provifv1 "github.com/mindersec/minder/pkg/providers/v1"
+	mockprov "github.com/mindersec/minder/pkg/providers/v1/mock"
+)
+
+func TestReconcileProvider(t *testing.T) {
+	t.Parallel()
+
+	hookProps := properties.NewProperties(map[string]any{"hook_id": "2"})
+
+	scenarios := []struct {
+		name           string
+		createdAt      time.Time
+		previous       *db.WebhookDeliveryStatus
+		result         *provifv1.WebhookReconcileResult
+		reconcileErr   error
+		expectedRepair string
+		expectedReport Report
+	}{
+		{
+			name:           "webhook in sync",
+			createdAt:      time.Now(),
+			result:         &provifv1.WebhookReconcileResult{},
+			expectedReport: Report{Checked: 1},
+		},
+		{
+			name:      "drifted webhook is repaired",
+			createdAt: time.Now(),
+			previous: &db.WebhookDeliveryStatus{
+				LastDeliveryAt: sql.NullTime{Time: time.Now().Add(-time.Hour), Valid: true},
+			},
+			result:         &provifv1.WebhookReconcileResult{Repaired: []string{"url", "active"}},
+			expectedRepair: "url,active",
+			expectedReport: Report{Checked: 1, Repaired: 1},
+		},
+		{
+			name:      "missing webhook is created",
+			createdAt: time.Now(),
+			result: &provifv1.WebhookReconcileResult{
+				Repaired:   []string{"missing"},
+				Properties: hookProps,
+			},
+			expectedRepair: "missing",
+			expectedReport: Report{Checked: 1, Repaired: 1},
+		},
+		{
+			name:      "repository without recent deliveries is quiet",
+			createdAt: time.Now().Add(-30 * 24 * time.Hour),
+			previous: &db.WebhookDeliveryStatus{
+				LastDeliveryAt: sql.NullTime{Time: time.Now().Add(-8 * 24 * time.Hour), Valid: true},
+			},
+			result:         &provifv1.WebhookReconcileResult{},
+			expectedReport: Report{Checked: 1, Quiet: 1},
+		},
+		{
+			name:           "repository which never received a delivery is quiet",
+			createdAt:      time.Now().Add(-8 * 24 * time.Hour),
+			result:         &provifv1.WebhookReconcileResult{},
+			expectedReport: Report{Checked: 1, Quiet: 1},
+		},
+		{
+			name:           "webhook cannot be checked",
+			createdAt:      time.Now(),
+			reconcileErr:   errors.New("403 Forbidden"),
+			expectedReport: Report{Failed: 1},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			propSvc := mockprops.NewMockPropertiesService(ctrl)
+			provMgr := mockmanager.NewMockProviderManager(ctrl)
+			prov := mockprov.NewMockWebhookReconciler(ctrl)
+
+			projectID := uuid.New()
+			providerID := uuid.New()
+			repo := db.EntityInstance{
+				ID:         uuid.New(),
+				EntityType: db.EntitiesRepository,
+				Name:       "owner/repo",
+				ProjectID:  projectID,
+				ProviderID: providerID,
+				CreatedAt:  scenario.createdAt,
+			}
+			repoProps := properties.NewProperties(map[string]any{"hook_id": "1"})
+
+			provMgr.EXPECT().InstantiateFromID(gomock.Any(), providerID).Return(prov, nil)
+			store.EXPECT().GetEntitiesByType(gomock.Any(), db.GetEntitiesByTypeParams{
+				EntityType: db.EntitiesRepository,
+				ProviderID: providerID,
+				Projects:   []uuid.UUID{projectID},
+			}).Return([]db.EntityInstance{repo}, nil)
+			propSvc.EXPECT().EntityWithPropertiesByID(gomock.Any(), repo.ID, gomock.Any()).
+				Return(models.NewEntityWithProperties(repo, repoProps), nil)
+			prov.EXPECT().ReconcileWebhook(gomock.Any(), pb.Entity_ENTITY_REPOSITORIES, repoProps).
+				Return(scenario.result, scenario.reconcileErr)
+
+			if scenario.result != nil && scenario.result.Properties != nil {
+				propSvc.EXPECT().SaveAllProperties(gomock.Any(), repo.ID, scenario.result.Properties, gomock.Any()).
+					Return(nil)
+			}
+
+			if scenario.reconcileErr == nil {
+				if scenario.previous != nil {
+					store.EXPECT().GetWebhookDeliveryStatus(gomock.Any(), repo.ID).Return(*scenario.previous, nil)
+				} else {
+					store.EXPECT().GetWebhookDeliveryStatus(gomock.Any(), repo.ID).
+						Return(db.WebhookDeliveryStatus{}, sql.ErrNoRows)
+				}
+				store.EXPECT().UpsertWebhookReconciliation(gomock.Any(), db.UpsertWebhookReconciliationParams{
+					EntityInstanceID: repo.ID,
+					LastRepair:       scenario.expectedRepair,
+					Quiet:            scenario.expectedReport.Quiet > 0,
+				}).Return(db.WebhookDeliveryStatus{}, nil)
+			}
+
+			svc := NewWebhookReconcileService(store, propSvc, provMgr,
+				serverconfig.WebhookConfig{QuietAfter: 7 * 24 * time.Hour})
+
+			report, err := svc.ReconcileProvider(context.Background(), projectID, providerID)
+			require.NoError(t, err)
+			require.Equal(t, scenario.expectedReport, *report)
+		})
+	}
+}
+
+func TestReconcileProviderWithoutWebhookSupport(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	propSvc := mockprops.NewMockPropertiesService(ctrl)
+	provMgr := mockmanager.NewMockProviderManager(ctrl)
+
+	providerID := uuid.New()
+	provMgr.EXPECT().InstantiateFromID(gomock.Any(), providerID).
+		Return(mockprov.NewMockProvider(ctrl), nil)
+
+	svc := NewWebhookReconcileService(store, propSvc, provMgr, serverconfig.WebhookConfig{})
+
+	report, err := svc.ReconcileProvider(context.Background(), uuid.New(), providerID)
+	require.NoError(t, err)
+	require.Equal(t, Report{}, *report)
+}
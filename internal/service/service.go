@@ -10,6 +10,7 @@ import (
 
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/open-feature/go-sdk/openfeature"
+	"github.com/rs/zerolog"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/mindersec/minder/internal/auth"
@@ -26,6 +27,7 @@ import (
 	"github.com/mindersec/minder/internal/email/sendgrid"
 	"github.com/mindersec/minder/internal/email/smtp"
 	"github.com/mindersec/minder/internal/engine"
+	"github.com/mindersec/minder/internal/engine/limits"
 	"github.com/mindersec/minder/internal/entities/handlers"
 	propService "github.com/mindersec/minder/internal/entities/properties/service"
 	entityService "github.com/mindersec/minder/internal/entities/service"
@@ -36,6 +38,7 @@ import (
 	"github.com/mindersec/minder/internal/metrics/meters"
 	"github.com/mindersec/minder/internal/projects"
 	"github.com/mindersec/minder/internal/providers"
+	adomanager "github.com/mindersec/minder/internal/providers/azuredevops/manager"
 	"github.com/mindersec/minder/internal/providers/dockerhub"
 	ghprov "github.com/mindersec/minder/internal/providers/github"
 	"github.com/mindersec/minder/internal/providers/github/clients"
@@ -43,14 +46,20 @@ import (
 	ghmanager "github.com/mindersec/minder/internal/providers/github/manager"
 	"github.com/mindersec/minder/internal/providers/github/service"
 	gitlabmanager "github.com/mindersec/minder/internal/providers/gitlab/manager"
+	"github.com/mindersec/minder/internal/providers/health"
 	"github.com/mindersec/minder/internal/providers/manager"
+	"github.com/mindersec/minder/internal/providers/ratebudget"
 	"github.com/mindersec/minder/internal/providers/ratecache"
 	"github.com/mindersec/minder/internal/providers/session"
 	provtelemetry "github.com/mindersec/minder/internal/providers/telemetry"
 	"github.com/mindersec/minder/internal/reconcilers"
 	"github.com/mindersec/minder/internal/reminderprocessor"
 	"github.com/mindersec/minder/internal/repositories"
+	"github.com/mindersec/minder/internal/repositories/autoregistration"
+	"github.com/mindersec/minder/internal/repositories/webhooks"
 	"github.com/mindersec/minder/internal/roles"
+	"github.com/mindersec/minder/internal/trustroots"
+	"github.com/mindersec/minder/internal/waivers"
 	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
 	serverconfig "github.com/mindersec/minder/pkg/config/server"
 	"github.com/mindersec/minder/pkg/engine/selectors"
@@ -101,13 +110,17 @@ func AllInOneServerService(
 	ruleSvc := ruletypes.NewRuleTypeService()
 	roleScv := roles.NewRoleService()
 	dataSourcesSvc := datasourcessvc.NewDataSourceService(store)
-	marketplace, err := marketplaces.NewMarketplaceFromServiceConfig(cfg.Marketplace, profileSvc, ruleSvc, dataSourcesSvc)
+	marketplace, err := marketplaces.NewMarketplaceFromServiceConfig(ctx, cfg.Marketplace, profileSvc, ruleSvc, dataSourcesSvc)
 	if err != nil {
 		return fmt.Errorf("failed to create marketplace: %w", err)
 	}
 
 	fallbackTokenClient := ghprov.NewFallbackTokenClient(cfg.Provider)
-	ghClientFactory := clients.NewGitHubClientFactory(providerMetrics)
+	budgets, err := ratebudget.NewTracker(meterFactory.Build("ratebudget"))
+	if err != nil {
+		return fmt.Errorf("unable to create rate budget tracker: %w", err)
+	}
+	ghClientFactory := clients.NewGitHubClientFactory(providerMetrics, budgets)
 	providerStore := providers.NewProviderStore(store)
 	projectCreator := projects.NewProjectCreator(authzClient, marketplace, &cfg.DefaultProfiles, &cfg.Features)
 	propSvc := propService.NewPropertiesService(store)
@@ -125,6 +138,7 @@ func AllInOneServerService(
 	githubProviderManager := ghmanager.NewGitHubProviderClassManager(
 		restClientCache,
 		ghClientFactory,
+		budgets,
 		&cfg.Provider,
 		&cfg.WebhookConfig,
 		fallbackTokenClient,
@@ -154,6 +168,7 @@ func AllInOneServerService(
 			evt,
 			cfg.Provider.GitLab,
 			cfg.WebhookConfig,
+			budgets,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to create gitlab provider manager: %w", err)
@@ -162,6 +177,23 @@ func AllInOneServerService(
 		provmans = append(provmans, gitlabProviderManager)
 	}
 
+	if flags.Bool(ctx, featureFlagClient, flags.AzureDevOpsProvider) {
+		adoProviderManager, err := adomanager.NewAzureDevOpsProviderClassManager(
+			ctx,
+			cryptoEngine,
+			store,
+			evt,
+			cfg.Provider.AzureDevOps,
+			cfg.Provider.Git,
+			cfg.WebhookConfig,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create azure devops provider manager: %w", err)
+		}
+
+		provmans = append(provmans, adoProviderManager)
+	}
+
 	providerManager, closer, err := manager.NewProviderManager(ctx, providerStore,
 		provmans...)
 	if err != nil {
@@ -190,6 +222,7 @@ func AllInOneServerService(
 
 	historySvc := history.NewEvaluationHistoryService(providerManager)
 	repos := repositories.NewRepositoryService(store, propSvc, evt, providerManager, entityCreator)
+	autoRegSvc := autoregistration.NewAutoRegistrationService(store, repos, providerManager, selChecker)
 	projectDeleter := projects.NewProjectDeleter(authzClient, providerManager)
 	sessionsService := session.NewProviderSessionService(providerManager, providerStore, store)
 	entSvc := entityService.NewEntityService(store, propSvc, providerManager)
@@ -205,12 +238,16 @@ func AllInOneServerService(
 		idClient,
 		inviteSvc,
 		repos,
+		autoRegSvc,
 		propSvc,
 		roleScv,
 		profileSvc,
 		historySvc,
 		ruleSvc,
 		dataSourcesSvc,
+		trustroots.NewTrustRootService(store),
+		waivers.NewWaiverService(store),
+		marketplace,
 		ghProviders,
 		providerManager,
 		providerAuthManager,
@@ -258,6 +295,7 @@ func AllInOneServerService(
 		profileStore,
 		selEnv,
 		propSvc,
+		limits.FromConfig(&cfg.Executor.Limits),
 	)
 
 	handler := engine.NewExecutorEventHandler(
@@ -265,17 +303,25 @@ func AllInOneServerService(
 		evt,
 		executorMiddleware,
 		exec,
+		budgets,
 	)
 
 	evt.ConsumeEvents(handler)
 
 	// Register the reconciler to handle entity events
-	rec, err := reconcilers.NewReconciler(store, evt, cryptoEngine, providerManager, repos)
+	providerHealth := health.NewProviderHealthService(
+		store, providerManager, evt, cfg.Provider.Health, cfg.WebhookConfig)
+	webhookReconcile := webhooks.NewWebhookReconcileService(store, propSvc, providerManager, cfg.WebhookConfig)
+	rec, err := reconcilers.NewReconciler(
+		store, evt, cryptoEngine, providerManager, repos, autoRegSvc, providerHealth, webhookReconcile)
 	if err != nil {
 		return fmt.Errorf("unable to create reconciler: %w", err)
 	}
 	evt.ConsumeEvents(rec)
 
+	// Register the provider health notifier to surface health changes
+	evt.ConsumeEvents(health.NewLogNotifier(*zerolog.Ctx(ctx)))
+
 	// Register the installation manager to handle provider installation events
 	im := installations.NewInstallationManager(ghProviders)
 	evt.ConsumeEvents(im)
@@ -287,6 +333,9 @@ func AllInOneServerService(
 	refreshById := handlers.NewRefreshByIDAndEvaluateHandler(evt, store, propSvc, providerManager)
 	evt.ConsumeEvents(refreshById)
 
+	prefetchProperties := handlers.NewPrefetchPropertiesHandler(evt, store, propSvc, providerManager)
+	evt.ConsumeEvents(prefetchProperties)
+
 	addOriginatingEntity := handlers.NewAddOriginatingEntityHandler(evt, store, propSvc, providerManager, entityCreator)
 	evt.ConsumeEvents(addOriginatingEntity)
 
@@ -320,7 +369,7 @@ func AllInOneServerService(
 	evt.ConsumeEvents(mailClient)
 
 	// Processor would only work for sql driver as reminder publisher is sql based
-	reminderProcessor := reminderprocessor.NewReminderProcessor(evt)
+	reminderProcessor := reminderprocessor.NewReminderProcessor(evt, budgets)
 	evt.ConsumeEvents(reminderProcessor)
 
 	// Start the gRPC and HTTP server in separate goroutines
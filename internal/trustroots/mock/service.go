@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service.go
+//
+// Generated by this command:
+//
+//	mockgen -package mock_trustroots -destination=./mock/service.go -source=./service.go
+//
+
+// Package mock_trustroots is a generated GoMock package.
+package mock_trustroots
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	v1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTrustRootService is a mock of TrustRootService interface.
+type MockTrustRootService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrustRootServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTrustRootServiceMockRecorder is the mock recorder for MockTrustRootService.
+type MockTrustRootServiceMockRecorder struct {
+	mock *MockTrustRootService
+}
+
+// NewMockTrustRootService creates a new mock instance.
+func NewMockTrustRootService(ctrl *gomock.Controller) *MockTrustRootService {
+	mock := &MockTrustRootService{ctrl: ctrl}
+	mock.recorder = &MockTrustRootServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrustRootService) EXPECT() *MockTrustRootServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockTrustRootService) Create(ctx context.Context, projectID uuid.UUID, tr *v1.TrustRoot) (*v1.TrustRoot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, projectID, tr)
+	ret0, _ := ret[0].(*v1.TrustRoot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTrustRootServiceMockRecorder) Create(ctx, projectID, tr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTrustRootService)(nil).Create), ctx, projectID, tr)
+}
+
+// Delete mocks base method.
+func (m *MockTrustRootService) Delete(ctx context.Context, name string, projectID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name, projectID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTrustRootServiceMockRecorder) Delete(ctx, name, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTrustRootService)(nil).Delete), ctx, name, projectID)
+}
+
+// GetByName mocks base method.
+func (m *MockTrustRootService) GetByName(ctx context.Context, name string, projectID uuid.UUID) (*v1.TrustRoot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", ctx, name, projectID)
+	ret0, _ := ret[0].(*v1.TrustRoot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName.
+func (mr *MockTrustRootServiceMockRecorder) GetByName(ctx, name, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockTrustRootService)(nil).GetByName), ctx, name, projectID)
+}
+
+// List mocks base method.
+func (m *MockTrustRootService) List(ctx context.Context, projectID uuid.UUID) ([]*v1.TrustRoot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, projectID)
+	ret0, _ := ret[0].([]*v1.TrustRoot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockTrustRootServiceMockRecorder) List(ctx, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTrustRootService)(nil).List), ctx, projectID)
+}
+
+// Update mocks base method.
+func (m *MockTrustRootService) Update(ctx context.Context, projectID uuid.UUID, tr *v1.TrustRoot) (*v1.TrustRoot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, projectID, tr)
+	ret0, _ := ret[0].(*v1.TrustRoot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTrustRootServiceMockRecorder) Update(ctx, projectID, tr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTrustRootService)(nil).Update), ctx, projectID, tr)
+}
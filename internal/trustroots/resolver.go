@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trustroots
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/verifier/sigstore"
+)
+
+// Resolver looks up trust roots by name in a project hierarchy. It is
+// handed to the rule engine so that rules can refer to trust roots defined
+// in the project of the evaluated entity or any of its parents.
+type Resolver struct {
+	querier  db.Querier
+	projects []uuid.UUID
+}
+
+var _ sigstore.TrustRootResolver = (*Resolver)(nil)
+
+// NewResolver creates a new trust root resolver for the given project hierarchy
+func NewResolver(querier db.Querier, projects []uuid.UUID) *Resolver {
+	return &Resolver{
+		querier:  querier,
+		projects: projects,
+	}
+}
+
+// ResolveTrustRoot returns the trust root with the given name
+func (r *Resolver) ResolveTrustRoot(ctx context.Context, name string) (*sigstore.TrustRoot, error) {
+	dbtr, err := r.querier.GetTrustRootByName(ctx, db.GetTrustRootByNameParams{
+		Name:     name,
+		Projects: r.projects,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", sigstore.ErrTrustRootNotFound, name)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get trust root %s: %w", name, err)
+	}
+
+	tr, err := trustRootDBToProtobuf(dbtr)
+	if err != nil {
+		return nil, err
+	}
+	return ToVerifierTrustRoot(tr), nil
+}
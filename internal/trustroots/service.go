@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package trustroots encodes the business logic for dealing with the custom
+// Sigstore trust roots that artifact signatures can be verified against.
+package trustroots
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/verifier/sigstore"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -package mock_$GOPACKAGE -destination=./mock/$GOFILE -source=./$GOFILE
+
+// ErrTrustRootAlreadyExists is returned when a trust root already exists
+var ErrTrustRootAlreadyExists = util.UserVisibleError(codes.AlreadyExists, "trust root already exists")
+
+// TrustRootService is an interface that defines the methods for the trust roots service.
+type TrustRootService interface {
+	// Create creates a new trust root in the given project.
+	Create(ctx context.Context, projectID uuid.UUID, tr *minderv1.TrustRoot) (*minderv1.TrustRoot, error)
+
+	// Update updates an existing trust root in the given project.
+	Update(ctx context.Context, projectID uuid.UUID, tr *minderv1.TrustRoot) (*minderv1.TrustRoot, error)
+
+	// GetByName returns a trust root by name, looking it up in the project hierarchy.
+	GetByName(ctx context.Context, name string, projectID uuid.UUID) (*minderv1.TrustRoot, error)
+
+	// List lists all trust roots visible from the given project.
+	List(ctx context.Context, projectID uuid.UUID) ([]*minderv1.TrustRoot, error)
+
+	// Delete deletes a trust root by name in the given project.
+	Delete(ctx context.Context, name string, projectID uuid.UUID) error
+}
+
+type trustRootService struct {
+	store db.Store
+}
+
+// NewTrustRootService creates a new trust root service.
+func NewTrustRootService(store db.Store) TrustRootService {
+	return &trustRootService{
+		store: store,
+	}
+}
+
+func (s *trustRootService) Create(
+	ctx context.Context, projectID uuid.UUID, tr *minderv1.TrustRoot,
+) (*minderv1.TrustRoot, error) {
+	definition, err := validateAndMarshal(tr)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.WithTransaction(s.store, func(qtx db.ExtendQuerier) (*minderv1.TrustRoot, error) {
+		// Names are unique in the project hierarchy, so that rules can refer to them unambiguously
+		projs, err := qtx.GetParentProjects(ctx, projectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list parent projects: %w", err)
+		}
+		_, err = qtx.GetTrustRootByName(ctx, db.GetTrustRootByNameParams{
+			Name:     tr.GetName(),
+			Projects: projs,
+		})
+		if err == nil {
+			return nil, ErrTrustRootAlreadyExists
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to check for existing trust root: %w", err)
+		}
+
+		dbtr, err := qtx.CreateTrustRoot(ctx, db.CreateTrustRootParams{
+			ProjectID:  projectID,
+			Name:       tr.GetName(),
+			Definition: definition,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create trust root: %w", err)
+		}
+
+		return trustRootDBToProtobuf(dbtr)
+	})
+}
+
+func (s *trustRootService) Update(
+	ctx context.Context, projectID uuid.UUID, tr *minderv1.TrustRoot,
+) (*minderv1.TrustRoot, error) {
+	definition, err := validateAndMarshal(tr)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.WithTransaction(s.store, func(qtx db.ExtendQuerier) (*minderv1.TrustRoot, error) {
+		// Only trust roots of the project itself can be updated
+		existing, err := getByName(ctx, qtx, tr.GetName(), []uuid.UUID{projectID})
+		if err != nil {
+			return nil, err
+		}
+
+		dbtr, err := qtx.UpdateTrustRoot(ctx, db.UpdateTrustRootParams{
+			ID:         existing.ID,
+			ProjectID:  projectID,
+			Definition: definition,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to update trust root: %w", err)
+		}
+
+		return trustRootDBToProtobuf(dbtr)
+	})
+}
+
+func (s *trustRootService) GetByName(
+	ctx context.Context, name string, projectID uuid.UUID,
+) (*minderv1.TrustRoot, error) {
+	projs, err := s.store.GetParentProjects(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list parent projects: %w", err)
+	}
+
+	dbtr, err := getByName(ctx, s.store, name, projs)
+	if err != nil {
+		return nil, err
+	}
+
+	return trustRootDBToProtobuf(dbtr)
+}
+
+func (s *trustRootService) List(ctx context.Context, projectID uuid.UUID) ([]*minderv1.TrustRoot, error) {
+	projs, err := s.store.GetParentProjects(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list parent projects: %w", err)
+	}
+
+	dbtrs, err := s.store.ListTrustRoots(ctx, projs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trust roots: %w", err)
+	}
+
+	out := make([]*minderv1.TrustRoot, 0, len(dbtrs))
+	for _, dbtr := range dbtrs {
+		tr, err := trustRootDBToProtobuf(dbtr)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, tr)
+	}
+
+	return out, nil
+}
+
+func (s *trustRootService) Delete(ctx context.Context, name string, projectID uuid.UUID) error {
+	return s.store.WithTransactionErr(func(qtx db.ExtendQuerier) error {
+		// Only trust roots of the project itself can be deleted
+		existing, err := getByName(ctx, qtx, name, []uuid.UUID{projectID})
+		if err != nil {
+			return err
+		}
+
+		if _, err := qtx.DeleteTrustRoot(ctx, db.DeleteTrustRootParams{
+			ID:        existing.ID,
+			ProjectID: projectID,
+		}); err != nil {
+			return fmt.Errorf("failed to delete trust root: %w", err)
+		}
+		return nil
+	})
+}
+
+func getByName(ctx context.Context, qtx db.Querier, name string, projs []uuid.UUID) (db.TrustRoot, error) {
+	dbtr, err := qtx.GetTrustRootByName(ctx, db.GetTrustRootByNameParams{
+		Name:     name,
+		Projects: projs,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.TrustRoot{}, util.UserVisibleError(codes.NotFound, "trust root of name %s not found", name)
+	} else if err != nil {
+		return db.TrustRoot{}, fmt.Errorf("failed to get trust root by name: %w", err)
+	}
+	return dbtr, nil
+}
+
+// validateAndMarshal validates the trust root and serializes the definition
+// to be stored in the database
+func validateAndMarshal(tr *minderv1.TrustRoot) ([]byte, error) {
+	if tr == nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "missing trust root")
+	}
+	if tr.GetName() == "" {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "trust root name cannot be empty")
+	}
+	keyIDs := make(map[string]struct{}, len(tr.GetPublicKeys()))
+	for _, pk := range tr.GetPublicKeys() {
+		if _, ok := keyIDs[pk.GetId()]; ok {
+			return nil, util.UserVisibleError(codes.InvalidArgument, "duplicate public key id %s", pk.GetId())
+		}
+		keyIDs[pk.GetId()] = struct{}{}
+	}
+	if err := ToVerifierTrustRoot(tr).Validate(); err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "invalid trust root: %s", err)
+	}
+
+	// The name, id and project are stored in their own columns
+	definition, err := protojson.Marshal(&minderv1.TrustRoot{
+		Sigstore:   tr.GetSigstore(),
+		PublicKeys: tr.GetPublicKeys(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal trust root: %w", err)
+	}
+	return definition, nil
+}
+
+func trustRootDBToProtobuf(dbtr db.TrustRoot) (*minderv1.TrustRoot, error) {
+	tr := &minderv1.TrustRoot{}
+	if err := protojson.Unmarshal(dbtr.Definition, tr); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal trust root: %w", err)
+	}
+	tr.Id = dbtr.ID.String()
+	tr.Name = dbtr.Name
+	tr.Context = &minderv1.ContextV2{
+		ProjectId: dbtr.ProjectID.String(),
+	}
+	return tr, nil
+}
+
+// ToVerifierTrustRoot converts the protobuf trust root to the one used by the sigstore verifier
+func ToVerifierTrustRoot(tr *minderv1.TrustRoot) *sigstore.TrustRoot {
+	out := &sigstore.TrustRoot{}
+	if sr := tr.GetSigstore(); sr != nil {
+		out.TUFRepoURL = sr.GetTufRepository()
+		out.TUFRootJSON = []byte(sr.GetTufRoot())
+		out.TrustedRootJSON = []byte(sr.GetTrustedRoot())
+		out.RequireTransparencyLog = sr.GetRequireTransparencyLog()
+		out.RequireSignedCertificateTimestamps = sr.GetRequireSignedCertificateTimestamps()
+	}
+	if len(tr.GetPublicKeys()) > 0 {
+		out.PublicKeys = make(map[string][]byte, len(tr.GetPublicKeys()))
+		for _, pk := range tr.GetPublicKeys() {
+			out.PublicKeys[pk.GetId()] = []byte(pk.GetPem())
+		}
+	}
+	return out
+}
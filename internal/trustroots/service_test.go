@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trustroots
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/verifier/sigstore"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func testPublicKeyPEM(t *testing.T) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pemKey, err := cryptoutils.MarshalPublicKeyToPEM(key.Public())
+	require.NoError(t, err)
+	return string(pemKey)
+}
+
+func expectTransaction(store *mockdb.MockStore) {
+	tx := sql.Tx{}
+	store.EXPECT().BeginTransaction().Return(&tx, nil)
+	store.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(store)
+	store.EXPECT().Commit(gomock.Any()).Return(nil).AnyTimes()
+	store.EXPECT().Rollback(gomock.Any()).Return(nil)
+}
+
+func TestTrustRootServiceCreate(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	parentID := uuid.New()
+	pemKey := testPublicKeyPEM(t)
+
+	for _, tc := range []struct {
+		name      string
+		trustRoot *minderv1.TrustRoot
+		setup     func(store *mockdb.MockStore)
+		mustErr   bool
+	}{
+		{
+			name: "creates trust root",
+			trustRoot: &minderv1.TrustRoot{
+				Name:       "release-keys",
+				PublicKeys: []*minderv1.TrustRoot_PublicKey{{Id: "release", Pem: pemKey}},
+			},
+			setup: func(store *mockdb.MockStore) {
+				expectTransaction(store)
+				store.EXPECT().GetParentProjects(gomock.Any(), projectID).
+					Return([]uuid.UUID{projectID, parentID}, nil)
+				store.EXPECT().GetTrustRootByName(gomock.Any(), db.GetTrustRootByNameParams{
+					Name:     "release-keys",
+					Projects: []uuid.UUID{projectID, parentID},
+				}).Return(db.TrustRoot{}, sql.ErrNoRows)
+				store.EXPECT().CreateTrustRoot(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, arg db.CreateTrustRootParams) (db.TrustRoot, error) {
+						require.Equal(t, projectID, arg.ProjectID)
+						require.Equal(t, "release-keys", arg.Name)
+						return db.TrustRoot{
+							ID:         uuid.New(),
+							Name:       arg.Name,
+							ProjectID:  arg.ProjectID,
+							Definition: json.RawMessage(arg.Definition),
+						}, nil
+					})
+			},
+		},
+		{
+			name: "name exists in hierarchy",
+			trustRoot: &minderv1.TrustRoot{
+				Name:       "release-keys",
+				PublicKeys: []*minderv1.TrustRoot_PublicKey{{Id: "release", Pem: pemKey}},
+			},
+			setup: func(store *mockdb.MockStore) {
+				expectTransaction(store)
+				store.EXPECT().GetParentProjects(gomock.Any(), projectID).
+					Return([]uuid.UUID{projectID, parentID}, nil)
+				store.EXPECT().GetTrustRootByName(gomock.Any(), gomock.Any()).
+					Return(db.TrustRoot{ID: uuid.New(), ProjectID: parentID}, nil)
+			},
+			mustErr: true,
+		},
+		{
+			name:      "no trusted material",
+			trustRoot: &minderv1.TrustRoot{Name: "empty"},
+			mustErr:   true,
+		},
+		{
+			name: "duplicate key ids",
+			trustRoot: &minderv1.TrustRoot{
+				Name: "release-keys",
+				PublicKeys: []*minderv1.TrustRoot_PublicKey{
+					{Id: "release", Pem: pemKey},
+					{Id: "release", Pem: pemKey},
+				},
+			},
+			mustErr: true,
+		},
+		{
+			name: "invalid public key",
+			trustRoot: &minderv1.TrustRoot{
+				Name:       "release-keys",
+				PublicKeys: []*minderv1.TrustRoot_PublicKey{{Id: "release", Pem: "not a key"}},
+			},
+			mustErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			if tc.setup != nil {
+				tc.setup(store)
+			}
+
+			svc := NewTrustRootService(store)
+			out, err := svc.Create(context.Background(), projectID, tc.trustRoot)
+			if tc.mustErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.trustRoot.GetName(), out.GetName())
+			require.Equal(t, projectID.String(), out.GetContext().GetProjectId())
+			require.Len(t, out.GetPublicKeys(), len(tc.trustRoot.GetPublicKeys()))
+		})
+	}
+}
+
+func TestTrustRootServiceDeleteNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	projectID := uuid.New()
+
+	store.EXPECT().WithTransactionErr(gomock.Any()).
+		DoAndReturn(func(fn func(db.ExtendQuerier) error) error {
+			return fn(store)
+		})
+	// Only the project itself is searched when deleting
+	store.EXPECT().GetTrustRootByName(gomock.Any(), db.GetTrustRootByNameParams{
+		Name:     "release-keys",
+		Projects: []uuid.UUID{projectID},
+	}).Return(db.TrustRoot{}, sql.ErrNoRows)
+
+	err := NewTrustRootService(store).Delete(context.Background(), "release-keys", projectID)
+	require.Error(t, err)
+}
+
+func TestResolver(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	hierarchy := []uuid.UUID{uuid.New(), uuid.New()}
+	pemKey := testPublicKeyPEM(t)
+
+	store.EXPECT().GetTrustRootByName(gomock.Any(), db.GetTrustRootByNameParams{
+		Name:     "release-keys",
+		Projects: hierarchy,
+	}).Return(db.TrustRoot{
+		ID:         uuid.New(),
+		Name:       "release-keys",
+		ProjectID:  hierarchy[1],
+		Definition: json.RawMessage(`{"publicKeys":[{"id":"release","pem":` + mustJSON(t, pemKey) + `}]}`),
+	}, nil)
+	store.EXPECT().GetTrustRootByName(gomock.Any(), db.GetTrustRootByNameParams{
+		Name:     "unknown",
+		Projects: hierarchy,
+	}).Return(db.TrustRoot{}, sql.ErrNoRows)
+
+	resolver := NewResolver(store, hierarchy)
+
+	tr, err := resolver.ResolveTrustRoot(context.Background(), "release-keys")
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{"release": []byte(pemKey)}, tr.PublicKeys)
+
+	_, err = resolver.ResolveTrustRoot(context.Background(), "unknown")
+	require.True(t, errors.Is(err, sigstore.ErrTrustRootNotFound))
+}
+
+func mustJSON(t *testing.T, v any) string {
+	t.Helper()
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	return string(b)
+}
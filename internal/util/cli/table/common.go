@@ -15,6 +15,8 @@ const (
 	errorStatus        = "error"
 	skippedStatus      = "skipped"
 	pendingStatus      = "pending"
+	timeoutStatus      = "timeout"
+	waivedStatus       = "waived"
 	notAvailableStatus = "not_available"
 	onStatus           = "on"
 	offStatus          = "off"
@@ -58,6 +60,16 @@ var statuses = map[string]statusDisplay{
 		Text:     "Error",
 		Severity: 3,
 	},
+	"timed out": {
+		Emoji:    "⏱️",
+		Text:     "Timeout",
+		Severity: 3,
+	},
+	"waived": {
+		Emoji:    "🙈",
+		Text:     "Waived",
+		Severity: 2,
+	},
 	"skipped": {
 		Emoji:    "➖",
 		Text:     "Skipped",
@@ -111,6 +123,10 @@ func GetStatusIcon(eval EvalStatus, emoji bool) layouts.ColoredColumn {
 		results = append(results, statuses["in compliance"])
 	case errorStatus:
 		results = append(results, statuses["failed to evaluate"])
+	case timeoutStatus:
+		results = append(results, statuses["timed out"])
+	case waivedStatus:
+		results = append(results, statuses["waived"])
 	case skippedStatus:
 		results = append(results, statuses["skipped"])
 	case failureStatus:
@@ -105,3 +105,37 @@ func (c *ProviderCursor) String() string {
 	}
 	return EncodeValue(c.CreatedAt.Format(time.RFC3339Nano))
 }
+
+// PropertyHistoryCursor is the ID of the last property change returned
+type PropertyHistoryCursor struct {
+	// ID is the ID of the last property change returned
+	ID int64
+}
+
+// NewPropertyHistoryCursor creates a new PropertyHistoryCursor from an encoded cursor
+func NewPropertyHistoryCursor(encodedCursor string) (*PropertyHistoryCursor, error) {
+	if encodedCursor == "" {
+		return &PropertyHistoryCursor{}, nil
+	}
+
+	cursor, err := DecodeValue(encodedCursor)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := strconv.ParseInt(cursor, 10, 64)
+	if err != nil || id <= 0 {
+		return nil, fmt.Errorf("invalid cursor: %s", encodedCursor)
+	}
+
+	return &PropertyHistoryCursor{
+		ID: id,
+	}, nil
+}
+
+func (c *PropertyHistoryCursor) String() string {
+	if c == nil || c.ID == 0 {
+		return ""
+	}
+	return EncodeValue(strconv.FormatInt(c.ID, 10))
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package netutil contains network helpers shared by components which make
+// requests to user-supplied destinations.
+package netutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+// ErrNotPublic is returned when a dialed connection resolves to an address
+// which is not publicly routable.
+var ErrNotPublic = errors.New("remote address is not public")
+
+// DialContextFunc is the signature of http.Transport.DialContext.
+type DialContextFunc = func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// PublicOnlyTransport returns a clone of transport (or of
+// http.DefaultTransport when nil) which refuses to connect to loopback,
+// private and other non-global-unicast addresses. onBlocked, if not nil, is
+// called each time a connection is refused.
+func PublicOnlyTransport(transport *http.Transport, onBlocked func(ctx context.Context)) *http.Transport {
+	if transport == nil {
+		var ok bool
+		transport, ok = http.DefaultTransport.(*http.Transport)
+		if !ok {
+			transport = &http.Transport{}
+		}
+	}
+	transport = transport.Clone()
+	transport.DialContext = PublicOnlyDialer(transport.DialContext, onBlocked)
+	return transport
+}
+
+// PublicOnlyDialer wraps baseDialer so that it attempts to dial the requested
+// address (going through DNS resolution, etc), and then examines the remote
+// IP address via conn.RemoteAddr(), closing the connection if the address is
+// not public.
+func PublicOnlyDialer(baseDialer DialContextFunc, onBlocked func(ctx context.Context)) DialContextFunc {
+	if baseDialer == nil {
+		baseDialer = (&net.Dialer{}).DialContext
+	}
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := baseDialer(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+		remote, ok := conn.RemoteAddr().(*net.TCPAddr)
+		if !ok {
+			_ = conn.Close()
+			return nil, fmt.Errorf("remote address is not a TCP address")
+		}
+		if !IsPublicIP(remote.IP) {
+			_ = conn.Close()
+			if onBlocked != nil {
+				onBlocked(ctx)
+			}
+			// Intentionally do not leak address resolution information
+			return nil, ErrNotPublic
+		}
+		return conn, nil
+	}
+}
+
+// IsPublicIP reports whether ip is a global unicast address which is neither
+// loopback nor in a private range.
+func IsPublicIP(ip net.IP) bool {
+	return ip.IsGlobalUnicast() && !ip.IsLoopback() && !ip.IsPrivate()
+}
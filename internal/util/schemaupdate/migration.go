@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package schemaupdate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/google/cel-go/cel"
+	"github.com/itchyny/gojq"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// MigrationTypeJQ is the type of migrations written in jq
+	MigrationTypeJQ = "jq"
+	// MigrationTypeCEL is the type of migrations written in CEL
+	MigrationTypeCEL = "cel"
+)
+
+// ErrInvalidMigration is returned when a migration cannot be compiled
+var ErrInvalidMigration = errors.New("invalid migration")
+
+// transform computes a migrated value from the old definition and parameters
+type transform func(ctx context.Context, def, params map[string]any) (map[string]any, error)
+
+// Migration rewrites the definition and parameters of rules written for
+// the previous schema version of a rule type
+type Migration struct {
+	def    transform
+	params transform
+}
+
+// NewMigration compiles the transforms of a migration in the given
+// language. Empty transforms keep the value as is.
+func NewMigration(lang, defExpr, paramsExpr string) (*Migration, error) {
+	var compile func(string) (transform, error)
+	switch lang {
+	case MigrationTypeJQ:
+		compile = compileJQ
+	case MigrationTypeCEL:
+		compile = compileCEL
+	default:
+		return nil, fmt.Errorf("%w: unknown migration type %q", ErrInvalidMigration, lang)
+	}
+
+	m := &Migration{}
+	var err error
+	if defExpr != "" {
+		if m.def, err = compile(defExpr); err != nil {
+			return nil, fmt.Errorf("%w: def: %w", ErrInvalidMigration, err)
+		}
+	}
+	if paramsExpr != "" {
+		if m.params, err = compile(paramsExpr); err != nil {
+			return nil, fmt.Errorf("%w: params: %w", ErrInvalidMigration, err)
+		}
+	}
+	return m, nil
+}
+
+// Apply returns the migrated definition and parameters
+func (m *Migration) Apply(
+	ctx context.Context, def, params map[string]any,
+) (map[string]any, map[string]any, error) {
+	newDef, newParams := def, params
+	var err error
+	if m.def != nil {
+		if newDef, err = m.def(ctx, def, params); err != nil {
+			return nil, nil, fmt.Errorf("error migrating rule definition: %w", err)
+		}
+	}
+	if m.params != nil {
+		if newParams, err = m.params(ctx, def, params); err != nil {
+			return nil, nil, fmt.Errorf("error migrating rule parameters: %w", err)
+		}
+	}
+	return newDef, newParams, nil
+}
+
+func compileJQ(expr string) (transform, error) {
+	query, err := gojq.Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, def, params map[string]any) (map[string]any, error) {
+		iter := code.RunWithContext(ctx, map[string]any{
+			"def":    orEmpty(def),
+			"params": orEmpty(params),
+		})
+		v, ok := iter.Next()
+		if !ok {
+			return nil, errors.New("jq expression returned no value")
+		}
+		if err, ok := v.(error); ok {
+			return nil, err
+		}
+		return asObject(v)
+	}, nil
+}
+
+func compileCEL(expr string) (transform, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("def", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("params", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Compile(expr)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, def, params map[string]any) (map[string]any, error) {
+		out, _, err := prg.ContextEval(ctx, map[string]any{
+			"def":    orEmpty(def),
+			"params": orEmpty(params),
+		})
+		if err != nil {
+			return nil, err
+		}
+		native, err := out.ConvertToNative(reflect.TypeOf(&structpb.Struct{}))
+		if err != nil {
+			return nil, fmt.Errorf("cel expression must return an object: %w", err)
+		}
+		//nolint:forcetypeassert // ConvertToNative returns the requested type
+		return native.(*structpb.Struct).AsMap(), nil
+	}, nil
+}
+
+func orEmpty(m map[string]any) map[string]any {
+	if m == nil {
+		return map[string]any{}
+	}
+	return m
+}
+
+func asObject(v any) (map[string]any, error) {
+	switch obj := v.(type) {
+	case nil:
+		return map[string]any{}, nil
+	case map[string]any:
+		return obj, nil
+	default:
+		return nil, fmt.Errorf("expression must return an object, got %T", v)
+	}
+}
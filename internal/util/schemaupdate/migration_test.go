@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package schemaupdate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		lang       string
+		def        string
+		params     string
+		oldDef     map[string]any
+		oldParams  map[string]any
+		wantDef    map[string]any
+		wantParams map[string]any
+		wantErr    bool
+		compileErr bool
+	}{
+		{
+			name:      "jq renames a field",
+			lang:      MigrationTypeJQ,
+			def:       `.def | {languages: [.language]} + del(.language)`,
+			oldDef:    map[string]any{"language": "go", "enabled": true},
+			oldParams: map[string]any{"a": "b"},
+			wantDef:   map[string]any{"languages": []any{"go"}, "enabled": true},
+			// params are kept as they have no transform
+			wantParams: map[string]any{"a": "b"},
+		},
+		{
+			name:       "jq moves a field from def to params",
+			lang:       MigrationTypeJQ,
+			def:        `.def | del(.threshold)`,
+			params:     `.params + {threshold: .def.threshold}`,
+			oldDef:     map[string]any{"threshold": float64(3)},
+			wantDef:    map[string]any{},
+			wantParams: map[string]any{"threshold": float64(3)},
+		},
+		{
+			name:       "cel builds the new definition",
+			lang:       MigrationTypeCEL,
+			def:        `{"languages": [def.language], "strict": has(params.strict) ? params.strict : false}`,
+			oldDef:     map[string]any{"language": "go"},
+			wantDef:    map[string]any{"languages": []any{"go"}, "strict": false},
+			wantParams: nil,
+		},
+		{
+			name:    "jq must return an object",
+			lang:    MigrationTypeJQ,
+			def:     `.def.language`,
+			oldDef:  map[string]any{"language": "go"},
+			wantErr: true,
+		},
+		{
+			name:    "cel must return an object",
+			lang:    MigrationTypeCEL,
+			def:     `"go"`,
+			wantErr: true,
+		},
+		{
+			name:       "invalid jq",
+			lang:       MigrationTypeJQ,
+			def:        `.def |`,
+			compileErr: true,
+		},
+		{
+			name:       "invalid cel",
+			lang:       MigrationTypeCEL,
+			params:     `params.`,
+			compileErr: true,
+		},
+		{
+			name:       "unknown type",
+			lang:       "python",
+			compileErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := NewMigration(tt.lang, tt.def, tt.params)
+			if tt.compileErr {
+				require.ErrorIs(t, err, ErrInvalidMigration)
+				return
+			}
+			require.NoError(t, err)
+
+			def, params, err := m.Apply(context.Background(), tt.oldDef, tt.oldParams)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantDef, def)
+			require.Equal(t, tt.wantParams, params)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	containerdigest "github.com/opencontainers/go-digest"
+
+	"github.com/mindersec/minder/internal/verifier/verifyif"
+)
+
+const (
+	// SigstoreBundleArtifactType is the OCI artifact type of sigstore bundles attached to
+	// container images as referrers
+	SigstoreBundleArtifactType = "application/vnd.dev.sigstore.bundle.v0.3+json"
+
+	// PredicateTypeAnnotation is the annotation of a sigstore bundle referrer holding
+	// the predicate type of the attestation in the bundle
+	PredicateTypeAnnotation = "dev.sigstore.bundle.predicateType"
+)
+
+// VerifyAttestationBundle verifies a sigstore bundle carrying an in-toto attestation about the
+// container image with the given digest. The result is verified only if the bundle signature
+// was verified, the attestation subject matches the digest and, for keyless signatures, the
+// signing certificate matched the identities in the policy.
+func VerifyAttestationBundle(
+	ctx context.Context,
+	verifiers *Verifiers,
+	policy *Policy,
+	bundleJSON []byte,
+	checksumref string,
+) (verifyif.Result, error) {
+	b, err := unmarhsalAttestationReply(&Attestation{Bundle: bundleJSON})
+	if err != nil {
+		return verifyif.Result{}, err
+	}
+	if b.GetDsseEnvelope() == nil {
+		return verifyif.Result{}, errors.New("bundle does not contain an attestation")
+	}
+
+	digest, err := getDigestFromVersion(checksumref)
+	if err != nil {
+		return verifyif.Result{}, fmt.Errorf("error getting digest from version: %w", err)
+	}
+
+	results := getVerifiedResults(ctx, verifiers, policy, []sigstoreBundle{{
+		bundle:      b,
+		digestBytes: digest,
+		digestAlgo:  containerdigest.Canonical.String(),
+		keyBased:    b.GetVerificationMaterial().GetPublicKey() != nil,
+	}})
+	return results[0], nil
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sigstore/sigstore-go/pkg/root"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/stretchr/testify/require"
+)
+
+const inTotoPayloadType = "application/vnd.in-toto+json"
+
+// dsseBundle builds a sigstore bundle with an in-toto attestation about the given
+// digest, signed with a long-lived key
+func dsseBundle(t *testing.T, key *ecdsa.PrivateKey, subjectDigest string) []byte {
+	t.Helper()
+
+	statement, err := json.Marshal(map[string]any{
+		"_type":         "https://in-toto.io/Statement/v1",
+		"subject":       []any{map[string]any{"name": "ghcr.io/stacklok/demo", "digest": map[string]string{"sha256": subjectDigest}}},
+		"predicateType": "https://slsa.dev/provenance/v1",
+		"predicate":     map[string]any{"runDetails": map[string]any{"builder": map[string]any{"id": "https://example.com/builder"}}},
+	})
+	require.NoError(t, err)
+
+	pae := fmt.Sprintf("DSSEv1 %d %s %d %s", len(inTotoPayloadType), inTotoPayloadType, len(statement), statement)
+	paeDigest := sha256.Sum256([]byte(pae))
+	sig, err := ecdsa.SignASN1(rand.Reader, key, paeDigest[:])
+	require.NoError(t, err)
+
+	b, err := json.Marshal(map[string]any{
+		"mediaType": "application/vnd.dev.sigstore.bundle.v0.3+json",
+		"verificationMaterial": map[string]any{
+			"publicKey": map[string]any{"hint": "release"},
+		},
+		"dsseEnvelope": map[string]any{
+			"payload":     base64.StdEncoding.EncodeToString(statement),
+			"payloadType": inTotoPayloadType,
+			"signatures":  []any{map[string]any{"sig": base64.StdEncoding.EncodeToString(sig)}},
+		},
+	})
+	require.NoError(t, err)
+	return b
+}
+
+func TestVerifyAttestationBundle(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	sv, err := signature.LoadECDSAVerifier(&key.PublicKey, crypto.SHA256)
+	require.NoError(t, err)
+	keys, err := verify.NewVerifier(root.NewTrustedPublicKeyMaterialFromMapping(map[string]*root.ExpiringKey{
+		"release": root.NewExpiringKey(sv, time.Time{}, time.Time{}),
+	}), verify.WithNoObserverTimestamps())
+	require.NoError(t, err)
+	verifiers := &Verifiers{Keys: keys, KeyIDs: []string{"release"}}
+
+	imageDigest := sha256.Sum256([]byte("image"))
+	otherDigest := sha256.Sum256([]byte("other image"))
+	checksumref := "sha256:" + hex.EncodeToString(imageDigest[:])
+
+	for _, tc := range []struct {
+		name     string
+		bundle   []byte
+		verified bool
+		mustErr  bool
+	}{
+		{
+			name:     "attestation-about-image",
+			bundle:   dsseBundle(t, key, hex.EncodeToString(imageDigest[:])),
+			verified: true,
+		},
+		{
+			name:   "attestation-about-other-image",
+			bundle: dsseBundle(t, key, hex.EncodeToString(otherDigest[:])),
+		},
+		{
+			name:    "not-a-bundle",
+			bundle:  []byte(`{"mediaType": "application/vnd.dev.sigstore.bundle.v0.3+json"}`),
+			mustErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := VerifyAttestationBundle(context.Background(), verifiers, &Policy{}, tc.bundle, checksumref)
+			if tc.mustErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, res.IsSigned)
+			require.Equal(t, tc.verified, res.IsVerified)
+			if tc.verified {
+				require.Equal(t, "release", res.KeyID)
+				require.Equal(t, "https://slsa.dev/provenance/v1", res.Statement.GetPredicateType())
+			}
+		})
+	}
+}
@@ -47,6 +47,9 @@ var (
 
 const (
 	sigstoreBundleMediaType01 = "application/vnd.dev.sigstore.bundle+json;version=0.1"
+
+	cosignCertificateAnnotation = "dev.sigstore.cosign/certificate"
+	cosignBundleAnnotation      = "dev.sigstore.cosign/bundle"
 )
 
 // AuthMethod is an option for containerAuth
@@ -110,10 +113,12 @@ func (c *containerAuth) getRegistry() string {
 // Verify verifies a container artifact using sigstore
 // isSigned is true only if we were able to find a signature/attestation and it had everything needed to construct the
 // sigstore bundle.
-// isVerified is true only if we were able to verify the constructed bundle against the configured sigstore instance.
+// isVerified is true only if we were able to verify the constructed bundle against the configured sigstore instance
+// or trusted public keys, and, for keyless signatures, the signing certificate matched the identities in the policy.
 func Verify(
 	ctx context.Context,
-	sev *verify.Verifier,
+	verifiers *Verifiers,
+	policy *Policy,
 	owner, artifact, checksumref string,
 	authOpts ...AuthMethod,
 ) ([]verifyif.Result, error) {
@@ -142,14 +147,15 @@ func Verify(
 	}
 
 	// Construct the verification result for each bundle we managed to generate.
-	return getVerifiedResults(ctx, sev, bundles), nil
+	return getVerifiedResults(ctx, verifiers, policy, bundles), nil
 }
 
 // getVerifiedResults verifies the artifact using the bundles against the configured sigstore instance
 // and returns the extracted metadata that we need for ingestion
 func getVerifiedResults(
 	ctx context.Context,
-	sev *verify.Verifier,
+	verifiers *Verifiers,
+	policy *Policy,
 	bundles []sigstoreBundle,
 ) []verifyif.Result {
 	var results []verifyif.Result
@@ -166,12 +172,7 @@ func getVerifiedResults(
 		}
 
 		// Verify the artifact using the bundle
-		// Note that we verify the identity in the next step (evaluation) where we check it against what was set by the
-		// user in their Minder profile (e.g., repository, cert. issuer, etc.)
-		verificationResult, err := sev.Verify(b.bundle, verify.NewPolicy(
-			verify.WithArtifactDigest(b.digestAlgo, b.digestBytes),
-			verify.WithoutIdentitiesUnsafe(),
-		))
+		verificationResult, keyID, err := verifyBundle(verifiers, b)
 		if err != nil {
 			// The bundle we provided failed verification
 			// Log the error and continue to the next bundle, this one is considered signed but not verified
@@ -179,16 +180,63 @@ func getVerifiedResults(
 			results = append(results, res)
 			continue
 		}
+		res.VerificationResult = *verificationResult
+		res.KeyID = keyID
+
+		// Keyless signatures are checked against the identities in the policy, if any. When no identities are
+		// configured, we verify the identity in the next step (evaluation) where we check it against what was set
+		// by the user in their Minder profile (e.g., repository, cert. issuer, etc.)
+		if keyID == "" && policy != nil && len(policy.Identities) > 0 {
+			matched, err := policy.MatchIdentity(verificationResult.Signature.Certificate)
+			if err != nil || matched == nil {
+				logger.Err(err).Msg("signing certificate did not match any trusted identity")
+				results = append(results, res)
+				continue
+			}
+			res.MatchedIdentity = policy.identityName(matched)
+		}
 
 		// We've successfully verified and extracted the artifact provenance information
 		res.IsVerified = true
-		res.VerificationResult = *verificationResult
 		results = append(results, res)
 	}
 	// Return the results
 	return results
 }
 
+// verifyBundle verifies a single bundle, using the keyless verifier for bundles carrying a signing
+// certificate and trying each of the trusted public keys otherwise. It returns the ID of the key
+// that verified the bundle, if any.
+func verifyBundle(verifiers *Verifiers, b sigstoreBundle) (*verify.VerificationResult, string, error) {
+	digestOpt := verify.WithArtifactDigest(b.digestAlgo, b.digestBytes)
+
+	if !b.keyBased {
+		if verifiers.Keyless == nil {
+			return nil, "", errors.New("keyless signature found, but no keyless trusted root is configured")
+		}
+		// The identity is verified separately against the policy, as the policy supports matchers
+		// sigstore-go's certificate identities don't
+		vr, err := verifiers.Keyless.Verify(b.bundle, verify.NewPolicy(digestOpt, verify.WithoutIdentitiesUnsafe()))
+		return vr, "", err
+	}
+
+	if verifiers.Keys == nil {
+		return nil, "", errors.New("key-based signature found, but no public keys are trusted")
+	}
+	var errs []error
+	for _, keyID := range verifiers.KeyIDs {
+		b.bundle.VerificationMaterial.Content = &protobundle.VerificationMaterial_PublicKey{
+			PublicKey: &protocommon.PublicKeyIdentifier{Hint: keyID},
+		}
+		vr, err := verifiers.Keys.Verify(b.bundle, verify.NewPolicy(digestOpt, verify.WithKey()))
+		if err == nil {
+			return vr, keyID, nil
+		}
+		errs = append(errs, fmt.Errorf("key %s: %w", keyID, err))
+	}
+	return nil, "", fmt.Errorf("signature not verified by any trusted key: %w", errors.Join(errs...))
+}
+
 // getSigstoreBundles returns the sigstore bundles, either through the OCI registry or the GitHub attestation endpoint
 func getSigstoreBundles(
 	ctx context.Context,
@@ -386,6 +434,7 @@ func bundleFromOCIImage(ctx context.Context,
 			bundle:      bun,
 			digestAlgo:  layer.Digest.Algorithm,
 			digestBytes: digestBytes,
+			keyBased:    verificationMaterial.GetPublicKey() != nil,
 		})
 	}
 
@@ -463,23 +512,35 @@ func getSimpleSigningLayersFromSignatureManifest(manifestRef string, auth authn.
 // getBundleVerificationMaterial returns the bundle verification material from the simple signing layer
 func getBundleVerificationMaterial(manifestLayer v1.Descriptor) (
 	*protobundle.VerificationMaterial, error) {
-	// 1. Get the signing certificate chain
-	signingCert, err := getVerificationMaterialX509CertificateChain(manifestLayer)
-	if err != nil {
-		return nil, fmt.Errorf("error getting signing certificate: %w", err)
+	verificationMaterial := &protobundle.VerificationMaterial{}
+
+	// 1. Get the signing certificate chain. Signatures made with a long-lived key don't carry a
+	// certificate, in which case the public key is resolved from the trusted keys during verification.
+	_, hasCert := manifestLayer.Annotations[cosignCertificateAnnotation]
+	if hasCert {
+		signingCert, err := getVerificationMaterialX509CertificateChain(manifestLayer)
+		if err != nil {
+			return nil, fmt.Errorf("error getting signing certificate: %w", err)
+		}
+		verificationMaterial.Content = signingCert
+	} else {
+		verificationMaterial.Content = &protobundle.VerificationMaterial_PublicKey{
+			PublicKey: &protocommon.PublicKeyIdentifier{},
+		}
 	}
 
-	// 2. Get the transparency log entries
-	tlogEntries, err := getVerificationMaterialTlogEntries(manifestLayer)
-	if err != nil {
-		return nil, fmt.Errorf("error getting tlog entries: %w", err)
+	// 2. Get the transparency log entries. Key-based signatures may not have been uploaded to the
+	// transparency log, whether that's acceptable is up to the verifier.
+	if _, hasBundle := manifestLayer.Annotations[cosignBundleAnnotation]; hasBundle || hasCert {
+		tlogEntries, err := getVerificationMaterialTlogEntries(manifestLayer)
+		if err != nil {
+			return nil, fmt.Errorf("error getting tlog entries: %w", err)
+		}
+		verificationMaterial.TlogEntries = tlogEntries
 	}
-	// 3. Construct the verification material
-	return &protobundle.VerificationMaterial{
-		Content:                   signingCert,
-		TlogEntries:               tlogEntries,
-		TimestampVerificationData: nil,
-	}, nil
+
+	// 3. Return the verification material
+	return verificationMaterial, nil
 }
 
 // getVerificationMaterialX509CertificateChain returns the verification material X509 certificate chain from the
@@ -487,7 +548,7 @@ func getBundleVerificationMaterial(manifestLayer v1.Descriptor) (
 func getVerificationMaterialX509CertificateChain(manifestLayer v1.Descriptor) (
 	*protobundle.VerificationMaterial_X509CertificateChain, error) {
 	// 1. Get the PEM certificate from the simple signing layer
-	pemCert := manifestLayer.Annotations["dev.sigstore.cosign/certificate"]
+	pemCert := manifestLayer.Annotations[cosignCertificateAnnotation]
 	// 2. Construct the DER encoded version of the PEM certificate
 	block, _ := pem.Decode([]byte(pemCert))
 	if block == nil {
@@ -508,7 +569,7 @@ func getVerificationMaterialX509CertificateChain(manifestLayer v1.Descriptor) (
 func getVerificationMaterialTlogEntries(manifestLayer v1.Descriptor) (
 	[]*protorekor.TransparencyLogEntry, error) {
 	// 1. Get the bundle annotation
-	bun := manifestLayer.Annotations["dev.sigstore.cosign/bundle"]
+	bun := manifestLayer.Annotations[cosignBundleAnnotation]
 	var jsonData map[string]interface{}
 	err := json.Unmarshal([]byte(bun), &jsonData)
 	if err != nil {
@@ -618,4 +679,6 @@ type sigstoreBundle struct {
 	bundle      *bundle.Bundle
 	digestBytes []byte
 	digestAlgo  string
+	// keyBased is true if the bundle was signed with a long-lived key rather than a signing certificate
+	keyBased bool
 }
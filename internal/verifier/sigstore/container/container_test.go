@@ -5,16 +5,31 @@ package container
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/layout"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	protobundle "github.com/sigstore/protobuf-specs/gen/pb-go/bundle/v1"
+	"github.com/sigstore/sigstore-go/pkg/bundle"
+	"github.com/sigstore/sigstore-go/pkg/root"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+	"github.com/sigstore/sigstore/pkg/signature"
 	"github.com/stretchr/testify/require"
 	"sigs.k8s.io/release-utils/tar"
 )
@@ -109,3 +124,112 @@ func TestBundleFromOCIImage(t *testing.T) {
 		})
 	}
 }
+
+func TestGetVerifiedResultsWithKeys(t *testing.T) {
+	t.Parallel()
+
+	signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	// Sign a simple signing payload the way cosign does with a long-lived key
+	payload := []byte(`{"critical":{"type":"cosign container image signature"}}`)
+	digest := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, signingKey, digest[:])
+	require.NoError(t, err)
+	layer := v1.Descriptor{
+		MediaType: "application/vnd.dev.cosign.simplesigning.v1+json",
+		Digest:    v1.Hash{Algorithm: "sha256", Hex: hex.EncodeToString(digest[:])},
+		Annotations: map[string]string{
+			"dev.cosignproject.cosign/signature": base64.StdEncoding.EncodeToString(sig),
+		},
+	}
+
+	keyVerifiers := func(t *testing.T, keys map[string]*ecdsa.PrivateKey) *Verifiers {
+		t.Helper()
+		expiringKeys := make(map[string]*root.ExpiringKey, len(keys))
+		var ids []string
+		for id, k := range keys {
+			sv, err := signature.LoadECDSAVerifier(&k.PublicKey, crypto.SHA256)
+			require.NoError(t, err)
+			expiringKeys[id] = root.NewExpiringKey(sv, time.Time{}, time.Time{})
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		v, err := verify.NewVerifier(root.NewTrustedPublicKeyMaterialFromMapping(expiringKeys),
+			verify.WithNoObserverTimestamps())
+		require.NoError(t, err)
+		return &Verifiers{Keys: v, KeyIDs: ids}
+	}
+
+	for _, tc := range []struct {
+		name          string
+		verifiers     func(t *testing.T) *Verifiers
+		policy        *Policy
+		expectedKeyID string
+		verified      bool
+	}{
+		{
+			name: "trusted-key",
+			verifiers: func(t *testing.T) *Verifiers {
+				t.Helper()
+				return keyVerifiers(t, map[string]*ecdsa.PrivateKey{"a-other": otherKey, "release": signingKey})
+			},
+			expectedKeyID: "release",
+			verified:      true,
+		},
+		{
+			name: "identities-do-not-apply-to-keys",
+			verifiers: func(t *testing.T) *Verifiers {
+				t.Helper()
+				return keyVerifiers(t, map[string]*ecdsa.PrivateKey{"release": signingKey})
+			},
+			policy:        &Policy{Identities: []IdentityPolicy{{Issuer: "https://accounts.google.com"}}},
+			expectedKeyID: "release",
+			verified:      true,
+		},
+		{
+			name: "untrusted-key",
+			verifiers: func(t *testing.T) *Verifiers {
+				t.Helper()
+				return keyVerifiers(t, map[string]*ecdsa.PrivateKey{"other": otherKey})
+			},
+		},
+		{
+			name: "no-trusted-keys",
+			verifiers: func(t *testing.T) *Verifiers {
+				t.Helper()
+				return &Verifiers{}
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Build a fresh bundle for each test case, as verification sets the key hint
+			verificationMaterial, err := getBundleVerificationMaterial(layer)
+			require.NoError(t, err)
+			require.NotNil(t, verificationMaterial.GetPublicKey())
+			msgSignature, err := getBundleMsgSignature(layer)
+			require.NoError(t, err)
+			bun, err := bundle.NewBundle(&protobundle.Bundle{
+				MediaType:            sigstoreBundleMediaType01,
+				VerificationMaterial: verificationMaterial,
+				Content:              msgSignature,
+			})
+			require.NoError(t, err)
+
+			results := getVerifiedResults(context.Background(), tc.verifiers(t), tc.policy, []sigstoreBundle{{
+				bundle:      bun,
+				digestAlgo:  "sha256",
+				digestBytes: digest[:],
+				keyBased:    true,
+			}})
+			require.Len(t, results, 1)
+			require.True(t, results[0].IsSigned)
+			require.Equal(t, tc.verified, results[0].IsVerified)
+			require.Equal(t, tc.expectedKeyID, results[0].KeyID)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/sigstore/sigstore-go/pkg/fulcio/certificate"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+)
+
+// Verifiers holds the sigstore verifiers used for the different signing methods
+type Verifiers struct {
+	// Keyless verifies signatures made with a short-lived signing certificate
+	// (e.g. Fulcio). May be nil if keyless signatures are not trusted.
+	Keyless *verify.Verifier
+	// Keys verifies signatures made with one of the trusted public keys.
+	// May be nil if no public keys are trusted.
+	Keys *verify.Verifier
+	// KeyIDs are the IDs of the public keys trusted by Keys
+	KeyIDs []string
+}
+
+// Policy is the verification policy applied to the signatures of an artifact
+type Policy struct {
+	// Identities is the list of keyless signing identities trusted to sign the
+	// artifact. A keyless signature is only considered verified if its signing
+	// certificate matches at least one of them. If empty, the identity is not
+	// checked during verification and it's up to the rule to evaluate it.
+	Identities []IdentityPolicy
+}
+
+// IdentityPolicy describes a keyless signing identity. All the non-empty
+// fields must match the signing certificate for the identity to match.
+type IdentityPolicy struct {
+	// Name is an optional name for the identity, reported back when it matches
+	Name string `json:"name,omitempty" yaml:"name" mapstructure:"name"`
+	// Issuer is the exact OIDC issuer of the signing certificate
+	Issuer string `json:"issuer,omitempty" yaml:"issuer" mapstructure:"issuer"`
+	// IssuerRegex is a regular expression matched against the whole OIDC issuer
+	IssuerRegex string `json:"issuer_regex,omitempty" yaml:"issuer_regex" mapstructure:"issuer_regex"`
+	// Subject is the exact subject alternative name of the signing certificate
+	Subject string `json:"subject,omitempty" yaml:"subject" mapstructure:"subject"`
+	// SubjectRegex is a regular expression matched against the whole subject alternative name
+	SubjectRegex string `json:"subject_regex,omitempty" yaml:"subject_regex" mapstructure:"subject_regex"`
+	// WorkflowRef is the exact build signer URI of the signing certificate. For
+	// GitHub Actions this is the workflow that signed, including its git ref, e.g.
+	// https://github.com/org/repo/.github/workflows/release.yml@refs/tags/v1.0.0
+	WorkflowRef string `json:"workflow_ref,omitempty" yaml:"workflow_ref" mapstructure:"workflow_ref"`
+	// WorkflowRefRegex is a regular expression matched against the whole build signer URI
+	WorkflowRefRegex string `json:"workflow_ref_regex,omitempty" yaml:"workflow_ref_regex" mapstructure:"workflow_ref_regex"`
+	// SourceRepository is the exact source repository URI of the signing certificate
+	SourceRepository string `json:"source_repository,omitempty" yaml:"source_repository" mapstructure:"source_repository"`
+}
+
+// Validate checks that the identity policy is not empty and that its regular
+// expressions compile
+func (p *IdentityPolicy) Validate() error {
+	if *p == (IdentityPolicy{Name: p.Name}) {
+		return errors.New("identity policy must set at least one matcher")
+	}
+	if p.Issuer != "" && p.IssuerRegex != "" {
+		return errors.New("issuer and issuer_regex are mutually exclusive")
+	}
+	if p.Subject != "" && p.SubjectRegex != "" {
+		return errors.New("subject and subject_regex are mutually exclusive")
+	}
+	if p.WorkflowRef != "" && p.WorkflowRefRegex != "" {
+		return errors.New("workflow_ref and workflow_ref_regex are mutually exclusive")
+	}
+	for field, expr := range map[string]string{
+		"issuer_regex":       p.IssuerRegex,
+		"subject_regex":      p.SubjectRegex,
+		"workflow_ref_regex": p.WorkflowRefRegex,
+	} {
+		if _, err := regexp.Compile(expr); err != nil {
+			return fmt.Errorf("invalid %s: %w", field, err)
+		}
+	}
+	return nil
+}
+
+// Matches returns true if the signing certificate matches the identity policy
+func (p *IdentityPolicy) Matches(cert *certificate.Summary) (bool, error) {
+	if cert == nil {
+		return false, nil
+	}
+	for _, m := range []struct {
+		value, exact, expr string
+	}{
+		{cert.Issuer, p.Issuer, p.IssuerRegex},
+		{cert.SubjectAlternativeName, p.Subject, p.SubjectRegex},
+		{cert.BuildSignerURI, p.WorkflowRef, p.WorkflowRefRegex},
+		{cert.SourceRepositoryURI, p.SourceRepository, ""},
+	} {
+		ok, err := matchValue(m.value, m.exact, m.expr)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// MatchIdentity returns the first identity policy matching the signing
+// certificate, or nil if none matches
+func (p *Policy) MatchIdentity(cert *certificate.Summary) (*IdentityPolicy, error) {
+	for i := range p.Identities {
+		ok, err := p.Identities[i].Matches(cert)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return &p.Identities[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// identityName returns the name of the identity policy, defaulting to its position in the list
+func (p *Policy) identityName(id *IdentityPolicy) string {
+	if id.Name != "" {
+		return id.Name
+	}
+	for i := range p.Identities {
+		if &p.Identities[i] == id {
+			return fmt.Sprintf("identities[%d]", i)
+		}
+	}
+	return ""
+}
+
+func matchValue(value, exact, expr string) (bool, error) {
+	if exact != "" && value != exact {
+		return false, nil
+	}
+	if expr != "" {
+		// Anchor the expression so it must match the whole value, not a substring
+		re, err := regexp.Compile("^(?:" + expr + ")$")
+		if err != nil {
+			return false, fmt.Errorf("invalid regular expression %q: %w", expr, err)
+		}
+		return re.MatchString(value), nil
+	}
+	return true, nil
+}
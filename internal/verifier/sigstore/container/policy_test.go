@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"testing"
+
+	"github.com/sigstore/sigstore-go/pkg/fulcio/certificate"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIssuer = "https://token.actions.githubusercontent.com"
+	testSAN    = "https://github.com/stacklok/minder/.github/workflows/release.yml@refs/tags/v1.0.0"
+	testRepo   = "https://github.com/stacklok/minder"
+)
+
+func testCertificate() *certificate.Summary {
+	return &certificate.Summary{
+		SubjectAlternativeName: testSAN,
+		Extensions: certificate.Extensions{
+			Issuer:              testIssuer,
+			BuildSignerURI:      testSAN,
+			SourceRepositoryURI: testRepo,
+		},
+	}
+}
+
+func TestIdentityPolicyValidate(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name    string
+		policy  IdentityPolicy
+		mustErr bool
+	}{
+		{name: "issuer-and-subject", policy: IdentityPolicy{Issuer: testIssuer, Subject: testSAN}},
+		{name: "regexes", policy: IdentityPolicy{IssuerRegex: ".*", SubjectRegex: "^https://", WorkflowRefRegex: "@refs/tags/"}},
+		{name: "empty", policy: IdentityPolicy{}, mustErr: true},
+		{name: "only-name", policy: IdentityPolicy{Name: "release"}, mustErr: true},
+		{name: "issuer-and-issuer-regex", policy: IdentityPolicy{Issuer: testIssuer, IssuerRegex: ".*"}, mustErr: true},
+		{name: "subject-and-subject-regex", policy: IdentityPolicy{Subject: testSAN, SubjectRegex: ".*"}, mustErr: true},
+		{name: "workflow-and-workflow-regex", policy: IdentityPolicy{WorkflowRef: testSAN, WorkflowRefRegex: ".*"}, mustErr: true},
+		{name: "invalid-regex", policy: IdentityPolicy{SubjectRegex: "("}, mustErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.policy.Validate()
+			if tc.mustErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPolicyMatchIdentity(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name     string
+		policy   Policy
+		cert     *certificate.Summary
+		expected string
+	}{
+		{
+			name: "exact-issuer-and-subject",
+			policy: Policy{Identities: []IdentityPolicy{
+				{Name: "release", Issuer: testIssuer, Subject: testSAN},
+			}},
+			cert:     testCertificate(),
+			expected: "release",
+		},
+		{
+			name: "workflow-ref-regex",
+			policy: Policy{Identities: []IdentityPolicy{
+				{Issuer: testIssuer, WorkflowRefRegex: `^https://github\.com/stacklok/minder/\.github/workflows/release\.yml@refs/tags/v.*`},
+			}},
+			cert:     testCertificate(),
+			expected: "identities[0]",
+		},
+		{
+			name: "first-matching-identity",
+			policy: Policy{Identities: []IdentityPolicy{
+				{Name: "other-repo", SourceRepository: "https://github.com/stacklok/other"},
+				{Name: "any-tag", SubjectRegex: ".*@refs/tags/.*"},
+				{Name: "exact", Subject: testSAN},
+			}},
+			cert:     testCertificate(),
+			expected: "any-tag",
+		},
+		{
+			name: "issuer-mismatch",
+			policy: Policy{Identities: []IdentityPolicy{
+				{Issuer: "https://accounts.google.com", SubjectRegex: ".*"},
+			}},
+			cert: testCertificate(),
+		},
+		{
+			name: "branch-not-allowed",
+			policy: Policy{Identities: []IdentityPolicy{
+				{Issuer: testIssuer, WorkflowRefRegex: ".*@refs/heads/main"},
+			}},
+			cert: testCertificate(),
+		},
+		{
+			name: "regex-must-match-whole-value",
+			policy: Policy{Identities: []IdentityPolicy{
+				{IssuerRegex: `https://token\.actions\.githubusercontent\.com`, SubjectRegex: `https://github\.com/stacklok/minder/`},
+			}},
+			cert: testCertificate(),
+		},
+		{
+			name: "no-certificate",
+			policy: Policy{Identities: []IdentityPolicy{
+				{Issuer: testIssuer},
+			}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			matched, err := tc.policy.MatchIdentity(tc.cert)
+			require.NoError(t, err)
+			if tc.expected == "" {
+				require.Nil(t, matched)
+				return
+			}
+			require.NotNil(t, matched)
+			require.Equal(t, tc.expected, tc.policy.identityName(matched))
+		})
+	}
+}
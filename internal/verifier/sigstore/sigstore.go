@@ -36,14 +36,65 @@ const (
 
 // Sigstore is the sigstore verifier
 type Sigstore struct {
-	verifier *verify.Verifier
-	authOpts []container.AuthMethod
+	verifiers *container.Verifiers
+	policy    *container.Policy
+	authOpts  []container.AuthMethod
 }
 
 var _ verifyif.ArtifactVerifier = (*Sigstore)(nil)
 
+// Options configures a Sigstore verifier
+type Options struct {
+	// TUFRepoURL selects one of the well-known TUF repositories to fetch the
+	// trusted root from. It's ignored if TrustRoot is set.
+	TUFRepoURL string
+	// TrustRoot is a custom trust root to verify signatures against
+	TrustRoot *TrustRoot
+	// Policy is the verification policy applied to the signatures
+	Policy container.Policy
+	// AuthOpts are the authentication options for the container registry
+	AuthOpts []container.AuthMethod
+}
+
 // New creates a new Sigstore verifier
 func New(sigstoreTUFRepoURL string, authOpts ...container.AuthMethod) (*Sigstore, error) {
+	return NewWithOptions(&Options{
+		TUFRepoURL: sigstoreTUFRepoURL,
+		AuthOpts:   authOpts,
+	})
+}
+
+// NewWithOptions creates a new Sigstore verifier with a custom trust root and
+// verification policy
+func NewWithOptions(opts *Options) (*Sigstore, error) {
+	for i := range opts.Policy.Identities {
+		if err := opts.Policy.Identities[i].Validate(); err != nil {
+			return nil, fmt.Errorf("invalid identity policy %d: %w", i, err)
+		}
+	}
+
+	var verifiers *container.Verifiers
+	var err error
+	if opts.TrustRoot != nil {
+		verifiers, err = opts.TrustRoot.verifiers()
+	} else {
+		verifiers, err = wellKnownVerifiers(opts.TUFRepoURL)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// return the verifier
+	return &Sigstore{
+		verifiers: verifiers,
+		policy:    &opts.Policy,
+		authOpts:  opts.AuthOpts,
+	}, nil
+}
+
+// wellKnownVerifiers builds the verifiers for one of the TUF repositories we
+// ship an embedded root for
+func wellKnownVerifiers(sigstoreTUFRepoURL string) (*container.Verifiers, error) {
 	// Get the sigstore options for the TUF client and the verifier
 	tufOpts, opts, err := getSigstoreOptions(sigstoreTUFRepoURL)
 	if err != nil {
@@ -61,11 +112,7 @@ func New(sigstoreTUFRepoURL string, authOpts ...container.AuthMethod) (*Sigstore
 		return nil, err
 	}
 
-	// return the verifier
-	return &Sigstore{
-		verifier: sev,
-		authOpts: authOpts,
-	}, nil
+	return &container.Verifiers{Keyless: sev}, nil
 }
 
 func getSigstoreOptions(sigstoreTUFRepoURL string) (*tuf.Options, []verify.VerifierOption, error) {
@@ -157,7 +204,13 @@ func (s *Sigstore) Verify(ctx context.Context, artifactType verifyif.ArtifactTyp
 // VerifyContainer verifies a container artifact using sigstore
 func (s *Sigstore) VerifyContainer(ctx context.Context, owner, artifact, checksumref string) (
 	[]verifyif.Result, error) {
-	return container.Verify(ctx, s.verifier, owner, artifact, checksumref, s.authOpts...)
+	return container.Verify(ctx, s.verifiers, s.policy, owner, artifact, checksumref, s.authOpts...)
+}
+
+// VerifyAttestation verifies a sigstore bundle carrying an attestation about the artifact
+// with the given digest
+func (s *Sigstore) VerifyAttestation(ctx context.Context, bundle []byte, checksumref string) (verifyif.Result, error) {
+	return container.VerifyAttestationBundle(ctx, s.verifiers, s.policy, bundle, checksumref)
 }
 
 // sanitizeInput sanitizes the input parameters
@@ -0,0 +1,235 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sigstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sigstore/sigstore-go/pkg/root"
+	"github.com/sigstore/sigstore-go/pkg/tuf"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/theupdateframework/go-tuf/v2/metadata/fetcher"
+
+	"github.com/mindersec/minder/internal/util/cache"
+	"github.com/mindersec/minder/internal/util/netutil"
+	"github.com/mindersec/minder/internal/verifier/sigstore/container"
+)
+
+const (
+	// tufFetchTimeout bounds each request made to a custom TUF repository
+	tufFetchTimeout = 30 * time.Second
+	// tufTrustedRootTTL is how long a trusted root fetched from a custom TUF
+	// repository is reused before it is fetched again
+	tufTrustedRootTTL = time.Hour
+)
+
+var (
+	tufTrustedRootsOnce  sync.Once
+	tufTrustedRootsCache *cache.ExpiringCache[root.TrustedMaterial]
+)
+
+// tufTrustedRoots returns the process-wide cache of trusted roots fetched
+// from custom TUF repositories
+func tufTrustedRoots() *cache.ExpiringCache[root.TrustedMaterial] {
+	tufTrustedRootsOnce.Do(func() {
+		tufTrustedRootsCache = cache.NewExpiringCache[root.TrustedMaterial](
+			context.Background(), &cache.ExpiringCacheConfig{EvictionTime: tufTrustedRootTTL})
+	})
+	return tufTrustedRootsCache
+}
+
+// ErrTrustRootNotFound is returned by a TrustRootResolver when the trust root does not exist
+var ErrTrustRootNotFound = errors.New("trust root not found")
+
+// TrustRootResolver looks up custom trust roots by name
+type TrustRootResolver interface {
+	// ResolveTrustRoot returns the trust root with the given name
+	ResolveTrustRoot(ctx context.Context, name string) (*TrustRoot, error)
+}
+
+// TrustRoot is a custom set of trusted material to verify signatures against,
+// used instead of one of the well-known TUF repositories. It may contain a
+// sigstore trusted root for keyless signatures, trusted public keys, or both.
+type TrustRoot struct {
+	// TUFRepoURL is the URL of a TUF repository distributing a trusted_root.json
+	TUFRepoURL string
+	// TUFRootJSON is the initial root.json of the TUF repository at TUFRepoURL
+	TUFRootJSON []byte
+	// TrustedRootJSON is a sigstore trusted_root.json document. It is mutually
+	// exclusive with TUFRepoURL.
+	TrustedRootJSON []byte
+	// RequireTransparencyLog requires signatures to have a verified
+	// transparency log entry
+	RequireTransparencyLog bool
+	// RequireSignedCertificateTimestamps requires signing certificates to
+	// carry a verified signed certificate timestamp
+	RequireSignedCertificateTimestamps bool
+	// PublicKeys maps key IDs to PEM-encoded public keys trusted to sign artifacts
+	PublicKeys map[string][]byte
+}
+
+// Validate checks that the trust root is well-formed without fetching any
+// remote material
+func (tr *TrustRoot) Validate() error {
+	if tr.TUFRepoURL != "" && len(tr.TrustedRootJSON) > 0 {
+		return errors.New("a TUF repository and a trusted root are mutually exclusive")
+	}
+	keyless := tr.TUFRepoURL != "" || len(tr.TrustedRootJSON) > 0
+	if !keyless && len(tr.PublicKeys) == 0 {
+		return errors.New("trust root must contain a sigstore trusted root or at least one public key")
+	}
+	if !keyless && (tr.RequireTransparencyLog || tr.RequireSignedCertificateTimestamps) {
+		return errors.New("transparency log and certificate timestamp requirements need a sigstore trusted root")
+	}
+	if tr.TUFRepoURL != "" {
+		if _, err := url.Parse(tr.TUFRepoURL); err != nil {
+			return fmt.Errorf("error parsing TUF repository URL: %w", err)
+		}
+		// Without an initial root, the TUF client would silently fall back to
+		// the embedded public-good root and never trust the custom repository.
+		if len(tr.TUFRootJSON) == 0 {
+			return errors.New("a TUF repository requires its initial root.json")
+		}
+	}
+	if len(tr.TrustedRootJSON) > 0 {
+		if _, err := root.NewTrustedRootFromJSON(tr.TrustedRootJSON); err != nil {
+			return fmt.Errorf("error parsing trusted root: %w", err)
+		}
+	}
+	for id, pemKey := range tr.PublicKeys {
+		if _, err := loadKeyVerifier(pemKey); err != nil {
+			return fmt.Errorf("invalid public key %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// verifiers builds the sigstore verifiers for the trust root
+func (tr *TrustRoot) verifiers() (*container.Verifiers, error) {
+	if err := tr.Validate(); err != nil {
+		return nil, err
+	}
+
+	keylessMaterial, err := tr.keylessMaterial()
+	if err != nil {
+		return nil, err
+	}
+
+	verifiers := &container.Verifiers{}
+	if keylessMaterial != nil {
+		// Keyless signatures need an observer timestamp to validate the short-lived certificate
+		opts := []verify.VerifierOption{verify.WithObserverTimestamps(1)}
+		if tr.RequireTransparencyLog {
+			opts = append(opts, verify.WithTransparencyLog(1))
+		}
+		if tr.RequireSignedCertificateTimestamps {
+			opts = append(opts, verify.WithSignedCertificateTimestamps(1))
+		}
+		verifiers.Keyless, err = verify.NewVerifier(keylessMaterial, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error creating keyless verifier: %w", err)
+		}
+	}
+
+	if len(tr.PublicKeys) > 0 {
+		keys := make(map[string]*root.ExpiringKey, len(tr.PublicKeys))
+		for id, pemKey := range tr.PublicKeys {
+			sv, err := loadKeyVerifier(pemKey)
+			if err != nil {
+				return nil, fmt.Errorf("invalid public key %s: %w", id, err)
+			}
+			keys[id] = root.NewExpiringKey(sv, time.Time{}, time.Time{})
+			verifiers.KeyIDs = append(verifiers.KeyIDs, id)
+		}
+		// Try the keys in a stable order
+		sort.Strings(verifiers.KeyIDs)
+
+		var keyMaterial root.TrustedMaterial = root.NewTrustedPublicKeyMaterialFromMapping(keys)
+		opts := []verify.VerifierOption{verify.WithNoObserverTimestamps()}
+		if tr.RequireTransparencyLog {
+			// The transparency log keys come from the sigstore trusted root
+			keyMaterial = root.TrustedMaterialCollection{keyMaterial, keylessMaterial}
+			opts = []verify.VerifierOption{verify.WithTransparencyLog(1), verify.WithIntegratedTimestamps(1)}
+		}
+		verifiers.Keys, err = verify.NewVerifier(keyMaterial, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("error creating public key verifier: %w", err)
+		}
+	}
+
+	return verifiers, nil
+}
+
+// keylessMaterial returns the sigstore trusted root of the trust root, if any
+func (tr *TrustRoot) keylessMaterial() (root.TrustedMaterial, error) {
+	switch {
+	case len(tr.TrustedRootJSON) > 0:
+		trustedRoot, err := root.NewTrustedRootFromJSON(tr.TrustedRootJSON)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing trusted root: %w", err)
+		}
+		return trustedRoot, nil
+	case tr.TUFRepoURL != "":
+		key := tr.tufCacheKey()
+		cached := tufTrustedRoots()
+		if trustedRoot, ok := cached.Get(key); ok {
+			return trustedRoot, nil
+		}
+		tufURL, err := url.Parse(tr.TUFRepoURL)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing TUF repository URL: %w", err)
+		}
+		if tufURL.Scheme == "" {
+			tufURL.Scheme = "https"
+		}
+		// The repository URL is supplied by project admins, so only allow
+		// fetching from public addresses.
+		tufFetcher := fetcher.NewDefaultFetcher()
+		tufFetcher.SetHTTPClient(&http.Client{
+			Transport: netutil.PublicOnlyTransport(nil, nil),
+			Timeout:   tufFetchTimeout,
+		})
+		tufOpts := tuf.DefaultOptions()
+		tufOpts.DisableLocalCache = true
+		tufOpts.RepositoryBaseURL = tufURL.String()
+		tufOpts.Root = tr.TUFRootJSON
+		tufOpts.Fetcher = tufFetcher
+		trustedRoot, err := root.FetchTrustedRootWithOptions(tufOpts)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching trusted root from %s: %w", tr.TUFRepoURL, err)
+		}
+		cached.Set(key, trustedRoot)
+		return trustedRoot, nil
+	}
+	return nil, nil
+}
+
+// tufCacheKey identifies the fetched trusted root of a TUF trust root by the
+// repository and the initial root it was fetched with
+func (tr *TrustRoot) tufCacheKey() string {
+	h := sha256.New()
+	h.Write([]byte(tr.TUFRepoURL))
+	h.Write([]byte{0})
+	h.Write(tr.TUFRootJSON)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func loadKeyVerifier(pemKey []byte) (signature.Verifier, error) {
+	pub, err := cryptoutils.UnmarshalPEMToPublicKey(pemKey)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing PEM public key: %w", err)
+	}
+	return signature.LoadDefaultVerifier(pub)
+}
@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sigstore
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrustRootValidate(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pemKey, err := cryptoutils.MarshalPublicKeyToPEM(key.Public())
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name    string
+		root    TrustRoot
+		mustErr bool
+	}{
+		{
+			name: "public keys",
+			root: TrustRoot{PublicKeys: map[string][]byte{"release": pemKey}},
+		},
+		{
+			name: "tuf repository",
+			root: TrustRoot{
+				TUFRepoURL:             "tuf.sigstore.example.com",
+				TUFRootJSON:            []byte(`{}`),
+				RequireTransparencyLog: true,
+			},
+		},
+		{
+			name:    "tuf repository without initial root",
+			root:    TrustRoot{TUFRepoURL: "tuf.sigstore.example.com", RequireTransparencyLog: true},
+			mustErr: true,
+		},
+		{
+			name:    "empty",
+			root:    TrustRoot{},
+			mustErr: true,
+		},
+		{
+			name: "tuf repository and trusted root",
+			root: TrustRoot{
+				TUFRepoURL:      "tuf.sigstore.example.com",
+				TrustedRootJSON: []byte(`{}`),
+			},
+			mustErr: true,
+		},
+		{
+			name:    "invalid trusted root",
+			root:    TrustRoot{TrustedRootJSON: []byte(`not json`)},
+			mustErr: true,
+		},
+		{
+			name:    "invalid public key",
+			root:    TrustRoot{PublicKeys: map[string][]byte{"release": []byte("not a key")}},
+			mustErr: true,
+		},
+		{
+			name: "transparency log without sigstore root",
+			root: TrustRoot{
+				PublicKeys:             map[string][]byte{"release": pemKey},
+				RequireTransparencyLog: true,
+			},
+			mustErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.root.Validate()
+			if tc.mustErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNewWithOptionsPublicKeys(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pemKey, err := cryptoutils.MarshalPublicKeyToPEM(key.Public())
+	require.NoError(t, err)
+
+	s, err := NewWithOptions(&Options{
+		TrustRoot: &TrustRoot{PublicKeys: map[string][]byte{"b": pemKey, "a": pemKey}},
+	})
+	require.NoError(t, err)
+	// Keys only, keyless signatures are not trusted
+	require.Nil(t, s.verifiers.Keyless)
+	require.NotNil(t, s.verifiers.Keys)
+	require.Equal(t, []string{"a", "b"}, s.verifiers.KeyIDs)
+}
+
+func TestTrustRootTUFRepositoryMustBePublic(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(srv.Close)
+
+	rootJSON, err := embeddedRootJson("tuf-repo.github.com")
+	require.NoError(t, err)
+
+	tr := &TrustRoot{TUFRepoURL: srv.URL, TUFRootJSON: rootJSON}
+	_, err = tr.keylessMaterial()
+	require.ErrorContains(t, err, "remote address is not public")
+	require.Zero(t, requests.Load())
+}
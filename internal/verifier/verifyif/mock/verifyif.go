@@ -56,6 +56,21 @@ func (mr *MockArtifactVerifierMockRecorder) Verify(ctx, artifactType, owner, nam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockArtifactVerifier)(nil).Verify), ctx, artifactType, owner, name, checksumref)
 }
 
+// VerifyAttestation mocks base method.
+func (m *MockArtifactVerifier) VerifyAttestation(ctx context.Context, bundle []byte, checksumref string) (verifyif.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyAttestation", ctx, bundle, checksumref)
+	ret0, _ := ret[0].(verifyif.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyAttestation indicates an expected call of VerifyAttestation.
+func (mr *MockArtifactVerifierMockRecorder) VerifyAttestation(ctx, bundle, checksumref any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAttestation", reflect.TypeOf((*MockArtifactVerifier)(nil).VerifyAttestation), ctx, bundle, checksumref)
+}
+
 // VerifyContainer mocks base method.
 func (m *MockArtifactVerifier) VerifyContainer(ctx context.Context, owner, artifact, checksumref string) ([]verifyif.Result, error) {
 	m.ctrl.T.Helper()
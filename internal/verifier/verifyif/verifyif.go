@@ -25,6 +25,12 @@ const (
 type Result struct {
 	IsSigned   bool `json:"is_signed"`
 	IsVerified bool `json:"is_verified"`
+	// KeyID is the ID of the trusted public key that verified the signature,
+	// empty for keyless signatures
+	KeyID string `json:"key_id,omitempty"`
+	// MatchedIdentity is the name of the identity policy the signing
+	// certificate matched, empty if no identity policy was configured
+	MatchedIdentity string `json:"matched_identity,omitempty"`
 	verify.VerificationResult
 }
 
@@ -34,4 +40,7 @@ type ArtifactVerifier interface {
 		owner, name, checksumref string) ([]Result, error)
 	VerifyContainer(ctx context.Context,
 		owner, artifact, checksumref string) ([]Result, error)
+	// VerifyAttestation verifies a sigstore bundle carrying an in-toto attestation about the
+	// artifact with the given checksum, e.g. one fetched from an OCI referrer
+	VerifyAttestation(ctx context.Context, bundle []byte, checksumref string) (Result, error)
 }
@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service.go
+//
+// Generated by this command:
+//
+//	mockgen -package mock_waivers -destination=./mock/service.go -source=./service.go
+//
+
+// Package mock_waivers is a generated GoMock package.
+package mock_waivers
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	v1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWaiverService is a mock of WaiverService interface.
+type MockWaiverService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWaiverServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockWaiverServiceMockRecorder is the mock recorder for MockWaiverService.
+type MockWaiverServiceMockRecorder struct {
+	mock *MockWaiverService
+}
+
+// NewMockWaiverService creates a new mock instance.
+func NewMockWaiverService(ctrl *gomock.Controller) *MockWaiverService {
+	mock := &MockWaiverService{ctrl: ctrl}
+	mock.recorder = &MockWaiverServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWaiverService) EXPECT() *MockWaiverServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWaiverService) Create(ctx context.Context, projectID uuid.UUID, approver string, w *v1.Waiver) (*v1.Waiver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, projectID, approver, w)
+	ret0, _ := ret[0].(*v1.Waiver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWaiverServiceMockRecorder) Create(ctx, projectID, approver, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWaiverService)(nil).Create), ctx, projectID, approver, w)
+}
+
+// Delete mocks base method.
+func (m *MockWaiverService) Delete(ctx context.Context, projectID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, projectID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWaiverServiceMockRecorder) Delete(ctx, projectID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWaiverService)(nil).Delete), ctx, projectID, id)
+}
+
+// GetByID mocks base method.
+func (m *MockWaiverService) GetByID(ctx context.Context, projectID, id uuid.UUID) (*v1.Waiver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, projectID, id)
+	ret0, _ := ret[0].(*v1.Waiver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockWaiverServiceMockRecorder) GetByID(ctx, projectID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockWaiverService)(nil).GetByID), ctx, projectID, id)
+}
+
+// List mocks base method.
+func (m *MockWaiverService) List(ctx context.Context, projectID uuid.UUID, profile string, includeExpired bool) ([]*v1.Waiver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, projectID, profile, includeExpired)
+	ret0, _ := ret[0].([]*v1.Waiver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockWaiverServiceMockRecorder) List(ctx, projectID, profile, includeExpired any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWaiverService)(nil).List), ctx, projectID, profile, includeExpired)
+}
+
+// Update mocks base method.
+func (m *MockWaiverService) Update(ctx context.Context, projectID, id uuid.UUID, approver, justification string, expiresAt time.Time) (*v1.Waiver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, projectID, id, approver, justification, expiresAt)
+	ret0, _ := ret[0].(*v1.Waiver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWaiverServiceMockRecorder) Update(ctx, projectID, id, approver, justification, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWaiverService)(nil).Update), ctx, projectID, id, approver, justification, expiresAt)
+}
@@ -0,0 +1,300 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package waivers encodes the business logic for dealing with the waivers
+// which accept the failure of a rule of a profile for a single entity.
+package waivers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/entities"
+	"github.com/mindersec/minder/internal/util"
+	"github.com/mindersec/minder/internal/util/ptr"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -package mock_$GOPACKAGE -destination=./mock/$GOFILE -source=./$GOFILE
+
+// ErrWaiverNotFound is returned when a waiver does not exist in the project
+var ErrWaiverNotFound = util.UserVisibleError(codes.NotFound, "waiver not found")
+
+// WaiverService is an interface that defines the methods for the waivers service.
+type WaiverService interface {
+	// Create creates a waiver in the given project, approved by the given
+	// user. An existing waiver for the same rule and entity is replaced.
+	Create(ctx context.Context, projectID uuid.UUID, approver string, w *minderv1.Waiver) (*minderv1.Waiver, error)
+
+	// Update updates the justification and the expiry of a waiver, and
+	// records the given user as its approver.
+	Update(
+		ctx context.Context, projectID uuid.UUID, id uuid.UUID,
+		approver string, justification string, expiresAt time.Time,
+	) (*minderv1.Waiver, error)
+
+	// GetByID returns a waiver of the given project.
+	GetByID(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (*minderv1.Waiver, error)
+
+	// List lists the waivers of the given project, optionally restricted to
+	// a profile. Expired waivers are only listed if requested.
+	List(ctx context.Context, projectID uuid.UUID, profile string, includeExpired bool) ([]*minderv1.Waiver, error)
+
+	// Delete deletes a waiver of the given project.
+	Delete(ctx context.Context, projectID uuid.UUID, id uuid.UUID) error
+}
+
+type waiverService struct {
+	store db.Store
+}
+
+// NewWaiverService creates a new waiver service.
+func NewWaiverService(store db.Store) WaiverService {
+	return &waiverService{
+		store: store,
+	}
+}
+
+func (s *waiverService) Create(
+	ctx context.Context, projectID uuid.UUID, approver string, w *minderv1.Waiver,
+) (*minderv1.Waiver, error) {
+	if w == nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument, "missing waiver")
+	}
+	if err := validateWaiver(w.GetJustification(), w.GetExpiresAt()); err != nil {
+		return nil, err
+	}
+	entityType, err := entities.EntityTypeToDBType(w.GetEntity().GetType())
+	if err != nil {
+		return nil, util.UserVisibleError(codes.InvalidArgument,
+			"invalid entity type %s, please use one of %s", w.GetEntity().GetType(), entities.KnownTypesCSV())
+	}
+
+	return db.WithTransaction(s.store, func(qtx db.ExtendQuerier) (*minderv1.Waiver, error) {
+		// Lock the profile so that the rule can't be removed concurrently
+		profile, err := qtx.GetProfileByNameAndLock(ctx, db.GetProfileByNameAndLockParams{
+			ProjectID: projectID,
+			Name:      w.GetProfile(),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, util.UserVisibleError(codes.NotFound, "profile %s not found", w.GetProfile())
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get profile: %w", err)
+		}
+
+		rule, err := qtx.GetRuleInstanceByProfileAndName(ctx, db.GetRuleInstanceByProfileAndNameParams{
+			ProfileID:  profile.ID,
+			EntityType: entityType,
+			Name:       w.GetRuleName(),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, util.UserVisibleError(codes.NotFound,
+				"profile %s has no rule %s for %s entities", profile.Name, w.GetRuleName(), entityType)
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get rule instance: %w", err)
+		}
+
+		entity, err := getEntity(ctx, qtx, projectID, entityType, w.GetEntity())
+		if err != nil {
+			return nil, err
+		}
+
+		dbw, err := qtx.CreateWaiver(ctx, db.CreateWaiverParams{
+			ProjectID:        projectID,
+			ProfileID:        profile.ID,
+			RuleInstanceID:   rule.ID,
+			EntityInstanceID: entity.ID,
+			Justification:    w.GetJustification(),
+			Approver:         approver,
+			ExpiresAt:        w.GetExpiresAt().AsTime(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create waiver: %w", err)
+		}
+
+		return getByID(ctx, qtx, projectID, dbw.ID)
+	})
+}
+
+func (s *waiverService) Update(
+	ctx context.Context, projectID uuid.UUID, id uuid.UUID,
+	approver string, justification string, expiresAt time.Time,
+) (*minderv1.Waiver, error) {
+	if err := validateWaiver(justification, timestamppb.New(expiresAt)); err != nil {
+		return nil, err
+	}
+
+	return db.WithTransaction(s.store, func(qtx db.ExtendQuerier) (*minderv1.Waiver, error) {
+		_, err := qtx.UpdateWaiver(ctx, db.UpdateWaiverParams{
+			ID:            id,
+			ProjectID:     projectID,
+			Justification: justification,
+			Approver:      approver,
+			ExpiresAt:     expiresAt,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrWaiverNotFound
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to update waiver: %w", err)
+		}
+
+		return getByID(ctx, qtx, projectID, id)
+	})
+}
+
+func (s *waiverService) GetByID(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (*minderv1.Waiver, error) {
+	return getByID(ctx, s.store, projectID, id)
+}
+
+func (s *waiverService) List(
+	ctx context.Context, projectID uuid.UUID, profile string, includeExpired bool,
+) ([]*minderv1.Waiver, error) {
+	var profileID uuid.NullUUID
+	if profile != "" {
+		rows, err := s.store.GetProfileByProjectAndName(ctx, db.GetProfileByProjectAndNameParams{
+			ProjectID: projectID,
+			Name:      profile,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get profile: %w", err)
+		}
+		if len(rows) == 0 {
+			return nil, util.UserVisibleError(codes.NotFound, "profile %s not found", profile)
+		}
+		profileID = uuid.NullUUID{UUID: rows[0].Profile.ID, Valid: true}
+	}
+
+	dbws, err := s.store.ListWaivers(ctx, db.ListWaiversParams{
+		ProjectID:      projectID,
+		ProfileID:      profileID,
+		IncludeExpired: includeExpired,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list waivers: %w", err)
+	}
+
+	out := make([]*minderv1.Waiver, 0, len(dbws))
+	for _, dbw := range dbws {
+		out = append(out, waiverDBToProtobuf(db.GetWaiverByIDRow(dbw)))
+	}
+	return out, nil
+}
+
+func (s *waiverService) Delete(ctx context.Context, projectID uuid.UUID, id uuid.UUID) error {
+	_, err := s.store.DeleteWaiver(ctx, db.DeleteWaiverParams{
+		ID:        id,
+		ProjectID: projectID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrWaiverNotFound
+	} else if err != nil {
+		return fmt.Errorf("failed to delete waiver: %w", err)
+	}
+	return nil
+}
+
+func getByID(ctx context.Context, qtx db.Querier, projectID uuid.UUID, id uuid.UUID) (*minderv1.Waiver, error) {
+	dbw, err := qtx.GetWaiverByID(ctx, db.GetWaiverByIDParams{
+		ID:        id,
+		ProjectID: projectID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrWaiverNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get waiver: %w", err)
+	}
+	return waiverDBToProtobuf(dbw), nil
+}
+
+// getEntity looks up the entity a waiver is created for, by ID or by name.
+// Names are only unique per provider, so looking up an entity by name fails
+// if several providers of the project have an entity of that name.
+func getEntity(
+	ctx context.Context,
+	qtx db.Querier,
+	projectID uuid.UUID,
+	entityType db.Entities,
+	ref *minderv1.EntityTypedId,
+) (db.EntityInstance, error) {
+	if ref.GetId() != "" {
+		id, err := uuid.Parse(ref.GetId())
+		if err != nil {
+			return db.EntityInstance{}, util.UserVisibleError(codes.InvalidArgument, "invalid entity id %q", ref.GetId())
+		}
+		entity, err := qtx.GetEntityByID(ctx, id)
+		if errors.Is(err, sql.ErrNoRows) ||
+			(err == nil && (entity.ProjectID != projectID || entity.EntityType != entityType)) {
+			return db.EntityInstance{}, util.UserVisibleError(codes.NotFound, "entity %s not found", ref.GetId())
+		} else if err != nil {
+			return db.EntityInstance{}, fmt.Errorf("failed to get entity: %w", err)
+		}
+		if ref.GetName() != "" && ref.GetName() != entity.Name {
+			return db.EntityInstance{}, util.UserVisibleError(codes.InvalidArgument,
+				"entity %s is named %s, not %s", ref.GetId(), entity.Name, ref.GetName())
+		}
+		return entity, nil
+	}
+
+	if ref.GetName() == "" {
+		return db.EntityInstance{}, util.UserVisibleError(codes.InvalidArgument, "entity id or name must be set")
+	}
+	ents, err := qtx.ListEntitiesByProjectAndName(ctx, db.ListEntitiesByProjectAndNameParams{
+		ProjectID:  projectID,
+		EntityType: entityType,
+		Name:       ref.GetName(),
+	})
+	if err != nil {
+		return db.EntityInstance{}, fmt.Errorf("failed to get entity: %w", err)
+	}
+	switch len(ents) {
+	case 0:
+		return db.EntityInstance{}, util.UserVisibleError(codes.NotFound, "entity %s not found", ref.GetName())
+	case 1:
+		return ents[0], nil
+	default:
+		return db.EntityInstance{}, util.UserVisibleError(codes.InvalidArgument,
+			"several entities are named %s, please use the entity id", ref.GetName())
+	}
+}
+
+func validateWaiver(justification string, expiresAt *timestamppb.Timestamp) error {
+	if justification == "" {
+		return util.UserVisibleError(codes.InvalidArgument, "waiver justification cannot be empty")
+	}
+	if expiresAt == nil {
+		return util.UserVisibleError(codes.InvalidArgument, "waiver expiry must be set")
+	}
+	if !expiresAt.AsTime().After(time.Now()) {
+		return util.UserVisibleError(codes.InvalidArgument, "waiver expiry must be in the future")
+	}
+	return nil
+}
+
+func waiverDBToProtobuf(dbw db.GetWaiverByIDRow) *minderv1.Waiver {
+	return &minderv1.Waiver{
+		Id: dbw.ID.String(),
+		Context: &minderv1.Context{
+			Project: ptr.Ptr(dbw.ProjectID.String()),
+		},
+		Profile:  dbw.ProfileName,
+		RuleName: dbw.RuleName,
+		Entity: &minderv1.EntityTypedId{
+			Type: entities.EntityTypeFromDB(dbw.EntityType),
+			Id:   dbw.EntityInstanceID.String(),
+			Name: dbw.EntityName,
+		},
+		Justification: dbw.Justification,
+		Approver:      dbw.Approver,
+		ExpiresAt:     timestamppb.New(dbw.ExpiresAt),
+		CreatedAt:     timestamppb.New(dbw.CreatedAt),
+		UpdatedAt:     timestamppb.New(dbw.UpdatedAt),
+	}
+}
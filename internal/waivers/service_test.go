@@ -0,0 +1,217 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package waivers
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	mockdb "github.com/mindersec/minder/database/mock"
+	"github.com/mindersec/minder/internal/db"
+	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+func expectTransaction(store *mockdb.MockStore) {
+	tx := sql.Tx{}
+	store.EXPECT().BeginTransaction().Return(&tx, nil)
+	store.EXPECT().GetQuerierWithTransaction(gomock.Any()).Return(store)
+	store.EXPECT().Commit(gomock.Any()).Return(nil).AnyTimes()
+	store.EXPECT().Rollback(gomock.Any()).Return(nil)
+}
+
+func TestWaiverServiceCreate(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	profileID := uuid.New()
+	ruleID := uuid.New()
+	entityID := uuid.New()
+	waiverID := uuid.New()
+	expiry := time.Now().Add(24 * time.Hour).UTC()
+
+	newWaiver := func() *minderv1.Waiver {
+		return &minderv1.Waiver{
+			Profile:  "security",
+			RuleName: "branch_protection",
+			Entity: &minderv1.EntityTypedId{
+				Type: minderv1.Entity_ENTITY_REPOSITORIES,
+				Name: "stacklok/legacy",
+			},
+			Justification: "archived soon",
+			ExpiresAt:     timestamppb.New(expiry),
+		}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		waiver   func() *minderv1.Waiver
+		setup    func(store *mockdb.MockStore)
+		wantCode codes.Code
+	}{
+		{
+			name:   "creates waiver",
+			waiver: newWaiver,
+			setup: func(store *mockdb.MockStore) {
+				expectTransaction(store)
+				store.EXPECT().GetProfileByNameAndLock(gomock.Any(), db.GetProfileByNameAndLockParams{
+					ProjectID: projectID,
+					Name:      "security",
+				}).Return(db.Profile{ID: profileID, Name: "security"}, nil)
+				store.EXPECT().GetRuleInstanceByProfileAndName(gomock.Any(), db.GetRuleInstanceByProfileAndNameParams{
+					ProfileID:  profileID,
+					EntityType: db.EntitiesRepository,
+					Name:       "branch_protection",
+				}).Return(db.RuleInstance{ID: ruleID}, nil)
+				store.EXPECT().ListEntitiesByProjectAndName(gomock.Any(), db.ListEntitiesByProjectAndNameParams{
+					ProjectID:  projectID,
+					EntityType: db.EntitiesRepository,
+					Name:       "stacklok/legacy",
+				}).Return([]db.EntityInstance{{ID: entityID}}, nil)
+				store.EXPECT().CreateWaiver(gomock.Any(), db.CreateWaiverParams{
+					ProjectID:        projectID,
+					ProfileID:        profileID,
+					RuleInstanceID:   ruleID,
+					EntityInstanceID: entityID,
+					Justification:    "archived soon",
+					Approver:         "alice",
+					ExpiresAt:        expiry,
+				}).Return(db.Waiver{ID: waiverID}, nil)
+				store.EXPECT().GetWaiverByID(gomock.Any(), db.GetWaiverByIDParams{
+					ID:        waiverID,
+					ProjectID: projectID,
+				}).Return(db.GetWaiverByIDRow{
+					ID:               waiverID,
+					ProjectID:        projectID,
+					EntityInstanceID: entityID,
+					Justification:    "archived soon",
+					Approver:         "alice",
+					ExpiresAt:        expiry,
+					ProfileName:      "security",
+					RuleName:         "branch_protection",
+					EntityName:       "stacklok/legacy",
+					EntityType:       db.EntitiesRepository,
+				}, nil)
+			},
+		},
+		{
+			name: "expiry in the past",
+			waiver: func() *minderv1.Waiver {
+				w := newWaiver()
+				w.ExpiresAt = timestamppb.New(time.Now().Add(-time.Hour))
+				return w
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "missing justification",
+			waiver: func() *minderv1.Waiver {
+				w := newWaiver()
+				w.Justification = ""
+				return w
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:   "rule not in profile",
+			waiver: newWaiver,
+			setup: func(store *mockdb.MockStore) {
+				expectTransaction(store)
+				store.EXPECT().GetProfileByNameAndLock(gomock.Any(), gomock.Any()).
+					Return(db.Profile{ID: profileID, Name: "security"}, nil)
+				store.EXPECT().GetRuleInstanceByProfileAndName(gomock.Any(), gomock.Any()).
+					Return(db.RuleInstance{}, sql.ErrNoRows)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:   "ambiguous entity name",
+			waiver: newWaiver,
+			setup: func(store *mockdb.MockStore) {
+				expectTransaction(store)
+				store.EXPECT().GetProfileByNameAndLock(gomock.Any(), gomock.Any()).
+					Return(db.Profile{ID: profileID, Name: "security"}, nil)
+				store.EXPECT().GetRuleInstanceByProfileAndName(gomock.Any(), gomock.Any()).
+					Return(db.RuleInstance{ID: ruleID}, nil)
+				store.EXPECT().ListEntitiesByProjectAndName(gomock.Any(), gomock.Any()).
+					Return([]db.EntityInstance{{ID: entityID}, {ID: uuid.New()}}, nil)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "entity of another project",
+			waiver: func() *minderv1.Waiver {
+				w := newWaiver()
+				w.Entity = &minderv1.EntityTypedId{
+					Type: minderv1.Entity_ENTITY_REPOSITORIES,
+					Id:   entityID.String(),
+				}
+				return w
+			},
+			setup: func(store *mockdb.MockStore) {
+				expectTransaction(store)
+				store.EXPECT().GetProfileByNameAndLock(gomock.Any(), gomock.Any()).
+					Return(db.Profile{ID: profileID, Name: "security"}, nil)
+				store.EXPECT().GetRuleInstanceByProfileAndName(gomock.Any(), gomock.Any()).
+					Return(db.RuleInstance{ID: ruleID}, nil)
+				store.EXPECT().GetEntityByID(gomock.Any(), entityID).
+					Return(db.EntityInstance{
+						ID:         entityID,
+						ProjectID:  uuid.New(),
+						EntityType: db.EntitiesRepository,
+					}, nil)
+			},
+			wantCode: codes.NotFound,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			if tc.setup != nil {
+				tc.setup(store)
+			}
+
+			svc := NewWaiverService(store)
+			ret, err := svc.Create(context.Background(), projectID, "alice", tc.waiver())
+			if tc.wantCode != codes.OK {
+				require.Equal(t, tc.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, waiverID.String(), ret.GetId())
+			require.Equal(t, "alice", ret.GetApprover())
+			require.Equal(t, "branch_protection", ret.GetRuleName())
+			require.Equal(t, minderv1.Entity_ENTITY_REPOSITORIES, ret.GetEntity().GetType())
+			require.Equal(t, entityID.String(), ret.GetEntity().GetId())
+			require.Equal(t, projectID.String(), ret.GetContext().GetProject())
+		})
+	}
+}
+
+func TestWaiverServiceDelete(t *testing.T) {
+	t.Parallel()
+
+	projectID := uuid.New()
+	waiverID := uuid.New()
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().DeleteWaiver(gomock.Any(), db.DeleteWaiverParams{
+		ID:        waiverID,
+		ProjectID: projectID,
+	}).Return(db.Waiver{}, sql.ErrNoRows)
+
+	err := NewWaiverService(store).Delete(context.Background(), projectID, waiverID)
+	require.ErrorIs(t, err, ErrWaiverNotFound)
+}
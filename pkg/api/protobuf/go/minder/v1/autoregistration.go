@@ -0,0 +1,9 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+// GetContext returns the context from the CreateAutoRegistrationRuleRequest rule.
+func (r *CreateAutoRegistrationRuleRequest) GetContext() *Context {
+	return r.Rule.GetContext()
+}
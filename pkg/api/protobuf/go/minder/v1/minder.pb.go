@@ -128,6 +128,14 @@ const (
 	Relation_RELATION_ENTITY_REGISTER                   Relation = 43
 	Relation_RELATION_ENTITY_UPDATE                     Relation = 44
 	Relation_RELATION_ENTITY_DELETE                     Relation = 45
+	Relation_RELATION_TRUST_ROOT_GET                    Relation = 46
+	Relation_RELATION_TRUST_ROOT_CREATE                 Relation = 47
+	Relation_RELATION_TRUST_ROOT_UPDATE                 Relation = 48
+	Relation_RELATION_TRUST_ROOT_DELETE                 Relation = 49
+	Relation_RELATION_BUNDLE_GET                        Relation = 50
+	Relation_RELATION_BUNDLE_SUBSCRIBE                  Relation = 51
+	Relation_RELATION_BUNDLE_UNSUBSCRIBE                Relation = 52
+	Relation_RELATION_BUNDLE_UPGRADE                    Relation = 53
 )
 
 // Enum value maps for Relation.
@@ -179,6 +187,14 @@ var (
 		43: "RELATION_ENTITY_REGISTER",
 		44: "RELATION_ENTITY_UPDATE",
 		45: "RELATION_ENTITY_DELETE",
+		46: "RELATION_TRUST_ROOT_GET",
+		47: "RELATION_TRUST_ROOT_CREATE",
+		48: "RELATION_TRUST_ROOT_UPDATE",
+		49: "RELATION_TRUST_ROOT_DELETE",
+		50: "RELATION_BUNDLE_GET",
+		51: "RELATION_BUNDLE_SUBSCRIBE",
+		52: "RELATION_BUNDLE_UNSUBSCRIBE",
+		53: "RELATION_BUNDLE_UPGRADE",
 	}
 	Relation_value = map[string]int32{
 		"RELATION_UNSPECIFIED":                       0,
@@ -227,6 +243,14 @@ var (
 		"RELATION_ENTITY_REGISTER":                   43,
 		"RELATION_ENTITY_UPDATE":                     44,
 		"RELATION_ENTITY_DELETE":                     45,
+		"RELATION_TRUST_ROOT_GET":                    46,
+		"RELATION_TRUST_ROOT_CREATE":                 47,
+		"RELATION_TRUST_ROOT_UPDATE":                 48,
+		"RELATION_TRUST_ROOT_DELETE":                 49,
+		"RELATION_BUNDLE_GET":                        50,
+		"RELATION_BUNDLE_SUBSCRIBE":                  51,
+		"RELATION_BUNDLE_UNSUBSCRIBE":                52,
+		"RELATION_BUNDLE_UPGRADE":                    53,
 	}
 )
 
@@ -657,6 +681,58 @@ func (CredentialsState) EnumDescriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{8}
 }
 
+type ProviderHealthStatus int32
+
+const (
+	ProviderHealthStatus_PROVIDER_HEALTH_STATUS_UNSPECIFIED ProviderHealthStatus = 0
+	ProviderHealthStatus_PROVIDER_HEALTH_STATUS_HEALTHY     ProviderHealthStatus = 1
+	ProviderHealthStatus_PROVIDER_HEALTH_STATUS_DEGRADED    ProviderHealthStatus = 2
+	ProviderHealthStatus_PROVIDER_HEALTH_STATUS_UNHEALTHY   ProviderHealthStatus = 3
+)
+
+// Enum value maps for ProviderHealthStatus.
+var (
+	ProviderHealthStatus_name = map[int32]string{
+		0: "PROVIDER_HEALTH_STATUS_UNSPECIFIED",
+		1: "PROVIDER_HEALTH_STATUS_HEALTHY",
+		2: "PROVIDER_HEALTH_STATUS_DEGRADED",
+		3: "PROVIDER_HEALTH_STATUS_UNHEALTHY",
+	}
+	ProviderHealthStatus_value = map[string]int32{
+		"PROVIDER_HEALTH_STATUS_UNSPECIFIED": 0,
+		"PROVIDER_HEALTH_STATUS_HEALTHY":     1,
+		"PROVIDER_HEALTH_STATUS_DEGRADED":    2,
+		"PROVIDER_HEALTH_STATUS_UNHEALTHY":   3,
+	}
+)
+
+func (x ProviderHealthStatus) Enum() *ProviderHealthStatus {
+	p := new(ProviderHealthStatus)
+	*p = x
+	return p
+}
+
+func (x ProviderHealthStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProviderHealthStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_minder_v1_minder_proto_enumTypes[9].Descriptor()
+}
+
+func (ProviderHealthStatus) Type() protoreflect.EnumType {
+	return &file_minder_v1_minder_proto_enumTypes[9]
+}
+
+func (x ProviderHealthStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProviderHealthStatus.Descriptor instead.
+func (ProviderHealthStatus) EnumDescriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{9}
+}
+
 // Value enumerates the severity values.
 type Severity_Value int32
 
@@ -715,11 +791,11 @@ func (x Severity_Value) String() string {
 }
 
 func (Severity_Value) Descriptor() protoreflect.EnumDescriptor {
-	return file_minder_v1_minder_proto_enumTypes[9].Descriptor()
+	return file_minder_v1_minder_proto_enumTypes[10].Descriptor()
 }
 
 func (Severity_Value) Type() protoreflect.EnumType {
-	return &file_minder_v1_minder_proto_enumTypes[9]
+	return &file_minder_v1_minder_proto_enumTypes[10]
 }
 
 func (x Severity_Value) Number() protoreflect.EnumNumber {
@@ -728,7 +804,62 @@ func (x Severity_Value) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Severity_Value.Descriptor instead.
 func (Severity_Value) EnumDescriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{125, 0}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{169, 0}
+}
+
+type BundleDiffEntry_Change int32
+
+const (
+	BundleDiffEntry_CHANGE_UNSPECIFIED BundleDiffEntry_Change = 0
+	BundleDiffEntry_CHANGE_ADDED       BundleDiffEntry_Change = 1
+	BundleDiffEntry_CHANGE_UPDATED     BundleDiffEntry_Change = 2
+	BundleDiffEntry_CHANGE_REMOVED     BundleDiffEntry_Change = 3
+	BundleDiffEntry_CHANGE_UNCHANGED   BundleDiffEntry_Change = 4
+)
+
+// Enum value maps for BundleDiffEntry_Change.
+var (
+	BundleDiffEntry_Change_name = map[int32]string{
+		0: "CHANGE_UNSPECIFIED",
+		1: "CHANGE_ADDED",
+		2: "CHANGE_UPDATED",
+		3: "CHANGE_REMOVED",
+		4: "CHANGE_UNCHANGED",
+	}
+	BundleDiffEntry_Change_value = map[string]int32{
+		"CHANGE_UNSPECIFIED": 0,
+		"CHANGE_ADDED":       1,
+		"CHANGE_UPDATED":     2,
+		"CHANGE_REMOVED":     3,
+		"CHANGE_UNCHANGED":   4,
+	}
+)
+
+func (x BundleDiffEntry_Change) Enum() *BundleDiffEntry_Change {
+	p := new(BundleDiffEntry_Change)
+	*p = x
+	return p
+}
+
+func (x BundleDiffEntry_Change) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BundleDiffEntry_Change) Descriptor() protoreflect.EnumDescriptor {
+	return file_minder_v1_minder_proto_enumTypes[11].Descriptor()
+}
+
+func (BundleDiffEntry_Change) Type() protoreflect.EnumType {
+	return &file_minder_v1_minder_proto_enumTypes[11]
+}
+
+func (x BundleDiffEntry_Change) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BundleDiffEntry_Change.Descriptor instead.
+func (BundleDiffEntry_Change) EnumDescriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{264, 0}
 }
 
 type RpcOptions struct {
@@ -2714,28 +2845,43 @@ func (x *RegisterRepositoryResponse) GetResult() *RegisterRepoResult {
 	return nil
 }
 
-type GetRepositoryByIdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RepositoryId  string                 `protobuf:"bytes,1,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
-	Context       *Context               `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+// AutoRegistrationRule selects the remote entities of a project which are
+// registered automatically, on provider enrollment, when they are created
+// upstream and periodically.
+type AutoRegistrationRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the rule.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// context is the project the rule belongs to. When a provider is set,
+	// the rule only applies to the entities of that provider.
+	Context *Context `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	// name is the name of the rule, unique within the project.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// entity is the type of entity the rule registers. Only repositories
+	// are supported.
+	Entity string `protobuf:"bytes,4,opt,name=entity,proto3" json:"entity,omitempty"`
+	// selector is the CEL expression selecting the entities to register.
+	// It is written like the selectors of profiles, against the properties
+	// of the remote entity, e.g. `repository.properties['github/topics']`.
+	Selector      string `protobuf:"bytes,5,opt,name=selector,proto3" json:"selector,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRepositoryByIdRequest) Reset() {
-	*x = GetRepositoryByIdRequest{}
+func (x *AutoRegistrationRule) Reset() {
+	*x = AutoRegistrationRule{}
 	mi := &file_minder_v1_minder_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRepositoryByIdRequest) String() string {
+func (x *AutoRegistrationRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRepositoryByIdRequest) ProtoMessage() {}
+func (*AutoRegistrationRule) ProtoMessage() {}
 
-func (x *GetRepositoryByIdRequest) ProtoReflect() protoreflect.Message {
+func (x *AutoRegistrationRule) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2747,46 +2893,67 @@ func (x *GetRepositoryByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRepositoryByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetRepositoryByIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AutoRegistrationRule.ProtoReflect.Descriptor instead.
+func (*AutoRegistrationRule) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetRepositoryByIdRequest) GetRepositoryId() string {
+func (x *AutoRegistrationRule) GetId() string {
 	if x != nil {
-		return x.RepositoryId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetRepositoryByIdRequest) GetContext() *Context {
+func (x *AutoRegistrationRule) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-type GetRepositoryByIdResponse struct {
+func (x *AutoRegistrationRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AutoRegistrationRule) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *AutoRegistrationRule) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+type CreateAutoRegistrationRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Repository    *Repository            `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	Rule          *AutoRegistrationRule  `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRepositoryByIdResponse) Reset() {
-	*x = GetRepositoryByIdResponse{}
+func (x *CreateAutoRegistrationRuleRequest) Reset() {
+	*x = CreateAutoRegistrationRuleRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRepositoryByIdResponse) String() string {
+func (x *CreateAutoRegistrationRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRepositoryByIdResponse) ProtoMessage() {}
+func (*CreateAutoRegistrationRuleRequest) ProtoMessage() {}
 
-func (x *GetRepositoryByIdResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateAutoRegistrationRuleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2798,40 +2965,39 @@ func (x *GetRepositoryByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRepositoryByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetRepositoryByIdResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateAutoRegistrationRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateAutoRegistrationRuleRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetRepositoryByIdResponse) GetRepository() *Repository {
+func (x *CreateAutoRegistrationRuleRequest) GetRule() *AutoRegistrationRule {
 	if x != nil {
-		return x.Repository
+		return x.Rule
 	}
 	return nil
 }
 
-type DeleteRepositoryByIdRequest struct {
+type CreateAutoRegistrationRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RepositoryId  string                 `protobuf:"bytes,1,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
-	Context       *Context               `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	Rule          *AutoRegistrationRule  `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRepositoryByIdRequest) Reset() {
-	*x = DeleteRepositoryByIdRequest{}
+func (x *CreateAutoRegistrationRuleResponse) Reset() {
+	*x = CreateAutoRegistrationRuleResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRepositoryByIdRequest) String() string {
+func (x *CreateAutoRegistrationRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRepositoryByIdRequest) ProtoMessage() {}
+func (*CreateAutoRegistrationRuleResponse) ProtoMessage() {}
 
-func (x *DeleteRepositoryByIdRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateAutoRegistrationRuleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2843,46 +3009,39 @@ func (x *DeleteRepositoryByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRepositoryByIdRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRepositoryByIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateAutoRegistrationRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateAutoRegistrationRuleResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *DeleteRepositoryByIdRequest) GetRepositoryId() string {
-	if x != nil {
-		return x.RepositoryId
-	}
-	return ""
-}
-
-func (x *DeleteRepositoryByIdRequest) GetContext() *Context {
+func (x *CreateAutoRegistrationRuleResponse) GetRule() *AutoRegistrationRule {
 	if x != nil {
-		return x.Context
+		return x.Rule
 	}
 	return nil
 }
 
-type DeleteRepositoryByIdResponse struct {
+type ListAutoRegistrationRulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RepositoryId  string                 `protobuf:"bytes,1,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
+	Context       *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRepositoryByIdResponse) Reset() {
-	*x = DeleteRepositoryByIdResponse{}
+func (x *ListAutoRegistrationRulesRequest) Reset() {
+	*x = ListAutoRegistrationRulesRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRepositoryByIdResponse) String() string {
+func (x *ListAutoRegistrationRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRepositoryByIdResponse) ProtoMessage() {}
+func (*ListAutoRegistrationRulesRequest) ProtoMessage() {}
 
-func (x *DeleteRepositoryByIdResponse) ProtoReflect() protoreflect.Message {
+func (x *ListAutoRegistrationRulesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2894,42 +3053,39 @@ func (x *DeleteRepositoryByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRepositoryByIdResponse.ProtoReflect.Descriptor instead.
-func (*DeleteRepositoryByIdResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAutoRegistrationRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListAutoRegistrationRulesRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *DeleteRepositoryByIdResponse) GetRepositoryId() string {
+func (x *ListAutoRegistrationRulesRequest) GetContext() *Context {
 	if x != nil {
-		return x.RepositoryId
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-type GetRepositoryByNameRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-	Provider      string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Name          string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Context       *Context `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+type ListAutoRegistrationRulesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Rules         []*AutoRegistrationRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRepositoryByNameRequest) Reset() {
-	*x = GetRepositoryByNameRequest{}
+func (x *ListAutoRegistrationRulesResponse) Reset() {
+	*x = ListAutoRegistrationRulesResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRepositoryByNameRequest) String() string {
+func (x *ListAutoRegistrationRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRepositoryByNameRequest) ProtoMessage() {}
+func (*ListAutoRegistrationRulesResponse) ProtoMessage() {}
 
-func (x *GetRepositoryByNameRequest) ProtoReflect() protoreflect.Message {
+func (x *ListAutoRegistrationRulesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2941,54 +3097,40 @@ func (x *GetRepositoryByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRepositoryByNameRequest.ProtoReflect.Descriptor instead.
-func (*GetRepositoryByNameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListAutoRegistrationRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListAutoRegistrationRulesResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{36}
 }
 
-// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-func (x *GetRepositoryByNameRequest) GetProvider() string {
-	if x != nil {
-		return x.Provider
-	}
-	return ""
-}
-
-func (x *GetRepositoryByNameRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *GetRepositoryByNameRequest) GetContext() *Context {
+func (x *ListAutoRegistrationRulesResponse) GetRules() []*AutoRegistrationRule {
 	if x != nil {
-		return x.Context
+		return x.Rules
 	}
 	return nil
 }
 
-type GetRepositoryByNameResponse struct {
+type DeleteAutoRegistrationRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Repository    *Repository            `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
+	Context       *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRepositoryByNameResponse) Reset() {
-	*x = GetRepositoryByNameResponse{}
+func (x *DeleteAutoRegistrationRuleRequest) Reset() {
+	*x = DeleteAutoRegistrationRuleRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRepositoryByNameResponse) String() string {
+func (x *DeleteAutoRegistrationRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRepositoryByNameResponse) ProtoMessage() {}
+func (*DeleteAutoRegistrationRuleRequest) ProtoMessage() {}
 
-func (x *GetRepositoryByNameResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteAutoRegistrationRuleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3000,42 +3142,46 @@ func (x *GetRepositoryByNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRepositoryByNameResponse.ProtoReflect.Descriptor instead.
-func (*GetRepositoryByNameResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteAutoRegistrationRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAutoRegistrationRuleRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetRepositoryByNameResponse) GetRepository() *Repository {
+func (x *DeleteAutoRegistrationRuleRequest) GetContext() *Context {
 	if x != nil {
-		return x.Repository
+		return x.Context
 	}
 	return nil
 }
 
-type DeleteRepositoryByNameRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-	Provider      string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Name          string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Context       *Context `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+func (x *DeleteAutoRegistrationRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteAutoRegistrationRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRepositoryByNameRequest) Reset() {
-	*x = DeleteRepositoryByNameRequest{}
+func (x *DeleteAutoRegistrationRuleResponse) Reset() {
+	*x = DeleteAutoRegistrationRuleResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRepositoryByNameRequest) String() string {
+func (x *DeleteAutoRegistrationRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRepositoryByNameRequest) ProtoMessage() {}
+func (*DeleteAutoRegistrationRuleResponse) ProtoMessage() {}
 
-func (x *DeleteRepositoryByNameRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteAutoRegistrationRuleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3047,54 +3193,44 @@ func (x *DeleteRepositoryByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRepositoryByNameRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRepositoryByNameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteAutoRegistrationRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAutoRegistrationRuleResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{38}
 }
 
-// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-func (x *DeleteRepositoryByNameRequest) GetProvider() string {
-	if x != nil {
-		return x.Provider
-	}
-	return ""
-}
-
-func (x *DeleteRepositoryByNameRequest) GetName() string {
+func (x *DeleteAutoRegistrationRuleResponse) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *DeleteRepositoryByNameRequest) GetContext() *Context {
-	if x != nil {
-		return x.Context
-	}
-	return nil
-}
-
-type DeleteRepositoryByNameResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+type ReconcileAutoRegistrationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the project to reconcile. When a provider is set, only the
+	// repositories of that provider are reconciled.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// dry_run reports the changes without registering or deregistering
+	// any repository.
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRepositoryByNameResponse) Reset() {
-	*x = DeleteRepositoryByNameResponse{}
+func (x *ReconcileAutoRegistrationRequest) Reset() {
+	*x = ReconcileAutoRegistrationRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRepositoryByNameResponse) String() string {
+func (x *ReconcileAutoRegistrationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRepositoryByNameResponse) ProtoMessage() {}
+func (*ReconcileAutoRegistrationRequest) ProtoMessage() {}
 
-func (x *DeleteRepositoryByNameResponse) ProtoReflect() protoreflect.Message {
+func (x *ReconcileAutoRegistrationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3106,47 +3242,46 @@ func (x *DeleteRepositoryByNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRepositoryByNameResponse.ProtoReflect.Descriptor instead.
-func (*DeleteRepositoryByNameResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReconcileAutoRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileAutoRegistrationRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *DeleteRepositoryByNameResponse) GetName() string {
+func (x *ReconcileAutoRegistrationRequest) GetContext() *Context {
 	if x != nil {
-		return x.Name
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-type ListRepositoriesRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	// limit is the maximum number of results to return.
-	// This is optional.
-	Limit   int64    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Context *Context `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
-	// cursor is the cursor to use for the next page of results.
-	// This is optional.
-	Cursor        string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+func (x *ReconcileAutoRegistrationRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ReconcileAutoRegistrationResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Report        *AutoRegistrationReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRepositoriesRequest) Reset() {
-	*x = ListRepositoriesRequest{}
+func (x *ReconcileAutoRegistrationResponse) Reset() {
+	*x = ReconcileAutoRegistrationResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRepositoriesRequest) String() string {
+func (x *ReconcileAutoRegistrationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRepositoriesRequest) ProtoMessage() {}
+func (*ReconcileAutoRegistrationResponse) ProtoMessage() {}
 
-func (x *ListRepositoriesRequest) ProtoReflect() protoreflect.Message {
+func (x *ReconcileAutoRegistrationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3158,63 +3293,49 @@ func (x *ListRepositoriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRepositoriesRequest.ProtoReflect.Descriptor instead.
-func (*ListRepositoriesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReconcileAutoRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileAutoRegistrationResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{40}
 }
 
-// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-func (x *ListRepositoriesRequest) GetProvider() string {
-	if x != nil {
-		return x.Provider
-	}
-	return ""
-}
-
-func (x *ListRepositoriesRequest) GetLimit() int64 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ListRepositoriesRequest) GetContext() *Context {
+func (x *ReconcileAutoRegistrationResponse) GetReport() *AutoRegistrationReport {
 	if x != nil {
-		return x.Context
+		return x.Report
 	}
 	return nil
 }
 
-func (x *ListRepositoriesRequest) GetCursor() string {
-	if x != nil {
-		return x.Cursor
-	}
-	return ""
-}
-
-type ListRepositoriesResponse struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	Results []*Repository          `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	// cursor is the cursor to use for the next page of results, empty if at the end
-	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// AutoRegistrationReport lists the changes made by reconciling the
+// auto-registration rules of a project.
+type AutoRegistrationReport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dry_run is set when the changes were not applied.
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// registered are the repositories registered.
+	Registered []*AutoRegistrationReport_Change `protobuf:"bytes,2,rep,name=registered,proto3" json:"registered,omitempty"`
+	// deregistered are the repositories deregistered.
+	Deregistered []*AutoRegistrationReport_Change `protobuf:"bytes,3,rep,name=deregistered,proto3" json:"deregistered,omitempty"`
+	// failed_providers are the providers whose repositories could not be
+	// listed, and which were left untouched.
+	FailedProviders []string `protobuf:"bytes,4,rep,name=failed_providers,json=failedProviders,proto3" json:"failed_providers,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListRepositoriesResponse) Reset() {
-	*x = ListRepositoriesResponse{}
+func (x *AutoRegistrationReport) Reset() {
+	*x = AutoRegistrationReport{}
 	mi := &file_minder_v1_minder_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRepositoriesResponse) String() string {
+func (x *AutoRegistrationReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRepositoriesResponse) ProtoMessage() {}
+func (*AutoRegistrationReport) ProtoMessage() {}
 
-func (x *ListRepositoriesResponse) ProtoReflect() protoreflect.Message {
+func (x *AutoRegistrationReport) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3226,48 +3347,61 @@ func (x *ListRepositoriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRepositoriesResponse.ProtoReflect.Descriptor instead.
-func (*ListRepositoriesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AutoRegistrationReport.ProtoReflect.Descriptor instead.
+func (*AutoRegistrationReport) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ListRepositoriesResponse) GetResults() []*Repository {
+func (x *AutoRegistrationReport) GetDryRun() bool {
 	if x != nil {
-		return x.Results
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *AutoRegistrationReport) GetRegistered() []*AutoRegistrationReport_Change {
+	if x != nil {
+		return x.Registered
 	}
 	return nil
 }
 
-func (x *ListRepositoriesResponse) GetCursor() string {
+func (x *AutoRegistrationReport) GetDeregistered() []*AutoRegistrationReport_Change {
 	if x != nil {
-		return x.Cursor
+		return x.Deregistered
 	}
-	return ""
+	return nil
 }
 
-type ReconcileEntityRegistrationRequest struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	Context *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// entity is the entity type
-	Entity        string `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+func (x *AutoRegistrationReport) GetFailedProviders() []string {
+	if x != nil {
+		return x.FailedProviders
+	}
+	return nil
+}
+
+type GetRepositoryByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RepositoryId  string                 `protobuf:"bytes,1,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
+	Context       *Context               `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReconcileEntityRegistrationRequest) Reset() {
-	*x = ReconcileEntityRegistrationRequest{}
+func (x *GetRepositoryByIdRequest) Reset() {
+	*x = GetRepositoryByIdRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReconcileEntityRegistrationRequest) String() string {
+func (x *GetRepositoryByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReconcileEntityRegistrationRequest) ProtoMessage() {}
+func (*GetRepositoryByIdRequest) ProtoMessage() {}
 
-func (x *ReconcileEntityRegistrationRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRepositoryByIdRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3279,45 +3413,46 @@ func (x *ReconcileEntityRegistrationRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReconcileEntityRegistrationRequest.ProtoReflect.Descriptor instead.
-func (*ReconcileEntityRegistrationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRepositoryByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetRepositoryByIdRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ReconcileEntityRegistrationRequest) GetContext() *Context {
+func (x *GetRepositoryByIdRequest) GetRepositoryId() string {
 	if x != nil {
-		return x.Context
+		return x.RepositoryId
 	}
-	return nil
+	return ""
 }
 
-func (x *ReconcileEntityRegistrationRequest) GetEntity() string {
+func (x *GetRepositoryByIdRequest) GetContext() *Context {
 	if x != nil {
-		return x.Entity
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-type ReconcileEntityRegistrationResponse struct {
+type GetRepositoryByIdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repository    *Repository            `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReconcileEntityRegistrationResponse) Reset() {
-	*x = ReconcileEntityRegistrationResponse{}
+func (x *GetRepositoryByIdResponse) Reset() {
+	*x = GetRepositoryByIdResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReconcileEntityRegistrationResponse) String() string {
+func (x *GetRepositoryByIdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReconcileEntityRegistrationResponse) ProtoMessage() {}
+func (*GetRepositoryByIdResponse) ProtoMessage() {}
 
-func (x *ReconcileEntityRegistrationResponse) ProtoReflect() protoreflect.Message {
+func (x *GetRepositoryByIdResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3329,35 +3464,40 @@ func (x *ReconcileEntityRegistrationResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReconcileEntityRegistrationResponse.ProtoReflect.Descriptor instead.
-func (*ReconcileEntityRegistrationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRepositoryByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetRepositoryByIdResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{43}
 }
 
-type VerifyProviderTokenFromRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Context       *Context               `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+func (x *GetRepositoryByIdResponse) GetRepository() *Repository {
+	if x != nil {
+		return x.Repository
+	}
+	return nil
+}
+
+type DeleteRepositoryByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RepositoryId  string                 `protobuf:"bytes,1,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
+	Context       *Context               `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyProviderTokenFromRequest) Reset() {
-	*x = VerifyProviderTokenFromRequest{}
+func (x *DeleteRepositoryByIdRequest) Reset() {
+	*x = DeleteRepositoryByIdRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyProviderTokenFromRequest) String() string {
+func (x *DeleteRepositoryByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyProviderTokenFromRequest) ProtoMessage() {}
+func (*DeleteRepositoryByIdRequest) ProtoMessage() {}
 
-func (x *VerifyProviderTokenFromRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteRepositoryByIdRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3369,54 +3509,46 @@ func (x *VerifyProviderTokenFromRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyProviderTokenFromRequest.ProtoReflect.Descriptor instead.
-func (*VerifyProviderTokenFromRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteRepositoryByIdRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRepositoryByIdRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{44}
 }
 
-// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-func (x *VerifyProviderTokenFromRequest) GetProvider() string {
+func (x *DeleteRepositoryByIdRequest) GetRepositoryId() string {
 	if x != nil {
-		return x.Provider
+		return x.RepositoryId
 	}
 	return ""
 }
 
-func (x *VerifyProviderTokenFromRequest) GetTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Timestamp
-	}
-	return nil
-}
-
-func (x *VerifyProviderTokenFromRequest) GetContext() *Context {
+func (x *DeleteRepositoryByIdRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-type VerifyProviderTokenFromResponse struct {
+type DeleteRepositoryByIdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	RepositoryId  string                 `protobuf:"bytes,1,opt,name=repository_id,json=repositoryId,proto3" json:"repository_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyProviderTokenFromResponse) Reset() {
-	*x = VerifyProviderTokenFromResponse{}
+func (x *DeleteRepositoryByIdResponse) Reset() {
+	*x = DeleteRepositoryByIdResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyProviderTokenFromResponse) String() string {
+func (x *DeleteRepositoryByIdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyProviderTokenFromResponse) ProtoMessage() {}
+func (*DeleteRepositoryByIdResponse) ProtoMessage() {}
 
-func (x *VerifyProviderTokenFromResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteRepositoryByIdResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3428,42 +3560,42 @@ func (x *VerifyProviderTokenFromResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyProviderTokenFromResponse.ProtoReflect.Descriptor instead.
-func (*VerifyProviderTokenFromResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteRepositoryByIdResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRepositoryByIdResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *VerifyProviderTokenFromResponse) GetStatus() string {
+func (x *DeleteRepositoryByIdResponse) GetRepositoryId() string {
 	if x != nil {
-		return x.Status
+		return x.RepositoryId
 	}
 	return ""
 }
 
-// VerifyProviderCredentialRequest contains the enrollment nonce (aka state) that was used when enrolling the provider
-type VerifyProviderCredentialRequest struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	Context *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// enrollment_nonce is the state parameter returned when enrolling the provider
-	EnrollmentNonce string `protobuf:"bytes,2,opt,name=enrollment_nonce,json=enrollmentNonce,proto3" json:"enrollment_nonce,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetRepositoryByNameRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+	Provider      string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Name          string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Context       *Context `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyProviderCredentialRequest) Reset() {
-	*x = VerifyProviderCredentialRequest{}
+func (x *GetRepositoryByNameRequest) Reset() {
+	*x = GetRepositoryByNameRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyProviderCredentialRequest) String() string {
+func (x *GetRepositoryByNameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyProviderCredentialRequest) ProtoMessage() {}
+func (*GetRepositoryByNameRequest) ProtoMessage() {}
 
-func (x *VerifyProviderCredentialRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRepositoryByNameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3475,52 +3607,54 @@ func (x *VerifyProviderCredentialRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyProviderCredentialRequest.ProtoReflect.Descriptor instead.
-func (*VerifyProviderCredentialRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRepositoryByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetRepositoryByNameRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *VerifyProviderCredentialRequest) GetContext() *Context {
+// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+func (x *GetRepositoryByNameRequest) GetProvider() string {
 	if x != nil {
-		return x.Context
+		return x.Provider
 	}
-	return nil
+	return ""
 }
 
-func (x *VerifyProviderCredentialRequest) GetEnrollmentNonce() string {
+func (x *GetRepositoryByNameRequest) GetName() string {
 	if x != nil {
-		return x.EnrollmentNonce
+		return x.Name
 	}
 	return ""
 }
 
-// VerifyProviderCredentialRequest responds with a boolean indicating if the provider has been created and the provider
-// name, if it has been created
-type VerifyProviderCredentialResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// created is true if the provider was created.
-	Created bool `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
-	// provider_name is the name of the provider that was created.
-	// This is populated if creation was successful.
-	ProviderName  string `protobuf:"bytes,2,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
+func (x *GetRepositoryByNameRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type GetRepositoryByNameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Repository    *Repository            `protobuf:"bytes,1,opt,name=repository,proto3" json:"repository,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyProviderCredentialResponse) Reset() {
-	*x = VerifyProviderCredentialResponse{}
+func (x *GetRepositoryByNameResponse) Reset() {
+	*x = GetRepositoryByNameResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyProviderCredentialResponse) String() string {
+func (x *GetRepositoryByNameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyProviderCredentialResponse) ProtoMessage() {}
+func (*GetRepositoryByNameResponse) ProtoMessage() {}
 
-func (x *VerifyProviderCredentialResponse) ProtoReflect() protoreflect.Message {
+func (x *GetRepositoryByNameResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3532,46 +3666,42 @@ func (x *VerifyProviderCredentialResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyProviderCredentialResponse.ProtoReflect.Descriptor instead.
-func (*VerifyProviderCredentialResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRepositoryByNameResponse.ProtoReflect.Descriptor instead.
+func (*GetRepositoryByNameResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *VerifyProviderCredentialResponse) GetCreated() bool {
+func (x *GetRepositoryByNameResponse) GetRepository() *Repository {
 	if x != nil {
-		return x.Created
-	}
-	return false
-}
-
-func (x *VerifyProviderCredentialResponse) GetProviderName() string {
-	if x != nil {
-		return x.ProviderName
+		return x.Repository
 	}
-	return ""
+	return nil
 }
 
-// User service
-type CreateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type DeleteRepositoryByNameRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+	Provider      string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Name          string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Context       *Context `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserRequest) Reset() {
-	*x = CreateUserRequest{}
+func (x *DeleteRepositoryByNameRequest) Reset() {
+	*x = DeleteRepositoryByNameRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserRequest) String() string {
+func (x *DeleteRepositoryByNameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRequest) ProtoMessage() {}
+func (*DeleteRepositoryByNameRequest) ProtoMessage() {}
 
-func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteRepositoryByNameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3583,42 +3713,54 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteRepositoryByNameRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRepositoryByNameRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{48}
 }
 
-type CreateUserResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-	OrganizationId string `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-	OrganizatioName string                 `protobuf:"bytes,3,opt,name=organizatio_name,json=organizatioName,proto3" json:"organizatio_name,omitempty"`
-	ProjectId       string                 `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	ProjectName     string                 `protobuf:"bytes,5,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	IdentitySubject string                 `protobuf:"bytes,6,opt,name=identity_subject,json=identitySubject,proto3" json:"identity_subject,omitempty"`
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-	Context       *Context `protobuf:"bytes,8,opt,name=context,proto3" json:"context,omitempty"`
+// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+func (x *DeleteRepositoryByNameRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *DeleteRepositoryByNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteRepositoryByNameRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type DeleteRepositoryByNameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserResponse) Reset() {
-	*x = CreateUserResponse{}
+func (x *DeleteRepositoryByNameResponse) Reset() {
+	*x = DeleteRepositoryByNameResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserResponse) String() string {
+func (x *DeleteRepositoryByNameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserResponse) ProtoMessage() {}
+func (*DeleteRepositoryByNameResponse) ProtoMessage() {}
 
-func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteRepositoryByNameResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3630,126 +3772,115 @@ func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
-func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteRepositoryByNameResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRepositoryByNameResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CreateUserResponse) GetId() int32 {
+func (x *DeleteRepositoryByNameResponse) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-func (x *CreateUserResponse) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
+type ListRepositoriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// limit is the maximum number of results to return.
+	// This is optional.
+	Limit   int64    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Context *Context `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
+	// cursor is the cursor to use for the next page of results.
+	// This is optional.
+	Cursor        string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-func (x *CreateUserResponse) GetOrganizatioName() string {
-	if x != nil {
-		return x.OrganizatioName
-	}
-	return ""
+func (x *ListRepositoriesRequest) Reset() {
+	*x = ListRepositoriesRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserResponse) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
+func (x *ListRepositoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateUserResponse) GetProjectName() string {
+func (*ListRepositoriesRequest) ProtoMessage() {}
+
+func (x *ListRepositoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[50]
 	if x != nil {
-		return x.ProjectName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateUserResponse) GetIdentitySubject() string {
+// Deprecated: Use ListRepositoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListRepositoriesRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{50}
+}
+
+// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+func (x *ListRepositoriesRequest) GetProvider() string {
 	if x != nil {
-		return x.IdentitySubject
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *CreateUserResponse) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ListRepositoriesRequest) GetLimit() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-func (x *CreateUserResponse) GetContext() *Context {
+func (x *ListRepositoriesRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-type DeleteUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteUserRequest) Reset() {
-	*x = DeleteUserRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[50]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DeleteUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DeleteUserRequest) ProtoMessage() {}
-
-func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[50]
+func (x *ListRepositoriesRequest) GetCursor() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Cursor
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{50}
+	return ""
 }
 
-type DeleteUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type ListRepositoriesResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Results []*Repository          `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// cursor is the cursor to use for the next page of results, empty if at the end
+	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserResponse) Reset() {
-	*x = DeleteUserResponse{}
+func (x *ListRepositoriesResponse) Reset() {
+	*x = ListRepositoriesResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserResponse) String() string {
+func (x *ListRepositoriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserResponse) ProtoMessage() {}
+func (*ListRepositoriesResponse) ProtoMessage() {}
 
-func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+func (x *ListRepositoriesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3761,36 +3892,48 @@ func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
-func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRepositoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListRepositoriesResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{51}
 }
 
-// user record to be returned
-type UserRecord struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	IdentitySubject string                 `protobuf:"bytes,3,opt,name=identity_subject,json=identitySubject,proto3" json:"identity_subject,omitempty"`
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *ListRepositoriesResponse) GetResults() []*Repository {
+	if x != nil {
+		return x.Results
+	}
+	return nil
 }
 
-func (x *UserRecord) Reset() {
-	*x = UserRecord{}
+func (x *ListRepositoriesResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ReconcileEntityRegistrationRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// entity is the entity type
+	Entity        string `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileEntityRegistrationRequest) Reset() {
+	*x = ReconcileEntityRegistrationRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserRecord) String() string {
+func (x *ReconcileEntityRegistrationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserRecord) ProtoMessage() {}
+func (*ReconcileEntityRegistrationRequest) ProtoMessage() {}
 
-func (x *UserRecord) ProtoReflect() protoreflect.Message {
+func (x *ReconcileEntityRegistrationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3802,62 +3945,45 @@ func (x *UserRecord) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserRecord.ProtoReflect.Descriptor instead.
-func (*UserRecord) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReconcileEntityRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileEntityRegistrationRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *UserRecord) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UserRecord) GetIdentitySubject() string {
-	if x != nil {
-		return x.IdentitySubject
-	}
-	return ""
-}
-
-func (x *UserRecord) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ReconcileEntityRegistrationRequest) GetContext() *Context {
 	if x != nil {
-		return x.CreatedAt
+		return x.Context
 	}
 	return nil
 }
 
-func (x *UserRecord) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ReconcileEntityRegistrationRequest) GetEntity() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Entity
 	}
-	return nil
+	return ""
 }
 
-// ProjectRole has the project along with the role the user has in the project
-type ProjectRole struct {
+type ReconcileEntityRegistrationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
-	Project       *Project               `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProjectRole) Reset() {
-	*x = ProjectRole{}
+func (x *ReconcileEntityRegistrationResponse) Reset() {
+	*x = ReconcileEntityRegistrationResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProjectRole) String() string {
+func (x *ReconcileEntityRegistrationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProjectRole) ProtoMessage() {}
+func (*ReconcileEntityRegistrationResponse) ProtoMessage() {}
 
-func (x *ProjectRole) ProtoReflect() protoreflect.Message {
+func (x *ReconcileEntityRegistrationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3869,46 +3995,35 @@ func (x *ProjectRole) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProjectRole.ProtoReflect.Descriptor instead.
-func (*ProjectRole) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReconcileEntityRegistrationResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileEntityRegistrationResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ProjectRole) GetRole() *Role {
-	if x != nil {
-		return x.Role
-	}
-	return nil
-}
-
-func (x *ProjectRole) GetProject() *Project {
-	if x != nil {
-		return x.Project
-	}
-	return nil
-}
-
-// get user
-type GetUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type VerifyProviderTokenFromRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Context       *Context               `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserRequest) Reset() {
-	*x = GetUserRequest{}
+func (x *VerifyProviderTokenFromRequest) Reset() {
+	*x = VerifyProviderTokenFromRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserRequest) String() string {
+func (x *VerifyProviderTokenFromRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRequest) ProtoMessage() {}
+func (*VerifyProviderTokenFromRequest) ProtoMessage() {}
 
-func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+func (x *VerifyProviderTokenFromRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3920,37 +4035,54 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
-func (*GetUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyProviderTokenFromRequest.ProtoReflect.Descriptor instead.
+func (*VerifyProviderTokenFromRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{54}
 }
 
-type GetUserResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	User  *UserRecord            `protobuf:"bytes,1,opt,name=user,proto3,oneof" json:"user,omitempty"`
-	// This will be deprecated in favor of the project_roles field
-	//
-	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-	Projects      []*Project     `protobuf:"bytes,2,rep,name=projects,proto3" json:"projects,omitempty"`
-	ProjectRoles  []*ProjectRole `protobuf:"bytes,3,rep,name=project_roles,json=projectRoles,proto3" json:"project_roles,omitempty"`
+// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+func (x *VerifyProviderTokenFromRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *VerifyProviderTokenFromRequest) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *VerifyProviderTokenFromRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type VerifyProviderTokenFromResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserResponse) Reset() {
-	*x = GetUserResponse{}
+func (x *VerifyProviderTokenFromResponse) Reset() {
+	*x = VerifyProviderTokenFromResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserResponse) String() string {
+func (x *VerifyProviderTokenFromResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserResponse) ProtoMessage() {}
+func (*VerifyProviderTokenFromResponse) ProtoMessage() {}
 
-func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+func (x *VerifyProviderTokenFromResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3962,55 +4094,42 @@ func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
-func (*GetUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyProviderTokenFromResponse.ProtoReflect.Descriptor instead.
+func (*VerifyProviderTokenFromResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetUserResponse) GetUser() *UserRecord {
-	if x != nil {
-		return x.User
-	}
-	return nil
-}
-
-// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-func (x *GetUserResponse) GetProjects() []*Project {
-	if x != nil {
-		return x.Projects
-	}
-	return nil
-}
-
-func (x *GetUserResponse) GetProjectRoles() []*ProjectRole {
+func (x *VerifyProviderTokenFromResponse) GetStatus() string {
 	if x != nil {
-		return x.ProjectRoles
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-// DataSource service
-type CreateDataSourceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DataSource    *DataSource            `protobuf:"bytes,1,opt,name=data_source,json=dataSource,proto3" json:"data_source,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// VerifyProviderCredentialRequest contains the enrollment nonce (aka state) that was used when enrolling the provider
+type VerifyProviderCredentialRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// enrollment_nonce is the state parameter returned when enrolling the provider
+	EnrollmentNonce string `protobuf:"bytes,2,opt,name=enrollment_nonce,json=enrollmentNonce,proto3" json:"enrollment_nonce,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateDataSourceRequest) Reset() {
-	*x = CreateDataSourceRequest{}
+func (x *VerifyProviderCredentialRequest) Reset() {
+	*x = VerifyProviderCredentialRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDataSourceRequest) String() string {
+func (x *VerifyProviderCredentialRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDataSourceRequest) ProtoMessage() {}
+func (*VerifyProviderCredentialRequest) ProtoMessage() {}
 
-func (x *CreateDataSourceRequest) ProtoReflect() protoreflect.Message {
+func (x *VerifyProviderCredentialRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4022,39 +4141,52 @@ func (x *CreateDataSourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDataSourceRequest.ProtoReflect.Descriptor instead.
-func (*CreateDataSourceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyProviderCredentialRequest.ProtoReflect.Descriptor instead.
+func (*VerifyProviderCredentialRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CreateDataSourceRequest) GetDataSource() *DataSource {
+func (x *VerifyProviderCredentialRequest) GetContext() *Context {
 	if x != nil {
-		return x.DataSource
+		return x.Context
 	}
 	return nil
 }
 
-type CreateDataSourceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DataSource    *DataSource            `protobuf:"bytes,1,opt,name=data_source,json=dataSource,proto3" json:"data_source,omitempty"`
+func (x *VerifyProviderCredentialRequest) GetEnrollmentNonce() string {
+	if x != nil {
+		return x.EnrollmentNonce
+	}
+	return ""
+}
+
+// VerifyProviderCredentialRequest responds with a boolean indicating if the provider has been created and the provider
+// name, if it has been created
+type VerifyProviderCredentialResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// created is true if the provider was created.
+	Created bool `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	// provider_name is the name of the provider that was created.
+	// This is populated if creation was successful.
+	ProviderName  string `protobuf:"bytes,2,opt,name=provider_name,json=providerName,proto3" json:"provider_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateDataSourceResponse) Reset() {
-	*x = CreateDataSourceResponse{}
+func (x *VerifyProviderCredentialResponse) Reset() {
+	*x = VerifyProviderCredentialResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDataSourceResponse) String() string {
+func (x *VerifyProviderCredentialResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDataSourceResponse) ProtoMessage() {}
+func (*VerifyProviderCredentialResponse) ProtoMessage() {}
 
-func (x *CreateDataSourceResponse) ProtoReflect() protoreflect.Message {
+func (x *VerifyProviderCredentialResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4066,40 +4198,46 @@ func (x *CreateDataSourceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDataSourceResponse.ProtoReflect.Descriptor instead.
-func (*CreateDataSourceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyProviderCredentialResponse.ProtoReflect.Descriptor instead.
+func (*VerifyProviderCredentialResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *CreateDataSourceResponse) GetDataSource() *DataSource {
+func (x *VerifyProviderCredentialResponse) GetCreated() bool {
 	if x != nil {
-		return x.DataSource
+		return x.Created
 	}
-	return nil
+	return false
 }
 
-type GetDataSourceByIdRequest struct {
+func (x *VerifyProviderCredentialResponse) GetProviderName() string {
+	if x != nil {
+		return x.ProviderName
+	}
+	return ""
+}
+
+// User service
+type CreateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *ContextV2             `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDataSourceByIdRequest) Reset() {
-	*x = GetDataSourceByIdRequest{}
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDataSourceByIdRequest) String() string {
+func (x *CreateUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataSourceByIdRequest) ProtoMessage() {}
+func (*CreateUserRequest) ProtoMessage() {}
 
-func (x *GetDataSourceByIdRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4111,46 +4249,42 @@ func (x *GetDataSourceByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataSourceByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetDataSourceByIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetDataSourceByIdRequest) GetContext() *ContextV2 {
-	if x != nil {
-		return x.Context
-	}
-	return nil
-}
-
-func (x *GetDataSourceByIdRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-type GetDataSourceByIdResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DataSource    *DataSource            `protobuf:"bytes,1,opt,name=data_source,json=dataSource,proto3" json:"data_source,omitempty"`
+type CreateUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+	OrganizationId string `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+	OrganizatioName string                 `protobuf:"bytes,3,opt,name=organizatio_name,json=organizatioName,proto3" json:"organizatio_name,omitempty"`
+	ProjectId       string                 `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProjectName     string                 `protobuf:"bytes,5,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	IdentitySubject string                 `protobuf:"bytes,6,opt,name=identity_subject,json=identitySubject,proto3" json:"identity_subject,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+	Context       *Context `protobuf:"bytes,8,opt,name=context,proto3" json:"context,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDataSourceByIdResponse) Reset() {
-	*x = GetDataSourceByIdResponse{}
+func (x *CreateUserResponse) Reset() {
+	*x = CreateUserResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDataSourceByIdResponse) String() string {
+func (x *CreateUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataSourceByIdResponse) ProtoMessage() {}
+func (*CreateUserResponse) ProtoMessage() {}
 
-func (x *GetDataSourceByIdResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4162,41 +4296,90 @@ func (x *GetDataSourceByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataSourceByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetDataSourceByIdResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *GetDataSourceByIdResponse) GetDataSource() *DataSource {
+func (x *CreateUserResponse) GetId() int32 {
 	if x != nil {
-		return x.DataSource
+		return x.Id
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+func (x *CreateUserResponse) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+func (x *CreateUserResponse) GetOrganizatioName() string {
+	if x != nil {
+		return x.OrganizatioName
+	}
+	return ""
+}
+
+func (x *CreateUserResponse) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateUserResponse) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *CreateUserResponse) GetIdentitySubject() string {
+	if x != nil {
+		return x.IdentitySubject
+	}
+	return ""
+}
+
+func (x *CreateUserResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-// GetDataSourceByNameRequest is the request message for the GetDataSourceByName RPC.
-type GetDataSourceByNameRequest struct {
+// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+func (x *CreateUserResponse) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type DeleteUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *ContextV2             `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDataSourceByNameRequest) Reset() {
-	*x = GetDataSourceByNameRequest{}
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDataSourceByNameRequest) String() string {
+func (x *DeleteUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataSourceByNameRequest) ProtoMessage() {}
+func (*DeleteUserRequest) ProtoMessage() {}
 
-func (x *GetDataSourceByNameRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4208,46 +4391,31 @@ func (x *GetDataSourceByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataSourceByNameRequest.ProtoReflect.Descriptor instead.
-func (*GetDataSourceByNameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GetDataSourceByNameRequest) GetContext() *ContextV2 {
-	if x != nil {
-		return x.Context
-	}
-	return nil
-}
-
-func (x *GetDataSourceByNameRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-type GetDataSourceByNameResponse struct {
+type DeleteUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DataSource    *DataSource            `protobuf:"bytes,1,opt,name=data_source,json=dataSource,proto3" json:"data_source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDataSourceByNameResponse) Reset() {
-	*x = GetDataSourceByNameResponse{}
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDataSourceByNameResponse) String() string {
+func (x *DeleteUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataSourceByNameResponse) ProtoMessage() {}
+func (*DeleteUserResponse) ProtoMessage() {}
 
-func (x *GetDataSourceByNameResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4259,39 +4427,36 @@ func (x *GetDataSourceByNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataSourceByNameResponse.ProtoReflect.Descriptor instead.
-func (*GetDataSourceByNameResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *GetDataSourceByNameResponse) GetDataSource() *DataSource {
-	if x != nil {
-		return x.DataSource
-	}
-	return nil
-}
-
-type ListDataSourcesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *ContextV2             `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// user record to be returned
+type UserRecord struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IdentitySubject string                 `protobuf:"bytes,3,opt,name=identity_subject,json=identitySubject,proto3" json:"identity_subject,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListDataSourcesRequest) Reset() {
-	*x = ListDataSourcesRequest{}
+func (x *UserRecord) Reset() {
+	*x = UserRecord{}
 	mi := &file_minder_v1_minder_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDataSourcesRequest) String() string {
+func (x *UserRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDataSourcesRequest) ProtoMessage() {}
+func (*UserRecord) ProtoMessage() {}
 
-func (x *ListDataSourcesRequest) ProtoReflect() protoreflect.Message {
+func (x *UserRecord) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4303,39 +4468,62 @@ func (x *ListDataSourcesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDataSourcesRequest.ProtoReflect.Descriptor instead.
-func (*ListDataSourcesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserRecord.ProtoReflect.Descriptor instead.
+func (*UserRecord) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ListDataSourcesRequest) GetContext() *ContextV2 {
+func (x *UserRecord) GetId() int32 {
 	if x != nil {
-		return x.Context
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserRecord) GetIdentitySubject() string {
+	if x != nil {
+		return x.IdentitySubject
+	}
+	return ""
+}
+
+func (x *UserRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type ListDataSourcesResponse struct {
+func (x *UserRecord) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ProjectRole has the project along with the role the user has in the project
+type ProjectRole struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DataSources   []*DataSource          `protobuf:"bytes,1,rep,name=data_sources,json=dataSources,proto3" json:"data_sources,omitempty"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Project       *Project               `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListDataSourcesResponse) Reset() {
-	*x = ListDataSourcesResponse{}
+func (x *ProjectRole) Reset() {
+	*x = ProjectRole{}
 	mi := &file_minder_v1_minder_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDataSourcesResponse) String() string {
+func (x *ProjectRole) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDataSourcesResponse) ProtoMessage() {}
+func (*ProjectRole) ProtoMessage() {}
 
-func (x *ListDataSourcesResponse) ProtoReflect() protoreflect.Message {
+func (x *ProjectRole) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4347,39 +4535,46 @@ func (x *ListDataSourcesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDataSourcesResponse.ProtoReflect.Descriptor instead.
-func (*ListDataSourcesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProjectRole.ProtoReflect.Descriptor instead.
+func (*ProjectRole) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ListDataSourcesResponse) GetDataSources() []*DataSource {
+func (x *ProjectRole) GetRole() *Role {
 	if x != nil {
-		return x.DataSources
+		return x.Role
 	}
 	return nil
 }
 
-type UpdateDataSourceRequest struct {
+func (x *ProjectRole) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+// get user
+type GetUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DataSource    *DataSource            `protobuf:"bytes,1,opt,name=data_source,json=dataSource,proto3" json:"data_source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDataSourceRequest) Reset() {
-	*x = UpdateDataSourceRequest{}
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDataSourceRequest) String() string {
+func (x *GetUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDataSourceRequest) ProtoMessage() {}
+func (*GetUserRequest) ProtoMessage() {}
 
-func (x *UpdateDataSourceRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4391,39 +4586,37 @@ func (x *UpdateDataSourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDataSourceRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDataSourceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *UpdateDataSourceRequest) GetDataSource() *DataSource {
-	if x != nil {
-		return x.DataSource
-	}
-	return nil
-}
-
-type UpdateDataSourceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DataSource    *DataSource            `protobuf:"bytes,1,opt,name=data_source,json=dataSource,proto3" json:"data_source,omitempty"`
+type GetUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	User  *UserRecord            `protobuf:"bytes,1,opt,name=user,proto3,oneof" json:"user,omitempty"`
+	// This will be deprecated in favor of the project_roles field
+	//
+	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+	Projects      []*Project     `protobuf:"bytes,2,rep,name=projects,proto3" json:"projects,omitempty"`
+	ProjectRoles  []*ProjectRole `protobuf:"bytes,3,rep,name=project_roles,json=projectRoles,proto3" json:"project_roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDataSourceResponse) Reset() {
-	*x = UpdateDataSourceResponse{}
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDataSourceResponse) String() string {
+func (x *GetUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDataSourceResponse) ProtoMessage() {}
+func (*GetUserResponse) ProtoMessage() {}
 
-func (x *UpdateDataSourceResponse) ProtoReflect() protoreflect.Message {
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4435,40 +4628,55 @@ func (x *UpdateDataSourceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDataSourceResponse.ProtoReflect.Descriptor instead.
-func (*UpdateDataSourceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *UpdateDataSourceResponse) GetDataSource() *DataSource {
+func (x *GetUserResponse) GetUser() *UserRecord {
 	if x != nil {
-		return x.DataSource
+		return x.User
 	}
 	return nil
 }
 
-type DeleteDataSourceByIdRequest struct {
+// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+func (x *GetUserResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *GetUserResponse) GetProjectRoles() []*ProjectRole {
+	if x != nil {
+		return x.ProjectRoles
+	}
+	return nil
+}
+
+// DataSource service
+type CreateDataSourceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *ContextV2             `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	DataSource    *DataSource            `protobuf:"bytes,1,opt,name=data_source,json=dataSource,proto3" json:"data_source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteDataSourceByIdRequest) Reset() {
-	*x = DeleteDataSourceByIdRequest{}
+func (x *CreateDataSourceRequest) Reset() {
+	*x = CreateDataSourceRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteDataSourceByIdRequest) String() string {
+func (x *CreateDataSourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDataSourceByIdRequest) ProtoMessage() {}
+func (*CreateDataSourceRequest) ProtoMessage() {}
 
-func (x *DeleteDataSourceByIdRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateDataSourceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4480,46 +4688,39 @@ func (x *DeleteDataSourceByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDataSourceByIdRequest.ProtoReflect.Descriptor instead.
-func (*DeleteDataSourceByIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateDataSourceRequest.ProtoReflect.Descriptor instead.
+func (*CreateDataSourceRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *DeleteDataSourceByIdRequest) GetContext() *ContextV2 {
+func (x *CreateDataSourceRequest) GetDataSource() *DataSource {
 	if x != nil {
-		return x.Context
+		return x.DataSource
 	}
 	return nil
 }
 
-func (x *DeleteDataSourceByIdRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-type DeleteDataSourceByIdResponse struct {
+type CreateDataSourceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DataSource    *DataSource            `protobuf:"bytes,1,opt,name=data_source,json=dataSource,proto3" json:"data_source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteDataSourceByIdResponse) Reset() {
-	*x = DeleteDataSourceByIdResponse{}
+func (x *CreateDataSourceResponse) Reset() {
+	*x = CreateDataSourceResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteDataSourceByIdResponse) String() string {
+func (x *CreateDataSourceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDataSourceByIdResponse) ProtoMessage() {}
+func (*CreateDataSourceResponse) ProtoMessage() {}
 
-func (x *DeleteDataSourceByIdResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateDataSourceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4531,40 +4732,40 @@ func (x *DeleteDataSourceByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDataSourceByIdResponse.ProtoReflect.Descriptor instead.
-func (*DeleteDataSourceByIdResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateDataSourceResponse.ProtoReflect.Descriptor instead.
+func (*CreateDataSourceResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *DeleteDataSourceByIdResponse) GetId() string {
+func (x *CreateDataSourceResponse) GetDataSource() *DataSource {
 	if x != nil {
-		return x.Id
+		return x.DataSource
 	}
-	return ""
+	return nil
 }
 
-type DeleteDataSourceByNameRequest struct {
+type GetDataSourceByIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Context       *ContextV2             `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteDataSourceByNameRequest) Reset() {
-	*x = DeleteDataSourceByNameRequest{}
+func (x *GetDataSourceByIdRequest) Reset() {
+	*x = GetDataSourceByIdRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteDataSourceByNameRequest) String() string {
+func (x *GetDataSourceByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDataSourceByNameRequest) ProtoMessage() {}
+func (*GetDataSourceByIdRequest) ProtoMessage() {}
 
-func (x *DeleteDataSourceByNameRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDataSourceByIdRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4576,46 +4777,46 @@ func (x *DeleteDataSourceByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDataSourceByNameRequest.ProtoReflect.Descriptor instead.
-func (*DeleteDataSourceByNameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDataSourceByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetDataSourceByIdRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *DeleteDataSourceByNameRequest) GetContext() *ContextV2 {
+func (x *GetDataSourceByIdRequest) GetContext() *ContextV2 {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *DeleteDataSourceByNameRequest) GetName() string {
+func (x *GetDataSourceByIdRequest) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-type DeleteDataSourceByNameResponse struct {
+type GetDataSourceByIdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DataSource    *DataSource            `protobuf:"bytes,1,opt,name=data_source,json=dataSource,proto3" json:"data_source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteDataSourceByNameResponse) Reset() {
-	*x = DeleteDataSourceByNameResponse{}
+func (x *GetDataSourceByIdResponse) Reset() {
+	*x = GetDataSourceByIdResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteDataSourceByNameResponse) String() string {
+func (x *GetDataSourceByIdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteDataSourceByNameResponse) ProtoMessage() {}
+func (*GetDataSourceByIdResponse) ProtoMessage() {}
 
-func (x *DeleteDataSourceByNameResponse) ProtoReflect() protoreflect.Message {
+func (x *GetDataSourceByIdResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4627,40 +4828,41 @@ func (x *DeleteDataSourceByNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteDataSourceByNameResponse.ProtoReflect.Descriptor instead.
-func (*DeleteDataSourceByNameResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDataSourceByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetDataSourceByIdResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *DeleteDataSourceByNameResponse) GetName() string {
+func (x *GetDataSourceByIdResponse) GetDataSource() *DataSource {
 	if x != nil {
-		return x.Name
+		return x.DataSource
 	}
-	return ""
+	return nil
 }
 
-// Profile service
-type CreateProfileRequest struct {
+// GetDataSourceByNameRequest is the request message for the GetDataSourceByName RPC.
+type GetDataSourceByNameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Context       *ContextV2             `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProfileRequest) Reset() {
-	*x = CreateProfileRequest{}
+func (x *GetDataSourceByNameRequest) Reset() {
+	*x = GetDataSourceByNameRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProfileRequest) String() string {
+func (x *GetDataSourceByNameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProfileRequest) ProtoMessage() {}
+func (*GetDataSourceByNameRequest) ProtoMessage() {}
 
-func (x *CreateProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDataSourceByNameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4672,39 +4874,46 @@ func (x *CreateProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProfileRequest.ProtoReflect.Descriptor instead.
-func (*CreateProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDataSourceByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetDataSourceByNameRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *CreateProfileRequest) GetProfile() *Profile {
+func (x *GetDataSourceByNameRequest) GetContext() *ContextV2 {
 	if x != nil {
-		return x.Profile
+		return x.Context
 	}
 	return nil
 }
 
-type CreateProfileResponse struct {
+func (x *GetDataSourceByNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetDataSourceByNameResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	DataSource    *DataSource            `protobuf:"bytes,1,opt,name=data_source,json=dataSource,proto3" json:"data_source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProfileResponse) Reset() {
-	*x = CreateProfileResponse{}
+func (x *GetDataSourceByNameResponse) Reset() {
+	*x = GetDataSourceByNameResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProfileResponse) String() string {
+func (x *GetDataSourceByNameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProfileResponse) ProtoMessage() {}
+func (*GetDataSourceByNameResponse) ProtoMessage() {}
 
-func (x *CreateProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *GetDataSourceByNameResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4716,39 +4925,39 @@ func (x *CreateProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProfileResponse.ProtoReflect.Descriptor instead.
-func (*CreateProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDataSourceByNameResponse.ProtoReflect.Descriptor instead.
+func (*GetDataSourceByNameResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *CreateProfileResponse) GetProfile() *Profile {
+func (x *GetDataSourceByNameResponse) GetDataSource() *DataSource {
 	if x != nil {
-		return x.Profile
+		return x.DataSource
 	}
 	return nil
 }
 
-type UpdateProfileRequest struct {
+type ListDataSourcesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Context       *ContextV2             `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProfileRequest) Reset() {
-	*x = UpdateProfileRequest{}
+func (x *ListDataSourcesRequest) Reset() {
+	*x = ListDataSourcesRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProfileRequest) String() string {
+func (x *ListDataSourcesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProfileRequest) ProtoMessage() {}
+func (*ListDataSourcesRequest) ProtoMessage() {}
 
-func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *ListDataSourcesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4760,39 +4969,39 @@ func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDataSourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListDataSourcesRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *UpdateProfileRequest) GetProfile() *Profile {
+func (x *ListDataSourcesRequest) GetContext() *ContextV2 {
 	if x != nil {
-		return x.Profile
+		return x.Context
 	}
 	return nil
 }
 
-type UpdateProfileResponse struct {
+type ListDataSourcesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	DataSources   []*DataSource          `protobuf:"bytes,1,rep,name=data_sources,json=dataSources,proto3" json:"data_sources,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProfileResponse) Reset() {
-	*x = UpdateProfileResponse{}
+func (x *ListDataSourcesResponse) Reset() {
+	*x = ListDataSourcesResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProfileResponse) String() string {
+func (x *ListDataSourcesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProfileResponse) ProtoMessage() {}
+func (*ListDataSourcesResponse) ProtoMessage() {}
 
-func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *ListDataSourcesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4804,50 +5013,39 @@ func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDataSourcesResponse.ProtoReflect.Descriptor instead.
+func (*ListDataSourcesResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *UpdateProfileResponse) GetProfile() *Profile {
+func (x *ListDataSourcesResponse) GetDataSources() []*DataSource {
 	if x != nil {
-		return x.Profile
+		return x.DataSources
 	}
 	return nil
 }
 
-type PatchProfileRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The context in which the patch is applied. Provided explicitly
-	// so that the patch itself can be minimal and contain only
-	// the attribute to set, e.g. remediate=true
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// The id or name of the profile to patch. Same explanation about explicitness
-	// as for the context
-	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	// The patch to apply to the profile
-	Patch *Profile `protobuf:"bytes,3,opt,name=patch,proto3" json:"patch,omitempty"`
-	// needed to enable PATCH, see https://grpc-ecosystem.github.io/grpc-gateway/docs/mapping/patch_feature/
-	// is not exposed to the API user
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+type UpdateDataSourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DataSource    *DataSource            `protobuf:"bytes,1,opt,name=data_source,json=dataSource,proto3" json:"data_source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PatchProfileRequest) Reset() {
-	*x = PatchProfileRequest{}
+func (x *UpdateDataSourceRequest) Reset() {
+	*x = UpdateDataSourceRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PatchProfileRequest) String() string {
+func (x *UpdateDataSourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchProfileRequest) ProtoMessage() {}
+func (*UpdateDataSourceRequest) ProtoMessage() {}
 
-func (x *PatchProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateDataSourceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4859,60 +5057,39 @@ func (x *PatchProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchProfileRequest.ProtoReflect.Descriptor instead.
-func (*PatchProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateDataSourceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDataSourceRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *PatchProfileRequest) GetContext() *Context {
-	if x != nil {
-		return x.Context
-	}
-	return nil
-}
-
-func (x *PatchProfileRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *PatchProfileRequest) GetPatch() *Profile {
-	if x != nil {
-		return x.Patch
-	}
-	return nil
-}
-
-func (x *PatchProfileRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *UpdateDataSourceRequest) GetDataSource() *DataSource {
 	if x != nil {
-		return x.UpdateMask
+		return x.DataSource
 	}
 	return nil
 }
 
-type PatchProfileResponse struct {
+type UpdateDataSourceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	DataSource    *DataSource            `protobuf:"bytes,1,opt,name=data_source,json=dataSource,proto3" json:"data_source,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PatchProfileResponse) Reset() {
-	*x = PatchProfileResponse{}
+func (x *UpdateDataSourceResponse) Reset() {
+	*x = UpdateDataSourceResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PatchProfileResponse) String() string {
+func (x *UpdateDataSourceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchProfileResponse) ProtoMessage() {}
+func (*UpdateDataSourceResponse) ProtoMessage() {}
 
-func (x *PatchProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateDataSourceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4924,42 +5101,40 @@ func (x *PatchProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchProfileResponse.ProtoReflect.Descriptor instead.
-func (*PatchProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateDataSourceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDataSourceResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *PatchProfileResponse) GetProfile() *Profile {
+func (x *UpdateDataSourceResponse) GetDataSource() *DataSource {
 	if x != nil {
-		return x.Profile
+		return x.DataSource
 	}
 	return nil
 }
 
-type DeleteProfileRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the rule type is evaluated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// id is the name or id of the profile to delete
-	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+type DeleteDataSourceByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *ContextV2             `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProfileRequest) Reset() {
-	*x = DeleteProfileRequest{}
+func (x *DeleteDataSourceByIdRequest) Reset() {
+	*x = DeleteDataSourceByIdRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProfileRequest) String() string {
+func (x *DeleteDataSourceByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProfileRequest) ProtoMessage() {}
+func (*DeleteDataSourceByIdRequest) ProtoMessage() {}
 
-func (x *DeleteProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteDataSourceByIdRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4971,45 +5146,46 @@ func (x *DeleteProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProfileRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteDataSourceByIdRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDataSourceByIdRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *DeleteProfileRequest) GetContext() *Context {
+func (x *DeleteDataSourceByIdRequest) GetContext() *ContextV2 {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *DeleteProfileRequest) GetId() string {
+func (x *DeleteDataSourceByIdRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteProfileResponse struct {
+type DeleteDataSourceByIdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProfileResponse) Reset() {
-	*x = DeleteProfileResponse{}
+func (x *DeleteDataSourceByIdResponse) Reset() {
+	*x = DeleteDataSourceByIdResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProfileResponse) String() string {
+func (x *DeleteDataSourceByIdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProfileResponse) ProtoMessage() {}
+func (*DeleteDataSourceByIdResponse) ProtoMessage() {}
 
-func (x *DeleteProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteDataSourceByIdResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5021,40 +5197,40 @@ func (x *DeleteProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProfileResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteDataSourceByIdResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDataSourceByIdResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{77}
 }
 
-// list profiles
-type ListProfilesRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context which contains the profiles
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// Filter profiles to only those matching the specified labels.
-	//
-	// The default is to return all user-created profiles; the string "*" can
-	// be used to select all profiles, including system profiles.  This syntax
-	// may be expanded in the future.
-	LabelFilter   string `protobuf:"bytes,2,opt,name=label_filter,json=labelFilter,proto3" json:"label_filter,omitempty"`
+func (x *DeleteDataSourceByIdResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteDataSourceByNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *ContextV2             `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProfilesRequest) Reset() {
-	*x = ListProfilesRequest{}
+func (x *DeleteDataSourceByNameRequest) Reset() {
+	*x = DeleteDataSourceByNameRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProfilesRequest) String() string {
+func (x *DeleteDataSourceByNameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProfilesRequest) ProtoMessage() {}
+func (*DeleteDataSourceByNameRequest) ProtoMessage() {}
 
-func (x *ListProfilesRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteDataSourceByNameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5066,46 +5242,46 @@ func (x *ListProfilesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProfilesRequest.ProtoReflect.Descriptor instead.
-func (*ListProfilesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteDataSourceByNameRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDataSourceByNameRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *ListProfilesRequest) GetContext() *Context {
+func (x *DeleteDataSourceByNameRequest) GetContext() *ContextV2 {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *ListProfilesRequest) GetLabelFilter() string {
+func (x *DeleteDataSourceByNameRequest) GetName() string {
 	if x != nil {
-		return x.LabelFilter
+		return x.Name
 	}
 	return ""
 }
 
-type ListProfilesResponse struct {
+type DeleteDataSourceByNameResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profiles      []*Profile             `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProfilesResponse) Reset() {
-	*x = ListProfilesResponse{}
+func (x *DeleteDataSourceByNameResponse) Reset() {
+	*x = DeleteDataSourceByNameResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProfilesResponse) String() string {
+func (x *DeleteDataSourceByNameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProfilesResponse) ProtoMessage() {}
+func (*DeleteDataSourceByNameResponse) ProtoMessage() {}
 
-func (x *ListProfilesResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteDataSourceByNameResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5117,43 +5293,39 @@ func (x *ListProfilesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProfilesResponse.ProtoReflect.Descriptor instead.
-func (*ListProfilesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteDataSourceByNameResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDataSourceByNameResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *ListProfilesResponse) GetProfiles() []*Profile {
+func (x *DeleteDataSourceByNameResponse) GetName() string {
 	if x != nil {
-		return x.Profiles
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-// get profile by id
-type GetProfileByIdRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context which contains the profiles
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// id is the id of the profile to get
-	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+type CreateTrustRootRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TrustRoot     *TrustRoot             `protobuf:"bytes,1,opt,name=trust_root,json=trustRoot,proto3" json:"trust_root,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileByIdRequest) Reset() {
-	*x = GetProfileByIdRequest{}
+func (x *CreateTrustRootRequest) Reset() {
+	*x = CreateTrustRootRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileByIdRequest) String() string {
+func (x *CreateTrustRootRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileByIdRequest) ProtoMessage() {}
+func (*CreateTrustRootRequest) ProtoMessage() {}
 
-func (x *GetProfileByIdRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateTrustRootRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5165,46 +5337,39 @@ func (x *GetProfileByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetProfileByIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateTrustRootRequest.ProtoReflect.Descriptor instead.
+func (*CreateTrustRootRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *GetProfileByIdRequest) GetContext() *Context {
+func (x *CreateTrustRootRequest) GetTrustRoot() *TrustRoot {
 	if x != nil {
-		return x.Context
+		return x.TrustRoot
 	}
 	return nil
 }
 
-func (x *GetProfileByIdRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-type GetProfileByIdResponse struct {
+type CreateTrustRootResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	TrustRoot     *TrustRoot             `protobuf:"bytes,1,opt,name=trust_root,json=trustRoot,proto3" json:"trust_root,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileByIdResponse) Reset() {
-	*x = GetProfileByIdResponse{}
+func (x *CreateTrustRootResponse) Reset() {
+	*x = CreateTrustRootResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileByIdResponse) String() string {
+func (x *CreateTrustRootResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileByIdResponse) ProtoMessage() {}
+func (*CreateTrustRootResponse) ProtoMessage() {}
 
-func (x *GetProfileByIdResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateTrustRootResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5216,43 +5381,40 @@ func (x *GetProfileByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetProfileByIdResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateTrustRootResponse.ProtoReflect.Descriptor instead.
+func (*CreateTrustRootResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *GetProfileByIdResponse) GetProfile() *Profile {
+func (x *CreateTrustRootResponse) GetTrustRoot() *TrustRoot {
 	if x != nil {
-		return x.Profile
+		return x.TrustRoot
 	}
 	return nil
 }
 
-// get profile by name
-type GetProfileByNameRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the rule type is evaluated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// name is the name of the profile to get
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+type GetTrustRootByNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *ContextV2             `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileByNameRequest) Reset() {
-	*x = GetProfileByNameRequest{}
+func (x *GetTrustRootByNameRequest) Reset() {
+	*x = GetTrustRootByNameRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileByNameRequest) String() string {
+func (x *GetTrustRootByNameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileByNameRequest) ProtoMessage() {}
+func (*GetTrustRootByNameRequest) ProtoMessage() {}
 
-func (x *GetProfileByNameRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTrustRootByNameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5264,46 +5426,46 @@ func (x *GetProfileByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileByNameRequest.ProtoReflect.Descriptor instead.
-func (*GetProfileByNameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTrustRootByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetTrustRootByNameRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *GetProfileByNameRequest) GetContext() *Context {
+func (x *GetTrustRootByNameRequest) GetContext() *ContextV2 {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *GetProfileByNameRequest) GetName() string {
+func (x *GetTrustRootByNameRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type GetProfileByNameResponse struct {
+type GetTrustRootByNameResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	TrustRoot     *TrustRoot             `protobuf:"bytes,1,opt,name=trust_root,json=trustRoot,proto3" json:"trust_root,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileByNameResponse) Reset() {
-	*x = GetProfileByNameResponse{}
+func (x *GetTrustRootByNameResponse) Reset() {
+	*x = GetTrustRootByNameResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileByNameResponse) String() string {
+func (x *GetTrustRootByNameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileByNameResponse) ProtoMessage() {}
+func (*GetTrustRootByNameResponse) ProtoMessage() {}
 
-func (x *GetProfileByNameResponse) ProtoReflect() protoreflect.Message {
+func (x *GetTrustRootByNameResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5315,49 +5477,39 @@ func (x *GetProfileByNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileByNameResponse.ProtoReflect.Descriptor instead.
-func (*GetProfileByNameResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTrustRootByNameResponse.ProtoReflect.Descriptor instead.
+func (*GetTrustRootByNameResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *GetProfileByNameResponse) GetProfile() *Profile {
+func (x *GetTrustRootByNameResponse) GetTrustRoot() *TrustRoot {
 	if x != nil {
-		return x.Profile
+		return x.TrustRoot
 	}
 	return nil
 }
 
-// get the overall profile status as output
-type ProfileStatus struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// profile_id is the id of the profile.  One of profile_id or profile_name must be set.
-	ProfileId string `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
-	// profile_name is the name of the profile.  One of profile_id or profile_name must be set.
-	ProfileName string `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
-	// profile_status is the status of the profile
-	ProfileStatus string `protobuf:"bytes,3,opt,name=profile_status,json=profileStatus,proto3" json:"profile_status,omitempty"`
-	// last_updated is the last time the profile was updated
-	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
-	// profile_display_name is the display name of the profile
-	ProfileDisplayName string `protobuf:"bytes,5,opt,name=profile_display_name,json=profileDisplayName,proto3" json:"profile_display_name,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type ListTrustRootsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *ContextV2             `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProfileStatus) Reset() {
-	*x = ProfileStatus{}
+func (x *ListTrustRootsRequest) Reset() {
+	*x = ListTrustRootsRequest{}
 	mi := &file_minder_v1_minder_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProfileStatus) String() string {
+func (x *ListTrustRootsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProfileStatus) ProtoMessage() {}
+func (*ListTrustRootsRequest) ProtoMessage() {}
 
-func (x *ProfileStatus) ProtoReflect() protoreflect.Message {
+func (x *ListTrustRootsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5369,75 +5521,39 @@ func (x *ProfileStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProfileStatus.ProtoReflect.Descriptor instead.
-func (*ProfileStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTrustRootsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrustRootsRequest) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *ProfileStatus) GetProfileId() string {
-	if x != nil {
-		return x.ProfileId
-	}
-	return ""
-}
-
-func (x *ProfileStatus) GetProfileName() string {
-	if x != nil {
-		return x.ProfileName
-	}
-	return ""
-}
-
-func (x *ProfileStatus) GetProfileStatus() string {
-	if x != nil {
-		return x.ProfileStatus
-	}
-	return ""
-}
-
-func (x *ProfileStatus) GetLastUpdated() *timestamppb.Timestamp {
+func (x *ListTrustRootsRequest) GetContext() *ContextV2 {
 	if x != nil {
-		return x.LastUpdated
+		return x.Context
 	}
 	return nil
 }
 
-func (x *ProfileStatus) GetProfileDisplayName() string {
-	if x != nil {
-		return x.ProfileDisplayName
-	}
-	return ""
-}
-
-// EvalResultAlert holds the alert details for a given rule evaluation
-type EvalResultAlert struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// status is the status of the alert
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// last_updated is the last time the alert was performed or attempted
-	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
-	// details is the description of the alert attempt if any
-	Details string `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
-	// url is the URL to the alert
-	Url           string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+type ListTrustRootsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TrustRoots    []*TrustRoot           `protobuf:"bytes,1,rep,name=trust_roots,json=trustRoots,proto3" json:"trust_roots,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EvalResultAlert) Reset() {
-	*x = EvalResultAlert{}
+func (x *ListTrustRootsResponse) Reset() {
+	*x = ListTrustRootsResponse{}
 	mi := &file_minder_v1_minder_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EvalResultAlert) String() string {
+func (x *ListTrustRootsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EvalResultAlert) ProtoMessage() {}
+func (*ListTrustRootsResponse) ProtoMessage() {}
 
-func (x *EvalResultAlert) ProtoReflect() protoreflect.Message {
+func (x *ListTrustRootsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_minder_v1_minder_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5449,103 +5565,84 @@ func (x *EvalResultAlert) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EvalResultAlert.ProtoReflect.Descriptor instead.
-func (*EvalResultAlert) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTrustRootsResponse.ProtoReflect.Descriptor instead.
+func (*ListTrustRootsResponse) Descriptor() ([]byte, []int) {
 	return file_minder_v1_minder_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *EvalResultAlert) GetStatus() string {
+func (x *ListTrustRootsResponse) GetTrustRoots() []*TrustRoot {
 	if x != nil {
-		return x.Status
+		return x.TrustRoots
 	}
-	return ""
+	return nil
 }
 
-func (x *EvalResultAlert) GetLastUpdated() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastUpdated
-	}
-	return nil
+type UpdateTrustRootRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TrustRoot     *TrustRoot             `protobuf:"bytes,1,opt,name=trust_root,json=trustRoot,proto3" json:"trust_root,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EvalResultAlert) GetDetails() string {
+func (x *UpdateTrustRootRequest) Reset() {
+	*x = UpdateTrustRootRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTrustRootRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTrustRootRequest) ProtoMessage() {}
+
+func (x *UpdateTrustRootRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[86]
 	if x != nil {
-		return x.Details
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *EvalResultAlert) GetUrl() string {
+// Deprecated: Use UpdateTrustRootRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTrustRootRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *UpdateTrustRootRequest) GetTrustRoot() *TrustRoot {
 	if x != nil {
-		return x.Url
+		return x.TrustRoot
 	}
-	return ""
+	return nil
 }
 
-// get the status of the rules for a given profile
-type RuleEvaluationStatus struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// profile_id is the id of the profile
-	ProfileId string `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
-	// rule_id is the id of the rule
-	RuleId string `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
-	// rule_name is the type of the rule. Deprecated in favor of rule_type_name
-	//
-	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-	RuleName string `protobuf:"bytes,3,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
-	// entity is the entity that was evaluated
-	Entity string `protobuf:"bytes,4,opt,name=entity,proto3" json:"entity,omitempty"`
-	// status is the status of the evaluation
-	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	// last_updated is the last time the profile was updated
-	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
-	// entity_info is the information about the entity
-	EntityInfo map[string]string `protobuf:"bytes,7,rep,name=entity_info,json=entityInfo,proto3" json:"entity_info,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// details is the description of the evaluation if any
-	Details string `protobuf:"bytes,8,opt,name=details,proto3" json:"details,omitempty"`
-	// guidance is the guidance for the evaluation if any
-	Guidance string `protobuf:"bytes,9,opt,name=guidance,proto3" json:"guidance,omitempty"`
-	// remediation_status is the status of the remediation
-	RemediationStatus string `protobuf:"bytes,10,opt,name=remediation_status,json=remediationStatus,proto3" json:"remediation_status,omitempty"`
-	// remediation_last_updated is the last time the remediation was performed or attempted
-	RemediationLastUpdated *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=remediation_last_updated,json=remediationLastUpdated,proto3,oneof" json:"remediation_last_updated,omitempty"`
-	// remediation_details is the description of the remediation attempt if any
-	RemediationDetails string `protobuf:"bytes,12,opt,name=remediation_details,json=remediationDetails,proto3" json:"remediation_details,omitempty"`
-	// rule_type_name is the name of the rule
-	RuleTypeName string `protobuf:"bytes,13,opt,name=rule_type_name,json=ruleTypeName,proto3" json:"rule_type_name,omitempty"`
-	// rule_description_name is the name to describe the rule
-	RuleDescriptionName string `protobuf:"bytes,14,opt,name=rule_description_name,json=ruleDescriptionName,proto3" json:"rule_description_name,omitempty"`
-	// alert holds the alert details if the rule generated an alert in an external system
-	Alert *EvalResultAlert `protobuf:"bytes,15,opt,name=alert,proto3" json:"alert,omitempty"`
-	// severity is the severity of the rule. This may be empty.
-	Severity *Severity `protobuf:"bytes,16,opt,name=severity,proto3" json:"severity,omitempty"`
-	// rule_evaluation_id is the id of the rule evaluation
-	RuleEvaluationId string `protobuf:"bytes,17,opt,name=rule_evaluation_id,json=ruleEvaluationId,proto3" json:"rule_evaluation_id,omitempty"`
-	// remediation_url is a url to get more data about a remediation, for PRs is the link to the PR
-	RemediationUrl string `protobuf:"bytes,18,opt,name=remediation_url,json=remediationUrl,proto3" json:"remediation_url,omitempty"`
-	// rule_display_name captures the display name of the rule
-	RuleDisplayName string `protobuf:"bytes,19,opt,name=rule_display_name,json=ruleDisplayName,proto3" json:"rule_display_name,omitempty"`
-	// release_phase is the phase of the release
-	ReleasePhase  RuleTypeReleasePhase `protobuf:"varint,20,opt,name=release_phase,json=releasePhase,proto3,enum=minder.v1.RuleTypeReleasePhase" json:"release_phase,omitempty"`
+type UpdateTrustRootResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TrustRoot     *TrustRoot             `protobuf:"bytes,1,opt,name=trust_root,json=trustRoot,proto3" json:"trust_root,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleEvaluationStatus) Reset() {
-	*x = RuleEvaluationStatus{}
-	mi := &file_minder_v1_minder_proto_msgTypes[86]
+func (x *UpdateTrustRootResponse) Reset() {
+	*x = UpdateTrustRootResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleEvaluationStatus) String() string {
+func (x *UpdateTrustRootResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleEvaluationStatus) ProtoMessage() {}
+func (*UpdateTrustRootResponse) ProtoMessage() {}
 
-func (x *RuleEvaluationStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[86]
+func (x *UpdateTrustRootResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5556,181 +5653,180 @@ func (x *RuleEvaluationStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleEvaluationStatus.ProtoReflect.Descriptor instead.
-func (*RuleEvaluationStatus) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use UpdateTrustRootResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTrustRootResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *RuleEvaluationStatus) GetProfileId() string {
+func (x *UpdateTrustRootResponse) GetTrustRoot() *TrustRoot {
 	if x != nil {
-		return x.ProfileId
+		return x.TrustRoot
 	}
-	return ""
+	return nil
 }
 
-func (x *RuleEvaluationStatus) GetRuleId() string {
-	if x != nil {
-		return x.RuleId
-	}
-	return ""
+type DeleteTrustRootByNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *ContextV2             `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-func (x *RuleEvaluationStatus) GetRuleName() string {
-	if x != nil {
-		return x.RuleName
-	}
-	return ""
+func (x *DeleteTrustRootByNameRequest) Reset() {
+	*x = DeleteTrustRootByNameRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleEvaluationStatus) GetEntity() string {
-	if x != nil {
-		return x.Entity
-	}
-	return ""
+func (x *DeleteTrustRootByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RuleEvaluationStatus) GetStatus() string {
+func (*DeleteTrustRootByNameRequest) ProtoMessage() {}
+
+func (x *DeleteTrustRootByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[88]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RuleEvaluationStatus) GetLastUpdated() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastUpdated
-	}
-	return nil
+// Deprecated: Use DeleteTrustRootByNameRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTrustRootByNameRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *RuleEvaluationStatus) GetEntityInfo() map[string]string {
+func (x *DeleteTrustRootByNameRequest) GetContext() *ContextV2 {
 	if x != nil {
-		return x.EntityInfo
+		return x.Context
 	}
 	return nil
 }
 
-func (x *RuleEvaluationStatus) GetDetails() string {
+func (x *DeleteTrustRootByNameRequest) GetName() string {
 	if x != nil {
-		return x.Details
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RuleEvaluationStatus) GetGuidance() string {
-	if x != nil {
-		return x.Guidance
-	}
-	return ""
+type DeleteTrustRootByNameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleEvaluationStatus) GetRemediationStatus() string {
-	if x != nil {
-		return x.RemediationStatus
-	}
-	return ""
+func (x *DeleteTrustRootByNameResponse) Reset() {
+	*x = DeleteTrustRootByNameResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleEvaluationStatus) GetRemediationLastUpdated() *timestamppb.Timestamp {
-	if x != nil {
-		return x.RemediationLastUpdated
-	}
-	return nil
+func (x *DeleteTrustRootByNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RuleEvaluationStatus) GetRemediationDetails() string {
+func (*DeleteTrustRootByNameResponse) ProtoMessage() {}
+
+func (x *DeleteTrustRootByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[89]
 	if x != nil {
-		return x.RemediationDetails
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RuleEvaluationStatus) GetRuleTypeName() string {
+// Deprecated: Use DeleteTrustRootByNameResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTrustRootByNameResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DeleteTrustRootByNameResponse) GetName() string {
 	if x != nil {
-		return x.RuleTypeName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RuleEvaluationStatus) GetRuleDescriptionName() string {
-	if x != nil {
-		return x.RuleDescriptionName
-	}
-	return ""
+type ListBundlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *ContextV2             `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleEvaluationStatus) GetAlert() *EvalResultAlert {
-	if x != nil {
-		return x.Alert
-	}
-	return nil
+func (x *ListBundlesRequest) Reset() {
+	*x = ListBundlesRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleEvaluationStatus) GetSeverity() *Severity {
-	if x != nil {
-		return x.Severity
-	}
-	return nil
+func (x *ListBundlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RuleEvaluationStatus) GetRuleEvaluationId() string {
-	if x != nil {
-		return x.RuleEvaluationId
-	}
-	return ""
-}
+func (*ListBundlesRequest) ProtoMessage() {}
 
-func (x *RuleEvaluationStatus) GetRemediationUrl() string {
+func (x *ListBundlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[90]
 	if x != nil {
-		return x.RemediationUrl
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RuleEvaluationStatus) GetRuleDisplayName() string {
-	if x != nil {
-		return x.RuleDisplayName
-	}
-	return ""
+// Deprecated: Use ListBundlesRequest.ProtoReflect.Descriptor instead.
+func (*ListBundlesRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *RuleEvaluationStatus) GetReleasePhase() RuleTypeReleasePhase {
+func (x *ListBundlesRequest) GetContext() *ContextV2 {
 	if x != nil {
-		return x.ReleasePhase
+		return x.Context
 	}
-	return RuleTypeReleasePhase_RULE_TYPE_RELEASE_PHASE_UNSPECIFIED
+	return nil
 }
 
-// EntityTypedId is a message that carries an ID together with a type to uniquely identify an entity
-// such as (repo, 1), (artifact, 2), ...
-type EntityTypedId struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// entity is the entity to get status for. Incompatible with `all`
-	Type Entity `protobuf:"varint,1,opt,name=type,proto3,enum=minder.v1.Entity" json:"type,omitempty"`
-	// id is the ID of the entity to get status for. Incompatible with `all`
-	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	// name is the name of the entity.  This name is unique within a given project, type, and provider, but may not be globally unique.
-	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+type ListBundlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bundles       []*BundleInfo          `protobuf:"bytes,1,rep,name=bundles,proto3" json:"bundles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EntityTypedId) Reset() {
-	*x = EntityTypedId{}
-	mi := &file_minder_v1_minder_proto_msgTypes[87]
+func (x *ListBundlesResponse) Reset() {
+	*x = ListBundlesResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EntityTypedId) String() string {
+func (x *ListBundlesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityTypedId) ProtoMessage() {}
+func (*ListBundlesResponse) ProtoMessage() {}
 
-func (x *EntityTypedId) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[87]
+func (x *ListBundlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5741,73 +5837,86 @@ func (x *EntityTypedId) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntityTypedId.ProtoReflect.Descriptor instead.
-func (*EntityTypedId) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use ListBundlesResponse.ProtoReflect.Descriptor instead.
+func (*ListBundlesResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *EntityTypedId) GetType() Entity {
+func (x *ListBundlesResponse) GetBundles() []*BundleInfo {
 	if x != nil {
-		return x.Type
+		return x.Bundles
 	}
-	return Entity_ENTITY_UNSPECIFIED
+	return nil
 }
 
-func (x *EntityTypedId) GetId() string {
+type ListBundleSubscriptionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the project whose subscriptions are listed. The
+	// subscriptions of its child projects are listed as well.
+	Context       *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBundleSubscriptionsRequest) Reset() {
+	*x = ListBundleSubscriptionsRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBundleSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBundleSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListBundleSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[92]
 	if x != nil {
-		return x.Id
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *EntityTypedId) GetName() string {
+// Deprecated: Use ListBundleSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListBundleSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListBundleSubscriptionsRequest) GetContext() *ContextV2 {
 	if x != nil {
-		return x.Name
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-type GetProfileStatusByNameRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the rule type is evaluated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// name is the name of the profile to get
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// entity is the entity to get status for. Incompatible with `all`.
-	// This is optional.
-	Entity *EntityTypedId `protobuf:"bytes,3,opt,name=entity,proto3" json:"entity,omitempty"`
-	// all is true if the status of all entities should be returned.
-	// Incompatible with `entity`. This is optional.
-	All bool `protobuf:"varint,4,opt,name=all,proto3" json:"all,omitempty"`
-	// rule is the type of the rule. Deprecated in favor of rule_type
-	//
-	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-	Rule string `protobuf:"bytes,5,opt,name=rule,proto3" json:"rule,omitempty"`
-	// rule_type is the type of the rule to filter on.
-	// This is optional.
-	RuleType string `protobuf:"bytes,6,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
-	// rule_name is the name of the rule to filter on.
-	// This is optional.
-	RuleName      string `protobuf:"bytes,7,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+type ListBundleSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*BundleSubscription  `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileStatusByNameRequest) Reset() {
-	*x = GetProfileStatusByNameRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[88]
+func (x *ListBundleSubscriptionsResponse) Reset() {
+	*x = ListBundleSubscriptionsResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileStatusByNameRequest) String() string {
+func (x *ListBundleSubscriptionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileStatusByNameRequest) ProtoMessage() {}
+func (*ListBundleSubscriptionsResponse) ProtoMessage() {}
 
-func (x *GetProfileStatusByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[88]
+func (x *ListBundleSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5818,86 +5927,100 @@ func (x *GetProfileStatusByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileStatusByNameRequest.ProtoReflect.Descriptor instead.
-func (*GetProfileStatusByNameRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use ListBundleSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListBundleSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *GetProfileStatusByNameRequest) GetContext() *Context {
+func (x *ListBundleSubscriptionsResponse) GetSubscriptions() []*BundleSubscription {
 	if x != nil {
-		return x.Context
+		return x.Subscriptions
 	}
 	return nil
 }
 
-func (x *GetProfileStatusByNameRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type SubscribeBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *ContextV2             `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileStatusByNameRequest) GetEntity() *EntityTypedId {
-	if x != nil {
-		return x.Entity
-	}
-	return nil
+func (x *SubscribeBundleRequest) Reset() {
+	*x = SubscribeBundleRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileStatusByNameRequest) GetAll() bool {
+func (x *SubscribeBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeBundleRequest) ProtoMessage() {}
+
+func (x *SubscribeBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[94]
 	if x != nil {
-		return x.All
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-// Deprecated: Marked as deprecated in minder/v1/minder.proto.
-func (x *GetProfileStatusByNameRequest) GetRule() string {
+// Deprecated: Use SubscribeBundleRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeBundleRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SubscribeBundleRequest) GetContext() *ContextV2 {
 	if x != nil {
-		return x.Rule
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-func (x *GetProfileStatusByNameRequest) GetRuleType() string {
+func (x *SubscribeBundleRequest) GetNamespace() string {
 	if x != nil {
-		return x.RuleType
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *GetProfileStatusByNameRequest) GetRuleName() string {
+func (x *SubscribeBundleRequest) GetName() string {
 	if x != nil {
-		return x.RuleName
+		return x.Name
 	}
 	return ""
 }
 
-type GetProfileStatusByNameResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// profile_status is the status of the profile
-	ProfileStatus *ProfileStatus `protobuf:"bytes,1,opt,name=profile_status,json=profileStatus,proto3" json:"profile_status,omitempty"`
-	// rule_evaluation_status is the status of the rules
-	RuleEvaluationStatus []*RuleEvaluationStatus `protobuf:"bytes,2,rep,name=rule_evaluation_status,json=ruleEvaluationStatus,proto3" json:"rule_evaluation_status,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type SubscribeBundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *BundleSubscription    `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileStatusByNameResponse) Reset() {
-	*x = GetProfileStatusByNameResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[89]
+func (x *SubscribeBundleResponse) Reset() {
+	*x = SubscribeBundleResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileStatusByNameResponse) String() string {
+func (x *SubscribeBundleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileStatusByNameResponse) ProtoMessage() {}
+func (*SubscribeBundleResponse) ProtoMessage() {}
 
-func (x *GetProfileStatusByNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[89]
+func (x *SubscribeBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5908,54 +6031,42 @@ func (x *GetProfileStatusByNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileStatusByNameResponse.ProtoReflect.Descriptor instead.
-func (*GetProfileStatusByNameResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{89}
-}
-
-func (x *GetProfileStatusByNameResponse) GetProfileStatus() *ProfileStatus {
-	if x != nil {
-		return x.ProfileStatus
-	}
-	return nil
+// Deprecated: Use SubscribeBundleResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeBundleResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *GetProfileStatusByNameResponse) GetRuleEvaluationStatus() []*RuleEvaluationStatus {
+func (x *SubscribeBundleResponse) GetSubscription() *BundleSubscription {
 	if x != nil {
-		return x.RuleEvaluationStatus
+		return x.Subscription
 	}
 	return nil
 }
 
-type GetProfileStatusByIdRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the rule type is evaluated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// id is the id of the profile to get
-	Id            string         `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Entity        *EntityTypedId `protobuf:"bytes,3,opt,name=entity,proto3" json:"entity,omitempty"`
-	All           bool           `protobuf:"varint,4,opt,name=all,proto3" json:"all,omitempty"`
-	RuleType      string         `protobuf:"bytes,5,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
-	RuleName      string         `protobuf:"bytes,6,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+type UnsubscribeBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *ContextV2             `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileStatusByIdRequest) Reset() {
-	*x = GetProfileStatusByIdRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[90]
+func (x *UnsubscribeBundleRequest) Reset() {
+	*x = UnsubscribeBundleRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileStatusByIdRequest) String() string {
+func (x *UnsubscribeBundleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileStatusByIdRequest) ProtoMessage() {}
+func (*UnsubscribeBundleRequest) ProtoMessage() {}
 
-func (x *GetProfileStatusByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[90]
+func (x *UnsubscribeBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5966,78 +6077,98 @@ func (x *GetProfileStatusByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileStatusByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetProfileStatusByIdRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use UnsubscribeBundleRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeBundleRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *GetProfileStatusByIdRequest) GetContext() *Context {
+func (x *UnsubscribeBundleRequest) GetContext() *ContextV2 {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *GetProfileStatusByIdRequest) GetId() string {
+func (x *UnsubscribeBundleRequest) GetNamespace() string {
 	if x != nil {
-		return x.Id
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *GetProfileStatusByIdRequest) GetEntity() *EntityTypedId {
+func (x *UnsubscribeBundleRequest) GetName() string {
 	if x != nil {
-		return x.Entity
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *GetProfileStatusByIdRequest) GetAll() bool {
-	if x != nil {
-		return x.All
-	}
-	return false
+type UnsubscribeBundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileStatusByIdRequest) GetRuleType() string {
-	if x != nil {
-		return x.RuleType
-	}
-	return ""
+func (x *UnsubscribeBundleResponse) Reset() {
+	*x = UnsubscribeBundleResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileStatusByIdRequest) GetRuleName() string {
+func (x *UnsubscribeBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeBundleResponse) ProtoMessage() {}
+
+func (x *UnsubscribeBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[97]
 	if x != nil {
-		return x.RuleName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type GetProfileStatusByIdResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// profile_status is the status of the profile
-	ProfileStatus *ProfileStatus `protobuf:"bytes,1,opt,name=profile_status,json=profileStatus,proto3" json:"profile_status,omitempty"`
-	// rule_evaluation_status is the status of the rules
-	RuleEvaluationStatus []*RuleEvaluationStatus `protobuf:"bytes,2,rep,name=rule_evaluation_status,json=ruleEvaluationStatus,proto3" json:"rule_evaluation_status,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// Deprecated: Use UnsubscribeBundleResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeBundleResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *GetProfileStatusByIdResponse) Reset() {
-	*x = GetProfileStatusByIdResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[91]
+type UpgradeBundleRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Context   *ContextV2             `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Namespace string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// version is the bundle version to upgrade to. The latest available
+	// version is used if not set.
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// dry_run previews the upgrade, returning the changes it would make
+	// without applying them.
+	DryRun        bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpgradeBundleRequest) Reset() {
+	*x = UpgradeBundleRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileStatusByIdResponse) String() string {
+func (x *UpgradeBundleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileStatusByIdResponse) ProtoMessage() {}
+func (*UpgradeBundleRequest) ProtoMessage() {}
 
-func (x *GetProfileStatusByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[91]
+func (x *UpgradeBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6048,48 +6179,75 @@ func (x *GetProfileStatusByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileStatusByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetProfileStatusByIdResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use UpgradeBundleRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeBundleRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *GetProfileStatusByIdResponse) GetProfileStatus() *ProfileStatus {
+func (x *UpgradeBundleRequest) GetContext() *ContextV2 {
 	if x != nil {
-		return x.ProfileStatus
+		return x.Context
 	}
 	return nil
 }
 
-func (x *GetProfileStatusByIdResponse) GetRuleEvaluationStatus() []*RuleEvaluationStatus {
+func (x *UpgradeBundleRequest) GetNamespace() string {
 	if x != nil {
-		return x.RuleEvaluationStatus
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-type GetProfileStatusByProjectRequest struct {
+func (x *UpgradeBundleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpgradeBundleRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *UpgradeBundleRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type UpgradeBundleResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the rule type is evaluated.
-	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// from_version is the version the project was subscribed to
+	FromVersion string `protobuf:"bytes,1,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	// to_version is the version the project is upgraded to
+	ToVersion string `protobuf:"bytes,2,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	// diff lists the changes the upgrade makes to the project
+	Diff *BundleDiff `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
+	// applied is true if the upgrade was applied, false for a dry run
+	Applied       bool `protobuf:"varint,4,opt,name=applied,proto3" json:"applied,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileStatusByProjectRequest) Reset() {
-	*x = GetProfileStatusByProjectRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[92]
+func (x *UpgradeBundleResponse) Reset() {
+	*x = UpgradeBundleResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileStatusByProjectRequest) String() string {
+func (x *UpgradeBundleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileStatusByProjectRequest) ProtoMessage() {}
+func (*UpgradeBundleResponse) ProtoMessage() {}
 
-func (x *GetProfileStatusByProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[92]
+func (x *UpgradeBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6100,41 +6258,62 @@ func (x *GetProfileStatusByProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileStatusByProjectRequest.ProtoReflect.Descriptor instead.
-func (*GetProfileStatusByProjectRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use UpgradeBundleResponse.ProtoReflect.Descriptor instead.
+func (*UpgradeBundleResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *GetProfileStatusByProjectRequest) GetContext() *Context {
+func (x *UpgradeBundleResponse) GetFromVersion() string {
 	if x != nil {
-		return x.Context
+		return x.FromVersion
+	}
+	return ""
+}
+
+func (x *UpgradeBundleResponse) GetToVersion() string {
+	if x != nil {
+		return x.ToVersion
+	}
+	return ""
+}
+
+func (x *UpgradeBundleResponse) GetDiff() *BundleDiff {
+	if x != nil {
+		return x.Diff
 	}
 	return nil
 }
 
-type GetProfileStatusByProjectResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// profile_status is the status of the profile
-	ProfileStatus []*ProfileStatus `protobuf:"bytes,1,rep,name=profile_status,json=profileStatus,proto3" json:"profile_status,omitempty"`
+func (x *UpgradeBundleResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+// Profile service
+type CreateProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProfileStatusByProjectResponse) Reset() {
-	*x = GetProfileStatusByProjectResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[93]
+func (x *CreateProfileRequest) Reset() {
+	*x = CreateProfileRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProfileStatusByProjectResponse) String() string {
+func (x *CreateProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProfileStatusByProjectResponse) ProtoMessage() {}
+func (*CreateProfileRequest) ProtoMessage() {}
 
-func (x *GetProfileStatusByProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[93]
+func (x *CreateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6145,40 +6324,40 @@ func (x *GetProfileStatusByProjectResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProfileStatusByProjectResponse.ProtoReflect.Descriptor instead.
-func (*GetProfileStatusByProjectResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use CreateProfileRequest.ProtoReflect.Descriptor instead.
+func (*CreateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *GetProfileStatusByProjectResponse) GetProfileStatus() []*ProfileStatus {
+func (x *CreateProfileRequest) GetProfile() *Profile {
 	if x != nil {
-		return x.ProfileStatus
+		return x.Profile
 	}
 	return nil
 }
 
-type EntityAutoRegistrationConfig struct {
+type CreateProfileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enabled       *bool                  `protobuf:"varint,1,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EntityAutoRegistrationConfig) Reset() {
-	*x = EntityAutoRegistrationConfig{}
-	mi := &file_minder_v1_minder_proto_msgTypes[94]
+func (x *CreateProfileResponse) Reset() {
+	*x = CreateProfileResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EntityAutoRegistrationConfig) String() string {
+func (x *CreateProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityAutoRegistrationConfig) ProtoMessage() {}
+func (*CreateProfileResponse) ProtoMessage() {}
 
-func (x *EntityAutoRegistrationConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[94]
+func (x *CreateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6189,44 +6368,40 @@ func (x *EntityAutoRegistrationConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntityAutoRegistrationConfig.ProtoReflect.Descriptor instead.
-func (*EntityAutoRegistrationConfig) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use CreateProfileResponse.ProtoReflect.Descriptor instead.
+func (*CreateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *EntityAutoRegistrationConfig) GetEnabled() bool {
-	if x != nil && x.Enabled != nil {
-		return *x.Enabled
+func (x *CreateProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
 	}
-	return false
+	return nil
 }
 
-// AutoRegistration is the configuration for auto-registering entities.
-// When nothing is set, it means that auto-registration is disabled. There is no difference between disabled
-// and undefined so for the "let's not auto-register anything" case we'd just let the repeated string empty
-type AutoRegistration struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// enabled is the list of entities that are enabled for auto-registration.
-	Entities      map[string]*EntityAutoRegistrationConfig `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+type UpdateProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AutoRegistration) Reset() {
-	*x = AutoRegistration{}
-	mi := &file_minder_v1_minder_proto_msgTypes[95]
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AutoRegistration) String() string {
+func (x *UpdateProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AutoRegistration) ProtoMessage() {}
+func (*UpdateProfileRequest) ProtoMessage() {}
 
-func (x *AutoRegistration) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[95]
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6237,42 +6412,40 @@ func (x *AutoRegistration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AutoRegistration.ProtoReflect.Descriptor instead.
-func (*AutoRegistration) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *AutoRegistration) GetEntities() map[string]*EntityAutoRegistrationConfig {
+func (x *UpdateProfileRequest) GetProfile() *Profile {
 	if x != nil {
-		return x.Entities
+		return x.Profile
 	}
 	return nil
 }
 
-// ProviderConfig contains the generic configuration for a provider.
-type ProviderConfig struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// auto_registration is the configuration for auto-registering entities.
-	AutoRegistration *AutoRegistration `protobuf:"bytes,1,opt,name=auto_registration,json=autoRegistration,proto3,oneof" json:"auto_registration,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProviderConfig) Reset() {
-	*x = ProviderConfig{}
-	mi := &file_minder_v1_minder_proto_msgTypes[96]
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProviderConfig) String() string {
+func (x *UpdateProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderConfig) ProtoMessage() {}
+func (*UpdateProfileResponse) ProtoMessage() {}
 
-func (x *ProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[96]
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6283,42 +6456,51 @@ func (x *ProviderConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderConfig.ProtoReflect.Descriptor instead.
-func (*ProviderConfig) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *ProviderConfig) GetAutoRegistration() *AutoRegistration {
+func (x *UpdateProfileResponse) GetProfile() *Profile {
 	if x != nil {
-		return x.AutoRegistration
+		return x.Profile
 	}
 	return nil
 }
 
-// RESTProviderConfig contains the configuration for the REST provider.
-type RESTProviderConfig struct {
+type PatchProfileRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// base_url is the base URL for the REST provider.
-	BaseUrl       *string `protobuf:"bytes,1,opt,name=base_url,json=baseUrl,proto3,oneof" json:"base_url,omitempty"`
+	// The context in which the patch is applied. Provided explicitly
+	// so that the patch itself can be minimal and contain only
+	// the attribute to set, e.g. remediate=true
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// The id or name of the profile to patch. Same explanation about explicitness
+	// as for the context
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The patch to apply to the profile
+	Patch *Profile `protobuf:"bytes,3,opt,name=patch,proto3" json:"patch,omitempty"`
+	// needed to enable PATCH, see https://grpc-ecosystem.github.io/grpc-gateway/docs/mapping/patch_feature/
+	// is not exposed to the API user
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RESTProviderConfig) Reset() {
-	*x = RESTProviderConfig{}
-	mi := &file_minder_v1_minder_proto_msgTypes[97]
+func (x *PatchProfileRequest) Reset() {
+	*x = PatchProfileRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RESTProviderConfig) String() string {
+func (x *PatchProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RESTProviderConfig) ProtoMessage() {}
+func (*PatchProfileRequest) ProtoMessage() {}
 
-func (x *RESTProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[97]
+func (x *PatchProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6329,94 +6511,61 @@ func (x *RESTProviderConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RESTProviderConfig.ProtoReflect.Descriptor instead.
-func (*RESTProviderConfig) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use PatchProfileRequest.ProtoReflect.Descriptor instead.
+func (*PatchProfileRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *RESTProviderConfig) GetBaseUrl() string {
-	if x != nil && x.BaseUrl != nil {
-		return *x.BaseUrl
+func (x *PatchProfileRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
 	}
-	return ""
-}
-
-// GitHubProviderConfig contains the configuration for the GitHub client
-//
-// Endpoint: is the GitHub API endpoint
-//
-// If using the public GitHub API, Endpoint can be left blank
-// disable revive linting for this struct as there is nothing wrong with the
-// naming convention
-type GitHubProviderConfig struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Endpoint is the GitHub API endpoint. If using the public GitHub API, Endpoint can be left blank.
-	Endpoint      *string `protobuf:"bytes,1,opt,name=endpoint,proto3,oneof" json:"endpoint,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GitHubProviderConfig) Reset() {
-	*x = GitHubProviderConfig{}
-	mi := &file_minder_v1_minder_proto_msgTypes[98]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GitHubProviderConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*GitHubProviderConfig) ProtoMessage() {}
-
-func (x *GitHubProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[98]
+func (x *PatchProfileRequest) GetId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Id
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GitHubProviderConfig.ProtoReflect.Descriptor instead.
-func (*GitHubProviderConfig) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{98}
+func (x *PatchProfileRequest) GetPatch() *Profile {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
 }
 
-func (x *GitHubProviderConfig) GetEndpoint() string {
-	if x != nil && x.Endpoint != nil {
-		return *x.Endpoint
+func (x *PatchProfileRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
 	}
-	return ""
+	return nil
 }
 
-// GitHubAppProviderConfig contains the configuration for the GitHub App provider
-type GitHubAppProviderConfig struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Endpoint is the GitHub API endpoint. If using the public GitHub API, Endpoint can be left blank.
-	Endpoint      *string `protobuf:"bytes,1,opt,name=endpoint,proto3,oneof" json:"endpoint,omitempty"`
+type PatchProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GitHubAppProviderConfig) Reset() {
-	*x = GitHubAppProviderConfig{}
-	mi := &file_minder_v1_minder_proto_msgTypes[99]
+func (x *PatchProfileResponse) Reset() {
+	*x = PatchProfileResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GitHubAppProviderConfig) String() string {
+func (x *PatchProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitHubAppProviderConfig) ProtoMessage() {}
+func (*PatchProfileResponse) ProtoMessage() {}
 
-func (x *GitHubAppProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[99]
+func (x *PatchProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6427,48 +6576,43 @@ func (x *GitHubAppProviderConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitHubAppProviderConfig.ProtoReflect.Descriptor instead.
-func (*GitHubAppProviderConfig) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use PatchProfileResponse.ProtoReflect.Descriptor instead.
+func (*PatchProfileResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *GitHubAppProviderConfig) GetEndpoint() string {
-	if x != nil && x.Endpoint != nil {
-		return *x.Endpoint
+func (x *PatchProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
 	}
-	return ""
+	return nil
 }
 
-// GitLabProviderConfig contains the configuration for the GitLab provider.
-//
-// Endpoint: is the GitLab API endpoint
-//
-// If using the public GitLab API, Endpoint can be left blank
-type GitLabProviderConfig struct {
+type DeleteProfileRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Endpoint is the GitLab API endpoint. If using the public GitLab API, Endpoint can be left blank.
-	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	// group is the GitLab group to use for the provider
-	Group         string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	// context is the context in which the rule type is evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// id is the name or id of the profile to delete
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GitLabProviderConfig) Reset() {
-	*x = GitLabProviderConfig{}
-	mi := &file_minder_v1_minder_proto_msgTypes[100]
+func (x *DeleteProfileRequest) Reset() {
+	*x = DeleteProfileRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GitLabProviderConfig) String() string {
+func (x *DeleteProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitLabProviderConfig) ProtoMessage() {}
+func (*DeleteProfileRequest) ProtoMessage() {}
 
-func (x *GitLabProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[100]
+func (x *DeleteProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6479,51 +6623,46 @@ func (x *GitLabProviderConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitLabProviderConfig.ProtoReflect.Descriptor instead.
-func (*GitLabProviderConfig) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use DeleteProfileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProfileRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *GitLabProviderConfig) GetEndpoint() string {
+func (x *DeleteProfileRequest) GetContext() *Context {
 	if x != nil {
-		return x.Endpoint
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-func (x *GitLabProviderConfig) GetGroup() string {
+func (x *DeleteProfileRequest) GetId() string {
 	if x != nil {
-		return x.Group
+		return x.Id
 	}
 	return ""
 }
 
-// DockerHubProviderConfig contains the configuration for the DockerHub provider.
-//
-// Namespace: is the namespace for the DockerHub provider.
-type DockerHubProviderConfig struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// namespace is the namespace for the DockerHub provider.
-	Namespace     *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+type DeleteProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DockerHubProviderConfig) Reset() {
-	*x = DockerHubProviderConfig{}
-	mi := &file_minder_v1_minder_proto_msgTypes[101]
+func (x *DeleteProfileResponse) Reset() {
+	*x = DeleteProfileResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DockerHubProviderConfig) String() string {
+func (x *DeleteProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DockerHubProviderConfig) ProtoMessage() {}
+func (*DeleteProfileResponse) ProtoMessage() {}
 
-func (x *DockerHubProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[101]
+func (x *DeleteProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6534,44 +6673,41 @@ func (x *DockerHubProviderConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DockerHubProviderConfig.ProtoReflect.Descriptor instead.
-func (*DockerHubProviderConfig) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{101}
-}
-
-func (x *DockerHubProviderConfig) GetNamespace() string {
-	if x != nil && x.Namespace != nil {
-		return *x.Namespace
-	}
-	return ""
+// Deprecated: Use DeleteProfileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProfileResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{107}
 }
 
-// GHCRProviderConfig contains the configuration for the GHCR provider.
-//
-// Namespace: is the namespace for the GHCR provider.
-type GHCRProviderConfig struct {
+// list profiles
+type ListProfilesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// namespace is the namespace for the GHCR provider.
-	Namespace     *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	// context is the context which contains the profiles
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// Filter profiles to only those matching the specified labels.
+	//
+	// The default is to return all user-created profiles; the string "*" can
+	// be used to select all profiles, including system profiles.  This syntax
+	// may be expanded in the future.
+	LabelFilter   string `protobuf:"bytes,2,opt,name=label_filter,json=labelFilter,proto3" json:"label_filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GHCRProviderConfig) Reset() {
-	*x = GHCRProviderConfig{}
-	mi := &file_minder_v1_minder_proto_msgTypes[102]
+func (x *ListProfilesRequest) Reset() {
+	*x = ListProfilesRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GHCRProviderConfig) String() string {
+func (x *ListProfilesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GHCRProviderConfig) ProtoMessage() {}
+func (*ListProfilesRequest) ProtoMessage() {}
 
-func (x *GHCRProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[102]
+func (x *ListProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6582,114 +6718,47 @@ func (x *GHCRProviderConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GHCRProviderConfig.ProtoReflect.Descriptor instead.
-func (*GHCRProviderConfig) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use ListProfilesRequest.ProtoReflect.Descriptor instead.
+func (*ListProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *GHCRProviderConfig) GetNamespace() string {
-	if x != nil && x.Namespace != nil {
-		return *x.Namespace
+func (x *ListProfilesRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-// Context defines the context in which a rule is evaluated.
-// this normally refers to a combination of the provider, organization and project.
-type Context struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// name of the provider
-	// This is optional, but some existing clients may set the field unconditionally,
-	// so an empty string is also an allowed value.
-	Provider *string `protobuf:"bytes,1,opt,name=provider,proto3,oneof" json:"provider,omitempty"`
-	// ID or name of the project.  If empty or unset, will select the user's default
-	// project if they only have one project.  Existing clients may unconditionally set
-	// this to the empty string rather than leaving this unset, so we allow "" as an
-	// alias for unset.
-	Project             *string `protobuf:"bytes,3,opt,name=project,proto3,oneof" json:"project,omitempty"`
-	RetiredOrganization *string `protobuf:"bytes,2,opt,name=retired_organization,json=retiredOrganization,proto3,oneof" json:"retired_organization,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
-}
-
-func (x *Context) Reset() {
-	*x = Context{}
-	mi := &file_minder_v1_minder_proto_msgTypes[103]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Context) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Context) ProtoMessage() {}
-
-func (x *Context) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[103]
+func (x *ListProfilesRequest) GetLabelFilter() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Context.ProtoReflect.Descriptor instead.
-func (*Context) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{103}
-}
-
-func (x *Context) GetProvider() string {
-	if x != nil && x.Provider != nil {
-		return *x.Provider
-	}
-	return ""
-}
-
-func (x *Context) GetProject() string {
-	if x != nil && x.Project != nil {
-		return *x.Project
-	}
-	return ""
-}
-
-func (x *Context) GetRetiredOrganization() string {
-	if x != nil && x.RetiredOrganization != nil {
-		return *x.RetiredOrganization
+		return x.LabelFilter
 	}
 	return ""
 }
 
-// ContextV2 defines the context in which a rule is evaluated.
-type ContextV2 struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// project is the project ID or name.  If empty or unset, will select the user's
-	// default project if they only have one project.
-	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	// name of the provider. Set to empty string when not applicable.
-	Provider      string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+type ListProfilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profiles      []*Profile             `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContextV2) Reset() {
-	*x = ContextV2{}
-	mi := &file_minder_v1_minder_proto_msgTypes[104]
+func (x *ListProfilesResponse) Reset() {
+	*x = ListProfilesResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContextV2) String() string {
+func (x *ListProfilesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContextV2) ProtoMessage() {}
+func (*ListProfilesResponse) ProtoMessage() {}
 
-func (x *ContextV2) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[104]
+func (x *ListProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6700,49 +6769,44 @@ func (x *ContextV2) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContextV2.ProtoReflect.Descriptor instead.
-func (*ContextV2) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{104}
-}
-
-func (x *ContextV2) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
+// Deprecated: Use ListProfilesResponse.ProtoReflect.Descriptor instead.
+func (*ListProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *ContextV2) GetProvider() string {
+func (x *ListProfilesResponse) GetProfiles() []*Profile {
 	if x != nil {
-		return x.Provider
+		return x.Profiles
 	}
-	return ""
+	return nil
 }
 
-// ListRuleTypesRequest is the request to list rule types.
-type ListRuleTypesRequest struct {
+// get profile by id
+type GetProfileByIdRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the rule types are evaluated.
-	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// context is the context which contains the profiles
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// id is the id of the profile to get
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRuleTypesRequest) Reset() {
-	*x = ListRuleTypesRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[105]
+func (x *GetProfileByIdRequest) Reset() {
+	*x = GetProfileByIdRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRuleTypesRequest) String() string {
+func (x *GetProfileByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRuleTypesRequest) ProtoMessage() {}
+func (*GetProfileByIdRequest) ProtoMessage() {}
 
-func (x *ListRuleTypesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[105]
+func (x *GetProfileByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6753,42 +6817,47 @@ func (x *ListRuleTypesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRuleTypesRequest.ProtoReflect.Descriptor instead.
-func (*ListRuleTypesRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use GetProfileByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileByIdRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *ListRuleTypesRequest) GetContext() *Context {
+func (x *GetProfileByIdRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-// ListRuleTypesResponse is the response to list rule types.
-type ListRuleTypesResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// rule_types is the list of rule types.
-	RuleTypes     []*RuleType `protobuf:"bytes,1,rep,name=rule_types,json=ruleTypes,proto3" json:"rule_types,omitempty"`
+func (x *GetProfileByIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetProfileByIdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRuleTypesResponse) Reset() {
-	*x = ListRuleTypesResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[106]
+func (x *GetProfileByIdResponse) Reset() {
+	*x = GetProfileByIdResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRuleTypesResponse) String() string {
+func (x *GetProfileByIdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRuleTypesResponse) ProtoMessage() {}
+func (*GetProfileByIdResponse) ProtoMessage() {}
 
-func (x *ListRuleTypesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[106]
+func (x *GetProfileByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6799,44 +6868,44 @@ func (x *ListRuleTypesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRuleTypesResponse.ProtoReflect.Descriptor instead.
-func (*ListRuleTypesResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use GetProfileByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileByIdResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *ListRuleTypesResponse) GetRuleTypes() []*RuleType {
+func (x *GetProfileByIdResponse) GetProfile() *Profile {
 	if x != nil {
-		return x.RuleTypes
+		return x.Profile
 	}
 	return nil
 }
 
-// GetRuleTypeByNameRequest is the request to get a rule type by name.
-type GetRuleTypeByNameRequest struct {
+// get profile by name
+type GetProfileByNameRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// context is the context in which the rule type is evaluated.
 	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// name is the name of the rule type.
+	// name is the name of the profile to get
 	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRuleTypeByNameRequest) Reset() {
-	*x = GetRuleTypeByNameRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[107]
+func (x *GetProfileByNameRequest) Reset() {
+	*x = GetProfileByNameRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRuleTypeByNameRequest) String() string {
+func (x *GetProfileByNameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRuleTypeByNameRequest) ProtoMessage() {}
+func (*GetProfileByNameRequest) ProtoMessage() {}
 
-func (x *GetRuleTypeByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[107]
+func (x *GetProfileByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6847,49 +6916,47 @@ func (x *GetRuleTypeByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRuleTypeByNameRequest.ProtoReflect.Descriptor instead.
-func (*GetRuleTypeByNameRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use GetProfileByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileByNameRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *GetRuleTypeByNameRequest) GetContext() *Context {
+func (x *GetProfileByNameRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *GetRuleTypeByNameRequest) GetName() string {
+func (x *GetProfileByNameRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-// GetRuleTypeByNameResponse is the response to get a rule type by name.
-type GetRuleTypeByNameResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// rule_type is the rule type.
-	RuleType      *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+type GetProfileByNameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRuleTypeByNameResponse) Reset() {
-	*x = GetRuleTypeByNameResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[108]
+func (x *GetProfileByNameResponse) Reset() {
+	*x = GetProfileByNameResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRuleTypeByNameResponse) String() string {
+func (x *GetProfileByNameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRuleTypeByNameResponse) ProtoMessage() {}
+func (*GetProfileByNameResponse) ProtoMessage() {}
 
-func (x *GetRuleTypeByNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[108]
+func (x *GetProfileByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6900,44 +6967,50 @@ func (x *GetRuleTypeByNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRuleTypeByNameResponse.ProtoReflect.Descriptor instead.
-func (*GetRuleTypeByNameResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{108}
+// Deprecated: Use GetProfileByNameResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileByNameResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *GetRuleTypeByNameResponse) GetRuleType() *RuleType {
+func (x *GetProfileByNameResponse) GetProfile() *Profile {
 	if x != nil {
-		return x.RuleType
+		return x.Profile
 	}
 	return nil
 }
 
-// GetRuleTypeByIdRequest is the request to get a rule type by id.
-type GetRuleTypeByIdRequest struct {
+// get the overall profile status as output
+type ProfileStatus struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the rule type is evaluated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// id is the id of the rule type.
-	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetRuleTypeByIdRequest) Reset() {
-	*x = GetRuleTypeByIdRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[109]
+	// profile_id is the id of the profile.  One of profile_id or profile_name must be set.
+	ProfileId string `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	// profile_name is the name of the profile.  One of profile_id or profile_name must be set.
+	ProfileName string `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	// profile_status is the status of the profile
+	ProfileStatus string `protobuf:"bytes,3,opt,name=profile_status,json=profileStatus,proto3" json:"profile_status,omitempty"`
+	// last_updated is the last time the profile was updated
+	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	// profile_display_name is the display name of the profile
+	ProfileDisplayName string `protobuf:"bytes,5,opt,name=profile_display_name,json=profileDisplayName,proto3" json:"profile_display_name,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ProfileStatus) Reset() {
+	*x = ProfileStatus{}
+	mi := &file_minder_v1_minder_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRuleTypeByIdRequest) String() string {
+func (x *ProfileStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRuleTypeByIdRequest) ProtoMessage() {}
+func (*ProfileStatus) ProtoMessage() {}
 
-func (x *GetRuleTypeByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[109]
+func (x *ProfileStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6948,95 +7021,76 @@ func (x *GetRuleTypeByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRuleTypeByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetRuleTypeByIdRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{109}
+// Deprecated: Use ProfileStatus.ProtoReflect.Descriptor instead.
+func (*ProfileStatus) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *GetRuleTypeByIdRequest) GetContext() *Context {
+func (x *ProfileStatus) GetProfileId() string {
 	if x != nil {
-		return x.Context
+		return x.ProfileId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetRuleTypeByIdRequest) GetId() string {
+func (x *ProfileStatus) GetProfileName() string {
 	if x != nil {
-		return x.Id
+		return x.ProfileName
 	}
 	return ""
 }
 
-// GetRuleTypeByIdResponse is the response to get a rule type by id.
-type GetRuleTypeByIdResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// rule_type is the rule type.
-	RuleType      *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetRuleTypeByIdResponse) Reset() {
-	*x = GetRuleTypeByIdResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[110]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetRuleTypeByIdResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetRuleTypeByIdResponse) ProtoMessage() {}
-
-func (x *GetRuleTypeByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[110]
+func (x *ProfileStatus) GetProfileStatus() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ProfileStatus
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetRuleTypeByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetRuleTypeByIdResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{110}
+func (x *ProfileStatus) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
 }
 
-func (x *GetRuleTypeByIdResponse) GetRuleType() *RuleType {
+func (x *ProfileStatus) GetProfileDisplayName() string {
 	if x != nil {
-		return x.RuleType
+		return x.ProfileDisplayName
 	}
-	return nil
+	return ""
 }
 
-// CreateRuleTypeRequest is the request to create a rule type.
-type CreateRuleTypeRequest struct {
+// EvalResultAlert holds the alert details for a given rule evaluation
+type EvalResultAlert struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// rule_type is the rule type to be created.
-	RuleType      *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	// status is the status of the alert
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// last_updated is the last time the alert was performed or attempted
+	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	// details is the description of the alert attempt if any
+	Details string `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	// url is the URL to the alert
+	Url           string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRuleTypeRequest) Reset() {
-	*x = CreateRuleTypeRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[111]
+func (x *EvalResultAlert) Reset() {
+	*x = EvalResultAlert{}
+	mi := &file_minder_v1_minder_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRuleTypeRequest) String() string {
+func (x *EvalResultAlert) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRuleTypeRequest) ProtoMessage() {}
+func (*EvalResultAlert) ProtoMessage() {}
 
-func (x *CreateRuleTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[111]
+func (x *EvalResultAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7047,88 +7101,103 @@ func (x *CreateRuleTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRuleTypeRequest.ProtoReflect.Descriptor instead.
-func (*CreateRuleTypeRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{111}
+// Deprecated: Use EvalResultAlert.ProtoReflect.Descriptor instead.
+func (*EvalResultAlert) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *CreateRuleTypeRequest) GetRuleType() *RuleType {
+func (x *EvalResultAlert) GetStatus() string {
 	if x != nil {
-		return x.RuleType
+		return x.Status
 	}
-	return nil
-}
-
-// CreateRuleTypeResponse is the response to create a rule type.
-type CreateRuleTypeResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// rule_type is the rule type that was created.
-	RuleType      *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateRuleTypeResponse) Reset() {
-	*x = CreateRuleTypeResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[112]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateRuleTypeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*CreateRuleTypeResponse) ProtoMessage() {}
-
-func (x *CreateRuleTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[112]
+func (x *EvalResultAlert) GetLastUpdated() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LastUpdated
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CreateRuleTypeResponse.ProtoReflect.Descriptor instead.
-func (*CreateRuleTypeResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{112}
+func (x *EvalResultAlert) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
 }
 
-func (x *CreateRuleTypeResponse) GetRuleType() *RuleType {
+func (x *EvalResultAlert) GetUrl() string {
 	if x != nil {
-		return x.RuleType
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-// UpdateRuleTypeRequest is the request to update a rule type.
-type UpdateRuleTypeRequest struct {
+// get the status of the rules for a given profile
+type RuleEvaluationStatus struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// rule_type is the rule type to be updated.
-	RuleType      *RuleType `protobuf:"bytes,2,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	// profile_id is the id of the profile
+	ProfileId string `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	// rule_id is the id of the rule
+	RuleId string `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	// rule_name is the type of the rule. Deprecated in favor of rule_type_name
+	//
+	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+	RuleName string `protobuf:"bytes,3,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	// entity is the entity that was evaluated
+	Entity string `protobuf:"bytes,4,opt,name=entity,proto3" json:"entity,omitempty"`
+	// status is the status of the evaluation
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// last_updated is the last time the profile was updated
+	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	// entity_info is the information about the entity
+	EntityInfo map[string]string `protobuf:"bytes,7,rep,name=entity_info,json=entityInfo,proto3" json:"entity_info,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// details is the description of the evaluation if any
+	Details string `protobuf:"bytes,8,opt,name=details,proto3" json:"details,omitempty"`
+	// guidance is the guidance for the evaluation if any
+	Guidance string `protobuf:"bytes,9,opt,name=guidance,proto3" json:"guidance,omitempty"`
+	// remediation_status is the status of the remediation
+	RemediationStatus string `protobuf:"bytes,10,opt,name=remediation_status,json=remediationStatus,proto3" json:"remediation_status,omitempty"`
+	// remediation_last_updated is the last time the remediation was performed or attempted
+	RemediationLastUpdated *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=remediation_last_updated,json=remediationLastUpdated,proto3,oneof" json:"remediation_last_updated,omitempty"`
+	// remediation_details is the description of the remediation attempt if any
+	RemediationDetails string `protobuf:"bytes,12,opt,name=remediation_details,json=remediationDetails,proto3" json:"remediation_details,omitempty"`
+	// rule_type_name is the name of the rule
+	RuleTypeName string `protobuf:"bytes,13,opt,name=rule_type_name,json=ruleTypeName,proto3" json:"rule_type_name,omitempty"`
+	// rule_description_name is the name to describe the rule
+	RuleDescriptionName string `protobuf:"bytes,14,opt,name=rule_description_name,json=ruleDescriptionName,proto3" json:"rule_description_name,omitempty"`
+	// alert holds the alert details if the rule generated an alert in an external system
+	Alert *EvalResultAlert `protobuf:"bytes,15,opt,name=alert,proto3" json:"alert,omitempty"`
+	// severity is the severity of the rule. This may be empty.
+	Severity *Severity `protobuf:"bytes,16,opt,name=severity,proto3" json:"severity,omitempty"`
+	// rule_evaluation_id is the id of the rule evaluation
+	RuleEvaluationId string `protobuf:"bytes,17,opt,name=rule_evaluation_id,json=ruleEvaluationId,proto3" json:"rule_evaluation_id,omitempty"`
+	// remediation_url is a url to get more data about a remediation, for PRs is the link to the PR
+	RemediationUrl string `protobuf:"bytes,18,opt,name=remediation_url,json=remediationUrl,proto3" json:"remediation_url,omitempty"`
+	// rule_display_name captures the display name of the rule
+	RuleDisplayName string `protobuf:"bytes,19,opt,name=rule_display_name,json=ruleDisplayName,proto3" json:"rule_display_name,omitempty"`
+	// release_phase is the phase of the release
+	ReleasePhase  RuleTypeReleasePhase `protobuf:"varint,20,opt,name=release_phase,json=releasePhase,proto3,enum=minder.v1.RuleTypeReleasePhase" json:"release_phase,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRuleTypeRequest) Reset() {
-	*x = UpdateRuleTypeRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[113]
+func (x *RuleEvaluationStatus) Reset() {
+	*x = RuleEvaluationStatus{}
+	mi := &file_minder_v1_minder_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRuleTypeRequest) String() string {
+func (x *RuleEvaluationStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRuleTypeRequest) ProtoMessage() {}
+func (*RuleEvaluationStatus) ProtoMessage() {}
 
-func (x *UpdateRuleTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[113]
+func (x *RuleEvaluationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7139,42 +7208,3578 @@ func (x *UpdateRuleTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRuleTypeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRuleTypeRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{113}
+// Deprecated: Use RuleEvaluationStatus.ProtoReflect.Descriptor instead.
+func (*RuleEvaluationStatus) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *UpdateRuleTypeRequest) GetRuleType() *RuleType {
+func (x *RuleEvaluationStatus) GetProfileId() string {
 	if x != nil {
-		return x.RuleType
+		return x.ProfileId
 	}
-	return nil
+	return ""
 }
 
-// UpdateRuleTypeResponse is the response to update a rule type.
-type UpdateRuleTypeResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// rule_type is the rule type that was updated.
-	RuleType      *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
+func (x *RuleEvaluationStatus) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+func (x *RuleEvaluationStatus) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *RuleEvaluationStatus) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *RuleEvaluationStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RuleEvaluationStatus) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+func (x *RuleEvaluationStatus) GetEntityInfo() map[string]string {
+	if x != nil {
+		return x.EntityInfo
+	}
+	return nil
+}
+
+func (x *RuleEvaluationStatus) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *RuleEvaluationStatus) GetGuidance() string {
+	if x != nil {
+		return x.Guidance
+	}
+	return ""
+}
+
+func (x *RuleEvaluationStatus) GetRemediationStatus() string {
+	if x != nil {
+		return x.RemediationStatus
+	}
+	return ""
+}
+
+func (x *RuleEvaluationStatus) GetRemediationLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemediationLastUpdated
+	}
+	return nil
+}
+
+func (x *RuleEvaluationStatus) GetRemediationDetails() string {
+	if x != nil {
+		return x.RemediationDetails
+	}
+	return ""
+}
+
+func (x *RuleEvaluationStatus) GetRuleTypeName() string {
+	if x != nil {
+		return x.RuleTypeName
+	}
+	return ""
+}
+
+func (x *RuleEvaluationStatus) GetRuleDescriptionName() string {
+	if x != nil {
+		return x.RuleDescriptionName
+	}
+	return ""
+}
+
+func (x *RuleEvaluationStatus) GetAlert() *EvalResultAlert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+func (x *RuleEvaluationStatus) GetSeverity() *Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return nil
+}
+
+func (x *RuleEvaluationStatus) GetRuleEvaluationId() string {
+	if x != nil {
+		return x.RuleEvaluationId
+	}
+	return ""
+}
+
+func (x *RuleEvaluationStatus) GetRemediationUrl() string {
+	if x != nil {
+		return x.RemediationUrl
+	}
+	return ""
+}
+
+func (x *RuleEvaluationStatus) GetRuleDisplayName() string {
+	if x != nil {
+		return x.RuleDisplayName
+	}
+	return ""
+}
+
+func (x *RuleEvaluationStatus) GetReleasePhase() RuleTypeReleasePhase {
+	if x != nil {
+		return x.ReleasePhase
+	}
+	return RuleTypeReleasePhase_RULE_TYPE_RELEASE_PHASE_UNSPECIFIED
+}
+
+// EntityTypedId is a message that carries an ID together with a type to uniquely identify an entity
+// such as (repo, 1), (artifact, 2), ...
+type EntityTypedId struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// entity is the entity to get status for. Incompatible with `all`
+	Type Entity `protobuf:"varint,1,opt,name=type,proto3,enum=minder.v1.Entity" json:"type,omitempty"`
+	// id is the ID of the entity to get status for. Incompatible with `all`
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the name of the entity.  This name is unique within a given project, type, and provider, but may not be globally unique.
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityTypedId) Reset() {
+	*x = EntityTypedId{}
+	mi := &file_minder_v1_minder_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityTypedId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityTypedId) ProtoMessage() {}
+
+func (x *EntityTypedId) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityTypedId.ProtoReflect.Descriptor instead.
+func (*EntityTypedId) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *EntityTypedId) GetType() Entity {
+	if x != nil {
+		return x.Type
+	}
+	return Entity_ENTITY_UNSPECIFIED
+}
+
+func (x *EntityTypedId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EntityTypedId) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetProfileStatusByNameRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the rule type is evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// name is the name of the profile to get
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// entity is the entity to get status for. Incompatible with `all`.
+	// This is optional.
+	Entity *EntityTypedId `protobuf:"bytes,3,opt,name=entity,proto3" json:"entity,omitempty"`
+	// all is true if the status of all entities should be returned.
+	// Incompatible with `entity`. This is optional.
+	All bool `protobuf:"varint,4,opt,name=all,proto3" json:"all,omitempty"`
+	// rule is the type of the rule. Deprecated in favor of rule_type
+	//
+	// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+	Rule string `protobuf:"bytes,5,opt,name=rule,proto3" json:"rule,omitempty"`
+	// rule_type is the type of the rule to filter on.
+	// This is optional.
+	RuleType string `protobuf:"bytes,6,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	// rule_name is the name of the rule to filter on.
+	// This is optional.
+	RuleName      string `protobuf:"bytes,7,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileStatusByNameRequest) Reset() {
+	*x = GetProfileStatusByNameRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileStatusByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileStatusByNameRequest) ProtoMessage() {}
+
+func (x *GetProfileStatusByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileStatusByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileStatusByNameRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetProfileStatusByNameRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetProfileStatusByNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetProfileStatusByNameRequest) GetEntity() *EntityTypedId {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *GetProfileStatusByNameRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+// Deprecated: Marked as deprecated in minder/v1/minder.proto.
+func (x *GetProfileStatusByNameRequest) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *GetProfileStatusByNameRequest) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+func (x *GetProfileStatusByNameRequest) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+type GetProfileStatusByNameResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// profile_status is the status of the profile
+	ProfileStatus *ProfileStatus `protobuf:"bytes,1,opt,name=profile_status,json=profileStatus,proto3" json:"profile_status,omitempty"`
+	// rule_evaluation_status is the status of the rules
+	RuleEvaluationStatus []*RuleEvaluationStatus `protobuf:"bytes,2,rep,name=rule_evaluation_status,json=ruleEvaluationStatus,proto3" json:"rule_evaluation_status,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetProfileStatusByNameResponse) Reset() {
+	*x = GetProfileStatusByNameResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileStatusByNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileStatusByNameResponse) ProtoMessage() {}
+
+func (x *GetProfileStatusByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileStatusByNameResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileStatusByNameResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *GetProfileStatusByNameResponse) GetProfileStatus() *ProfileStatus {
+	if x != nil {
+		return x.ProfileStatus
+	}
+	return nil
+}
+
+func (x *GetProfileStatusByNameResponse) GetRuleEvaluationStatus() []*RuleEvaluationStatus {
+	if x != nil {
+		return x.RuleEvaluationStatus
+	}
+	return nil
+}
+
+type GetProfileStatusByIdRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the rule type is evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// id is the id of the profile to get
+	Id            string         `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Entity        *EntityTypedId `protobuf:"bytes,3,opt,name=entity,proto3" json:"entity,omitempty"`
+	All           bool           `protobuf:"varint,4,opt,name=all,proto3" json:"all,omitempty"`
+	RuleType      string         `protobuf:"bytes,5,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	RuleName      string         `protobuf:"bytes,6,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileStatusByIdRequest) Reset() {
+	*x = GetProfileStatusByIdRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileStatusByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileStatusByIdRequest) ProtoMessage() {}
+
+func (x *GetProfileStatusByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileStatusByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileStatusByIdRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetProfileStatusByIdRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetProfileStatusByIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetProfileStatusByIdRequest) GetEntity() *EntityTypedId {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *GetProfileStatusByIdRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *GetProfileStatusByIdRequest) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+func (x *GetProfileStatusByIdRequest) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+type GetProfileStatusByIdResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// profile_status is the status of the profile
+	ProfileStatus *ProfileStatus `protobuf:"bytes,1,opt,name=profile_status,json=profileStatus,proto3" json:"profile_status,omitempty"`
+	// rule_evaluation_status is the status of the rules
+	RuleEvaluationStatus []*RuleEvaluationStatus `protobuf:"bytes,2,rep,name=rule_evaluation_status,json=ruleEvaluationStatus,proto3" json:"rule_evaluation_status,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetProfileStatusByIdResponse) Reset() {
+	*x = GetProfileStatusByIdResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileStatusByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileStatusByIdResponse) ProtoMessage() {}
+
+func (x *GetProfileStatusByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileStatusByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileStatusByIdResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetProfileStatusByIdResponse) GetProfileStatus() *ProfileStatus {
+	if x != nil {
+		return x.ProfileStatus
+	}
+	return nil
+}
+
+func (x *GetProfileStatusByIdResponse) GetRuleEvaluationStatus() []*RuleEvaluationStatus {
+	if x != nil {
+		return x.RuleEvaluationStatus
+	}
+	return nil
+}
+
+type GetProfileStatusByProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the rule type is evaluated.
+	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileStatusByProjectRequest) Reset() {
+	*x = GetProfileStatusByProjectRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileStatusByProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileStatusByProjectRequest) ProtoMessage() {}
+
+func (x *GetProfileStatusByProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileStatusByProjectRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileStatusByProjectRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *GetProfileStatusByProjectRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type GetProfileStatusByProjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// profile_status is the status of the profile
+	ProfileStatus []*ProfileStatus `protobuf:"bytes,1,rep,name=profile_status,json=profileStatus,proto3" json:"profile_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileStatusByProjectResponse) Reset() {
+	*x = GetProfileStatusByProjectResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileStatusByProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileStatusByProjectResponse) ProtoMessage() {}
+
+func (x *GetProfileStatusByProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileStatusByProjectResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileStatusByProjectResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *GetProfileStatusByProjectResponse) GetProfileStatus() []*ProfileStatus {
+	if x != nil {
+		return x.ProfileStatus
+	}
+	return nil
+}
+
+// Waiver accepts the failure of a rule of a profile for a single entity.
+// While the waiver is active, failures are recorded with the `waived`
+// status and no remediation or alert is performed. Once the waiver expires
+// the rule is enforced again.
+type Waiver struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the waiver.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// context is the project the waiver belongs to, which is the project
+	// of the profile and of the entity.
+	Context *Context `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	// profile is the name of the profile.
+	Profile string `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	// rule_name is the name of the rule in the profile, which defaults to
+	// the name of the rule type.
+	RuleName string `protobuf:"bytes,4,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	// entity is the entity the failure is accepted for.
+	Entity *EntityTypedId `protobuf:"bytes,5,opt,name=entity,proto3" json:"entity,omitempty"`
+	// justification explains why the failure is accepted.
+	Justification string `protobuf:"bytes,6,opt,name=justification,proto3" json:"justification,omitempty"`
+	// approver is the user who created or last updated the waiver.
+	Approver string `protobuf:"bytes,7,opt,name=approver,proto3" json:"approver,omitempty"`
+	// expires_at is the time the waiver expires at.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// created_at is the time the waiver was created at.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at is the time the waiver was last updated at.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Waiver) Reset() {
+	*x = Waiver{}
+	mi := &file_minder_v1_minder_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Waiver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Waiver) ProtoMessage() {}
+
+func (x *Waiver) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Waiver.ProtoReflect.Descriptor instead.
+func (*Waiver) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *Waiver) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Waiver) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *Waiver) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *Waiver) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *Waiver) GetEntity() *EntityTypedId {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *Waiver) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *Waiver) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *Waiver) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Waiver) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Waiver) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateWaiverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Waiver        *Waiver                `protobuf:"bytes,1,opt,name=waiver,proto3" json:"waiver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWaiverRequest) Reset() {
+	*x = CreateWaiverRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWaiverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWaiverRequest) ProtoMessage() {}
+
+func (x *CreateWaiverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWaiverRequest.ProtoReflect.Descriptor instead.
+func (*CreateWaiverRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *CreateWaiverRequest) GetWaiver() *Waiver {
+	if x != nil {
+		return x.Waiver
+	}
+	return nil
+}
+
+type CreateWaiverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Waiver        *Waiver                `protobuf:"bytes,1,opt,name=waiver,proto3" json:"waiver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWaiverResponse) Reset() {
+	*x = CreateWaiverResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWaiverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWaiverResponse) ProtoMessage() {}
+
+func (x *CreateWaiverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWaiverResponse.ProtoReflect.Descriptor instead.
+func (*CreateWaiverResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *CreateWaiverResponse) GetWaiver() *Waiver {
+	if x != nil {
+		return x.Waiver
+	}
+	return nil
+}
+
+type UpdateWaiverRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Id      string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// justification replaces the justification of the waiver.
+	Justification string `protobuf:"bytes,3,opt,name=justification,proto3" json:"justification,omitempty"`
+	// expires_at replaces the expiry of the waiver.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWaiverRequest) Reset() {
+	*x = UpdateWaiverRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWaiverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWaiverRequest) ProtoMessage() {}
+
+func (x *UpdateWaiverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWaiverRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWaiverRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *UpdateWaiverRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *UpdateWaiverRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateWaiverRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *UpdateWaiverRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type UpdateWaiverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Waiver        *Waiver                `protobuf:"bytes,1,opt,name=waiver,proto3" json:"waiver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWaiverResponse) Reset() {
+	*x = UpdateWaiverResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWaiverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWaiverResponse) ProtoMessage() {}
+
+func (x *UpdateWaiverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWaiverResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWaiverResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *UpdateWaiverResponse) GetWaiver() *Waiver {
+	if x != nil {
+		return x.Waiver
+	}
+	return nil
+}
+
+type GetWaiverByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWaiverByIdRequest) Reset() {
+	*x = GetWaiverByIdRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWaiverByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaiverByIdRequest) ProtoMessage() {}
+
+func (x *GetWaiverByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaiverByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetWaiverByIdRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *GetWaiverByIdRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetWaiverByIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetWaiverByIdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Waiver        *Waiver                `protobuf:"bytes,1,opt,name=waiver,proto3" json:"waiver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWaiverByIdResponse) Reset() {
+	*x = GetWaiverByIdResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWaiverByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaiverByIdResponse) ProtoMessage() {}
+
+func (x *GetWaiverByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaiverByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetWaiverByIdResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetWaiverByIdResponse) GetWaiver() *Waiver {
+	if x != nil {
+		return x.Waiver
+	}
+	return nil
+}
+
+type ListWaiversRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// profile restricts the list to the waivers of a profile.
+	Profile string `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	// include_expired lists the expired waivers too.
+	IncludeExpired bool `protobuf:"varint,3,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListWaiversRequest) Reset() {
+	*x = ListWaiversRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWaiversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWaiversRequest) ProtoMessage() {}
+
+func (x *ListWaiversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWaiversRequest.ProtoReflect.Descriptor instead.
+func (*ListWaiversRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *ListWaiversRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListWaiversRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *ListWaiversRequest) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+type ListWaiversResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Waivers       []*Waiver              `protobuf:"bytes,1,rep,name=waivers,proto3" json:"waivers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWaiversResponse) Reset() {
+	*x = ListWaiversResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWaiversResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWaiversResponse) ProtoMessage() {}
+
+func (x *ListWaiversResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWaiversResponse.ProtoReflect.Descriptor instead.
+func (*ListWaiversResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ListWaiversResponse) GetWaivers() []*Waiver {
+	if x != nil {
+		return x.Waivers
+	}
+	return nil
+}
+
+type DeleteWaiverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWaiverRequest) Reset() {
+	*x = DeleteWaiverRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWaiverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWaiverRequest) ProtoMessage() {}
+
+func (x *DeleteWaiverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWaiverRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWaiverRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *DeleteWaiverRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *DeleteWaiverRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteWaiverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWaiverResponse) Reset() {
+	*x = DeleteWaiverResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWaiverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWaiverResponse) ProtoMessage() {}
+
+func (x *DeleteWaiverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWaiverResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWaiverResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *DeleteWaiverResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type EntityAutoRegistrationConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       *bool                  `protobuf:"varint,1,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EntityAutoRegistrationConfig) Reset() {
+	*x = EntityAutoRegistrationConfig{}
+	mi := &file_minder_v1_minder_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntityAutoRegistrationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityAutoRegistrationConfig) ProtoMessage() {}
+
+func (x *EntityAutoRegistrationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityAutoRegistrationConfig.ProtoReflect.Descriptor instead.
+func (*EntityAutoRegistrationConfig) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *EntityAutoRegistrationConfig) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+// AutoRegistration is the configuration for auto-registering entities.
+// When nothing is set, it means that auto-registration is disabled. There is no difference between disabled
+// and undefined so for the "let's not auto-register anything" case we'd just let the repeated string empty
+type AutoRegistration struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled is the list of entities that are enabled for auto-registration.
+	Entities      map[string]*EntityAutoRegistrationConfig `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AutoRegistration) Reset() {
+	*x = AutoRegistration{}
+	mi := &file_minder_v1_minder_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AutoRegistration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoRegistration) ProtoMessage() {}
+
+func (x *AutoRegistration) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoRegistration.ProtoReflect.Descriptor instead.
+func (*AutoRegistration) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *AutoRegistration) GetEntities() map[string]*EntityAutoRegistrationConfig {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+// ProviderConfig contains the generic configuration for a provider.
+type ProviderConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// auto_registration is the configuration for auto-registering entities.
+	AutoRegistration *AutoRegistration `protobuf:"bytes,1,opt,name=auto_registration,json=autoRegistration,proto3,oneof" json:"auto_registration,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ProviderConfig) Reset() {
+	*x = ProviderConfig{}
+	mi := &file_minder_v1_minder_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderConfig) ProtoMessage() {}
+
+func (x *ProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderConfig.ProtoReflect.Descriptor instead.
+func (*ProviderConfig) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *ProviderConfig) GetAutoRegistration() *AutoRegistration {
+	if x != nil {
+		return x.AutoRegistration
+	}
+	return nil
+}
+
+// RESTProviderConfig contains the configuration for the REST provider.
+type RESTProviderConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// base_url is the base URL for the REST provider.
+	BaseUrl       *string `protobuf:"bytes,1,opt,name=base_url,json=baseUrl,proto3,oneof" json:"base_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RESTProviderConfig) Reset() {
+	*x = RESTProviderConfig{}
+	mi := &file_minder_v1_minder_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RESTProviderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RESTProviderConfig) ProtoMessage() {}
+
+func (x *RESTProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RESTProviderConfig.ProtoReflect.Descriptor instead.
+func (*RESTProviderConfig) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *RESTProviderConfig) GetBaseUrl() string {
+	if x != nil && x.BaseUrl != nil {
+		return *x.BaseUrl
+	}
+	return ""
+}
+
+// GitHubProviderConfig contains the configuration for the GitHub client
+//
+// Endpoint: is the GitHub API endpoint
+//
+// If using the public GitHub API, Endpoint can be left blank
+// disable revive linting for this struct as there is nothing wrong with the
+// naming convention
+type GitHubProviderConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Endpoint is the GitHub API endpoint. If using the public GitHub API, Endpoint can be left blank.
+	Endpoint      *string `protobuf:"bytes,1,opt,name=endpoint,proto3,oneof" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitHubProviderConfig) Reset() {
+	*x = GitHubProviderConfig{}
+	mi := &file_minder_v1_minder_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitHubProviderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitHubProviderConfig) ProtoMessage() {}
+
+func (x *GitHubProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitHubProviderConfig.ProtoReflect.Descriptor instead.
+func (*GitHubProviderConfig) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *GitHubProviderConfig) GetEndpoint() string {
+	if x != nil && x.Endpoint != nil {
+		return *x.Endpoint
+	}
+	return ""
+}
+
+// GitHubAppProviderConfig contains the configuration for the GitHub App provider
+type GitHubAppProviderConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Endpoint is the GitHub API endpoint. If using the public GitHub API, Endpoint can be left blank.
+	Endpoint      *string `protobuf:"bytes,1,opt,name=endpoint,proto3,oneof" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitHubAppProviderConfig) Reset() {
+	*x = GitHubAppProviderConfig{}
+	mi := &file_minder_v1_minder_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitHubAppProviderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitHubAppProviderConfig) ProtoMessage() {}
+
+func (x *GitHubAppProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitHubAppProviderConfig.ProtoReflect.Descriptor instead.
+func (*GitHubAppProviderConfig) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *GitHubAppProviderConfig) GetEndpoint() string {
+	if x != nil && x.Endpoint != nil {
+		return *x.Endpoint
+	}
+	return ""
+}
+
+// GitLabProviderConfig contains the configuration for the GitLab provider.
+//
+// Endpoint: is the GitLab API endpoint
+//
+// If using the public GitLab API, Endpoint can be left blank
+type GitLabProviderConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Endpoint is the GitLab API endpoint. If using the public GitLab API, Endpoint can be left blank.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// group is the GitLab group to use for the provider
+	Group string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	// registry is the host of the GitLab container registry. If using the public GitLab registry,
+	// registry can be left blank.
+	Registry      string `protobuf:"bytes,3,opt,name=registry,proto3" json:"registry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitLabProviderConfig) Reset() {
+	*x = GitLabProviderConfig{}
+	mi := &file_minder_v1_minder_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitLabProviderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitLabProviderConfig) ProtoMessage() {}
+
+func (x *GitLabProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitLabProviderConfig.ProtoReflect.Descriptor instead.
+func (*GitLabProviderConfig) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *GitLabProviderConfig) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *GitLabProviderConfig) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *GitLabProviderConfig) GetRegistry() string {
+	if x != nil {
+		return x.Registry
+	}
+	return ""
+}
+
+// AzureDevOpsProviderConfig contains the configuration for the Azure DevOps provider.
+//
+// Endpoint: is the Azure DevOps API endpoint
+// Organization: is the Azure DevOps organization the provider manages
+//
+// If using Azure DevOps Services, Endpoint can be left blank
+type AzureDevOpsProviderConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// endpoint is the Azure DevOps API endpoint. If using Azure DevOps Services, endpoint can be left blank.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// organization is the Azure DevOps organization to use for the provider
+	Organization  string `protobuf:"bytes,2,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AzureDevOpsProviderConfig) Reset() {
+	*x = AzureDevOpsProviderConfig{}
+	mi := &file_minder_v1_minder_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AzureDevOpsProviderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AzureDevOpsProviderConfig) ProtoMessage() {}
+
+func (x *AzureDevOpsProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AzureDevOpsProviderConfig.ProtoReflect.Descriptor instead.
+func (*AzureDevOpsProviderConfig) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *AzureDevOpsProviderConfig) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *AzureDevOpsProviderConfig) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+// DockerHubProviderConfig contains the configuration for the DockerHub provider.
+//
+// Namespace: is the namespace for the DockerHub provider.
+type DockerHubProviderConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// namespace is the namespace for the DockerHub provider.
+	Namespace     *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DockerHubProviderConfig) Reset() {
+	*x = DockerHubProviderConfig{}
+	mi := &file_minder_v1_minder_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DockerHubProviderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DockerHubProviderConfig) ProtoMessage() {}
+
+func (x *DockerHubProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DockerHubProviderConfig.ProtoReflect.Descriptor instead.
+func (*DockerHubProviderConfig) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *DockerHubProviderConfig) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+// GHCRProviderConfig contains the configuration for the GHCR provider.
+//
+// Namespace: is the namespace for the GHCR provider.
+type GHCRProviderConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// namespace is the namespace for the GHCR provider.
+	Namespace     *string `protobuf:"bytes,1,opt,name=namespace,proto3,oneof" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GHCRProviderConfig) Reset() {
+	*x = GHCRProviderConfig{}
+	mi := &file_minder_v1_minder_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GHCRProviderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GHCRProviderConfig) ProtoMessage() {}
+
+func (x *GHCRProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GHCRProviderConfig.ProtoReflect.Descriptor instead.
+func (*GHCRProviderConfig) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *GHCRProviderConfig) GetNamespace() string {
+	if x != nil && x.Namespace != nil {
+		return *x.Namespace
+	}
+	return ""
+}
+
+// Context defines the context in which a rule is evaluated.
+// this normally refers to a combination of the provider, organization and project.
+type Context struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name of the provider
+	// This is optional, but some existing clients may set the field unconditionally,
+	// so an empty string is also an allowed value.
+	Provider *string `protobuf:"bytes,1,opt,name=provider,proto3,oneof" json:"provider,omitempty"`
+	// ID or name of the project.  If empty or unset, will select the user's default
+	// project if they only have one project.  Existing clients may unconditionally set
+	// this to the empty string rather than leaving this unset, so we allow "" as an
+	// alias for unset.
+	Project             *string `protobuf:"bytes,3,opt,name=project,proto3,oneof" json:"project,omitempty"`
+	RetiredOrganization *string `protobuf:"bytes,2,opt,name=retired_organization,json=retiredOrganization,proto3,oneof" json:"retired_organization,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Context) Reset() {
+	*x = Context{}
+	mi := &file_minder_v1_minder_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Context) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Context) ProtoMessage() {}
+
+func (x *Context) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Context.ProtoReflect.Descriptor instead.
+func (*Context) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *Context) GetProvider() string {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
+	}
+	return ""
+}
+
+func (x *Context) GetProject() string {
+	if x != nil && x.Project != nil {
+		return *x.Project
+	}
+	return ""
+}
+
+func (x *Context) GetRetiredOrganization() string {
+	if x != nil && x.RetiredOrganization != nil {
+		return *x.RetiredOrganization
+	}
+	return ""
+}
+
+// ContextV2 defines the context in which a rule is evaluated.
+type ContextV2 struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project is the project ID or name.  If empty or unset, will select the user's
+	// default project if they only have one project.
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// name of the provider. Set to empty string when not applicable.
+	Provider      string `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContextV2) Reset() {
+	*x = ContextV2{}
+	mi := &file_minder_v1_minder_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContextV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContextV2) ProtoMessage() {}
+
+func (x *ContextV2) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContextV2.ProtoReflect.Descriptor instead.
+func (*ContextV2) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *ContextV2) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ContextV2) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+// ListRuleTypesRequest is the request to list rule types.
+type ListRuleTypesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the rule types are evaluated.
+	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRuleTypesRequest) Reset() {
+	*x = ListRuleTypesRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRuleTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRuleTypesRequest) ProtoMessage() {}
+
+func (x *ListRuleTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRuleTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListRuleTypesRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ListRuleTypesRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+// ListRuleTypesResponse is the response to list rule types.
+type ListRuleTypesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rule_types is the list of rule types.
+	RuleTypes     []*RuleType `protobuf:"bytes,1,rep,name=rule_types,json=ruleTypes,proto3" json:"rule_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRuleTypesResponse) Reset() {
+	*x = ListRuleTypesResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRuleTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRuleTypesResponse) ProtoMessage() {}
+
+func (x *ListRuleTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRuleTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListRuleTypesResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *ListRuleTypesResponse) GetRuleTypes() []*RuleType {
+	if x != nil {
+		return x.RuleTypes
+	}
+	return nil
+}
+
+// GetRuleTypeByNameRequest is the request to get a rule type by name.
+type GetRuleTypeByNameRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the rule type is evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// name is the name of the rule type.
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleTypeByNameRequest) Reset() {
+	*x = GetRuleTypeByNameRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleTypeByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleTypeByNameRequest) ProtoMessage() {}
+
+func (x *GetRuleTypeByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleTypeByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetRuleTypeByNameRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *GetRuleTypeByNameRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetRuleTypeByNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetRuleTypeByNameResponse is the response to get a rule type by name.
+type GetRuleTypeByNameResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rule_type is the rule type.
+	RuleType      *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleTypeByNameResponse) Reset() {
+	*x = GetRuleTypeByNameResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleTypeByNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleTypeByNameResponse) ProtoMessage() {}
+
+func (x *GetRuleTypeByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleTypeByNameResponse.ProtoReflect.Descriptor instead.
+func (*GetRuleTypeByNameResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *GetRuleTypeByNameResponse) GetRuleType() *RuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return nil
+}
+
+// GetRuleTypeByIdRequest is the request to get a rule type by id.
+type GetRuleTypeByIdRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the rule type is evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// id is the id of the rule type.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleTypeByIdRequest) Reset() {
+	*x = GetRuleTypeByIdRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleTypeByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleTypeByIdRequest) ProtoMessage() {}
+
+func (x *GetRuleTypeByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleTypeByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetRuleTypeByIdRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *GetRuleTypeByIdRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetRuleTypeByIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetRuleTypeByIdResponse is the response to get a rule type by id.
+type GetRuleTypeByIdResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rule_type is the rule type.
+	RuleType      *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleTypeByIdResponse) Reset() {
+	*x = GetRuleTypeByIdResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleTypeByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleTypeByIdResponse) ProtoMessage() {}
+
+func (x *GetRuleTypeByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleTypeByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetRuleTypeByIdResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *GetRuleTypeByIdResponse) GetRuleType() *RuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return nil
+}
+
+// CreateRuleTypeRequest is the request to create a rule type.
+type CreateRuleTypeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rule_type is the rule type to be created.
+	RuleType      *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRuleTypeRequest) Reset() {
+	*x = CreateRuleTypeRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRuleTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRuleTypeRequest) ProtoMessage() {}
+
+func (x *CreateRuleTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRuleTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateRuleTypeRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *CreateRuleTypeRequest) GetRuleType() *RuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return nil
+}
+
+// CreateRuleTypeResponse is the response to create a rule type.
+type CreateRuleTypeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rule_type is the rule type that was created.
+	RuleType      *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRuleTypeResponse) Reset() {
+	*x = CreateRuleTypeResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRuleTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRuleTypeResponse) ProtoMessage() {}
+
+func (x *CreateRuleTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRuleTypeResponse.ProtoReflect.Descriptor instead.
+func (*CreateRuleTypeResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *CreateRuleTypeResponse) GetRuleType() *RuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return nil
+}
+
+// UpdateRuleTypeRequest is the request to update a rule type.
+type UpdateRuleTypeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rule_type is the rule type to be updated.
+	RuleType *RuleType `protobuf:"bytes,2,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	// dry_run validates the update and lists the profile rules it would
+	// migrate, without applying it.
+	DryRun        bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRuleTypeRequest) Reset() {
+	*x = UpdateRuleTypeRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRuleTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRuleTypeRequest) ProtoMessage() {}
+
+func (x *UpdateRuleTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRuleTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRuleTypeRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *UpdateRuleTypeRequest) GetRuleType() *RuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return nil
+}
+
+func (x *UpdateRuleTypeRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// UpdateRuleTypeResponse is the response to update a rule type.
+type UpdateRuleTypeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rule_type is the rule type that was updated.
+	RuleType *RuleType `protobuf:"bytes,1,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	// migrations are the profile rules migrated to the new schema version
+	// of the rule type, if it changed.
+	Migrations    []*RuleMigration `protobuf:"bytes,2,rep,name=migrations,proto3" json:"migrations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRuleTypeResponse) Reset() {
+	*x = UpdateRuleTypeResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRuleTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRuleTypeResponse) ProtoMessage() {}
+
+func (x *UpdateRuleTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRuleTypeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRuleTypeResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *UpdateRuleTypeResponse) GetRuleType() *RuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return nil
+}
+
+func (x *UpdateRuleTypeResponse) GetMigrations() []*RuleMigration {
+	if x != nil {
+		return x.Migrations
+	}
+	return nil
+}
+
+// RuleMigration is a rule of a profile migrated to a new schema version of
+// its rule type.
+type RuleMigration struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// profile_id is the ID of the profile the rule belongs to.
+	ProfileId string `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	// profile_name is the name of the profile the rule belongs to.
+	ProfileName string `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	// project_id is the project of the profile.
+	ProjectId string `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// rule_name is the name of the rule in the profile.
+	RuleName string `protobuf:"bytes,4,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	// entity is the entity type the rule applies to.
+	Entity Entity `protobuf:"varint,5,opt,name=entity,proto3,enum=minder.v1.Entity" json:"entity,omitempty"`
+	// def is the migrated rule definition.
+	Def *structpb.Struct `protobuf:"bytes,6,opt,name=def,proto3" json:"def,omitempty"`
+	// params are the migrated rule parameters.
+	Params        *structpb.Struct `protobuf:"bytes,7,opt,name=params,proto3" json:"params,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleMigration) Reset() {
+	*x = RuleMigration{}
+	mi := &file_minder_v1_minder_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleMigration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleMigration) ProtoMessage() {}
+
+func (x *RuleMigration) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleMigration.ProtoReflect.Descriptor instead.
+func (*RuleMigration) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *RuleMigration) GetProfileId() string {
+	if x != nil {
+		return x.ProfileId
+	}
+	return ""
+}
+
+func (x *RuleMigration) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+func (x *RuleMigration) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *RuleMigration) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *RuleMigration) GetEntity() Entity {
+	if x != nil {
+		return x.Entity
+	}
+	return Entity_ENTITY_UNSPECIFIED
+}
+
+func (x *RuleMigration) GetDef() *structpb.Struct {
+	if x != nil {
+		return x.Def
+	}
+	return nil
+}
+
+func (x *RuleMigration) GetParams() *structpb.Struct {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// DeleteRuleTypeRequest is the request to delete a rule type.
+type DeleteRuleTypeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the rule type is evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// id is the name or id of the rule type to be deleted.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRuleTypeRequest) Reset() {
+	*x = DeleteRuleTypeRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRuleTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRuleTypeRequest) ProtoMessage() {}
+
+func (x *DeleteRuleTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRuleTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRuleTypeRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *DeleteRuleTypeRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *DeleteRuleTypeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteRuleTypeResponse is the response to delete a rule type.
+type DeleteRuleTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRuleTypeResponse) Reset() {
+	*x = DeleteRuleTypeResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRuleTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRuleTypeResponse) ProtoMessage() {}
+
+func (x *DeleteRuleTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRuleTypeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRuleTypeResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{159}
+}
+
+type ListEvaluationResultsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the evaluation results are evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// profile is the profile name or ID to retrieve results for.
+	// If empty, return evaluation results for profiles selected by
+	// an empty label_filter.
+	//
+	// Types that are valid to be assigned to ProfileSelector:
+	//
+	//	*ListEvaluationResultsRequest_Profile
+	//	*ListEvaluationResultsRequest_LabelFilter
+	ProfileSelector isListEvaluationResultsRequest_ProfileSelector `protobuf_oneof:"profile_selector"`
+	// If set, only return evaluation results for the named entities.
+	// If empty, return evaluation results for all entities
+	Entity []*EntityTypedId `protobuf:"bytes,4,rep,name=entity,proto3" json:"entity,omitempty"`
+	// If set, only return evaluation results for the named rules.
+	// If empty, return evaluation results for all rules
+	RuleName      []string `protobuf:"bytes,5,rep,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEvaluationResultsRequest) Reset() {
+	*x = ListEvaluationResultsRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvaluationResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvaluationResultsRequest) ProtoMessage() {}
+
+func (x *ListEvaluationResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvaluationResultsRequest.ProtoReflect.Descriptor instead.
+func (*ListEvaluationResultsRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *ListEvaluationResultsRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ListEvaluationResultsRequest) GetProfileSelector() isListEvaluationResultsRequest_ProfileSelector {
+	if x != nil {
+		return x.ProfileSelector
+	}
+	return nil
+}
+
+func (x *ListEvaluationResultsRequest) GetProfile() string {
+	if x != nil {
+		if x, ok := x.ProfileSelector.(*ListEvaluationResultsRequest_Profile); ok {
+			return x.Profile
+		}
+	}
+	return ""
+}
+
+func (x *ListEvaluationResultsRequest) GetLabelFilter() string {
+	if x != nil {
+		if x, ok := x.ProfileSelector.(*ListEvaluationResultsRequest_LabelFilter); ok {
+			return x.LabelFilter
+		}
+	}
+	return ""
+}
+
+func (x *ListEvaluationResultsRequest) GetEntity() []*EntityTypedId {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *ListEvaluationResultsRequest) GetRuleName() []string {
+	if x != nil {
+		return x.RuleName
+	}
+	return nil
+}
+
+type isListEvaluationResultsRequest_ProfileSelector interface {
+	isListEvaluationResultsRequest_ProfileSelector()
+}
+
+type ListEvaluationResultsRequest_Profile struct {
+	// ID can contain either a profile name or an ID.
+	Profile string `protobuf:"bytes,2,opt,name=profile,proto3,oneof"`
+}
+
+type ListEvaluationResultsRequest_LabelFilter struct {
+	// Filter profiles to only those matching the specified labels.
+	//
+	// The default is to return all user-created profiles; the string "*" can
+	// be used to select all profiles, including system profiles.  This syntax
+	// may be expanded in the future.
+	LabelFilter string `protobuf:"bytes,3,opt,name=label_filter,json=labelFilter,proto3,oneof"`
+}
+
+func (*ListEvaluationResultsRequest_Profile) isListEvaluationResultsRequest_ProfileSelector() {}
+
+func (*ListEvaluationResultsRequest_LabelFilter) isListEvaluationResultsRequest_ProfileSelector() {}
+
+type ListEvaluationResultsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Each entity selected by the list request will have _single_ entry in entities which contains results of all evaluations for each profile.
+	Entities      []*ListEvaluationResultsResponse_EntityEvaluationResults `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEvaluationResultsResponse) Reset() {
+	*x = ListEvaluationResultsResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEvaluationResultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEvaluationResultsResponse) ProtoMessage() {}
+
+func (x *ListEvaluationResultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEvaluationResultsResponse.ProtoReflect.Descriptor instead.
+func (*ListEvaluationResultsResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *ListEvaluationResultsResponse) GetEntities() []*ListEvaluationResultsResponse_EntityEvaluationResults {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+// RestType defines the rest data evaluation.
+// This is used to fetch data from a REST endpoint.
+type RestType struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// endpoint is the endpoint to fetch data from.
+	// This can be a URL or path on the API.
+	// This is a required field and must be set.
+	// This is also evaluated via a template which allows
+	// us dynamically fill in the values.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// method is the method to use to fetch data.
+	// Go templates may be used to vary the method using the same parameters
+	// as the endpoint.
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// headers are the headers to be sent to the endpoint.
+	Headers []string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
+	// body is the body to be sent to the endpoint, which must be valid JSON
+	// Go templates may be used to vary the method using the same parameters
+	// as the endpoint.
+	Body *string `protobuf:"bytes,4,opt,name=body,proto3,oneof" json:"body,omitempty"`
+	// parse is the parsing mechanism to be used to parse the data.
+	Parse string `protobuf:"bytes,5,opt,name=parse,proto3" json:"parse,omitempty"`
+	// fallback provides a body that the ingester would return in case
+	// the REST call returns a non-200 status code.
+	Fallback      []*RestType_Fallback `protobuf:"bytes,6,rep,name=fallback,proto3" json:"fallback,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestType) Reset() {
+	*x = RestType{}
+	mi := &file_minder_v1_minder_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestType) ProtoMessage() {}
+
+func (x *RestType) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestType.ProtoReflect.Descriptor instead.
+func (*RestType) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *RestType) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *RestType) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *RestType) GetHeaders() []string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *RestType) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
+	}
+	return ""
+}
+
+func (x *RestType) GetParse() string {
+	if x != nil {
+		return x.Parse
+	}
+	return ""
+}
+
+func (x *RestType) GetFallback() []*RestType_Fallback {
+	if x != nil {
+		return x.Fallback
+	}
+	return nil
+}
+
+// BuiltinType defines the builtin data evaluation.
+type BuiltinType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuiltinType) Reset() {
+	*x = BuiltinType{}
+	mi := &file_minder_v1_minder_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuiltinType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuiltinType) ProtoMessage() {}
+
+func (x *BuiltinType) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuiltinType.ProtoReflect.Descriptor instead.
+func (*BuiltinType) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *BuiltinType) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+// ArtifactType defines the artifact data evaluation.
+type ArtifactType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArtifactType) Reset() {
+	*x = ArtifactType{}
+	mi := &file_minder_v1_minder_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArtifactType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactType) ProtoMessage() {}
+
+func (x *ArtifactType) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactType.ProtoReflect.Descriptor instead.
+func (*ArtifactType) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{164}
+}
+
+// GitType defines the git data ingester.
+type GitType struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// clone_url is the url of the git repository.
+	CloneUrl string `protobuf:"bytes,1,opt,name=clone_url,json=cloneUrl,proto3" json:"clone_url,omitempty"`
+	// branch is the branch of the git repository.
+	Branch        string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitType) Reset() {
+	*x = GitType{}
+	mi := &file_minder_v1_minder_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitType) ProtoMessage() {}
+
+func (x *GitType) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitType.ProtoReflect.Descriptor instead.
+func (*GitType) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *GitType) GetCloneUrl() string {
+	if x != nil {
+		return x.CloneUrl
+	}
+	return ""
+}
+
+func (x *GitType) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+// DiffType defines the diff data ingester.
+type DiffType struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ecosystems is the list of ecosystems to be used
+	// for the "dep" diff type.
+	Ecosystems []*DiffType_Ecosystem `protobuf:"bytes,1,rep,name=ecosystems,proto3" json:"ecosystems,omitempty"`
+	// type is the type of diff ingestor to use.
+	// The default is "dep" which will leverage
+	// the ecosystems array.
+	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffType) Reset() {
+	*x = DiffType{}
+	mi := &file_minder_v1_minder_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffType) ProtoMessage() {}
+
+func (x *DiffType) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffType.ProtoReflect.Descriptor instead.
+func (*DiffType) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *DiffType) GetEcosystems() []*DiffType_Ecosystem {
+	if x != nil {
+		return x.Ecosystems
+	}
+	return nil
+}
+
+func (x *DiffType) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// DepsType defines the "deps" ingester which can extract depndencies in protobom
+// format for rule evaluation.
+type DepsType struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to EntityType:
+	//
+	//	*DepsType_Repo
+	//	*DepsType_Pr
+	EntityType    isDepsType_EntityType `protobuf_oneof:"entity_type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepsType) Reset() {
+	*x = DepsType{}
+	mi := &file_minder_v1_minder_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepsType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepsType) ProtoMessage() {}
+
+func (x *DepsType) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepsType.ProtoReflect.Descriptor instead.
+func (*DepsType) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *DepsType) GetEntityType() isDepsType_EntityType {
+	if x != nil {
+		return x.EntityType
+	}
+	return nil
+}
+
+func (x *DepsType) GetRepo() *DepsType_RepoConfigs {
+	if x != nil {
+		if x, ok := x.EntityType.(*DepsType_Repo); ok {
+			return x.Repo
+		}
+	}
+	return nil
+}
+
+func (x *DepsType) GetPr() *DepsType_PullRequestConfigs {
+	if x != nil {
+		if x, ok := x.EntityType.(*DepsType_Pr); ok {
+			return x.Pr
+		}
+	}
+	return nil
+}
+
+type isDepsType_EntityType interface {
+	isDepsType_EntityType()
+}
+
+type DepsType_Repo struct {
+	Repo *DepsType_RepoConfigs `protobuf:"bytes,1,opt,name=repo,proto3,oneof"`
+}
+
+type DepsType_Pr struct {
+	Pr *DepsType_PullRequestConfigs `protobuf:"bytes,2,opt,name=pr,proto3,oneof"`
+}
+
+func (*DepsType_Repo) isDepsType_EntityType() {}
+
+func (*DepsType_Pr) isDepsType_EntityType() {}
+
+// AlertsType defines the "alerts" ingester which lists the open security
+// alerts of a repository for rule evaluation.
+type AlertsType struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kinds are the kinds of alerts to ingest. All kinds are ingested if
+	// none are set.
+	Kinds         []string `protobuf:"bytes,1,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertsType) Reset() {
+	*x = AlertsType{}
+	mi := &file_minder_v1_minder_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertsType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertsType) ProtoMessage() {}
+
+func (x *AlertsType) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertsType.ProtoReflect.Descriptor instead.
+func (*AlertsType) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *AlertsType) GetKinds() []string {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+// Severity defines the severity of the rule.
+type Severity struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// value is the severity value.
+	Value         Severity_Value `protobuf:"varint,1,opt,name=value,proto3,enum=minder.v1.Severity_Value" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Severity) Reset() {
+	*x = Severity{}
+	mi := &file_minder_v1_minder_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Severity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Severity) ProtoMessage() {}
+
+func (x *Severity) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Severity.ProtoReflect.Descriptor instead.
+func (*Severity) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *Severity) GetValue() Severity_Value {
+	if x != nil {
+		return x.Value
+	}
+	return Severity_VALUE_UNSPECIFIED
+}
+
+// RuleType defines rules that may or may not be user defined.
+// The version is assumed from the folder's version.
+type RuleType struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// version is the version of the rule type API.
+	Version string `protobuf:"bytes,11,opt,name=version,proto3" json:"version,omitempty"`
+	// type is the type of the rule.
+	Type string `protobuf:"bytes,12,opt,name=type,proto3" json:"type,omitempty"`
+	// id is the id of the rule type.
+	// This is mostly optional and is set by the server.
+	Id *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	// name is the name of the rule type.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// display_name is the display name of the rule type.
+	DisplayName string `protobuf:"bytes,8,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// short_failure_message is the message to display when the evaluation fails.
+	ShortFailureMessage string `protobuf:"bytes,10,opt,name=short_failure_message,json=shortFailureMessage,proto3" json:"short_failure_message,omitempty"`
+	// context is the context in which the rule is evaluated.
+	Context *Context `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	// def is the definition of the rule type.
+	Def *RuleType_Definition `protobuf:"bytes,4,opt,name=def,proto3" json:"def,omitempty"`
+	// description is the description of the rule type.
+	// This is expected to be a valid markdown formatted string.
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// guidance are instructions we give the user in case a rule fails.
+	// This is expected to be a valid markdown formatted string.
+	Guidance string `protobuf:"bytes,6,opt,name=guidance,proto3" json:"guidance,omitempty"`
+	// severity is the severity of the rule type.
+	Severity *Severity `protobuf:"bytes,7,opt,name=severity,proto3" json:"severity,omitempty"`
+	// release_phase is the release phase of the rule type, i.e. alpha, beta, ga, deprecated.
+	ReleasePhase  RuleTypeReleasePhase `protobuf:"varint,9,opt,name=release_phase,json=releasePhase,proto3,enum=minder.v1.RuleTypeReleasePhase" json:"release_phase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleType) Reset() {
+	*x = RuleType{}
+	mi := &file_minder_v1_minder_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleType) ProtoMessage() {}
+
+func (x *RuleType) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleType.ProtoReflect.Descriptor instead.
+func (*RuleType) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *RuleType) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *RuleType) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RuleType) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *RuleType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RuleType) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *RuleType) GetShortFailureMessage() string {
+	if x != nil {
+		return x.ShortFailureMessage
+	}
+	return ""
+}
+
+func (x *RuleType) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *RuleType) GetDef() *RuleType_Definition {
+	if x != nil {
+		return x.Def
+	}
+	return nil
+}
+
+func (x *RuleType) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RuleType) GetGuidance() string {
+	if x != nil {
+		return x.Guidance
+	}
+	return ""
+}
+
+func (x *RuleType) GetSeverity() *Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return nil
+}
+
+func (x *RuleType) GetReleasePhase() RuleTypeReleasePhase {
+	if x != nil {
+		return x.ReleasePhase
+	}
+	return RuleTypeReleasePhase_RULE_TYPE_RELEASE_PHASE_UNSPECIFIED
+}
+
+// Profile defines a profile that is user defined.
+// All fields are optional because we want to allow partial updates.
+type Profile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the profile is evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// id is the id of the profile.
+	// This is optional and is set by the system.
+	Id *string `protobuf:"bytes,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	// name is the name of the profile instance.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// labels are a set of system-provided attributes which can be used to
+	// filter profiles and status results.  Labels cannot be set by the user,
+	// but are returned in ListProfiles.
+	//
+	// Labels use DNS label constraints, with a possible namespace prefix
+	// separated by a colon (:).  They are intended to allow filtering, but
+	// not to store arbitrary metadata.
+	// DNS labels are 1-63 character alphanumeric strings with internal hyphens.
+	// An RE2-style validation regex would be:
+	//
+	// DNS_STR = "[a-zA-Z0-9](?[-a-zA-Z0-9]{0,61}[a-zA-Z0-9])?"
+	// ($DNS_STR:)?$DNS_STR
+	Labels []string `protobuf:"bytes,12,rep,name=labels,proto3" json:"labels,omitempty"`
+	// These are the entities that one could set in the profile.
+	Repository       []*Profile_Rule     `protobuf:"bytes,4,rep,name=repository,proto3" json:"repository,omitempty"`
+	BuildEnvironment []*Profile_Rule     `protobuf:"bytes,5,rep,name=build_environment,json=buildEnvironment,proto3" json:"build_environment,omitempty"`
+	Artifact         []*Profile_Rule     `protobuf:"bytes,6,rep,name=artifact,proto3" json:"artifact,omitempty"`
+	PullRequest      []*Profile_Rule     `protobuf:"bytes,7,rep,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	Release          []*Profile_Rule     `protobuf:"bytes,15,rep,name=release,proto3" json:"release,omitempty"`
+	PipelineRun      []*Profile_Rule     `protobuf:"bytes,16,rep,name=pipeline_run,json=pipelineRun,proto3" json:"pipeline_run,omitempty"`
+	TaskRun          []*Profile_Rule     `protobuf:"bytes,17,rep,name=task_run,json=taskRun,proto3" json:"task_run,omitempty"`
+	Build            []*Profile_Rule     `protobuf:"bytes,18,rep,name=build,proto3" json:"build,omitempty"`
+	Selection        []*Profile_Selector `protobuf:"bytes,14,rep,name=selection,proto3" json:"selection,omitempty"`
+	// whether and how to remediate (on,off,dry_run)
+	// this is optional and defaults to "off"
+	Remediate *string `protobuf:"bytes,8,opt,name=remediate,proto3,oneof" json:"remediate,omitempty"`
+	// whether and how to alert (on,off,dry_run)
+	// this is optional and defaults to "on"
+	Alert *string `protobuf:"bytes,9,opt,name=alert,proto3,oneof" json:"alert,omitempty"`
+	// type is a placeholder for the object type. It should always be set to "profile".
+	Type string `protobuf:"bytes,10,opt,name=type,proto3" json:"type,omitempty"`
+	// version is the version of the profile type. In this case, it is "v1"
+	Version string `protobuf:"bytes,11,opt,name=version,proto3" json:"version,omitempty"`
+	// display_name is the display name of the profile.
+	DisplayName   string `protobuf:"bytes,13,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_minder_v1_minder_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *Profile) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *Profile) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *Profile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Profile) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Profile) GetRepository() []*Profile_Rule {
+	if x != nil {
+		return x.Repository
+	}
+	return nil
+}
+
+func (x *Profile) GetBuildEnvironment() []*Profile_Rule {
+	if x != nil {
+		return x.BuildEnvironment
+	}
+	return nil
+}
+
+func (x *Profile) GetArtifact() []*Profile_Rule {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+func (x *Profile) GetPullRequest() []*Profile_Rule {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+func (x *Profile) GetRelease() []*Profile_Rule {
+	if x != nil {
+		return x.Release
+	}
+	return nil
+}
+
+func (x *Profile) GetPipelineRun() []*Profile_Rule {
+	if x != nil {
+		return x.PipelineRun
+	}
+	return nil
+}
+
+func (x *Profile) GetTaskRun() []*Profile_Rule {
+	if x != nil {
+		return x.TaskRun
+	}
+	return nil
+}
+
+func (x *Profile) GetBuild() []*Profile_Rule {
+	if x != nil {
+		return x.Build
+	}
+	return nil
+}
+
+func (x *Profile) GetSelection() []*Profile_Selector {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+func (x *Profile) GetRemediate() string {
+	if x != nil && x.Remediate != nil {
+		return *x.Remediate
+	}
+	return ""
+}
+
+func (x *Profile) GetAlert() string {
+	if x != nil && x.Alert != nil {
+		return *x.Alert
+	}
+	return ""
+}
+
+func (x *Profile) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Profile) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Profile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+type ListProjectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRuleTypeResponse) Reset() {
-	*x = UpdateRuleTypeResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[114]
+func (x *ListProjectsRequest) Reset() {
+	*x = ListProjectsRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRuleTypeResponse) String() string {
+func (x *ListProjectsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRuleTypeResponse) ProtoMessage() {}
+func (*ListProjectsRequest) ProtoMessage() {}
 
-func (x *UpdateRuleTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[114]
+func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7185,44 +10790,80 @@ func (x *UpdateRuleTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRuleTypeResponse.ProtoReflect.Descriptor instead.
-func (*UpdateRuleTypeResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{114}
+// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *UpdateRuleTypeResponse) GetRuleType() *RuleType {
+type ListProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsResponse) Reset() {
+	*x = ListProjectsResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsResponse) ProtoMessage() {}
+
+func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[173]
 	if x != nil {
-		return x.RuleType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *ListProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
 	}
 	return nil
 }
 
-// DeleteRuleTypeRequest is the request to delete a rule type.
-type DeleteRuleTypeRequest struct {
+type CreateProjectRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the rule type is evaluated.
+	// context is the context in which the project is created.
 	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// id is the name or id of the rule type to be deleted.
-	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the name of the project to create.
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRuleTypeRequest) Reset() {
-	*x = DeleteRuleTypeRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[115]
+func (x *CreateProjectRequest) Reset() {
+	*x = CreateProjectRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRuleTypeRequest) String() string {
+func (x *CreateProjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRuleTypeRequest) ProtoMessage() {}
+func (*CreateProjectRequest) ProtoMessage() {}
 
-func (x *DeleteRuleTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[115]
+func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7233,47 +10874,48 @@ func (x *DeleteRuleTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRuleTypeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRuleTypeRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{115}
+// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{174}
 }
 
-func (x *DeleteRuleTypeRequest) GetContext() *Context {
+func (x *CreateProjectRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *DeleteRuleTypeRequest) GetId() string {
+func (x *CreateProjectRequest) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-// DeleteRuleTypeResponse is the response to delete a rule type.
-type DeleteRuleTypeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type CreateProjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project is the project that was created.
+	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRuleTypeResponse) Reset() {
-	*x = DeleteRuleTypeResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[116]
+func (x *CreateProjectResponse) Reset() {
+	*x = CreateProjectResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRuleTypeResponse) String() string {
+func (x *CreateProjectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRuleTypeResponse) ProtoMessage() {}
+func (*CreateProjectResponse) ProtoMessage() {}
 
-func (x *DeleteRuleTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[116]
+func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7284,49 +10926,137 @@ func (x *DeleteRuleTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRuleTypeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteRuleTypeResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{116}
+// Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
+func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{175}
 }
 
-type ListEvaluationResultsRequest struct {
+func (x *CreateProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type DeleteProjectRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the evaluation results are evaluated.
+	// context is the context in which the project is deleted.
+	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectRequest) Reset() {
+	*x = DeleteProjectRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectRequest) ProtoMessage() {}
+
+func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *DeleteProjectRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type DeleteProjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project_id is the id of the project that was deleted.
+	ProjectId     string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProjectResponse) Reset() {
+	*x = DeleteProjectResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProjectResponse) ProtoMessage() {}
+
+func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProjectResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *DeleteProjectResponse) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type UpdateProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the project is updated.
 	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// profile is the profile name or ID to retrieve results for.
-	// If empty, return evaluation results for profiles selected by
-	// an empty label_filter.
-	//
-	// Types that are valid to be assigned to ProfileSelector:
-	//
-	//	*ListEvaluationResultsRequest_Profile
-	//	*ListEvaluationResultsRequest_LabelFilter
-	ProfileSelector isListEvaluationResultsRequest_ProfileSelector `protobuf_oneof:"profile_selector"`
-	// If set, only return evaluation results for the named entities.
-	// If empty, return evaluation results for all entities
-	Entity []*EntityTypedId `protobuf:"bytes,4,rep,name=entity,proto3" json:"entity,omitempty"`
-	// If set, only return evaluation results for the named rules.
-	// If empty, return evaluation results for all rules
-	RuleName      []string `protobuf:"bytes,5,rep,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	// display_name is the display name of the project to update.
+	// This is optional.
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// description is the description of the project to update.
+	// This is optional.
+	Description   string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEvaluationResultsRequest) Reset() {
-	*x = ListEvaluationResultsRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[117]
+func (x *UpdateProjectRequest) Reset() {
+	*x = UpdateProjectRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvaluationResultsRequest) String() string {
+func (x *UpdateProjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvaluationResultsRequest) ProtoMessage() {}
+func (*UpdateProjectRequest) ProtoMessage() {}
 
-func (x *ListEvaluationResultsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[117]
+func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7337,102 +11067,102 @@ func (x *ListEvaluationResultsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvaluationResultsRequest.ProtoReflect.Descriptor instead.
-func (*ListEvaluationResultsRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{117}
+// Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{178}
 }
 
-func (x *ListEvaluationResultsRequest) GetContext() *Context {
+func (x *UpdateProjectRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *ListEvaluationResultsRequest) GetProfileSelector() isListEvaluationResultsRequest_ProfileSelector {
-	if x != nil {
-		return x.ProfileSelector
-	}
-	return nil
-}
-
-func (x *ListEvaluationResultsRequest) GetProfile() string {
+func (x *UpdateProjectRequest) GetDisplayName() string {
 	if x != nil {
-		if x, ok := x.ProfileSelector.(*ListEvaluationResultsRequest_Profile); ok {
-			return x.Profile
-		}
+		return x.DisplayName
 	}
 	return ""
 }
 
-func (x *ListEvaluationResultsRequest) GetLabelFilter() string {
+func (x *UpdateProjectRequest) GetDescription() string {
 	if x != nil {
-		if x, ok := x.ProfileSelector.(*ListEvaluationResultsRequest_LabelFilter); ok {
-			return x.LabelFilter
-		}
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ListEvaluationResultsRequest) GetEntity() []*EntityTypedId {
-	if x != nil {
-		return x.Entity
-	}
-	return nil
+type UpdateProjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project is the project that was updated.
+	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEvaluationResultsRequest) GetRuleName() []string {
-	if x != nil {
-		return x.RuleName
-	}
-	return nil
+func (x *UpdateProjectResponse) Reset() {
+	*x = UpdateProjectResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type isListEvaluationResultsRequest_ProfileSelector interface {
-	isListEvaluationResultsRequest_ProfileSelector()
+func (x *UpdateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type ListEvaluationResultsRequest_Profile struct {
-	// ID can contain either a profile name or an ID.
-	Profile string `protobuf:"bytes,2,opt,name=profile,proto3,oneof"`
-}
+func (*UpdateProjectResponse) ProtoMessage() {}
 
-type ListEvaluationResultsRequest_LabelFilter struct {
-	// Filter profiles to only those matching the specified labels.
-	//
-	// The default is to return all user-created profiles; the string "*" can
-	// be used to select all profiles, including system profiles.  This syntax
-	// may be expanded in the future.
-	LabelFilter string `protobuf:"bytes,3,opt,name=label_filter,json=labelFilter,proto3,oneof"`
+func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*ListEvaluationResultsRequest_Profile) isListEvaluationResultsRequest_ProfileSelector() {}
+// Deprecated: Use UpdateProjectResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{179}
+}
 
-func (*ListEvaluationResultsRequest_LabelFilter) isListEvaluationResultsRequest_ProfileSelector() {}
+func (x *UpdateProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
 
-type ListEvaluationResultsResponse struct {
+type ProjectPatch struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Each entity selected by the list request will have _single_ entry in entities which contains results of all evaluations for each profile.
-	Entities      []*ListEvaluationResultsResponse_EntityEvaluationResults `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	// display_name is the display name of the project to update.
+	DisplayName *string `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
+	// description is the description of the project to update.
+	Description   *string `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEvaluationResultsResponse) Reset() {
-	*x = ListEvaluationResultsResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[118]
+func (x *ProjectPatch) Reset() {
+	*x = ProjectPatch{}
+	mi := &file_minder_v1_minder_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvaluationResultsResponse) String() string {
+func (x *ProjectPatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvaluationResultsResponse) ProtoMessage() {}
+func (*ProjectPatch) ProtoMessage() {}
 
-func (x *ListEvaluationResultsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[118]
+func (x *ProjectPatch) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7443,62 +11173,53 @@ func (x *ListEvaluationResultsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvaluationResultsResponse.ProtoReflect.Descriptor instead.
-func (*ListEvaluationResultsResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{118}
+// Deprecated: Use ProjectPatch.ProtoReflect.Descriptor instead.
+func (*ProjectPatch) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{180}
 }
 
-func (x *ListEvaluationResultsResponse) GetEntities() []*ListEvaluationResultsResponse_EntityEvaluationResults {
-	if x != nil {
-		return x.Entities
+func (x *ProjectPatch) GetDisplayName() string {
+	if x != nil && x.DisplayName != nil {
+		return *x.DisplayName
 	}
-	return nil
+	return ""
 }
 
-// RestType defines the rest data evaluation.
-// This is used to fetch data from a REST endpoint.
-type RestType struct {
+func (x *ProjectPatch) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+type PatchProjectRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// endpoint is the endpoint to fetch data from.
-	// This can be a URL or path on the API.
-	// This is a required field and must be set.
-	// This is also evaluated via a template which allows
-	// us dynamically fill in the values.
-	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	// method is the method to use to fetch data.
-	// Go templates may be used to vary the method using the same parameters
-	// as the endpoint.
-	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
-	// headers are the headers to be sent to the endpoint.
-	Headers []string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
-	// body is the body to be sent to the endpoint, which must be valid JSON
-	// Go templates may be used to vary the method using the same parameters
-	// as the endpoint.
-	Body *string `protobuf:"bytes,4,opt,name=body,proto3,oneof" json:"body,omitempty"`
-	// parse is the parsing mechanism to be used to parse the data.
-	Parse string `protobuf:"bytes,5,opt,name=parse,proto3" json:"parse,omitempty"`
-	// fallback provides a body that the ingester would return in case
-	// the REST call returns a non-200 status code.
-	Fallback      []*RestType_Fallback `protobuf:"bytes,6,rep,name=fallback,proto3" json:"fallback,omitempty"`
+	// context is the context in which the project is updated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// patch is the patch to apply to the project
+	Patch *ProjectPatch `protobuf:"bytes,3,opt,name=patch,proto3" json:"patch,omitempty"`
+	// needed to enable PATCH, see https://grpc-ecosystem.github.io/grpc-gateway/docs/mapping/patch_feature/
+	// is not exposed to the API user
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestType) Reset() {
-	*x = RestType{}
-	mi := &file_minder_v1_minder_proto_msgTypes[119]
+func (x *PatchProjectRequest) Reset() {
+	*x = PatchProjectRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestType) String() string {
+func (x *PatchProjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestType) ProtoMessage() {}
+func (*PatchProjectRequest) ProtoMessage() {}
 
-func (x *RestType) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[119]
+func (x *PatchProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7509,76 +11230,102 @@ func (x *RestType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestType.ProtoReflect.Descriptor instead.
-func (*RestType) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{119}
+// Deprecated: Use PatchProjectRequest.ProtoReflect.Descriptor instead.
+func (*PatchProjectRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{181}
 }
 
-func (x *RestType) GetEndpoint() string {
+func (x *PatchProjectRequest) GetContext() *Context {
 	if x != nil {
-		return x.Endpoint
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-func (x *RestType) GetMethod() string {
+func (x *PatchProjectRequest) GetPatch() *ProjectPatch {
 	if x != nil {
-		return x.Method
+		return x.Patch
 	}
-	return ""
+	return nil
 }
 
-func (x *RestType) GetHeaders() []string {
+func (x *PatchProjectRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.Headers
+		return x.UpdateMask
 	}
 	return nil
 }
 
-func (x *RestType) GetBody() string {
-	if x != nil && x.Body != nil {
-		return *x.Body
-	}
-	return ""
+type PatchProjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// project is the project that was updated.
+	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestType) GetParse() string {
+func (x *PatchProjectResponse) Reset() {
+	*x = PatchProjectResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchProjectResponse) ProtoMessage() {}
+
+func (x *PatchProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[182]
 	if x != nil {
-		return x.Parse
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RestType) GetFallback() []*RestType_Fallback {
+// Deprecated: Use PatchProjectResponse.ProtoReflect.Descriptor instead.
+func (*PatchProjectResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *PatchProjectResponse) GetProject() *Project {
 	if x != nil {
-		return x.Fallback
+		return x.Project
 	}
 	return nil
 }
 
-// BuiltinType defines the builtin data evaluation.
-type BuiltinType struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+type ListChildProjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the child projects are listed.
+	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// recursive is true if child projects should be listed recursively.
+	Recursive     bool `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuiltinType) Reset() {
-	*x = BuiltinType{}
-	mi := &file_minder_v1_minder_proto_msgTypes[120]
+func (x *ListChildProjectsRequest) Reset() {
+	*x = ListChildProjectsRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuiltinType) String() string {
+func (x *ListChildProjectsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuiltinType) ProtoMessage() {}
+func (*ListChildProjectsRequest) ProtoMessage() {}
 
-func (x *BuiltinType) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[120]
+func (x *ListChildProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7589,40 +11336,47 @@ func (x *BuiltinType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuiltinType.ProtoReflect.Descriptor instead.
-func (*BuiltinType) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{120}
+// Deprecated: Use ListChildProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListChildProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{183}
 }
 
-func (x *BuiltinType) GetMethod() string {
+func (x *ListChildProjectsRequest) GetContext() *ContextV2 {
 	if x != nil {
-		return x.Method
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-// ArtifactType defines the artifact data evaluation.
-type ArtifactType struct {
+func (x *ListChildProjectsRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+type ListChildProjectsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArtifactType) Reset() {
-	*x = ArtifactType{}
-	mi := &file_minder_v1_minder_proto_msgTypes[121]
+func (x *ListChildProjectsResponse) Reset() {
+	*x = ListChildProjectsResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArtifactType) String() string {
+func (x *ListChildProjectsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArtifactType) ProtoMessage() {}
+func (*ListChildProjectsResponse) ProtoMessage() {}
 
-func (x *ArtifactType) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[121]
+func (x *ListChildProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7633,37 +11387,43 @@ func (x *ArtifactType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArtifactType.ProtoReflect.Descriptor instead.
-func (*ArtifactType) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{121}
+// Deprecated: Use ListChildProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListChildProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{184}
 }
 
-// GitType defines the git data ingester.
-type GitType struct {
+func (x *ListChildProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+type CreateEntityReconciliationTaskRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// clone_url is the url of the git repository.
-	CloneUrl string `protobuf:"bytes,1,opt,name=clone_url,json=cloneUrl,proto3" json:"clone_url,omitempty"`
-	// branch is the branch of the git repository.
-	Branch        string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	// entity is the entity to be reconciled.
+	Entity *EntityTypedId `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	// context is the context in which the entity reconciliation task is created.
+	Context       *Context `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GitType) Reset() {
-	*x = GitType{}
-	mi := &file_minder_v1_minder_proto_msgTypes[122]
+func (x *CreateEntityReconciliationTaskRequest) Reset() {
+	*x = CreateEntityReconciliationTaskRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GitType) String() string {
+func (x *CreateEntityReconciliationTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitType) ProtoMessage() {}
+func (*CreateEntityReconciliationTaskRequest) ProtoMessage() {}
 
-func (x *GitType) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[122]
+func (x *CreateEntityReconciliationTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7674,54 +11434,46 @@ func (x *GitType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitType.ProtoReflect.Descriptor instead.
-func (*GitType) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{122}
+// Deprecated: Use CreateEntityReconciliationTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateEntityReconciliationTaskRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{185}
 }
 
-func (x *GitType) GetCloneUrl() string {
+func (x *CreateEntityReconciliationTaskRequest) GetEntity() *EntityTypedId {
 	if x != nil {
-		return x.CloneUrl
+		return x.Entity
 	}
-	return ""
+	return nil
 }
 
-func (x *GitType) GetBranch() string {
+func (x *CreateEntityReconciliationTaskRequest) GetContext() *Context {
 	if x != nil {
-		return x.Branch
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-// DiffType defines the diff data ingester.
-type DiffType struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// ecosystems is the list of ecosystems to be used
-	// for the "dep" diff type.
-	Ecosystems []*DiffType_Ecosystem `protobuf:"bytes,1,rep,name=ecosystems,proto3" json:"ecosystems,omitempty"`
-	// type is the type of diff ingestor to use.
-	// The default is "dep" which will leverage
-	// the ecosystems array.
-	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+type CreateEntityReconciliationTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DiffType) Reset() {
-	*x = DiffType{}
-	mi := &file_minder_v1_minder_proto_msgTypes[123]
+func (x *CreateEntityReconciliationTaskResponse) Reset() {
+	*x = CreateEntityReconciliationTaskResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiffType) String() string {
+func (x *CreateEntityReconciliationTaskResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiffType) ProtoMessage() {}
+func (*CreateEntityReconciliationTaskResponse) ProtoMessage() {}
 
-func (x *DiffType) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[123]
+func (x *CreateEntityReconciliationTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7732,53 +11484,34 @@ func (x *DiffType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiffType.ProtoReflect.Descriptor instead.
-func (*DiffType) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{123}
-}
-
-func (x *DiffType) GetEcosystems() []*DiffType_Ecosystem {
-	if x != nil {
-		return x.Ecosystems
-	}
-	return nil
-}
-
-func (x *DiffType) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use CreateEntityReconciliationTaskResponse.ProtoReflect.Descriptor instead.
+func (*CreateEntityReconciliationTaskResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{186}
 }
 
-// DepsType defines the "deps" ingester which can extract depndencies in protobom
-// format for rule evaluation.
-type DepsType struct {
+type ListRolesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to EntityType:
-	//
-	//	*DepsType_Repo
-	//	*DepsType_Pr
-	EntityType    isDepsType_EntityType `protobuf_oneof:"entity_type"`
+	// context is the context in which the roles are evaluated.
+	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DepsType) Reset() {
-	*x = DepsType{}
-	mi := &file_minder_v1_minder_proto_msgTypes[124]
+func (x *ListRolesRequest) Reset() {
+	*x = ListRolesRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepsType) String() string {
+func (x *ListRolesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepsType) ProtoMessage() {}
+func (*ListRolesRequest) ProtoMessage() {}
 
-func (x *DepsType) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[124]
+func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7789,76 +11522,85 @@ func (x *DepsType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepsType.ProtoReflect.Descriptor instead.
-func (*DepsType) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{124}
+// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListRolesRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{187}
 }
 
-func (x *DepsType) GetEntityType() isDepsType_EntityType {
+func (x *ListRolesRequest) GetContext() *Context {
 	if x != nil {
-		return x.EntityType
+		return x.Context
 	}
 	return nil
 }
 
-func (x *DepsType) GetRepo() *DepsType_RepoConfigs {
-	if x != nil {
-		if x, ok := x.EntityType.(*DepsType_Repo); ok {
-			return x.Repo
-		}
-	}
-	return nil
+type ListRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*Role                `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DepsType) GetPr() *DepsType_PullRequestConfigs {
-	if x != nil {
-		if x, ok := x.EntityType.(*DepsType_Pr); ok {
-			return x.Pr
-		}
-	}
-	return nil
+func (x *ListRolesResponse) Reset() {
+	*x = ListRolesResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type isDepsType_EntityType interface {
-	isDepsType_EntityType()
+func (x *ListRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type DepsType_Repo struct {
-	Repo *DepsType_RepoConfigs `protobuf:"bytes,1,opt,name=repo,proto3,oneof"`
-}
+func (*ListRolesResponse) ProtoMessage() {}
 
-type DepsType_Pr struct {
-	Pr *DepsType_PullRequestConfigs `protobuf:"bytes,2,opt,name=pr,proto3,oneof"`
+func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*DepsType_Repo) isDepsType_EntityType() {}
+// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
+func (*ListRolesResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{188}
+}
 
-func (*DepsType_Pr) isDepsType_EntityType() {}
+func (x *ListRolesResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
 
-// Severity defines the severity of the rule.
-type Severity struct {
+type ListRoleAssignmentsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// value is the severity value.
-	Value         Severity_Value `protobuf:"varint,1,opt,name=value,proto3,enum=minder.v1.Severity_Value" json:"value,omitempty"`
+	// context is the context in which the role assignments are evaluated.
+	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Severity) Reset() {
-	*x = Severity{}
-	mi := &file_minder_v1_minder_proto_msgTypes[125]
+func (x *ListRoleAssignmentsRequest) Reset() {
+	*x = ListRoleAssignmentsRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Severity) String() string {
+func (x *ListRoleAssignmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Severity) ProtoMessage() {}
+func (*ListRoleAssignmentsRequest) ProtoMessage() {}
 
-func (x *Severity) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[125]
+func (x *ListRoleAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7869,68 +11611,45 @@ func (x *Severity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Severity.ProtoReflect.Descriptor instead.
-func (*Severity) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{125}
+// Deprecated: Use ListRoleAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoleAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{189}
 }
 
-func (x *Severity) GetValue() Severity_Value {
+func (x *ListRoleAssignmentsRequest) GetContext() *Context {
 	if x != nil {
-		return x.Value
+		return x.Context
 	}
-	return Severity_VALUE_UNSPECIFIED
+	return nil
 }
 
-// RuleType defines rules that may or may not be user defined.
-// The version is assumed from the folder's version.
-type RuleType struct {
+type ListRoleAssignmentsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// version is the version of the rule type API.
-	Version string `protobuf:"bytes,11,opt,name=version,proto3" json:"version,omitempty"`
-	// type is the type of the rule.
-	Type string `protobuf:"bytes,12,opt,name=type,proto3" json:"type,omitempty"`
-	// id is the id of the rule type.
-	// This is mostly optional and is set by the server.
-	Id *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	// name is the name of the rule type.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// display_name is the display name of the rule type.
-	DisplayName string `protobuf:"bytes,8,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// short_failure_message is the message to display when the evaluation fails.
-	ShortFailureMessage string `protobuf:"bytes,10,opt,name=short_failure_message,json=shortFailureMessage,proto3" json:"short_failure_message,omitempty"`
-	// context is the context in which the rule is evaluated.
-	Context *Context `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
-	// def is the definition of the rule type.
-	Def *RuleType_Definition `protobuf:"bytes,4,opt,name=def,proto3" json:"def,omitempty"`
-	// description is the description of the rule type.
-	// This is expected to be a valid markdown formatted string.
-	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	// guidance are instructions we give the user in case a rule fails.
-	// This is expected to be a valid markdown formatted string.
-	Guidance string `protobuf:"bytes,6,opt,name=guidance,proto3" json:"guidance,omitempty"`
-	// severity is the severity of the rule type.
-	Severity *Severity `protobuf:"bytes,7,opt,name=severity,proto3" json:"severity,omitempty"`
-	// release_phase is the release phase of the rule type, i.e. alpha, beta, ga, deprecated.
-	ReleasePhase  RuleTypeReleasePhase `protobuf:"varint,9,opt,name=release_phase,json=releasePhase,proto3,enum=minder.v1.RuleTypeReleasePhase" json:"release_phase,omitempty"`
+	// role_assignments contains permission grants which have been accepted
+	// by a user.
+	RoleAssignments []*RoleAssignment `protobuf:"bytes,1,rep,name=role_assignments,json=roleAssignments,proto3" json:"role_assignments,omitempty"`
+	// invitations contains outstanding role invitations which have not yet
+	// been accepted by a user.
+	Invitations   []*Invitation `protobuf:"bytes,2,rep,name=invitations,proto3" json:"invitations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType) Reset() {
-	*x = RuleType{}
-	mi := &file_minder_v1_minder_proto_msgTypes[126]
+func (x *ListRoleAssignmentsResponse) Reset() {
+	*x = ListRoleAssignmentsResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType) String() string {
+func (x *ListRoleAssignmentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType) ProtoMessage() {}
+func (*ListRoleAssignmentsResponse) ProtoMessage() {}
 
-func (x *RuleType) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[126]
+func (x *ListRoleAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7941,160 +11660,167 @@ func (x *RuleType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType.ProtoReflect.Descriptor instead.
-func (*RuleType) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126}
+// Deprecated: Use ListRoleAssignmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoleAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{190}
 }
 
-func (x *RuleType) GetVersion() string {
+func (x *ListRoleAssignmentsResponse) GetRoleAssignments() []*RoleAssignment {
 	if x != nil {
-		return x.Version
+		return x.RoleAssignments
 	}
-	return ""
+	return nil
 }
 
-func (x *RuleType) GetType() string {
+func (x *ListRoleAssignmentsResponse) GetInvitations() []*Invitation {
 	if x != nil {
-		return x.Type
+		return x.Invitations
 	}
-	return ""
+	return nil
 }
 
-func (x *RuleType) GetId() string {
-	if x != nil && x.Id != nil {
-		return *x.Id
-	}
-	return ""
+type AssignRoleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the role assignment is evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// role_assignment is the role assignment to be created.
+	RoleAssignment *RoleAssignment `protobuf:"bytes,2,opt,name=role_assignment,json=roleAssignment,proto3" json:"role_assignment,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RuleType) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *AssignRoleRequest) Reset() {
+	*x = AssignRoleRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
+func (x *AssignRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RuleType) GetShortFailureMessage() string {
+func (*AssignRoleRequest) ProtoMessage() {}
+
+func (x *AssignRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[191]
 	if x != nil {
-		return x.ShortFailureMessage
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RuleType) GetContext() *Context {
+// Deprecated: Use AssignRoleRequest.ProtoReflect.Descriptor instead.
+func (*AssignRoleRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *AssignRoleRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *RuleType) GetDef() *RuleType_Definition {
+func (x *AssignRoleRequest) GetRoleAssignment() *RoleAssignment {
 	if x != nil {
-		return x.Def
+		return x.RoleAssignment
 	}
 	return nil
 }
 
-func (x *RuleType) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+type AssignRoleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// role_assignment is the role assignment that was created.
+	// This is optional.
+	RoleAssignment *RoleAssignment `protobuf:"bytes,1,opt,name=role_assignment,json=roleAssignment,proto3" json:"role_assignment,omitempty"`
+	// invitation contains the details of the invitation for the
+	// assigned user to join the project if the user is not already
+	// a member. This is optional.
+	Invitation    *Invitation `protobuf:"bytes,2,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType) GetGuidance() string {
+func (x *AssignRoleResponse) Reset() {
+	*x = AssignRoleResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRoleResponse) ProtoMessage() {}
+
+func (x *AssignRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[192]
 	if x != nil {
-		return x.Guidance
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RuleType) GetSeverity() *Severity {
+// Deprecated: Use AssignRoleResponse.ProtoReflect.Descriptor instead.
+func (*AssignRoleResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *AssignRoleResponse) GetRoleAssignment() *RoleAssignment {
 	if x != nil {
-		return x.Severity
+		return x.RoleAssignment
 	}
 	return nil
 }
 
-func (x *RuleType) GetReleasePhase() RuleTypeReleasePhase {
+func (x *AssignRoleResponse) GetInvitation() *Invitation {
 	if x != nil {
-		return x.ReleasePhase
+		return x.Invitation
 	}
-	return RuleTypeReleasePhase_RULE_TYPE_RELEASE_PHASE_UNSPECIFIED
+	return nil
 }
 
-// Profile defines a profile that is user defined.
-// All fields are optional because we want to allow partial updates.
-type Profile struct {
+type UpdateRoleRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the profile is evaluated.
+	// context is the context in which the role assignment is evaluated.
 	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// id is the id of the profile.
-	// This is optional and is set by the system.
-	Id *string `protobuf:"bytes,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	// name is the name of the profile instance.
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// labels are a set of system-provided attributes which can be used to
-	// filter profiles and status results.  Labels cannot be set by the user,
-	// but are returned in ListProfiles.
-	//
-	// Labels use DNS label constraints, with a possible namespace prefix
-	// separated by a colon (:).  They are intended to allow filtering, but
-	// not to store arbitrary metadata.
-	// DNS labels are 1-63 character alphanumeric strings with internal hyphens.
-	// An RE2-style validation regex would be:
-	//
-	// DNS_STR = "[a-zA-Z0-9](?[-a-zA-Z0-9]{0,61}[a-zA-Z0-9])?"
-	// ($DNS_STR:)?$DNS_STR
-	Labels []string `protobuf:"bytes,12,rep,name=labels,proto3" json:"labels,omitempty"`
-	// These are the entities that one could set in the profile.
-	Repository       []*Profile_Rule     `protobuf:"bytes,4,rep,name=repository,proto3" json:"repository,omitempty"`
-	BuildEnvironment []*Profile_Rule     `protobuf:"bytes,5,rep,name=build_environment,json=buildEnvironment,proto3" json:"build_environment,omitempty"`
-	Artifact         []*Profile_Rule     `protobuf:"bytes,6,rep,name=artifact,proto3" json:"artifact,omitempty"`
-	PullRequest      []*Profile_Rule     `protobuf:"bytes,7,rep,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
-	Release          []*Profile_Rule     `protobuf:"bytes,15,rep,name=release,proto3" json:"release,omitempty"`
-	PipelineRun      []*Profile_Rule     `protobuf:"bytes,16,rep,name=pipeline_run,json=pipelineRun,proto3" json:"pipeline_run,omitempty"`
-	TaskRun          []*Profile_Rule     `protobuf:"bytes,17,rep,name=task_run,json=taskRun,proto3" json:"task_run,omitempty"`
-	Build            []*Profile_Rule     `protobuf:"bytes,18,rep,name=build,proto3" json:"build,omitempty"`
-	Selection        []*Profile_Selector `protobuf:"bytes,14,rep,name=selection,proto3" json:"selection,omitempty"`
-	// whether and how to remediate (on,off,dry_run)
-	// this is optional and defaults to "off"
-	Remediate *string `protobuf:"bytes,8,opt,name=remediate,proto3,oneof" json:"remediate,omitempty"`
-	// whether and how to alert (on,off,dry_run)
-	// this is optional and defaults to "on"
-	Alert *string `protobuf:"bytes,9,opt,name=alert,proto3,oneof" json:"alert,omitempty"`
-	// type is a placeholder for the object type. It should always be set to "profile".
-	Type string `protobuf:"bytes,10,opt,name=type,proto3" json:"type,omitempty"`
-	// version is the version of the profile type. In this case, it is "v1"
-	Version string `protobuf:"bytes,11,opt,name=version,proto3" json:"version,omitempty"`
-	// display_name is the display name of the profile.
-	DisplayName   string `protobuf:"bytes,13,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// subject is the account to change permissions for.
+	// The account must already have permissions on the project
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// All subject roles are _replaced_ with the following role assignments.  Must be non-empty,
+	// use RemoveRole to remove permissions entirely from the project.
+	Roles []string `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	// email is the email address of the subject used for updating invitations
+	Email         string `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Profile) Reset() {
-	*x = Profile{}
-	mi := &file_minder_v1_minder_proto_msgTypes[127]
+func (x *UpdateRoleRequest) Reset() {
+	*x = UpdateRoleRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Profile) String() string {
+func (x *UpdateRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Profile) ProtoMessage() {}
+func (*UpdateRoleRequest) ProtoMessage() {}
 
-func (x *Profile) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[127]
+func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8105,158 +11831,228 @@ func (x *Profile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
-func (*Profile) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{127}
+// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{193}
 }
 
-func (x *Profile) GetContext() *Context {
+func (x *UpdateRoleRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *Profile) GetId() string {
-	if x != nil && x.Id != nil {
-		return *x.Id
-	}
-	return ""
-}
-
-func (x *Profile) GetName() string {
+func (x *UpdateRoleRequest) GetSubject() string {
 	if x != nil {
-		return x.Name
+		return x.Subject
 	}
 	return ""
 }
 
-func (x *Profile) GetLabels() []string {
+func (x *UpdateRoleRequest) GetRoles() []string {
 	if x != nil {
-		return x.Labels
+		return x.Roles
 	}
 	return nil
 }
 
-func (x *Profile) GetRepository() []*Profile_Rule {
+func (x *UpdateRoleRequest) GetEmail() string {
 	if x != nil {
-		return x.Repository
+		return x.Email
 	}
-	return nil
+	return ""
 }
 
-func (x *Profile) GetBuildEnvironment() []*Profile_Rule {
-	if x != nil {
-		return x.BuildEnvironment
-	}
-	return nil
+type UpdateRoleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// role_assignments are the role assignments that were updated.
+	RoleAssignments []*RoleAssignment `protobuf:"bytes,1,rep,name=role_assignments,json=roleAssignments,proto3" json:"role_assignments,omitempty"`
+	// invitations contains the details of the invitations that were updated.
+	Invitations   []*Invitation `protobuf:"bytes,2,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Profile) GetArtifact() []*Profile_Rule {
-	if x != nil {
-		return x.Artifact
-	}
-	return nil
+func (x *UpdateRoleResponse) Reset() {
+	*x = UpdateRoleResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Profile) GetPullRequest() []*Profile_Rule {
+func (x *UpdateRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleResponse) ProtoMessage() {}
+
+func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[194]
 	if x != nil {
-		return x.PullRequest
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Profile) GetRelease() []*Profile_Rule {
+// Deprecated: Use UpdateRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRoleResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *UpdateRoleResponse) GetRoleAssignments() []*RoleAssignment {
 	if x != nil {
-		return x.Release
+		return x.RoleAssignments
 	}
 	return nil
 }
 
-func (x *Profile) GetPipelineRun() []*Profile_Rule {
+func (x *UpdateRoleResponse) GetInvitations() []*Invitation {
 	if x != nil {
-		return x.PipelineRun
+		return x.Invitations
 	}
 	return nil
 }
 
-func (x *Profile) GetTaskRun() []*Profile_Rule {
+type RemoveRoleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the role assignment is evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// role_assignment is the role assignment to be removed.
+	RoleAssignment *RoleAssignment `protobuf:"bytes,2,opt,name=role_assignment,json=roleAssignment,proto3" json:"role_assignment,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RemoveRoleRequest) Reset() {
+	*x = RemoveRoleRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRoleRequest) ProtoMessage() {}
+
+func (x *RemoveRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[195]
 	if x != nil {
-		return x.TaskRun
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Profile) GetBuild() []*Profile_Rule {
+// Deprecated: Use RemoveRoleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRoleRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *RemoveRoleRequest) GetContext() *Context {
 	if x != nil {
-		return x.Build
+		return x.Context
 	}
 	return nil
 }
 
-func (x *Profile) GetSelection() []*Profile_Selector {
+func (x *RemoveRoleRequest) GetRoleAssignment() *RoleAssignment {
 	if x != nil {
-		return x.Selection
+		return x.RoleAssignment
 	}
 	return nil
 }
 
-func (x *Profile) GetRemediate() string {
-	if x != nil && x.Remediate != nil {
-		return *x.Remediate
-	}
-	return ""
+type RemoveRoleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// role_assignment is the role assignment that was removed.
+	RoleAssignment *RoleAssignment `protobuf:"bytes,1,opt,name=role_assignment,json=roleAssignment,proto3" json:"role_assignment,omitempty"`
+	// invitation contains the details of the invitation that was removed.
+	Invitation    *Invitation `protobuf:"bytes,2,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Profile) GetAlert() string {
-	if x != nil && x.Alert != nil {
-		return *x.Alert
-	}
-	return ""
+func (x *RemoveRoleResponse) Reset() {
+	*x = RemoveRoleResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Profile) GetType() string {
+func (x *RemoveRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRoleResponse) ProtoMessage() {}
+
+func (x *RemoveRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[196]
 	if x != nil {
-		return x.Type
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Profile) GetVersion() string {
+// Deprecated: Use RemoveRoleResponse.ProtoReflect.Descriptor instead.
+func (*RemoveRoleResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *RemoveRoleResponse) GetRoleAssignment() *RoleAssignment {
 	if x != nil {
-		return x.Version
+		return x.RoleAssignment
 	}
-	return ""
+	return nil
 }
 
-func (x *Profile) GetDisplayName() string {
+func (x *RemoveRoleResponse) GetInvitation() *Invitation {
 	if x != nil {
-		return x.DisplayName
+		return x.Invitation
 	}
-	return ""
+	return nil
 }
 
-type ListProjectsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type Role struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the role.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// display name of the role
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// description is the description of the role.
+	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProjectsRequest) Reset() {
-	*x = ListProjectsRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[128]
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_minder_v1_minder_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProjectsRequest) String() string {
+func (x *Role) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProjectsRequest) ProtoMessage() {}
+func (*Role) ProtoMessage() {}
 
-func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[128]
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8267,33 +12063,68 @@ func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
-func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{128}
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{197}
 }
 
-type ListProjectsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Role) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type RoleAssignment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// role is the role that is assigned.
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// subject is the subject to which the role is assigned.
+	// Can be either a UUID or a providername/subject string.
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// display_name is the display name of the subject.
+	DisplayName string `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// project is the project in which the role is assigned.
+	Project *string `protobuf:"bytes,4,opt,name=project,proto3,oneof" json:"project,omitempty"`
+	// email is the email address of the subject used for invitations.
+	Email string `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	// first_name is the first name of the subject.
+	FirstName string `protobuf:"bytes,7,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	// last_name is the last name of the subject.
+	LastName      string `protobuf:"bytes,8,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProjectsResponse) Reset() {
-	*x = ListProjectsResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[129]
+func (x *RoleAssignment) Reset() {
+	*x = RoleAssignment{}
+	mi := &file_minder_v1_minder_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProjectsResponse) String() string {
+func (x *RoleAssignment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProjectsResponse) ProtoMessage() {}
+func (*RoleAssignment) ProtoMessage() {}
 
-func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[129]
+func (x *RoleAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8304,95 +12135,81 @@ func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
-func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{129}
+// Deprecated: Use RoleAssignment.ProtoReflect.Descriptor instead.
+func (*RoleAssignment) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{198}
 }
 
-func (x *ListProjectsResponse) GetProjects() []*Project {
+func (x *RoleAssignment) GetRole() string {
 	if x != nil {
-		return x.Projects
+		return x.Role
 	}
-	return nil
+	return ""
 }
 
-type CreateProjectRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the project is created.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// name is the name of the project to create.
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RoleAssignment) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
 }
 
-func (x *CreateProjectRequest) Reset() {
-	*x = CreateProjectRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[130]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RoleAssignment) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
 }
 
-func (x *CreateProjectRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RoleAssignment) GetProject() string {
+	if x != nil && x.Project != nil {
+		return *x.Project
+	}
+	return ""
 }
 
-func (*CreateProjectRequest) ProtoMessage() {}
-
-func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[130]
+func (x *RoleAssignment) GetEmail() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Email
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
-func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{130}
+	return ""
 }
 
-func (x *CreateProjectRequest) GetContext() *Context {
+func (x *RoleAssignment) GetFirstName() string {
 	if x != nil {
-		return x.Context
+		return x.FirstName
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateProjectRequest) GetName() string {
+func (x *RoleAssignment) GetLastName() string {
 	if x != nil {
-		return x.Name
+		return x.LastName
 	}
 	return ""
 }
 
-type CreateProjectResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// project is the project that was created.
-	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+type ListInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProjectResponse) Reset() {
-	*x = CreateProjectResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[131]
+func (x *ListInvitationsRequest) Reset() {
+	*x = ListInvitationsRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProjectResponse) String() string {
+func (x *ListInvitationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProjectResponse) ProtoMessage() {}
+func (*ListInvitationsRequest) ProtoMessage() {}
 
-func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[131]
+func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8403,41 +12220,33 @@ func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
-func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{131}
-}
-
-func (x *CreateProjectResponse) GetProject() *Project {
-	if x != nil {
-		return x.Project
-	}
-	return nil
+// Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{199}
 }
 
-type DeleteProjectRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the project is deleted.
-	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+type ListInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*Invitation          `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProjectRequest) Reset() {
-	*x = DeleteProjectRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[132]
+func (x *ListInvitationsResponse) Reset() {
+	*x = ListInvitationsResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProjectRequest) String() string {
+func (x *ListInvitationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProjectRequest) ProtoMessage() {}
+func (*ListInvitationsResponse) ProtoMessage() {}
 
-func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[132]
+func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8448,41 +12257,43 @@ func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{132}
+// Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{200}
 }
 
-func (x *DeleteProjectRequest) GetContext() *Context {
+func (x *ListInvitationsResponse) GetInvitations() []*Invitation {
 	if x != nil {
-		return x.Context
+		return x.Invitations
 	}
 	return nil
 }
 
-type DeleteProjectResponse struct {
+type ResolveInvitationRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// project_id is the id of the project that was deleted.
-	ProjectId     string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// code is the code of the invitation to resolve.
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// accept is true if the invitation is accepted, false if it is rejected.
+	Accept        bool `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProjectResponse) Reset() {
-	*x = DeleteProjectResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[133]
+func (x *ResolveInvitationRequest) Reset() {
+	*x = ResolveInvitationRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProjectResponse) String() string {
+func (x *ResolveInvitationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProjectResponse) ProtoMessage() {}
+func (*ResolveInvitationRequest) ProtoMessage() {}
 
-func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[133]
+func (x *ResolveInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8493,47 +12304,58 @@ func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProjectResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{133}
+// Deprecated: Use ResolveInvitationRequest.ProtoReflect.Descriptor instead.
+func (*ResolveInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{201}
 }
 
-func (x *DeleteProjectResponse) GetProjectId() string {
+func (x *ResolveInvitationRequest) GetCode() string {
 	if x != nil {
-		return x.ProjectId
+		return x.Code
 	}
 	return ""
 }
 
-type UpdateProjectRequest struct {
+func (x *ResolveInvitationRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type ResolveInvitationResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the project is updated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// display_name is the display name of the project to update.
-	// This is optional.
-	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// description is the description of the project to update.
-	// This is optional.
-	Description   string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// role is the role that would be assigned if the user
+	// accepts the invitation.
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// email is the email address of the invited user.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// project is the project to which the user is invited.
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	// is_accepted is the status of the invitation.
+	IsAccepted bool `protobuf:"varint,4,opt,name=is_accepted,json=isAccepted,proto3" json:"is_accepted,omitempty"`
+	// project_display is the display name of the project to which the user
+	// is invited.
+	ProjectDisplay string `protobuf:"bytes,5,opt,name=project_display,json=projectDisplay,proto3" json:"project_display,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateProjectRequest) Reset() {
-	*x = UpdateProjectRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[134]
+func (x *ResolveInvitationResponse) Reset() {
+	*x = ResolveInvitationResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProjectRequest) String() string {
+func (x *ResolveInvitationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProjectRequest) ProtoMessage() {}
+func (*ResolveInvitationResponse) ProtoMessage() {}
 
-func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[134]
+func (x *ResolveInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8544,55 +12366,101 @@ func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{134}
+// Deprecated: Use ResolveInvitationResponse.ProtoReflect.Descriptor instead.
+func (*ResolveInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *ResolveInvitationResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ResolveInvitationResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
 }
 
-func (x *UpdateProjectRequest) GetContext() *Context {
+func (x *ResolveInvitationResponse) GetProject() string {
 	if x != nil {
-		return x.Context
+		return x.Project
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateProjectRequest) GetDisplayName() string {
+func (x *ResolveInvitationResponse) GetIsAccepted() bool {
 	if x != nil {
-		return x.DisplayName
+		return x.IsAccepted
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateProjectRequest) GetDescription() string {
+func (x *ResolveInvitationResponse) GetProjectDisplay() string {
 	if x != nil {
-		return x.Description
+		return x.ProjectDisplay
 	}
 	return ""
 }
 
-type UpdateProjectResponse struct {
+// Invitation is an invitation to join a project. This is only used in responses.
+type Invitation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// project is the project that was updated.
-	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// role is the role that would be assigned if the user
+	// accepts the invitation.
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// email is the email address of the invited user.  This is
+	// presented as a convenience for display purposes, and does
+	// not affect who can accept the invitation using the code.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// project is the project to which the user is invited.
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	// code is a unique identifier for the invitation, which can
+	// be used by the recipient to accept or reject the invitation.
+	// The code is only transmitted in response to AssignRole or
+	// ListInvitations RPCs, and not transmitted in
+	// ListRoleAssignments or other calls.
+	Code string `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	// created_at is the time at which the invitation was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// expires_at is the time at which the invitation expires.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// expired is true if the invitation has expired.
+	Expired bool `protobuf:"varint,9,opt,name=expired,proto3" json:"expired,omitempty"`
+	// sponsor is the account (ID) of the user who created the invitation.
+	Sponsor string `protobuf:"bytes,7,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// sponsor_display is the display name of the user who created the
+	// invitation.
+	SponsorDisplay string `protobuf:"bytes,8,opt,name=sponsor_display,json=sponsorDisplay,proto3" json:"sponsor_display,omitempty"`
+	// project_display is the display name of the project to which the user
+	// is invited.
+	ProjectDisplay string `protobuf:"bytes,10,opt,name=project_display,json=projectDisplay,proto3" json:"project_display,omitempty"`
+	// inviteURL is the URL that can be used to accept the invitation.
+	InviteUrl string `protobuf:"bytes,11,opt,name=invite_url,json=inviteUrl,proto3" json:"invite_url,omitempty"`
+	// emailSkipped is true if the email was not sent to the invitee.
+	EmailSkipped  bool `protobuf:"varint,12,opt,name=email_skipped,json=emailSkipped,proto3" json:"email_skipped,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProjectResponse) Reset() {
-	*x = UpdateProjectResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[135]
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	mi := &file_minder_v1_minder_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProjectResponse) String() string {
+func (x *Invitation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProjectResponse) ProtoMessage() {}
+func (*Invitation) ProtoMessage() {}
 
-func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[135]
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8603,100 +12471,120 @@ func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProjectResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{135}
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{203}
 }
 
-func (x *UpdateProjectResponse) GetProject() *Project {
+func (x *Invitation) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Invitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Invitation) GetProject() string {
 	if x != nil {
 		return x.Project
 	}
-	return nil
+	return ""
 }
 
-type ProjectPatch struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// display_name is the display name of the project to update.
-	DisplayName *string `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3,oneof" json:"display_name,omitempty"`
-	// description is the description of the project to update.
-	Description   *string `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Invitation) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
 }
 
-func (x *ProjectPatch) Reset() {
-	*x = ProjectPatch{}
-	mi := &file_minder_v1_minder_proto_msgTypes[136]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Invitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *ProjectPatch) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Invitation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
 }
 
-func (*ProjectPatch) ProtoMessage() {}
+func (x *Invitation) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
 
-func (x *ProjectPatch) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[136]
+func (x *Invitation) GetSponsor() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Sponsor
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ProjectPatch.ProtoReflect.Descriptor instead.
-func (*ProjectPatch) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{136}
+func (x *Invitation) GetSponsorDisplay() string {
+	if x != nil {
+		return x.SponsorDisplay
+	}
+	return ""
 }
 
-func (x *ProjectPatch) GetDisplayName() string {
-	if x != nil && x.DisplayName != nil {
-		return *x.DisplayName
+func (x *Invitation) GetProjectDisplay() string {
+	if x != nil {
+		return x.ProjectDisplay
 	}
 	return ""
 }
 
-func (x *ProjectPatch) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *Invitation) GetInviteUrl() string {
+	if x != nil {
+		return x.InviteUrl
 	}
 	return ""
 }
 
-type PatchProjectRequest struct {
+func (x *Invitation) GetEmailSkipped() bool {
+	if x != nil {
+		return x.EmailSkipped
+	}
+	return false
+}
+
+type GetProviderRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the project is updated.
+	// context is the context in which the provider is evaluated.
 	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// patch is the patch to apply to the project
-	Patch *ProjectPatch `protobuf:"bytes,3,opt,name=patch,proto3" json:"patch,omitempty"`
-	// needed to enable PATCH, see https://grpc-ecosystem.github.io/grpc-gateway/docs/mapping/patch_feature/
-	// is not exposed to the API user
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// name is the name of the provider to get.
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PatchProjectRequest) Reset() {
-	*x = PatchProjectRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[137]
+func (x *GetProviderRequest) Reset() {
+	*x = GetProviderRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PatchProjectRequest) String() string {
+func (x *GetProviderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchProjectRequest) ProtoMessage() {}
+func (*GetProviderRequest) ProtoMessage() {}
 
-func (x *PatchProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[137]
+func (x *GetProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8707,55 +12595,48 @@ func (x *PatchProjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchProjectRequest.ProtoReflect.Descriptor instead.
-func (*PatchProjectRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{137}
+// Deprecated: Use GetProviderRequest.ProtoReflect.Descriptor instead.
+func (*GetProviderRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{204}
 }
 
-func (x *PatchProjectRequest) GetContext() *Context {
+func (x *GetProviderRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *PatchProjectRequest) GetPatch() *ProjectPatch {
-	if x != nil {
-		return x.Patch
-	}
-	return nil
-}
-
-func (x *PatchProjectRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *GetProviderRequest) GetName() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type PatchProjectResponse struct {
+type GetProviderResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// project is the project that was updated.
-	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// provider is the provider that was retrieved.
+	Provider      *Provider `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PatchProjectResponse) Reset() {
-	*x = PatchProjectResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[138]
+func (x *GetProviderResponse) Reset() {
+	*x = GetProviderResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PatchProjectResponse) String() string {
+func (x *GetProviderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchProjectResponse) ProtoMessage() {}
+func (*GetProviderResponse) ProtoMessage() {}
 
-func (x *PatchProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[138]
+func (x *GetProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8766,43 +12647,46 @@ func (x *PatchProjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchProjectResponse.ProtoReflect.Descriptor instead.
-func (*PatchProjectResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{138}
+// Deprecated: Use GetProviderResponse.ProtoReflect.Descriptor instead.
+func (*GetProviderResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{205}
 }
 
-func (x *PatchProjectResponse) GetProject() *Project {
+func (x *GetProviderResponse) GetProvider() *Provider {
 	if x != nil {
-		return x.Project
+		return x.Provider
 	}
 	return nil
 }
 
-type ListChildProjectsRequest struct {
+type ListProvidersRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the child projects are listed.
-	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// recursive is true if child projects should be listed recursively.
-	Recursive     bool `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	// context is the context in which the providers are evaluated.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// limit is the maximum number of providers to return.
+	// 0 uses a server-defined default.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor is the cursor to use for the page of results, empty if at the beginning
+	Cursor        string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListChildProjectsRequest) Reset() {
-	*x = ListChildProjectsRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[139]
+func (x *ListProvidersRequest) Reset() {
+	*x = ListProvidersRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListChildProjectsRequest) String() string {
+func (x *ListProvidersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListChildProjectsRequest) ProtoMessage() {}
+func (*ListProvidersRequest) ProtoMessage() {}
 
-func (x *ListChildProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[139]
+func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8813,47 +12697,56 @@ func (x *ListChildProjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListChildProjectsRequest.ProtoReflect.Descriptor instead.
-func (*ListChildProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{139}
+// Deprecated: Use ListProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{206}
 }
 
-func (x *ListChildProjectsRequest) GetContext() *ContextV2 {
+func (x *ListProvidersRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *ListChildProjectsRequest) GetRecursive() bool {
+func (x *ListProvidersRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Recursive
+		return x.Limit
 	}
-	return false
+	return 0
 }
 
-type ListChildProjectsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+func (x *ListProvidersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListProvidersResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Providers []*Provider            `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	// cursor is the cursor to use for the next page of results, empty if at the end
+	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListChildProjectsResponse) Reset() {
-	*x = ListChildProjectsResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[140]
+func (x *ListProvidersResponse) Reset() {
+	*x = ListProvidersResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListChildProjectsResponse) String() string {
+func (x *ListProvidersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListChildProjectsResponse) ProtoMessage() {}
+func (*ListProvidersResponse) ProtoMessage() {}
 
-func (x *ListChildProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[140]
+func (x *ListProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8864,43 +12757,50 @@ func (x *ListChildProjectsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListChildProjectsResponse.ProtoReflect.Descriptor instead.
-func (*ListChildProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{140}
+// Deprecated: Use ListProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{207}
 }
 
-func (x *ListChildProjectsResponse) GetProjects() []*Project {
+func (x *ListProvidersResponse) GetProviders() []*Provider {
 	if x != nil {
-		return x.Projects
+		return x.Providers
 	}
 	return nil
 }
 
-type CreateEntityReconciliationTaskRequest struct {
+func (x *ListProvidersResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type CreateProviderRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// entity is the entity to be reconciled.
-	Entity *EntityTypedId `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
-	// context is the context in which the entity reconciliation task is created.
-	Context       *Context `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	// context is the context in which the provider is created.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// provider is the provider to be created.
+	Provider      *Provider `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEntityReconciliationTaskRequest) Reset() {
-	*x = CreateEntityReconciliationTaskRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[141]
+func (x *CreateProviderRequest) Reset() {
+	*x = CreateProviderRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEntityReconciliationTaskRequest) String() string {
+func (x *CreateProviderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEntityReconciliationTaskRequest) ProtoMessage() {}
+func (*CreateProviderRequest) ProtoMessage() {}
 
-func (x *CreateEntityReconciliationTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[141]
+func (x *CreateProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8911,46 +12811,51 @@ func (x *CreateEntityReconciliationTaskRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEntityReconciliationTaskRequest.ProtoReflect.Descriptor instead.
-func (*CreateEntityReconciliationTaskRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{141}
+// Deprecated: Use CreateProviderRequest.ProtoReflect.Descriptor instead.
+func (*CreateProviderRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{208}
 }
 
-func (x *CreateEntityReconciliationTaskRequest) GetEntity() *EntityTypedId {
+func (x *CreateProviderRequest) GetContext() *Context {
 	if x != nil {
-		return x.Entity
+		return x.Context
 	}
 	return nil
 }
 
-func (x *CreateEntityReconciliationTaskRequest) GetContext() *Context {
+func (x *CreateProviderRequest) GetProvider() *Provider {
 	if x != nil {
-		return x.Context
+		return x.Provider
 	}
 	return nil
 }
 
-type CreateEntityReconciliationTaskResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type CreateProviderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// provider is the provider that was created.
+	Provider *Provider `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// authorization provides additional authorization information needed
+	// to complete the initialization of the provider.
+	Authorization *AuthorizationParams `protobuf:"bytes,2,opt,name=authorization,proto3" json:"authorization,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEntityReconciliationTaskResponse) Reset() {
-	*x = CreateEntityReconciliationTaskResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[142]
+func (x *CreateProviderResponse) Reset() {
+	*x = CreateProviderResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEntityReconciliationTaskResponse) String() string {
+func (x *CreateProviderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEntityReconciliationTaskResponse) ProtoMessage() {}
+func (*CreateProviderResponse) ProtoMessage() {}
 
-func (x *CreateEntityReconciliationTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[142]
+func (x *CreateProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8961,34 +12866,49 @@ func (x *CreateEntityReconciliationTaskResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEntityReconciliationTaskResponse.ProtoReflect.Descriptor instead.
-func (*CreateEntityReconciliationTaskResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{142}
+// Deprecated: Use CreateProviderResponse.ProtoReflect.Descriptor instead.
+func (*CreateProviderResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{209}
 }
 
-type ListRolesRequest struct {
+func (x *CreateProviderResponse) GetProvider() *Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *CreateProviderResponse) GetAuthorization() *AuthorizationParams {
+	if x != nil {
+		return x.Authorization
+	}
+	return nil
+}
+
+type DeleteProviderRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the roles are evaluated.
+	// context is the context in which the provider is deleted.  Both
+	// project and provider are required in this context.
 	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRolesRequest) Reset() {
-	*x = ListRolesRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[143]
+func (x *DeleteProviderRequest) Reset() {
+	*x = DeleteProviderRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRolesRequest) String() string {
+func (x *DeleteProviderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRolesRequest) ProtoMessage() {}
+func (*DeleteProviderRequest) ProtoMessage() {}
 
-func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[143]
+func (x *DeleteProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8999,40 +12919,41 @@ func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
-func (*ListRolesRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{143}
+// Deprecated: Use DeleteProviderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProviderRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *ListRolesRequest) GetContext() *Context {
+func (x *DeleteProviderRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-type ListRolesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Roles         []*Role                `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+type DeleteProviderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the provider that was deleted
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRolesResponse) Reset() {
-	*x = ListRolesResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[144]
+func (x *DeleteProviderResponse) Reset() {
+	*x = DeleteProviderResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRolesResponse) String() string {
+func (x *DeleteProviderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRolesResponse) ProtoMessage() {}
+func (*DeleteProviderResponse) ProtoMessage() {}
 
-func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[144]
+func (x *DeleteProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9043,41 +12964,43 @@ func (x *ListRolesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRolesResponse.ProtoReflect.Descriptor instead.
-func (*ListRolesResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{144}
+// Deprecated: Use DeleteProviderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProviderResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{211}
 }
 
-func (x *ListRolesResponse) GetRoles() []*Role {
+func (x *DeleteProviderResponse) GetName() string {
 	if x != nil {
-		return x.Roles
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type ListRoleAssignmentsRequest struct {
+type DeleteProviderByIDRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the role assignments are evaluated.
-	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// context is the context in which the provider is deleted. Only the project is required in this context.
+	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// id is the id of the provider to delete
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRoleAssignmentsRequest) Reset() {
-	*x = ListRoleAssignmentsRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[145]
+func (x *DeleteProviderByIDRequest) Reset() {
+	*x = DeleteProviderByIDRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRoleAssignmentsRequest) String() string {
+func (x *DeleteProviderByIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRoleAssignmentsRequest) ProtoMessage() {}
+func (*DeleteProviderByIDRequest) ProtoMessage() {}
 
-func (x *ListRoleAssignmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[145]
+func (x *DeleteProviderByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9088,45 +13011,48 @@ func (x *ListRoleAssignmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRoleAssignmentsRequest.ProtoReflect.Descriptor instead.
-func (*ListRoleAssignmentsRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{145}
+// Deprecated: Use DeleteProviderByIDRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProviderByIDRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{212}
 }
 
-func (x *ListRoleAssignmentsRequest) GetContext() *Context {
+func (x *DeleteProviderByIDRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-type ListRoleAssignmentsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// role_assignments contains permission grants which have been accepted
-	// by a user.
-	RoleAssignments []*RoleAssignment `protobuf:"bytes,1,rep,name=role_assignments,json=roleAssignments,proto3" json:"role_assignments,omitempty"`
-	// invitations contains outstanding role invitations which have not yet
-	// been accepted by a user.
-	Invitations   []*Invitation `protobuf:"bytes,2,rep,name=invitations,proto3" json:"invitations,omitempty"`
+func (x *DeleteProviderByIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProviderByIDResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the id of the provider that was deleted
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRoleAssignmentsResponse) Reset() {
-	*x = ListRoleAssignmentsResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[146]
+func (x *DeleteProviderByIDResponse) Reset() {
+	*x = DeleteProviderByIDResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRoleAssignmentsResponse) String() string {
+func (x *DeleteProviderByIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRoleAssignmentsResponse) ProtoMessage() {}
+func (*DeleteProviderByIDResponse) ProtoMessage() {}
 
-func (x *ListRoleAssignmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[146]
+func (x *DeleteProviderByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9137,50 +13063,41 @@ func (x *ListRoleAssignmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRoleAssignmentsResponse.ProtoReflect.Descriptor instead.
-func (*ListRoleAssignmentsResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{146}
-}
-
-func (x *ListRoleAssignmentsResponse) GetRoleAssignments() []*RoleAssignment {
-	if x != nil {
-		return x.RoleAssignments
-	}
-	return nil
+// Deprecated: Use DeleteProviderByIDResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProviderByIDResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{213}
 }
 
-func (x *ListRoleAssignmentsResponse) GetInvitations() []*Invitation {
+func (x *DeleteProviderByIDResponse) GetId() string {
 	if x != nil {
-		return x.Invitations
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type AssignRoleRequest struct {
+type ListProviderClassesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the role assignment is evaluated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// role_assignment is the role assignment to be created.
-	RoleAssignment *RoleAssignment `protobuf:"bytes,2,opt,name=role_assignment,json=roleAssignment,proto3" json:"role_assignment,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// context is the context in which the provider classes are evaluated.
+	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssignRoleRequest) Reset() {
-	*x = AssignRoleRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[147]
+func (x *ListProviderClassesRequest) Reset() {
+	*x = ListProviderClassesRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignRoleRequest) String() string {
+func (x *ListProviderClassesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignRoleRequest) ProtoMessage() {}
+func (*ListProviderClassesRequest) ProtoMessage() {}
 
-func (x *AssignRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[147]
+func (x *ListProviderClassesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9191,53 +13108,41 @@ func (x *AssignRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignRoleRequest.ProtoReflect.Descriptor instead.
-func (*AssignRoleRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{147}
+// Deprecated: Use ListProviderClassesRequest.ProtoReflect.Descriptor instead.
+func (*ListProviderClassesRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{214}
 }
 
-func (x *AssignRoleRequest) GetContext() *Context {
+func (x *ListProviderClassesRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *AssignRoleRequest) GetRoleAssignment() *RoleAssignment {
-	if x != nil {
-		return x.RoleAssignment
-	}
-	return nil
-}
-
-type AssignRoleResponse struct {
+type ListProviderClassesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// role_assignment is the role assignment that was created.
-	// This is optional.
-	RoleAssignment *RoleAssignment `protobuf:"bytes,1,opt,name=role_assignment,json=roleAssignment,proto3" json:"role_assignment,omitempty"`
-	// invitation contains the details of the invitation for the
-	// assigned user to join the project if the user is not already
-	// a member. This is optional.
-	Invitation    *Invitation `protobuf:"bytes,2,opt,name=invitation,proto3" json:"invitation,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// provider_classes is the list of provider classes.
+	ProviderClasses []string `protobuf:"bytes,1,rep,name=provider_classes,json=providerClasses,proto3" json:"provider_classes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *AssignRoleResponse) Reset() {
-	*x = AssignRoleResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[148]
+func (x *ListProviderClassesResponse) Reset() {
+	*x = ListProviderClassesResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignRoleResponse) String() string {
+func (x *ListProviderClassesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignRoleResponse) ProtoMessage() {}
+func (*ListProviderClassesResponse) ProtoMessage() {}
 
-func (x *AssignRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[148]
+func (x *ListProviderClassesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9248,56 +13153,44 @@ func (x *AssignRoleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignRoleResponse.ProtoReflect.Descriptor instead.
-func (*AssignRoleResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{148}
-}
-
-func (x *AssignRoleResponse) GetRoleAssignment() *RoleAssignment {
-	if x != nil {
-		return x.RoleAssignment
-	}
-	return nil
+// Deprecated: Use ListProviderClassesResponse.ProtoReflect.Descriptor instead.
+func (*ListProviderClassesResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{215}
 }
 
-func (x *AssignRoleResponse) GetInvitation() *Invitation {
+func (x *ListProviderClassesResponse) GetProviderClasses() []string {
 	if x != nil {
-		return x.Invitation
+		return x.ProviderClasses
 	}
 	return nil
 }
 
-type UpdateRoleRequest struct {
+type PatchProviderRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the role assignment is evaluated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// subject is the account to change permissions for.
-	// The account must already have permissions on the project
-	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
-	// All subject roles are _replaced_ with the following role assignments.  Must be non-empty,
-	// use RemoveRole to remove permissions entirely from the project.
-	Roles []string `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
-	// email is the email address of the subject used for updating invitations
-	Email         string `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	// context is the context in which the provider is updated.
+	// The provider name is required in this context.
+	Context       *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Patch         *Provider              `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRoleRequest) Reset() {
-	*x = UpdateRoleRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[149]
+func (x *PatchProviderRequest) Reset() {
+	*x = PatchProviderRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRoleRequest) String() string {
+func (x *PatchProviderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRoleRequest) ProtoMessage() {}
+func (*PatchProviderRequest) ProtoMessage() {}
 
-func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[149]
+func (x *PatchProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9308,64 +13201,54 @@ func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{149}
+// Deprecated: Use PatchProviderRequest.ProtoReflect.Descriptor instead.
+func (*PatchProviderRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{216}
 }
 
-func (x *UpdateRoleRequest) GetContext() *Context {
+func (x *PatchProviderRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *UpdateRoleRequest) GetSubject() string {
-	if x != nil {
-		return x.Subject
-	}
-	return ""
-}
-
-func (x *UpdateRoleRequest) GetRoles() []string {
+func (x *PatchProviderRequest) GetPatch() *Provider {
 	if x != nil {
-		return x.Roles
+		return x.Patch
 	}
 	return nil
 }
 
-func (x *UpdateRoleRequest) GetEmail() string {
+func (x *PatchProviderRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.Email
+		return x.UpdateMask
 	}
-	return ""
+	return nil
 }
 
-type UpdateRoleResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// role_assignments are the role assignments that were updated.
-	RoleAssignments []*RoleAssignment `protobuf:"bytes,1,rep,name=role_assignments,json=roleAssignments,proto3" json:"role_assignments,omitempty"`
-	// invitations contains the details of the invitations that were updated.
-	Invitations   []*Invitation `protobuf:"bytes,2,rep,name=invitations,proto3" json:"invitations,omitempty"`
+type PatchProviderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      *Provider              `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRoleResponse) Reset() {
-	*x = UpdateRoleResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[150]
+func (x *PatchProviderResponse) Reset() {
+	*x = PatchProviderResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRoleResponse) String() string {
+func (x *PatchProviderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRoleResponse) ProtoMessage() {}
+func (*PatchProviderResponse) ProtoMessage() {}
 
-func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[150]
+func (x *PatchProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9376,50 +13259,41 @@ func (x *UpdateRoleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRoleResponse.ProtoReflect.Descriptor instead.
-func (*UpdateRoleResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{150}
-}
-
-func (x *UpdateRoleResponse) GetRoleAssignments() []*RoleAssignment {
-	if x != nil {
-		return x.RoleAssignments
-	}
-	return nil
+// Deprecated: Use PatchProviderResponse.ProtoReflect.Descriptor instead.
+func (*PatchProviderResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{217}
 }
 
-func (x *UpdateRoleResponse) GetInvitations() []*Invitation {
+func (x *PatchProviderResponse) GetProvider() *Provider {
 	if x != nil {
-		return x.Invitations
+		return x.Provider
 	}
 	return nil
 }
 
-type RemoveRoleRequest struct {
+type AuthorizationParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the role assignment is evaluated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// role_assignment is the role assignment to be removed.
-	RoleAssignment *RoleAssignment `protobuf:"bytes,2,opt,name=role_assignment,json=roleAssignment,proto3" json:"role_assignment,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// authorization_url is an external URL to use to authorize the provider.
+	AuthorizationUrl string `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *RemoveRoleRequest) Reset() {
-	*x = RemoveRoleRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[151]
+func (x *AuthorizationParams) Reset() {
+	*x = AuthorizationParams{}
+	mi := &file_minder_v1_minder_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveRoleRequest) String() string {
+func (x *AuthorizationParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveRoleRequest) ProtoMessage() {}
+func (*AuthorizationParams) ProtoMessage() {}
 
-func (x *RemoveRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[151]
+func (x *AuthorizationParams) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9430,50 +13304,43 @@ func (x *RemoveRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveRoleRequest.ProtoReflect.Descriptor instead.
-func (*RemoveRoleRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{151}
-}
-
-func (x *RemoveRoleRequest) GetContext() *Context {
-	if x != nil {
-		return x.Context
-	}
-	return nil
+// Deprecated: Use AuthorizationParams.ProtoReflect.Descriptor instead.
+func (*AuthorizationParams) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{218}
 }
 
-func (x *RemoveRoleRequest) GetRoleAssignment() *RoleAssignment {
+func (x *AuthorizationParams) GetAuthorizationUrl() string {
 	if x != nil {
-		return x.RoleAssignment
+		return x.AuthorizationUrl
 	}
-	return nil
+	return ""
 }
 
-type RemoveRoleResponse struct {
+type ProviderParameter struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// role_assignment is the role assignment that was removed.
-	RoleAssignment *RoleAssignment `protobuf:"bytes,1,opt,name=role_assignment,json=roleAssignment,proto3" json:"role_assignment,omitempty"`
-	// invitation contains the details of the invitation that was removed.
-	Invitation    *Invitation `protobuf:"bytes,2,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	// Types that are valid to be assigned to Parameters:
+	//
+	//	*ProviderParameter_GithubApp
+	Parameters    isProviderParameter_Parameters `protobuf_oneof:"parameters"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveRoleResponse) Reset() {
-	*x = RemoveRoleResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[152]
+func (x *ProviderParameter) Reset() {
+	*x = ProviderParameter{}
+	mi := &file_minder_v1_minder_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveRoleResponse) String() string {
+func (x *ProviderParameter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveRoleResponse) ProtoMessage() {}
+func (*ProviderParameter) ProtoMessage() {}
 
-func (x *RemoveRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[152]
+func (x *ProviderParameter) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9484,52 +13351,70 @@ func (x *RemoveRoleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveRoleResponse.ProtoReflect.Descriptor instead.
-func (*RemoveRoleResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{152}
+// Deprecated: Use ProviderParameter.ProtoReflect.Descriptor instead.
+func (*ProviderParameter) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{219}
 }
 
-func (x *RemoveRoleResponse) GetRoleAssignment() *RoleAssignment {
+func (x *ProviderParameter) GetParameters() isProviderParameter_Parameters {
 	if x != nil {
-		return x.RoleAssignment
+		return x.Parameters
 	}
 	return nil
 }
 
-func (x *RemoveRoleResponse) GetInvitation() *Invitation {
+func (x *ProviderParameter) GetGithubApp() *GitHubAppParams {
 	if x != nil {
-		return x.Invitation
+		if x, ok := x.Parameters.(*ProviderParameter_GithubApp); ok {
+			return x.GithubApp
+		}
 	}
 	return nil
 }
 
-type Role struct {
+type isProviderParameter_Parameters interface {
+	isProviderParameter_Parameters()
+}
+
+type ProviderParameter_GithubApp struct {
+	GithubApp *GitHubAppParams `protobuf:"bytes,1,opt,name=github_app,json=githubApp,proto3,oneof"`
+}
+
+func (*ProviderParameter_GithubApp) isProviderParameter_Parameters() {}
+
+// GitHubAppParams is the parameters for a GitHub App provider.
+type GitHubAppParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name is the name of the role.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// display name of the role
-	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// description is the description of the role.
-	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The GitHub installation ID for the app.  On create, this is the only
+	// parameter used; the organization parameters are ignored.
+	InstallationId int64 `protobuf:"varint,1,opt,name=installation_id,json=installationId,proto3" json:"installation_id,omitempty"`
+	// The GitHub organization slug where the app is installed.  This is an
+	// output-only parameter, and is validated on input if set (i.e. the value
+	// must be either empty or match the org of the installation_id).
+	Organization string `protobuf:"bytes,2,opt,name=organization,proto3" json:"organization,omitempty"`
+	// The GitHub organization ID where the app is installed.  This is an
+	// output-only parameter, and is validated on input if set (i.e. the value
+	// must be either empty or match the org of the installation_id).
+	OrganizationId int64 `protobuf:"varint,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Role) Reset() {
-	*x = Role{}
-	mi := &file_minder_v1_minder_proto_msgTypes[153]
+func (x *GitHubAppParams) Reset() {
+	*x = GitHubAppParams{}
+	mi := &file_minder_v1_minder_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Role) String() string {
+func (x *GitHubAppParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Role) ProtoMessage() {}
+func (*GitHubAppParams) ProtoMessage() {}
 
-func (x *Role) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[153]
+func (x *GitHubAppParams) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9540,68 +13425,81 @@ func (x *Role) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Role.ProtoReflect.Descriptor instead.
-func (*Role) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{153}
+// Deprecated: Use GitHubAppParams.ProtoReflect.Descriptor instead.
+func (*GitHubAppParams) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{220}
 }
 
-func (x *Role) GetName() string {
+func (x *GitHubAppParams) GetInstallationId() int64 {
 	if x != nil {
-		return x.Name
+		return x.InstallationId
 	}
-	return ""
+	return 0
 }
 
-func (x *Role) GetDisplayName() string {
+func (x *GitHubAppParams) GetOrganization() string {
 	if x != nil {
-		return x.DisplayName
+		return x.Organization
 	}
 	return ""
 }
 
-func (x *Role) GetDescription() string {
+func (x *GitHubAppParams) GetOrganizationId() int64 {
 	if x != nil {
-		return x.Description
+		return x.OrganizationId
 	}
-	return ""
+	return 0
 }
 
-type RoleAssignment struct {
+// Provider represents a provider that is used to interact with external systems.
+// All fields are optional because we want to allow partial updates.
+type Provider struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// role is the role that is assigned.
-	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
-	// subject is the subject to which the role is assigned.
-	// Can be either a UUID or a providername/subject string.
-	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
-	// display_name is the display name of the subject.
-	DisplayName string `protobuf:"bytes,5,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	// project is the project in which the role is assigned.
-	Project *string `protobuf:"bytes,4,opt,name=project,proto3,oneof" json:"project,omitempty"`
-	// email is the email address of the subject used for invitations.
-	Email string `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
-	// first_name is the first name of the subject.
-	FirstName string `protobuf:"bytes,7,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	// last_name is the last name of the subject.
-	LastName      string `protobuf:"bytes,8,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	// name is the name of the provider.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// class is the name of the provider implementation, eg. 'github' or 'gh-app'.
+	Class string `protobuf:"bytes,7,opt,name=class,proto3" json:"class,omitempty"`
+	// project is the project where the provider is.  This is ignored on input
+	// in favor of the context field in CreateProviderRequest.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// version is the version of the provider.
+	// if unset, "v1" is assumed.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// implements is the list of interfaces that the provider implements.
+	Implements []ProviderType `protobuf:"varint,4,rep,packed,name=implements,proto3,enum=minder.v1.ProviderType" json:"implements,omitempty"`
+	// config is the configuration of the provider.
+	Config *structpb.Struct `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	// auth_flows is the list of authorization flows that the provider supports.
+	AuthFlows []AuthorizationFlow `protobuf:"varint,6,rep,packed,name=auth_flows,json=authFlows,proto3,enum=minder.v1.AuthorizationFlow" json:"auth_flows,omitempty"`
+	// parameters is the list of parameters that the provider requires.
+	Parameters *ProviderParameter `protobuf:"bytes,8,opt,name=parameters,proto3" json:"parameters,omitempty"`
+	// credentials_state is the state of the credentials for the provider.
+	// This is an output-only field. It may be: "set", "unset", "not_applicable".
+	CredentialsState string `protobuf:"bytes,9,opt,name=credentials_state,json=credentialsState,proto3" json:"credentials_state,omitempty"`
+	// id is the unique identifier of the provider.
+	Id string `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	// health is the result of the last health check of the provider.
+	// This is an output-only field. It is unset until the provider is checked.
+	Health        *ProviderHealth `protobuf:"bytes,11,opt,name=health,proto3" json:"health,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RoleAssignment) Reset() {
-	*x = RoleAssignment{}
-	mi := &file_minder_v1_minder_proto_msgTypes[154]
+func (x *Provider) Reset() {
+	*x = Provider{}
+	mi := &file_minder_v1_minder_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RoleAssignment) String() string {
+func (x *Provider) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleAssignment) ProtoMessage() {}
+func (*Provider) ProtoMessage() {}
 
-func (x *RoleAssignment) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[154]
+func (x *Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9612,118 +13510,123 @@ func (x *RoleAssignment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleAssignment.ProtoReflect.Descriptor instead.
-func (*RoleAssignment) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{154}
+// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
+func (*Provider) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{221}
 }
 
-func (x *RoleAssignment) GetRole() string {
+func (x *Provider) GetName() string {
 	if x != nil {
-		return x.Role
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RoleAssignment) GetSubject() string {
+func (x *Provider) GetClass() string {
 	if x != nil {
-		return x.Subject
+		return x.Class
 	}
 	return ""
 }
 
-func (x *RoleAssignment) GetDisplayName() string {
+func (x *Provider) GetProject() string {
 	if x != nil {
-		return x.DisplayName
+		return x.Project
 	}
 	return ""
 }
 
-func (x *RoleAssignment) GetProject() string {
-	if x != nil && x.Project != nil {
-		return *x.Project
+func (x *Provider) GetVersion() string {
+	if x != nil {
+		return x.Version
 	}
 	return ""
 }
 
-func (x *RoleAssignment) GetEmail() string {
+func (x *Provider) GetImplements() []ProviderType {
 	if x != nil {
-		return x.Email
+		return x.Implements
 	}
-	return ""
+	return nil
 }
 
-func (x *RoleAssignment) GetFirstName() string {
+func (x *Provider) GetConfig() *structpb.Struct {
 	if x != nil {
-		return x.FirstName
+		return x.Config
 	}
-	return ""
+	return nil
 }
 
-func (x *RoleAssignment) GetLastName() string {
+func (x *Provider) GetAuthFlows() []AuthorizationFlow {
 	if x != nil {
-		return x.LastName
+		return x.AuthFlows
 	}
-	return ""
-}
-
-type ListInvitationsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListInvitationsRequest) Reset() {
-	*x = ListInvitationsRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[155]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return nil
 }
 
-func (x *ListInvitationsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Provider) GetParameters() *ProviderParameter {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
 }
 
-func (*ListInvitationsRequest) ProtoMessage() {}
-
-func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[155]
+func (x *Provider) GetCredentialsState() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CredentialsState
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
-func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{155}
+func (x *Provider) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-type ListInvitationsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Invitations   []*Invitation          `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Provider) GetHealth() *ProviderHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
 }
 
-func (x *ListInvitationsResponse) Reset() {
-	*x = ListInvitationsResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[156]
+// ProviderHealth is the result of the periodic health check of a provider.
+type ProviderHealth struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// status is the health of the provider. It may be: "healthy", "degraded", "unhealthy".
+	// A degraded provider still works, but needs attention soon, e.g. because
+	// its credential is about to expire or its webhook is unreachable.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// last_error is the problem found by the last check, if any.
+	LastError string `protobuf:"bytes,2,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// checked_at is the time of the last check.
+	CheckedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	// last_healthy_at is the last time the provider was found healthy.
+	LastHealthyAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_healthy_at,json=lastHealthyAt,proto3" json:"last_healthy_at,omitempty"`
+	// credential_expires_at is the expiry of the provider credential.
+	// It is unset if the credential does not expire, or its expiry is unknown.
+	CredentialExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=credential_expires_at,json=credentialExpiresAt,proto3" json:"credential_expires_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ProviderHealth) Reset() {
+	*x = ProviderHealth{}
+	mi := &file_minder_v1_minder_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListInvitationsResponse) String() string {
+func (x *ProviderHealth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListInvitationsResponse) ProtoMessage() {}
+func (*ProviderHealth) ProtoMessage() {}
 
-func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[156]
+func (x *ProviderHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9734,43 +13637,70 @@ func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
-func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{156}
+// Deprecated: Use ProviderHealth.ProtoReflect.Descriptor instead.
+func (*ProviderHealth) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{222}
 }
 
-func (x *ListInvitationsResponse) GetInvitations() []*Invitation {
+func (x *ProviderHealth) GetStatus() string {
 	if x != nil {
-		return x.Invitations
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ProviderHealth) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ProviderHealth) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
 	}
 	return nil
 }
 
-type ResolveInvitationRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// code is the code of the invitation to resolve.
-	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	// accept is true if the invitation is accepted, false if it is rejected.
-	Accept        bool `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+func (x *ProviderHealth) GetLastHealthyAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastHealthyAt
+	}
+	return nil
+}
+
+func (x *ProviderHealth) GetCredentialExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CredentialExpiresAt
+	}
+	return nil
+}
+
+// GetEvaluationHistoryRequest represents a request for the GetEvaluationHistory endpoint
+type GetEvaluationHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Context       *Context               `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResolveInvitationRequest) Reset() {
-	*x = ResolveInvitationRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[157]
+func (x *GetEvaluationHistoryRequest) Reset() {
+	*x = GetEvaluationHistoryRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolveInvitationRequest) String() string {
+func (x *GetEvaluationHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveInvitationRequest) ProtoMessage() {}
+func (*GetEvaluationHistoryRequest) ProtoMessage() {}
 
-func (x *ResolveInvitationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[157]
+func (x *GetEvaluationHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9781,58 +13711,76 @@ func (x *ResolveInvitationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveInvitationRequest.ProtoReflect.Descriptor instead.
-func (*ResolveInvitationRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{157}
+// Deprecated: Use GetEvaluationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetEvaluationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{223}
 }
 
-func (x *ResolveInvitationRequest) GetCode() string {
+func (x *GetEvaluationHistoryRequest) GetId() string {
 	if x != nil {
-		return x.Code
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ResolveInvitationRequest) GetAccept() bool {
+func (x *GetEvaluationHistoryRequest) GetContext() *Context {
 	if x != nil {
-		return x.Accept
+		return x.Context
 	}
-	return false
+	return nil
 }
 
-type ResolveInvitationResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// role is the role that would be assigned if the user
-	// accepts the invitation.
-	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
-	// email is the email address of the invited user.
-	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	// project is the project to which the user is invited.
-	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
-	// is_accepted is the status of the invitation.
-	IsAccepted bool `protobuf:"varint,4,opt,name=is_accepted,json=isAccepted,proto3" json:"is_accepted,omitempty"`
-	// project_display is the display name of the project to which the user
-	// is invited.
-	ProjectDisplay string `protobuf:"bytes,5,opt,name=project_display,json=projectDisplay,proto3" json:"project_display,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// ListEvaluationHistoryRequest represents a request message for the
+// ListEvaluationHistory RPC.
+//
+// Most of its fields are used for filtering, except for `cursor`
+// which is used for pagination.
+type ListEvaluationHistoryRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// List of entity types to retrieve.
+	EntityType []string `protobuf:"bytes,2,rep,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	// List of entity names to retrieve.
+	EntityName []string `protobuf:"bytes,3,rep,name=entity_name,json=entityName,proto3" json:"entity_name,omitempty"`
+	// List of profile names to retrieve.
+	ProfileName []string `protobuf:"bytes,4,rep,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	// List of evaluation statuses to retrieve.
+	Status []string `protobuf:"bytes,5,rep,name=status,proto3" json:"status,omitempty"`
+	// List of remediation statuses to retrieve.
+	Remediation []string `protobuf:"bytes,6,rep,name=remediation,proto3" json:"remediation,omitempty"`
+	// List of alert statuses to retrieve.
+	Alert []string `protobuf:"bytes,7,rep,name=alert,proto3" json:"alert,omitempty"`
+	// Timestamp representing the start time of the selection window.
+	From *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=from,proto3" json:"from,omitempty"`
+	// Timestamp representing the end time of the selection window.
+	To *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=to,proto3" json:"to,omitempty"`
+	// Filter evaluation history to only those matching the specified labels.
+	//
+	// The default is to return all user-created profiles; the string "*" can
+	// be used to select all profiles, including system profiles.  This syntax
+	// may be expanded in the future.
+	LabelFilter []string `protobuf:"bytes,11,rep,name=label_filter,json=labelFilter,proto3" json:"label_filter,omitempty"`
+	// Cursor object to select the "page" of data to retrieve. This is optional.
+	Cursor        *Cursor `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResolveInvitationResponse) Reset() {
-	*x = ResolveInvitationResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[158]
+func (x *ListEvaluationHistoryRequest) Reset() {
+	*x = ListEvaluationHistoryRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolveInvitationResponse) String() string {
+func (x *ListEvaluationHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveInvitationResponse) ProtoMessage() {}
+func (*ListEvaluationHistoryRequest) ProtoMessage() {}
 
-func (x *ResolveInvitationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[158]
+func (x *ListEvaluationHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9843,101 +13791,113 @@ func (x *ResolveInvitationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveInvitationResponse.ProtoReflect.Descriptor instead.
-func (*ResolveInvitationResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{158}
+// Deprecated: Use ListEvaluationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListEvaluationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{224}
 }
 
-func (x *ResolveInvitationResponse) GetRole() string {
+func (x *ListEvaluationHistoryRequest) GetContext() *Context {
 	if x != nil {
-		return x.Role
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-func (x *ResolveInvitationResponse) GetEmail() string {
+func (x *ListEvaluationHistoryRequest) GetEntityType() []string {
 	if x != nil {
-		return x.Email
+		return x.EntityType
 	}
-	return ""
+	return nil
 }
 
-func (x *ResolveInvitationResponse) GetProject() string {
+func (x *ListEvaluationHistoryRequest) GetEntityName() []string {
 	if x != nil {
-		return x.Project
+		return x.EntityName
 	}
-	return ""
+	return nil
 }
 
-func (x *ResolveInvitationResponse) GetIsAccepted() bool {
+func (x *ListEvaluationHistoryRequest) GetProfileName() []string {
 	if x != nil {
-		return x.IsAccepted
+		return x.ProfileName
 	}
-	return false
+	return nil
 }
 
-func (x *ResolveInvitationResponse) GetProjectDisplay() string {
+func (x *ListEvaluationHistoryRequest) GetStatus() []string {
 	if x != nil {
-		return x.ProjectDisplay
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-// Invitation is an invitation to join a project. This is only used in responses.
-type Invitation struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// role is the role that would be assigned if the user
-	// accepts the invitation.
-	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
-	// email is the email address of the invited user.  This is
-	// presented as a convenience for display purposes, and does
-	// not affect who can accept the invitation using the code.
-	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	// project is the project to which the user is invited.
-	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
-	// code is a unique identifier for the invitation, which can
-	// be used by the recipient to accept or reject the invitation.
-	// The code is only transmitted in response to AssignRole or
-	// ListInvitations RPCs, and not transmitted in
-	// ListRoleAssignments or other calls.
-	Code string `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
-	// created_at is the time at which the invitation was created.
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// expires_at is the time at which the invitation expires.
-	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	// expired is true if the invitation has expired.
-	Expired bool `protobuf:"varint,9,opt,name=expired,proto3" json:"expired,omitempty"`
-	// sponsor is the account (ID) of the user who created the invitation.
-	Sponsor string `protobuf:"bytes,7,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
-	// sponsor_display is the display name of the user who created the
-	// invitation.
-	SponsorDisplay string `protobuf:"bytes,8,opt,name=sponsor_display,json=sponsorDisplay,proto3" json:"sponsor_display,omitempty"`
-	// project_display is the display name of the project to which the user
-	// is invited.
-	ProjectDisplay string `protobuf:"bytes,10,opt,name=project_display,json=projectDisplay,proto3" json:"project_display,omitempty"`
-	// inviteURL is the URL that can be used to accept the invitation.
-	InviteUrl string `protobuf:"bytes,11,opt,name=invite_url,json=inviteUrl,proto3" json:"invite_url,omitempty"`
-	// emailSkipped is true if the email was not sent to the invitee.
-	EmailSkipped  bool `protobuf:"varint,12,opt,name=email_skipped,json=emailSkipped,proto3" json:"email_skipped,omitempty"`
+func (x *ListEvaluationHistoryRequest) GetRemediation() []string {
+	if x != nil {
+		return x.Remediation
+	}
+	return nil
+}
+
+func (x *ListEvaluationHistoryRequest) GetAlert() []string {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+func (x *ListEvaluationHistoryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListEvaluationHistoryRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListEvaluationHistoryRequest) GetLabelFilter() []string {
+	if x != nil {
+		return x.LabelFilter
+	}
+	return nil
+}
+
+func (x *ListEvaluationHistoryRequest) GetCursor() *Cursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+// GetEvaluationHistoryResponse represents a response message for the
+// GetEvaluationHistory RPC.
+type GetEvaluationHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The requested record
+	Evaluation    *EvaluationHistory `protobuf:"bytes,1,opt,name=evaluation,proto3" json:"evaluation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Invitation) Reset() {
-	*x = Invitation{}
-	mi := &file_minder_v1_minder_proto_msgTypes[159]
+func (x *GetEvaluationHistoryResponse) Reset() {
+	*x = GetEvaluationHistoryResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[225]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Invitation) String() string {
+func (x *GetEvaluationHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Invitation) ProtoMessage() {}
+func (*GetEvaluationHistoryResponse) ProtoMessage() {}
 
-func (x *Invitation) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[159]
+func (x *GetEvaluationHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[225]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9948,120 +13908,206 @@ func (x *Invitation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
-func (*Invitation) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{159}
+// Deprecated: Use GetEvaluationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetEvaluationHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{225}
 }
 
-func (x *Invitation) GetRole() string {
+func (x *GetEvaluationHistoryResponse) GetEvaluation() *EvaluationHistory {
 	if x != nil {
-		return x.Role
+		return x.Evaluation
 	}
-	return ""
+	return nil
 }
 
-func (x *Invitation) GetEmail() string {
+// WatchEvaluationsRequest represents a request message for the
+// WatchEvaluations RPC. Its fields filter the streamed evaluations.
+type WatchEvaluationsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// List of entity types to watch.
+	EntityType []string `protobuf:"bytes,2,rep,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	// List of entity names to watch.
+	EntityName []string `protobuf:"bytes,3,rep,name=entity_name,json=entityName,proto3" json:"entity_name,omitempty"`
+	// List of profile names to watch.
+	ProfileName []string `protobuf:"bytes,4,rep,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	// List of evaluation statuses to watch.
+	Status []string `protobuf:"bytes,5,rep,name=status,proto3" json:"status,omitempty"`
+	// List of remediation statuses to watch.
+	Remediation []string `protobuf:"bytes,6,rep,name=remediation,proto3" json:"remediation,omitempty"`
+	// List of alert statuses to watch.
+	Alert []string `protobuf:"bytes,7,rep,name=alert,proto3" json:"alert,omitempty"`
+	// Filter evaluations to only those matching the specified labels.
+	//
+	// The default is to return all user-created profiles; the string "*" can
+	// be used to select all profiles, including system profiles.  This syntax
+	// may be expanded in the future.
+	LabelFilter   []string `protobuf:"bytes,8,rep,name=label_filter,json=labelFilter,proto3" json:"label_filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEvaluationsRequest) Reset() {
+	*x = WatchEvaluationsRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[226]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEvaluationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEvaluationsRequest) ProtoMessage() {}
+
+func (x *WatchEvaluationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[226]
 	if x != nil {
-		return x.Email
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Invitation) GetProject() string {
+// Deprecated: Use WatchEvaluationsRequest.ProtoReflect.Descriptor instead.
+func (*WatchEvaluationsRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{226}
+}
+
+func (x *WatchEvaluationsRequest) GetContext() *Context {
 	if x != nil {
-		return x.Project
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-func (x *Invitation) GetCode() string {
+func (x *WatchEvaluationsRequest) GetEntityType() []string {
 	if x != nil {
-		return x.Code
+		return x.EntityType
 	}
-	return ""
+	return nil
 }
 
-func (x *Invitation) GetCreatedAt() *timestamppb.Timestamp {
+func (x *WatchEvaluationsRequest) GetEntityName() []string {
 	if x != nil {
-		return x.CreatedAt
+		return x.EntityName
 	}
 	return nil
 }
 
-func (x *Invitation) GetExpiresAt() *timestamppb.Timestamp {
+func (x *WatchEvaluationsRequest) GetProfileName() []string {
 	if x != nil {
-		return x.ExpiresAt
+		return x.ProfileName
 	}
 	return nil
 }
 
-func (x *Invitation) GetExpired() bool {
+func (x *WatchEvaluationsRequest) GetStatus() []string {
 	if x != nil {
-		return x.Expired
+		return x.Status
 	}
-	return false
+	return nil
 }
 
-func (x *Invitation) GetSponsor() string {
+func (x *WatchEvaluationsRequest) GetRemediation() []string {
 	if x != nil {
-		return x.Sponsor
+		return x.Remediation
 	}
-	return ""
+	return nil
 }
 
-func (x *Invitation) GetSponsorDisplay() string {
+func (x *WatchEvaluationsRequest) GetAlert() []string {
 	if x != nil {
-		return x.SponsorDisplay
+		return x.Alert
 	}
-	return ""
+	return nil
 }
 
-func (x *Invitation) GetProjectDisplay() string {
+func (x *WatchEvaluationsRequest) GetLabelFilter() []string {
 	if x != nil {
-		return x.ProjectDisplay
+		return x.LabelFilter
 	}
-	return ""
+	return nil
 }
 
-func (x *Invitation) GetInviteUrl() string {
+// WatchEvaluationsResponse represents a response message for the
+// WatchEvaluations RPC.
+type WatchEvaluationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// evaluation is a newly recorded evaluation
+	Evaluation    *EvaluationHistory `protobuf:"bytes,1,opt,name=evaluation,proto3" json:"evaluation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEvaluationsResponse) Reset() {
+	*x = WatchEvaluationsResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[227]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEvaluationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEvaluationsResponse) ProtoMessage() {}
+
+func (x *WatchEvaluationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[227]
 	if x != nil {
-		return x.InviteUrl
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Invitation) GetEmailSkipped() bool {
+// Deprecated: Use WatchEvaluationsResponse.ProtoReflect.Descriptor instead.
+func (*WatchEvaluationsResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{227}
+}
+
+func (x *WatchEvaluationsResponse) GetEvaluation() *EvaluationHistory {
 	if x != nil {
-		return x.EmailSkipped
+		return x.Evaluation
 	}
-	return false
+	return nil
 }
 
-type GetProviderRequest struct {
+// ExplainEvaluationRequest represents a request message for the
+// ExplainEvaluation RPC.
+type ExplainEvaluationRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the provider is evaluated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// name is the name of the provider to get.
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// id is the id of the past evaluation to explain
+	Id      string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Context *Context `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	// trace requests the full trace of the evaluation, which can be long
+	Trace         bool `protobuf:"varint,3,opt,name=trace,proto3" json:"trace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProviderRequest) Reset() {
-	*x = GetProviderRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[160]
+func (x *ExplainEvaluationRequest) Reset() {
+	*x = ExplainEvaluationRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[228]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProviderRequest) String() string {
+func (x *ExplainEvaluationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProviderRequest) ProtoMessage() {}
+func (*ExplainEvaluationRequest) ProtoMessage() {}
 
-func (x *GetProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[160]
+func (x *ExplainEvaluationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[228]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10072,48 +14118,56 @@ func (x *GetProviderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProviderRequest.ProtoReflect.Descriptor instead.
-func (*GetProviderRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{160}
+// Deprecated: Use ExplainEvaluationRequest.ProtoReflect.Descriptor instead.
+func (*ExplainEvaluationRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{228}
 }
 
-func (x *GetProviderRequest) GetContext() *Context {
+func (x *ExplainEvaluationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExplainEvaluationRequest) GetContext() *Context {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *GetProviderRequest) GetName() string {
+func (x *ExplainEvaluationRequest) GetTrace() bool {
 	if x != nil {
-		return x.Name
+		return x.Trace
 	}
-	return ""
+	return false
 }
 
-type GetProviderResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// provider is the provider that was retrieved.
-	Provider      *Provider `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+// ExplainEvaluationResponse represents a response message for the
+// ExplainEvaluation RPC.
+type ExplainEvaluationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Explanation   *EvaluationExplanation `protobuf:"bytes,1,opt,name=explanation,proto3" json:"explanation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProviderResponse) Reset() {
-	*x = GetProviderResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[161]
+func (x *ExplainEvaluationResponse) Reset() {
+	*x = ExplainEvaluationResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[229]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProviderResponse) String() string {
+func (x *ExplainEvaluationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProviderResponse) ProtoMessage() {}
+func (*ExplainEvaluationResponse) ProtoMessage() {}
 
-func (x *GetProviderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[161]
+func (x *ExplainEvaluationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[229]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10124,46 +14178,56 @@ func (x *GetProviderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProviderResponse.ProtoReflect.Descriptor instead.
-func (*GetProviderResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{161}
+// Deprecated: Use ExplainEvaluationResponse.ProtoReflect.Descriptor instead.
+func (*ExplainEvaluationResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{229}
 }
 
-func (x *GetProviderResponse) GetProvider() *Provider {
+func (x *ExplainEvaluationResponse) GetExplanation() *EvaluationExplanation {
 	if x != nil {
-		return x.Provider
+		return x.Explanation
 	}
 	return nil
 }
 
-type ListProvidersRequest struct {
+// EvaluationExplanation explains a new evaluation of the rule and entity
+// of a past evaluation. Only Rego policies are explained, the other
+// evaluation types only report the status of the new evaluation.
+type EvaluationExplanation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the providers are evaluated.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// limit is the maximum number of providers to return.
-	// 0 uses a server-defined default.
-	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	// cursor is the cursor to use for the page of results, empty if at the beginning
-	Cursor        string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// evaluation is the past evaluation being explained
+	Evaluation *EvaluationHistory `protobuf:"bytes,1,opt,name=evaluation,proto3" json:"evaluation,omitempty"`
+	// status is the status of the new evaluation
+	Status *EvaluationHistoryStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// prints is the output of the print statements of the policy
+	Prints []string `protobuf:"bytes,3,rep,name=prints,proto3" json:"prints,omitempty"`
+	// rules are the rules of the policy that were evaluated
+	Rules []*EvaluationExplanation_Rule `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
+	// input is the input the policy was evaluated with, with its
+	// sensitive values redacted
+	Input *structpb.Struct `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
+	// trace is the trace of the evaluation, one expression per line.
+	// It is only set if requested.
+	Trace         []string `protobuf:"bytes,6,rep,name=trace,proto3" json:"trace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProvidersRequest) Reset() {
-	*x = ListProvidersRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[162]
+func (x *EvaluationExplanation) Reset() {
+	*x = EvaluationExplanation{}
+	mi := &file_minder_v1_minder_proto_msgTypes[230]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProvidersRequest) String() string {
+func (x *EvaluationExplanation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProvidersRequest) ProtoMessage() {}
+func (*EvaluationExplanation) ProtoMessage() {}
 
-func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[162]
+func (x *EvaluationExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[230]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10174,56 +14238,86 @@ func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProvidersRequest.ProtoReflect.Descriptor instead.
-func (*ListProvidersRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{162}
+// Deprecated: Use EvaluationExplanation.ProtoReflect.Descriptor instead.
+func (*EvaluationExplanation) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{230}
 }
 
-func (x *ListProvidersRequest) GetContext() *Context {
+func (x *EvaluationExplanation) GetEvaluation() *EvaluationHistory {
 	if x != nil {
-		return x.Context
+		return x.Evaluation
 	}
 	return nil
 }
 
-func (x *ListProvidersRequest) GetLimit() int32 {
+func (x *EvaluationExplanation) GetStatus() *EvaluationHistoryStatus {
 	if x != nil {
-		return x.Limit
+		return x.Status
 	}
-	return 0
+	return nil
 }
 
-func (x *ListProvidersRequest) GetCursor() string {
+func (x *EvaluationExplanation) GetPrints() []string {
 	if x != nil {
-		return x.Cursor
+		return x.Prints
 	}
-	return ""
+	return nil
 }
 
-type ListProvidersResponse struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	Providers []*Provider            `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
-	// cursor is the cursor to use for the next page of results, empty if at the end
-	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+func (x *EvaluationExplanation) GetRules() []*EvaluationExplanation_Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *EvaluationExplanation) GetInput() *structpb.Struct {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *EvaluationExplanation) GetTrace() []string {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+// GenerateComplianceReportRequest represents a request message for the
+// GenerateComplianceReport RPC.
+type GenerateComplianceReportRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Context *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// from is the start of the time range of the history trends. It
+	// defaults to 30 days before the end of the time range.
+	From *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the end of the time range of the history trends. It
+	// defaults to the current time.
+	To *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// format is the format the report is rendered to, one of json, csv
+	// or html. It defaults to json.
+	Format        string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProvidersResponse) Reset() {
-	*x = ListProvidersResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[163]
+func (x *GenerateComplianceReportRequest) Reset() {
+	*x = GenerateComplianceReportRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[231]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProvidersResponse) String() string {
+func (x *GenerateComplianceReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProvidersResponse) ProtoMessage() {}
+func (*GenerateComplianceReportRequest) ProtoMessage() {}
 
-func (x *ListProvidersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[163]
+func (x *GenerateComplianceReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[231]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10234,50 +14328,68 @@ func (x *ListProvidersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProvidersResponse.ProtoReflect.Descriptor instead.
-func (*ListProvidersResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{163}
+// Deprecated: Use GenerateComplianceReportRequest.ProtoReflect.Descriptor instead.
+func (*GenerateComplianceReportRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{231}
+}
+
+func (x *GenerateComplianceReportRequest) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GenerateComplianceReportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
 }
 
-func (x *ListProvidersResponse) GetProviders() []*Provider {
+func (x *GenerateComplianceReportRequest) GetTo() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Providers
+		return x.To
 	}
 	return nil
 }
 
-func (x *ListProvidersResponse) GetCursor() string {
+func (x *GenerateComplianceReportRequest) GetFormat() string {
 	if x != nil {
-		return x.Cursor
+		return x.Format
 	}
 	return ""
 }
 
-type CreateProviderRequest struct {
+// GenerateComplianceReportResponse represents a response message for the
+// GenerateComplianceReport RPC.
+type GenerateComplianceReportResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the provider is created.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// provider is the provider to be created.
-	Provider      *Provider `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	// report is the compliance report
+	Report *ComplianceReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	// rendered is the report rendered to the requested format
+	Rendered string `protobuf:"bytes,2,opt,name=rendered,proto3" json:"rendered,omitempty"`
+	// content_type is the media type of the rendered report
+	ContentType   string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProviderRequest) Reset() {
-	*x = CreateProviderRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[164]
+func (x *GenerateComplianceReportResponse) Reset() {
+	*x = GenerateComplianceReportResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[232]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProviderRequest) String() string {
+func (x *GenerateComplianceReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProviderRequest) ProtoMessage() {}
+func (*GenerateComplianceReportResponse) ProtoMessage() {}
 
-func (x *CreateProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[164]
+func (x *GenerateComplianceReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[232]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10288,51 +14400,74 @@ func (x *CreateProviderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProviderRequest.ProtoReflect.Descriptor instead.
-func (*CreateProviderRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{164}
+// Deprecated: Use GenerateComplianceReportResponse.ProtoReflect.Descriptor instead.
+func (*GenerateComplianceReportResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{232}
 }
 
-func (x *CreateProviderRequest) GetContext() *Context {
+func (x *GenerateComplianceReportResponse) GetReport() *ComplianceReport {
 	if x != nil {
-		return x.Context
+		return x.Report
 	}
 	return nil
 }
 
-func (x *CreateProviderRequest) GetProvider() *Provider {
+func (x *GenerateComplianceReportResponse) GetRendered() string {
 	if x != nil {
-		return x.Provider
+		return x.Rendered
 	}
-	return nil
+	return ""
 }
 
-type CreateProviderResponse struct {
+func (x *GenerateComplianceReportResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// ComplianceReport is a snapshot of the latest evaluation results of a
+// project and its sub-projects.
+type ComplianceReport struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// provider is the provider that was created.
-	Provider *Provider `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	// authorization provides additional authorization information needed
-	// to complete the initialization of the provider.
-	Authorization *AuthorizationParams `protobuf:"bytes,2,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	// project is the name of the project the report was generated for
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// project_id is the ID of the project the report was generated for
+	ProjectId   string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	GeneratedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	// from and to are the time range of the trends
+	From *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	// totals aggregates all the latest evaluation results
+	Totals     *ComplianceReport_Counts  `protobuf:"bytes,6,opt,name=totals,proto3" json:"totals,omitempty"`
+	ByProject  []*ComplianceReport_Group `protobuf:"bytes,7,rep,name=by_project,json=byProject,proto3" json:"by_project,omitempty"`
+	ByProfile  []*ComplianceReport_Group `protobuf:"bytes,8,rep,name=by_profile,json=byProfile,proto3" json:"by_profile,omitempty"`
+	ByRuleType []*ComplianceReport_Group `protobuf:"bytes,9,rep,name=by_rule_type,json=byRuleType,proto3" json:"by_rule_type,omitempty"`
+	BySeverity []*ComplianceReport_Group `protobuf:"bytes,10,rep,name=by_severity,json=bySeverity,proto3" json:"by_severity,omitempty"`
+	ByEntity   []*ComplianceReport_Group `protobuf:"bytes,11,rep,name=by_entity,json=byEntity,proto3" json:"by_entity,omitempty"`
+	// findings are the latest evaluation results
+	Findings []*ComplianceReport_Finding `protobuf:"bytes,12,rep,name=findings,proto3" json:"findings,omitempty"`
+	// trend aggregates the evaluation history by day
+	Trend         []*ComplianceReport_TrendPoint `protobuf:"bytes,13,rep,name=trend,proto3" json:"trend,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProviderResponse) Reset() {
-	*x = CreateProviderResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[165]
+func (x *ComplianceReport) Reset() {
+	*x = ComplianceReport{}
+	mi := &file_minder_v1_minder_proto_msgTypes[233]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProviderResponse) String() string {
+func (x *ComplianceReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProviderResponse) ProtoMessage() {}
+func (*ComplianceReport) ProtoMessage() {}
 
-func (x *CreateProviderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[165]
+func (x *ComplianceReport) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[233]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10343,94 +14478,133 @@ func (x *CreateProviderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProviderResponse.ProtoReflect.Descriptor instead.
-func (*CreateProviderResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{165}
+// Deprecated: Use ComplianceReport.ProtoReflect.Descriptor instead.
+func (*ComplianceReport) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{233}
 }
 
-func (x *CreateProviderResponse) GetProvider() *Provider {
+func (x *ComplianceReport) GetProject() string {
 	if x != nil {
-		return x.Provider
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ComplianceReport) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ComplianceReport) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
 	}
 	return nil
 }
 
-func (x *CreateProviderResponse) GetAuthorization() *AuthorizationParams {
+func (x *ComplianceReport) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Authorization
+		return x.From
 	}
 	return nil
 }
 
-type DeleteProviderRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the provider is deleted.  Both
-	// project and provider are required in this context.
-	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ComplianceReport) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
 }
 
-func (x *DeleteProviderRequest) Reset() {
-	*x = DeleteProviderRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[166]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ComplianceReport) GetTotals() *ComplianceReport_Counts {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
 }
 
-func (x *DeleteProviderRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ComplianceReport) GetByProject() []*ComplianceReport_Group {
+	if x != nil {
+		return x.ByProject
+	}
+	return nil
 }
 
-func (*DeleteProviderRequest) ProtoMessage() {}
+func (x *ComplianceReport) GetByProfile() []*ComplianceReport_Group {
+	if x != nil {
+		return x.ByProfile
+	}
+	return nil
+}
 
-func (x *DeleteProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[166]
+func (x *ComplianceReport) GetByRuleType() []*ComplianceReport_Group {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ByRuleType
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DeleteProviderRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProviderRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{166}
+func (x *ComplianceReport) GetBySeverity() []*ComplianceReport_Group {
+	if x != nil {
+		return x.BySeverity
+	}
+	return nil
 }
 
-func (x *DeleteProviderRequest) GetContext() *Context {
+func (x *ComplianceReport) GetByEntity() []*ComplianceReport_Group {
 	if x != nil {
-		return x.Context
+		return x.ByEntity
 	}
 	return nil
 }
 
-type DeleteProviderResponse struct {
+func (x *ComplianceReport) GetFindings() []*ComplianceReport_Finding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+func (x *ComplianceReport) GetTrend() []*ComplianceReport_TrendPoint {
+	if x != nil {
+		return x.Trend
+	}
+	return nil
+}
+
+// ListEvaluationHistoryResponse represents a response message for the
+// ListEvaluationHistory RPC.
+//
+// It ships a collection of records retrieved and pointers to get to
+// the next and/or previous pages of data.
+type ListEvaluationHistoryResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name is the name of the provider that was deleted
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// List of records retrieved.
+	Data []*EvaluationHistory `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// Metadata of the current page and pointers to next and/or
+	// previous pages.
+	Page          *CursorPage `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProviderResponse) Reset() {
-	*x = DeleteProviderResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[167]
+func (x *ListEvaluationHistoryResponse) Reset() {
+	*x = ListEvaluationHistoryResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[234]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProviderResponse) String() string {
+func (x *ListEvaluationHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProviderResponse) ProtoMessage() {}
+func (*ListEvaluationHistoryResponse) ProtoMessage() {}
 
-func (x *DeleteProviderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[167]
+func (x *ListEvaluationHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[234]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10441,43 +14615,64 @@ func (x *DeleteProviderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProviderResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProviderResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{167}
+// Deprecated: Use ListEvaluationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListEvaluationHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{234}
 }
 
-func (x *DeleteProviderResponse) GetName() string {
+func (x *ListEvaluationHistoryResponse) GetData() []*EvaluationHistory {
 	if x != nil {
-		return x.Name
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type DeleteProviderByIDRequest struct {
+func (x *ListEvaluationHistoryResponse) GetPage() *CursorPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// EvaluationHistory represents the history of an entity evaluation.
+// This is only used in responses.
+type EvaluationHistory struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the provider is deleted. Only the project is required in this context.
-	Context *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// id is the id of the provider to delete
-	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// entity contains details of the entity which was evaluated.
+	Entity *EvaluationHistoryEntity `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	// rule contains details of the rule which the entity was evaluated against.
+	Rule *EvaluationHistoryRule `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	// status contains the evaluation status.
+	Status *EvaluationHistoryStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// alert contains details of the alerts for this evaluation.
+	// This is optional.
+	Alert *EvaluationHistoryAlert `protobuf:"bytes,4,opt,name=alert,proto3" json:"alert,omitempty"`
+	// remediation contains details of the remediation for this evaluation.
+	// This is optional.
+	Remediation *EvaluationHistoryRemediation `protobuf:"bytes,5,opt,name=remediation,proto3" json:"remediation,omitempty"`
+	// created_at is the timestamp of creation of this evaluation
+	EvaluatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=evaluated_at,json=evaluatedAt,proto3" json:"evaluated_at,omitempty"`
+	// id is the unique identifier of the evaluation.
+	Id            string `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProviderByIDRequest) Reset() {
-	*x = DeleteProviderByIDRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[168]
+func (x *EvaluationHistory) Reset() {
+	*x = EvaluationHistory{}
+	mi := &file_minder_v1_minder_proto_msgTypes[235]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProviderByIDRequest) String() string {
+func (x *EvaluationHistory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProviderByIDRequest) ProtoMessage() {}
+func (*EvaluationHistory) ProtoMessage() {}
 
-func (x *DeleteProviderByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[168]
+func (x *EvaluationHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[235]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10488,93 +14683,87 @@ func (x *DeleteProviderByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProviderByIDRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProviderByIDRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{168}
+// Deprecated: Use EvaluationHistory.ProtoReflect.Descriptor instead.
+func (*EvaluationHistory) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{235}
 }
 
-func (x *DeleteProviderByIDRequest) GetContext() *Context {
+func (x *EvaluationHistory) GetEntity() *EvaluationHistoryEntity {
 	if x != nil {
-		return x.Context
+		return x.Entity
 	}
 	return nil
 }
 
-func (x *DeleteProviderByIDRequest) GetId() string {
+func (x *EvaluationHistory) GetRule() *EvaluationHistoryRule {
 	if x != nil {
-		return x.Id
+		return x.Rule
 	}
-	return ""
-}
-
-type DeleteProviderByIDResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// id is the id of the provider that was deleted
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return nil
 }
 
-func (x *DeleteProviderByIDResponse) Reset() {
-	*x = DeleteProviderByIDResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[169]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *EvaluationHistory) GetStatus() *EvaluationHistoryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
 }
 
-func (x *DeleteProviderByIDResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EvaluationHistory) GetAlert() *EvaluationHistoryAlert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
 }
 
-func (*DeleteProviderByIDResponse) ProtoMessage() {}
-
-func (x *DeleteProviderByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[169]
+func (x *EvaluationHistory) GetRemediation() *EvaluationHistoryRemediation {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Remediation
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DeleteProviderByIDResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProviderByIDResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{169}
+func (x *EvaluationHistory) GetEvaluatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EvaluatedAt
+	}
+	return nil
 }
 
-func (x *DeleteProviderByIDResponse) GetId() string {
+func (x *EvaluationHistory) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ListProviderClassesRequest struct {
+type EvaluationHistoryEntity struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the provider classes are evaluated.
-	Context       *Context `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// id is the unique identifier of the entity.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// type is the entity type.
+	Type Entity `protobuf:"varint,2,opt,name=type,proto3,enum=minder.v1.Entity" json:"type,omitempty"`
+	// name is the entity name.
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProviderClassesRequest) Reset() {
-	*x = ListProviderClassesRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[170]
+func (x *EvaluationHistoryEntity) Reset() {
+	*x = EvaluationHistoryEntity{}
+	mi := &file_minder_v1_minder_proto_msgTypes[236]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProviderClassesRequest) String() string {
+func (x *EvaluationHistoryEntity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProviderClassesRequest) ProtoMessage() {}
+func (*EvaluationHistoryEntity) ProtoMessage() {}
 
-func (x *ListProviderClassesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[170]
+func (x *EvaluationHistoryEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[236]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10585,89 +14774,61 @@ func (x *ListProviderClassesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProviderClassesRequest.ProtoReflect.Descriptor instead.
-func (*ListProviderClassesRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170}
+// Deprecated: Use EvaluationHistoryEntity.ProtoReflect.Descriptor instead.
+func (*EvaluationHistoryEntity) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{236}
 }
 
-func (x *ListProviderClassesRequest) GetContext() *Context {
+func (x *EvaluationHistoryEntity) GetId() string {
 	if x != nil {
-		return x.Context
+		return x.Id
 	}
-	return nil
-}
-
-type ListProviderClassesResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// provider_classes is the list of provider classes.
-	ProviderClasses []string `protobuf:"bytes,1,rep,name=provider_classes,json=providerClasses,proto3" json:"provider_classes,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *ListProviderClassesResponse) Reset() {
-	*x = ListProviderClassesResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[171]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListProviderClassesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*ListProviderClassesResponse) ProtoMessage() {}
-
-func (x *ListProviderClassesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[171]
+func (x *EvaluationHistoryEntity) GetType() Entity {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Type
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListProviderClassesResponse.ProtoReflect.Descriptor instead.
-func (*ListProviderClassesResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{171}
+	return Entity_ENTITY_UNSPECIFIED
 }
 
-func (x *ListProviderClassesResponse) GetProviderClasses() []string {
+func (x *EvaluationHistoryEntity) GetName() string {
 	if x != nil {
-		return x.ProviderClasses
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type PatchProviderRequest struct {
+type EvaluationHistoryRule struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the provider is updated.
-	// The provider name is required in this context.
-	Context       *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Patch         *Provider              `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// name is the name of the rule instance.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// type is the name of the rule type.
+	RuleType string `protobuf:"bytes,2,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	// profile is the name of the profile which contains the rule.
+	Profile string `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	// severity is the severity of the rule type.
+	Severity      *Severity `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PatchProviderRequest) Reset() {
-	*x = PatchProviderRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[172]
+func (x *EvaluationHistoryRule) Reset() {
+	*x = EvaluationHistoryRule{}
+	mi := &file_minder_v1_minder_proto_msgTypes[237]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PatchProviderRequest) String() string {
+func (x *EvaluationHistoryRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchProviderRequest) ProtoMessage() {}
+func (*EvaluationHistoryRule) ProtoMessage() {}
 
-func (x *PatchProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[172]
+func (x *EvaluationHistoryRule) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[237]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10678,54 +14839,66 @@ func (x *PatchProviderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchProviderRequest.ProtoReflect.Descriptor instead.
-func (*PatchProviderRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{172}
+// Deprecated: Use EvaluationHistoryRule.ProtoReflect.Descriptor instead.
+func (*EvaluationHistoryRule) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{237}
 }
 
-func (x *PatchProviderRequest) GetContext() *Context {
+func (x *EvaluationHistoryRule) GetName() string {
 	if x != nil {
-		return x.Context
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *PatchProviderRequest) GetPatch() *Provider {
+func (x *EvaluationHistoryRule) GetRuleType() string {
 	if x != nil {
-		return x.Patch
+		return x.RuleType
 	}
-	return nil
+	return ""
 }
 
-func (x *PatchProviderRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *EvaluationHistoryRule) GetProfile() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *EvaluationHistoryRule) GetSeverity() *Severity {
+	if x != nil {
+		return x.Severity
 	}
 	return nil
 }
 
-type PatchProviderResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Provider      *Provider              `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+type EvaluationHistoryStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// status is one of (success, error, failure, skipped, timeout, waived)
+	// not using enums to mirror the behaviour of the existing API contracts.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// details contains optional details about the evaluation.
+	// the structure and contents are rule type specific, and are subject to change.
+	Details       string `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PatchProviderResponse) Reset() {
-	*x = PatchProviderResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[173]
+func (x *EvaluationHistoryStatus) Reset() {
+	*x = EvaluationHistoryStatus{}
+	mi := &file_minder_v1_minder_proto_msgTypes[238]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PatchProviderResponse) String() string {
+func (x *EvaluationHistoryStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatchProviderResponse) ProtoMessage() {}
+func (*EvaluationHistoryStatus) ProtoMessage() {}
 
-func (x *PatchProviderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[173]
+func (x *EvaluationHistoryStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[238]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10736,41 +14909,52 @@ func (x *PatchProviderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatchProviderResponse.ProtoReflect.Descriptor instead.
-func (*PatchProviderResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{173}
+// Deprecated: Use EvaluationHistoryStatus.ProtoReflect.Descriptor instead.
+func (*EvaluationHistoryStatus) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{238}
 }
 
-func (x *PatchProviderResponse) GetProvider() *Provider {
+func (x *EvaluationHistoryStatus) GetStatus() string {
 	if x != nil {
-		return x.Provider
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type AuthorizationParams struct {
+func (x *EvaluationHistoryStatus) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+type EvaluationHistoryRemediation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// authorization_url is an external URL to use to authorize the provider.
-	AuthorizationUrl string `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// status is one of (success, error, failure, skipped, not available)
+	// not using enums to mirror the behaviour of the existing API contracts.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// details contains optional details about the remediation.
+	// the structure and contents are remediation specific, and are subject to change.
+	Details       string `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuthorizationParams) Reset() {
-	*x = AuthorizationParams{}
-	mi := &file_minder_v1_minder_proto_msgTypes[174]
+func (x *EvaluationHistoryRemediation) Reset() {
+	*x = EvaluationHistoryRemediation{}
+	mi := &file_minder_v1_minder_proto_msgTypes[239]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthorizationParams) String() string {
+func (x *EvaluationHistoryRemediation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizationParams) ProtoMessage() {}
+func (*EvaluationHistoryRemediation) ProtoMessage() {}
 
-func (x *AuthorizationParams) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[174]
+func (x *EvaluationHistoryRemediation) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[239]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10781,43 +14965,52 @@ func (x *AuthorizationParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizationParams.ProtoReflect.Descriptor instead.
-func (*AuthorizationParams) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{174}
+// Deprecated: Use EvaluationHistoryRemediation.ProtoReflect.Descriptor instead.
+func (*EvaluationHistoryRemediation) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{239}
 }
 
-func (x *AuthorizationParams) GetAuthorizationUrl() string {
+func (x *EvaluationHistoryRemediation) GetStatus() string {
 	if x != nil {
-		return x.AuthorizationUrl
+		return x.Status
 	}
 	return ""
 }
 
-type ProviderParameter struct {
+func (x *EvaluationHistoryRemediation) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+type EvaluationHistoryAlert struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Parameters:
-	//
-	//	*ProviderParameter_GithubApp
-	Parameters    isProviderParameter_Parameters `protobuf_oneof:"parameters"`
+	// status is one of (on, off, error, skipped, not available)
+	// not using enums to mirror the behaviour of the existing API contracts.
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// details contains optional details about the alert.
+	// the structure and contents are alert specific, and are subject to change.
+	Details       string `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProviderParameter) Reset() {
-	*x = ProviderParameter{}
-	mi := &file_minder_v1_minder_proto_msgTypes[175]
+func (x *EvaluationHistoryAlert) Reset() {
+	*x = EvaluationHistoryAlert{}
+	mi := &file_minder_v1_minder_proto_msgTypes[240]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProviderParameter) String() string {
+func (x *EvaluationHistoryAlert) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderParameter) ProtoMessage() {}
+func (*EvaluationHistoryAlert) ProtoMessage() {}
 
-func (x *ProviderParameter) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[175]
+func (x *EvaluationHistoryAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[240]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10828,70 +15021,59 @@ func (x *ProviderParameter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderParameter.ProtoReflect.Descriptor instead.
-func (*ProviderParameter) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{175}
+// Deprecated: Use EvaluationHistoryAlert.ProtoReflect.Descriptor instead.
+func (*EvaluationHistoryAlert) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{240}
 }
 
-func (x *ProviderParameter) GetParameters() isProviderParameter_Parameters {
+func (x *EvaluationHistoryAlert) GetStatus() string {
 	if x != nil {
-		return x.Parameters
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *ProviderParameter) GetGithubApp() *GitHubAppParams {
+func (x *EvaluationHistoryAlert) GetDetails() string {
 	if x != nil {
-		if x, ok := x.Parameters.(*ProviderParameter_GithubApp); ok {
-			return x.GithubApp
-		}
+		return x.Details
 	}
-	return nil
-}
-
-type isProviderParameter_Parameters interface {
-	isProviderParameter_Parameters()
-}
-
-type ProviderParameter_GithubApp struct {
-	GithubApp *GitHubAppParams `protobuf:"bytes,1,opt,name=github_app,json=githubApp,proto3,oneof"`
+	return ""
 }
 
-func (*ProviderParameter_GithubApp) isProviderParameter_Parameters() {}
-
-// GitHubAppParams is the parameters for a GitHub App provider.
-type GitHubAppParams struct {
+// used for parsing resources in ruletypes
+type EntityInstance struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The GitHub installation ID for the app.  On create, this is the only
-	// parameter used; the organization parameters are ignored.
-	InstallationId int64 `protobuf:"varint,1,opt,name=installation_id,json=installationId,proto3" json:"installation_id,omitempty"`
-	// The GitHub organization slug where the app is installed.  This is an
-	// output-only parameter, and is validated on input if set (i.e. the value
-	// must be either empty or match the org of the installation_id).
-	Organization string `protobuf:"bytes,2,opt,name=organization,proto3" json:"organization,omitempty"`
-	// The GitHub organization ID where the app is installed.  This is an
-	// output-only parameter, and is validated on input if set (i.e. the value
-	// must be either empty or match the org of the installation_id).
-	OrganizationId int64 `protobuf:"varint,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// id is the unique identifier of the entity.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// context is the context in which the entity is evaluated.
+	Context *ContextV2 `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	// name is the name of the entity.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// type is the type of the entity.
+	// DISCUSSION: If we're aiming for a BYO entity type, we should probably
+	// have this be a string, and have the user provide the type.
+	Type Entity `protobuf:"varint,4,opt,name=type,proto3,enum=minder.v1.Entity" json:"type,omitempty"`
+	// properties is a map of properties of the entity.
+	Properties    *structpb.Struct `protobuf:"bytes,5,opt,name=properties,proto3" json:"properties,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GitHubAppParams) Reset() {
-	*x = GitHubAppParams{}
-	mi := &file_minder_v1_minder_proto_msgTypes[176]
+func (x *EntityInstance) Reset() {
+	*x = EntityInstance{}
+	mi := &file_minder_v1_minder_proto_msgTypes[241]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GitHubAppParams) String() string {
+func (x *EntityInstance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitHubAppParams) ProtoMessage() {}
+func (*EntityInstance) ProtoMessage() {}
 
-func (x *GitHubAppParams) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[176]
+func (x *EntityInstance) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[241]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10902,78 +15084,74 @@ func (x *GitHubAppParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitHubAppParams.ProtoReflect.Descriptor instead.
-func (*GitHubAppParams) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{176}
+// Deprecated: Use EntityInstance.ProtoReflect.Descriptor instead.
+func (*EntityInstance) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{241}
 }
 
-func (x *GitHubAppParams) GetInstallationId() int64 {
+func (x *EntityInstance) GetId() string {
 	if x != nil {
-		return x.InstallationId
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *GitHubAppParams) GetOrganization() string {
+func (x *EntityInstance) GetContext() *ContextV2 {
 	if x != nil {
-		return x.Organization
+		return x.Context
+	}
+	return nil
+}
+
+func (x *EntityInstance) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GitHubAppParams) GetOrganizationId() int64 {
+func (x *EntityInstance) GetType() Entity {
 	if x != nil {
-		return x.OrganizationId
+		return x.Type
 	}
-	return 0
+	return Entity_ENTITY_UNSPECIFIED
 }
 
-// Provider represents a provider that is used to interact with external systems.
-// All fields are optional because we want to allow partial updates.
-type Provider struct {
+func (x *EntityInstance) GetProperties() *structpb.Struct {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+// ListEntitiesRequest is the request message for the ListEntities method
+type ListEntitiesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name is the name of the provider.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// class is the name of the provider implementation, eg. 'github' or 'gh-app'.
-	Class string `protobuf:"bytes,7,opt,name=class,proto3" json:"class,omitempty"`
-	// project is the project where the provider is.  This is ignored on input
-	// in favor of the context field in CreateProviderRequest.
-	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
-	// version is the version of the provider.
-	// if unset, "v1" is assumed.
-	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	// implements is the list of interfaces that the provider implements.
-	Implements []ProviderType `protobuf:"varint,4,rep,packed,name=implements,proto3,enum=minder.v1.ProviderType" json:"implements,omitempty"`
-	// config is the configuration of the provider.
-	Config *structpb.Struct `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
-	// auth_flows is the list of authorization flows that the provider supports.
-	AuthFlows []AuthorizationFlow `protobuf:"varint,6,rep,packed,name=auth_flows,json=authFlows,proto3,enum=minder.v1.AuthorizationFlow" json:"auth_flows,omitempty"`
-	// parameters is the list of parameters that the provider requires.
-	Parameters *ProviderParameter `protobuf:"bytes,8,opt,name=parameters,proto3" json:"parameters,omitempty"`
-	// credentials_state is the state of the credentials for the provider.
-	// This is an output-only field. It may be: "set", "unset", "not_applicable".
-	CredentialsState string `protobuf:"bytes,9,opt,name=credentials_state,json=credentialsState,proto3" json:"credentials_state,omitempty"`
-	// id is the unique identifier of the provider.
-	Id            string `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	// context is the context in which the entities are listed
+	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// entity_type is the type of entity to list
+	EntityType Entity `protobuf:"varint,2,opt,name=entity_type,json=entityType,proto3,enum=minder.v1.Entity" json:"entity_type,omitempty"`
+	// cursor is the pagination cursor
+	Cursor        *Cursor `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Provider) Reset() {
-	*x = Provider{}
-	mi := &file_minder_v1_minder_proto_msgTypes[177]
+func (x *ListEntitiesRequest) Reset() {
+	*x = ListEntitiesRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[242]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Provider) String() string {
+func (x *ListEntitiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Provider) ProtoMessage() {}
+func (*ListEntitiesRequest) ProtoMessage() {}
 
-func (x *Provider) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[177]
+func (x *ListEntitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[242]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10984,105 +15162,113 @@ func (x *Provider) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Provider.ProtoReflect.Descriptor instead.
-func (*Provider) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{177}
+// Deprecated: Use ListEntitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListEntitiesRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{242}
 }
 
-func (x *Provider) GetName() string {
+func (x *ListEntitiesRequest) GetContext() *ContextV2 {
 	if x != nil {
-		return x.Name
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-func (x *Provider) GetClass() string {
+func (x *ListEntitiesRequest) GetEntityType() Entity {
 	if x != nil {
-		return x.Class
+		return x.EntityType
 	}
-	return ""
+	return Entity_ENTITY_UNSPECIFIED
 }
 
-func (x *Provider) GetProject() string {
+func (x *ListEntitiesRequest) GetCursor() *Cursor {
 	if x != nil {
-		return x.Project
+		return x.Cursor
 	}
-	return ""
+	return nil
 }
 
-func (x *Provider) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
+// ListEntitiesResponse is the response message for the ListEntities method
+type ListEntitiesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// results is the list of entities
+	Results []*EntityInstance `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// page is the pagination information
+	Page          *CursorPage `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Provider) GetImplements() []ProviderType {
-	if x != nil {
-		return x.Implements
-	}
-	return nil
+func (x *ListEntitiesResponse) Reset() {
+	*x = ListEntitiesResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[243]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Provider) GetConfig() *structpb.Struct {
-	if x != nil {
-		return x.Config
-	}
-	return nil
+func (x *ListEntitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Provider) GetAuthFlows() []AuthorizationFlow {
+func (*ListEntitiesResponse) ProtoMessage() {}
+
+func (x *ListEntitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[243]
 	if x != nil {
-		return x.AuthFlows
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Provider) GetParameters() *ProviderParameter {
-	if x != nil {
-		return x.Parameters
-	}
-	return nil
+// Deprecated: Use ListEntitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListEntitiesResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{243}
 }
 
-func (x *Provider) GetCredentialsState() string {
+func (x *ListEntitiesResponse) GetResults() []*EntityInstance {
 	if x != nil {
-		return x.CredentialsState
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *Provider) GetId() string {
+func (x *ListEntitiesResponse) GetPage() *CursorPage {
 	if x != nil {
-		return x.Id
+		return x.Page
 	}
-	return ""
+	return nil
 }
 
-// GetEvaluationHistoryRequest represents a request for the GetEvaluationHistory endpoint
-type GetEvaluationHistoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Context       *Context               `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+// GetEntityByIdRequest is the request message for the GetEntityById method
+type GetEntityByIdRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the entity is evaluated
+	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// id is the ID of the entity to get
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetEvaluationHistoryRequest) Reset() {
-	*x = GetEvaluationHistoryRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[178]
+}
+
+func (x *GetEntityByIdRequest) Reset() {
+	*x = GetEntityByIdRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[244]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEvaluationHistoryRequest) String() string {
+func (x *GetEntityByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEvaluationHistoryRequest) ProtoMessage() {}
+func (*GetEntityByIdRequest) ProtoMessage() {}
 
-func (x *GetEvaluationHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[178]
+func (x *GetEntityByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[244]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11093,76 +15279,49 @@ func (x *GetEvaluationHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEvaluationHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetEvaluationHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{178}
+// Deprecated: Use GetEntityByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetEntityByIdRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{244}
 }
 
-func (x *GetEvaluationHistoryRequest) GetId() string {
+func (x *GetEntityByIdRequest) GetContext() *ContextV2 {
 	if x != nil {
-		return x.Id
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-func (x *GetEvaluationHistoryRequest) GetContext() *Context {
+func (x *GetEntityByIdRequest) GetId() string {
 	if x != nil {
-		return x.Context
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// ListEvaluationHistoryRequest represents a request message for the
-// ListEvaluationHistory RPC.
-//
-// Most of its fields are used for filtering, except for `cursor`
-// which is used for pagination.
-type ListEvaluationHistoryRequest struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	Context *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// List of entity types to retrieve.
-	EntityType []string `protobuf:"bytes,2,rep,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
-	// List of entity names to retrieve.
-	EntityName []string `protobuf:"bytes,3,rep,name=entity_name,json=entityName,proto3" json:"entity_name,omitempty"`
-	// List of profile names to retrieve.
-	ProfileName []string `protobuf:"bytes,4,rep,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
-	// List of evaluation statuses to retrieve.
-	Status []string `protobuf:"bytes,5,rep,name=status,proto3" json:"status,omitempty"`
-	// List of remediation statuses to retrieve.
-	Remediation []string `protobuf:"bytes,6,rep,name=remediation,proto3" json:"remediation,omitempty"`
-	// List of alert statuses to retrieve.
-	Alert []string `protobuf:"bytes,7,rep,name=alert,proto3" json:"alert,omitempty"`
-	// Timestamp representing the start time of the selection window.
-	From *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=from,proto3" json:"from,omitempty"`
-	// Timestamp representing the end time of the selection window.
-	To *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=to,proto3" json:"to,omitempty"`
-	// Filter evaluation history to only those matching the specified labels.
-	//
-	// The default is to return all user-created profiles; the string "*" can
-	// be used to select all profiles, including system profiles.  This syntax
-	// may be expanded in the future.
-	LabelFilter []string `protobuf:"bytes,11,rep,name=label_filter,json=labelFilter,proto3" json:"label_filter,omitempty"`
-	// Cursor object to select the "page" of data to retrieve. This is optional.
-	Cursor        *Cursor `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`
+// GetEntityByIdResponse is the response message for the GetEntityById method
+type GetEntityByIdResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// entity is the entity that was retrieved
+	Entity        *EntityInstance `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEvaluationHistoryRequest) Reset() {
-	*x = ListEvaluationHistoryRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[179]
+func (x *GetEntityByIdResponse) Reset() {
+	*x = GetEntityByIdResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[245]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvaluationHistoryRequest) String() string {
+func (x *GetEntityByIdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvaluationHistoryRequest) ProtoMessage() {}
+func (*GetEntityByIdResponse) ProtoMessage() {}
 
-func (x *ListEvaluationHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[179]
+func (x *GetEntityByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[245]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11173,113 +15332,106 @@ func (x *ListEvaluationHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvaluationHistoryRequest.ProtoReflect.Descriptor instead.
-func (*ListEvaluationHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{179}
-}
-
-func (x *ListEvaluationHistoryRequest) GetContext() *Context {
-	if x != nil {
-		return x.Context
-	}
-	return nil
+// Deprecated: Use GetEntityByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetEntityByIdResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{245}
 }
 
-func (x *ListEvaluationHistoryRequest) GetEntityType() []string {
+func (x *GetEntityByIdResponse) GetEntity() *EntityInstance {
 	if x != nil {
-		return x.EntityType
+		return x.Entity
 	}
 	return nil
 }
 
-func (x *ListEvaluationHistoryRequest) GetEntityName() []string {
-	if x != nil {
-		return x.EntityName
-	}
-	return nil
+// GetEntityByNameRequest is the request message for the GetEntityByName method
+type GetEntityByNameRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// context is the context in which the entity is evaluated
+	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// name is the name of the entity to get
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// entity_type is the type of entity to get
+	EntityType    Entity `protobuf:"varint,3,opt,name=entity_type,json=entityType,proto3,enum=minder.v1.Entity" json:"entity_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEvaluationHistoryRequest) GetProfileName() []string {
-	if x != nil {
-		return x.ProfileName
-	}
-	return nil
+func (x *GetEntityByNameRequest) Reset() {
+	*x = GetEntityByNameRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[246]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvaluationHistoryRequest) GetStatus() []string {
-	if x != nil {
-		return x.Status
-	}
-	return nil
+func (x *GetEntityByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListEvaluationHistoryRequest) GetRemediation() []string {
-	if x != nil {
-		return x.Remediation
-	}
-	return nil
-}
+func (*GetEntityByNameRequest) ProtoMessage() {}
 
-func (x *ListEvaluationHistoryRequest) GetAlert() []string {
+func (x *GetEntityByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[246]
 	if x != nil {
-		return x.Alert
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ListEvaluationHistoryRequest) GetFrom() *timestamppb.Timestamp {
-	if x != nil {
-		return x.From
-	}
-	return nil
+// Deprecated: Use GetEntityByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetEntityByNameRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{246}
 }
 
-func (x *ListEvaluationHistoryRequest) GetTo() *timestamppb.Timestamp {
+func (x *GetEntityByNameRequest) GetContext() *ContextV2 {
 	if x != nil {
-		return x.To
+		return x.Context
 	}
 	return nil
 }
 
-func (x *ListEvaluationHistoryRequest) GetLabelFilter() []string {
+func (x *GetEntityByNameRequest) GetName() string {
 	if x != nil {
-		return x.LabelFilter
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ListEvaluationHistoryRequest) GetCursor() *Cursor {
+func (x *GetEntityByNameRequest) GetEntityType() Entity {
 	if x != nil {
-		return x.Cursor
+		return x.EntityType
 	}
-	return nil
+	return Entity_ENTITY_UNSPECIFIED
 }
 
-// GetEvaluationHistoryResponse represents a response message for the
-// GetEvaluationHistory RPC.
-type GetEvaluationHistoryResponse struct {
+// GetEntityByNameResponse is the response message for the GetEntityByName method
+type GetEntityByNameResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The requested record
-	Evaluation    *EvaluationHistory `protobuf:"bytes,1,opt,name=evaluation,proto3" json:"evaluation,omitempty"`
+	// entity is the entity that was retrieved
+	Entity        *EntityInstance `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEvaluationHistoryResponse) Reset() {
-	*x = GetEvaluationHistoryResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[180]
+func (x *GetEntityByNameResponse) Reset() {
+	*x = GetEntityByNameResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[247]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEvaluationHistoryResponse) String() string {
+func (x *GetEntityByNameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEvaluationHistoryResponse) ProtoMessage() {}
+func (*GetEntityByNameResponse) ProtoMessage() {}
 
-func (x *GetEvaluationHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[180]
+func (x *GetEntityByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[247]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11290,49 +15442,48 @@ func (x *GetEvaluationHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEvaluationHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetEvaluationHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{180}
+// Deprecated: Use GetEntityByNameResponse.ProtoReflect.Descriptor instead.
+func (*GetEntityByNameResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{247}
 }
 
-func (x *GetEvaluationHistoryResponse) GetEvaluation() *EvaluationHistory {
+func (x *GetEntityByNameResponse) GetEntity() *EntityInstance {
 	if x != nil {
-		return x.Evaluation
+		return x.Entity
 	}
 	return nil
 }
 
-// ListEvaluationHistoryResponse represents a response message for the
-// ListEvaluationHistory RPC.
-//
-// It ships a collection of records retrieved and pointers to get to
-// the next and/or previous pages of data.
-type ListEvaluationHistoryResponse struct {
+// ListEntityPropertyHistoryRequest is the request message for the ListEntityPropertyHistory method
+type ListEntityPropertyHistoryRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of records retrieved.
-	Data []*EvaluationHistory `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
-	// Metadata of the current page and pointers to next and/or
-	// previous pages.
-	Page          *CursorPage `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	// context is the context in which the entity is evaluated
+	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// id is the ID of the entity to get the property history of
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// key restricts the history to the changes of a single property
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// cursor is the pagination cursor
+	Cursor        *Cursor `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEvaluationHistoryResponse) Reset() {
-	*x = ListEvaluationHistoryResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[181]
+func (x *ListEntityPropertyHistoryRequest) Reset() {
+	*x = ListEntityPropertyHistoryRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[248]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvaluationHistoryResponse) String() string {
+func (x *ListEntityPropertyHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvaluationHistoryResponse) ProtoMessage() {}
+func (*ListEntityPropertyHistoryRequest) ProtoMessage() {}
 
-func (x *ListEvaluationHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[181]
+func (x *ListEntityPropertyHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[248]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11343,64 +15494,74 @@ func (x *ListEvaluationHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvaluationHistoryResponse.ProtoReflect.Descriptor instead.
-func (*ListEvaluationHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{181}
+// Deprecated: Use ListEntityPropertyHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListEntityPropertyHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{248}
 }
 
-func (x *ListEvaluationHistoryResponse) GetData() []*EvaluationHistory {
+func (x *ListEntityPropertyHistoryRequest) GetContext() *ContextV2 {
 	if x != nil {
-		return x.Data
+		return x.Context
 	}
 	return nil
 }
 
-func (x *ListEvaluationHistoryResponse) GetPage() *CursorPage {
+func (x *ListEntityPropertyHistoryRequest) GetId() string {
 	if x != nil {
-		return x.Page
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListEntityPropertyHistoryRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ListEntityPropertyHistoryRequest) GetCursor() *Cursor {
+	if x != nil {
+		return x.Cursor
 	}
 	return nil
 }
 
-// EvaluationHistory represents the history of an entity evaluation.
-// This is only used in responses.
-type EvaluationHistory struct {
+// EntityPropertyChange is a change of a single property of an entity
+type EntityPropertyChange struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// entity contains details of the entity which was evaluated.
-	Entity *EvaluationHistoryEntity `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
-	// rule contains details of the rule which the entity was evaluated against.
-	Rule *EvaluationHistoryRule `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
-	// status contains the evaluation status.
-	Status *EvaluationHistoryStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	// alert contains details of the alerts for this evaluation.
-	// This is optional.
-	Alert *EvaluationHistoryAlert `protobuf:"bytes,4,opt,name=alert,proto3" json:"alert,omitempty"`
-	// remediation contains details of the remediation for this evaluation.
-	// This is optional.
-	Remediation *EvaluationHistoryRemediation `protobuf:"bytes,5,opt,name=remediation,proto3" json:"remediation,omitempty"`
-	// created_at is the timestamp of creation of this evaluation
-	EvaluatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=evaluated_at,json=evaluatedAt,proto3" json:"evaluated_at,omitempty"`
-	// id is the unique identifier of the evaluation.
-	Id            string `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	// key is the name of the property
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// old_value is the value of the property before the change.
+	// It is not set if the property was added.
+	OldValue *structpb.Value `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// new_value is the value of the property after the change.
+	// It is not set if the property was removed.
+	NewValue *structpb.Value `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	// source is what caused the change, one of webhook, refresh,
+	// reconcile or registration
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	// changed_at is the time of the change
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EvaluationHistory) Reset() {
-	*x = EvaluationHistory{}
-	mi := &file_minder_v1_minder_proto_msgTypes[182]
+func (x *EntityPropertyChange) Reset() {
+	*x = EntityPropertyChange{}
+	mi := &file_minder_v1_minder_proto_msgTypes[249]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EvaluationHistory) String() string {
+func (x *EntityPropertyChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EvaluationHistory) ProtoMessage() {}
+func (*EntityPropertyChange) ProtoMessage() {}
 
-func (x *EvaluationHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[182]
+func (x *EntityPropertyChange) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[249]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11411,87 +15572,72 @@ func (x *EvaluationHistory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EvaluationHistory.ProtoReflect.Descriptor instead.
-func (*EvaluationHistory) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{182}
-}
-
-func (x *EvaluationHistory) GetEntity() *EvaluationHistoryEntity {
-	if x != nil {
-		return x.Entity
-	}
-	return nil
+// Deprecated: Use EntityPropertyChange.ProtoReflect.Descriptor instead.
+func (*EntityPropertyChange) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{249}
 }
 
-func (x *EvaluationHistory) GetRule() *EvaluationHistoryRule {
+func (x *EntityPropertyChange) GetKey() string {
 	if x != nil {
-		return x.Rule
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-func (x *EvaluationHistory) GetStatus() *EvaluationHistoryStatus {
+func (x *EntityPropertyChange) GetOldValue() *structpb.Value {
 	if x != nil {
-		return x.Status
+		return x.OldValue
 	}
 	return nil
 }
 
-func (x *EvaluationHistory) GetAlert() *EvaluationHistoryAlert {
+func (x *EntityPropertyChange) GetNewValue() *structpb.Value {
 	if x != nil {
-		return x.Alert
+		return x.NewValue
 	}
 	return nil
 }
 
-func (x *EvaluationHistory) GetRemediation() *EvaluationHistoryRemediation {
+func (x *EntityPropertyChange) GetSource() string {
 	if x != nil {
-		return x.Remediation
+		return x.Source
 	}
-	return nil
+	return ""
 }
 
-func (x *EvaluationHistory) GetEvaluatedAt() *timestamppb.Timestamp {
+func (x *EntityPropertyChange) GetChangedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EvaluatedAt
+		return x.ChangedAt
 	}
 	return nil
 }
 
-func (x *EvaluationHistory) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-type EvaluationHistoryEntity struct {
+// ListEntityPropertyHistoryResponse is the response message for the ListEntityPropertyHistory method
+type ListEntityPropertyHistoryResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// id is the unique identifier of the entity.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// type is the entity type.
-	Type Entity `protobuf:"varint,2,opt,name=type,proto3,enum=minder.v1.Entity" json:"type,omitempty"`
-	// name is the entity name.
-	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// results is the list of changes, newest first
+	Results []*EntityPropertyChange `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// page is the pagination information
+	Page          *CursorPage `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EvaluationHistoryEntity) Reset() {
-	*x = EvaluationHistoryEntity{}
-	mi := &file_minder_v1_minder_proto_msgTypes[183]
+func (x *ListEntityPropertyHistoryResponse) Reset() {
+	*x = ListEntityPropertyHistoryResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[250]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EvaluationHistoryEntity) String() string {
+func (x *ListEntityPropertyHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EvaluationHistoryEntity) ProtoMessage() {}
+func (*ListEntityPropertyHistoryResponse) ProtoMessage() {}
 
-func (x *EvaluationHistoryEntity) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[183]
+func (x *ListEntityPropertyHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[250]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11502,61 +15648,51 @@ func (x *EvaluationHistoryEntity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EvaluationHistoryEntity.ProtoReflect.Descriptor instead.
-func (*EvaluationHistoryEntity) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{183}
-}
-
-func (x *EvaluationHistoryEntity) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use ListEntityPropertyHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListEntityPropertyHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{250}
 }
 
-func (x *EvaluationHistoryEntity) GetType() Entity {
+func (x *ListEntityPropertyHistoryResponse) GetResults() []*EntityPropertyChange {
 	if x != nil {
-		return x.Type
+		return x.Results
 	}
-	return Entity_ENTITY_UNSPECIFIED
+	return nil
 }
 
-func (x *EvaluationHistoryEntity) GetName() string {
+func (x *ListEntityPropertyHistoryResponse) GetPage() *CursorPage {
 	if x != nil {
-		return x.Name
+		return x.Page
 	}
-	return ""
+	return nil
 }
 
-type EvaluationHistoryRule struct {
+// DeleteEntityByIdRequest is the request message for the DeleteEntityById method
+type DeleteEntityByIdRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name is the name of the rule instance.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// type is the name of the rule type.
-	RuleType string `protobuf:"bytes,2,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
-	// profile is the name of the profile which contains the rule.
-	Profile string `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
-	// severity is the severity of the rule type.
-	Severity      *Severity `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	// context is the context in which the entity is evaluated
+	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// id is the ID of the entity to delete
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EvaluationHistoryRule) Reset() {
-	*x = EvaluationHistoryRule{}
-	mi := &file_minder_v1_minder_proto_msgTypes[184]
+func (x *DeleteEntityByIdRequest) Reset() {
+	*x = DeleteEntityByIdRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[251]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EvaluationHistoryRule) String() string {
+func (x *DeleteEntityByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EvaluationHistoryRule) ProtoMessage() {}
+func (*DeleteEntityByIdRequest) ProtoMessage() {}
 
-func (x *EvaluationHistoryRule) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[184]
+func (x *DeleteEntityByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[251]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11567,66 +15703,102 @@ func (x *EvaluationHistoryRule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EvaluationHistoryRule.ProtoReflect.Descriptor instead.
-func (*EvaluationHistoryRule) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{184}
+// Deprecated: Use DeleteEntityByIdRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEntityByIdRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{251}
 }
 
-func (x *EvaluationHistoryRule) GetName() string {
+func (x *DeleteEntityByIdRequest) GetContext() *ContextV2 {
 	if x != nil {
-		return x.Name
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-func (x *EvaluationHistoryRule) GetRuleType() string {
+func (x *DeleteEntityByIdRequest) GetId() string {
 	if x != nil {
-		return x.RuleType
+		return x.Id
 	}
 	return ""
 }
 
-func (x *EvaluationHistoryRule) GetProfile() string {
+// DeleteEntityByIdResponse is the response message for the DeleteEntityById method
+type DeleteEntityByIdResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the ID of the entity that was deleted
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEntityByIdResponse) Reset() {
+	*x = DeleteEntityByIdResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[252]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEntityByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEntityByIdResponse) ProtoMessage() {}
+
+func (x *DeleteEntityByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[252]
 	if x != nil {
-		return x.Profile
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *EvaluationHistoryRule) GetSeverity() *Severity {
+// Deprecated: Use DeleteEntityByIdResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEntityByIdResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{252}
+}
+
+func (x *DeleteEntityByIdResponse) GetId() string {
 	if x != nil {
-		return x.Severity
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type EvaluationHistoryStatus struct {
+// RegisterEntityRequest is the request message for the RegisterEntity method
+type RegisterEntityRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// status is one of (success, error, failure, skipped)
-	// not using enums to mirror the behaviour of the existing API contracts.
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// details contains optional details about the evaluation.
-	// the structure and contents are rule type specific, and are subject to change.
-	Details       string `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// context is the context in which the entity is created
+	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// entity_type is the type of entity to create
+	EntityType Entity `protobuf:"varint,2,opt,name=entity_type,json=entityType,proto3,enum=minder.v1.Entity" json:"entity_type,omitempty"`
+	// identifying_properties uniquely identifies the entity in the provider.
+	// For example, for a GitHub repository use github/repo_owner and github/repo_name,
+	// or use upstream_id to identify by provider's internal ID.
+	// Each key maps to a value that can be a string, number, boolean, or nested structure.
+	IdentifyingProperties map[string]*structpb.Value `protobuf:"bytes,3,rep,name=identifying_properties,json=identifyingProperties,proto3" json:"identifying_properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *EvaluationHistoryStatus) Reset() {
-	*x = EvaluationHistoryStatus{}
-	mi := &file_minder_v1_minder_proto_msgTypes[185]
+func (x *RegisterEntityRequest) Reset() {
+	*x = RegisterEntityRequest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[253]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EvaluationHistoryStatus) String() string {
+func (x *RegisterEntityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EvaluationHistoryStatus) ProtoMessage() {}
+func (*RegisterEntityRequest) ProtoMessage() {}
 
-func (x *EvaluationHistoryStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[185]
+func (x *RegisterEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[253]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11637,52 +15809,56 @@ func (x *EvaluationHistoryStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EvaluationHistoryStatus.ProtoReflect.Descriptor instead.
-func (*EvaluationHistoryStatus) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{185}
+// Deprecated: Use RegisterEntityRequest.ProtoReflect.Descriptor instead.
+func (*RegisterEntityRequest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{253}
 }
 
-func (x *EvaluationHistoryStatus) GetStatus() string {
+func (x *RegisterEntityRequest) GetContext() *ContextV2 {
 	if x != nil {
-		return x.Status
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-func (x *EvaluationHistoryStatus) GetDetails() string {
+func (x *RegisterEntityRequest) GetEntityType() Entity {
 	if x != nil {
-		return x.Details
+		return x.EntityType
 	}
-	return ""
+	return Entity_ENTITY_UNSPECIFIED
 }
 
-type EvaluationHistoryRemediation struct {
+func (x *RegisterEntityRequest) GetIdentifyingProperties() map[string]*structpb.Value {
+	if x != nil {
+		return x.IdentifyingProperties
+	}
+	return nil
+}
+
+// RegisterEntityResponse is the response message for the RegisterEntity method
+type RegisterEntityResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// status is one of (success, error, failure, skipped, not available)
-	// not using enums to mirror the behaviour of the existing API contracts.
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// details contains optional details about the remediation.
-	// the structure and contents are remediation specific, and are subject to change.
-	Details       string `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	// entity is the entity that was created
+	Entity        *EntityInstance `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EvaluationHistoryRemediation) Reset() {
-	*x = EvaluationHistoryRemediation{}
-	mi := &file_minder_v1_minder_proto_msgTypes[186]
+func (x *RegisterEntityResponse) Reset() {
+	*x = RegisterEntityResponse{}
+	mi := &file_minder_v1_minder_proto_msgTypes[254]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EvaluationHistoryRemediation) String() string {
+func (x *RegisterEntityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EvaluationHistoryRemediation) ProtoMessage() {}
+func (*RegisterEntityResponse) ProtoMessage() {}
 
-func (x *EvaluationHistoryRemediation) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[186]
+func (x *RegisterEntityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[254]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11693,52 +15869,53 @@ func (x *EvaluationHistoryRemediation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EvaluationHistoryRemediation.ProtoReflect.Descriptor instead.
-func (*EvaluationHistoryRemediation) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{186}
-}
-
-func (x *EvaluationHistoryRemediation) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// Deprecated: Use RegisterEntityResponse.ProtoReflect.Descriptor instead.
+func (*RegisterEntityResponse) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{254}
 }
 
-func (x *EvaluationHistoryRemediation) GetDetails() string {
+func (x *RegisterEntityResponse) GetEntity() *EntityInstance {
 	if x != nil {
-		return x.Details
+		return x.Entity
 	}
-	return ""
+	return nil
 }
 
-type EvaluationHistoryAlert struct {
+// UpstreamEntityRef providers enough information for the
+// provider to identify the entity in the upstream system.
+type UpstreamEntityRef struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// status is one of (on, off, error, skipped, not available)
-	// not using enums to mirror the behaviour of the existing API contracts.
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// details contains optional details about the alert.
-	// the structure and contents are alert specific, and are subject to change.
-	Details       string `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	// context is the context in which the entity is evaluated.
+	// Note that the context is included here since users of
+	// this message may return upstream references from
+	// multiple providers
+	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	// type is the type of the entity.
+	Type Entity `protobuf:"varint,2,opt,name=type,proto3,enum=minder.v1.Entity" json:"type,omitempty"`
+	// properties is a map of properties of the entity.
+	// This will be used to identify the entity in the upstream system
+	// and will be a subset of the properties of the entity that will
+	// be stored in Minder.
+	Properties    *structpb.Struct `protobuf:"bytes,3,opt,name=properties,proto3" json:"properties,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EvaluationHistoryAlert) Reset() {
-	*x = EvaluationHistoryAlert{}
-	mi := &file_minder_v1_minder_proto_msgTypes[187]
+func (x *UpstreamEntityRef) Reset() {
+	*x = UpstreamEntityRef{}
+	mi := &file_minder_v1_minder_proto_msgTypes[255]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EvaluationHistoryAlert) String() string {
+func (x *UpstreamEntityRef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EvaluationHistoryAlert) ProtoMessage() {}
+func (*UpstreamEntityRef) ProtoMessage() {}
 
-func (x *EvaluationHistoryAlert) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[187]
+func (x *UpstreamEntityRef) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[255]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11749,59 +15926,77 @@ func (x *EvaluationHistoryAlert) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EvaluationHistoryAlert.ProtoReflect.Descriptor instead.
-func (*EvaluationHistoryAlert) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{187}
+// Deprecated: Use UpstreamEntityRef.ProtoReflect.Descriptor instead.
+func (*UpstreamEntityRef) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{255}
 }
 
-func (x *EvaluationHistoryAlert) GetStatus() string {
+func (x *UpstreamEntityRef) GetContext() *ContextV2 {
 	if x != nil {
-		return x.Status
+		return x.Context
 	}
-	return ""
+	return nil
 }
 
-func (x *EvaluationHistoryAlert) GetDetails() string {
+func (x *UpstreamEntityRef) GetType() Entity {
 	if x != nil {
-		return x.Details
+		return x.Type
 	}
-	return ""
+	return Entity_ENTITY_UNSPECIFIED
 }
 
-// used for parsing resources in ruletypes
-type EntityInstance struct {
+func (x *UpstreamEntityRef) GetProperties() *structpb.Struct {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+// DataSource is a Data source instance. Data sources represent
+// external integrations that enrich the data in Minder, but do not
+// have explicit lifecycle objects (entities).  Integrations which
+// create entities are called Providers.
+type DataSource struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// id is the unique identifier of the entity.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// context is the context in which the entity is evaluated.
-	Context *ContextV2 `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
-	// name is the name of the entity.
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// type is the type of the entity.
-	// DISCUSSION: If we're aiming for a BYO entity type, we should probably
-	// have this be a string, and have the user provide the type.
-	Type Entity `protobuf:"varint,4,opt,name=type,proto3,enum=minder.v1.Entity" json:"type,omitempty"`
-	// properties is a map of properties of the entity.
-	Properties    *structpb.Struct `protobuf:"bytes,5,opt,name=properties,proto3" json:"properties,omitempty"`
+	// version is the version of the data source API.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// type is the data source type
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// context is the context in which the data source is evaluated.
+	// Note that in this case we only need the project in the
+	// context, since data sources are not provider-specific.
+	Context *ContextV2 `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	// name is the name of the data source.
+	// Note that this is unique within a project hierarchy.
+	// Names must be lowercase and can only contain letters, numbers,
+	// hyphens, and underscores.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// id is the unique identifier of the data source.
+	Id string `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are valid to be assigned to Driver:
+	//
+	//	*DataSource_Structured
+	//	*DataSource_Rest
+	Driver        isDataSource_Driver `protobuf_oneof:"driver"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EntityInstance) Reset() {
-	*x = EntityInstance{}
-	mi := &file_minder_v1_minder_proto_msgTypes[188]
+func (x *DataSource) Reset() {
+	*x = DataSource{}
+	mi := &file_minder_v1_minder_proto_msgTypes[256]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EntityInstance) String() string {
+func (x *DataSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityInstance) ProtoMessage() {}
+func (*DataSource) ProtoMessage() {}
 
-func (x *EntityInstance) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[188]
+func (x *DataSource) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[256]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11812,74 +16007,113 @@ func (x *EntityInstance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntityInstance.ProtoReflect.Descriptor instead.
-func (*EntityInstance) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{188}
+// Deprecated: Use DataSource.ProtoReflect.Descriptor instead.
+func (*DataSource) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{256}
 }
 
-func (x *EntityInstance) GetId() string {
+func (x *DataSource) GetVersion() string {
 	if x != nil {
-		return x.Id
+		return x.Version
 	}
 	return ""
 }
 
-func (x *EntityInstance) GetContext() *ContextV2 {
+func (x *DataSource) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DataSource) GetContext() *ContextV2 {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *EntityInstance) GetName() string {
+func (x *DataSource) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *EntityInstance) GetType() Entity {
+func (x *DataSource) GetId() string {
 	if x != nil {
-		return x.Type
+		return x.Id
 	}
-	return Entity_ENTITY_UNSPECIFIED
+	return ""
 }
 
-func (x *EntityInstance) GetProperties() *structpb.Struct {
+func (x *DataSource) GetDriver() isDataSource_Driver {
 	if x != nil {
-		return x.Properties
+		return x.Driver
 	}
 	return nil
 }
 
-// ListEntitiesRequest is the request message for the ListEntities method
-type ListEntitiesRequest struct {
+func (x *DataSource) GetStructured() *StructDataSource {
+	if x != nil {
+		if x, ok := x.Driver.(*DataSource_Structured); ok {
+			return x.Structured
+		}
+	}
+	return nil
+}
+
+func (x *DataSource) GetRest() *RestDataSource {
+	if x != nil {
+		if x, ok := x.Driver.(*DataSource_Rest); ok {
+			return x.Rest
+		}
+	}
+	return nil
+}
+
+type isDataSource_Driver interface {
+	isDataSource_Driver()
+}
+
+type DataSource_Structured struct {
+	// structured is the structired data - data source.
+	Structured *StructDataSource `protobuf:"bytes,8,opt,name=structured,proto3,oneof"`
+}
+
+type DataSource_Rest struct {
+	// rest is the REST data source driver.
+	Rest *RestDataSource `protobuf:"bytes,6,opt,name=rest,proto3,oneof"`
+}
+
+func (*DataSource_Structured) isDataSource_Driver() {}
+
+func (*DataSource_Rest) isDataSource_Driver() {}
+
+// StructDataSource is the structured data source driver.
+type StructDataSource struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the entities are listed
-	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// entity_type is the type of entity to list
-	EntityType Entity `protobuf:"varint,2,opt,name=entity_type,json=entityType,proto3,enum=minder.v1.Entity" json:"entity_type,omitempty"`
-	// cursor is the pagination cursor
-	Cursor        *Cursor `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// defs is the list of definitions for the structured data API.
+	Def           map[string]*StructDataSource_Def `protobuf:"bytes,1,rep,name=def,proto3" json:"def,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEntitiesRequest) Reset() {
-	*x = ListEntitiesRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[189]
+func (x *StructDataSource) Reset() {
+	*x = StructDataSource{}
+	mi := &file_minder_v1_minder_proto_msgTypes[257]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEntitiesRequest) String() string {
+func (x *StructDataSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEntitiesRequest) ProtoMessage() {}
+func (*StructDataSource) ProtoMessage() {}
 
-func (x *ListEntitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[189]
+func (x *StructDataSource) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[257]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11890,58 +16124,105 @@ func (x *ListEntitiesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEntitiesRequest.ProtoReflect.Descriptor instead.
-func (*ListEntitiesRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{189}
+// Deprecated: Use StructDataSource.ProtoReflect.Descriptor instead.
+func (*StructDataSource) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{257}
 }
 
-func (x *ListEntitiesRequest) GetContext() *ContextV2 {
+func (x *StructDataSource) GetDef() map[string]*StructDataSource_Def {
 	if x != nil {
-		return x.Context
+		return x.Def
 	}
 	return nil
 }
 
-func (x *ListEntitiesRequest) GetEntityType() Entity {
+// RestDataSource is the REST data source driver.
+type RestDataSource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// defs is the list of definitions for the REST API.
+	Def map[string]*RestDataSource_Def `protobuf:"bytes,1,rep,name=def,proto3" json:"def,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// provider_auth enables provider authentication for this data source.
+	// When enabled, the data source will use the provider's authentication
+	// credentials to make requests.
+	ProviderAuth  bool `protobuf:"varint,2,opt,name=provider_auth,json=providerAuth,proto3" json:"provider_auth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestDataSource) Reset() {
+	*x = RestDataSource{}
+	mi := &file_minder_v1_minder_proto_msgTypes[258]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestDataSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestDataSource) ProtoMessage() {}
+
+func (x *RestDataSource) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[258]
 	if x != nil {
-		return x.EntityType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return Entity_ENTITY_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *ListEntitiesRequest) GetCursor() *Cursor {
+// Deprecated: Use RestDataSource.ProtoReflect.Descriptor instead.
+func (*RestDataSource) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{258}
+}
+
+func (x *RestDataSource) GetDef() map[string]*RestDataSource_Def {
 	if x != nil {
-		return x.Cursor
+		return x.Def
 	}
 	return nil
 }
 
-// ListEntitiesResponse is the response message for the ListEntities method
-type ListEntitiesResponse struct {
+func (x *RestDataSource) GetProviderAuth() bool {
+	if x != nil {
+		return x.ProviderAuth
+	}
+	return false
+}
+
+// DataSourceReference is a reference to a data source.
+// Note that for a resource to refer to a data source the data source must
+// be available in the same project hierarchy.
+type DataSourceReference struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// results is the list of entities
-	Results []*EntityInstance `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	// page is the pagination information
-	Page          *CursorPage `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	// name is the name of the data source within the project hierarchy.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// alias is the alias used to refer to the data source in the rule
+	// definition.
+	// If left unset, it will default to the name of the data source.
+	Alias         string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEntitiesResponse) Reset() {
-	*x = ListEntitiesResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[190]
+func (x *DataSourceReference) Reset() {
+	*x = DataSourceReference{}
+	mi := &file_minder_v1_minder_proto_msgTypes[259]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEntitiesResponse) String() string {
+func (x *DataSourceReference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEntitiesResponse) ProtoMessage() {}
+func (*DataSourceReference) ProtoMessage() {}
 
-func (x *ListEntitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[190]
+func (x *DataSourceReference) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[259]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11952,51 +16233,63 @@ func (x *ListEntitiesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEntitiesResponse.ProtoReflect.Descriptor instead.
-func (*ListEntitiesResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{190}
+// Deprecated: Use DataSourceReference.ProtoReflect.Descriptor instead.
+func (*DataSourceReference) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{259}
 }
 
-func (x *ListEntitiesResponse) GetResults() []*EntityInstance {
+func (x *DataSourceReference) GetName() string {
 	if x != nil {
-		return x.Results
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ListEntitiesResponse) GetPage() *CursorPage {
+func (x *DataSourceReference) GetAlias() string {
 	if x != nil {
-		return x.Page
+		return x.Alias
 	}
-	return nil
+	return ""
 }
 
-// GetEntityByIdRequest is the request message for the GetEntityById method
-type GetEntityByIdRequest struct {
+// TrustRoot is a custom set of trusted material that artifact signatures
+// can be verified against, instead of the public Sigstore instances. It may
+// contain a Sigstore trusted root for keyless signatures, public keys for
+// key-based signatures, or both.
+type TrustRoot struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the entity is evaluated
-	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// id is the ID of the entity to get
-	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// id is the unique identifier of the trust root.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// context is the context in which the trust root is defined.
+	// Trust roots are visible to the project and its child projects.
+	Context *ContextV2 `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	// name is the name of the trust root, unique within a project hierarchy.
+	// Names must be lowercase and can only contain letters, numbers,
+	// hyphens, and underscores.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// sigstore is the Sigstore deployment trusted for keyless signatures.
+	Sigstore *TrustRoot_SigstoreRoot `protobuf:"bytes,4,opt,name=sigstore,proto3" json:"sigstore,omitempty"`
+	// public_keys are the public keys trusted for key-based signatures.
+	PublicKeys    []*TrustRoot_PublicKey `protobuf:"bytes,5,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEntityByIdRequest) Reset() {
-	*x = GetEntityByIdRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[191]
+func (x *TrustRoot) Reset() {
+	*x = TrustRoot{}
+	mi := &file_minder_v1_minder_proto_msgTypes[260]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEntityByIdRequest) String() string {
+func (x *TrustRoot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEntityByIdRequest) ProtoMessage() {}
+func (*TrustRoot) ProtoMessage() {}
 
-func (x *GetEntityByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[191]
+func (x *TrustRoot) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[260]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12007,49 +16300,72 @@ func (x *GetEntityByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEntityByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetEntityByIdRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{191}
+// Deprecated: Use TrustRoot.ProtoReflect.Descriptor instead.
+func (*TrustRoot) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{260}
 }
 
-func (x *GetEntityByIdRequest) GetContext() *ContextV2 {
+func (x *TrustRoot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TrustRoot) GetContext() *ContextV2 {
 	if x != nil {
 		return x.Context
 	}
 	return nil
 }
 
-func (x *GetEntityByIdRequest) GetId() string {
+func (x *TrustRoot) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-// GetEntityByIdResponse is the response message for the GetEntityById method
-type GetEntityByIdResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// entity is the entity that was retrieved
-	Entity        *EntityInstance `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+func (x *TrustRoot) GetSigstore() *TrustRoot_SigstoreRoot {
+	if x != nil {
+		return x.Sigstore
+	}
+	return nil
+}
+
+func (x *TrustRoot) GetPublicKeys() []*TrustRoot_PublicKey {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+// BundleInfo describes a bundle available in the marketplace
+type BundleInfo struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// versions lists the available versions of the bundle, newest first
+	Versions      []string `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEntityByIdResponse) Reset() {
-	*x = GetEntityByIdResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[192]
+func (x *BundleInfo) Reset() {
+	*x = BundleInfo{}
+	mi := &file_minder_v1_minder_proto_msgTypes[261]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEntityByIdResponse) String() string {
+func (x *BundleInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEntityByIdResponse) ProtoMessage() {}
+func (*BundleInfo) ProtoMessage() {}
 
-func (x *GetEntityByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[192]
+func (x *BundleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[261]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12060,46 +16376,63 @@ func (x *GetEntityByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEntityByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetEntityByIdResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{192}
+// Deprecated: Use BundleInfo.ProtoReflect.Descriptor instead.
+func (*BundleInfo) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{261}
 }
 
-func (x *GetEntityByIdResponse) GetEntity() *EntityInstance {
+func (x *BundleInfo) GetNamespace() string {
 	if x != nil {
-		return x.Entity
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *BundleInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BundleInfo) GetVersions() []string {
+	if x != nil {
+		return x.Versions
 	}
 	return nil
 }
 
-// GetEntityByNameRequest is the request message for the GetEntityByName method
-type GetEntityByNameRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the entity is evaluated
-	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// name is the name of the entity to get
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// entity_type is the type of entity to get
-	EntityType    Entity `protobuf:"varint,3,opt,name=entity_type,json=entityType,proto3,enum=minder.v1.Entity" json:"entity_type,omitempty"`
+// BundleSubscription describes the subscription of a project to a bundle
+type BundleSubscription struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId   string                 `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProjectName string                 `protobuf:"bytes,2,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	Namespace   string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name        string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// version is the bundle version the project is subscribed to
+	Version string `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	// latest_version is the latest available version of the bundle, if the
+	// bundle is available in the marketplace
+	LatestVersion string `protobuf:"bytes,6,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEntityByNameRequest) Reset() {
-	*x = GetEntityByNameRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[193]
+func (x *BundleSubscription) Reset() {
+	*x = BundleSubscription{}
+	mi := &file_minder_v1_minder_proto_msgTypes[262]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEntityByNameRequest) String() string {
+func (x *BundleSubscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEntityByNameRequest) ProtoMessage() {}
+func (*BundleSubscription) ProtoMessage() {}
 
-func (x *GetEntityByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[193]
+func (x *BundleSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[262]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12110,56 +16443,78 @@ func (x *GetEntityByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEntityByNameRequest.ProtoReflect.Descriptor instead.
-func (*GetEntityByNameRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{193}
+// Deprecated: Use BundleSubscription.ProtoReflect.Descriptor instead.
+func (*BundleSubscription) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{262}
 }
 
-func (x *GetEntityByNameRequest) GetContext() *ContextV2 {
+func (x *BundleSubscription) GetProjectId() string {
 	if x != nil {
-		return x.Context
+		return x.ProjectId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetEntityByNameRequest) GetName() string {
+func (x *BundleSubscription) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *BundleSubscription) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *BundleSubscription) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *GetEntityByNameRequest) GetEntityType() Entity {
+func (x *BundleSubscription) GetVersion() string {
 	if x != nil {
-		return x.EntityType
+		return x.Version
 	}
-	return Entity_ENTITY_UNSPECIFIED
+	return ""
 }
 
-// GetEntityByNameResponse is the response message for the GetEntityByName method
-type GetEntityByNameResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// entity is the entity that was retrieved
-	Entity        *EntityInstance `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+func (x *BundleSubscription) GetLatestVersion() string {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return ""
+}
+
+// BundleDiff lists the changes an upgrade of a bundle makes to a project
+type BundleDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleTypes     []*BundleDiffEntry     `protobuf:"bytes,1,rep,name=rule_types,json=ruleTypes,proto3" json:"rule_types,omitempty"`
+	Profiles      []*BundleDiffEntry     `protobuf:"bytes,2,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	DataSources   []*BundleDiffEntry     `protobuf:"bytes,3,rep,name=data_sources,json=dataSources,proto3" json:"data_sources,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEntityByNameResponse) Reset() {
-	*x = GetEntityByNameResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[194]
+func (x *BundleDiff) Reset() {
+	*x = BundleDiff{}
+	mi := &file_minder_v1_minder_proto_msgTypes[263]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEntityByNameResponse) String() string {
+func (x *BundleDiff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEntityByNameResponse) ProtoMessage() {}
+func (*BundleDiff) ProtoMessage() {}
 
-func (x *GetEntityByNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[194]
+func (x *BundleDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[263]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12170,44 +16525,56 @@ func (x *GetEntityByNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEntityByNameResponse.ProtoReflect.Descriptor instead.
-func (*GetEntityByNameResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{194}
+// Deprecated: Use BundleDiff.ProtoReflect.Descriptor instead.
+func (*BundleDiff) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{263}
 }
 
-func (x *GetEntityByNameResponse) GetEntity() *EntityInstance {
+func (x *BundleDiff) GetRuleTypes() []*BundleDiffEntry {
 	if x != nil {
-		return x.Entity
+		return x.RuleTypes
 	}
 	return nil
 }
 
-// DeleteEntityByIdRequest is the request message for the DeleteEntityById method
-type DeleteEntityByIdRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the entity is evaluated
-	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// id is the ID of the entity to delete
-	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+func (x *BundleDiff) GetProfiles() []*BundleDiffEntry {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+func (x *BundleDiff) GetDataSources() []*BundleDiffEntry {
+	if x != nil {
+		return x.DataSources
+	}
+	return nil
+}
+
+// BundleDiffEntry is the change made to a single resource of a bundle
+type BundleDiffEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Change        BundleDiffEntry_Change `protobuf:"varint,2,opt,name=change,proto3,enum=minder.v1.BundleDiffEntry_Change" json:"change,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEntityByIdRequest) Reset() {
-	*x = DeleteEntityByIdRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[195]
+func (x *BundleDiffEntry) Reset() {
+	*x = BundleDiffEntry{}
+	mi := &file_minder_v1_minder_proto_msgTypes[264]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEntityByIdRequest) String() string {
+func (x *BundleDiffEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEntityByIdRequest) ProtoMessage() {}
+func (*BundleDiffEntry) ProtoMessage() {}
 
-func (x *DeleteEntityByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[195]
+func (x *BundleDiffEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[264]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12218,49 +16585,48 @@ func (x *DeleteEntityByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEntityByIdRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEntityByIdRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{195}
+// Deprecated: Use BundleDiffEntry.ProtoReflect.Descriptor instead.
+func (*BundleDiffEntry) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{264}
 }
 
-func (x *DeleteEntityByIdRequest) GetContext() *ContextV2 {
+func (x *BundleDiffEntry) GetName() string {
 	if x != nil {
-		return x.Context
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *DeleteEntityByIdRequest) GetId() string {
+func (x *BundleDiffEntry) GetChange() BundleDiffEntry_Change {
 	if x != nil {
-		return x.Id
+		return x.Change
 	}
-	return ""
+	return BundleDiffEntry_CHANGE_UNSPECIFIED
 }
 
-// DeleteEntityByIdResponse is the response message for the DeleteEntityById method
-type DeleteEntityByIdResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// id is the ID of the entity that was deleted
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type RegisterRepoResult_Status struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         *string                `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEntityByIdResponse) Reset() {
-	*x = DeleteEntityByIdResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[196]
+func (x *RegisterRepoResult_Status) Reset() {
+	*x = RegisterRepoResult_Status{}
+	mi := &file_minder_v1_minder_proto_msgTypes[265]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEntityByIdResponse) String() string {
+func (x *RegisterRepoResult_Status) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEntityByIdResponse) ProtoMessage() {}
+func (*RegisterRepoResult_Status) ProtoMessage() {}
 
-func (x *DeleteEntityByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[196]
+func (x *RegisterRepoResult_Status) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[265]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12271,49 +16637,55 @@ func (x *DeleteEntityByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEntityByIdResponse.ProtoReflect.Descriptor instead.
-func (*DeleteEntityByIdResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{196}
+// Deprecated: Use RegisterRepoResult_Status.ProtoReflect.Descriptor instead.
+func (*RegisterRepoResult_Status) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{30, 0}
 }
 
-func (x *DeleteEntityByIdResponse) GetId() string {
+func (x *RegisterRepoResult_Status) GetSuccess() bool {
 	if x != nil {
-		return x.Id
+		return x.Success
+	}
+	return false
+}
+
+func (x *RegisterRepoResult_Status) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
 	}
 	return ""
 }
 
-// RegisterEntityRequest is the request message for the RegisterEntity method
-type RegisterEntityRequest struct {
+// Change is a repository registered or deregistered by a rule.
+type AutoRegistrationReport_Change struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the entity is created
-	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// entity_type is the type of entity to create
-	EntityType Entity `protobuf:"varint,2,opt,name=entity_type,json=entityType,proto3,enum=minder.v1.Entity" json:"entity_type,omitempty"`
-	// identifying_properties uniquely identifies the entity in the provider.
-	// For example, for a GitHub repository use github/repo_owner and github/repo_name,
-	// or use upstream_id to identify by provider's internal ID.
-	// Each key maps to a value that can be a string, number, boolean, or nested structure.
-	IdentifyingProperties map[string]*structpb.Value `protobuf:"bytes,3,rep,name=identifying_properties,json=identifyingProperties,proto3" json:"identifying_properties,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// provider is the name of the provider of the repository.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// name is the name of the repository.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// rule is the name of the rule which registered the repository.
+	Rule string `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
+	// error is set when the change failed.
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterEntityRequest) Reset() {
-	*x = RegisterEntityRequest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[197]
+func (x *AutoRegistrationReport_Change) Reset() {
+	*x = AutoRegistrationReport_Change{}
+	mi := &file_minder_v1_minder_proto_msgTypes[266]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterEntityRequest) String() string {
+func (x *AutoRegistrationReport_Change) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterEntityRequest) ProtoMessage() {}
+func (*AutoRegistrationReport_Change) ProtoMessage() {}
 
-func (x *RegisterEntityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[197]
+func (x *AutoRegistrationReport_Change) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[266]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12324,56 +16696,65 @@ func (x *RegisterEntityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterEntityRequest.ProtoReflect.Descriptor instead.
-func (*RegisterEntityRequest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{197}
+// Deprecated: Use AutoRegistrationReport_Change.ProtoReflect.Descriptor instead.
+func (*AutoRegistrationReport_Change) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{41, 0}
 }
 
-func (x *RegisterEntityRequest) GetContext() *ContextV2 {
+func (x *AutoRegistrationReport_Change) GetProvider() string {
 	if x != nil {
-		return x.Context
+		return x.Provider
 	}
-	return nil
+	return ""
 }
 
-func (x *RegisterEntityRequest) GetEntityType() Entity {
+func (x *AutoRegistrationReport_Change) GetName() string {
 	if x != nil {
-		return x.EntityType
+		return x.Name
 	}
-	return Entity_ENTITY_UNSPECIFIED
+	return ""
 }
 
-func (x *RegisterEntityRequest) GetIdentifyingProperties() map[string]*structpb.Value {
+func (x *AutoRegistrationReport_Change) GetRule() string {
 	if x != nil {
-		return x.IdentifyingProperties
+		return x.Rule
 	}
-	return nil
+	return ""
 }
 
-// RegisterEntityResponse is the response message for the RegisterEntity method
-type RegisterEntityResponse struct {
+func (x *AutoRegistrationReport_Change) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListEvaluationResultsResponse_EntityProfileEvaluationResults struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// entity is the entity that was created
-	Entity        *EntityInstance `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	// profile_status is the status of the profile - id, name, status, last_updated
+	ProfileStatus *ProfileStatus `protobuf:"bytes,1,opt,name=profile_status,json=profileStatus,proto3" json:"profile_status,omitempty"`
+	// Note that some fields like profile_id and entity might be empty
+	// Eventually we might replace this type with another one that fits the API better
+	Results       []*RuleEvaluationStatus `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisterEntityResponse) Reset() {
-	*x = RegisterEntityResponse{}
-	mi := &file_minder_v1_minder_proto_msgTypes[198]
+func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) Reset() {
+	*x = ListEvaluationResultsResponse_EntityProfileEvaluationResults{}
+	mi := &file_minder_v1_minder_proto_msgTypes[269]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisterEntityResponse) String() string {
+func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterEntityResponse) ProtoMessage() {}
+func (*ListEvaluationResultsResponse_EntityProfileEvaluationResults) ProtoMessage() {}
 
-func (x *RegisterEntityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[198]
+func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[269]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12384,53 +16765,48 @@ func (x *RegisterEntityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterEntityResponse.ProtoReflect.Descriptor instead.
-func (*RegisterEntityResponse) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{198}
+// Deprecated: Use ListEvaluationResultsResponse_EntityProfileEvaluationResults.ProtoReflect.Descriptor instead.
+func (*ListEvaluationResultsResponse_EntityProfileEvaluationResults) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{161, 0}
 }
 
-func (x *RegisterEntityResponse) GetEntity() *EntityInstance {
+func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) GetProfileStatus() *ProfileStatus {
 	if x != nil {
-		return x.Entity
+		return x.ProfileStatus
 	}
 	return nil
 }
 
-// UpstreamEntityRef providers enough information for the
-// provider to identify the entity in the upstream system.
-type UpstreamEntityRef struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// context is the context in which the entity is evaluated.
-	// Note that the context is included here since users of
-	// this message may return upstream references from
-	// multiple providers
-	Context *ContextV2 `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	// type is the type of the entity.
-	Type Entity `protobuf:"varint,2,opt,name=type,proto3,enum=minder.v1.Entity" json:"type,omitempty"`
-	// properties is a map of properties of the entity.
-	// This will be used to identify the entity in the upstream system
-	// and will be a subset of the properties of the entity that will
-	// be stored in Minder.
-	Properties    *structpb.Struct `protobuf:"bytes,3,opt,name=properties,proto3" json:"properties,omitempty"`
+func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) GetResults() []*RuleEvaluationStatus {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ListEvaluationResultsResponse_EntityEvaluationResults struct {
+	state         protoimpl.MessageState                                          `protogen:"open.v1"`
+	Entity        *EntityTypedId                                                  `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	Profiles      []*ListEvaluationResultsResponse_EntityProfileEvaluationResults `protobuf:"bytes,2,rep,name=profiles,proto3" json:"profiles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpstreamEntityRef) Reset() {
-	*x = UpstreamEntityRef{}
-	mi := &file_minder_v1_minder_proto_msgTypes[199]
+func (x *ListEvaluationResultsResponse_EntityEvaluationResults) Reset() {
+	*x = ListEvaluationResultsResponse_EntityEvaluationResults{}
+	mi := &file_minder_v1_minder_proto_msgTypes[270]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpstreamEntityRef) String() string {
+func (x *ListEvaluationResultsResponse_EntityEvaluationResults) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpstreamEntityRef) ProtoMessage() {}
+func (*ListEvaluationResultsResponse_EntityEvaluationResults) ProtoMessage() {}
 
-func (x *UpstreamEntityRef) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[199]
+func (x *ListEvaluationResultsResponse_EntityEvaluationResults) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[270]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12441,77 +16817,49 @@ func (x *UpstreamEntityRef) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpstreamEntityRef.ProtoReflect.Descriptor instead.
-func (*UpstreamEntityRef) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{199}
+// Deprecated: Use ListEvaluationResultsResponse_EntityEvaluationResults.ProtoReflect.Descriptor instead.
+func (*ListEvaluationResultsResponse_EntityEvaluationResults) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{161, 1}
 }
 
-func (x *UpstreamEntityRef) GetContext() *ContextV2 {
+func (x *ListEvaluationResultsResponse_EntityEvaluationResults) GetEntity() *EntityTypedId {
 	if x != nil {
-		return x.Context
+		return x.Entity
 	}
 	return nil
 }
 
-func (x *UpstreamEntityRef) GetType() Entity {
-	if x != nil {
-		return x.Type
-	}
-	return Entity_ENTITY_UNSPECIFIED
-}
-
-func (x *UpstreamEntityRef) GetProperties() *structpb.Struct {
+func (x *ListEvaluationResultsResponse_EntityEvaluationResults) GetProfiles() []*ListEvaluationResultsResponse_EntityProfileEvaluationResults {
 	if x != nil {
-		return x.Properties
+		return x.Profiles
 	}
 	return nil
 }
 
-// DataSource is a Data source instance. Data sources represent
-// external integrations that enrich the data in Minder, but do not
-// have explicit lifecycle objects (entities).  Integrations which
-// create entities are called Providers.
-type DataSource struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// version is the version of the data source API.
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	// type is the data source type
-	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	// context is the context in which the data source is evaluated.
-	// Note that in this case we only need the project in the
-	// context, since data sources are not provider-specific.
-	Context *ContextV2 `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
-	// name is the name of the data source.
-	// Note that this is unique within a project hierarchy.
-	// Names must be lowercase and can only contain letters, numbers,
-	// hyphens, and underscores.
-	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	// id is the unique identifier of the data source.
-	Id string `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
-	// Types that are valid to be assigned to Driver:
-	//
-	//	*DataSource_Structured
-	//	*DataSource_Rest
-	Driver        isDataSource_Driver `protobuf_oneof:"driver"`
+type RestType_Fallback struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	HttpCode int32                  `protobuf:"varint,1,opt,name=http_code,json=httpCode,proto3" json:"http_code,omitempty"`
+	// This is expected to be a valid JSON string.
+	Body          string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataSource) Reset() {
-	*x = DataSource{}
-	mi := &file_minder_v1_minder_proto_msgTypes[200]
+func (x *RestType_Fallback) Reset() {
+	*x = RestType_Fallback{}
+	mi := &file_minder_v1_minder_proto_msgTypes[271]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataSource) String() string {
+func (x *RestType_Fallback) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataSource) ProtoMessage() {}
+func (*RestType_Fallback) ProtoMessage() {}
 
-func (x *DataSource) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[200]
+func (x *RestType_Fallback) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[271]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12522,113 +16870,148 @@ func (x *DataSource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataSource.ProtoReflect.Descriptor instead.
-func (*DataSource) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{200}
+// Deprecated: Use RestType_Fallback.ProtoReflect.Descriptor instead.
+func (*RestType_Fallback) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{162, 0}
 }
 
-func (x *DataSource) GetVersion() string {
+func (x *RestType_Fallback) GetHttpCode() int32 {
 	if x != nil {
-		return x.Version
+		return x.HttpCode
 	}
-	return ""
+	return 0
 }
 
-func (x *DataSource) GetType() string {
+func (x *RestType_Fallback) GetBody() string {
 	if x != nil {
-		return x.Type
+		return x.Body
 	}
 	return ""
 }
 
-func (x *DataSource) GetContext() *ContextV2 {
+type DiffType_Ecosystem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the ecosystem.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// depfile is the file that contains the dependencies for this ecosystem
+	Depfile       string `protobuf:"bytes,2,opt,name=depfile,proto3" json:"depfile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffType_Ecosystem) Reset() {
+	*x = DiffType_Ecosystem{}
+	mi := &file_minder_v1_minder_proto_msgTypes[272]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffType_Ecosystem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffType_Ecosystem) ProtoMessage() {}
+
+func (x *DiffType_Ecosystem) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[272]
 	if x != nil {
-		return x.Context
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *DataSource) GetName() string {
+// Deprecated: Use DiffType_Ecosystem.ProtoReflect.Descriptor instead.
+func (*DiffType_Ecosystem) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{166, 0}
+}
+
+func (x *DiffType_Ecosystem) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *DataSource) GetId() string {
+func (x *DiffType_Ecosystem) GetDepfile() string {
 	if x != nil {
-		return x.Id
+		return x.Depfile
 	}
 	return ""
 }
 
-func (x *DataSource) GetDriver() isDataSource_Driver {
-	if x != nil {
-		return x.Driver
-	}
-	return nil
+// branch is the branch of the git repository, when applied to repository entities.
+// Has no meaning or effect on other entity types.
+type DepsType_RepoConfigs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Branch        string                 `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepsType_RepoConfigs) Reset() {
+	*x = DepsType_RepoConfigs{}
+	mi := &file_minder_v1_minder_proto_msgTypes[273]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataSource) GetStructured() *StructDataSource {
-	if x != nil {
-		if x, ok := x.Driver.(*DataSource_Structured); ok {
-			return x.Structured
-		}
-	}
-	return nil
+func (x *DepsType_RepoConfigs) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DataSource) GetRest() *RestDataSource {
+func (*DepsType_RepoConfigs) ProtoMessage() {}
+
+func (x *DepsType_RepoConfigs) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[273]
 	if x != nil {
-		if x, ok := x.Driver.(*DataSource_Rest); ok {
-			return x.Rest
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
-}
-
-type isDataSource_Driver interface {
-	isDataSource_Driver()
+	return mi.MessageOf(x)
 }
 
-type DataSource_Structured struct {
-	// structured is the structired data - data source.
-	Structured *StructDataSource `protobuf:"bytes,8,opt,name=structured,proto3,oneof"`
+// Deprecated: Use DepsType_RepoConfigs.ProtoReflect.Descriptor instead.
+func (*DepsType_RepoConfigs) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{167, 0}
 }
 
-type DataSource_Rest struct {
-	// rest is the REST data source driver.
-	Rest *RestDataSource `protobuf:"bytes,6,opt,name=rest,proto3,oneof"`
+func (x *DepsType_RepoConfigs) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
 }
 
-func (*DataSource_Structured) isDataSource_Driver() {}
-
-func (*DataSource_Rest) isDataSource_Driver() {}
-
-// StructDataSource is the structured data source driver.
-type StructDataSource struct {
+type DepsType_PullRequestConfigs struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// defs is the list of definitions for the structured data API.
-	Def           map[string]*StructDataSource_Def `protobuf:"bytes,1,rep,name=def,proto3" json:"def,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// filter is the filter to apply to the PRs.  The default value is "NEW_AND_UPDATED".
+	Filter        string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StructDataSource) Reset() {
-	*x = StructDataSource{}
-	mi := &file_minder_v1_minder_proto_msgTypes[201]
+func (x *DepsType_PullRequestConfigs) Reset() {
+	*x = DepsType_PullRequestConfigs{}
+	mi := &file_minder_v1_minder_proto_msgTypes[274]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StructDataSource) String() string {
+func (x *DepsType_PullRequestConfigs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StructDataSource) ProtoMessage() {}
+func (*DepsType_PullRequestConfigs) ProtoMessage() {}
 
-func (x *StructDataSource) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[201]
+func (x *DepsType_PullRequestConfigs) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[274]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12639,46 +17022,59 @@ func (x *StructDataSource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StructDataSource.ProtoReflect.Descriptor instead.
-func (*StructDataSource) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{201}
+// Deprecated: Use DepsType_PullRequestConfigs.ProtoReflect.Descriptor instead.
+func (*DepsType_PullRequestConfigs) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{167, 1}
 }
 
-func (x *StructDataSource) GetDef() map[string]*StructDataSource_Def {
+func (x *DepsType_PullRequestConfigs) GetFilter() string {
 	if x != nil {
-		return x.Def
+		return x.Filter
 	}
-	return nil
+	return ""
 }
 
-// RestDataSource is the REST data source driver.
-type RestDataSource struct {
+// Definition defines the rule type. It encompases the schema and the data evaluation.
+type RuleType_Definition struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// defs is the list of definitions for the REST API.
-	Def map[string]*RestDataSource_Def `protobuf:"bytes,1,rep,name=def,proto3" json:"def,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// provider_auth enables provider authentication for this data source.
-	// When enabled, the data source will use the provider's authentication
-	// credentials to make requests.
-	ProviderAuth  bool `protobuf:"varint,2,opt,name=provider_auth,json=providerAuth,proto3" json:"provider_auth,omitempty"`
+	// in_entity is the entity in which the rule is evaluated.
+	// This can be repository, build_environment or artifact.
+	InEntity string `protobuf:"bytes,1,opt,name=in_entity,json=inEntity,proto3" json:"in_entity,omitempty"`
+	// rule_schema is the schema of the rule. This is expressed in JSON Schema.
+	RuleSchema *structpb.Struct `protobuf:"bytes,2,opt,name=rule_schema,json=ruleSchema,proto3" json:"rule_schema,omitempty"`
+	// param_schema is the schema of the parameters that are passed to the rule.
+	// This is expressed in JSON Schema.
+	ParamSchema *structpb.Struct               `protobuf:"bytes,3,opt,name=param_schema,json=paramSchema,proto3,oneof" json:"param_schema,omitempty"`
+	Ingest      *RuleType_Definition_Ingest    `protobuf:"bytes,4,opt,name=ingest,proto3" json:"ingest,omitempty"`
+	Eval        *RuleType_Definition_Eval      `protobuf:"bytes,5,opt,name=eval,proto3" json:"eval,omitempty"`
+	Remediate   *RuleType_Definition_Remediate `protobuf:"bytes,6,opt,name=remediate,proto3" json:"remediate,omitempty"`
+	Alert       *RuleType_Definition_Alert     `protobuf:"bytes,7,opt,name=alert,proto3" json:"alert,omitempty"`
+	Limits      *RuleType_Definition_Limits    `protobuf:"bytes,8,opt,name=limits,proto3,oneof" json:"limits,omitempty"`
+	// schema_version is the version of the rule and parameter schemas.
+	// Updates which change the schemas in a backwards incompatible way
+	// must increase it, and may declare a migration of the rules of
+	// existing profiles.
+	SchemaVersion uint32                         `protobuf:"varint,9,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	Migration     *RuleType_Definition_Migration `protobuf:"bytes,10,opt,name=migration,proto3,oneof" json:"migration,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestDataSource) Reset() {
-	*x = RestDataSource{}
-	mi := &file_minder_v1_minder_proto_msgTypes[202]
+func (x *RuleType_Definition) Reset() {
+	*x = RuleType_Definition{}
+	mi := &file_minder_v1_minder_proto_msgTypes[275]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestDataSource) String() string {
+func (x *RuleType_Definition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestDataSource) ProtoMessage() {}
+func (*RuleType_Definition) ProtoMessage() {}
 
-func (x *RestDataSource) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[202]
+func (x *RuleType_Definition) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[275]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12689,55 +17085,126 @@ func (x *RestDataSource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestDataSource.ProtoReflect.Descriptor instead.
-func (*RestDataSource) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{202}
+// Deprecated: Use RuleType_Definition.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0}
 }
 
-func (x *RestDataSource) GetDef() map[string]*RestDataSource_Def {
+func (x *RuleType_Definition) GetInEntity() string {
 	if x != nil {
-		return x.Def
+		return x.InEntity
+	}
+	return ""
+}
+
+func (x *RuleType_Definition) GetRuleSchema() *structpb.Struct {
+	if x != nil {
+		return x.RuleSchema
 	}
 	return nil
 }
 
-func (x *RestDataSource) GetProviderAuth() bool {
+func (x *RuleType_Definition) GetParamSchema() *structpb.Struct {
 	if x != nil {
-		return x.ProviderAuth
+		return x.ParamSchema
 	}
-	return false
+	return nil
 }
 
-// DataSourceReference is a reference to a data source.
-// Note that for a resource to refer to a data source the data source must
-// be available in the same project hierarchy.
-type DataSourceReference struct {
+func (x *RuleType_Definition) GetIngest() *RuleType_Definition_Ingest {
+	if x != nil {
+		return x.Ingest
+	}
+	return nil
+}
+
+func (x *RuleType_Definition) GetEval() *RuleType_Definition_Eval {
+	if x != nil {
+		return x.Eval
+	}
+	return nil
+}
+
+func (x *RuleType_Definition) GetRemediate() *RuleType_Definition_Remediate {
+	if x != nil {
+		return x.Remediate
+	}
+	return nil
+}
+
+func (x *RuleType_Definition) GetAlert() *RuleType_Definition_Alert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+func (x *RuleType_Definition) GetLimits() *RuleType_Definition_Limits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *RuleType_Definition) GetSchemaVersion() uint32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *RuleType_Definition) GetMigration() *RuleType_Definition_Migration {
+	if x != nil {
+		return x.Migration
+	}
+	return nil
+}
+
+// Ingest defines how the data is ingested.
+type RuleType_Definition_Ingest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// name is the name of the data source within the project hierarchy.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// alias is the alias used to refer to the data source in the rule
-	// definition.
-	// If left unset, it will default to the name of the data source.
-	Alias         string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+	// type is the type of the data ingestion.
+	// we currently support rest, artifact and builtin.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// rest is the rest data ingestion.
+	// this is only used if the type is rest.
+	Rest *RestType `protobuf:"bytes,3,opt,name=rest,proto3,oneof" json:"rest,omitempty"`
+	// builtin is the builtin data ingestion.
+	Builtin *BuiltinType `protobuf:"bytes,4,opt,name=builtin,proto3,oneof" json:"builtin,omitempty"`
+	// artifact is the artifact data ingestion.
+	// artifact currently only applies to artifacts.
+	Artifact *ArtifactType `protobuf:"bytes,5,opt,name=artifact,proto3,oneof" json:"artifact,omitempty"`
+	// git is the git data ingestion.
+	// git currently only applies to repositories.
+	Git *GitType `protobuf:"bytes,6,opt,name=git,proto3,oneof" json:"git,omitempty"`
+	// diff is the diff data ingestion.
+	// diff currently only applies to pull_requests.
+	Diff *DiffType `protobuf:"bytes,7,opt,name=diff,proto3,oneof" json:"diff,omitempty"`
+	// deps is the deps data ingestion.
+	// deps currently only applies to repositories.
+	Deps *DepsType `protobuf:"bytes,8,opt,name=deps,proto3,oneof" json:"deps,omitempty"`
+	// alerts is the security alerts data ingestion.
+	// alerts currently only applies to repositories.
+	Alerts        *AlertsType `protobuf:"bytes,9,opt,name=alerts,proto3,oneof" json:"alerts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataSourceReference) Reset() {
-	*x = DataSourceReference{}
-	mi := &file_minder_v1_minder_proto_msgTypes[203]
+func (x *RuleType_Definition_Ingest) Reset() {
+	*x = RuleType_Definition_Ingest{}
+	mi := &file_minder_v1_minder_proto_msgTypes[276]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataSourceReference) String() string {
+func (x *RuleType_Definition_Ingest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataSourceReference) ProtoMessage() {}
+func (*RuleType_Definition_Ingest) ProtoMessage() {}
 
-func (x *DataSourceReference) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[203]
+func (x *RuleType_Definition_Ingest) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[276]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12748,103 +17215,113 @@ func (x *DataSourceReference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataSourceReference.ProtoReflect.Descriptor instead.
-func (*DataSourceReference) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{203}
+// Deprecated: Use RuleType_Definition_Ingest.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Ingest) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0, 0}
 }
 
-func (x *DataSourceReference) GetName() string {
+func (x *RuleType_Definition_Ingest) GetType() string {
 	if x != nil {
-		return x.Name
+		return x.Type
 	}
 	return ""
 }
 
-func (x *DataSourceReference) GetAlias() string {
+func (x *RuleType_Definition_Ingest) GetRest() *RestType {
 	if x != nil {
-		return x.Alias
+		return x.Rest
 	}
-	return ""
-}
-
-type RegisterRepoResult_Status struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error         *string                `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return nil
 }
 
-func (x *RegisterRepoResult_Status) Reset() {
-	*x = RegisterRepoResult_Status{}
-	mi := &file_minder_v1_minder_proto_msgTypes[204]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RuleType_Definition_Ingest) GetBuiltin() *BuiltinType {
+	if x != nil {
+		return x.Builtin
+	}
+	return nil
 }
 
-func (x *RegisterRepoResult_Status) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RuleType_Definition_Ingest) GetArtifact() *ArtifactType {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
 }
-
-func (*RegisterRepoResult_Status) ProtoMessage() {}
-
-func (x *RegisterRepoResult_Status) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[204]
+
+func (x *RuleType_Definition_Ingest) GetGit() *GitType {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Git
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use RegisterRepoResult_Status.ProtoReflect.Descriptor instead.
-func (*RegisterRepoResult_Status) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{30, 0}
+func (x *RuleType_Definition_Ingest) GetDiff() *DiffType {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
 }
 
-func (x *RegisterRepoResult_Status) GetSuccess() bool {
+func (x *RuleType_Definition_Ingest) GetDeps() *DepsType {
 	if x != nil {
-		return x.Success
+		return x.Deps
 	}
-	return false
+	return nil
 }
 
-func (x *RegisterRepoResult_Status) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
+func (x *RuleType_Definition_Ingest) GetAlerts() *AlertsType {
+	if x != nil {
+		return x.Alerts
 	}
-	return ""
+	return nil
 }
 
-type ListEvaluationResultsResponse_EntityProfileEvaluationResults struct {
+// Eval defines the data evaluation definition.
+// This pertains to the way we traverse data from the upstream
+// endpoint and how we compare it to the rule.
+type RuleType_Definition_Eval struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// profile_status is the status of the profile - id, name, status, last_updated
-	ProfileStatus *ProfileStatus `protobuf:"bytes,1,opt,name=profile_status,json=profileStatus,proto3" json:"profile_status,omitempty"`
-	// Note that some fields like profile_id and entity might be empty
-	// Eventually we might replace this type with another one that fits the API better
-	Results       []*RuleEvaluationStatus `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	// type is the type of the data evaluation.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// jq is only used if the `jq` type is selected.
+	// It defines the comparisons that are made between
+	// the ingested data and the profile rule.
+	Jq []*RuleType_Definition_Eval_JQComparison `protobuf:"bytes,2,rep,name=jq,proto3" json:"jq,omitempty"`
+	// rego is only used if the `rego` type is selected.
+	Rego *RuleType_Definition_Eval_Rego `protobuf:"bytes,3,opt,name=rego,proto3,oneof" json:"rego,omitempty"`
+	// vulncheck is only used if the `vulncheck` type is selected.
+	Vulncheck *RuleType_Definition_Eval_Vulncheck `protobuf:"bytes,4,opt,name=vulncheck,proto3,oneof" json:"vulncheck,omitempty"`
+	// The trusty type is no longer used, but is still here for backwards
+	// compatibility with existing stored rules
+	Trusty *RuleType_Definition_Eval_Trusty `protobuf:"bytes,5,opt,name=trusty,proto3,oneof" json:"trusty,omitempty"`
+	// homoglyphs is only used if the `homoglyphs` type is selected.
+	Homoglyphs *RuleType_Definition_Eval_Homoglyphs `protobuf:"bytes,6,opt,name=homoglyphs,proto3,oneof" json:"homoglyphs,omitempty"`
+	// Data sources that the rule refers to. These are used to
+	// instantiate the relevant data sources for the rule and keep
+	// track of them as dependencies.
+	//
+	// Note that the data source must exist in the project hierarchy
+	// in order to be used in the rule.
+	DataSources   []*DataSourceReference `protobuf:"bytes,7,rep,name=data_sources,json=dataSources,proto3" json:"data_sources,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) Reset() {
-	*x = ListEvaluationResultsResponse_EntityProfileEvaluationResults{}
-	mi := &file_minder_v1_minder_proto_msgTypes[207]
+func (x *RuleType_Definition_Eval) Reset() {
+	*x = RuleType_Definition_Eval{}
+	mi := &file_minder_v1_minder_proto_msgTypes[277]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) String() string {
+func (x *RuleType_Definition_Eval) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEvaluationResultsResponse_EntityProfileEvaluationResults) ProtoMessage() {}
+func (*RuleType_Definition_Eval) ProtoMessage() {}
 
-func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[207]
+func (x *RuleType_Definition_Eval) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[277]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12855,101 +17332,86 @@ func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) ProtoRefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEvaluationResultsResponse_EntityProfileEvaluationResults.ProtoReflect.Descriptor instead.
-func (*ListEvaluationResultsResponse_EntityProfileEvaluationResults) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{118, 0}
+// Deprecated: Use RuleType_Definition_Eval.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Eval) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0, 1}
 }
 
-func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) GetProfileStatus() *ProfileStatus {
+func (x *RuleType_Definition_Eval) GetType() string {
 	if x != nil {
-		return x.ProfileStatus
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-func (x *ListEvaluationResultsResponse_EntityProfileEvaluationResults) GetResults() []*RuleEvaluationStatus {
+func (x *RuleType_Definition_Eval) GetJq() []*RuleType_Definition_Eval_JQComparison {
 	if x != nil {
-		return x.Results
+		return x.Jq
 	}
 	return nil
 }
 
-type ListEvaluationResultsResponse_EntityEvaluationResults struct {
-	state         protoimpl.MessageState                                          `protogen:"open.v1"`
-	Entity        *EntityTypedId                                                  `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
-	Profiles      []*ListEvaluationResultsResponse_EntityProfileEvaluationResults `protobuf:"bytes,2,rep,name=profiles,proto3" json:"profiles,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListEvaluationResultsResponse_EntityEvaluationResults) Reset() {
-	*x = ListEvaluationResultsResponse_EntityEvaluationResults{}
-	mi := &file_minder_v1_minder_proto_msgTypes[208]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListEvaluationResultsResponse_EntityEvaluationResults) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RuleType_Definition_Eval) GetRego() *RuleType_Definition_Eval_Rego {
+	if x != nil {
+		return x.Rego
+	}
+	return nil
 }
 
-func (*ListEvaluationResultsResponse_EntityEvaluationResults) ProtoMessage() {}
-
-func (x *ListEvaluationResultsResponse_EntityEvaluationResults) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[208]
+func (x *RuleType_Definition_Eval) GetVulncheck() *RuleType_Definition_Eval_Vulncheck {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Vulncheck
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListEvaluationResultsResponse_EntityEvaluationResults.ProtoReflect.Descriptor instead.
-func (*ListEvaluationResultsResponse_EntityEvaluationResults) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{118, 1}
+func (x *RuleType_Definition_Eval) GetTrusty() *RuleType_Definition_Eval_Trusty {
+	if x != nil {
+		return x.Trusty
+	}
+	return nil
 }
 
-func (x *ListEvaluationResultsResponse_EntityEvaluationResults) GetEntity() *EntityTypedId {
+func (x *RuleType_Definition_Eval) GetHomoglyphs() *RuleType_Definition_Eval_Homoglyphs {
 	if x != nil {
-		return x.Entity
+		return x.Homoglyphs
 	}
 	return nil
 }
 
-func (x *ListEvaluationResultsResponse_EntityEvaluationResults) GetProfiles() []*ListEvaluationResultsResponse_EntityProfileEvaluationResults {
+func (x *RuleType_Definition_Eval) GetDataSources() []*DataSourceReference {
 	if x != nil {
-		return x.Profiles
+		return x.DataSources
 	}
 	return nil
 }
 
-type RestType_Fallback struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	HttpCode int32                  `protobuf:"varint,1,opt,name=http_code,json=httpCode,proto3" json:"http_code,omitempty"`
-	// This is expected to be a valid JSON string.
-	Body          string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RuleType_Definition_Remediate struct {
+	state              protoimpl.MessageState                                `protogen:"open.v1"`
+	Type               string                                                `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Rest               *RestType                                             `protobuf:"bytes,2,opt,name=rest,proto3,oneof" json:"rest,omitempty"`
+	GhBranchProtection *RuleType_Definition_Remediate_GhBranchProtectionType `protobuf:"bytes,3,opt,name=gh_branch_protection,json=ghBranchProtection,proto3,oneof" json:"gh_branch_protection,omitempty"`
+	PullRequest        *RuleType_Definition_Remediate_PullRequestRemediation `protobuf:"bytes,4,opt,name=pull_request,json=pullRequest,proto3,oneof" json:"pull_request,omitempty"`
+	GhRuleset          *RuleType_Definition_Remediate_GhRulesetType          `protobuf:"bytes,5,opt,name=gh_ruleset,json=ghRuleset,proto3,oneof" json:"gh_ruleset,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *RestType_Fallback) Reset() {
-	*x = RestType_Fallback{}
-	mi := &file_minder_v1_minder_proto_msgTypes[209]
+func (x *RuleType_Definition_Remediate) Reset() {
+	*x = RuleType_Definition_Remediate{}
+	mi := &file_minder_v1_minder_proto_msgTypes[278]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestType_Fallback) String() string {
+func (x *RuleType_Definition_Remediate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestType_Fallback) ProtoMessage() {}
+func (*RuleType_Definition_Remediate) ProtoMessage() {}
 
-func (x *RestType_Fallback) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[209]
+func (x *RuleType_Definition_Remediate) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[278]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12960,50 +17422,70 @@ func (x *RestType_Fallback) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestType_Fallback.ProtoReflect.Descriptor instead.
-func (*RestType_Fallback) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{119, 0}
+// Deprecated: Use RuleType_Definition_Remediate.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Remediate) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0, 2}
 }
 
-func (x *RestType_Fallback) GetHttpCode() int32 {
+func (x *RuleType_Definition_Remediate) GetType() string {
 	if x != nil {
-		return x.HttpCode
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *RestType_Fallback) GetBody() string {
+func (x *RuleType_Definition_Remediate) GetRest() *RestType {
 	if x != nil {
-		return x.Body
+		return x.Rest
 	}
-	return ""
+	return nil
 }
 
-type DiffType_Ecosystem struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// name is the name of the ecosystem.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// depfile is the file that contains the dependencies for this ecosystem
-	Depfile       string `protobuf:"bytes,2,opt,name=depfile,proto3" json:"depfile,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RuleType_Definition_Remediate) GetGhBranchProtection() *RuleType_Definition_Remediate_GhBranchProtectionType {
+	if x != nil {
+		return x.GhBranchProtection
+	}
+	return nil
 }
 
-func (x *DiffType_Ecosystem) Reset() {
-	*x = DiffType_Ecosystem{}
-	mi := &file_minder_v1_minder_proto_msgTypes[210]
+func (x *RuleType_Definition_Remediate) GetPullRequest() *RuleType_Definition_Remediate_PullRequestRemediation {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+func (x *RuleType_Definition_Remediate) GetGhRuleset() *RuleType_Definition_Remediate_GhRulesetType {
+	if x != nil {
+		return x.GhRuleset
+	}
+	return nil
+}
+
+type RuleType_Definition_Alert struct {
+	state              protoimpl.MessageState                        `protogen:"open.v1"`
+	Type               string                                        `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	SecurityAdvisory   *RuleType_Definition_Alert_AlertTypeSA        `protobuf:"bytes,2,opt,name=security_advisory,json=securityAdvisory,proto3,oneof" json:"security_advisory,omitempty"`
+	PullRequestComment *RuleType_Definition_Alert_AlertTypePRComment `protobuf:"bytes,3,opt,name=pull_request_comment,json=pullRequestComment,proto3,oneof" json:"pull_request_comment,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RuleType_Definition_Alert) Reset() {
+	*x = RuleType_Definition_Alert{}
+	mi := &file_minder_v1_minder_proto_msgTypes[279]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiffType_Ecosystem) String() string {
+func (x *RuleType_Definition_Alert) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiffType_Ecosystem) ProtoMessage() {}
+func (*RuleType_Definition_Alert) ProtoMessage() {}
 
-func (x *DiffType_Ecosystem) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[210]
+func (x *RuleType_Definition_Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[279]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13014,49 +17496,65 @@ func (x *DiffType_Ecosystem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiffType_Ecosystem.ProtoReflect.Descriptor instead.
-func (*DiffType_Ecosystem) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{123, 0}
+// Deprecated: Use RuleType_Definition_Alert.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Alert) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0, 3}
 }
 
-func (x *DiffType_Ecosystem) GetName() string {
+func (x *RuleType_Definition_Alert) GetType() string {
 	if x != nil {
-		return x.Name
+		return x.Type
 	}
 	return ""
 }
 
-func (x *DiffType_Ecosystem) GetDepfile() string {
+func (x *RuleType_Definition_Alert) GetSecurityAdvisory() *RuleType_Definition_Alert_AlertTypeSA {
 	if x != nil {
-		return x.Depfile
+		return x.SecurityAdvisory
 	}
-	return ""
+	return nil
 }
 
-// branch is the branch of the git repository, when applied to repository entities.
-// Has no meaning or effect on other entity types.
-type DepsType_RepoConfigs struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Branch        string                 `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RuleType_Definition_Alert) GetPullRequestComment() *RuleType_Definition_Alert_AlertTypePRComment {
+	if x != nil {
+		return x.PullRequestComment
+	}
+	return nil
+}
+
+// Limits bounds the resources a single evaluation of the rule
+// can use. The server-wide limits apply if they are stricter.
+type RuleType_Definition_Limits struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// timeout is the maximum time the ingestion and evaluation of
+	// the rule can take, expressed as a duration such as "30s".
+	Timeout string `protobuf:"bytes,1,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// max_rego_instructions is the maximum number of expressions
+	// a Rego policy can evaluate. This only applies to the rego
+	// evaluation type.
+	MaxRegoInstructions int64 `protobuf:"varint,2,opt,name=max_rego_instructions,json=maxRegoInstructions,proto3" json:"max_rego_instructions,omitempty"`
+	// max_data_source_calls is the maximum number of data source
+	// functions calls a single evaluation can make.
+	MaxDataSourceCalls int64 `protobuf:"varint,3,opt,name=max_data_source_calls,json=maxDataSourceCalls,proto3" json:"max_data_source_calls,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *DepsType_RepoConfigs) Reset() {
-	*x = DepsType_RepoConfigs{}
-	mi := &file_minder_v1_minder_proto_msgTypes[211]
+func (x *RuleType_Definition_Limits) Reset() {
+	*x = RuleType_Definition_Limits{}
+	mi := &file_minder_v1_minder_proto_msgTypes[280]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepsType_RepoConfigs) String() string {
+func (x *RuleType_Definition_Limits) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepsType_RepoConfigs) ProtoMessage() {}
+func (*RuleType_Definition_Limits) ProtoMessage() {}
 
-func (x *DepsType_RepoConfigs) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[211]
+func (x *RuleType_Definition_Limits) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[280]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13067,41 +17565,65 @@ func (x *DepsType_RepoConfigs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepsType_RepoConfigs.ProtoReflect.Descriptor instead.
-func (*DepsType_RepoConfigs) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{124, 0}
+// Deprecated: Use RuleType_Definition_Limits.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Limits) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0, 4}
 }
 
-func (x *DepsType_RepoConfigs) GetBranch() string {
+func (x *RuleType_Definition_Limits) GetTimeout() string {
 	if x != nil {
-		return x.Branch
+		return x.Timeout
 	}
 	return ""
 }
 
-type DepsType_PullRequestConfigs struct {
+func (x *RuleType_Definition_Limits) GetMaxRegoInstructions() int64 {
+	if x != nil {
+		return x.MaxRegoInstructions
+	}
+	return 0
+}
+
+func (x *RuleType_Definition_Limits) GetMaxDataSourceCalls() int64 {
+	if x != nil {
+		return x.MaxDataSourceCalls
+	}
+	return 0
+}
+
+// Migration rewrites the definition and parameters of the rules of
+// existing profiles, written for the previous schema version, when
+// the rule type is updated to a new schema version.
+type RuleType_Definition_Migration struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// filter is the filter to apply to the PRs.  The default value is "NEW_AND_UPDATED".
-	Filter        string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// type is the language of the transforms, either jq or cel.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// def transforms the rule definition. It may refer to the old
+	// definition and parameters as `.def` and `.params` in jq, or
+	// `def` and `params` in cel. If empty, the definition is kept.
+	Def string `protobuf:"bytes,2,opt,name=def,proto3" json:"def,omitempty"`
+	// params transforms the rule parameters, like def. If empty, the
+	// parameters are kept.
+	Params        string `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DepsType_PullRequestConfigs) Reset() {
-	*x = DepsType_PullRequestConfigs{}
-	mi := &file_minder_v1_minder_proto_msgTypes[212]
+func (x *RuleType_Definition_Migration) Reset() {
+	*x = RuleType_Definition_Migration{}
+	mi := &file_minder_v1_minder_proto_msgTypes[281]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepsType_PullRequestConfigs) String() string {
+func (x *RuleType_Definition_Migration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepsType_PullRequestConfigs) ProtoMessage() {}
+func (*RuleType_Definition_Migration) ProtoMessage() {}
 
-func (x *DepsType_PullRequestConfigs) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[212]
+func (x *RuleType_Definition_Migration) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[281]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13112,52 +17634,61 @@ func (x *DepsType_PullRequestConfigs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepsType_PullRequestConfigs.ProtoReflect.Descriptor instead.
-func (*DepsType_PullRequestConfigs) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{124, 1}
+// Deprecated: Use RuleType_Definition_Migration.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Migration) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0, 5}
 }
 
-func (x *DepsType_PullRequestConfigs) GetFilter() string {
+func (x *RuleType_Definition_Migration) GetType() string {
 	if x != nil {
-		return x.Filter
+		return x.Type
 	}
 	return ""
 }
 
-// Definition defines the rule type. It encompases the schema and the data evaluation.
-type RuleType_Definition struct {
+func (x *RuleType_Definition_Migration) GetDef() string {
+	if x != nil {
+		return x.Def
+	}
+	return ""
+}
+
+func (x *RuleType_Definition_Migration) GetParams() string {
+	if x != nil {
+		return x.Params
+	}
+	return ""
+}
+
+type RuleType_Definition_Eval_JQComparison struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// in_entity is the entity in which the rule is evaluated.
-	// This can be repository, build_environment or artifact.
-	InEntity string `protobuf:"bytes,1,opt,name=in_entity,json=inEntity,proto3" json:"in_entity,omitempty"`
-	// rule_schema is the schema of the rule. This is expressed in JSON Schema.
-	RuleSchema *structpb.Struct `protobuf:"bytes,2,opt,name=rule_schema,json=ruleSchema,proto3" json:"rule_schema,omitempty"`
-	// param_schema is the schema of the parameters that are passed to the rule.
-	// This is expressed in JSON Schema.
-	ParamSchema   *structpb.Struct               `protobuf:"bytes,3,opt,name=param_schema,json=paramSchema,proto3,oneof" json:"param_schema,omitempty"`
-	Ingest        *RuleType_Definition_Ingest    `protobuf:"bytes,4,opt,name=ingest,proto3" json:"ingest,omitempty"`
-	Eval          *RuleType_Definition_Eval      `protobuf:"bytes,5,opt,name=eval,proto3" json:"eval,omitempty"`
-	Remediate     *RuleType_Definition_Remediate `protobuf:"bytes,6,opt,name=remediate,proto3" json:"remediate,omitempty"`
-	Alert         *RuleType_Definition_Alert     `protobuf:"bytes,7,opt,name=alert,proto3" json:"alert,omitempty"`
+	// Ingested points to the data retrieved in the `ingest` section
+	Ingested *RuleType_Definition_Eval_JQComparison_Operator `protobuf:"bytes,1,opt,name=ingested,proto3" json:"ingested,omitempty"`
+	// Profile points to the profile itself.
+	// This is mutually exclusive with the `constant` field.
+	Profile *RuleType_Definition_Eval_JQComparison_Operator `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Constant points to a constant value.
+	// This is mutually exclusive with the `profile` field.
+	Constant      *structpb.Value `protobuf:"bytes,3,opt,name=constant,proto3" json:"constant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition) Reset() {
-	*x = RuleType_Definition{}
-	mi := &file_minder_v1_minder_proto_msgTypes[213]
+func (x *RuleType_Definition_Eval_JQComparison) Reset() {
+	*x = RuleType_Definition_Eval_JQComparison{}
+	mi := &file_minder_v1_minder_proto_msgTypes[282]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition) String() string {
+func (x *RuleType_Definition_Eval_JQComparison) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition) ProtoMessage() {}
+func (*RuleType_Definition_Eval_JQComparison) ProtoMessage() {}
 
-func (x *RuleType_Definition) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[213]
+func (x *RuleType_Definition_Eval_JQComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[282]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13168,102 +17699,71 @@ func (x *RuleType_Definition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126, 0}
-}
-
-func (x *RuleType_Definition) GetInEntity() string {
-	if x != nil {
-		return x.InEntity
-	}
-	return ""
-}
-
-func (x *RuleType_Definition) GetRuleSchema() *structpb.Struct {
-	if x != nil {
-		return x.RuleSchema
-	}
-	return nil
-}
-
-func (x *RuleType_Definition) GetParamSchema() *structpb.Struct {
-	if x != nil {
-		return x.ParamSchema
-	}
-	return nil
-}
-
-func (x *RuleType_Definition) GetIngest() *RuleType_Definition_Ingest {
-	if x != nil {
-		return x.Ingest
-	}
-	return nil
+// Deprecated: Use RuleType_Definition_Eval_JQComparison.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Eval_JQComparison) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0, 1, 0}
 }
 
-func (x *RuleType_Definition) GetEval() *RuleType_Definition_Eval {
+func (x *RuleType_Definition_Eval_JQComparison) GetIngested() *RuleType_Definition_Eval_JQComparison_Operator {
 	if x != nil {
-		return x.Eval
+		return x.Ingested
 	}
 	return nil
 }
 
-func (x *RuleType_Definition) GetRemediate() *RuleType_Definition_Remediate {
+func (x *RuleType_Definition_Eval_JQComparison) GetProfile() *RuleType_Definition_Eval_JQComparison_Operator {
 	if x != nil {
-		return x.Remediate
+		return x.Profile
 	}
 	return nil
 }
 
-func (x *RuleType_Definition) GetAlert() *RuleType_Definition_Alert {
+func (x *RuleType_Definition_Eval_JQComparison) GetConstant() *structpb.Value {
 	if x != nil {
-		return x.Alert
+		return x.Constant
 	}
 	return nil
 }
 
-// Ingest defines how the data is ingested.
-type RuleType_Definition_Ingest struct {
+type RuleType_Definition_Eval_Rego struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// type is the type of the data ingestion.
-	// we currently support rest, artifact and builtin.
+	// type is the type of evaluation engine to use
+	// for rego. We currently have two modes of operation:
+	//   - deny-by-default: this is the default mode of operation
+	//     where we deny access by default and allow access only
+	//     if the profile explicitly allows it. It expects the
+	//     profile to set an `allow` variable to true or false.
+	//   - constraints: this is the mode of operation where we
+	//     allow access by default and deny access only if a
+	//     violation is found. It expects the profile to set a
+	//     `violations` variable with a "msg" field.
 	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	// rest is the rest data ingestion.
-	// this is only used if the type is rest.
-	Rest *RestType `protobuf:"bytes,3,opt,name=rest,proto3,oneof" json:"rest,omitempty"`
-	// builtin is the builtin data ingestion.
-	Builtin *BuiltinType `protobuf:"bytes,4,opt,name=builtin,proto3,oneof" json:"builtin,omitempty"`
-	// artifact is the artifact data ingestion.
-	// artifact currently only applies to artifacts.
-	Artifact *ArtifactType `protobuf:"bytes,5,opt,name=artifact,proto3,oneof" json:"artifact,omitempty"`
-	// git is the git data ingestion.
-	// git currently only applies to repositories.
-	Git *GitType `protobuf:"bytes,6,opt,name=git,proto3,oneof" json:"git,omitempty"`
-	// diff is the diff data ingestion.
-	// diff currently only applies to pull_requests.
-	Diff *DiffType `protobuf:"bytes,7,opt,name=diff,proto3,oneof" json:"diff,omitempty"`
-	// deps is the deps data ingestion.
-	// deps currently only applies to repositories.
-	Deps          *DepsType `protobuf:"bytes,8,opt,name=deps,proto3,oneof" json:"deps,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// def is the definition of the rego profile.
+	Def string `protobuf:"bytes,2,opt,name=def,proto3" json:"def,omitempty"`
+	// how are violations reported. This is only used if the
+	// `constraints` type is selected. The default is `text`
+	// which returns human-readable text. The other option is
+	// `json` which returns a JSON array containing the violations.
+	ViolationFormat *string `protobuf:"bytes,3,opt,name=violation_format,json=violationFormat,proto3,oneof" json:"violation_format,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Ingest) Reset() {
-	*x = RuleType_Definition_Ingest{}
-	mi := &file_minder_v1_minder_proto_msgTypes[214]
+func (x *RuleType_Definition_Eval_Rego) Reset() {
+	*x = RuleType_Definition_Eval_Rego{}
+	mi := &file_minder_v1_minder_proto_msgTypes[283]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Ingest) String() string {
+func (x *RuleType_Definition_Eval_Rego) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Ingest) ProtoMessage() {}
+func (*RuleType_Definition_Eval_Rego) ProtoMessage() {}
 
-func (x *RuleType_Definition_Ingest) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[214]
+func (x *RuleType_Definition_Eval_Rego) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[283]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13274,106 +17774,92 @@ func (x *RuleType_Definition_Ingest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Ingest.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Ingest) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126, 0, 0}
+// Deprecated: Use RuleType_Definition_Eval_Rego.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Eval_Rego) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0, 1, 1}
 }
 
-func (x *RuleType_Definition_Ingest) GetType() string {
+func (x *RuleType_Definition_Eval_Rego) GetType() string {
 	if x != nil {
 		return x.Type
 	}
 	return ""
 }
 
-func (x *RuleType_Definition_Ingest) GetRest() *RestType {
+func (x *RuleType_Definition_Eval_Rego) GetDef() string {
 	if x != nil {
-		return x.Rest
+		return x.Def
 	}
-	return nil
+	return ""
 }
 
-func (x *RuleType_Definition_Ingest) GetBuiltin() *BuiltinType {
-	if x != nil {
-		return x.Builtin
+func (x *RuleType_Definition_Eval_Rego) GetViolationFormat() string {
+	if x != nil && x.ViolationFormat != nil {
+		return *x.ViolationFormat
 	}
-	return nil
+	return ""
 }
 
-func (x *RuleType_Definition_Ingest) GetArtifact() *ArtifactType {
-	if x != nil {
-		return x.Artifact
-	}
-	return nil
+type RuleType_Definition_Eval_Vulncheck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Ingest) GetGit() *GitType {
-	if x != nil {
-		return x.Git
-	}
-	return nil
+func (x *RuleType_Definition_Eval_Vulncheck) Reset() {
+	*x = RuleType_Definition_Eval_Vulncheck{}
+	mi := &file_minder_v1_minder_proto_msgTypes[284]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Ingest) GetDiff() *DiffType {
-	if x != nil {
-		return x.Diff
-	}
-	return nil
+func (x *RuleType_Definition_Eval_Vulncheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RuleType_Definition_Ingest) GetDeps() *DepsType {
+func (*RuleType_Definition_Eval_Vulncheck) ProtoMessage() {}
+
+func (x *RuleType_Definition_Eval_Vulncheck) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[284]
 	if x != nil {
-		return x.Deps
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-// Eval defines the data evaluation definition.
-// This pertains to the way we traverse data from the upstream
-// endpoint and how we compare it to the rule.
-type RuleType_Definition_Eval struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// type is the type of the data evaluation.
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	// jq is only used if the `jq` type is selected.
-	// It defines the comparisons that are made between
-	// the ingested data and the profile rule.
-	Jq []*RuleType_Definition_Eval_JQComparison `protobuf:"bytes,2,rep,name=jq,proto3" json:"jq,omitempty"`
-	// rego is only used if the `rego` type is selected.
-	Rego *RuleType_Definition_Eval_Rego `protobuf:"bytes,3,opt,name=rego,proto3,oneof" json:"rego,omitempty"`
-	// vulncheck is only used if the `vulncheck` type is selected.
-	Vulncheck *RuleType_Definition_Eval_Vulncheck `protobuf:"bytes,4,opt,name=vulncheck,proto3,oneof" json:"vulncheck,omitempty"`
-	// The trusty type is no longer used, but is still here for backwards
+// Deprecated: Use RuleType_Definition_Eval_Vulncheck.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Eval_Vulncheck) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0, 1, 2}
+}
+
+type RuleType_Definition_Eval_Trusty struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// This is no longer used, but is still here for backwards
 	// compatibility with existing stored rules
-	Trusty *RuleType_Definition_Eval_Trusty `protobuf:"bytes,5,opt,name=trusty,proto3,oneof" json:"trusty,omitempty"`
-	// homoglyphs is only used if the `homoglyphs` type is selected.
-	Homoglyphs *RuleType_Definition_Eval_Homoglyphs `protobuf:"bytes,6,opt,name=homoglyphs,proto3,oneof" json:"homoglyphs,omitempty"`
-	// Data sources that the rule refers to. These are used to
-	// instantiate the relevant data sources for the rule and keep
-	// track of them as dependencies.
-	//
-	// Note that the data source must exist in the project hierarchy
-	// in order to be used in the rule.
-	DataSources   []*DataSourceReference `protobuf:"bytes,7,rep,name=data_sources,json=dataSources,proto3" json:"data_sources,omitempty"`
+	Endpoint      string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Eval) Reset() {
-	*x = RuleType_Definition_Eval{}
-	mi := &file_minder_v1_minder_proto_msgTypes[215]
+func (x *RuleType_Definition_Eval_Trusty) Reset() {
+	*x = RuleType_Definition_Eval_Trusty{}
+	mi := &file_minder_v1_minder_proto_msgTypes[285]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Eval) String() string {
+func (x *RuleType_Definition_Eval_Trusty) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Eval) ProtoMessage() {}
+func (*RuleType_Definition_Eval_Trusty) ProtoMessage() {}
 
-func (x *RuleType_Definition_Eval) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[215]
+func (x *RuleType_Definition_Eval_Trusty) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[285]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13384,85 +17870,84 @@ func (x *RuleType_Definition_Eval) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Eval.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Eval) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126, 0, 1}
+// Deprecated: Use RuleType_Definition_Eval_Trusty.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Eval_Trusty) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0, 1, 3}
 }
 
-func (x *RuleType_Definition_Eval) GetType() string {
+func (x *RuleType_Definition_Eval_Trusty) GetEndpoint() string {
 	if x != nil {
-		return x.Type
+		return x.Endpoint
 	}
 	return ""
 }
 
-func (x *RuleType_Definition_Eval) GetJq() []*RuleType_Definition_Eval_JQComparison {
-	if x != nil {
-		return x.Jq
-	}
-	return nil
+type RuleType_Definition_Eval_Homoglyphs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Eval) GetRego() *RuleType_Definition_Eval_Rego {
-	if x != nil {
-		return x.Rego
-	}
-	return nil
+func (x *RuleType_Definition_Eval_Homoglyphs) Reset() {
+	*x = RuleType_Definition_Eval_Homoglyphs{}
+	mi := &file_minder_v1_minder_proto_msgTypes[286]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Eval) GetVulncheck() *RuleType_Definition_Eval_Vulncheck {
-	if x != nil {
-		return x.Vulncheck
-	}
-	return nil
+func (x *RuleType_Definition_Eval_Homoglyphs) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RuleType_Definition_Eval) GetTrusty() *RuleType_Definition_Eval_Trusty {
+func (*RuleType_Definition_Eval_Homoglyphs) ProtoMessage() {}
+
+func (x *RuleType_Definition_Eval_Homoglyphs) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[286]
 	if x != nil {
-		return x.Trusty
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *RuleType_Definition_Eval) GetHomoglyphs() *RuleType_Definition_Eval_Homoglyphs {
-	if x != nil {
-		return x.Homoglyphs
-	}
-	return nil
+// Deprecated: Use RuleType_Definition_Eval_Homoglyphs.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Eval_Homoglyphs) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0, 1, 4}
 }
 
-func (x *RuleType_Definition_Eval) GetDataSources() []*DataSourceReference {
+func (x *RuleType_Definition_Eval_Homoglyphs) GetType() string {
 	if x != nil {
-		return x.DataSources
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-type RuleType_Definition_Remediate struct {
-	state              protoimpl.MessageState                                `protogen:"open.v1"`
-	Type               string                                                `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Rest               *RestType                                             `protobuf:"bytes,2,opt,name=rest,proto3,oneof" json:"rest,omitempty"`
-	GhBranchProtection *RuleType_Definition_Remediate_GhBranchProtectionType `protobuf:"bytes,3,opt,name=gh_branch_protection,json=ghBranchProtection,proto3,oneof" json:"gh_branch_protection,omitempty"`
-	PullRequest        *RuleType_Definition_Remediate_PullRequestRemediation `protobuf:"bytes,4,opt,name=pull_request,json=pullRequest,proto3,oneof" json:"pull_request,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type RuleType_Definition_Eval_JQComparison_Operator struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Def           string                 `protobuf:"bytes,1,opt,name=def,proto3" json:"def,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Remediate) Reset() {
-	*x = RuleType_Definition_Remediate{}
-	mi := &file_minder_v1_minder_proto_msgTypes[216]
+func (x *RuleType_Definition_Eval_JQComparison_Operator) Reset() {
+	*x = RuleType_Definition_Eval_JQComparison_Operator{}
+	mi := &file_minder_v1_minder_proto_msgTypes[287]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Remediate) String() string {
+func (x *RuleType_Definition_Eval_JQComparison_Operator) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Remediate) ProtoMessage() {}
+func (*RuleType_Definition_Eval_JQComparison_Operator) ProtoMessage() {}
 
-func (x *RuleType_Definition_Remediate) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[216]
+func (x *RuleType_Definition_Eval_JQComparison_Operator) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[287]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13473,63 +17958,40 @@ func (x *RuleType_Definition_Remediate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Remediate.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Remediate) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126, 0, 2}
+// Deprecated: Use RuleType_Definition_Eval_JQComparison_Operator.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Eval_JQComparison_Operator) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0, 1, 0, 0}
 }
 
-func (x *RuleType_Definition_Remediate) GetType() string {
+func (x *RuleType_Definition_Eval_JQComparison_Operator) GetDef() string {
 	if x != nil {
-		return x.Type
+		return x.Def
 	}
 	return ""
 }
 
-func (x *RuleType_Definition_Remediate) GetRest() *RestType {
-	if x != nil {
-		return x.Rest
-	}
-	return nil
-}
-
-func (x *RuleType_Definition_Remediate) GetGhBranchProtection() *RuleType_Definition_Remediate_GhBranchProtectionType {
-	if x != nil {
-		return x.GhBranchProtection
-	}
-	return nil
-}
-
-func (x *RuleType_Definition_Remediate) GetPullRequest() *RuleType_Definition_Remediate_PullRequestRemediation {
-	if x != nil {
-		return x.PullRequest
-	}
-	return nil
-}
-
-type RuleType_Definition_Alert struct {
-	state              protoimpl.MessageState                        `protogen:"open.v1"`
-	Type               string                                        `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	SecurityAdvisory   *RuleType_Definition_Alert_AlertTypeSA        `protobuf:"bytes,2,opt,name=security_advisory,json=securityAdvisory,proto3,oneof" json:"security_advisory,omitempty"`
-	PullRequestComment *RuleType_Definition_Alert_AlertTypePRComment `protobuf:"bytes,3,opt,name=pull_request_comment,json=pullRequestComment,proto3,oneof" json:"pull_request_comment,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type RuleType_Definition_Remediate_GhBranchProtectionType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Patch         string                 `protobuf:"bytes,1,opt,name=patch,proto3" json:"patch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Alert) Reset() {
-	*x = RuleType_Definition_Alert{}
-	mi := &file_minder_v1_minder_proto_msgTypes[217]
+func (x *RuleType_Definition_Remediate_GhBranchProtectionType) Reset() {
+	*x = RuleType_Definition_Remediate_GhBranchProtectionType{}
+	mi := &file_minder_v1_minder_proto_msgTypes[288]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Alert) String() string {
+func (x *RuleType_Definition_Remediate_GhBranchProtectionType) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Alert) ProtoMessage() {}
+func (*RuleType_Definition_Remediate_GhBranchProtectionType) ProtoMessage() {}
 
-func (x *RuleType_Definition_Alert) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[217]
+func (x *RuleType_Definition_Remediate_GhBranchProtectionType) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[288]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13540,61 +18002,48 @@ func (x *RuleType_Definition_Alert) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Alert.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Alert) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126, 0, 3}
+// Deprecated: Use RuleType_Definition_Remediate_GhBranchProtectionType.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Remediate_GhBranchProtectionType) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0, 2, 0}
 }
 
-func (x *RuleType_Definition_Alert) GetType() string {
+func (x *RuleType_Definition_Remediate_GhBranchProtectionType) GetPatch() string {
 	if x != nil {
-		return x.Type
+		return x.Patch
 	}
 	return ""
 }
 
-func (x *RuleType_Definition_Alert) GetSecurityAdvisory() *RuleType_Definition_Alert_AlertTypeSA {
-	if x != nil {
-		return x.SecurityAdvisory
-	}
-	return nil
-}
-
-func (x *RuleType_Definition_Alert) GetPullRequestComment() *RuleType_Definition_Alert_AlertTypePRComment {
-	if x != nil {
-		return x.PullRequestComment
-	}
-	return nil
-}
-
-type RuleType_Definition_Eval_JQComparison struct {
+type RuleType_Definition_Remediate_GhRulesetType struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Ingested points to the data retrieved in the `ingest` section
-	Ingested *RuleType_Definition_Eval_JQComparison_Operator `protobuf:"bytes,1,opt,name=ingested,proto3" json:"ingested,omitempty"`
-	// Profile points to the profile itself.
-	// This is mutually exclusive with the `constant` field.
-	Profile *RuleType_Definition_Eval_JQComparison_Operator `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
-	// Constant points to a constant value.
-	// This is mutually exclusive with the `profile` field.
-	Constant      *structpb.Value `protobuf:"bytes,3,opt,name=constant,proto3" json:"constant,omitempty"`
+	// the name of the repository ruleset to create or update
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the patch to apply to the ruleset. The patch is a template
+	// evaluated against the entity, profile and parameters.
+	Patch string `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+	// how to apply the patch. For now, these are supported:
+	// -- merge - the patch is a JSON merge patch (RFC 7396), this is the default
+	// -- json - the patch is a JSON patch (RFC 6902)
+	PatchType     string `protobuf:"bytes,3,opt,name=patch_type,json=patchType,proto3" json:"patch_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Eval_JQComparison) Reset() {
-	*x = RuleType_Definition_Eval_JQComparison{}
-	mi := &file_minder_v1_minder_proto_msgTypes[218]
+func (x *RuleType_Definition_Remediate_GhRulesetType) Reset() {
+	*x = RuleType_Definition_Remediate_GhRulesetType{}
+	mi := &file_minder_v1_minder_proto_msgTypes[289]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Eval_JQComparison) String() string {
+func (x *RuleType_Definition_Remediate_GhRulesetType) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Eval_JQComparison) ProtoMessage() {}
+func (*RuleType_Definition_Remediate_GhRulesetType) ProtoMessage() {}
 
-func (x *RuleType_Definition_Eval_JQComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[218]
+func (x *RuleType_Definition_Remediate_GhRulesetType) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[289]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13605,71 +18054,80 @@ func (x *RuleType_Definition_Eval_JQComparison) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Eval_JQComparison.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Eval_JQComparison) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126, 0, 1, 0}
+// Deprecated: Use RuleType_Definition_Remediate_GhRulesetType.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Remediate_GhRulesetType) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0, 2, 1}
 }
 
-func (x *RuleType_Definition_Eval_JQComparison) GetIngested() *RuleType_Definition_Eval_JQComparison_Operator {
+func (x *RuleType_Definition_Remediate_GhRulesetType) GetName() string {
 	if x != nil {
-		return x.Ingested
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *RuleType_Definition_Eval_JQComparison) GetProfile() *RuleType_Definition_Eval_JQComparison_Operator {
+func (x *RuleType_Definition_Remediate_GhRulesetType) GetPatch() string {
 	if x != nil {
-		return x.Profile
+		return x.Patch
 	}
-	return nil
+	return ""
 }
 
-func (x *RuleType_Definition_Eval_JQComparison) GetConstant() *structpb.Value {
+func (x *RuleType_Definition_Remediate_GhRulesetType) GetPatchType() string {
 	if x != nil {
-		return x.Constant
+		return x.PatchType
 	}
-	return nil
+	return ""
 }
 
-type RuleType_Definition_Eval_Rego struct {
+// the name stutters a bit but we already use a PullRequest message for handling PR entities
+type RuleType_Definition_Remediate_PullRequestRemediation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// type is the type of evaluation engine to use
-	// for rego. We currently have two modes of operation:
-	//   - deny-by-default: this is the default mode of operation
-	//     where we deny access by default and allow access only
-	//     if the profile explicitly allows it. It expects the
-	//     profile to set an `allow` variable to true or false.
-	//   - constraints: this is the mode of operation where we
-	//     allow access by default and deny access only if a
-	//     violation is found. It expects the profile to set a
-	//     `violations` variable with a "msg" field.
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	// def is the definition of the rego profile.
-	Def string `protobuf:"bytes,2,opt,name=def,proto3" json:"def,omitempty"`
-	// how are violations reported. This is only used if the
-	// `constraints` type is selected. The default is `text`
-	// which returns human-readable text. The other option is
-	// `json` which returns a JSON array containing the violations.
-	ViolationFormat *string `protobuf:"bytes,3,opt,name=violation_format,json=violationFormat,proto3,oneof" json:"violation_format,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// the title of the PR
+	// This is not validated here as it will be validated by the repository provider, i.e. GitHub upon
+	// creation of the PR.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the body of the PR
+	// This is not validated here as it will be validated by the repository provider, i.e. GitHub upon
+	// creation of the PR.
+	Body     string                                                          `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Contents []*RuleType_Definition_Remediate_PullRequestRemediation_Content `protobuf:"bytes,3,rep,name=contents,proto3" json:"contents,omitempty"`
+	// the method to use to create the PR. For now, these are supported:
+	// -- minder.content - ensures that the content of the file is exactly as specified
+	//
+	//	refer to the Content message for more details
+	//
+	// -- minder.actions.replace_tags_with_sha - finds any github actions within a workflow
+	//
+	//	file and replaces the tag with the SHA
+	//
+	// -- minder.yq.evaluate - evaluates a yq expression on a file
+	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	// params are unstructured parameters passed to the method. These are optional
+	// and evaluated by the method.
+	Params *structpb.Struct `protobuf:"bytes,6,opt,name=params,proto3" json:"params,omitempty"`
+	// If the method is minder.actions.replace_tags_with_sha, this is the configuration
+	// for that method
+	ActionsReplaceTagsWithSha *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha `protobuf:"bytes,5,opt,name=actions_replace_tags_with_sha,json=actionsReplaceTagsWithSha,proto3,oneof" json:"actions_replace_tags_with_sha,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Eval_Rego) Reset() {
-	*x = RuleType_Definition_Eval_Rego{}
-	mi := &file_minder_v1_minder_proto_msgTypes[219]
+func (x *RuleType_Definition_Remediate_PullRequestRemediation) Reset() {
+	*x = RuleType_Definition_Remediate_PullRequestRemediation{}
+	mi := &file_minder_v1_minder_proto_msgTypes[290]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Eval_Rego) String() string {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Eval_Rego) ProtoMessage() {}
+func (*RuleType_Definition_Remediate_PullRequestRemediation) ProtoMessage() {}
 
-func (x *RuleType_Definition_Eval_Rego) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[219]
+func (x *RuleType_Definition_Remediate_PullRequestRemediation) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[290]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13680,92 +18138,85 @@ func (x *RuleType_Definition_Eval_Rego) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Eval_Rego.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Eval_Rego) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126, 0, 1, 1}
+// Deprecated: Use RuleType_Definition_Remediate_PullRequestRemediation.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Remediate_PullRequestRemediation) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0, 2, 2}
 }
 
-func (x *RuleType_Definition_Eval_Rego) GetType() string {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetTitle() string {
 	if x != nil {
-		return x.Type
+		return x.Title
 	}
 	return ""
 }
 
-func (x *RuleType_Definition_Eval_Rego) GetDef() string {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetBody() string {
 	if x != nil {
-		return x.Def
+		return x.Body
 	}
 	return ""
 }
 
-func (x *RuleType_Definition_Eval_Rego) GetViolationFormat() string {
-	if x != nil && x.ViolationFormat != nil {
-		return *x.ViolationFormat
+func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetContents() []*RuleType_Definition_Remediate_PullRequestRemediation_Content {
+	if x != nil {
+		return x.Contents
 	}
-	return ""
-}
-
-type RuleType_Definition_Eval_Vulncheck struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RuleType_Definition_Eval_Vulncheck) Reset() {
-	*x = RuleType_Definition_Eval_Vulncheck{}
-	mi := &file_minder_v1_minder_proto_msgTypes[220]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return nil
 }
 
-func (x *RuleType_Definition_Eval_Vulncheck) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
 }
 
-func (*RuleType_Definition_Eval_Vulncheck) ProtoMessage() {}
-
-func (x *RuleType_Definition_Eval_Vulncheck) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[220]
+func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetParams() *structpb.Struct {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Params
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use RuleType_Definition_Eval_Vulncheck.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Eval_Vulncheck) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126, 0, 1, 2}
+func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetActionsReplaceTagsWithSha() *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha {
+	if x != nil {
+		return x.ActionsReplaceTagsWithSha
+	}
+	return nil
 }
 
-type RuleType_Definition_Eval_Trusty struct {
+type RuleType_Definition_Remediate_PullRequestRemediation_Content struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// This is no longer used, but is still here for backwards
-	// compatibility with existing stored rules
-	Endpoint      string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// the file to patch
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// how to patch the file. For now, only replace is supported
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	// the content of the file
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// the GIT mode of the file. Not UNIX mode! String because the GH API also uses strings
+	// the usual modes are: 100644 for regular files, 100755 for executable files and
+	// 040000 for submodules (which we don't use but now you know the meaning of the 1 in 100644)
+	// see e.g. https://github.com/go-git/go-git/blob/32e0172851c35ae2fac495069c923330040903d2/plumbing/filemode/filemode.go#L16
+	Mode          *string `protobuf:"bytes,3,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Eval_Trusty) Reset() {
-	*x = RuleType_Definition_Eval_Trusty{}
-	mi := &file_minder_v1_minder_proto_msgTypes[221]
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) Reset() {
+	*x = RuleType_Definition_Remediate_PullRequestRemediation_Content{}
+	mi := &file_minder_v1_minder_proto_msgTypes[291]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Eval_Trusty) String() string {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Eval_Trusty) ProtoMessage() {}
+func (*RuleType_Definition_Remediate_PullRequestRemediation_Content) ProtoMessage() {}
 
-func (x *RuleType_Definition_Eval_Trusty) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[221]
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[291]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13776,40 +18227,63 @@ func (x *RuleType_Definition_Eval_Trusty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Eval_Trusty.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Eval_Trusty) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126, 0, 1, 3}
+// Deprecated: Use RuleType_Definition_Remediate_PullRequestRemediation_Content.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Remediate_PullRequestRemediation_Content) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0, 2, 2, 0}
 }
 
-func (x *RuleType_Definition_Eval_Trusty) GetEndpoint() string {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) GetPath() string {
 	if x != nil {
-		return x.Endpoint
+		return x.Path
 	}
 	return ""
 }
 
-type RuleType_Definition_Eval_Homoglyphs struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) GetMode() string {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return ""
+}
+
+type RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of actions to exclude from the replacement
+	Exclude       []string `protobuf:"bytes,1,rep,name=exclude,proto3" json:"exclude,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Eval_Homoglyphs) Reset() {
-	*x = RuleType_Definition_Eval_Homoglyphs{}
-	mi := &file_minder_v1_minder_proto_msgTypes[222]
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) Reset() {
+	*x = RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha{}
+	mi := &file_minder_v1_minder_proto_msgTypes[292]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Eval_Homoglyphs) String() string {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Eval_Homoglyphs) ProtoMessage() {}
+func (*RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) ProtoMessage() {
+}
 
-func (x *RuleType_Definition_Eval_Homoglyphs) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[222]
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[292]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13820,40 +18294,40 @@ func (x *RuleType_Definition_Eval_Homoglyphs) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Eval_Homoglyphs.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Eval_Homoglyphs) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126, 0, 1, 4}
+// Deprecated: Use RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0, 2, 2, 1}
 }
 
-func (x *RuleType_Definition_Eval_Homoglyphs) GetType() string {
+func (x *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) GetExclude() []string {
 	if x != nil {
-		return x.Type
+		return x.Exclude
 	}
-	return ""
+	return nil
 }
 
-type RuleType_Definition_Eval_JQComparison_Operator struct {
+type RuleType_Definition_Alert_AlertTypeSA struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Def           string                 `protobuf:"bytes,1,opt,name=def,proto3" json:"def,omitempty"`
+	Severity      string                 `protobuf:"bytes,1,opt,name=severity,proto3" json:"severity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Eval_JQComparison_Operator) Reset() {
-	*x = RuleType_Definition_Eval_JQComparison_Operator{}
-	mi := &file_minder_v1_minder_proto_msgTypes[223]
+func (x *RuleType_Definition_Alert_AlertTypeSA) Reset() {
+	*x = RuleType_Definition_Alert_AlertTypeSA{}
+	mi := &file_minder_v1_minder_proto_msgTypes[293]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Eval_JQComparison_Operator) String() string {
+func (x *RuleType_Definition_Alert_AlertTypeSA) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Eval_JQComparison_Operator) ProtoMessage() {}
+func (*RuleType_Definition_Alert_AlertTypeSA) ProtoMessage() {}
 
-func (x *RuleType_Definition_Eval_JQComparison_Operator) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[223]
+func (x *RuleType_Definition_Alert_AlertTypeSA) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[293]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13864,40 +18338,40 @@ func (x *RuleType_Definition_Eval_JQComparison_Operator) ProtoReflect() protoref
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Eval_JQComparison_Operator.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Eval_JQComparison_Operator) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126, 0, 1, 0, 0}
+// Deprecated: Use RuleType_Definition_Alert_AlertTypeSA.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Alert_AlertTypeSA) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0, 3, 0}
 }
 
-func (x *RuleType_Definition_Eval_JQComparison_Operator) GetDef() string {
+func (x *RuleType_Definition_Alert_AlertTypeSA) GetSeverity() string {
 	if x != nil {
-		return x.Def
+		return x.Severity
 	}
 	return ""
 }
 
-type RuleType_Definition_Remediate_GhBranchProtectionType struct {
+type RuleType_Definition_Alert_AlertTypePRComment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Patch         string                 `protobuf:"bytes,1,opt,name=patch,proto3" json:"patch,omitempty"`
+	ReviewMessage string                 `protobuf:"bytes,1,opt,name=review_message,json=reviewMessage,proto3" json:"review_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Remediate_GhBranchProtectionType) Reset() {
-	*x = RuleType_Definition_Remediate_GhBranchProtectionType{}
-	mi := &file_minder_v1_minder_proto_msgTypes[224]
+func (x *RuleType_Definition_Alert_AlertTypePRComment) Reset() {
+	*x = RuleType_Definition_Alert_AlertTypePRComment{}
+	mi := &file_minder_v1_minder_proto_msgTypes[294]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Remediate_GhBranchProtectionType) String() string {
+func (x *RuleType_Definition_Alert_AlertTypePRComment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Remediate_GhBranchProtectionType) ProtoMessage() {}
+func (*RuleType_Definition_Alert_AlertTypePRComment) ProtoMessage() {}
 
-func (x *RuleType_Definition_Remediate_GhBranchProtectionType) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[224]
+func (x *RuleType_Definition_Alert_AlertTypePRComment) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[294]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13908,66 +18382,50 @@ func (x *RuleType_Definition_Remediate_GhBranchProtectionType) ProtoReflect() pr
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Remediate_GhBranchProtectionType.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Remediate_GhBranchProtectionType) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126, 0, 2, 0}
-}
-
-func (x *RuleType_Definition_Remediate_GhBranchProtectionType) GetPatch() string {
-	if x != nil {
-		return x.Patch
-	}
-	return ""
-}
-
-// the name stutters a bit but we already use a PullRequest message for handling PR entities
-type RuleType_Definition_Remediate_PullRequestRemediation struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// the title of the PR
-	// This is not validated here as it will be validated by the repository provider, i.e. GitHub upon
-	// creation of the PR.
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	// the body of the PR
-	// This is not validated here as it will be validated by the repository provider, i.e. GitHub upon
-	// creation of the PR.
-	Body     string                                                          `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
-	Contents []*RuleType_Definition_Remediate_PullRequestRemediation_Content `protobuf:"bytes,3,rep,name=contents,proto3" json:"contents,omitempty"`
-	// the method to use to create the PR. For now, these are supported:
-	// -- minder.content - ensures that the content of the file is exactly as specified
-	//
-	//	refer to the Content message for more details
-	//
-	// -- minder.actions.replace_tags_with_sha - finds any github actions within a workflow
-	//
-	//	file and replaces the tag with the SHA
-	//
-	// -- minder.yq.evaluate - evaluates a yq expression on a file
-	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
-	// params are unstructured parameters passed to the method. These are optional
-	// and evaluated by the method.
-	Params *structpb.Struct `protobuf:"bytes,6,opt,name=params,proto3" json:"params,omitempty"`
-	// If the method is minder.actions.replace_tags_with_sha, this is the configuration
-	// for that method
-	ActionsReplaceTagsWithSha *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha `protobuf:"bytes,5,opt,name=actions_replace_tags_with_sha,json=actionsReplaceTagsWithSha,proto3,oneof" json:"actions_replace_tags_with_sha,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+// Deprecated: Use RuleType_Definition_Alert_AlertTypePRComment.ProtoReflect.Descriptor instead.
+func (*RuleType_Definition_Alert_AlertTypePRComment) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{170, 0, 3, 1}
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation) Reset() {
-	*x = RuleType_Definition_Remediate_PullRequestRemediation{}
-	mi := &file_minder_v1_minder_proto_msgTypes[225]
+func (x *RuleType_Definition_Alert_AlertTypePRComment) GetReviewMessage() string {
+	if x != nil {
+		return x.ReviewMessage
+	}
+	return ""
+}
+
+// Rule defines the individual call of a certain rule type.
+type Profile_Rule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type is the type of the rule to be instantiated.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// params are the parameters that are passed to the rule.
+	// This is optional and depends on the rule type.
+	Params *structpb.Struct `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	// def is the definition of the rule.
+	// This depends on the rule type.
+	Def *structpb.Struct `protobuf:"bytes,3,opt,name=def,proto3" json:"def,omitempty"`
+	// name is the descriptive name of the rule, not to be confused with type
+	Name          string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile_Rule) Reset() {
+	*x = Profile_Rule{}
+	mi := &file_minder_v1_minder_proto_msgTypes[295]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation) String() string {
+func (x *Profile_Rule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Remediate_PullRequestRemediation) ProtoMessage() {}
+func (*Profile_Rule) ProtoMessage() {}
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[225]
+func (x *Profile_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[295]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13978,85 +18436,68 @@ func (x *RuleType_Definition_Remediate_PullRequestRemediation) ProtoReflect() pr
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Remediate_PullRequestRemediation.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Remediate_PullRequestRemediation) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126, 0, 2, 1}
-}
-
-func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use Profile_Rule.ProtoReflect.Descriptor instead.
+func (*Profile_Rule) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{171, 0}
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetBody() string {
+func (x *Profile_Rule) GetType() string {
 	if x != nil {
-		return x.Body
+		return x.Type
 	}
 	return ""
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetContents() []*RuleType_Definition_Remediate_PullRequestRemediation_Content {
+func (x *Profile_Rule) GetParams() *structpb.Struct {
 	if x != nil {
-		return x.Contents
+		return x.Params
 	}
 	return nil
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetMethod() string {
-	if x != nil {
-		return x.Method
-	}
-	return ""
-}
-
-func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetParams() *structpb.Struct {
+func (x *Profile_Rule) GetDef() *structpb.Struct {
 	if x != nil {
-		return x.Params
+		return x.Def
 	}
 	return nil
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation) GetActionsReplaceTagsWithSha() *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha {
+func (x *Profile_Rule) GetName() string {
 	if x != nil {
-		return x.ActionsReplaceTagsWithSha
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type RuleType_Definition_Remediate_PullRequestRemediation_Content struct {
+type Profile_Selector struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// the file to patch
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	// how to patch the file. For now, only replace is supported
-	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
-	// the content of the file
-	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	// the GIT mode of the file. Not UNIX mode! String because the GH API also uses strings
-	// the usual modes are: 100644 for regular files, 100755 for executable files and
-	// 040000 for submodules (which we don't use but now you know the meaning of the 1 in 100644)
-	// see e.g. https://github.com/go-git/go-git/blob/32e0172851c35ae2fac495069c923330040903d2/plumbing/filemode/filemode.go#L16
-	Mode          *string `protobuf:"bytes,3,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
+	// id is optional and use for updates to match upserts as well as read operations. It is ignored for creates.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// entity is the entity to select.
+	Entity string `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	// expr is the expression to select the entity.
+	Selector string `protobuf:"bytes,4,opt,name=selector,proto3" json:"selector,omitempty"`
+	// description is the human-readable description of the selector.
+	Description   string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) Reset() {
-	*x = RuleType_Definition_Remediate_PullRequestRemediation_Content{}
-	mi := &file_minder_v1_minder_proto_msgTypes[226]
+func (x *Profile_Selector) Reset() {
+	*x = Profile_Selector{}
+	mi := &file_minder_v1_minder_proto_msgTypes[296]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) String() string {
+func (x *Profile_Selector) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Remediate_PullRequestRemediation_Content) ProtoMessage() {}
+func (*Profile_Selector) ProtoMessage() {}
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[226]
+func (x *Profile_Selector) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[296]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14067,63 +18508,67 @@ func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) ProtoRefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Remediate_PullRequestRemediation_Content.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Remediate_PullRequestRemediation_Content) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126, 0, 2, 1, 0}
+// Deprecated: Use Profile_Selector.ProtoReflect.Descriptor instead.
+func (*Profile_Selector) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{171, 1}
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) GetPath() string {
+func (x *Profile_Selector) GetId() string {
 	if x != nil {
-		return x.Path
+		return x.Id
 	}
 	return ""
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) GetAction() string {
+func (x *Profile_Selector) GetEntity() string {
 	if x != nil {
-		return x.Action
+		return x.Entity
 	}
 	return ""
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) GetContent() string {
+func (x *Profile_Selector) GetSelector() string {
 	if x != nil {
-		return x.Content
+		return x.Selector
 	}
 	return ""
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_Content) GetMode() string {
-	if x != nil && x.Mode != nil {
-		return *x.Mode
+func (x *Profile_Selector) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-type RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha struct {
+// Rule is a rule of the policy that was evaluated
+type EvaluationExplanation_Rule struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of actions to exclude from the replacement
-	Exclude       []string `protobuf:"bytes,1,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	// name is the name of the rule, e.g. deny or allow
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// location is the location of the rule in the policy
+	Location string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	// matched is true if the body of the rule was satisfied at least once
+	Matched       bool `protobuf:"varint,3,opt,name=matched,proto3" json:"matched,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) Reset() {
-	*x = RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha{}
-	mi := &file_minder_v1_minder_proto_msgTypes[227]
+func (x *EvaluationExplanation_Rule) Reset() {
+	*x = EvaluationExplanation_Rule{}
+	mi := &file_minder_v1_minder_proto_msgTypes[297]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) String() string {
+func (x *EvaluationExplanation_Rule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) ProtoMessage() {
-}
+func (*EvaluationExplanation_Rule) ProtoMessage() {}
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[227]
+func (x *EvaluationExplanation_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[297]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14134,40 +18579,70 @@ func (x *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTags
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126, 0, 2, 1, 1}
+// Deprecated: Use EvaluationExplanation_Rule.ProtoReflect.Descriptor instead.
+func (*EvaluationExplanation_Rule) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{230, 0}
 }
 
-func (x *RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha) GetExclude() []string {
+func (x *EvaluationExplanation_Rule) GetName() string {
 	if x != nil {
-		return x.Exclude
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type RuleType_Definition_Alert_AlertTypeSA struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Severity      string                 `protobuf:"bytes,1,opt,name=severity,proto3" json:"severity,omitempty"`
+func (x *EvaluationExplanation_Rule) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *EvaluationExplanation_Rule) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+// Counts are the numbers of evaluations by outcome
+type ComplianceReport_Counts struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Total   int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Success int64                  `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Failure int64                  `protobuf:"varint,3,opt,name=failure,proto3" json:"failure,omitempty"`
+	Error   int64                  `protobuf:"varint,4,opt,name=error,proto3" json:"error,omitempty"`
+	Skipped int64                  `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Pending int64                  `protobuf:"varint,6,opt,name=pending,proto3" json:"pending,omitempty"`
+	// alerts_on is the number of evaluations with an open alert
+	AlertsOn int64 `protobuf:"varint,7,opt,name=alerts_on,json=alertsOn,proto3" json:"alerts_on,omitempty"`
+	// remediations_success is the number of evaluations which were
+	// remediated successfully
+	RemediationsSuccess int64 `protobuf:"varint,8,opt,name=remediations_success,json=remediationsSuccess,proto3" json:"remediations_success,omitempty"`
+	// remediations_failed is the number of evaluations whose
+	// remediation failed
+	RemediationsFailed int64 `protobuf:"varint,9,opt,name=remediations_failed,json=remediationsFailed,proto3" json:"remediations_failed,omitempty"`
+	// waived is the number of failed evaluations covered by a waiver
+	Waived        int64 `protobuf:"varint,10,opt,name=waived,proto3" json:"waived,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Alert_AlertTypeSA) Reset() {
-	*x = RuleType_Definition_Alert_AlertTypeSA{}
-	mi := &file_minder_v1_minder_proto_msgTypes[228]
+func (x *ComplianceReport_Counts) Reset() {
+	*x = ComplianceReport_Counts{}
+	mi := &file_minder_v1_minder_proto_msgTypes[298]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Alert_AlertTypeSA) String() string {
+func (x *ComplianceReport_Counts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Alert_AlertTypeSA) ProtoMessage() {}
+func (*ComplianceReport_Counts) ProtoMessage() {}
 
-func (x *RuleType_Definition_Alert_AlertTypeSA) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[228]
+func (x *ComplianceReport_Counts) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[298]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14178,40 +18653,107 @@ func (x *RuleType_Definition_Alert_AlertTypeSA) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Alert_AlertTypeSA.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Alert_AlertTypeSA) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126, 0, 3, 0}
+// Deprecated: Use ComplianceReport_Counts.ProtoReflect.Descriptor instead.
+func (*ComplianceReport_Counts) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{233, 0}
 }
 
-func (x *RuleType_Definition_Alert_AlertTypeSA) GetSeverity() string {
+func (x *ComplianceReport_Counts) GetTotal() int64 {
 	if x != nil {
-		return x.Severity
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-type RuleType_Definition_Alert_AlertTypePRComment struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReviewMessage string                 `protobuf:"bytes,1,opt,name=review_message,json=reviewMessage,proto3" json:"review_message,omitempty"`
+func (x *ComplianceReport_Counts) GetSuccess() int64 {
+	if x != nil {
+		return x.Success
+	}
+	return 0
+}
+
+func (x *ComplianceReport_Counts) GetFailure() int64 {
+	if x != nil {
+		return x.Failure
+	}
+	return 0
+}
+
+func (x *ComplianceReport_Counts) GetError() int64 {
+	if x != nil {
+		return x.Error
+	}
+	return 0
+}
+
+func (x *ComplianceReport_Counts) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ComplianceReport_Counts) GetPending() int64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *ComplianceReport_Counts) GetAlertsOn() int64 {
+	if x != nil {
+		return x.AlertsOn
+	}
+	return 0
+}
+
+func (x *ComplianceReport_Counts) GetRemediationsSuccess() int64 {
+	if x != nil {
+		return x.RemediationsSuccess
+	}
+	return 0
+}
+
+func (x *ComplianceReport_Counts) GetRemediationsFailed() int64 {
+	if x != nil {
+		return x.RemediationsFailed
+	}
+	return 0
+}
+
+func (x *ComplianceReport_Counts) GetWaived() int64 {
+	if x != nil {
+		return x.Waived
+	}
+	return 0
+}
+
+// Group is an aggregation of the latest evaluation results
+type ComplianceReport_Group struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the profile, rule type, severity, entity or
+	// project the results are aggregated by
+	Name          string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Counts        *ComplianceReport_Counts `protobuf:"bytes,2,opt,name=counts,proto3" json:"counts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleType_Definition_Alert_AlertTypePRComment) Reset() {
-	*x = RuleType_Definition_Alert_AlertTypePRComment{}
-	mi := &file_minder_v1_minder_proto_msgTypes[229]
+func (x *ComplianceReport_Group) Reset() {
+	*x = ComplianceReport_Group{}
+	mi := &file_minder_v1_minder_proto_msgTypes[299]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleType_Definition_Alert_AlertTypePRComment) String() string {
+func (x *ComplianceReport_Group) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleType_Definition_Alert_AlertTypePRComment) ProtoMessage() {}
+func (*ComplianceReport_Group) ProtoMessage() {}
 
-func (x *RuleType_Definition_Alert_AlertTypePRComment) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[229]
+func (x *ComplianceReport_Group) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[299]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14222,50 +18764,59 @@ func (x *RuleType_Definition_Alert_AlertTypePRComment) ProtoReflect() protorefle
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleType_Definition_Alert_AlertTypePRComment.ProtoReflect.Descriptor instead.
-func (*RuleType_Definition_Alert_AlertTypePRComment) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{126, 0, 3, 1}
+// Deprecated: Use ComplianceReport_Group.ProtoReflect.Descriptor instead.
+func (*ComplianceReport_Group) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{233, 1}
 }
 
-func (x *RuleType_Definition_Alert_AlertTypePRComment) GetReviewMessage() string {
+func (x *ComplianceReport_Group) GetName() string {
 	if x != nil {
-		return x.ReviewMessage
+		return x.Name
 	}
 	return ""
 }
 
-// Rule defines the individual call of a certain rule type.
-type Profile_Rule struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// type is the type of the rule to be instantiated.
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	// params are the parameters that are passed to the rule.
-	// This is optional and depends on the rule type.
-	Params *structpb.Struct `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
-	// def is the definition of the rule.
-	// This depends on the rule type.
-	Def *structpb.Struct `protobuf:"bytes,3,opt,name=def,proto3" json:"def,omitempty"`
-	// name is the descriptive name of the rule, not to be confused with type
-	Name          string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ComplianceReport_Group) GetCounts() *ComplianceReport_Counts {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+// Finding is the latest evaluation result of a rule against an entity
+type ComplianceReport_Finding struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Project           string                 `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Profile           string                 `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	RuleType          string                 `protobuf:"bytes,3,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	RuleName          string                 `protobuf:"bytes,4,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	Severity          string                 `protobuf:"bytes,5,opt,name=severity,proto3" json:"severity,omitempty"`
+	EntityType        string                 `protobuf:"bytes,6,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityName        string                 `protobuf:"bytes,7,opt,name=entity_name,json=entityName,proto3" json:"entity_name,omitempty"`
+	Status            string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Details           string                 `protobuf:"bytes,9,opt,name=details,proto3" json:"details,omitempty"`
+	RemediationStatus string                 `protobuf:"bytes,10,opt,name=remediation_status,json=remediationStatus,proto3" json:"remediation_status,omitempty"`
+	AlertStatus       string                 `protobuf:"bytes,11,opt,name=alert_status,json=alertStatus,proto3" json:"alert_status,omitempty"`
+	LastUpdated       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Profile_Rule) Reset() {
-	*x = Profile_Rule{}
-	mi := &file_minder_v1_minder_proto_msgTypes[230]
+func (x *ComplianceReport_Finding) Reset() {
+	*x = ComplianceReport_Finding{}
+	mi := &file_minder_v1_minder_proto_msgTypes[300]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Profile_Rule) String() string {
+func (x *ComplianceReport_Finding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Profile_Rule) ProtoMessage() {}
+func (*ComplianceReport_Finding) ProtoMessage() {}
 
-func (x *Profile_Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[230]
+func (x *ComplianceReport_Finding) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[300]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14276,68 +18827,119 @@ func (x *Profile_Rule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Profile_Rule.ProtoReflect.Descriptor instead.
-func (*Profile_Rule) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{127, 0}
+// Deprecated: Use ComplianceReport_Finding.ProtoReflect.Descriptor instead.
+func (*ComplianceReport_Finding) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{233, 2}
 }
 
-func (x *Profile_Rule) GetType() string {
+func (x *ComplianceReport_Finding) GetProject() string {
 	if x != nil {
-		return x.Type
+		return x.Project
 	}
 	return ""
 }
 
-func (x *Profile_Rule) GetParams() *structpb.Struct {
+func (x *ComplianceReport_Finding) GetProfile() string {
 	if x != nil {
-		return x.Params
+		return x.Profile
 	}
-	return nil
+	return ""
 }
 
-func (x *Profile_Rule) GetDef() *structpb.Struct {
+func (x *ComplianceReport_Finding) GetRuleType() string {
 	if x != nil {
-		return x.Def
+		return x.RuleType
 	}
-	return nil
+	return ""
 }
 
-func (x *Profile_Rule) GetName() string {
+func (x *ComplianceReport_Finding) GetRuleName() string {
 	if x != nil {
-		return x.Name
+		return x.RuleName
 	}
 	return ""
 }
 
-type Profile_Selector struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// id is optional and use for updates to match upserts as well as read operations. It is ignored for creates.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// entity is the entity to select.
-	Entity string `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
-	// expr is the expression to select the entity.
-	Selector string `protobuf:"bytes,4,opt,name=selector,proto3" json:"selector,omitempty"`
-	// description is the human-readable description of the selector.
-	Description   string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+func (x *ComplianceReport_Finding) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *ComplianceReport_Finding) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *ComplianceReport_Finding) GetEntityName() string {
+	if x != nil {
+		return x.EntityName
+	}
+	return ""
+}
+
+func (x *ComplianceReport_Finding) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ComplianceReport_Finding) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *ComplianceReport_Finding) GetRemediationStatus() string {
+	if x != nil {
+		return x.RemediationStatus
+	}
+	return ""
+}
+
+func (x *ComplianceReport_Finding) GetAlertStatus() string {
+	if x != nil {
+		return x.AlertStatus
+	}
+	return ""
+}
+
+func (x *ComplianceReport_Finding) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+// TrendPoint aggregates the evaluation history of a day
+type ComplianceReport_TrendPoint struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Day           *timestamppb.Timestamp   `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	Counts        *ComplianceReport_Counts `protobuf:"bytes,2,opt,name=counts,proto3" json:"counts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Profile_Selector) Reset() {
-	*x = Profile_Selector{}
-	mi := &file_minder_v1_minder_proto_msgTypes[231]
+func (x *ComplianceReport_TrendPoint) Reset() {
+	*x = ComplianceReport_TrendPoint{}
+	mi := &file_minder_v1_minder_proto_msgTypes[301]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Profile_Selector) String() string {
+func (x *ComplianceReport_TrendPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Profile_Selector) ProtoMessage() {}
+func (*ComplianceReport_TrendPoint) ProtoMessage() {}
 
-func (x *Profile_Selector) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[231]
+func (x *ComplianceReport_TrendPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[301]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14348,37 +18950,23 @@ func (x *Profile_Selector) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Profile_Selector.ProtoReflect.Descriptor instead.
-func (*Profile_Selector) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{127, 1}
-}
-
-func (x *Profile_Selector) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Profile_Selector) GetEntity() string {
-	if x != nil {
-		return x.Entity
-	}
-	return ""
+// Deprecated: Use ComplianceReport_TrendPoint.ProtoReflect.Descriptor instead.
+func (*ComplianceReport_TrendPoint) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{233, 3}
 }
 
-func (x *Profile_Selector) GetSelector() string {
+func (x *ComplianceReport_TrendPoint) GetDay() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Selector
+		return x.Day
 	}
-	return ""
+	return nil
 }
 
-func (x *Profile_Selector) GetDescription() string {
+func (x *ComplianceReport_TrendPoint) GetCounts() *ComplianceReport_Counts {
 	if x != nil {
-		return x.Description
+		return x.Counts
 	}
-	return ""
+	return nil
 }
 
 type StructDataSource_Def struct {
@@ -14391,7 +18979,7 @@ type StructDataSource_Def struct {
 
 func (x *StructDataSource_Def) Reset() {
 	*x = StructDataSource_Def{}
-	mi := &file_minder_v1_minder_proto_msgTypes[233]
+	mi := &file_minder_v1_minder_proto_msgTypes[303]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14403,7 +18991,7 @@ func (x *StructDataSource_Def) String() string {
 func (*StructDataSource_Def) ProtoMessage() {}
 
 func (x *StructDataSource_Def) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[233]
+	mi := &file_minder_v1_minder_proto_msgTypes[303]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14416,7 +19004,7 @@ func (x *StructDataSource_Def) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StructDataSource_Def.ProtoReflect.Descriptor instead.
 func (*StructDataSource_Def) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{201, 0}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{257, 0}
 }
 
 func (x *StructDataSource_Def) GetPath() *StructDataSource_Def_Path {
@@ -14436,7 +19024,7 @@ type StructDataSource_Def_Path struct {
 
 func (x *StructDataSource_Def_Path) Reset() {
 	*x = StructDataSource_Def_Path{}
-	mi := &file_minder_v1_minder_proto_msgTypes[235]
+	mi := &file_minder_v1_minder_proto_msgTypes[305]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14448,7 +19036,7 @@ func (x *StructDataSource_Def_Path) String() string {
 func (*StructDataSource_Def_Path) ProtoMessage() {}
 
 func (x *StructDataSource_Def_Path) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[235]
+	mi := &file_minder_v1_minder_proto_msgTypes[305]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14461,7 +19049,7 @@ func (x *StructDataSource_Def_Path) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StructDataSource_Def_Path.ProtoReflect.Descriptor instead.
 func (*StructDataSource_Def_Path) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{201, 0, 0}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{257, 0, 0}
 }
 
 func (x *StructDataSource_Def_Path) GetFileName() string {
@@ -14516,7 +19104,7 @@ type RestDataSource_Def struct {
 
 func (x *RestDataSource_Def) Reset() {
 	*x = RestDataSource_Def{}
-	mi := &file_minder_v1_minder_proto_msgTypes[236]
+	mi := &file_minder_v1_minder_proto_msgTypes[306]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14528,7 +19116,7 @@ func (x *RestDataSource_Def) String() string {
 func (*RestDataSource_Def) ProtoMessage() {}
 
 func (x *RestDataSource_Def) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[236]
+	mi := &file_minder_v1_minder_proto_msgTypes[306]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14541,7 +19129,7 @@ func (x *RestDataSource_Def) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestDataSource_Def.ProtoReflect.Descriptor instead.
 func (*RestDataSource_Def) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{202, 0}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{258, 0}
 }
 
 func (x *RestDataSource_Def) GetEndpoint() string {
@@ -14665,7 +19253,7 @@ type RestDataSource_Def_Fallback struct {
 
 func (x *RestDataSource_Def_Fallback) Reset() {
 	*x = RestDataSource_Def_Fallback{}
-	mi := &file_minder_v1_minder_proto_msgTypes[239]
+	mi := &file_minder_v1_minder_proto_msgTypes[309]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14677,7 +19265,7 @@ func (x *RestDataSource_Def_Fallback) String() string {
 func (*RestDataSource_Def_Fallback) ProtoMessage() {}
 
 func (x *RestDataSource_Def_Fallback) ProtoReflect() protoreflect.Message {
-	mi := &file_minder_v1_minder_proto_msgTypes[239]
+	mi := &file_minder_v1_minder_proto_msgTypes[309]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14690,7 +19278,7 @@ func (x *RestDataSource_Def_Fallback) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestDataSource_Def_Fallback.ProtoReflect.Descriptor instead.
 func (*RestDataSource_Def_Fallback) Descriptor() ([]byte, []int) {
-	return file_minder_v1_minder_proto_rawDescGZIP(), []int{202, 0, 1}
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{258, 0, 1}
 }
 
 func (x *RestDataSource_Def_Fallback) GetHttpStatus() int32 {
@@ -14707,6 +19295,148 @@ func (x *RestDataSource_Def_Fallback) GetBody() string {
 	return ""
 }
 
+// SigstoreRoot describes a Sigstore deployment (Fulcio, Rekor, CT log
+// and timestamp authorities) trusted for keyless signatures.
+type TrustRoot_SigstoreRoot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tuf_repository is the URL of a TUF repository distributing the
+	// trusted_root.json of the deployment.
+	TufRepository string `protobuf:"bytes,1,opt,name=tuf_repository,json=tufRepository,proto3" json:"tuf_repository,omitempty"`
+	// tuf_root is the initial root.json of the TUF repository.
+	TufRoot string `protobuf:"bytes,2,opt,name=tuf_root,json=tufRoot,proto3" json:"tuf_root,omitempty"`
+	// trusted_root is a trusted_root.json document for the deployment.
+	// It is mutually exclusive with tuf_repository.
+	TrustedRoot string `protobuf:"bytes,3,opt,name=trusted_root,json=trustedRoot,proto3" json:"trusted_root,omitempty"`
+	// require_transparency_log requires signatures to have a verified
+	// transparency log entry.
+	RequireTransparencyLog bool `protobuf:"varint,4,opt,name=require_transparency_log,json=requireTransparencyLog,proto3" json:"require_transparency_log,omitempty"`
+	// require_signed_certificate_timestamps requires signing certificates
+	// to carry a verified signed certificate timestamp.
+	RequireSignedCertificateTimestamps bool `protobuf:"varint,5,opt,name=require_signed_certificate_timestamps,json=requireSignedCertificateTimestamps,proto3" json:"require_signed_certificate_timestamps,omitempty"`
+	unknownFields                      protoimpl.UnknownFields
+	sizeCache                          protoimpl.SizeCache
+}
+
+func (x *TrustRoot_SigstoreRoot) Reset() {
+	*x = TrustRoot_SigstoreRoot{}
+	mi := &file_minder_v1_minder_proto_msgTypes[310]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrustRoot_SigstoreRoot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustRoot_SigstoreRoot) ProtoMessage() {}
+
+func (x *TrustRoot_SigstoreRoot) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[310]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustRoot_SigstoreRoot.ProtoReflect.Descriptor instead.
+func (*TrustRoot_SigstoreRoot) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{260, 0}
+}
+
+func (x *TrustRoot_SigstoreRoot) GetTufRepository() string {
+	if x != nil {
+		return x.TufRepository
+	}
+	return ""
+}
+
+func (x *TrustRoot_SigstoreRoot) GetTufRoot() string {
+	if x != nil {
+		return x.TufRoot
+	}
+	return ""
+}
+
+func (x *TrustRoot_SigstoreRoot) GetTrustedRoot() string {
+	if x != nil {
+		return x.TrustedRoot
+	}
+	return ""
+}
+
+func (x *TrustRoot_SigstoreRoot) GetRequireTransparencyLog() bool {
+	if x != nil {
+		return x.RequireTransparencyLog
+	}
+	return false
+}
+
+func (x *TrustRoot_SigstoreRoot) GetRequireSignedCertificateTimestamps() bool {
+	if x != nil {
+		return x.RequireSignedCertificateTimestamps
+	}
+	return false
+}
+
+// PublicKey is a public key trusted for key-based (e.g. cosign) signatures.
+type TrustRoot_PublicKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id identifies the key in verification results.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pem is the PEM-encoded public key.
+	Pem           string `protobuf:"bytes,2,opt,name=pem,proto3" json:"pem,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrustRoot_PublicKey) Reset() {
+	*x = TrustRoot_PublicKey{}
+	mi := &file_minder_v1_minder_proto_msgTypes[311]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrustRoot_PublicKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustRoot_PublicKey) ProtoMessage() {}
+
+func (x *TrustRoot_PublicKey) ProtoReflect() protoreflect.Message {
+	mi := &file_minder_v1_minder_proto_msgTypes[311]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustRoot_PublicKey.ProtoReflect.Descriptor instead.
+func (*TrustRoot_PublicKey) Descriptor() ([]byte, []int) {
+	return file_minder_v1_minder_proto_rawDescGZIP(), []int{260, 1}
+}
+
+func (x *TrustRoot_PublicKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TrustRoot_PublicKey) GetPem() string {
+	if x != nil {
+		return x.Pem
+	}
+	return ""
+}
+
 var file_minder_v1_minder_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
@@ -14913,7 +19643,43 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x05error\x18\x02 \x01(\tH\x00R\x05error\x88\x01\x01B\b\n" +
 	"\x06_error\"X\n" +
 	"\x1aRegisterRepositoryResponse\x12:\n" +
-	"\x06result\x18\x01 \x01(\v2\x1d.minder.v1.RegisterRepoResultB\x03\xe0A\x02R\x06result\"z\n" +
+	"\x06result\x18\x01 \x01(\v2\x1d.minder.v1.RegisterRepoResultB\x03\xe0A\x02R\x06result\"\xff\x01\n" +
+	"\x14AutoRegistrationRule\x12\x1e\n" +
+	"\x02id\x18\x01 \x01(\tB\x0e\xe0A\x03\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x02id\x12,\n" +
+	"\acontext\x18\x02 \x01(\v2\x12.minder.v1.ContextR\acontext\x125\n" +
+	"\x04name\x18\x03 \x01(\tB!\xe0A\x02\xbaH\x1br\x19\x18\xc8\x012\x14^[a-z][-_[:word:]]*$R\x04name\x127\n" +
+	"\x06entity\x18\x04 \x01(\tB\x1f\xbaH\x1c\xd8\x01\x01r\x17\x18\xc8\x012\x12^[a-z]+(_[a-z]+)*$R\x06entity\x12)\n" +
+	"\bselector\x18\x05 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xe8\aR\bselector\"]\n" +
+	"!CreateAutoRegistrationRuleRequest\x128\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1f.minder.v1.AutoRegistrationRuleB\x03\xe0A\x02R\x04rule\"Y\n" +
+	"\"CreateAutoRegistrationRuleResponse\x123\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1f.minder.v1.AutoRegistrationRuleR\x04rule\"P\n" +
+	" ListAutoRegistrationRulesRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\"Z\n" +
+	"!ListAutoRegistrationRulesResponse\x125\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1f.minder.v1.AutoRegistrationRuleR\x05rules\"\x88\x01\n" +
+	"!DeleteAutoRegistrationRuleRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x125\n" +
+	"\x04name\x18\x02 \x01(\tB!\xe0A\x02\xbaH\x1br\x19\x18\xc8\x012\x14^[a-z][-_[:word:]]*$R\x04name\"8\n" +
+	"\"DeleteAutoRegistrationRuleResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"i\n" +
+	" ReconcileAutoRegistrationRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"^\n" +
+	"!ReconcileAutoRegistrationResponse\x129\n" +
+	"\x06report\x18\x01 \x01(\v2!.minder.v1.AutoRegistrationReportR\x06report\"\xd8\x02\n" +
+	"\x16AutoRegistrationReport\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12H\n" +
+	"\n" +
+	"registered\x18\x02 \x03(\v2(.minder.v1.AutoRegistrationReport.ChangeR\n" +
+	"registered\x12L\n" +
+	"\fderegistered\x18\x03 \x03(\v2(.minder.v1.AutoRegistrationReport.ChangeR\fderegistered\x12)\n" +
+	"\x10failed_providers\x18\x04 \x03(\tR\x0ffailedProviders\x1ab\n" +
+	"\x06Change\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04rule\x18\x03 \x01(\tR\x04rule\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"z\n" +
 	"\x18GetRepositoryByIdRequest\x120\n" +
 	"\rrepository_id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\frepositoryId\x12,\n" +
 	"\acontext\x18\x02 \x01(\v2\x12.minder.v1.ContextR\acontext\"W\n" +
@@ -15033,7 +19799,66 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\acontext\x18\x01 \x01(\v2\x14.minder.v1.ContextV2R\acontext\x127\n" +
 	"\x04name\x18\x02 \x01(\tB#\xe0A\x02\xbaH\x1dr\x1b\x18\xc8\x012\x16^[A-Za-z][-[:word:]]*$R\x04name\"4\n" +
 	"\x1eDeleteDataSourceByNameResponse\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"O\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"M\n" +
+	"\x16CreateTrustRootRequest\x123\n" +
+	"\n" +
+	"trust_root\x18\x01 \x01(\v2\x14.minder.v1.TrustRootR\ttrustRoot\"N\n" +
+	"\x17CreateTrustRootResponse\x123\n" +
+	"\n" +
+	"trust_root\x18\x01 \x01(\v2\x14.minder.v1.TrustRootR\ttrustRoot\"\x82\x01\n" +
+	"\x19GetTrustRootByNameRequest\x12.\n" +
+	"\acontext\x18\x01 \x01(\v2\x14.minder.v1.ContextV2R\acontext\x125\n" +
+	"\x04name\x18\x02 \x01(\tB!\xe0A\x02\xbaH\x1br\x19\x18\xc8\x012\x14^[a-z][-_[:word:]]*$R\x04name\"Q\n" +
+	"\x1aGetTrustRootByNameResponse\x123\n" +
+	"\n" +
+	"trust_root\x18\x01 \x01(\v2\x14.minder.v1.TrustRootR\ttrustRoot\"G\n" +
+	"\x15ListTrustRootsRequest\x12.\n" +
+	"\acontext\x18\x01 \x01(\v2\x14.minder.v1.ContextV2R\acontext\"O\n" +
+	"\x16ListTrustRootsResponse\x125\n" +
+	"\vtrust_roots\x18\x01 \x03(\v2\x14.minder.v1.TrustRootR\n" +
+	"trustRoots\"M\n" +
+	"\x16UpdateTrustRootRequest\x123\n" +
+	"\n" +
+	"trust_root\x18\x01 \x01(\v2\x14.minder.v1.TrustRootR\ttrustRoot\"N\n" +
+	"\x17UpdateTrustRootResponse\x123\n" +
+	"\n" +
+	"trust_root\x18\x01 \x01(\v2\x14.minder.v1.TrustRootR\ttrustRoot\"\x85\x01\n" +
+	"\x1cDeleteTrustRootByNameRequest\x12.\n" +
+	"\acontext\x18\x01 \x01(\v2\x14.minder.v1.ContextV2R\acontext\x125\n" +
+	"\x04name\x18\x02 \x01(\tB!\xe0A\x02\xbaH\x1br\x19\x18\xc8\x012\x14^[a-z][-_[:word:]]*$R\x04name\"3\n" +
+	"\x1dDeleteTrustRootByNameResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"D\n" +
+	"\x12ListBundlesRequest\x12.\n" +
+	"\acontext\x18\x01 \x01(\v2\x14.minder.v1.ContextV2R\acontext\"F\n" +
+	"\x13ListBundlesResponse\x12/\n" +
+	"\abundles\x18\x01 \x03(\v2\x15.minder.v1.BundleInfoR\abundles\"P\n" +
+	"\x1eListBundleSubscriptionsRequest\x12.\n" +
+	"\acontext\x18\x01 \x01(\v2\x14.minder.v1.ContextV2R\acontext\"f\n" +
+	"\x1fListBundleSubscriptionsResponse\x12C\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x1d.minder.v1.BundleSubscriptionR\rsubscriptions\"\xf2\x01\n" +
+	"\x16SubscribeBundleRequest\x12.\n" +
+	"\acontext\x18\x01 \x01(\v2\x14.minder.v1.ContextV2R\acontext\x12X\n" +
+	"\tnamespace\x18\x02 \x01(\tB:\xe0A\x02\xbaH4r220^[a-zA-Z0-9](?:[-_a-zA-Z0-9]{0,61}[a-zA-Z0-9])?$R\tnamespace\x12N\n" +
+	"\x04name\x18\x03 \x01(\tB:\xe0A\x02\xbaH4r220^[a-zA-Z0-9](?:[-_a-zA-Z0-9]{0,61}[a-zA-Z0-9])?$R\x04name\"\\\n" +
+	"\x17SubscribeBundleResponse\x12A\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x1d.minder.v1.BundleSubscriptionR\fsubscription\"\xf4\x01\n" +
+	"\x18UnsubscribeBundleRequest\x12.\n" +
+	"\acontext\x18\x01 \x01(\v2\x14.minder.v1.ContextV2R\acontext\x12X\n" +
+	"\tnamespace\x18\x02 \x01(\tB:\xe0A\x02\xbaH4r220^[a-zA-Z0-9](?:[-_a-zA-Z0-9]{0,61}[a-zA-Z0-9])?$R\tnamespace\x12N\n" +
+	"\x04name\x18\x03 \x01(\tB:\xe0A\x02\xbaH4r220^[a-zA-Z0-9](?:[-_a-zA-Z0-9]{0,61}[a-zA-Z0-9])?$R\x04name\"\x1b\n" +
+	"\x19UnsubscribeBundleResponse\"\xac\x02\n" +
+	"\x14UpgradeBundleRequest\x12.\n" +
+	"\acontext\x18\x01 \x01(\v2\x14.minder.v1.ContextV2R\acontext\x12X\n" +
+	"\tnamespace\x18\x02 \x01(\tB:\xe0A\x02\xbaH4r220^[a-zA-Z0-9](?:[-_a-zA-Z0-9]{0,61}[a-zA-Z0-9])?$R\tnamespace\x12N\n" +
+	"\x04name\x18\x03 \x01(\tB:\xe0A\x02\xbaH4r220^[a-zA-Z0-9](?:[-_a-zA-Z0-9]{0,61}[a-zA-Z0-9])?$R\x04name\x12!\n" +
+	"\aversion\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x18dR\aversion\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\"\x9e\x01\n" +
+	"\x15UpgradeBundleResponse\x12!\n" +
+	"\ffrom_version\x18\x01 \x01(\tR\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x02 \x01(\tR\ttoVersion\x12)\n" +
+	"\x04diff\x18\x03 \x01(\v2\x15.minder.v1.BundleDiffR\x04diff\x12\x18\n" +
+	"\aapplied\x18\x04 \x01(\bR\aapplied\"O\n" +
 	"\x14CreateProfileRequest\x121\n" +
 	"\aprofile\x18\x01 \x01(\v2\x12.minder.v1.ProfileB\x03\xe0A\x02R\aprofileJ\x04\b\x02\x10\x03\"J\n" +
 	"\x15CreateProfileResponse\x121\n" +
@@ -15137,7 +19962,50 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	" GetProfileStatusByProjectRequest\x12,\n" +
 	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\"i\n" +
 	"!GetProfileStatusByProjectResponse\x12D\n" +
-	"\x0eprofile_status\x18\x01 \x03(\v2\x18.minder.v1.ProfileStatusB\x03\xe0A\x02R\rprofileStatus\"I\n" +
+	"\x0eprofile_status\x18\x01 \x03(\v2\x18.minder.v1.ProfileStatusB\x03\xe0A\x02R\rprofileStatus\"\xa6\x04\n" +
+	"\x06Waiver\x12\x1e\n" +
+	"\x02id\x18\x01 \x01(\tB\x0e\xe0A\x03\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x02id\x12,\n" +
+	"\acontext\x18\x02 \x01(\v2\x12.minder.v1.ContextR\acontext\x12>\n" +
+	"\aprofile\x18\x03 \x01(\tB$\xe0A\x02\xbaH\x1er\x1c\x18\xc8\x012\x17^[A-Za-z][-/[:word:]]*$R\aprofile\x12A\n" +
+	"\trule_name\x18\x04 \x01(\tB$\xe0A\x02\xbaH\x1er\x1c\x18\xc8\x012\x17^[A-Za-z][-/[:word:]]*$R\bruleName\x125\n" +
+	"\x06entity\x18\x05 \x01(\v2\x18.minder.v1.EntityTypedIdB\x03\xe0A\x02R\x06entity\x123\n" +
+	"\rjustification\x18\x06 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xd0\x0fR\rjustification\x12\x1f\n" +
+	"\bapprover\x18\a \x01(\tB\x03\xe0A\x03R\bapprover\x12>\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\texpiresAt\x12>\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tcreatedAt\x12>\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\tupdatedAt\"E\n" +
+	"\x13CreateWaiverRequest\x12.\n" +
+	"\x06waiver\x18\x01 \x01(\v2\x11.minder.v1.WaiverB\x03\xe0A\x02R\x06waiver\"A\n" +
+	"\x14CreateWaiverResponse\x12)\n" +
+	"\x06waiver\x18\x01 \x01(\v2\x11.minder.v1.WaiverR\x06waiver\"\xd5\x01\n" +
+	"\x13UpdateWaiverRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x12\x1b\n" +
+	"\x02id\x18\x02 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x02id\x123\n" +
+	"\rjustification\x18\x03 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xd0\x0fR\rjustification\x12>\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\texpiresAt\"A\n" +
+	"\x14UpdateWaiverResponse\x12)\n" +
+	"\x06waiver\x18\x01 \x01(\v2\x11.minder.v1.WaiverR\x06waiver\"a\n" +
+	"\x14GetWaiverByIdRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x12\x1b\n" +
+	"\x02id\x18\x02 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x02id\"B\n" +
+	"\x15GetWaiverByIdResponse\x12)\n" +
+	"\x06waiver\x18\x01 \x01(\v2\x11.minder.v1.WaiverR\x06waiver\"\xab\x01\n" +
+	"\x12ListWaiversRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x12>\n" +
+	"\aprofile\x18\x02 \x01(\tB$\xbaH!\xd8\x01\x01r\x1c\x18\xc8\x012\x17^[A-Za-z][-/[:word:]]*$R\aprofile\x12'\n" +
+	"\x0finclude_expired\x18\x03 \x01(\bR\x0eincludeExpired\"B\n" +
+	"\x13ListWaiversResponse\x12+\n" +
+	"\awaivers\x18\x01 \x03(\v2\x11.minder.v1.WaiverR\awaivers\"`\n" +
+	"\x13DeleteWaiverRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x12\x1b\n" +
+	"\x02id\x18\x02 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x02id\"&\n" +
+	"\x14DeleteWaiverResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"I\n" +
 	"\x1cEntityAutoRegistrationConfig\x12\x1d\n" +
 	"\aenabled\x18\x01 \x01(\bH\x00R\aenabled\x88\x01\x01B\n" +
 	"\n" +
@@ -15158,10 +20026,14 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\t_endpoint\"t\n" +
 	"\x17GitHubAppProviderConfig\x12\x1f\n" +
 	"\bendpoint\x18\x01 \x01(\tH\x00R\bendpoint\x88\x01\x01B\v\n" +
-	"\t_endpointJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04J\x04\b\x04\x10\x05R\bapp_nameR\x06app_idR\auser_id\"H\n" +
+	"\t_endpointJ\x04\b\x02\x10\x03J\x04\b\x03\x10\x04J\x04\b\x04\x10\x05R\bapp_nameR\x06app_idR\auser_id\"d\n" +
 	"\x14GitLabProviderConfig\x12\x1a\n" +
 	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x14\n" +
-	"\x05group\x18\x02 \x01(\tR\x05group\"J\n" +
+	"\x05group\x18\x02 \x01(\tR\x05group\x12\x1a\n" +
+	"\bregistry\x18\x03 \x01(\tR\bregistry\"[\n" +
+	"\x19AzureDevOpsProviderConfig\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\"\n" +
+	"\forganization\x18\x02 \x01(\tR\forganization\"J\n" +
 	"\x17DockerHubProviderConfig\x12!\n" +
 	"\tnamespace\x18\x01 \x01(\tH\x00R\tnamespace\x88\x01\x01B\f\n" +
 	"\n" +
@@ -15200,11 +20072,25 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x15CreateRuleTypeRequest\x125\n" +
 	"\trule_type\x18\x01 \x01(\v2\x13.minder.v1.RuleTypeB\x03\xe0A\x02R\bruleTypeJ\x04\b\x02\x10\x03\"O\n" +
 	"\x16CreateRuleTypeResponse\x125\n" +
-	"\trule_type\x18\x01 \x01(\v2\x13.minder.v1.RuleTypeB\x03\xe0A\x02R\bruleType\"T\n" +
+	"\trule_type\x18\x01 \x01(\v2\x13.minder.v1.RuleTypeB\x03\xe0A\x02R\bruleType\"m\n" +
 	"\x15UpdateRuleTypeRequest\x125\n" +
-	"\trule_type\x18\x02 \x01(\v2\x13.minder.v1.RuleTypeB\x03\xe0A\x02R\bruleTypeJ\x04\b\x03\x10\x04\"O\n" +
+	"\trule_type\x18\x02 \x01(\v2\x13.minder.v1.RuleTypeB\x03\xe0A\x02R\bruleType\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRunJ\x04\b\x03\x10\x04\"\x89\x01\n" +
 	"\x16UpdateRuleTypeResponse\x125\n" +
-	"\trule_type\x18\x01 \x01(\v2\x13.minder.v1.RuleTypeB\x03\xe0A\x02R\bruleType\"~\n" +
+	"\trule_type\x18\x01 \x01(\v2\x13.minder.v1.RuleTypeB\x03\xe0A\x02R\bruleType\x128\n" +
+	"\n" +
+	"migrations\x18\x02 \x03(\v2\x18.minder.v1.RuleMigrationR\n" +
+	"migrations\"\x94\x02\n" +
+	"\rRuleMigration\x12\x1d\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\tR\tprofileId\x12!\n" +
+	"\fprofile_name\x18\x02 \x01(\tR\vprofileName\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x03 \x01(\tR\tprojectId\x12\x1b\n" +
+	"\trule_name\x18\x04 \x01(\tR\bruleName\x12)\n" +
+	"\x06entity\x18\x05 \x01(\x0e2\x11.minder.v1.EntityR\x06entity\x12)\n" +
+	"\x03def\x18\x06 \x01(\v2\x17.google.protobuf.StructR\x03def\x12/\n" +
+	"\x06params\x18\a \x01(\v2\x17.google.protobuf.StructR\x06params\"~\n" +
 	"\x15DeleteRuleTypeRequest\x12,\n" +
 	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x127\n" +
 	"\x02id\x18\x02 \x01(\tB'\xe0A\x02\xbaH!r\x1f\x18\xc8\x012\x1a^[A-Za-z0-9][-/[:word:]]*$R\x02id\"\x18\n" +
@@ -15258,7 +20144,11 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x06branch\x18\x01 \x01(\tB\x1d\xbaH\x1a\xd8\x01\x01r\x15\x18\xc8\x012\x10^[[:word:]./-]+$R\x06branch\x1aQ\n" +
 	"\x12PullRequestConfigs\x12;\n" +
 	"\x06filter\x18\x01 \x01(\tB#\xbaH \xd8\x01\x01r\x1bR\x0fNEW_AND_UPDATEDR\bNEW_ONLYR\x06filterB\r\n" +
-	"\ventity_type\"\x88\x02\n" +
+	"\ventity_type\"Z\n" +
+	"\n" +
+	"AlertsType\x12L\n" +
+	"\x05kinds\x18\x01 \x03(\tB6\xbaH3\x92\x010\".r,R\rcode_scanningR\x0fsecret_scanningR\n" +
+	"dependabotR\x05kinds\"\x88\x02\n" +
 	"\bSeverity\x12/\n" +
 	"\x05value\x18\x01 \x01(\x0e2\x19.minder.v1.Severity.ValueR\x05value\"\xca\x01\n" +
 	"\x05Value\x12\x15\n" +
@@ -15271,7 +20161,7 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\xea\xdc\x14\x06medium\x12\x18\n" +
 	"\n" +
 	"VALUE_HIGH\x10\x05\x1a\b\xea\xdc\x14\x04high\x12 \n" +
-	"\x0eVALUE_CRITICAL\x10\x06\x1a\f\xea\xdc\x14\bcritical\"\x81$\n" +
+	"\x0eVALUE_CRITICAL\x10\x06\x1a\f\xea\xdc\x14\bcritical\"\xd8*\n" +
 	"\bRuleType\x12&\n" +
 	"\aversion\x18\v \x01(\tB\f\xbaH\tr\a2\x05^v\\d$R\aversion\x12$\n" +
 	"\x04type\x18\f \x01(\tB\x10\xbaH\rr\v2\trule-typeR\x04type\x12\x1d\n" +
@@ -15285,7 +20175,7 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\vdescription\x18\x05 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xdc\vR\vdescription\x12)\n" +
 	"\bguidance\x18\x06 \x01(\tB\r\xe0A\x02\xbaH\ar\x05\x10\x01\x18\xe8\aR\bguidance\x12/\n" +
 	"\bseverity\x18\a \x01(\v2\x13.minder.v1.SeverityR\bseverity\x12D\n" +
-	"\rrelease_phase\x18\t \x01(\x0e2\x1f.minder.v1.RuleTypeReleasePhaseR\freleasePhase\x1a\xff\x1e\n" +
+	"\rrelease_phase\x18\t \x01(\x0e2\x1f.minder.v1.RuleTypeReleasePhaseR\freleasePhase\x1a\xd6%\n" +
 	"\n" +
 	"Definition\x12;\n" +
 	"\tin_entity\x18\x01 \x01(\tB\x1e\xbaH\x1br\x19\x10\x01\x18\xc8\x012\x12^[a-z]+(_[a-z]+)*$R\binEntity\x128\n" +
@@ -15295,22 +20185,28 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x06ingest\x18\x04 \x01(\v2%.minder.v1.RuleType.Definition.IngestB\x03\xe0A\x02R\x06ingest\x12<\n" +
 	"\x04eval\x18\x05 \x01(\v2#.minder.v1.RuleType.Definition.EvalB\x03\xe0A\x02R\x04eval\x12F\n" +
 	"\tremediate\x18\x06 \x01(\v2(.minder.v1.RuleType.Definition.RemediateR\tremediate\x12:\n" +
-	"\x05alert\x18\a \x01(\v2$.minder.v1.RuleType.Definition.AlertR\x05alert\x1a\xb2\x03\n" +
-	"\x06Ingest\x12F\n" +
-	"\x04type\x18\x01 \x01(\tB2\xe0A\x02\xbaH,r*R\x04restR\bartifactR\abuiltinR\x03gitR\x04diffR\x04depsR\x04type\x12,\n" +
+	"\x05alert\x18\a \x01(\v2$.minder.v1.RuleType.Definition.AlertR\x05alert\x12B\n" +
+	"\x06limits\x18\b \x01(\v2%.minder.v1.RuleType.Definition.LimitsH\x01R\x06limits\x88\x01\x01\x12%\n" +
+	"\x0eschema_version\x18\t \x01(\rR\rschemaVersion\x12K\n" +
+	"\tmigration\x18\n" +
+	" \x01(\v2(.minder.v1.RuleType.Definition.MigrationH\x02R\tmigration\x88\x01\x01\x1a\xf9\x03\n" +
+	"\x06Ingest\x12N\n" +
+	"\x04type\x18\x01 \x01(\tB:\xe0A\x02\xbaH4r2R\x04restR\bartifactR\abuiltinR\x03gitR\x04diffR\x04depsR\x06alertsR\x04type\x12,\n" +
 	"\x04rest\x18\x03 \x01(\v2\x13.minder.v1.RestTypeH\x00R\x04rest\x88\x01\x01\x125\n" +
 	"\abuiltin\x18\x04 \x01(\v2\x16.minder.v1.BuiltinTypeH\x01R\abuiltin\x88\x01\x01\x128\n" +
 	"\bartifact\x18\x05 \x01(\v2\x17.minder.v1.ArtifactTypeH\x02R\bartifact\x88\x01\x01\x12)\n" +
 	"\x03git\x18\x06 \x01(\v2\x12.minder.v1.GitTypeH\x03R\x03git\x88\x01\x01\x12,\n" +
 	"\x04diff\x18\a \x01(\v2\x13.minder.v1.DiffTypeH\x04R\x04diff\x88\x01\x01\x12,\n" +
-	"\x04deps\x18\b \x01(\v2\x13.minder.v1.DepsTypeH\x05R\x04deps\x88\x01\x01B\a\n" +
+	"\x04deps\x18\b \x01(\v2\x13.minder.v1.DepsTypeH\x05R\x04deps\x88\x01\x01\x122\n" +
+	"\x06alerts\x18\t \x01(\v2\x15.minder.v1.AlertsTypeH\x06R\x06alerts\x88\x01\x01B\a\n" +
 	"\x05_restB\n" +
 	"\n" +
 	"\b_builtinB\v\n" +
 	"\t_artifactB\x06\n" +
 	"\x04_gitB\a\n" +
 	"\x05_diffB\a\n" +
-	"\x05_deps\x1a\xd0\t\n" +
+	"\x05_depsB\t\n" +
+	"\a_alerts\x1a\xd0\t\n" +
 	"\x04Eval\x12E\n" +
 	"\x04type\x18\x01 \x01(\tB1\xe0A\x02\xbaH+r)R\x02jqR\x04regoR\tvulncheckR\x06trustyR\n" +
 	"homoglyphsR\x04type\x12@\n" +
@@ -15343,15 +20239,22 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\n" +
 	"_vulncheckB\t\n" +
 	"\a_trustyB\r\n" +
-	"\v_homoglyphs\x1a\x99\n" +
-	"\n" +
-	"\tRemediate\x12F\n" +
-	"\x04type\x18\x01 \x01(\tB2\xbaH/\xd8\x01\x01r*R\x04restR\x14gh_branch_protectionR\fpull_requestR\x04type\x12,\n" +
+	"\v_homoglyphs\x1a\x9a\f\n" +
+	"\tRemediate\x12R\n" +
+	"\x04type\x18\x01 \x01(\tB>\xbaH;\xd8\x01\x01r6R\x04restR\x14gh_branch_protectionR\n" +
+	"gh_rulesetR\fpull_requestR\x04type\x12,\n" +
 	"\x04rest\x18\x02 \x01(\v2\x13.minder.v1.RestTypeH\x00R\x04rest\x88\x01\x01\x12v\n" +
 	"\x14gh_branch_protection\x18\x03 \x01(\v2?.minder.v1.RuleType.Definition.Remediate.GhBranchProtectionTypeH\x01R\x12ghBranchProtection\x88\x01\x01\x12g\n" +
-	"\fpull_request\x18\x04 \x01(\v2?.minder.v1.RuleType.Definition.Remediate.PullRequestRemediationH\x02R\vpullRequest\x88\x01\x01\x1a;\n" +
+	"\fpull_request\x18\x04 \x01(\v2?.minder.v1.RuleType.Definition.Remediate.PullRequestRemediationH\x02R\vpullRequest\x88\x01\x01\x12Z\n" +
+	"\n" +
+	"gh_ruleset\x18\x05 \x01(\v26.minder.v1.RuleType.Definition.Remediate.GhRulesetTypeH\x03R\tghRuleset\x88\x01\x01\x1a;\n" +
 	"\x16GhBranchProtectionType\x12!\n" +
-	"\x05patch\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\x18\xe8\aR\x05patch\x1a\xc4\x06\n" +
+	"\x05patch\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\x18\xe8\aR\x05patch\x1a\x87\x01\n" +
+	"\rGhRulesetType\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04name\x12!\n" +
+	"\x05patch\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\x18\xd0\x0fR\x05patch\x124\n" +
+	"\n" +
+	"patch_type\x18\x03 \x01(\tB\x15\xbaH\x12\xd8\x01\x01r\rR\x05mergeR\x04jsonR\tpatchType\x1a\xc4\x06\n" +
 	"\x16PullRequestRemediation\x12\x1f\n" +
 	"\x05title\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18KR\x05title\x12\x1f\n" +
 	"\x04body\x18\x02 \x01(\tB\v\xbaH\br\x06\x10\x01\x18\x80\x80\x04R\x04body\x12c\n" +
@@ -15371,7 +20274,8 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x1e_actions_replace_tags_with_shaB\a\n" +
 	"\x05_restB\x17\n" +
 	"\x15_gh_branch_protectionB\x0f\n" +
-	"\r_pull_request\x1a\xfd\x03\n" +
+	"\r_pull_requestB\r\n" +
+	"\v_gh_ruleset\x1a\xfd\x03\n" +
 	"\x05Alert\x12E\n" +
 	"\x04type\x18\x01 \x01(\tB1\xbaH.\xd8\x01\x01r)R\x11security_advisoryR\x14pull_request_commentR\x04type\x12b\n" +
 	"\x11security_advisory\x18\x02 \x01(\v20.minder.v1.RuleType.Definition.Alert.AlertTypeSAH\x00R\x10securityAdvisory\x88\x01\x01\x12n\n" +
@@ -15381,8 +20285,19 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x12AlertTypePRComment\x123\n" +
 	"\x0ereview_message\x18\x01 \x01(\tB\f\xe0A\x02\xbaH\x06r\x04\x18\x80\x80\x04R\rreviewMessageB\x14\n" +
 	"\x12_security_advisoryB\x17\n" +
-	"\x15_pull_request_commentB\x0f\n" +
-	"\r_param_schemaB\x05\n" +
+	"\x15_pull_request_comment\x1a\xc9\x01\n" +
+	"\x06Limits\x12F\n" +
+	"\atimeout\x18\x01 \x01(\tB,\xbaH)\xd8\x01\x01r$\x1822 ^([0-9]+(\\.[0-9]+)?(ms|s|m|h))+$R\atimeout\x12;\n" +
+	"\x15max_rego_instructions\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x13maxRegoInstructions\x12:\n" +
+	"\x15max_data_source_calls\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x12maxDataSourceCalls\x1ap\n" +
+	"\tMigration\x12%\n" +
+	"\x04type\x18\x01 \x01(\tB\x11\xe0A\x02\xbaH\vr\tR\x02jqR\x03celR\x04type\x12\x1a\n" +
+	"\x03def\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\x03def\x12 \n" +
+	"\x06params\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\x06paramsB\x0f\n" +
+	"\r_param_schemaB\t\n" +
+	"\a_limitsB\f\n" +
+	"\n" +
+	"_migrationB\x05\n" +
 	"\x03_id\"\xff\v\n" +
 	"\aProfile\x12,\n" +
 	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x12\x1d\n" +
@@ -15591,7 +20506,7 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\xbaH\a\xd8\x01\x01\"\x02 \x00R\x0einstallationId\x12\"\n" +
 	"\forganization\x18\x02 \x01(\tR\forganization\x123\n" +
 	"\x0forganization_id\x18\x03 \x01(\x03B\n" +
-	"\xbaH\a\xd8\x01\x01\"\x02 \x00R\x0eorganizationId\"\xeb\x03\n" +
+	"\xbaH\a\xd8\x01\x01\"\x02 \x00R\x0eorganizationId\"\x9e\x04\n" +
 	"\bProvider\x127\n" +
 	"\x04name\x18\x01 \x01(\tB#\xbaH \xd8\x01\x01r\x1b\x18\xc8\x012\x16^[A-Za-z][-[:word:]]*$R\x04name\x125\n" +
 	"\x05class\x18\a \x01(\tB\x1f\xbaH\x1c\xd8\x01\x01r\x17\x18\xc8\x012\x12^[a-z][a-z0-9_-]*$R\x05class\x12\x18\n" +
@@ -15608,7 +20523,16 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"parameters\x12+\n" +
 	"\x11credentials_state\x18\t \x01(\tR\x10credentialsState\x12\x1b\n" +
 	"\x02id\x18\n" +
-	" \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x02id\"h\n" +
+	" \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x02id\x121\n" +
+	"\x06health\x18\v \x01(\v2\x19.minder.v1.ProviderHealthR\x06health\"\x96\x02\n" +
+	"\x0eProviderHealth\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x02 \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"checked_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\x12B\n" +
+	"\x0flast_healthy_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastHealthyAt\x12N\n" +
+	"\x15credential_expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x13credentialExpiresAt\"h\n" +
 	"\x1bGetEvaluationHistoryRequest\x12\x1b\n" +
 	"\x02id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12,\n" +
 	"\acontext\x18\x02 \x01(\v2\x12.minder.v1.ContextR\acontext\"\x98\x05\n" +
@@ -15630,7 +20554,105 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x1cGetEvaluationHistoryResponse\x12A\n" +
 	"\n" +
 	"evaluation\x18\x01 \x01(\v2\x1c.minder.v1.EvaluationHistoryB\x03\xe0A\x02R\n" +
-	"evaluation\"\x81\x01\n" +
+	"evaluation\"\x8c\x04\n" +
+	"\x17WatchEvaluationsRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x12>\n" +
+	"\ventity_type\x18\x02 \x03(\tB\x1d\xbaH\x1a\x92\x01\x17\"\x15r\x13\x18\xc8\x012\x0e^[,[:word:]]*$R\n" +
+	"entityType\x12A\n" +
+	"\ventity_name\x18\x03 \x03(\tB \xbaH\x1d\x92\x01\x1a\"\x18r\x16\x18\xc8\x012\x11^[,-./[:word:]]*$R\n" +
+	"entityName\x12I\n" +
+	"\fprofile_name\x18\x04 \x03(\tB&\xbaH#\x92\x01 \"\x1er\x1c\x18\xc8\x012\x17^[A-Za-z][-/[:word:]]*$R\vprofileName\x125\n" +
+	"\x06status\x18\x05 \x03(\tB\x1d\xbaH\x1a\x92\x01\x17\"\x15r\x13\x18\xc8\x012\x0e^[,[:word:]]*$R\x06status\x12?\n" +
+	"\vremediation\x18\x06 \x03(\tB\x1d\xbaH\x1a\x92\x01\x17\"\x15r\x13\x18\xc8\x012\x0e^[,[:word:]]*$R\vremediation\x123\n" +
+	"\x05alert\x18\a \x03(\tB\x1d\xbaH\x1a\x92\x01\x17\"\x15r\x13\x18\xc8\x012\x0e^[,[:word:]]*$R\x05alert\x12H\n" +
+	"\flabel_filter\x18\b \x03(\tB%\xbaH\"\x92\x01\x1f\"\x1dr\x1b\x18\xc8\x012\x16^(\\*|[a-z][a-z0-9_]*)$R\vlabelFilter\"X\n" +
+	"\x18WatchEvaluationsResponse\x12<\n" +
+	"\n" +
+	"evaluation\x18\x01 \x01(\v2\x1c.minder.v1.EvaluationHistoryR\n" +
+	"evaluation\"{\n" +
+	"\x18ExplainEvaluationRequest\x12\x1b\n" +
+	"\x02id\x18\x01 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12,\n" +
+	"\acontext\x18\x02 \x01(\v2\x12.minder.v1.ContextR\acontext\x12\x14\n" +
+	"\x05trace\x18\x03 \x01(\bR\x05trace\"_\n" +
+	"\x19ExplainEvaluationResponse\x12B\n" +
+	"\vexplanation\x18\x01 \x01(\v2 .minder.v1.EvaluationExplanationR\vexplanation\"\xfd\x02\n" +
+	"\x15EvaluationExplanation\x12<\n" +
+	"\n" +
+	"evaluation\x18\x01 \x01(\v2\x1c.minder.v1.EvaluationHistoryR\n" +
+	"evaluation\x12:\n" +
+	"\x06status\x18\x02 \x01(\v2\".minder.v1.EvaluationHistoryStatusR\x06status\x12\x16\n" +
+	"\x06prints\x18\x03 \x03(\tR\x06prints\x12;\n" +
+	"\x05rules\x18\x04 \x03(\v2%.minder.v1.EvaluationExplanation.RuleR\x05rules\x12-\n" +
+	"\x05input\x18\x05 \x01(\v2\x17.google.protobuf.StructR\x05input\x12\x14\n" +
+	"\x05trace\x18\x06 \x03(\tR\x05trace\x1aP\n" +
+	"\x04Rule\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x12\x18\n" +
+	"\amatched\x18\x03 \x01(\bR\amatched\"\xdd\x01\n" +
+	"\x1fGenerateComplianceReportRequest\x12,\n" +
+	"\acontext\x18\x01 \x01(\v2\x12.minder.v1.ContextR\acontext\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x120\n" +
+	"\x06format\x18\x04 \x01(\tB\x18\xbaH\x15r\x13R\x00R\x04jsonR\x03csvR\x04htmlR\x06format\"\x96\x01\n" +
+	" GenerateComplianceReportResponse\x123\n" +
+	"\x06report\x18\x01 \x01(\v2\x1b.minder.v1.ComplianceReportR\x06report\x12\x1a\n" +
+	"\brendered\x18\x02 \x01(\tR\brendered\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"\x92\r\n" +
+	"\x10ComplianceReport\x12\x18\n" +
+	"\aproject\x18\x01 \x01(\tR\aproject\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12=\n" +
+	"\fgenerated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12:\n" +
+	"\x06totals\x18\x06 \x01(\v2\".minder.v1.ComplianceReport.CountsR\x06totals\x12@\n" +
+	"\n" +
+	"by_project\x18\a \x03(\v2!.minder.v1.ComplianceReport.GroupR\tbyProject\x12@\n" +
+	"\n" +
+	"by_profile\x18\b \x03(\v2!.minder.v1.ComplianceReport.GroupR\tbyProfile\x12C\n" +
+	"\fby_rule_type\x18\t \x03(\v2!.minder.v1.ComplianceReport.GroupR\n" +
+	"byRuleType\x12B\n" +
+	"\vby_severity\x18\n" +
+	" \x03(\v2!.minder.v1.ComplianceReport.GroupR\n" +
+	"bySeverity\x12>\n" +
+	"\tby_entity\x18\v \x03(\v2!.minder.v1.ComplianceReport.GroupR\bbyEntity\x12?\n" +
+	"\bfindings\x18\f \x03(\v2#.minder.v1.ComplianceReport.FindingR\bfindings\x12<\n" +
+	"\x05trend\x18\r \x03(\v2&.minder.v1.ComplianceReport.TrendPointR\x05trend\x1a\xb5\x02\n" +
+	"\x06Counts\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\x03R\asuccess\x12\x18\n" +
+	"\afailure\x18\x03 \x01(\x03R\afailure\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\x03R\x05error\x12\x18\n" +
+	"\askipped\x18\x05 \x01(\x03R\askipped\x12\x18\n" +
+	"\apending\x18\x06 \x01(\x03R\apending\x12\x1b\n" +
+	"\talerts_on\x18\a \x01(\x03R\balertsOn\x121\n" +
+	"\x14remediations_success\x18\b \x01(\x03R\x13remediationsSuccess\x12/\n" +
+	"\x13remediations_failed\x18\t \x01(\x03R\x12remediationsFailed\x12\x16\n" +
+	"\x06waived\x18\n" +
+	" \x01(\x03R\x06waived\x1aW\n" +
+	"\x05Group\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12:\n" +
+	"\x06counts\x18\x02 \x01(\v2\".minder.v1.ComplianceReport.CountsR\x06counts\x1a\x98\x03\n" +
+	"\aFinding\x12\x18\n" +
+	"\aproject\x18\x01 \x01(\tR\aproject\x12\x18\n" +
+	"\aprofile\x18\x02 \x01(\tR\aprofile\x12\x1b\n" +
+	"\trule_type\x18\x03 \x01(\tR\bruleType\x12\x1b\n" +
+	"\trule_name\x18\x04 \x01(\tR\bruleName\x12\x1a\n" +
+	"\bseverity\x18\x05 \x01(\tR\bseverity\x12\x1f\n" +
+	"\ventity_type\x18\x06 \x01(\tR\n" +
+	"entityType\x12\x1f\n" +
+	"\ventity_name\x18\a \x01(\tR\n" +
+	"entityName\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x18\n" +
+	"\adetails\x18\t \x01(\tR\adetails\x12-\n" +
+	"\x12remediation_status\x18\n" +
+	" \x01(\tR\x11remediationStatus\x12!\n" +
+	"\falert_status\x18\v \x01(\tR\valertStatus\x12=\n" +
+	"\flast_updated\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vlastUpdated\x1av\n" +
+	"\n" +
+	"TrendPoint\x12,\n" +
+	"\x03day\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x03day\x12:\n" +
+	"\x06counts\x18\x02 \x01(\v2\".minder.v1.ComplianceReport.CountsR\x06counts\"\x81\x01\n" +
 	"\x1dListEvaluationHistoryResponse\x125\n" +
 	"\x04data\x18\x01 \x03(\v2\x1c.minder.v1.EvaluationHistoryB\x03\xe0A\x02R\x04data\x12)\n" +
 	"\x04page\x18\x02 \x01(\v2\x15.minder.v1.CursorPageR\x04page\"\xad\x03\n" +
@@ -15687,7 +20709,22 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\ventity_type\x18\x03 \x01(\x0e2\x11.minder.v1.EntityB\x03\xe0A\x02R\n" +
 	"entityType\"Q\n" +
 	"\x17GetEntityByNameResponse\x126\n" +
-	"\x06entity\x18\x01 \x01(\v2\x19.minder.v1.EntityInstanceB\x03\xe0A\x02R\x06entity\"f\n" +
+	"\x06entity\x18\x01 \x01(\v2\x19.minder.v1.EntityInstanceB\x03\xe0A\x02R\x06entity\"\xb6\x01\n" +
+	" ListEntityPropertyHistoryRequest\x12.\n" +
+	"\acontext\x18\x01 \x01(\v2\x14.minder.v1.ContextV2R\acontext\x12\x1b\n" +
+	"\x02id\x18\x02 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1a\n" +
+	"\x03key\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xc8\x01R\x03key\x12)\n" +
+	"\x06cursor\x18\x04 \x01(\v2\x11.minder.v1.CursorR\x06cursor\"\xe5\x01\n" +
+	"\x14EntityPropertyChange\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x123\n" +
+	"\told_value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\boldValue\x123\n" +
+	"\tnew_value\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\bnewValue\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x129\n" +
+	"\n" +
+	"changed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"\x8e\x01\n" +
+	"!ListEntityPropertyHistoryResponse\x12>\n" +
+	"\aresults\x18\x01 \x03(\v2\x1f.minder.v1.EntityPropertyChangeB\x03\xe0A\x02R\aresults\x12)\n" +
+	"\x04page\x18\x02 \x01(\v2\x15.minder.v1.CursorPageR\x04page\"f\n" +
 	"\x17DeleteEntityByIdRequest\x12.\n" +
 	"\acontext\x18\x01 \x01(\v2\x14.minder.v1.ContextV2R\acontext\x12\x1b\n" +
 	"\x02id\x18\x02 \x01(\tB\v\xe0A\x02\xbaH\x05r\x03\xb0\x01\x01R\x02id\"/\n" +
@@ -15760,11 +20797,55 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2\x1d.minder.v1.RestDataSource.DefR\x05value:\x028\x01\"\x83\x01\n" +
 	"\x13DataSourceReference\x123\n" +
 	"\x04name\x18\x01 \x01(\tB\x1f\xbaH\x1cr\x1a\x18\xc8\x012\x15^[a-z][-_/[:word:]]*$R\x04name\x127\n" +
-	"\x05alias\x18\x02 \x01(\tB!\xbaH\x1e\xd8\x01\x01r\x19\x18\xc8\x012\x14^[a-z][-_[:word:]]*$R\x05alias*b\n" +
+	"\x05alias\x18\x02 \x01(\tB!\xbaH\x1e\xd8\x01\x01r\x19\x18\xc8\x012\x14^[a-z][-_[:word:]]*$R\x05alias\"\xf3\x04\n" +
+	"\tTrustRoot\x12\x1e\n" +
+	"\x02id\x18\x01 \x01(\tB\x0e\xe0A\x03\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x02id\x12.\n" +
+	"\acontext\x18\x02 \x01(\v2\x14.minder.v1.ContextV2R\acontext\x125\n" +
+	"\x04name\x18\x03 \x01(\tB!\xe0A\x02\xbaH\x1br\x19\x18\xc8\x012\x14^[a-z][-_[:word:]]*$R\x04name\x12=\n" +
+	"\bsigstore\x18\x04 \x01(\v2!.minder.v1.TrustRoot.SigstoreRootR\bsigstore\x12?\n" +
+	"\vpublic_keys\x18\x05 \x03(\v2\x1e.minder.v1.TrustRoot.PublicKeyR\n" +
+	"publicKeys\x1a\x80\x02\n" +
+	"\fSigstoreRoot\x12%\n" +
+	"\x0etuf_repository\x18\x01 \x01(\tR\rtufRepository\x12\x19\n" +
+	"\btuf_root\x18\x02 \x01(\tR\atufRoot\x12!\n" +
+	"\ftrusted_root\x18\x03 \x01(\tR\vtrustedRoot\x128\n" +
+	"\x18require_transparency_log\x18\x04 \x01(\bR\x16requireTransparencyLog\x12Q\n" +
+	"%require_signed_certificate_timestamps\x18\x05 \x01(\bR\"requireSignedCertificateTimestamps\x1a\\\n" +
+	"\tPublicKey\x128\n" +
+	"\x02id\x18\x01 \x01(\tB(\xe0A\x02\xbaH\"r \x18\xc8\x012\x1b^[A-Za-z0-9][-_.[:word:]]*$R\x02id\x12\x15\n" +
+	"\x03pem\x18\x02 \x01(\tB\x03\xe0A\x02R\x03pem\"Z\n" +
+	"\n" +
+	"BundleInfo\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bversions\x18\x03 \x03(\tR\bversions\"\xc9\x01\n" +
+	"\x12BundleSubscription\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tR\tprojectId\x12!\n" +
+	"\fproject_name\x18\x02 \x01(\tR\vprojectName\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\tR\aversion\x12%\n" +
+	"\x0elatest_version\x18\x06 \x01(\tR\rlatestVersion\"\xbe\x01\n" +
+	"\n" +
+	"BundleDiff\x129\n" +
+	"\n" +
+	"rule_types\x18\x01 \x03(\v2\x1a.minder.v1.BundleDiffEntryR\truleTypes\x126\n" +
+	"\bprofiles\x18\x02 \x03(\v2\x1a.minder.v1.BundleDiffEntryR\bprofiles\x12=\n" +
+	"\fdata_sources\x18\x03 \x03(\v2\x1a.minder.v1.BundleDiffEntryR\vdataSources\"\xd2\x01\n" +
+	"\x0fBundleDiffEntry\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x129\n" +
+	"\x06change\x18\x02 \x01(\x0e2!.minder.v1.BundleDiffEntry.ChangeR\x06change\"p\n" +
+	"\x06Change\x12\x16\n" +
+	"\x12CHANGE_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fCHANGE_ADDED\x10\x01\x12\x12\n" +
+	"\x0eCHANGE_UPDATED\x10\x02\x12\x12\n" +
+	"\x0eCHANGE_REMOVED\x10\x03\x12\x14\n" +
+	"\x10CHANGE_UNCHANGED\x10\x04*b\n" +
 	"\vObjectOwner\x12\x1c\n" +
 	"\x18OBJECT_OWNER_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14OBJECT_OWNER_PROJECT\x10\x02\x12\x15\n" +
-	"\x11OBJECT_OWNER_USER\x10\x03\"\x04\b\x01\x10\x01*\xad\x11\n" +
+	"\x11OBJECT_OWNER_USER\x10\x03\"\x04\b\x01\x10\x01*\xcb\x14\n" +
 	"\bRelation\x12\x18\n" +
 	"\x14RELATION_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x0fRELATION_CREATE\x10\x01\x1a\n" +
@@ -15817,7 +20898,16 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"entity_get\x121\n" +
 	"\x18RELATION_ENTITY_REGISTER\x10+\x1a\x13\xea\xdc\x14\x0fentity_register\x12-\n" +
 	"\x16RELATION_ENTITY_UPDATE\x10,\x1a\x11\xea\xdc\x14\rentity_update\x12-\n" +
-	"\x16RELATION_ENTITY_DELETE\x10-\x1a\x11\xea\xdc\x14\rentity_delete*\x82\x01\n" +
+	"\x16RELATION_ENTITY_DELETE\x10-\x1a\x11\xea\xdc\x14\rentity_delete\x12/\n" +
+	"\x17RELATION_TRUST_ROOT_GET\x10.\x1a\x12\xea\xdc\x14\x0etrust_root_get\x125\n" +
+	"\x1aRELATION_TRUST_ROOT_CREATE\x10/\x1a\x15\xea\xdc\x14\x11trust_root_create\x125\n" +
+	"\x1aRELATION_TRUST_ROOT_UPDATE\x100\x1a\x15\xea\xdc\x14\x11trust_root_update\x125\n" +
+	"\x1aRELATION_TRUST_ROOT_DELETE\x101\x1a\x15\xea\xdc\x14\x11trust_root_delete\x12'\n" +
+	"\x13RELATION_BUNDLE_GET\x102\x1a\x0e\xea\xdc\x14\n" +
+	"bundle_get\x123\n" +
+	"\x19RELATION_BUNDLE_SUBSCRIBE\x103\x1a\x14\xea\xdc\x14\x10bundle_subscribe\x127\n" +
+	"\x1bRELATION_BUNDLE_UNSUBSCRIBE\x104\x1a\x16\xea\xdc\x14\x12bundle_unsubscribe\x12/\n" +
+	"\x17RELATION_BUNDLE_UPGRADE\x105\x1a\x12\xea\xdc\x14\x0ebundle_upgrade*\x82\x01\n" +
 	"\x0eTargetResource\x12\x1f\n" +
 	"\x1bTARGET_RESOURCE_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14TARGET_RESOURCE_NONE\x10\x01\x12\x18\n" +
@@ -15868,7 +20958,12 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x1dCREDENTIALS_STATE_UNSPECIFIED\x10\x00\x12\"\n" +
 	"\x15CREDENTIALS_STATE_SET\x10\x01\x1a\a\xea\xdc\x14\x03set\x12&\n" +
 	"\x17CREDENTIALS_STATE_UNSET\x10\x02\x1a\t\xea\xdc\x14\x05unset\x128\n" +
-	" CREDENTIALS_STATE_NOT_APPLICABLE\x10\x03\x1a\x12\xea\xdc\x14\x0enot_applicable2}\n" +
+	" CREDENTIALS_STATE_NOT_APPLICABLE\x10\x03\x1a\x12\xea\xdc\x14\x0enot_applicable*\xd7\x01\n" +
+	"\x14ProviderHealthStatus\x12&\n" +
+	"\"PROVIDER_HEALTH_STATUS_UNSPECIFIED\x10\x00\x12/\n" +
+	"\x1ePROVIDER_HEALTH_STATUS_HEALTHY\x10\x01\x1a\v\xea\xdc\x14\ahealthy\x121\n" +
+	"\x1fPROVIDER_HEALTH_STATUS_DEGRADED\x10\x02\x1a\f\xea\xdc\x14\bdegraded\x123\n" +
+	" PROVIDER_HEALTH_STATUS_UNHEALTHY\x10\x03\x1a\r\xea\xdc\x14\tunhealthy2}\n" +
 	"\rHealthService\x12l\n" +
 	"\vCheckHealth\x12\x1d.minder.v1.CheckHealthRequest\x1a\x1e.minder.v1.CheckHealthResponse\"\x1e\xaa\xf8\x18\x04\x10\x010\x01\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/health2\xbc\x03\n" +
 	"\x0fArtifactService\x12\x95\x01\n" +
@@ -15879,8 +20974,7 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x13GetAuthorizationURL\x12%.minder.v1.GetAuthorizationURLRequest\x1a&.minder.v1.GetAuthorizationURLResponse\" \xaa\xf8\x18\x040\x038\x17\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/auth/url\x12\xac\x01\n" +
 	"\x12StoreProviderToken\x12$.minder.v1.StoreProviderTokenRequest\x1a%.minder.v1.StoreProviderTokenResponse\"I\xaa\xf8\x18\x040\x038\x17\x82\xd3\xe4\x93\x02;:\x01*Z\x17:\x01*\"\x12/api/v1/auth/token\"\x1d/api/v1/auth/{provider}/token\x12\xd2\x01\n" +
 	"\x17VerifyProviderTokenFrom\x12).minder.v1.VerifyProviderTokenFromRequest\x1a*.minder.v1.VerifyProviderTokenFromResponse\"`\xaa\xf8\x18\x040\x038\x17\x82\xd3\xe4\x93\x02OZ!\x12\x1f/api/v1/auth/verify/{timestamp}\x12*/api/v1/auth/verify/{provider}/{timestamp}\x88\x02\x01\x12\x98\x01\n" +
-	"\x18VerifyProviderCredential\x12*.minder.v1.VerifyProviderCredentialRequest\x1a+.minder.v1.VerifyProviderCredentialResponse\"#\xaa\xf8\x18\x040\x038\x17\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/auth/verify2\xe9\n" +
-	"\n" +
+	"\x18VerifyProviderCredential\x12*.minder.v1.VerifyProviderCredentialRequest\x1a+.minder.v1.VerifyProviderCredentialResponse\"#\xaa\xf8\x18\x040\x038\x17\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/auth/verify2\xda\x10\n" +
 	"\x11RepositoryService\x12\xc7\x01\n" +
 	"\x12RegisterRepository\x12$.minder.v1.RegisterRepositoryRequest\x1a%.minder.v1.RegisterRepositoryResponse\"d\xaa\xf8\x18\x040\x038\n" +
 	"\x82\xd3\xe4\x93\x02V:\x01*Z :\x01*\"\x1b/api/v1/repository/register\"//api/v1/repository/provider/{provider}/register\x12\xf1\x01\n" +
@@ -15889,7 +20983,11 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x11GetRepositoryById\x12#.minder.v1.GetRepositoryByIdRequest\x1a$.minder.v1.GetRepositoryByIdResponse\"5\xaa\xf8\x18\x040\x038\t\x82\xd3\xe4\x93\x02'\x12%/api/v1/repository/id/{repository_id}\x12\xd0\x01\n" +
 	"\x13GetRepositoryByName\x12%.minder.v1.GetRepositoryByNameRequest\x1a&.minder.v1.GetRepositoryByNameResponse\"j\xaa\xf8\x18\x040\x038\t\x82\xd3\xe4\x93\x02\\Z#\x12!/api/v1/repository/name/{name=**}\x125/api/v1/repository/provider/{provider}/name/{name=**}\x12\x9e\x01\n" +
 	"\x14DeleteRepositoryById\x12&.minder.v1.DeleteRepositoryByIdRequest\x1a'.minder.v1.DeleteRepositoryByIdResponse\"5\xaa\xf8\x18\x040\x038\f\x82\xd3\xe4\x93\x02'*%/api/v1/repository/id/{repository_id}\x12\xd9\x01\n" +
-	"\x16DeleteRepositoryByName\x12(.minder.v1.DeleteRepositoryByNameRequest\x1a).minder.v1.DeleteRepositoryByNameResponse\"j\xaa\xf8\x18\x040\x038\f\x82\xd3\xe4\x93\x02\\Z#*!/api/v1/repository/name/{name=**}*5/api/v1/repository/provider/{provider}/name/{name=**}2\xce\x04\n" +
+	"\x16DeleteRepositoryByName\x12(.minder.v1.DeleteRepositoryByNameRequest\x1a).minder.v1.DeleteRepositoryByNameResponse\"j\xaa\xf8\x18\x040\x038\f\x82\xd3\xe4\x93\x02\\Z#*!/api/v1/repository/name/{name=**}*5/api/v1/repository/provider/{provider}/name/{name=**}\x12\xb9\x01\n" +
+	"\x1aCreateAutoRegistrationRule\x12,.minder.v1.CreateAutoRegistrationRuleRequest\x1a-.minder.v1.CreateAutoRegistrationRuleResponse\">\xaa\xf8\x18\x040\x038\v\x82\xd3\xe4\x93\x020:\x01*\"+/api/v1/repositories/auto_registration/rule\x12\xb4\x01\n" +
+	"\x19ListAutoRegistrationRules\x12+.minder.v1.ListAutoRegistrationRulesRequest\x1a,.minder.v1.ListAutoRegistrationRulesResponse\"<\xaa\xf8\x18\x040\x038\t\x82\xd3\xe4\x93\x02.\x12,/api/v1/repositories/auto_registration/rules\x12\xbd\x01\n" +
+	"\x1aDeleteAutoRegistrationRule\x12,.minder.v1.DeleteAutoRegistrationRuleRequest\x1a-.minder.v1.DeleteAutoRegistrationRuleResponse\"B\xaa\xf8\x18\x040\x038\v\x82\xd3\xe4\x93\x024*2/api/v1/repositories/auto_registration/rule/{name}\x12\xbb\x01\n" +
+	"\x19ReconcileAutoRegistration\x12+.minder.v1.ReconcileAutoRegistrationRequest\x1a,.minder.v1.ReconcileAutoRegistrationResponse\"C\xaa\xf8\x18\x040\x038\v\x82\xd3\xe4\x93\x025:\x01*\"0/api/v1/repositories/auto_registration/reconcile2\xce\x04\n" +
 	"\vUserService\x12h\n" +
 	"\n" +
 	"CreateUser\x12\x1c.minder.v1.CreateUserRequest\x1a\x1d.minder.v1.CreateUserResponse\"\x1d\xaa\xf8\x18\x020\x02\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/api/v1/user\x12e\n" +
@@ -15897,8 +20995,7 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"DeleteUser\x12\x1c.minder.v1.DeleteUserRequest\x1a\x1d.minder.v1.DeleteUserResponse\"\x1a\xaa\xf8\x18\x020\x02\x82\xd3\xe4\x93\x02\x0e*\f/api/v1/user\x12\\\n" +
 	"\aGetUser\x12\x19.minder.v1.GetUserRequest\x1a\x1a.minder.v1.GetUserResponse\"\x1a\xaa\xf8\x18\x020\x02\x82\xd3\xe4\x93\x02\x0e\x12\f/api/v1/user\x12\x80\x01\n" +
 	"\x0fListInvitations\x12!.minder.v1.ListInvitationsRequest\x1a\".minder.v1.ListInvitationsResponse\"&\xaa\xf8\x18\x020\x02\x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/user/invitations\x12\x8c\x01\n" +
-	"\x11ResolveInvitation\x12#.minder.v1.ResolveInvitationRequest\x1a$.minder.v1.ResolveInvitationResponse\",\xaa\xf8\x18\x020\x02\x82\xd3\xe4\x93\x02 \"\x1e/api/v1/user/invitation/{code}2\xd5\n" +
-	"\n" +
+	"\x11ResolveInvitation\x12#.minder.v1.ResolveInvitationRequest\x1a$.minder.v1.ResolveInvitationResponse\",\xaa\xf8\x18\x020\x02\x82\xd3\xe4\x93\x02 \"\x1e/api/v1/user/invitation/{code}2\xa0\x0f\n" +
 	"\x0eProfileService\x12v\n" +
 	"\rCreateProfile\x12\x1f.minder.v1.CreateProfileRequest\x1a .minder.v1.CreateProfileResponse\"\"\xaa\xf8\x18\x040\x038\x1e\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/v1/profile\x12v\n" +
 	"\rUpdateProfile\x12\x1f.minder.v1.UpdateProfileRequest\x1a .minder.v1.UpdateProfileResponse\"\"\xaa\xf8\x18\x040\x038\x1f\x82\xd3\xe4\x93\x02\x14:\x01*\x1a\x0f/api/v1/profile\x12|\n" +
@@ -15909,7 +21006,12 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x10GetProfileByName\x12\".minder.v1.GetProfileByNameRequest\x1a#.minder.v1.GetProfileByNameResponse\".\xaa\xf8\x18\x040\x038\x1d\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/profile/name/{name=**}\x12\xa4\x01\n" +
 	"\x16GetProfileStatusByName\x12(.minder.v1.GetProfileStatusByNameRequest\x1a).minder.v1.GetProfileStatusByNameResponse\"5\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02'\x12%/api/v1/profile/name/{name=**}/status\x12\x94\x01\n" +
 	"\x14GetProfileStatusById\x12&.minder.v1.GetProfileStatusByIdRequest\x1a'.minder.v1.GetProfileStatusByIdResponse\"+\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/profile/{id}/status\x12\x9e\x01\n" +
-	"\x19GetProfileStatusByProject\x12+.minder.v1.GetProfileStatusByProjectRequest\x1a,.minder.v1.GetProfileStatusByProjectResponse\"&\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/profile_status2\xfd\a\n" +
+	"\x19GetProfileStatusByProject\x12+.minder.v1.GetProfileStatusByProjectRequest\x1a,.minder.v1.GetProfileStatusByProjectResponse\"&\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/profile_status\x12r\n" +
+	"\fCreateWaiver\x12\x1e.minder.v1.CreateWaiverRequest\x1a\x1f.minder.v1.CreateWaiverResponse\"!\xaa\xf8\x18\x040\x038\x1f\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/api/v1/waiver\x12w\n" +
+	"\fUpdateWaiver\x12\x1e.minder.v1.UpdateWaiverRequest\x1a\x1f.minder.v1.UpdateWaiverResponse\"&\xaa\xf8\x18\x040\x038\x1f\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/api/v1/waiver/{id}\x12w\n" +
+	"\rGetWaiverById\x12\x1f.minder.v1.GetWaiverByIdRequest\x1a .minder.v1.GetWaiverByIdResponse\"#\xaa\xf8\x18\x040\x038\x1d\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/waiver/{id}\x12m\n" +
+	"\vListWaivers\x12\x1d.minder.v1.ListWaiversRequest\x1a\x1e.minder.v1.ListWaiversResponse\"\x1f\xaa\xf8\x18\x040\x038\x1d\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/waivers\x12t\n" +
+	"\fDeleteWaiver\x12\x1e.minder.v1.DeleteWaiverRequest\x1a\x1f.minder.v1.DeleteWaiverResponse\"#\xaa\xf8\x18\x040\x038\x1f\x82\xd3\xe4\x93\x02\x15*\x13/api/v1/waiver/{id}2\xfd\a\n" +
 	"\x11DataSourceService\x12\x83\x01\n" +
 	"\x10CreateDataSource\x12\".minder.v1.CreateDataSourceRequest\x1a#.minder.v1.CreateDataSourceResponse\"&\xaa\xf8\x18\x040\x038'\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/data_source\x12\x88\x01\n" +
 	"\x11GetDataSourceById\x12#.minder.v1.GetDataSourceByIdRequest\x1a$.minder.v1.GetDataSourceByIdResponse\"(\xaa\xf8\x18\x040\x038&\x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/data_source/{id}\x12\x98\x01\n" +
@@ -15917,18 +21019,33 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x0fListDataSources\x12!.minder.v1.ListDataSourcesRequest\x1a\".minder.v1.ListDataSourcesResponse\"$\xaa\xf8\x18\x040\x038&\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/data_sources\x12\x83\x01\n" +
 	"\x10UpdateDataSource\x12\".minder.v1.UpdateDataSourceRequest\x1a#.minder.v1.UpdateDataSourceResponse\"&\xaa\xf8\x18\x040\x038(\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/api/v1/data_source\x12\x91\x01\n" +
 	"\x14DeleteDataSourceById\x12&.minder.v1.DeleteDataSourceByIdRequest\x1a'.minder.v1.DeleteDataSourceByIdResponse\"(\xaa\xf8\x18\x040\x038)\x82\xd3\xe4\x93\x02\x1a*\x18/api/v1/data_source/{id}\x12\xa1\x01\n" +
-	"\x16DeleteDataSourceByName\x12(.minder.v1.DeleteDataSourceByNameRequest\x1a).minder.v1.DeleteDataSourceByNameResponse\"2\xaa\xf8\x18\x040\x038)\x82\xd3\xe4\x93\x02$*\"/api/v1/data_source/name/{name=**}2\x98\x06\n" +
+	"\x16DeleteDataSourceByName\x12(.minder.v1.DeleteDataSourceByNameRequest\x1a).minder.v1.DeleteDataSourceByNameResponse\"2\xaa\xf8\x18\x040\x038)\x82\xd3\xe4\x93\x02$*\"/api/v1/data_source/name/{name=**}2\xc7\x05\n" +
+	"\x10TrustRootService\x12\x7f\n" +
+	"\x0fCreateTrustRoot\x12!.minder.v1.CreateTrustRootRequest\x1a\".minder.v1.CreateTrustRootResponse\"%\xaa\xf8\x18\x040\x038/\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/trust_root\x12\x94\x01\n" +
+	"\x12GetTrustRootByName\x12$.minder.v1.GetTrustRootByNameRequest\x1a%.minder.v1.GetTrustRootByNameResponse\"1\xaa\xf8\x18\x040\x038.\x82\xd3\xe4\x93\x02#\x12!/api/v1/trust_root/name/{name=**}\x12z\n" +
+	"\x0eListTrustRoots\x12 .minder.v1.ListTrustRootsRequest\x1a!.minder.v1.ListTrustRootsResponse\"#\xaa\xf8\x18\x040\x038.\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/trust_roots\x12\x7f\n" +
+	"\x0fUpdateTrustRoot\x12!.minder.v1.UpdateTrustRootRequest\x1a\".minder.v1.UpdateTrustRootResponse\"%\xaa\xf8\x18\x040\x0380\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/api/v1/trust_root\x12\x9d\x01\n" +
+	"\x15DeleteTrustRootByName\x12'.minder.v1.DeleteTrustRootByNameRequest\x1a(.minder.v1.DeleteTrustRootByNameResponse\"1\xaa\xf8\x18\x040\x0381\x82\xd3\xe4\x93\x02#*!/api/v1/trust_root/name/{name=**}2\xe4\x05\n" +
+	"\x12MarketplaceService\x12m\n" +
+	"\vListBundles\x12\x1d.minder.v1.ListBundlesRequest\x1a\x1e.minder.v1.ListBundlesResponse\"\x1f\xaa\xf8\x18\x040\x0382\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/bundles\x12\x9f\x01\n" +
+	"\x17ListBundleSubscriptions\x12).minder.v1.ListBundleSubscriptionsRequest\x1a*.minder.v1.ListBundleSubscriptionsResponse\"-\xaa\xf8\x18\x040\x0382\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/bundles/subscriptions\x12\x8a\x01\n" +
+	"\x0fSubscribeBundle\x12!.minder.v1.SubscribeBundleRequest\x1a\".minder.v1.SubscribeBundleResponse\"0\xaa\xf8\x18\x040\x0383\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/bundles/subscriptions\x12\xa0\x01\n" +
+	"\x11UnsubscribeBundle\x12#.minder.v1.UnsubscribeBundleRequest\x1a$.minder.v1.UnsubscribeBundleResponse\"@\xaa\xf8\x18\x040\x0384\x82\xd3\xe4\x93\x022*0/api/v1/bundles/subscriptions/{namespace}/{name}\x12\x8c\x01\n" +
+	"\rUpgradeBundle\x12\x1f.minder.v1.UpgradeBundleRequest\x1a .minder.v1.UpgradeBundleResponse\"8\xaa\xf8\x18\x040\x0385\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/bundles/subscriptions/upgrade2\x98\x06\n" +
 	"\x0fRuleTypeService\x12v\n" +
 	"\rListRuleTypes\x12\x1f.minder.v1.ListRuleTypesRequest\x1a .minder.v1.ListRuleTypesResponse\"\"\xaa\xf8\x18\x040\x038\x19\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/rule_types\x12\x90\x01\n" +
 	"\x11GetRuleTypeByName\x12#.minder.v1.GetRuleTypeByNameRequest\x1a$.minder.v1.GetRuleTypeByNameResponse\"0\xaa\xf8\x18\x040\x038\x19\x82\xd3\xe4\x93\x02\"\x12 /api/v1/rule_type/name/{name=**}\x12\x80\x01\n" +
 	"\x0fGetRuleTypeById\x12!.minder.v1.GetRuleTypeByIdRequest\x1a\".minder.v1.GetRuleTypeByIdResponse\"&\xaa\xf8\x18\x040\x038\x19\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/rule_type/{id}\x12{\n" +
 	"\x0eCreateRuleType\x12 .minder.v1.CreateRuleTypeRequest\x1a!.minder.v1.CreateRuleTypeResponse\"$\xaa\xf8\x18\x040\x038\x1a\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/rule_type\x12{\n" +
 	"\x0eUpdateRuleType\x12 .minder.v1.UpdateRuleTypeRequest\x1a!.minder.v1.UpdateRuleTypeResponse\"$\xaa\xf8\x18\x040\x038\x1b\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/api/v1/rule_type\x12}\n" +
-	"\x0eDeleteRuleType\x12 .minder.v1.DeleteRuleTypeRequest\x1a!.minder.v1.DeleteRuleTypeResponse\"&\xaa\xf8\x18\x040\x038\x1c\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/rule_type/{id}2\xc0\x03\n" +
+	"\x0eDeleteRuleType\x12 .minder.v1.DeleteRuleTypeRequest\x1a!.minder.v1.DeleteRuleTypeResponse\"&\xaa\xf8\x18\x040\x038\x1c\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/rule_type/{id}2\xf8\x06\n" +
 	"\x12EvalResultsService\x12\x8b\x01\n" +
 	"\x15ListEvaluationResults\x12'.minder.v1.ListEvaluationResultsRequest\x1a(.minder.v1.ListEvaluationResultsResponse\"\x1f\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/results\x12\x8b\x01\n" +
 	"\x15ListEvaluationHistory\x12'.minder.v1.ListEvaluationHistoryRequest\x1a(.minder.v1.ListEvaluationHistoryResponse\"\x1f\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/history\x12\x8d\x01\n" +
-	"\x14GetEvaluationHistory\x12&.minder.v1.GetEvaluationHistoryRequest\x1a'.minder.v1.GetEvaluationHistoryResponse\"$\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/history/{id}2\x8a\x05\n" +
+	"\x14GetEvaluationHistory\x12&.minder.v1.GetEvaluationHistoryRequest\x1a'.minder.v1.GetEvaluationHistoryResponse\"$\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/history/{id}\x12\x84\x01\n" +
+	"\x10WatchEvaluations\x12\".minder.v1.WatchEvaluationsRequest\x1a#.minder.v1.WatchEvaluationsResponse\"%\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/history/watch0\x01\x12\x8c\x01\n" +
+	"\x11ExplainEvaluation\x12#.minder.v1.ExplainEvaluationRequest\x1a$.minder.v1.ExplainEvaluationResponse\",\xaa\xf8\x18\x040\x038\x1f\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/history/{id}/explain\x12\x9f\x01\n" +
+	"\x18GenerateComplianceReport\x12*.minder.v1.GenerateComplianceReportRequest\x1a+.minder.v1.GenerateComplianceReportResponse\"*\xaa\xf8\x18\x040\x038!\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/reports/compliance2\x8a\x05\n" +
 	"\x12PermissionsService\x12q\n" +
 	"\tListRoles\x12\x1b.minder.v1.ListRolesRequest\x1a\x1c.minder.v1.ListRolesResponse\")\xaa\xf8\x18\x040\x038\x05\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/permissions/roles\x12\x95\x01\n" +
 	"\x13ListRoleAssignments\x12%.minder.v1.ListRoleAssignmentsRequest\x1a&.minder.v1.ListRoleAssignmentsResponse\"/\xaa\xf8\x18\x040\x038\x06\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/permissions/assignments\x12x\n" +
@@ -15956,11 +21073,12 @@ const file_minder_v1_minder_proto_rawDesc = "" +
 	"\x13ListProviderClasses\x12%.minder.v1.ListProviderClassesRequest\x1a&.minder.v1.ListProviderClassesResponse\"(\xaa\xf8\x18\x040\x038\x15\x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/provider_classes\x12\xae\x01\n" +
 	"\x1bReconcileEntityRegistration\x12-.minder.v1.ReconcileEntityRegistrationRequest\x1a..minder.v1.ReconcileEntityRegistrationResponse\"0\xaa\xf8\x18\x040\x038$\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/provider/register_all2\x92\x01\n" +
 	"\rInviteService\x12\x80\x01\n" +
-	"\x10GetInviteDetails\x12\".minder.v1.GetInviteDetailsRequest\x1a#.minder.v1.GetInviteDetailsResponse\"#\xaa\xf8\x18\x020\x01\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/invite/{code}2\x99\x05\n" +
+	"\x10GetInviteDetails\x12\".minder.v1.GetInviteDetailsRequest\x1a#.minder.v1.GetInviteDetailsResponse\"#\xaa\xf8\x18\x020\x01\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/invite/{code}2\xc2\x06\n" +
 	"\x15EntityInstanceService\x12q\n" +
 	"\fListEntities\x12\x1e.minder.v1.ListEntitiesRequest\x1a\x1f.minder.v1.ListEntitiesResponse\" \xaa\xf8\x18\x040\x038*\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/entities\x12z\n" +
 	"\rGetEntityById\x12\x1f.minder.v1.GetEntityByIdRequest\x1a .minder.v1.GetEntityByIdResponse\"&\xaa\xf8\x18\x040\x038*\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/entity/id/{id}\x12\x90\x01\n" +
-	"\x0fGetEntityByName\x12!.minder.v1.GetEntityByNameRequest\x1a\".minder.v1.GetEntityByNameResponse\"6\xaa\xf8\x18\x040\x038*\x82\xd3\xe4\x93\x02(\x12&/api/v1/entity/{entity_type}/{name=**}\x12\x83\x01\n" +
+	"\x0fGetEntityByName\x12!.minder.v1.GetEntityByNameRequest\x1a\".minder.v1.GetEntityByNameResponse\"6\xaa\xf8\x18\x040\x038*\x82\xd3\xe4\x93\x02(\x12&/api/v1/entity/{entity_type}/{name=**}\x12\xa6\x01\n" +
+	"\x19ListEntityPropertyHistory\x12+.minder.v1.ListEntityPropertyHistoryRequest\x1a,.minder.v1.ListEntityPropertyHistoryResponse\".\xaa\xf8\x18\x040\x038*\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/entity/id/{id}/history\x12\x83\x01\n" +
 	"\x10DeleteEntityById\x12\".minder.v1.DeleteEntityByIdRequest\x1a#.minder.v1.DeleteEntityByIdResponse\"&\xaa\xf8\x18\x040\x038-\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/entity/id/{id}\x12x\n" +
 	"\x0eRegisterEntity\x12 .minder.v1.RegisterEntityRequest\x1a!.minder.v1.RegisterEntityResponse\"!\xaa\xf8\x18\x040\x038+\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/api/v1/entity::\n" +
 	"\x04name\x12!.google.protobuf.EnumValueOptions\x18\xcd\xcb\x02 \x01(\tR\x04name\x88\x01\x01:X\n" +
@@ -15979,697 +21097,913 @@ func file_minder_v1_minder_proto_rawDescGZIP() []byte {
 	return file_minder_v1_minder_proto_rawDescData
 }
 
-var file_minder_v1_minder_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
-var file_minder_v1_minder_proto_msgTypes = make([]protoimpl.MessageInfo, 240)
+var file_minder_v1_minder_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_minder_v1_minder_proto_msgTypes = make([]protoimpl.MessageInfo, 312)
 var file_minder_v1_minder_proto_goTypes = []any{
-	(ObjectOwner)(0),                                   // 0: minder.v1.ObjectOwner
-	(Relation)(0),                                      // 1: minder.v1.Relation
-	(TargetResource)(0),                                // 2: minder.v1.TargetResource
-	(Entity)(0),                                        // 3: minder.v1.Entity
-	(RuleTypeReleasePhase)(0),                          // 4: minder.v1.RuleTypeReleasePhase
-	(ProviderType)(0),                                  // 5: minder.v1.ProviderType
-	(ProviderClass)(0),                                 // 6: minder.v1.ProviderClass
-	(AuthorizationFlow)(0),                             // 7: minder.v1.AuthorizationFlow
-	(CredentialsState)(0),                              // 8: minder.v1.CredentialsState
-	(Severity_Value)(0),                                // 9: minder.v1.Severity.Value
-	(*RpcOptions)(nil),                                 // 10: minder.v1.RpcOptions
-	(*Cursor)(nil),                                     // 11: minder.v1.Cursor
-	(*CursorPage)(nil),                                 // 12: minder.v1.CursorPage
-	(*ListArtifactsRequest)(nil),                       // 13: minder.v1.ListArtifactsRequest
-	(*ListArtifactsResponse)(nil),                      // 14: minder.v1.ListArtifactsResponse
-	(*Artifact)(nil),                                   // 15: minder.v1.Artifact
-	(*ArtifactVersion)(nil),                            // 16: minder.v1.ArtifactVersion
-	(*GetArtifactByIdRequest)(nil),                     // 17: minder.v1.GetArtifactByIdRequest
-	(*GetArtifactByIdResponse)(nil),                    // 18: minder.v1.GetArtifactByIdResponse
-	(*GetArtifactByNameRequest)(nil),                   // 19: minder.v1.GetArtifactByNameRequest
-	(*GetArtifactByNameResponse)(nil),                  // 20: minder.v1.GetArtifactByNameResponse
-	(*Release)(nil),                                    // 21: minder.v1.Release
-	(*PipelineRun)(nil),                                // 22: minder.v1.PipelineRun
-	(*TaskRun)(nil),                                    // 23: minder.v1.TaskRun
-	(*Build)(nil),                                      // 24: minder.v1.Build
-	(*GetInviteDetailsRequest)(nil),                    // 25: minder.v1.GetInviteDetailsRequest
-	(*GetInviteDetailsResponse)(nil),                   // 26: minder.v1.GetInviteDetailsResponse
-	(*CheckHealthRequest)(nil),                         // 27: minder.v1.CheckHealthRequest
-	(*CheckHealthResponse)(nil),                        // 28: minder.v1.CheckHealthResponse
-	(*GetAuthorizationURLRequest)(nil),                 // 29: minder.v1.GetAuthorizationURLRequest
-	(*GetAuthorizationURLResponse)(nil),                // 30: minder.v1.GetAuthorizationURLResponse
-	(*StoreProviderTokenRequest)(nil),                  // 31: minder.v1.StoreProviderTokenRequest
-	(*StoreProviderTokenResponse)(nil),                 // 32: minder.v1.StoreProviderTokenResponse
-	(*Project)(nil),                                    // 33: minder.v1.Project
-	(*ListRemoteRepositoriesFromProviderRequest)(nil),  // 34: minder.v1.ListRemoteRepositoriesFromProviderRequest
-	(*ListRemoteRepositoriesFromProviderResponse)(nil), // 35: minder.v1.ListRemoteRepositoriesFromProviderResponse
-	(*RegistrableUpstreamEntityRef)(nil),               // 36: minder.v1.RegistrableUpstreamEntityRef
-	(*UpstreamRepositoryRef)(nil),                      // 37: minder.v1.UpstreamRepositoryRef
-	(*Repository)(nil),                                 // 38: minder.v1.Repository
-	(*RegisterRepositoryRequest)(nil),                  // 39: minder.v1.RegisterRepositoryRequest
-	(*RegisterRepoResult)(nil),                         // 40: minder.v1.RegisterRepoResult
-	(*RegisterRepositoryResponse)(nil),                 // 41: minder.v1.RegisterRepositoryResponse
-	(*GetRepositoryByIdRequest)(nil),                   // 42: minder.v1.GetRepositoryByIdRequest
-	(*GetRepositoryByIdResponse)(nil),                  // 43: minder.v1.GetRepositoryByIdResponse
-	(*DeleteRepositoryByIdRequest)(nil),                // 44: minder.v1.DeleteRepositoryByIdRequest
-	(*DeleteRepositoryByIdResponse)(nil),               // 45: minder.v1.DeleteRepositoryByIdResponse
-	(*GetRepositoryByNameRequest)(nil),                 // 46: minder.v1.GetRepositoryByNameRequest
-	(*GetRepositoryByNameResponse)(nil),                // 47: minder.v1.GetRepositoryByNameResponse
-	(*DeleteRepositoryByNameRequest)(nil),              // 48: minder.v1.DeleteRepositoryByNameRequest
-	(*DeleteRepositoryByNameResponse)(nil),             // 49: minder.v1.DeleteRepositoryByNameResponse
-	(*ListRepositoriesRequest)(nil),                    // 50: minder.v1.ListRepositoriesRequest
-	(*ListRepositoriesResponse)(nil),                   // 51: minder.v1.ListRepositoriesResponse
-	(*ReconcileEntityRegistrationRequest)(nil),         // 52: minder.v1.ReconcileEntityRegistrationRequest
-	(*ReconcileEntityRegistrationResponse)(nil),        // 53: minder.v1.ReconcileEntityRegistrationResponse
-	(*VerifyProviderTokenFromRequest)(nil),             // 54: minder.v1.VerifyProviderTokenFromRequest
-	(*VerifyProviderTokenFromResponse)(nil),            // 55: minder.v1.VerifyProviderTokenFromResponse
-	(*VerifyProviderCredentialRequest)(nil),            // 56: minder.v1.VerifyProviderCredentialRequest
-	(*VerifyProviderCredentialResponse)(nil),           // 57: minder.v1.VerifyProviderCredentialResponse
-	(*CreateUserRequest)(nil),                          // 58: minder.v1.CreateUserRequest
-	(*CreateUserResponse)(nil),                         // 59: minder.v1.CreateUserResponse
-	(*DeleteUserRequest)(nil),                          // 60: minder.v1.DeleteUserRequest
-	(*DeleteUserResponse)(nil),                         // 61: minder.v1.DeleteUserResponse
-	(*UserRecord)(nil),                                 // 62: minder.v1.UserRecord
-	(*ProjectRole)(nil),                                // 63: minder.v1.ProjectRole
-	(*GetUserRequest)(nil),                             // 64: minder.v1.GetUserRequest
-	(*GetUserResponse)(nil),                            // 65: minder.v1.GetUserResponse
-	(*CreateDataSourceRequest)(nil),                    // 66: minder.v1.CreateDataSourceRequest
-	(*CreateDataSourceResponse)(nil),                   // 67: minder.v1.CreateDataSourceResponse
-	(*GetDataSourceByIdRequest)(nil),                   // 68: minder.v1.GetDataSourceByIdRequest
-	(*GetDataSourceByIdResponse)(nil),                  // 69: minder.v1.GetDataSourceByIdResponse
-	(*GetDataSourceByNameRequest)(nil),                 // 70: minder.v1.GetDataSourceByNameRequest
-	(*GetDataSourceByNameResponse)(nil),                // 71: minder.v1.GetDataSourceByNameResponse
-	(*ListDataSourcesRequest)(nil),                     // 72: minder.v1.ListDataSourcesRequest
-	(*ListDataSourcesResponse)(nil),                    // 73: minder.v1.ListDataSourcesResponse
-	(*UpdateDataSourceRequest)(nil),                    // 74: minder.v1.UpdateDataSourceRequest
-	(*UpdateDataSourceResponse)(nil),                   // 75: minder.v1.UpdateDataSourceResponse
-	(*DeleteDataSourceByIdRequest)(nil),                // 76: minder.v1.DeleteDataSourceByIdRequest
-	(*DeleteDataSourceByIdResponse)(nil),               // 77: minder.v1.DeleteDataSourceByIdResponse
-	(*DeleteDataSourceByNameRequest)(nil),              // 78: minder.v1.DeleteDataSourceByNameRequest
-	(*DeleteDataSourceByNameResponse)(nil),             // 79: minder.v1.DeleteDataSourceByNameResponse
-	(*CreateProfileRequest)(nil),                       // 80: minder.v1.CreateProfileRequest
-	(*CreateProfileResponse)(nil),                      // 81: minder.v1.CreateProfileResponse
-	(*UpdateProfileRequest)(nil),                       // 82: minder.v1.UpdateProfileRequest
-	(*UpdateProfileResponse)(nil),                      // 83: minder.v1.UpdateProfileResponse
-	(*PatchProfileRequest)(nil),                        // 84: minder.v1.PatchProfileRequest
-	(*PatchProfileResponse)(nil),                       // 85: minder.v1.PatchProfileResponse
-	(*DeleteProfileRequest)(nil),                       // 86: minder.v1.DeleteProfileRequest
-	(*DeleteProfileResponse)(nil),                      // 87: minder.v1.DeleteProfileResponse
-	(*ListProfilesRequest)(nil),                        // 88: minder.v1.ListProfilesRequest
-	(*ListProfilesResponse)(nil),                       // 89: minder.v1.ListProfilesResponse
-	(*GetProfileByIdRequest)(nil),                      // 90: minder.v1.GetProfileByIdRequest
-	(*GetProfileByIdResponse)(nil),                     // 91: minder.v1.GetProfileByIdResponse
-	(*GetProfileByNameRequest)(nil),                    // 92: minder.v1.GetProfileByNameRequest
-	(*GetProfileByNameResponse)(nil),                   // 93: minder.v1.GetProfileByNameResponse
-	(*ProfileStatus)(nil),                              // 94: minder.v1.ProfileStatus
-	(*EvalResultAlert)(nil),                            // 95: minder.v1.EvalResultAlert
-	(*RuleEvaluationStatus)(nil),                       // 96: minder.v1.RuleEvaluationStatus
-	(*EntityTypedId)(nil),                              // 97: minder.v1.EntityTypedId
-	(*GetProfileStatusByNameRequest)(nil),              // 98: minder.v1.GetProfileStatusByNameRequest
-	(*GetProfileStatusByNameResponse)(nil),             // 99: minder.v1.GetProfileStatusByNameResponse
-	(*GetProfileStatusByIdRequest)(nil),                // 100: minder.v1.GetProfileStatusByIdRequest
-	(*GetProfileStatusByIdResponse)(nil),               // 101: minder.v1.GetProfileStatusByIdResponse
-	(*GetProfileStatusByProjectRequest)(nil),           // 102: minder.v1.GetProfileStatusByProjectRequest
-	(*GetProfileStatusByProjectResponse)(nil),          // 103: minder.v1.GetProfileStatusByProjectResponse
-	(*EntityAutoRegistrationConfig)(nil),               // 104: minder.v1.EntityAutoRegistrationConfig
-	(*AutoRegistration)(nil),                           // 105: minder.v1.AutoRegistration
-	(*ProviderConfig)(nil),                             // 106: minder.v1.ProviderConfig
-	(*RESTProviderConfig)(nil),                         // 107: minder.v1.RESTProviderConfig
-	(*GitHubProviderConfig)(nil),                       // 108: minder.v1.GitHubProviderConfig
-	(*GitHubAppProviderConfig)(nil),                    // 109: minder.v1.GitHubAppProviderConfig
-	(*GitLabProviderConfig)(nil),                       // 110: minder.v1.GitLabProviderConfig
-	(*DockerHubProviderConfig)(nil),                    // 111: minder.v1.DockerHubProviderConfig
-	(*GHCRProviderConfig)(nil),                         // 112: minder.v1.GHCRProviderConfig
-	(*Context)(nil),                                    // 113: minder.v1.Context
-	(*ContextV2)(nil),                                  // 114: minder.v1.ContextV2
-	(*ListRuleTypesRequest)(nil),                       // 115: minder.v1.ListRuleTypesRequest
-	(*ListRuleTypesResponse)(nil),                      // 116: minder.v1.ListRuleTypesResponse
-	(*GetRuleTypeByNameRequest)(nil),                   // 117: minder.v1.GetRuleTypeByNameRequest
-	(*GetRuleTypeByNameResponse)(nil),                  // 118: minder.v1.GetRuleTypeByNameResponse
-	(*GetRuleTypeByIdRequest)(nil),                     // 119: minder.v1.GetRuleTypeByIdRequest
-	(*GetRuleTypeByIdResponse)(nil),                    // 120: minder.v1.GetRuleTypeByIdResponse
-	(*CreateRuleTypeRequest)(nil),                      // 121: minder.v1.CreateRuleTypeRequest
-	(*CreateRuleTypeResponse)(nil),                     // 122: minder.v1.CreateRuleTypeResponse
-	(*UpdateRuleTypeRequest)(nil),                      // 123: minder.v1.UpdateRuleTypeRequest
-	(*UpdateRuleTypeResponse)(nil),                     // 124: minder.v1.UpdateRuleTypeResponse
-	(*DeleteRuleTypeRequest)(nil),                      // 125: minder.v1.DeleteRuleTypeRequest
-	(*DeleteRuleTypeResponse)(nil),                     // 126: minder.v1.DeleteRuleTypeResponse
-	(*ListEvaluationResultsRequest)(nil),               // 127: minder.v1.ListEvaluationResultsRequest
-	(*ListEvaluationResultsResponse)(nil),              // 128: minder.v1.ListEvaluationResultsResponse
-	(*RestType)(nil),                                   // 129: minder.v1.RestType
-	(*BuiltinType)(nil),                                // 130: minder.v1.BuiltinType
-	(*ArtifactType)(nil),                               // 131: minder.v1.ArtifactType
-	(*GitType)(nil),                                    // 132: minder.v1.GitType
-	(*DiffType)(nil),                                   // 133: minder.v1.DiffType
-	(*DepsType)(nil),                                   // 134: minder.v1.DepsType
-	(*Severity)(nil),                                   // 135: minder.v1.Severity
-	(*RuleType)(nil),                                   // 136: minder.v1.RuleType
-	(*Profile)(nil),                                    // 137: minder.v1.Profile
-	(*ListProjectsRequest)(nil),                        // 138: minder.v1.ListProjectsRequest
-	(*ListProjectsResponse)(nil),                       // 139: minder.v1.ListProjectsResponse
-	(*CreateProjectRequest)(nil),                       // 140: minder.v1.CreateProjectRequest
-	(*CreateProjectResponse)(nil),                      // 141: minder.v1.CreateProjectResponse
-	(*DeleteProjectRequest)(nil),                       // 142: minder.v1.DeleteProjectRequest
-	(*DeleteProjectResponse)(nil),                      // 143: minder.v1.DeleteProjectResponse
-	(*UpdateProjectRequest)(nil),                       // 144: minder.v1.UpdateProjectRequest
-	(*UpdateProjectResponse)(nil),                      // 145: minder.v1.UpdateProjectResponse
-	(*ProjectPatch)(nil),                               // 146: minder.v1.ProjectPatch
-	(*PatchProjectRequest)(nil),                        // 147: minder.v1.PatchProjectRequest
-	(*PatchProjectResponse)(nil),                       // 148: minder.v1.PatchProjectResponse
-	(*ListChildProjectsRequest)(nil),                   // 149: minder.v1.ListChildProjectsRequest
-	(*ListChildProjectsResponse)(nil),                  // 150: minder.v1.ListChildProjectsResponse
-	(*CreateEntityReconciliationTaskRequest)(nil),      // 151: minder.v1.CreateEntityReconciliationTaskRequest
-	(*CreateEntityReconciliationTaskResponse)(nil),     // 152: minder.v1.CreateEntityReconciliationTaskResponse
-	(*ListRolesRequest)(nil),                           // 153: minder.v1.ListRolesRequest
-	(*ListRolesResponse)(nil),                          // 154: minder.v1.ListRolesResponse
-	(*ListRoleAssignmentsRequest)(nil),                 // 155: minder.v1.ListRoleAssignmentsRequest
-	(*ListRoleAssignmentsResponse)(nil),                // 156: minder.v1.ListRoleAssignmentsResponse
-	(*AssignRoleRequest)(nil),                          // 157: minder.v1.AssignRoleRequest
-	(*AssignRoleResponse)(nil),                         // 158: minder.v1.AssignRoleResponse
-	(*UpdateRoleRequest)(nil),                          // 159: minder.v1.UpdateRoleRequest
-	(*UpdateRoleResponse)(nil),                         // 160: minder.v1.UpdateRoleResponse
-	(*RemoveRoleRequest)(nil),                          // 161: minder.v1.RemoveRoleRequest
-	(*RemoveRoleResponse)(nil),                         // 162: minder.v1.RemoveRoleResponse
-	(*Role)(nil),                                       // 163: minder.v1.Role
-	(*RoleAssignment)(nil),                             // 164: minder.v1.RoleAssignment
-	(*ListInvitationsRequest)(nil),                     // 165: minder.v1.ListInvitationsRequest
-	(*ListInvitationsResponse)(nil),                    // 166: minder.v1.ListInvitationsResponse
-	(*ResolveInvitationRequest)(nil),                   // 167: minder.v1.ResolveInvitationRequest
-	(*ResolveInvitationResponse)(nil),                  // 168: minder.v1.ResolveInvitationResponse
-	(*Invitation)(nil),                                 // 169: minder.v1.Invitation
-	(*GetProviderRequest)(nil),                         // 170: minder.v1.GetProviderRequest
-	(*GetProviderResponse)(nil),                        // 171: minder.v1.GetProviderResponse
-	(*ListProvidersRequest)(nil),                       // 172: minder.v1.ListProvidersRequest
-	(*ListProvidersResponse)(nil),                      // 173: minder.v1.ListProvidersResponse
-	(*CreateProviderRequest)(nil),                      // 174: minder.v1.CreateProviderRequest
-	(*CreateProviderResponse)(nil),                     // 175: minder.v1.CreateProviderResponse
-	(*DeleteProviderRequest)(nil),                      // 176: minder.v1.DeleteProviderRequest
-	(*DeleteProviderResponse)(nil),                     // 177: minder.v1.DeleteProviderResponse
-	(*DeleteProviderByIDRequest)(nil),                  // 178: minder.v1.DeleteProviderByIDRequest
-	(*DeleteProviderByIDResponse)(nil),                 // 179: minder.v1.DeleteProviderByIDResponse
-	(*ListProviderClassesRequest)(nil),                 // 180: minder.v1.ListProviderClassesRequest
-	(*ListProviderClassesResponse)(nil),                // 181: minder.v1.ListProviderClassesResponse
-	(*PatchProviderRequest)(nil),                       // 182: minder.v1.PatchProviderRequest
-	(*PatchProviderResponse)(nil),                      // 183: minder.v1.PatchProviderResponse
-	(*AuthorizationParams)(nil),                        // 184: minder.v1.AuthorizationParams
-	(*ProviderParameter)(nil),                          // 185: minder.v1.ProviderParameter
-	(*GitHubAppParams)(nil),                            // 186: minder.v1.GitHubAppParams
-	(*Provider)(nil),                                   // 187: minder.v1.Provider
-	(*GetEvaluationHistoryRequest)(nil),                // 188: minder.v1.GetEvaluationHistoryRequest
-	(*ListEvaluationHistoryRequest)(nil),               // 189: minder.v1.ListEvaluationHistoryRequest
-	(*GetEvaluationHistoryResponse)(nil),               // 190: minder.v1.GetEvaluationHistoryResponse
-	(*ListEvaluationHistoryResponse)(nil),              // 191: minder.v1.ListEvaluationHistoryResponse
-	(*EvaluationHistory)(nil),                          // 192: minder.v1.EvaluationHistory
-	(*EvaluationHistoryEntity)(nil),                    // 193: minder.v1.EvaluationHistoryEntity
-	(*EvaluationHistoryRule)(nil),                      // 194: minder.v1.EvaluationHistoryRule
-	(*EvaluationHistoryStatus)(nil),                    // 195: minder.v1.EvaluationHistoryStatus
-	(*EvaluationHistoryRemediation)(nil),               // 196: minder.v1.EvaluationHistoryRemediation
-	(*EvaluationHistoryAlert)(nil),                     // 197: minder.v1.EvaluationHistoryAlert
-	(*EntityInstance)(nil),                             // 198: minder.v1.EntityInstance
-	(*ListEntitiesRequest)(nil),                        // 199: minder.v1.ListEntitiesRequest
-	(*ListEntitiesResponse)(nil),                       // 200: minder.v1.ListEntitiesResponse
-	(*GetEntityByIdRequest)(nil),                       // 201: minder.v1.GetEntityByIdRequest
-	(*GetEntityByIdResponse)(nil),                      // 202: minder.v1.GetEntityByIdResponse
-	(*GetEntityByNameRequest)(nil),                     // 203: minder.v1.GetEntityByNameRequest
-	(*GetEntityByNameResponse)(nil),                    // 204: minder.v1.GetEntityByNameResponse
-	(*DeleteEntityByIdRequest)(nil),                    // 205: minder.v1.DeleteEntityByIdRequest
-	(*DeleteEntityByIdResponse)(nil),                   // 206: minder.v1.DeleteEntityByIdResponse
-	(*RegisterEntityRequest)(nil),                      // 207: minder.v1.RegisterEntityRequest
-	(*RegisterEntityResponse)(nil),                     // 208: minder.v1.RegisterEntityResponse
-	(*UpstreamEntityRef)(nil),                          // 209: minder.v1.UpstreamEntityRef
-	(*DataSource)(nil),                                 // 210: minder.v1.DataSource
-	(*StructDataSource)(nil),                           // 211: minder.v1.StructDataSource
-	(*RestDataSource)(nil),                             // 212: minder.v1.RestDataSource
-	(*DataSourceReference)(nil),                        // 213: minder.v1.DataSourceReference
-	(*RegisterRepoResult_Status)(nil),                  // 214: minder.v1.RegisterRepoResult.Status
-	nil,                                                // 215: minder.v1.RuleEvaluationStatus.EntityInfoEntry
-	nil,                                                // 216: minder.v1.AutoRegistration.EntitiesEntry
-	(*ListEvaluationResultsResponse_EntityProfileEvaluationResults)(nil), // 217: minder.v1.ListEvaluationResultsResponse.EntityProfileEvaluationResults
-	(*ListEvaluationResultsResponse_EntityEvaluationResults)(nil),        // 218: minder.v1.ListEvaluationResultsResponse.EntityEvaluationResults
-	(*RestType_Fallback)(nil),                                                              // 219: minder.v1.RestType.Fallback
-	(*DiffType_Ecosystem)(nil),                                                             // 220: minder.v1.DiffType.Ecosystem
-	(*DepsType_RepoConfigs)(nil),                                                           // 221: minder.v1.DepsType.RepoConfigs
-	(*DepsType_PullRequestConfigs)(nil),                                                    // 222: minder.v1.DepsType.PullRequestConfigs
-	(*RuleType_Definition)(nil),                                                            // 223: minder.v1.RuleType.Definition
-	(*RuleType_Definition_Ingest)(nil),                                                     // 224: minder.v1.RuleType.Definition.Ingest
-	(*RuleType_Definition_Eval)(nil),                                                       // 225: minder.v1.RuleType.Definition.Eval
-	(*RuleType_Definition_Remediate)(nil),                                                  // 226: minder.v1.RuleType.Definition.Remediate
-	(*RuleType_Definition_Alert)(nil),                                                      // 227: minder.v1.RuleType.Definition.Alert
-	(*RuleType_Definition_Eval_JQComparison)(nil),                                          // 228: minder.v1.RuleType.Definition.Eval.JQComparison
-	(*RuleType_Definition_Eval_Rego)(nil),                                                  // 229: minder.v1.RuleType.Definition.Eval.Rego
-	(*RuleType_Definition_Eval_Vulncheck)(nil),                                             // 230: minder.v1.RuleType.Definition.Eval.Vulncheck
-	(*RuleType_Definition_Eval_Trusty)(nil),                                                // 231: minder.v1.RuleType.Definition.Eval.Trusty
-	(*RuleType_Definition_Eval_Homoglyphs)(nil),                                            // 232: minder.v1.RuleType.Definition.Eval.Homoglyphs
-	(*RuleType_Definition_Eval_JQComparison_Operator)(nil),                                 // 233: minder.v1.RuleType.Definition.Eval.JQComparison.Operator
-	(*RuleType_Definition_Remediate_GhBranchProtectionType)(nil),                           // 234: minder.v1.RuleType.Definition.Remediate.GhBranchProtectionType
-	(*RuleType_Definition_Remediate_PullRequestRemediation)(nil),                           // 235: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation
-	(*RuleType_Definition_Remediate_PullRequestRemediation_Content)(nil),                   // 236: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.Content
-	(*RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha)(nil), // 237: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.ActionsReplaceTagsWithSha
-	(*RuleType_Definition_Alert_AlertTypeSA)(nil),                                          // 238: minder.v1.RuleType.Definition.Alert.AlertTypeSA
-	(*RuleType_Definition_Alert_AlertTypePRComment)(nil),                                   // 239: minder.v1.RuleType.Definition.Alert.AlertTypePRComment
-	(*Profile_Rule)(nil),                                                                   // 240: minder.v1.Profile.Rule
-	(*Profile_Selector)(nil),                                                               // 241: minder.v1.Profile.Selector
-	nil,                                                                                    // 242: minder.v1.RegisterEntityRequest.IdentifyingPropertiesEntry
-	(*StructDataSource_Def)(nil),                                                           // 243: minder.v1.StructDataSource.Def
-	nil,                                                                                    // 244: minder.v1.StructDataSource.DefEntry
-	(*StructDataSource_Def_Path)(nil),                                                      // 245: minder.v1.StructDataSource.Def.Path
-	(*RestDataSource_Def)(nil),                                                             // 246: minder.v1.RestDataSource.Def
-	nil,                                                                                    // 247: minder.v1.RestDataSource.DefEntry
-	nil,                                                                                    // 248: minder.v1.RestDataSource.Def.HeadersEntry
-	(*RestDataSource_Def_Fallback)(nil),                                                    // 249: minder.v1.RestDataSource.Def.Fallback
-	(*timestamppb.Timestamp)(nil),                                                          // 250: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),                                                                // 251: google.protobuf.Struct
-	(*fieldmaskpb.FieldMask)(nil),                                                          // 252: google.protobuf.FieldMask
-	(*structpb.Value)(nil),                                                                 // 253: google.protobuf.Value
-	(*descriptorpb.EnumValueOptions)(nil),                                                  // 254: google.protobuf.EnumValueOptions
-	(*descriptorpb.MethodOptions)(nil),                                                     // 255: google.protobuf.MethodOptions
+	(ObjectOwner)(0),                                                     // 0: minder.v1.ObjectOwner
+	(Relation)(0),                                                        // 1: minder.v1.Relation
+	(TargetResource)(0),                                                  // 2: minder.v1.TargetResource
+	(Entity)(0),                                                          // 3: minder.v1.Entity
+	(RuleTypeReleasePhase)(0),                                            // 4: minder.v1.RuleTypeReleasePhase
+	(ProviderType)(0),                                                    // 5: minder.v1.ProviderType
+	(ProviderClass)(0),                                                   // 6: minder.v1.ProviderClass
+	(AuthorizationFlow)(0),                                               // 7: minder.v1.AuthorizationFlow
+	(CredentialsState)(0),                                                // 8: minder.v1.CredentialsState
+	(ProviderHealthStatus)(0),                                            // 9: minder.v1.ProviderHealthStatus
+	(Severity_Value)(0),                                                  // 10: minder.v1.Severity.Value
+	(BundleDiffEntry_Change)(0),                                          // 11: minder.v1.BundleDiffEntry.Change
+	(*RpcOptions)(nil),                                                   // 12: minder.v1.RpcOptions
+	(*Cursor)(nil),                                                       // 13: minder.v1.Cursor
+	(*CursorPage)(nil),                                                   // 14: minder.v1.CursorPage
+	(*ListArtifactsRequest)(nil),                                         // 15: minder.v1.ListArtifactsRequest
+	(*ListArtifactsResponse)(nil),                                        // 16: minder.v1.ListArtifactsResponse
+	(*Artifact)(nil),                                                     // 17: minder.v1.Artifact
+	(*ArtifactVersion)(nil),                                              // 18: minder.v1.ArtifactVersion
+	(*GetArtifactByIdRequest)(nil),                                       // 19: minder.v1.GetArtifactByIdRequest
+	(*GetArtifactByIdResponse)(nil),                                      // 20: minder.v1.GetArtifactByIdResponse
+	(*GetArtifactByNameRequest)(nil),                                     // 21: minder.v1.GetArtifactByNameRequest
+	(*GetArtifactByNameResponse)(nil),                                    // 22: minder.v1.GetArtifactByNameResponse
+	(*Release)(nil),                                                      // 23: minder.v1.Release
+	(*PipelineRun)(nil),                                                  // 24: minder.v1.PipelineRun
+	(*TaskRun)(nil),                                                      // 25: minder.v1.TaskRun
+	(*Build)(nil),                                                        // 26: minder.v1.Build
+	(*GetInviteDetailsRequest)(nil),                                      // 27: minder.v1.GetInviteDetailsRequest
+	(*GetInviteDetailsResponse)(nil),                                     // 28: minder.v1.GetInviteDetailsResponse
+	(*CheckHealthRequest)(nil),                                           // 29: minder.v1.CheckHealthRequest
+	(*CheckHealthResponse)(nil),                                          // 30: minder.v1.CheckHealthResponse
+	(*GetAuthorizationURLRequest)(nil),                                   // 31: minder.v1.GetAuthorizationURLRequest
+	(*GetAuthorizationURLResponse)(nil),                                  // 32: minder.v1.GetAuthorizationURLResponse
+	(*StoreProviderTokenRequest)(nil),                                    // 33: minder.v1.StoreProviderTokenRequest
+	(*StoreProviderTokenResponse)(nil),                                   // 34: minder.v1.StoreProviderTokenResponse
+	(*Project)(nil),                                                      // 35: minder.v1.Project
+	(*ListRemoteRepositoriesFromProviderRequest)(nil),                    // 36: minder.v1.ListRemoteRepositoriesFromProviderRequest
+	(*ListRemoteRepositoriesFromProviderResponse)(nil),                   // 37: minder.v1.ListRemoteRepositoriesFromProviderResponse
+	(*RegistrableUpstreamEntityRef)(nil),                                 // 38: minder.v1.RegistrableUpstreamEntityRef
+	(*UpstreamRepositoryRef)(nil),                                        // 39: minder.v1.UpstreamRepositoryRef
+	(*Repository)(nil),                                                   // 40: minder.v1.Repository
+	(*RegisterRepositoryRequest)(nil),                                    // 41: minder.v1.RegisterRepositoryRequest
+	(*RegisterRepoResult)(nil),                                           // 42: minder.v1.RegisterRepoResult
+	(*RegisterRepositoryResponse)(nil),                                   // 43: minder.v1.RegisterRepositoryResponse
+	(*AutoRegistrationRule)(nil),                                         // 44: minder.v1.AutoRegistrationRule
+	(*CreateAutoRegistrationRuleRequest)(nil),                            // 45: minder.v1.CreateAutoRegistrationRuleRequest
+	(*CreateAutoRegistrationRuleResponse)(nil),                           // 46: minder.v1.CreateAutoRegistrationRuleResponse
+	(*ListAutoRegistrationRulesRequest)(nil),                             // 47: minder.v1.ListAutoRegistrationRulesRequest
+	(*ListAutoRegistrationRulesResponse)(nil),                            // 48: minder.v1.ListAutoRegistrationRulesResponse
+	(*DeleteAutoRegistrationRuleRequest)(nil),                            // 49: minder.v1.DeleteAutoRegistrationRuleRequest
+	(*DeleteAutoRegistrationRuleResponse)(nil),                           // 50: minder.v1.DeleteAutoRegistrationRuleResponse
+	(*ReconcileAutoRegistrationRequest)(nil),                             // 51: minder.v1.ReconcileAutoRegistrationRequest
+	(*ReconcileAutoRegistrationResponse)(nil),                            // 52: minder.v1.ReconcileAutoRegistrationResponse
+	(*AutoRegistrationReport)(nil),                                       // 53: minder.v1.AutoRegistrationReport
+	(*GetRepositoryByIdRequest)(nil),                                     // 54: minder.v1.GetRepositoryByIdRequest
+	(*GetRepositoryByIdResponse)(nil),                                    // 55: minder.v1.GetRepositoryByIdResponse
+	(*DeleteRepositoryByIdRequest)(nil),                                  // 56: minder.v1.DeleteRepositoryByIdRequest
+	(*DeleteRepositoryByIdResponse)(nil),                                 // 57: minder.v1.DeleteRepositoryByIdResponse
+	(*GetRepositoryByNameRequest)(nil),                                   // 58: minder.v1.GetRepositoryByNameRequest
+	(*GetRepositoryByNameResponse)(nil),                                  // 59: minder.v1.GetRepositoryByNameResponse
+	(*DeleteRepositoryByNameRequest)(nil),                                // 60: minder.v1.DeleteRepositoryByNameRequest
+	(*DeleteRepositoryByNameResponse)(nil),                               // 61: minder.v1.DeleteRepositoryByNameResponse
+	(*ListRepositoriesRequest)(nil),                                      // 62: minder.v1.ListRepositoriesRequest
+	(*ListRepositoriesResponse)(nil),                                     // 63: minder.v1.ListRepositoriesResponse
+	(*ReconcileEntityRegistrationRequest)(nil),                           // 64: minder.v1.ReconcileEntityRegistrationRequest
+	(*ReconcileEntityRegistrationResponse)(nil),                          // 65: minder.v1.ReconcileEntityRegistrationResponse
+	(*VerifyProviderTokenFromRequest)(nil),                               // 66: minder.v1.VerifyProviderTokenFromRequest
+	(*VerifyProviderTokenFromResponse)(nil),                              // 67: minder.v1.VerifyProviderTokenFromResponse
+	(*VerifyProviderCredentialRequest)(nil),                              // 68: minder.v1.VerifyProviderCredentialRequest
+	(*VerifyProviderCredentialResponse)(nil),                             // 69: minder.v1.VerifyProviderCredentialResponse
+	(*CreateUserRequest)(nil),                                            // 70: minder.v1.CreateUserRequest
+	(*CreateUserResponse)(nil),                                           // 71: minder.v1.CreateUserResponse
+	(*DeleteUserRequest)(nil),                                            // 72: minder.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),                                           // 73: minder.v1.DeleteUserResponse
+	(*UserRecord)(nil),                                                   // 74: minder.v1.UserRecord
+	(*ProjectRole)(nil),                                                  // 75: minder.v1.ProjectRole
+	(*GetUserRequest)(nil),                                               // 76: minder.v1.GetUserRequest
+	(*GetUserResponse)(nil),                                              // 77: minder.v1.GetUserResponse
+	(*CreateDataSourceRequest)(nil),                                      // 78: minder.v1.CreateDataSourceRequest
+	(*CreateDataSourceResponse)(nil),                                     // 79: minder.v1.CreateDataSourceResponse
+	(*GetDataSourceByIdRequest)(nil),                                     // 80: minder.v1.GetDataSourceByIdRequest
+	(*GetDataSourceByIdResponse)(nil),                                    // 81: minder.v1.GetDataSourceByIdResponse
+	(*GetDataSourceByNameRequest)(nil),                                   // 82: minder.v1.GetDataSourceByNameRequest
+	(*GetDataSourceByNameResponse)(nil),                                  // 83: minder.v1.GetDataSourceByNameResponse
+	(*ListDataSourcesRequest)(nil),                                       // 84: minder.v1.ListDataSourcesRequest
+	(*ListDataSourcesResponse)(nil),                                      // 85: minder.v1.ListDataSourcesResponse
+	(*UpdateDataSourceRequest)(nil),                                      // 86: minder.v1.UpdateDataSourceRequest
+	(*UpdateDataSourceResponse)(nil),                                     // 87: minder.v1.UpdateDataSourceResponse
+	(*DeleteDataSourceByIdRequest)(nil),                                  // 88: minder.v1.DeleteDataSourceByIdRequest
+	(*DeleteDataSourceByIdResponse)(nil),                                 // 89: minder.v1.DeleteDataSourceByIdResponse
+	(*DeleteDataSourceByNameRequest)(nil),                                // 90: minder.v1.DeleteDataSourceByNameRequest
+	(*DeleteDataSourceByNameResponse)(nil),                               // 91: minder.v1.DeleteDataSourceByNameResponse
+	(*CreateTrustRootRequest)(nil),                                       // 92: minder.v1.CreateTrustRootRequest
+	(*CreateTrustRootResponse)(nil),                                      // 93: minder.v1.CreateTrustRootResponse
+	(*GetTrustRootByNameRequest)(nil),                                    // 94: minder.v1.GetTrustRootByNameRequest
+	(*GetTrustRootByNameResponse)(nil),                                   // 95: minder.v1.GetTrustRootByNameResponse
+	(*ListTrustRootsRequest)(nil),                                        // 96: minder.v1.ListTrustRootsRequest
+	(*ListTrustRootsResponse)(nil),                                       // 97: minder.v1.ListTrustRootsResponse
+	(*UpdateTrustRootRequest)(nil),                                       // 98: minder.v1.UpdateTrustRootRequest
+	(*UpdateTrustRootResponse)(nil),                                      // 99: minder.v1.UpdateTrustRootResponse
+	(*DeleteTrustRootByNameRequest)(nil),                                 // 100: minder.v1.DeleteTrustRootByNameRequest
+	(*DeleteTrustRootByNameResponse)(nil),                                // 101: minder.v1.DeleteTrustRootByNameResponse
+	(*ListBundlesRequest)(nil),                                           // 102: minder.v1.ListBundlesRequest
+	(*ListBundlesResponse)(nil),                                          // 103: minder.v1.ListBundlesResponse
+	(*ListBundleSubscriptionsRequest)(nil),                               // 104: minder.v1.ListBundleSubscriptionsRequest
+	(*ListBundleSubscriptionsResponse)(nil),                              // 105: minder.v1.ListBundleSubscriptionsResponse
+	(*SubscribeBundleRequest)(nil),                                       // 106: minder.v1.SubscribeBundleRequest
+	(*SubscribeBundleResponse)(nil),                                      // 107: minder.v1.SubscribeBundleResponse
+	(*UnsubscribeBundleRequest)(nil),                                     // 108: minder.v1.UnsubscribeBundleRequest
+	(*UnsubscribeBundleResponse)(nil),                                    // 109: minder.v1.UnsubscribeBundleResponse
+	(*UpgradeBundleRequest)(nil),                                         // 110: minder.v1.UpgradeBundleRequest
+	(*UpgradeBundleResponse)(nil),                                        // 111: minder.v1.UpgradeBundleResponse
+	(*CreateProfileRequest)(nil),                                         // 112: minder.v1.CreateProfileRequest
+	(*CreateProfileResponse)(nil),                                        // 113: minder.v1.CreateProfileResponse
+	(*UpdateProfileRequest)(nil),                                         // 114: minder.v1.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),                                        // 115: minder.v1.UpdateProfileResponse
+	(*PatchProfileRequest)(nil),                                          // 116: minder.v1.PatchProfileRequest
+	(*PatchProfileResponse)(nil),                                         // 117: minder.v1.PatchProfileResponse
+	(*DeleteProfileRequest)(nil),                                         // 118: minder.v1.DeleteProfileRequest
+	(*DeleteProfileResponse)(nil),                                        // 119: minder.v1.DeleteProfileResponse
+	(*ListProfilesRequest)(nil),                                          // 120: minder.v1.ListProfilesRequest
+	(*ListProfilesResponse)(nil),                                         // 121: minder.v1.ListProfilesResponse
+	(*GetProfileByIdRequest)(nil),                                        // 122: minder.v1.GetProfileByIdRequest
+	(*GetProfileByIdResponse)(nil),                                       // 123: minder.v1.GetProfileByIdResponse
+	(*GetProfileByNameRequest)(nil),                                      // 124: minder.v1.GetProfileByNameRequest
+	(*GetProfileByNameResponse)(nil),                                     // 125: minder.v1.GetProfileByNameResponse
+	(*ProfileStatus)(nil),                                                // 126: minder.v1.ProfileStatus
+	(*EvalResultAlert)(nil),                                              // 127: minder.v1.EvalResultAlert
+	(*RuleEvaluationStatus)(nil),                                         // 128: minder.v1.RuleEvaluationStatus
+	(*EntityTypedId)(nil),                                                // 129: minder.v1.EntityTypedId
+	(*GetProfileStatusByNameRequest)(nil),                                // 130: minder.v1.GetProfileStatusByNameRequest
+	(*GetProfileStatusByNameResponse)(nil),                               // 131: minder.v1.GetProfileStatusByNameResponse
+	(*GetProfileStatusByIdRequest)(nil),                                  // 132: minder.v1.GetProfileStatusByIdRequest
+	(*GetProfileStatusByIdResponse)(nil),                                 // 133: minder.v1.GetProfileStatusByIdResponse
+	(*GetProfileStatusByProjectRequest)(nil),                             // 134: minder.v1.GetProfileStatusByProjectRequest
+	(*GetProfileStatusByProjectResponse)(nil),                            // 135: minder.v1.GetProfileStatusByProjectResponse
+	(*Waiver)(nil),                                                       // 136: minder.v1.Waiver
+	(*CreateWaiverRequest)(nil),                                          // 137: minder.v1.CreateWaiverRequest
+	(*CreateWaiverResponse)(nil),                                         // 138: minder.v1.CreateWaiverResponse
+	(*UpdateWaiverRequest)(nil),                                          // 139: minder.v1.UpdateWaiverRequest
+	(*UpdateWaiverResponse)(nil),                                         // 140: minder.v1.UpdateWaiverResponse
+	(*GetWaiverByIdRequest)(nil),                                         // 141: minder.v1.GetWaiverByIdRequest
+	(*GetWaiverByIdResponse)(nil),                                        // 142: minder.v1.GetWaiverByIdResponse
+	(*ListWaiversRequest)(nil),                                           // 143: minder.v1.ListWaiversRequest
+	(*ListWaiversResponse)(nil),                                          // 144: minder.v1.ListWaiversResponse
+	(*DeleteWaiverRequest)(nil),                                          // 145: minder.v1.DeleteWaiverRequest
+	(*DeleteWaiverResponse)(nil),                                         // 146: minder.v1.DeleteWaiverResponse
+	(*EntityAutoRegistrationConfig)(nil),                                 // 147: minder.v1.EntityAutoRegistrationConfig
+	(*AutoRegistration)(nil),                                             // 148: minder.v1.AutoRegistration
+	(*ProviderConfig)(nil),                                               // 149: minder.v1.ProviderConfig
+	(*RESTProviderConfig)(nil),                                           // 150: minder.v1.RESTProviderConfig
+	(*GitHubProviderConfig)(nil),                                         // 151: minder.v1.GitHubProviderConfig
+	(*GitHubAppProviderConfig)(nil),                                      // 152: minder.v1.GitHubAppProviderConfig
+	(*GitLabProviderConfig)(nil),                                         // 153: minder.v1.GitLabProviderConfig
+	(*AzureDevOpsProviderConfig)(nil),                                    // 154: minder.v1.AzureDevOpsProviderConfig
+	(*DockerHubProviderConfig)(nil),                                      // 155: minder.v1.DockerHubProviderConfig
+	(*GHCRProviderConfig)(nil),                                           // 156: minder.v1.GHCRProviderConfig
+	(*Context)(nil),                                                      // 157: minder.v1.Context
+	(*ContextV2)(nil),                                                    // 158: minder.v1.ContextV2
+	(*ListRuleTypesRequest)(nil),                                         // 159: minder.v1.ListRuleTypesRequest
+	(*ListRuleTypesResponse)(nil),                                        // 160: minder.v1.ListRuleTypesResponse
+	(*GetRuleTypeByNameRequest)(nil),                                     // 161: minder.v1.GetRuleTypeByNameRequest
+	(*GetRuleTypeByNameResponse)(nil),                                    // 162: minder.v1.GetRuleTypeByNameResponse
+	(*GetRuleTypeByIdRequest)(nil),                                       // 163: minder.v1.GetRuleTypeByIdRequest
+	(*GetRuleTypeByIdResponse)(nil),                                      // 164: minder.v1.GetRuleTypeByIdResponse
+	(*CreateRuleTypeRequest)(nil),                                        // 165: minder.v1.CreateRuleTypeRequest
+	(*CreateRuleTypeResponse)(nil),                                       // 166: minder.v1.CreateRuleTypeResponse
+	(*UpdateRuleTypeRequest)(nil),                                        // 167: minder.v1.UpdateRuleTypeRequest
+	(*UpdateRuleTypeResponse)(nil),                                       // 168: minder.v1.UpdateRuleTypeResponse
+	(*RuleMigration)(nil),                                                // 169: minder.v1.RuleMigration
+	(*DeleteRuleTypeRequest)(nil),                                        // 170: minder.v1.DeleteRuleTypeRequest
+	(*DeleteRuleTypeResponse)(nil),                                       // 171: minder.v1.DeleteRuleTypeResponse
+	(*ListEvaluationResultsRequest)(nil),                                 // 172: minder.v1.ListEvaluationResultsRequest
+	(*ListEvaluationResultsResponse)(nil),                                // 173: minder.v1.ListEvaluationResultsResponse
+	(*RestType)(nil),                                                     // 174: minder.v1.RestType
+	(*BuiltinType)(nil),                                                  // 175: minder.v1.BuiltinType
+	(*ArtifactType)(nil),                                                 // 176: minder.v1.ArtifactType
+	(*GitType)(nil),                                                      // 177: minder.v1.GitType
+	(*DiffType)(nil),                                                     // 178: minder.v1.DiffType
+	(*DepsType)(nil),                                                     // 179: minder.v1.DepsType
+	(*AlertsType)(nil),                                                   // 180: minder.v1.AlertsType
+	(*Severity)(nil),                                                     // 181: minder.v1.Severity
+	(*RuleType)(nil),                                                     // 182: minder.v1.RuleType
+	(*Profile)(nil),                                                      // 183: minder.v1.Profile
+	(*ListProjectsRequest)(nil),                                          // 184: minder.v1.ListProjectsRequest
+	(*ListProjectsResponse)(nil),                                         // 185: minder.v1.ListProjectsResponse
+	(*CreateProjectRequest)(nil),                                         // 186: minder.v1.CreateProjectRequest
+	(*CreateProjectResponse)(nil),                                        // 187: minder.v1.CreateProjectResponse
+	(*DeleteProjectRequest)(nil),                                         // 188: minder.v1.DeleteProjectRequest
+	(*DeleteProjectResponse)(nil),                                        // 189: minder.v1.DeleteProjectResponse
+	(*UpdateProjectRequest)(nil),                                         // 190: minder.v1.UpdateProjectRequest
+	(*UpdateProjectResponse)(nil),                                        // 191: minder.v1.UpdateProjectResponse
+	(*ProjectPatch)(nil),                                                 // 192: minder.v1.ProjectPatch
+	(*PatchProjectRequest)(nil),                                          // 193: minder.v1.PatchProjectRequest
+	(*PatchProjectResponse)(nil),                                         // 194: minder.v1.PatchProjectResponse
+	(*ListChildProjectsRequest)(nil),                                     // 195: minder.v1.ListChildProjectsRequest
+	(*ListChildProjectsResponse)(nil),                                    // 196: minder.v1.ListChildProjectsResponse
+	(*CreateEntityReconciliationTaskRequest)(nil),                        // 197: minder.v1.CreateEntityReconciliationTaskRequest
+	(*CreateEntityReconciliationTaskResponse)(nil),                       // 198: minder.v1.CreateEntityReconciliationTaskResponse
+	(*ListRolesRequest)(nil),                                             // 199: minder.v1.ListRolesRequest
+	(*ListRolesResponse)(nil),                                            // 200: minder.v1.ListRolesResponse
+	(*ListRoleAssignmentsRequest)(nil),                                   // 201: minder.v1.ListRoleAssignmentsRequest
+	(*ListRoleAssignmentsResponse)(nil),                                  // 202: minder.v1.ListRoleAssignmentsResponse
+	(*AssignRoleRequest)(nil),                                            // 203: minder.v1.AssignRoleRequest
+	(*AssignRoleResponse)(nil),                                           // 204: minder.v1.AssignRoleResponse
+	(*UpdateRoleRequest)(nil),                                            // 205: minder.v1.UpdateRoleRequest
+	(*UpdateRoleResponse)(nil),                                           // 206: minder.v1.UpdateRoleResponse
+	(*RemoveRoleRequest)(nil),                                            // 207: minder.v1.RemoveRoleRequest
+	(*RemoveRoleResponse)(nil),                                           // 208: minder.v1.RemoveRoleResponse
+	(*Role)(nil),                                                         // 209: minder.v1.Role
+	(*RoleAssignment)(nil),                                               // 210: minder.v1.RoleAssignment
+	(*ListInvitationsRequest)(nil),                                       // 211: minder.v1.ListInvitationsRequest
+	(*ListInvitationsResponse)(nil),                                      // 212: minder.v1.ListInvitationsResponse
+	(*ResolveInvitationRequest)(nil),                                     // 213: minder.v1.ResolveInvitationRequest
+	(*ResolveInvitationResponse)(nil),                                    // 214: minder.v1.ResolveInvitationResponse
+	(*Invitation)(nil),                                                   // 215: minder.v1.Invitation
+	(*GetProviderRequest)(nil),                                           // 216: minder.v1.GetProviderRequest
+	(*GetProviderResponse)(nil),                                          // 217: minder.v1.GetProviderResponse
+	(*ListProvidersRequest)(nil),                                         // 218: minder.v1.ListProvidersRequest
+	(*ListProvidersResponse)(nil),                                        // 219: minder.v1.ListProvidersResponse
+	(*CreateProviderRequest)(nil),                                        // 220: minder.v1.CreateProviderRequest
+	(*CreateProviderResponse)(nil),                                       // 221: minder.v1.CreateProviderResponse
+	(*DeleteProviderRequest)(nil),                                        // 222: minder.v1.DeleteProviderRequest
+	(*DeleteProviderResponse)(nil),                                       // 223: minder.v1.DeleteProviderResponse
+	(*DeleteProviderByIDRequest)(nil),                                    // 224: minder.v1.DeleteProviderByIDRequest
+	(*DeleteProviderByIDResponse)(nil),                                   // 225: minder.v1.DeleteProviderByIDResponse
+	(*ListProviderClassesRequest)(nil),                                   // 226: minder.v1.ListProviderClassesRequest
+	(*ListProviderClassesResponse)(nil),                                  // 227: minder.v1.ListProviderClassesResponse
+	(*PatchProviderRequest)(nil),                                         // 228: minder.v1.PatchProviderRequest
+	(*PatchProviderResponse)(nil),                                        // 229: minder.v1.PatchProviderResponse
+	(*AuthorizationParams)(nil),                                          // 230: minder.v1.AuthorizationParams
+	(*ProviderParameter)(nil),                                            // 231: minder.v1.ProviderParameter
+	(*GitHubAppParams)(nil),                                              // 232: minder.v1.GitHubAppParams
+	(*Provider)(nil),                                                     // 233: minder.v1.Provider
+	(*ProviderHealth)(nil),                                               // 234: minder.v1.ProviderHealth
+	(*GetEvaluationHistoryRequest)(nil),                                  // 235: minder.v1.GetEvaluationHistoryRequest
+	(*ListEvaluationHistoryRequest)(nil),                                 // 236: minder.v1.ListEvaluationHistoryRequest
+	(*GetEvaluationHistoryResponse)(nil),                                 // 237: minder.v1.GetEvaluationHistoryResponse
+	(*WatchEvaluationsRequest)(nil),                                      // 238: minder.v1.WatchEvaluationsRequest
+	(*WatchEvaluationsResponse)(nil),                                     // 239: minder.v1.WatchEvaluationsResponse
+	(*ExplainEvaluationRequest)(nil),                                     // 240: minder.v1.ExplainEvaluationRequest
+	(*ExplainEvaluationResponse)(nil),                                    // 241: minder.v1.ExplainEvaluationResponse
+	(*EvaluationExplanation)(nil),                                        // 242: minder.v1.EvaluationExplanation
+	(*GenerateComplianceReportRequest)(nil),                              // 243: minder.v1.GenerateComplianceReportRequest
+	(*GenerateComplianceReportResponse)(nil),                             // 244: minder.v1.GenerateComplianceReportResponse
+	(*ComplianceReport)(nil),                                             // 245: minder.v1.ComplianceReport
+	(*ListEvaluationHistoryResponse)(nil),                                // 246: minder.v1.ListEvaluationHistoryResponse
+	(*EvaluationHistory)(nil),                                            // 247: minder.v1.EvaluationHistory
+	(*EvaluationHistoryEntity)(nil),                                      // 248: minder.v1.EvaluationHistoryEntity
+	(*EvaluationHistoryRule)(nil),                                        // 249: minder.v1.EvaluationHistoryRule
+	(*EvaluationHistoryStatus)(nil),                                      // 250: minder.v1.EvaluationHistoryStatus
+	(*EvaluationHistoryRemediation)(nil),                                 // 251: minder.v1.EvaluationHistoryRemediation
+	(*EvaluationHistoryAlert)(nil),                                       // 252: minder.v1.EvaluationHistoryAlert
+	(*EntityInstance)(nil),                                               // 253: minder.v1.EntityInstance
+	(*ListEntitiesRequest)(nil),                                          // 254: minder.v1.ListEntitiesRequest
+	(*ListEntitiesResponse)(nil),                                         // 255: minder.v1.ListEntitiesResponse
+	(*GetEntityByIdRequest)(nil),                                         // 256: minder.v1.GetEntityByIdRequest
+	(*GetEntityByIdResponse)(nil),                                        // 257: minder.v1.GetEntityByIdResponse
+	(*GetEntityByNameRequest)(nil),                                       // 258: minder.v1.GetEntityByNameRequest
+	(*GetEntityByNameResponse)(nil),                                      // 259: minder.v1.GetEntityByNameResponse
+	(*ListEntityPropertyHistoryRequest)(nil),                             // 260: minder.v1.ListEntityPropertyHistoryRequest
+	(*EntityPropertyChange)(nil),                                         // 261: minder.v1.EntityPropertyChange
+	(*ListEntityPropertyHistoryResponse)(nil),                            // 262: minder.v1.ListEntityPropertyHistoryResponse
+	(*DeleteEntityByIdRequest)(nil),                                      // 263: minder.v1.DeleteEntityByIdRequest
+	(*DeleteEntityByIdResponse)(nil),                                     // 264: minder.v1.DeleteEntityByIdResponse
+	(*RegisterEntityRequest)(nil),                                        // 265: minder.v1.RegisterEntityRequest
+	(*RegisterEntityResponse)(nil),                                       // 266: minder.v1.RegisterEntityResponse
+	(*UpstreamEntityRef)(nil),                                            // 267: minder.v1.UpstreamEntityRef
+	(*DataSource)(nil),                                                   // 268: minder.v1.DataSource
+	(*StructDataSource)(nil),                                             // 269: minder.v1.StructDataSource
+	(*RestDataSource)(nil),                                               // 270: minder.v1.RestDataSource
+	(*DataSourceReference)(nil),                                          // 271: minder.v1.DataSourceReference
+	(*TrustRoot)(nil),                                                    // 272: minder.v1.TrustRoot
+	(*BundleInfo)(nil),                                                   // 273: minder.v1.BundleInfo
+	(*BundleSubscription)(nil),                                           // 274: minder.v1.BundleSubscription
+	(*BundleDiff)(nil),                                                   // 275: minder.v1.BundleDiff
+	(*BundleDiffEntry)(nil),                                              // 276: minder.v1.BundleDiffEntry
+	(*RegisterRepoResult_Status)(nil),                                    // 277: minder.v1.RegisterRepoResult.Status
+	(*AutoRegistrationReport_Change)(nil),                                // 278: minder.v1.AutoRegistrationReport.Change
+	nil,                                                                  // 279: minder.v1.RuleEvaluationStatus.EntityInfoEntry
+	nil,                                                                  // 280: minder.v1.AutoRegistration.EntitiesEntry
+	(*ListEvaluationResultsResponse_EntityProfileEvaluationResults)(nil), // 281: minder.v1.ListEvaluationResultsResponse.EntityProfileEvaluationResults
+	(*ListEvaluationResultsResponse_EntityEvaluationResults)(nil),        // 282: minder.v1.ListEvaluationResultsResponse.EntityEvaluationResults
+	(*RestType_Fallback)(nil),                                            // 283: minder.v1.RestType.Fallback
+	(*DiffType_Ecosystem)(nil),                                           // 284: minder.v1.DiffType.Ecosystem
+	(*DepsType_RepoConfigs)(nil),                                         // 285: minder.v1.DepsType.RepoConfigs
+	(*DepsType_PullRequestConfigs)(nil),                                  // 286: minder.v1.DepsType.PullRequestConfigs
+	(*RuleType_Definition)(nil),                                          // 287: minder.v1.RuleType.Definition
+	(*RuleType_Definition_Ingest)(nil),                                   // 288: minder.v1.RuleType.Definition.Ingest
+	(*RuleType_Definition_Eval)(nil),                                     // 289: minder.v1.RuleType.Definition.Eval
+	(*RuleType_Definition_Remediate)(nil),                                // 290: minder.v1.RuleType.Definition.Remediate
+	(*RuleType_Definition_Alert)(nil),                                    // 291: minder.v1.RuleType.Definition.Alert
+	(*RuleType_Definition_Limits)(nil),                                   // 292: minder.v1.RuleType.Definition.Limits
+	(*RuleType_Definition_Migration)(nil),                                // 293: minder.v1.RuleType.Definition.Migration
+	(*RuleType_Definition_Eval_JQComparison)(nil),                        // 294: minder.v1.RuleType.Definition.Eval.JQComparison
+	(*RuleType_Definition_Eval_Rego)(nil),                                // 295: minder.v1.RuleType.Definition.Eval.Rego
+	(*RuleType_Definition_Eval_Vulncheck)(nil),                           // 296: minder.v1.RuleType.Definition.Eval.Vulncheck
+	(*RuleType_Definition_Eval_Trusty)(nil),                              // 297: minder.v1.RuleType.Definition.Eval.Trusty
+	(*RuleType_Definition_Eval_Homoglyphs)(nil),                          // 298: minder.v1.RuleType.Definition.Eval.Homoglyphs
+	(*RuleType_Definition_Eval_JQComparison_Operator)(nil),               // 299: minder.v1.RuleType.Definition.Eval.JQComparison.Operator
+	(*RuleType_Definition_Remediate_GhBranchProtectionType)(nil),         // 300: minder.v1.RuleType.Definition.Remediate.GhBranchProtectionType
+	(*RuleType_Definition_Remediate_GhRulesetType)(nil),                  // 301: minder.v1.RuleType.Definition.Remediate.GhRulesetType
+	(*RuleType_Definition_Remediate_PullRequestRemediation)(nil),         // 302: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation
+	(*RuleType_Definition_Remediate_PullRequestRemediation_Content)(nil), // 303: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.Content
+	(*RuleType_Definition_Remediate_PullRequestRemediation_ActionsReplaceTagsWithSha)(nil), // 304: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.ActionsReplaceTagsWithSha
+	(*RuleType_Definition_Alert_AlertTypeSA)(nil),                                          // 305: minder.v1.RuleType.Definition.Alert.AlertTypeSA
+	(*RuleType_Definition_Alert_AlertTypePRComment)(nil),                                   // 306: minder.v1.RuleType.Definition.Alert.AlertTypePRComment
+	(*Profile_Rule)(nil),                  // 307: minder.v1.Profile.Rule
+	(*Profile_Selector)(nil),              // 308: minder.v1.Profile.Selector
+	(*EvaluationExplanation_Rule)(nil),    // 309: minder.v1.EvaluationExplanation.Rule
+	(*ComplianceReport_Counts)(nil),       // 310: minder.v1.ComplianceReport.Counts
+	(*ComplianceReport_Group)(nil),        // 311: minder.v1.ComplianceReport.Group
+	(*ComplianceReport_Finding)(nil),      // 312: minder.v1.ComplianceReport.Finding
+	(*ComplianceReport_TrendPoint)(nil),   // 313: minder.v1.ComplianceReport.TrendPoint
+	nil,                                   // 314: minder.v1.RegisterEntityRequest.IdentifyingPropertiesEntry
+	(*StructDataSource_Def)(nil),          // 315: minder.v1.StructDataSource.Def
+	nil,                                   // 316: minder.v1.StructDataSource.DefEntry
+	(*StructDataSource_Def_Path)(nil),     // 317: minder.v1.StructDataSource.Def.Path
+	(*RestDataSource_Def)(nil),            // 318: minder.v1.RestDataSource.Def
+	nil,                                   // 319: minder.v1.RestDataSource.DefEntry
+	nil,                                   // 320: minder.v1.RestDataSource.Def.HeadersEntry
+	(*RestDataSource_Def_Fallback)(nil),   // 321: minder.v1.RestDataSource.Def.Fallback
+	(*TrustRoot_SigstoreRoot)(nil),        // 322: minder.v1.TrustRoot.SigstoreRoot
+	(*TrustRoot_PublicKey)(nil),           // 323: minder.v1.TrustRoot.PublicKey
+	(*timestamppb.Timestamp)(nil),         // 324: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),               // 325: google.protobuf.Struct
+	(*fieldmaskpb.FieldMask)(nil),         // 326: google.protobuf.FieldMask
+	(*structpb.Value)(nil),                // 327: google.protobuf.Value
+	(*descriptorpb.EnumValueOptions)(nil), // 328: google.protobuf.EnumValueOptions
+	(*descriptorpb.MethodOptions)(nil),    // 329: google.protobuf.MethodOptions
 }
 var file_minder_v1_minder_proto_depIdxs = []int32{
 	2,   // 0: minder.v1.RpcOptions.target_resource:type_name -> minder.v1.TargetResource
 	1,   // 1: minder.v1.RpcOptions.relation:type_name -> minder.v1.Relation
-	11,  // 2: minder.v1.CursorPage.next:type_name -> minder.v1.Cursor
-	11,  // 3: minder.v1.CursorPage.prev:type_name -> minder.v1.Cursor
-	113, // 4: minder.v1.ListArtifactsRequest.context:type_name -> minder.v1.Context
-	15,  // 5: minder.v1.ListArtifactsResponse.results:type_name -> minder.v1.Artifact
-	16,  // 6: minder.v1.Artifact.versions:type_name -> minder.v1.ArtifactVersion
-	250, // 7: minder.v1.Artifact.created_at:type_name -> google.protobuf.Timestamp
-	113, // 8: minder.v1.Artifact.context:type_name -> minder.v1.Context
-	250, // 9: minder.v1.ArtifactVersion.created_at:type_name -> google.protobuf.Timestamp
-	113, // 10: minder.v1.GetArtifactByIdRequest.context:type_name -> minder.v1.Context
-	15,  // 11: minder.v1.GetArtifactByIdResponse.artifact:type_name -> minder.v1.Artifact
-	16,  // 12: minder.v1.GetArtifactByIdResponse.versions:type_name -> minder.v1.ArtifactVersion
-	113, // 13: minder.v1.GetArtifactByNameRequest.context:type_name -> minder.v1.Context
-	15,  // 14: minder.v1.GetArtifactByNameResponse.artifact:type_name -> minder.v1.Artifact
-	16,  // 15: minder.v1.GetArtifactByNameResponse.versions:type_name -> minder.v1.ArtifactVersion
-	250, // 16: minder.v1.GetInviteDetailsResponse.expires_at:type_name -> google.protobuf.Timestamp
-	113, // 17: minder.v1.GetAuthorizationURLRequest.context:type_name -> minder.v1.Context
-	251, // 18: minder.v1.GetAuthorizationURLRequest.config:type_name -> google.protobuf.Struct
-	113, // 19: minder.v1.StoreProviderTokenRequest.context:type_name -> minder.v1.Context
-	250, // 20: minder.v1.Project.created_at:type_name -> google.protobuf.Timestamp
-	250, // 21: minder.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
-	113, // 22: minder.v1.ListRemoteRepositoriesFromProviderRequest.context:type_name -> minder.v1.Context
-	37,  // 23: minder.v1.ListRemoteRepositoriesFromProviderResponse.results:type_name -> minder.v1.UpstreamRepositoryRef
-	36,  // 24: minder.v1.ListRemoteRepositoriesFromProviderResponse.entities:type_name -> minder.v1.RegistrableUpstreamEntityRef
-	209, // 25: minder.v1.RegistrableUpstreamEntityRef.entity:type_name -> minder.v1.UpstreamEntityRef
-	113, // 26: minder.v1.UpstreamRepositoryRef.context:type_name -> minder.v1.Context
-	113, // 27: minder.v1.Repository.context:type_name -> minder.v1.Context
-	250, // 28: minder.v1.Repository.created_at:type_name -> google.protobuf.Timestamp
-	250, // 29: minder.v1.Repository.updated_at:type_name -> google.protobuf.Timestamp
-	251, // 30: minder.v1.Repository.properties:type_name -> google.protobuf.Struct
-	37,  // 31: minder.v1.RegisterRepositoryRequest.repository:type_name -> minder.v1.UpstreamRepositoryRef
-	113, // 32: minder.v1.RegisterRepositoryRequest.context:type_name -> minder.v1.Context
-	209, // 33: minder.v1.RegisterRepositoryRequest.entity:type_name -> minder.v1.UpstreamEntityRef
-	38,  // 34: minder.v1.RegisterRepoResult.repository:type_name -> minder.v1.Repository
-	214, // 35: minder.v1.RegisterRepoResult.status:type_name -> minder.v1.RegisterRepoResult.Status
-	40,  // 36: minder.v1.RegisterRepositoryResponse.result:type_name -> minder.v1.RegisterRepoResult
-	113, // 37: minder.v1.GetRepositoryByIdRequest.context:type_name -> minder.v1.Context
-	38,  // 38: minder.v1.GetRepositoryByIdResponse.repository:type_name -> minder.v1.Repository
-	113, // 39: minder.v1.DeleteRepositoryByIdRequest.context:type_name -> minder.v1.Context
-	113, // 40: minder.v1.GetRepositoryByNameRequest.context:type_name -> minder.v1.Context
-	38,  // 41: minder.v1.GetRepositoryByNameResponse.repository:type_name -> minder.v1.Repository
-	113, // 42: minder.v1.DeleteRepositoryByNameRequest.context:type_name -> minder.v1.Context
-	113, // 43: minder.v1.ListRepositoriesRequest.context:type_name -> minder.v1.Context
-	38,  // 44: minder.v1.ListRepositoriesResponse.results:type_name -> minder.v1.Repository
-	113, // 45: minder.v1.ReconcileEntityRegistrationRequest.context:type_name -> minder.v1.Context
-	250, // 46: minder.v1.VerifyProviderTokenFromRequest.timestamp:type_name -> google.protobuf.Timestamp
-	113, // 47: minder.v1.VerifyProviderTokenFromRequest.context:type_name -> minder.v1.Context
-	113, // 48: minder.v1.VerifyProviderCredentialRequest.context:type_name -> minder.v1.Context
-	250, // 49: minder.v1.CreateUserResponse.created_at:type_name -> google.protobuf.Timestamp
-	113, // 50: minder.v1.CreateUserResponse.context:type_name -> minder.v1.Context
-	250, // 51: minder.v1.UserRecord.created_at:type_name -> google.protobuf.Timestamp
-	250, // 52: minder.v1.UserRecord.updated_at:type_name -> google.protobuf.Timestamp
-	163, // 53: minder.v1.ProjectRole.role:type_name -> minder.v1.Role
-	33,  // 54: minder.v1.ProjectRole.project:type_name -> minder.v1.Project
-	62,  // 55: minder.v1.GetUserResponse.user:type_name -> minder.v1.UserRecord
-	33,  // 56: minder.v1.GetUserResponse.projects:type_name -> minder.v1.Project
-	63,  // 57: minder.v1.GetUserResponse.project_roles:type_name -> minder.v1.ProjectRole
-	210, // 58: minder.v1.CreateDataSourceRequest.data_source:type_name -> minder.v1.DataSource
-	210, // 59: minder.v1.CreateDataSourceResponse.data_source:type_name -> minder.v1.DataSource
-	114, // 60: minder.v1.GetDataSourceByIdRequest.context:type_name -> minder.v1.ContextV2
-	210, // 61: minder.v1.GetDataSourceByIdResponse.data_source:type_name -> minder.v1.DataSource
-	114, // 62: minder.v1.GetDataSourceByNameRequest.context:type_name -> minder.v1.ContextV2
-	210, // 63: minder.v1.GetDataSourceByNameResponse.data_source:type_name -> minder.v1.DataSource
-	114, // 64: minder.v1.ListDataSourcesRequest.context:type_name -> minder.v1.ContextV2
-	210, // 65: minder.v1.ListDataSourcesResponse.data_sources:type_name -> minder.v1.DataSource
-	210, // 66: minder.v1.UpdateDataSourceRequest.data_source:type_name -> minder.v1.DataSource
-	210, // 67: minder.v1.UpdateDataSourceResponse.data_source:type_name -> minder.v1.DataSource
-	114, // 68: minder.v1.DeleteDataSourceByIdRequest.context:type_name -> minder.v1.ContextV2
-	114, // 69: minder.v1.DeleteDataSourceByNameRequest.context:type_name -> minder.v1.ContextV2
-	137, // 70: minder.v1.CreateProfileRequest.profile:type_name -> minder.v1.Profile
-	137, // 71: minder.v1.CreateProfileResponse.profile:type_name -> minder.v1.Profile
-	137, // 72: minder.v1.UpdateProfileRequest.profile:type_name -> minder.v1.Profile
-	137, // 73: minder.v1.UpdateProfileResponse.profile:type_name -> minder.v1.Profile
-	113, // 74: minder.v1.PatchProfileRequest.context:type_name -> minder.v1.Context
-	137, // 75: minder.v1.PatchProfileRequest.patch:type_name -> minder.v1.Profile
-	252, // 76: minder.v1.PatchProfileRequest.update_mask:type_name -> google.protobuf.FieldMask
-	137, // 77: minder.v1.PatchProfileResponse.profile:type_name -> minder.v1.Profile
-	113, // 78: minder.v1.DeleteProfileRequest.context:type_name -> minder.v1.Context
-	113, // 79: minder.v1.ListProfilesRequest.context:type_name -> minder.v1.Context
-	137, // 80: minder.v1.ListProfilesResponse.profiles:type_name -> minder.v1.Profile
-	113, // 81: minder.v1.GetProfileByIdRequest.context:type_name -> minder.v1.Context
-	137, // 82: minder.v1.GetProfileByIdResponse.profile:type_name -> minder.v1.Profile
-	113, // 83: minder.v1.GetProfileByNameRequest.context:type_name -> minder.v1.Context
-	137, // 84: minder.v1.GetProfileByNameResponse.profile:type_name -> minder.v1.Profile
-	250, // 85: minder.v1.ProfileStatus.last_updated:type_name -> google.protobuf.Timestamp
-	250, // 86: minder.v1.EvalResultAlert.last_updated:type_name -> google.protobuf.Timestamp
-	250, // 87: minder.v1.RuleEvaluationStatus.last_updated:type_name -> google.protobuf.Timestamp
-	215, // 88: minder.v1.RuleEvaluationStatus.entity_info:type_name -> minder.v1.RuleEvaluationStatus.EntityInfoEntry
-	250, // 89: minder.v1.RuleEvaluationStatus.remediation_last_updated:type_name -> google.protobuf.Timestamp
-	95,  // 90: minder.v1.RuleEvaluationStatus.alert:type_name -> minder.v1.EvalResultAlert
-	135, // 91: minder.v1.RuleEvaluationStatus.severity:type_name -> minder.v1.Severity
-	4,   // 92: minder.v1.RuleEvaluationStatus.release_phase:type_name -> minder.v1.RuleTypeReleasePhase
-	3,   // 93: minder.v1.EntityTypedId.type:type_name -> minder.v1.Entity
-	113, // 94: minder.v1.GetProfileStatusByNameRequest.context:type_name -> minder.v1.Context
-	97,  // 95: minder.v1.GetProfileStatusByNameRequest.entity:type_name -> minder.v1.EntityTypedId
-	94,  // 96: minder.v1.GetProfileStatusByNameResponse.profile_status:type_name -> minder.v1.ProfileStatus
-	96,  // 97: minder.v1.GetProfileStatusByNameResponse.rule_evaluation_status:type_name -> minder.v1.RuleEvaluationStatus
-	113, // 98: minder.v1.GetProfileStatusByIdRequest.context:type_name -> minder.v1.Context
-	97,  // 99: minder.v1.GetProfileStatusByIdRequest.entity:type_name -> minder.v1.EntityTypedId
-	94,  // 100: minder.v1.GetProfileStatusByIdResponse.profile_status:type_name -> minder.v1.ProfileStatus
-	96,  // 101: minder.v1.GetProfileStatusByIdResponse.rule_evaluation_status:type_name -> minder.v1.RuleEvaluationStatus
-	113, // 102: minder.v1.GetProfileStatusByProjectRequest.context:type_name -> minder.v1.Context
-	94,  // 103: minder.v1.GetProfileStatusByProjectResponse.profile_status:type_name -> minder.v1.ProfileStatus
-	216, // 104: minder.v1.AutoRegistration.entities:type_name -> minder.v1.AutoRegistration.EntitiesEntry
-	105, // 105: minder.v1.ProviderConfig.auto_registration:type_name -> minder.v1.AutoRegistration
-	113, // 106: minder.v1.ListRuleTypesRequest.context:type_name -> minder.v1.Context
-	136, // 107: minder.v1.ListRuleTypesResponse.rule_types:type_name -> minder.v1.RuleType
-	113, // 108: minder.v1.GetRuleTypeByNameRequest.context:type_name -> minder.v1.Context
-	136, // 109: minder.v1.GetRuleTypeByNameResponse.rule_type:type_name -> minder.v1.RuleType
-	113, // 110: minder.v1.GetRuleTypeByIdRequest.context:type_name -> minder.v1.Context
-	136, // 111: minder.v1.GetRuleTypeByIdResponse.rule_type:type_name -> minder.v1.RuleType
-	136, // 112: minder.v1.CreateRuleTypeRequest.rule_type:type_name -> minder.v1.RuleType
-	136, // 113: minder.v1.CreateRuleTypeResponse.rule_type:type_name -> minder.v1.RuleType
-	136, // 114: minder.v1.UpdateRuleTypeRequest.rule_type:type_name -> minder.v1.RuleType
-	136, // 115: minder.v1.UpdateRuleTypeResponse.rule_type:type_name -> minder.v1.RuleType
-	113, // 116: minder.v1.DeleteRuleTypeRequest.context:type_name -> minder.v1.Context
-	113, // 117: minder.v1.ListEvaluationResultsRequest.context:type_name -> minder.v1.Context
-	97,  // 118: minder.v1.ListEvaluationResultsRequest.entity:type_name -> minder.v1.EntityTypedId
-	218, // 119: minder.v1.ListEvaluationResultsResponse.entities:type_name -> minder.v1.ListEvaluationResultsResponse.EntityEvaluationResults
-	219, // 120: minder.v1.RestType.fallback:type_name -> minder.v1.RestType.Fallback
-	220, // 121: minder.v1.DiffType.ecosystems:type_name -> minder.v1.DiffType.Ecosystem
-	221, // 122: minder.v1.DepsType.repo:type_name -> minder.v1.DepsType.RepoConfigs
-	222, // 123: minder.v1.DepsType.pr:type_name -> minder.v1.DepsType.PullRequestConfigs
-	9,   // 124: minder.v1.Severity.value:type_name -> minder.v1.Severity.Value
-	113, // 125: minder.v1.RuleType.context:type_name -> minder.v1.Context
-	223, // 126: minder.v1.RuleType.def:type_name -> minder.v1.RuleType.Definition
-	135, // 127: minder.v1.RuleType.severity:type_name -> minder.v1.Severity
-	4,   // 128: minder.v1.RuleType.release_phase:type_name -> minder.v1.RuleTypeReleasePhase
-	113, // 129: minder.v1.Profile.context:type_name -> minder.v1.Context
-	240, // 130: minder.v1.Profile.repository:type_name -> minder.v1.Profile.Rule
-	240, // 131: minder.v1.Profile.build_environment:type_name -> minder.v1.Profile.Rule
-	240, // 132: minder.v1.Profile.artifact:type_name -> minder.v1.Profile.Rule
-	240, // 133: minder.v1.Profile.pull_request:type_name -> minder.v1.Profile.Rule
-	240, // 134: minder.v1.Profile.release:type_name -> minder.v1.Profile.Rule
-	240, // 135: minder.v1.Profile.pipeline_run:type_name -> minder.v1.Profile.Rule
-	240, // 136: minder.v1.Profile.task_run:type_name -> minder.v1.Profile.Rule
-	240, // 137: minder.v1.Profile.build:type_name -> minder.v1.Profile.Rule
-	241, // 138: minder.v1.Profile.selection:type_name -> minder.v1.Profile.Selector
-	33,  // 139: minder.v1.ListProjectsResponse.projects:type_name -> minder.v1.Project
-	113, // 140: minder.v1.CreateProjectRequest.context:type_name -> minder.v1.Context
-	33,  // 141: minder.v1.CreateProjectResponse.project:type_name -> minder.v1.Project
-	113, // 142: minder.v1.DeleteProjectRequest.context:type_name -> minder.v1.Context
-	113, // 143: minder.v1.UpdateProjectRequest.context:type_name -> minder.v1.Context
-	33,  // 144: minder.v1.UpdateProjectResponse.project:type_name -> minder.v1.Project
-	113, // 145: minder.v1.PatchProjectRequest.context:type_name -> minder.v1.Context
-	146, // 146: minder.v1.PatchProjectRequest.patch:type_name -> minder.v1.ProjectPatch
-	252, // 147: minder.v1.PatchProjectRequest.update_mask:type_name -> google.protobuf.FieldMask
-	33,  // 148: minder.v1.PatchProjectResponse.project:type_name -> minder.v1.Project
-	114, // 149: minder.v1.ListChildProjectsRequest.context:type_name -> minder.v1.ContextV2
-	33,  // 150: minder.v1.ListChildProjectsResponse.projects:type_name -> minder.v1.Project
-	97,  // 151: minder.v1.CreateEntityReconciliationTaskRequest.entity:type_name -> minder.v1.EntityTypedId
-	113, // 152: minder.v1.CreateEntityReconciliationTaskRequest.context:type_name -> minder.v1.Context
-	113, // 153: minder.v1.ListRolesRequest.context:type_name -> minder.v1.Context
-	163, // 154: minder.v1.ListRolesResponse.roles:type_name -> minder.v1.Role
-	113, // 155: minder.v1.ListRoleAssignmentsRequest.context:type_name -> minder.v1.Context
-	164, // 156: minder.v1.ListRoleAssignmentsResponse.role_assignments:type_name -> minder.v1.RoleAssignment
-	169, // 157: minder.v1.ListRoleAssignmentsResponse.invitations:type_name -> minder.v1.Invitation
-	113, // 158: minder.v1.AssignRoleRequest.context:type_name -> minder.v1.Context
-	164, // 159: minder.v1.AssignRoleRequest.role_assignment:type_name -> minder.v1.RoleAssignment
-	164, // 160: minder.v1.AssignRoleResponse.role_assignment:type_name -> minder.v1.RoleAssignment
-	169, // 161: minder.v1.AssignRoleResponse.invitation:type_name -> minder.v1.Invitation
-	113, // 162: minder.v1.UpdateRoleRequest.context:type_name -> minder.v1.Context
-	164, // 163: minder.v1.UpdateRoleResponse.role_assignments:type_name -> minder.v1.RoleAssignment
-	169, // 164: minder.v1.UpdateRoleResponse.invitations:type_name -> minder.v1.Invitation
-	113, // 165: minder.v1.RemoveRoleRequest.context:type_name -> minder.v1.Context
-	164, // 166: minder.v1.RemoveRoleRequest.role_assignment:type_name -> minder.v1.RoleAssignment
-	164, // 167: minder.v1.RemoveRoleResponse.role_assignment:type_name -> minder.v1.RoleAssignment
-	169, // 168: minder.v1.RemoveRoleResponse.invitation:type_name -> minder.v1.Invitation
-	169, // 169: minder.v1.ListInvitationsResponse.invitations:type_name -> minder.v1.Invitation
-	250, // 170: minder.v1.Invitation.created_at:type_name -> google.protobuf.Timestamp
-	250, // 171: minder.v1.Invitation.expires_at:type_name -> google.protobuf.Timestamp
-	113, // 172: minder.v1.GetProviderRequest.context:type_name -> minder.v1.Context
-	187, // 173: minder.v1.GetProviderResponse.provider:type_name -> minder.v1.Provider
-	113, // 174: minder.v1.ListProvidersRequest.context:type_name -> minder.v1.Context
-	187, // 175: minder.v1.ListProvidersResponse.providers:type_name -> minder.v1.Provider
-	113, // 176: minder.v1.CreateProviderRequest.context:type_name -> minder.v1.Context
-	187, // 177: minder.v1.CreateProviderRequest.provider:type_name -> minder.v1.Provider
-	187, // 178: minder.v1.CreateProviderResponse.provider:type_name -> minder.v1.Provider
-	184, // 179: minder.v1.CreateProviderResponse.authorization:type_name -> minder.v1.AuthorizationParams
-	113, // 180: minder.v1.DeleteProviderRequest.context:type_name -> minder.v1.Context
-	113, // 181: minder.v1.DeleteProviderByIDRequest.context:type_name -> minder.v1.Context
-	113, // 182: minder.v1.ListProviderClassesRequest.context:type_name -> minder.v1.Context
-	113, // 183: minder.v1.PatchProviderRequest.context:type_name -> minder.v1.Context
-	187, // 184: minder.v1.PatchProviderRequest.patch:type_name -> minder.v1.Provider
-	252, // 185: minder.v1.PatchProviderRequest.update_mask:type_name -> google.protobuf.FieldMask
-	187, // 186: minder.v1.PatchProviderResponse.provider:type_name -> minder.v1.Provider
-	186, // 187: minder.v1.ProviderParameter.github_app:type_name -> minder.v1.GitHubAppParams
-	5,   // 188: minder.v1.Provider.implements:type_name -> minder.v1.ProviderType
-	251, // 189: minder.v1.Provider.config:type_name -> google.protobuf.Struct
-	7,   // 190: minder.v1.Provider.auth_flows:type_name -> minder.v1.AuthorizationFlow
-	185, // 191: minder.v1.Provider.parameters:type_name -> minder.v1.ProviderParameter
-	113, // 192: minder.v1.GetEvaluationHistoryRequest.context:type_name -> minder.v1.Context
-	113, // 193: minder.v1.ListEvaluationHistoryRequest.context:type_name -> minder.v1.Context
-	250, // 194: minder.v1.ListEvaluationHistoryRequest.from:type_name -> google.protobuf.Timestamp
-	250, // 195: minder.v1.ListEvaluationHistoryRequest.to:type_name -> google.protobuf.Timestamp
-	11,  // 196: minder.v1.ListEvaluationHistoryRequest.cursor:type_name -> minder.v1.Cursor
-	192, // 197: minder.v1.GetEvaluationHistoryResponse.evaluation:type_name -> minder.v1.EvaluationHistory
-	192, // 198: minder.v1.ListEvaluationHistoryResponse.data:type_name -> minder.v1.EvaluationHistory
-	12,  // 199: minder.v1.ListEvaluationHistoryResponse.page:type_name -> minder.v1.CursorPage
-	193, // 200: minder.v1.EvaluationHistory.entity:type_name -> minder.v1.EvaluationHistoryEntity
-	194, // 201: minder.v1.EvaluationHistory.rule:type_name -> minder.v1.EvaluationHistoryRule
-	195, // 202: minder.v1.EvaluationHistory.status:type_name -> minder.v1.EvaluationHistoryStatus
-	197, // 203: minder.v1.EvaluationHistory.alert:type_name -> minder.v1.EvaluationHistoryAlert
-	196, // 204: minder.v1.EvaluationHistory.remediation:type_name -> minder.v1.EvaluationHistoryRemediation
-	250, // 205: minder.v1.EvaluationHistory.evaluated_at:type_name -> google.protobuf.Timestamp
-	3,   // 206: minder.v1.EvaluationHistoryEntity.type:type_name -> minder.v1.Entity
-	135, // 207: minder.v1.EvaluationHistoryRule.severity:type_name -> minder.v1.Severity
-	114, // 208: minder.v1.EntityInstance.context:type_name -> minder.v1.ContextV2
-	3,   // 209: minder.v1.EntityInstance.type:type_name -> minder.v1.Entity
-	251, // 210: minder.v1.EntityInstance.properties:type_name -> google.protobuf.Struct
-	114, // 211: minder.v1.ListEntitiesRequest.context:type_name -> minder.v1.ContextV2
-	3,   // 212: minder.v1.ListEntitiesRequest.entity_type:type_name -> minder.v1.Entity
-	11,  // 213: minder.v1.ListEntitiesRequest.cursor:type_name -> minder.v1.Cursor
-	198, // 214: minder.v1.ListEntitiesResponse.results:type_name -> minder.v1.EntityInstance
-	12,  // 215: minder.v1.ListEntitiesResponse.page:type_name -> minder.v1.CursorPage
-	114, // 216: minder.v1.GetEntityByIdRequest.context:type_name -> minder.v1.ContextV2
-	198, // 217: minder.v1.GetEntityByIdResponse.entity:type_name -> minder.v1.EntityInstance
-	114, // 218: minder.v1.GetEntityByNameRequest.context:type_name -> minder.v1.ContextV2
-	3,   // 219: minder.v1.GetEntityByNameRequest.entity_type:type_name -> minder.v1.Entity
-	198, // 220: minder.v1.GetEntityByNameResponse.entity:type_name -> minder.v1.EntityInstance
-	114, // 221: minder.v1.DeleteEntityByIdRequest.context:type_name -> minder.v1.ContextV2
-	114, // 222: minder.v1.RegisterEntityRequest.context:type_name -> minder.v1.ContextV2
-	3,   // 223: minder.v1.RegisterEntityRequest.entity_type:type_name -> minder.v1.Entity
-	242, // 224: minder.v1.RegisterEntityRequest.identifying_properties:type_name -> minder.v1.RegisterEntityRequest.IdentifyingPropertiesEntry
-	198, // 225: minder.v1.RegisterEntityResponse.entity:type_name -> minder.v1.EntityInstance
-	114, // 226: minder.v1.UpstreamEntityRef.context:type_name -> minder.v1.ContextV2
-	3,   // 227: minder.v1.UpstreamEntityRef.type:type_name -> minder.v1.Entity
-	251, // 228: minder.v1.UpstreamEntityRef.properties:type_name -> google.protobuf.Struct
-	114, // 229: minder.v1.DataSource.context:type_name -> minder.v1.ContextV2
-	211, // 230: minder.v1.DataSource.structured:type_name -> minder.v1.StructDataSource
-	212, // 231: minder.v1.DataSource.rest:type_name -> minder.v1.RestDataSource
-	244, // 232: minder.v1.StructDataSource.def:type_name -> minder.v1.StructDataSource.DefEntry
-	247, // 233: minder.v1.RestDataSource.def:type_name -> minder.v1.RestDataSource.DefEntry
-	104, // 234: minder.v1.AutoRegistration.EntitiesEntry.value:type_name -> minder.v1.EntityAutoRegistrationConfig
-	94,  // 235: minder.v1.ListEvaluationResultsResponse.EntityProfileEvaluationResults.profile_status:type_name -> minder.v1.ProfileStatus
-	96,  // 236: minder.v1.ListEvaluationResultsResponse.EntityProfileEvaluationResults.results:type_name -> minder.v1.RuleEvaluationStatus
-	97,  // 237: minder.v1.ListEvaluationResultsResponse.EntityEvaluationResults.entity:type_name -> minder.v1.EntityTypedId
-	217, // 238: minder.v1.ListEvaluationResultsResponse.EntityEvaluationResults.profiles:type_name -> minder.v1.ListEvaluationResultsResponse.EntityProfileEvaluationResults
-	251, // 239: minder.v1.RuleType.Definition.rule_schema:type_name -> google.protobuf.Struct
-	251, // 240: minder.v1.RuleType.Definition.param_schema:type_name -> google.protobuf.Struct
-	224, // 241: minder.v1.RuleType.Definition.ingest:type_name -> minder.v1.RuleType.Definition.Ingest
-	225, // 242: minder.v1.RuleType.Definition.eval:type_name -> minder.v1.RuleType.Definition.Eval
-	226, // 243: minder.v1.RuleType.Definition.remediate:type_name -> minder.v1.RuleType.Definition.Remediate
-	227, // 244: minder.v1.RuleType.Definition.alert:type_name -> minder.v1.RuleType.Definition.Alert
-	129, // 245: minder.v1.RuleType.Definition.Ingest.rest:type_name -> minder.v1.RestType
-	130, // 246: minder.v1.RuleType.Definition.Ingest.builtin:type_name -> minder.v1.BuiltinType
-	131, // 247: minder.v1.RuleType.Definition.Ingest.artifact:type_name -> minder.v1.ArtifactType
-	132, // 248: minder.v1.RuleType.Definition.Ingest.git:type_name -> minder.v1.GitType
-	133, // 249: minder.v1.RuleType.Definition.Ingest.diff:type_name -> minder.v1.DiffType
-	134, // 250: minder.v1.RuleType.Definition.Ingest.deps:type_name -> minder.v1.DepsType
-	228, // 251: minder.v1.RuleType.Definition.Eval.jq:type_name -> minder.v1.RuleType.Definition.Eval.JQComparison
-	229, // 252: minder.v1.RuleType.Definition.Eval.rego:type_name -> minder.v1.RuleType.Definition.Eval.Rego
-	230, // 253: minder.v1.RuleType.Definition.Eval.vulncheck:type_name -> minder.v1.RuleType.Definition.Eval.Vulncheck
-	231, // 254: minder.v1.RuleType.Definition.Eval.trusty:type_name -> minder.v1.RuleType.Definition.Eval.Trusty
-	232, // 255: minder.v1.RuleType.Definition.Eval.homoglyphs:type_name -> minder.v1.RuleType.Definition.Eval.Homoglyphs
-	213, // 256: minder.v1.RuleType.Definition.Eval.data_sources:type_name -> minder.v1.DataSourceReference
-	129, // 257: minder.v1.RuleType.Definition.Remediate.rest:type_name -> minder.v1.RestType
-	234, // 258: minder.v1.RuleType.Definition.Remediate.gh_branch_protection:type_name -> minder.v1.RuleType.Definition.Remediate.GhBranchProtectionType
-	235, // 259: minder.v1.RuleType.Definition.Remediate.pull_request:type_name -> minder.v1.RuleType.Definition.Remediate.PullRequestRemediation
-	238, // 260: minder.v1.RuleType.Definition.Alert.security_advisory:type_name -> minder.v1.RuleType.Definition.Alert.AlertTypeSA
-	239, // 261: minder.v1.RuleType.Definition.Alert.pull_request_comment:type_name -> minder.v1.RuleType.Definition.Alert.AlertTypePRComment
-	233, // 262: minder.v1.RuleType.Definition.Eval.JQComparison.ingested:type_name -> minder.v1.RuleType.Definition.Eval.JQComparison.Operator
-	233, // 263: minder.v1.RuleType.Definition.Eval.JQComparison.profile:type_name -> minder.v1.RuleType.Definition.Eval.JQComparison.Operator
-	253, // 264: minder.v1.RuleType.Definition.Eval.JQComparison.constant:type_name -> google.protobuf.Value
-	236, // 265: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.contents:type_name -> minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.Content
-	251, // 266: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.params:type_name -> google.protobuf.Struct
-	237, // 267: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.actions_replace_tags_with_sha:type_name -> minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.ActionsReplaceTagsWithSha
-	251, // 268: minder.v1.Profile.Rule.params:type_name -> google.protobuf.Struct
-	251, // 269: minder.v1.Profile.Rule.def:type_name -> google.protobuf.Struct
-	253, // 270: minder.v1.RegisterEntityRequest.IdentifyingPropertiesEntry.value:type_name -> google.protobuf.Value
-	245, // 271: minder.v1.StructDataSource.Def.path:type_name -> minder.v1.StructDataSource.Def.Path
-	243, // 272: minder.v1.StructDataSource.DefEntry.value:type_name -> minder.v1.StructDataSource.Def
-	248, // 273: minder.v1.RestDataSource.Def.headers:type_name -> minder.v1.RestDataSource.Def.HeadersEntry
-	251, // 274: minder.v1.RestDataSource.Def.bodyobj:type_name -> google.protobuf.Struct
-	249, // 275: minder.v1.RestDataSource.Def.fallback:type_name -> minder.v1.RestDataSource.Def.Fallback
-	251, // 276: minder.v1.RestDataSource.Def.input_schema:type_name -> google.protobuf.Struct
-	246, // 277: minder.v1.RestDataSource.DefEntry.value:type_name -> minder.v1.RestDataSource.Def
-	254, // 278: minder.v1.name:extendee -> google.protobuf.EnumValueOptions
-	255, // 279: minder.v1.rpc_options:extendee -> google.protobuf.MethodOptions
-	10,  // 280: minder.v1.rpc_options:type_name -> minder.v1.RpcOptions
-	27,  // 281: minder.v1.HealthService.CheckHealth:input_type -> minder.v1.CheckHealthRequest
-	13,  // 282: minder.v1.ArtifactService.ListArtifacts:input_type -> minder.v1.ListArtifactsRequest
-	17,  // 283: minder.v1.ArtifactService.GetArtifactById:input_type -> minder.v1.GetArtifactByIdRequest
-	19,  // 284: minder.v1.ArtifactService.GetArtifactByName:input_type -> minder.v1.GetArtifactByNameRequest
-	29,  // 285: minder.v1.OAuthService.GetAuthorizationURL:input_type -> minder.v1.GetAuthorizationURLRequest
-	31,  // 286: minder.v1.OAuthService.StoreProviderToken:input_type -> minder.v1.StoreProviderTokenRequest
-	54,  // 287: minder.v1.OAuthService.VerifyProviderTokenFrom:input_type -> minder.v1.VerifyProviderTokenFromRequest
-	56,  // 288: minder.v1.OAuthService.VerifyProviderCredential:input_type -> minder.v1.VerifyProviderCredentialRequest
-	39,  // 289: minder.v1.RepositoryService.RegisterRepository:input_type -> minder.v1.RegisterRepositoryRequest
-	34,  // 290: minder.v1.RepositoryService.ListRemoteRepositoriesFromProvider:input_type -> minder.v1.ListRemoteRepositoriesFromProviderRequest
-	50,  // 291: minder.v1.RepositoryService.ListRepositories:input_type -> minder.v1.ListRepositoriesRequest
-	42,  // 292: minder.v1.RepositoryService.GetRepositoryById:input_type -> minder.v1.GetRepositoryByIdRequest
-	46,  // 293: minder.v1.RepositoryService.GetRepositoryByName:input_type -> minder.v1.GetRepositoryByNameRequest
-	44,  // 294: minder.v1.RepositoryService.DeleteRepositoryById:input_type -> minder.v1.DeleteRepositoryByIdRequest
-	48,  // 295: minder.v1.RepositoryService.DeleteRepositoryByName:input_type -> minder.v1.DeleteRepositoryByNameRequest
-	58,  // 296: minder.v1.UserService.CreateUser:input_type -> minder.v1.CreateUserRequest
-	60,  // 297: minder.v1.UserService.DeleteUser:input_type -> minder.v1.DeleteUserRequest
-	64,  // 298: minder.v1.UserService.GetUser:input_type -> minder.v1.GetUserRequest
-	165, // 299: minder.v1.UserService.ListInvitations:input_type -> minder.v1.ListInvitationsRequest
-	167, // 300: minder.v1.UserService.ResolveInvitation:input_type -> minder.v1.ResolveInvitationRequest
-	80,  // 301: minder.v1.ProfileService.CreateProfile:input_type -> minder.v1.CreateProfileRequest
-	82,  // 302: minder.v1.ProfileService.UpdateProfile:input_type -> minder.v1.UpdateProfileRequest
-	84,  // 303: minder.v1.ProfileService.PatchProfile:input_type -> minder.v1.PatchProfileRequest
-	86,  // 304: minder.v1.ProfileService.DeleteProfile:input_type -> minder.v1.DeleteProfileRequest
-	88,  // 305: minder.v1.ProfileService.ListProfiles:input_type -> minder.v1.ListProfilesRequest
-	90,  // 306: minder.v1.ProfileService.GetProfileById:input_type -> minder.v1.GetProfileByIdRequest
-	92,  // 307: minder.v1.ProfileService.GetProfileByName:input_type -> minder.v1.GetProfileByNameRequest
-	98,  // 308: minder.v1.ProfileService.GetProfileStatusByName:input_type -> minder.v1.GetProfileStatusByNameRequest
-	100, // 309: minder.v1.ProfileService.GetProfileStatusById:input_type -> minder.v1.GetProfileStatusByIdRequest
-	102, // 310: minder.v1.ProfileService.GetProfileStatusByProject:input_type -> minder.v1.GetProfileStatusByProjectRequest
-	66,  // 311: minder.v1.DataSourceService.CreateDataSource:input_type -> minder.v1.CreateDataSourceRequest
-	68,  // 312: minder.v1.DataSourceService.GetDataSourceById:input_type -> minder.v1.GetDataSourceByIdRequest
-	70,  // 313: minder.v1.DataSourceService.GetDataSourceByName:input_type -> minder.v1.GetDataSourceByNameRequest
-	72,  // 314: minder.v1.DataSourceService.ListDataSources:input_type -> minder.v1.ListDataSourcesRequest
-	74,  // 315: minder.v1.DataSourceService.UpdateDataSource:input_type -> minder.v1.UpdateDataSourceRequest
-	76,  // 316: minder.v1.DataSourceService.DeleteDataSourceById:input_type -> minder.v1.DeleteDataSourceByIdRequest
-	78,  // 317: minder.v1.DataSourceService.DeleteDataSourceByName:input_type -> minder.v1.DeleteDataSourceByNameRequest
-	115, // 318: minder.v1.RuleTypeService.ListRuleTypes:input_type -> minder.v1.ListRuleTypesRequest
-	117, // 319: minder.v1.RuleTypeService.GetRuleTypeByName:input_type -> minder.v1.GetRuleTypeByNameRequest
-	119, // 320: minder.v1.RuleTypeService.GetRuleTypeById:input_type -> minder.v1.GetRuleTypeByIdRequest
-	121, // 321: minder.v1.RuleTypeService.CreateRuleType:input_type -> minder.v1.CreateRuleTypeRequest
-	123, // 322: minder.v1.RuleTypeService.UpdateRuleType:input_type -> minder.v1.UpdateRuleTypeRequest
-	125, // 323: minder.v1.RuleTypeService.DeleteRuleType:input_type -> minder.v1.DeleteRuleTypeRequest
-	127, // 324: minder.v1.EvalResultsService.ListEvaluationResults:input_type -> minder.v1.ListEvaluationResultsRequest
-	189, // 325: minder.v1.EvalResultsService.ListEvaluationHistory:input_type -> minder.v1.ListEvaluationHistoryRequest
-	188, // 326: minder.v1.EvalResultsService.GetEvaluationHistory:input_type -> minder.v1.GetEvaluationHistoryRequest
-	153, // 327: minder.v1.PermissionsService.ListRoles:input_type -> minder.v1.ListRolesRequest
-	155, // 328: minder.v1.PermissionsService.ListRoleAssignments:input_type -> minder.v1.ListRoleAssignmentsRequest
-	157, // 329: minder.v1.PermissionsService.AssignRole:input_type -> minder.v1.AssignRoleRequest
-	159, // 330: minder.v1.PermissionsService.UpdateRole:input_type -> minder.v1.UpdateRoleRequest
-	161, // 331: minder.v1.PermissionsService.RemoveRole:input_type -> minder.v1.RemoveRoleRequest
-	138, // 332: minder.v1.ProjectsService.ListProjects:input_type -> minder.v1.ListProjectsRequest
-	140, // 333: minder.v1.ProjectsService.CreateProject:input_type -> minder.v1.CreateProjectRequest
-	149, // 334: minder.v1.ProjectsService.ListChildProjects:input_type -> minder.v1.ListChildProjectsRequest
-	142, // 335: minder.v1.ProjectsService.DeleteProject:input_type -> minder.v1.DeleteProjectRequest
-	144, // 336: minder.v1.ProjectsService.UpdateProject:input_type -> minder.v1.UpdateProjectRequest
-	147, // 337: minder.v1.ProjectsService.PatchProject:input_type -> minder.v1.PatchProjectRequest
-	151, // 338: minder.v1.ProjectsService.CreateEntityReconciliationTask:input_type -> minder.v1.CreateEntityReconciliationTaskRequest
-	182, // 339: minder.v1.ProvidersService.PatchProvider:input_type -> minder.v1.PatchProviderRequest
-	170, // 340: minder.v1.ProvidersService.GetProvider:input_type -> minder.v1.GetProviderRequest
-	172, // 341: minder.v1.ProvidersService.ListProviders:input_type -> minder.v1.ListProvidersRequest
-	174, // 342: minder.v1.ProvidersService.CreateProvider:input_type -> minder.v1.CreateProviderRequest
-	176, // 343: minder.v1.ProvidersService.DeleteProvider:input_type -> minder.v1.DeleteProviderRequest
-	178, // 344: minder.v1.ProvidersService.DeleteProviderByID:input_type -> minder.v1.DeleteProviderByIDRequest
-	180, // 345: minder.v1.ProvidersService.ListProviderClasses:input_type -> minder.v1.ListProviderClassesRequest
-	52,  // 346: minder.v1.ProvidersService.ReconcileEntityRegistration:input_type -> minder.v1.ReconcileEntityRegistrationRequest
-	25,  // 347: minder.v1.InviteService.GetInviteDetails:input_type -> minder.v1.GetInviteDetailsRequest
-	199, // 348: minder.v1.EntityInstanceService.ListEntities:input_type -> minder.v1.ListEntitiesRequest
-	201, // 349: minder.v1.EntityInstanceService.GetEntityById:input_type -> minder.v1.GetEntityByIdRequest
-	203, // 350: minder.v1.EntityInstanceService.GetEntityByName:input_type -> minder.v1.GetEntityByNameRequest
-	205, // 351: minder.v1.EntityInstanceService.DeleteEntityById:input_type -> minder.v1.DeleteEntityByIdRequest
-	207, // 352: minder.v1.EntityInstanceService.RegisterEntity:input_type -> minder.v1.RegisterEntityRequest
-	28,  // 353: minder.v1.HealthService.CheckHealth:output_type -> minder.v1.CheckHealthResponse
-	14,  // 354: minder.v1.ArtifactService.ListArtifacts:output_type -> minder.v1.ListArtifactsResponse
-	18,  // 355: minder.v1.ArtifactService.GetArtifactById:output_type -> minder.v1.GetArtifactByIdResponse
-	20,  // 356: minder.v1.ArtifactService.GetArtifactByName:output_type -> minder.v1.GetArtifactByNameResponse
-	30,  // 357: minder.v1.OAuthService.GetAuthorizationURL:output_type -> minder.v1.GetAuthorizationURLResponse
-	32,  // 358: minder.v1.OAuthService.StoreProviderToken:output_type -> minder.v1.StoreProviderTokenResponse
-	55,  // 359: minder.v1.OAuthService.VerifyProviderTokenFrom:output_type -> minder.v1.VerifyProviderTokenFromResponse
-	57,  // 360: minder.v1.OAuthService.VerifyProviderCredential:output_type -> minder.v1.VerifyProviderCredentialResponse
-	41,  // 361: minder.v1.RepositoryService.RegisterRepository:output_type -> minder.v1.RegisterRepositoryResponse
-	35,  // 362: minder.v1.RepositoryService.ListRemoteRepositoriesFromProvider:output_type -> minder.v1.ListRemoteRepositoriesFromProviderResponse
-	51,  // 363: minder.v1.RepositoryService.ListRepositories:output_type -> minder.v1.ListRepositoriesResponse
-	43,  // 364: minder.v1.RepositoryService.GetRepositoryById:output_type -> minder.v1.GetRepositoryByIdResponse
-	47,  // 365: minder.v1.RepositoryService.GetRepositoryByName:output_type -> minder.v1.GetRepositoryByNameResponse
-	45,  // 366: minder.v1.RepositoryService.DeleteRepositoryById:output_type -> minder.v1.DeleteRepositoryByIdResponse
-	49,  // 367: minder.v1.RepositoryService.DeleteRepositoryByName:output_type -> minder.v1.DeleteRepositoryByNameResponse
-	59,  // 368: minder.v1.UserService.CreateUser:output_type -> minder.v1.CreateUserResponse
-	61,  // 369: minder.v1.UserService.DeleteUser:output_type -> minder.v1.DeleteUserResponse
-	65,  // 370: minder.v1.UserService.GetUser:output_type -> minder.v1.GetUserResponse
-	166, // 371: minder.v1.UserService.ListInvitations:output_type -> minder.v1.ListInvitationsResponse
-	168, // 372: minder.v1.UserService.ResolveInvitation:output_type -> minder.v1.ResolveInvitationResponse
-	81,  // 373: minder.v1.ProfileService.CreateProfile:output_type -> minder.v1.CreateProfileResponse
-	83,  // 374: minder.v1.ProfileService.UpdateProfile:output_type -> minder.v1.UpdateProfileResponse
-	85,  // 375: minder.v1.ProfileService.PatchProfile:output_type -> minder.v1.PatchProfileResponse
-	87,  // 376: minder.v1.ProfileService.DeleteProfile:output_type -> minder.v1.DeleteProfileResponse
-	89,  // 377: minder.v1.ProfileService.ListProfiles:output_type -> minder.v1.ListProfilesResponse
-	91,  // 378: minder.v1.ProfileService.GetProfileById:output_type -> minder.v1.GetProfileByIdResponse
-	93,  // 379: minder.v1.ProfileService.GetProfileByName:output_type -> minder.v1.GetProfileByNameResponse
-	99,  // 380: minder.v1.ProfileService.GetProfileStatusByName:output_type -> minder.v1.GetProfileStatusByNameResponse
-	101, // 381: minder.v1.ProfileService.GetProfileStatusById:output_type -> minder.v1.GetProfileStatusByIdResponse
-	103, // 382: minder.v1.ProfileService.GetProfileStatusByProject:output_type -> minder.v1.GetProfileStatusByProjectResponse
-	67,  // 383: minder.v1.DataSourceService.CreateDataSource:output_type -> minder.v1.CreateDataSourceResponse
-	69,  // 384: minder.v1.DataSourceService.GetDataSourceById:output_type -> minder.v1.GetDataSourceByIdResponse
-	71,  // 385: minder.v1.DataSourceService.GetDataSourceByName:output_type -> minder.v1.GetDataSourceByNameResponse
-	73,  // 386: minder.v1.DataSourceService.ListDataSources:output_type -> minder.v1.ListDataSourcesResponse
-	75,  // 387: minder.v1.DataSourceService.UpdateDataSource:output_type -> minder.v1.UpdateDataSourceResponse
-	77,  // 388: minder.v1.DataSourceService.DeleteDataSourceById:output_type -> minder.v1.DeleteDataSourceByIdResponse
-	79,  // 389: minder.v1.DataSourceService.DeleteDataSourceByName:output_type -> minder.v1.DeleteDataSourceByNameResponse
-	116, // 390: minder.v1.RuleTypeService.ListRuleTypes:output_type -> minder.v1.ListRuleTypesResponse
-	118, // 391: minder.v1.RuleTypeService.GetRuleTypeByName:output_type -> minder.v1.GetRuleTypeByNameResponse
-	120, // 392: minder.v1.RuleTypeService.GetRuleTypeById:output_type -> minder.v1.GetRuleTypeByIdResponse
-	122, // 393: minder.v1.RuleTypeService.CreateRuleType:output_type -> minder.v1.CreateRuleTypeResponse
-	124, // 394: minder.v1.RuleTypeService.UpdateRuleType:output_type -> minder.v1.UpdateRuleTypeResponse
-	126, // 395: minder.v1.RuleTypeService.DeleteRuleType:output_type -> minder.v1.DeleteRuleTypeResponse
-	128, // 396: minder.v1.EvalResultsService.ListEvaluationResults:output_type -> minder.v1.ListEvaluationResultsResponse
-	191, // 397: minder.v1.EvalResultsService.ListEvaluationHistory:output_type -> minder.v1.ListEvaluationHistoryResponse
-	190, // 398: minder.v1.EvalResultsService.GetEvaluationHistory:output_type -> minder.v1.GetEvaluationHistoryResponse
-	154, // 399: minder.v1.PermissionsService.ListRoles:output_type -> minder.v1.ListRolesResponse
-	156, // 400: minder.v1.PermissionsService.ListRoleAssignments:output_type -> minder.v1.ListRoleAssignmentsResponse
-	158, // 401: minder.v1.PermissionsService.AssignRole:output_type -> minder.v1.AssignRoleResponse
-	160, // 402: minder.v1.PermissionsService.UpdateRole:output_type -> minder.v1.UpdateRoleResponse
-	162, // 403: minder.v1.PermissionsService.RemoveRole:output_type -> minder.v1.RemoveRoleResponse
-	139, // 404: minder.v1.ProjectsService.ListProjects:output_type -> minder.v1.ListProjectsResponse
-	141, // 405: minder.v1.ProjectsService.CreateProject:output_type -> minder.v1.CreateProjectResponse
-	150, // 406: minder.v1.ProjectsService.ListChildProjects:output_type -> minder.v1.ListChildProjectsResponse
-	143, // 407: minder.v1.ProjectsService.DeleteProject:output_type -> minder.v1.DeleteProjectResponse
-	145, // 408: minder.v1.ProjectsService.UpdateProject:output_type -> minder.v1.UpdateProjectResponse
-	148, // 409: minder.v1.ProjectsService.PatchProject:output_type -> minder.v1.PatchProjectResponse
-	152, // 410: minder.v1.ProjectsService.CreateEntityReconciliationTask:output_type -> minder.v1.CreateEntityReconciliationTaskResponse
-	183, // 411: minder.v1.ProvidersService.PatchProvider:output_type -> minder.v1.PatchProviderResponse
-	171, // 412: minder.v1.ProvidersService.GetProvider:output_type -> minder.v1.GetProviderResponse
-	173, // 413: minder.v1.ProvidersService.ListProviders:output_type -> minder.v1.ListProvidersResponse
-	175, // 414: minder.v1.ProvidersService.CreateProvider:output_type -> minder.v1.CreateProviderResponse
-	177, // 415: minder.v1.ProvidersService.DeleteProvider:output_type -> minder.v1.DeleteProviderResponse
-	179, // 416: minder.v1.ProvidersService.DeleteProviderByID:output_type -> minder.v1.DeleteProviderByIDResponse
-	181, // 417: minder.v1.ProvidersService.ListProviderClasses:output_type -> minder.v1.ListProviderClassesResponse
-	53,  // 418: minder.v1.ProvidersService.ReconcileEntityRegistration:output_type -> minder.v1.ReconcileEntityRegistrationResponse
-	26,  // 419: minder.v1.InviteService.GetInviteDetails:output_type -> minder.v1.GetInviteDetailsResponse
-	200, // 420: minder.v1.EntityInstanceService.ListEntities:output_type -> minder.v1.ListEntitiesResponse
-	202, // 421: minder.v1.EntityInstanceService.GetEntityById:output_type -> minder.v1.GetEntityByIdResponse
-	204, // 422: minder.v1.EntityInstanceService.GetEntityByName:output_type -> minder.v1.GetEntityByNameResponse
-	206, // 423: minder.v1.EntityInstanceService.DeleteEntityById:output_type -> minder.v1.DeleteEntityByIdResponse
-	208, // 424: minder.v1.EntityInstanceService.RegisterEntity:output_type -> minder.v1.RegisterEntityResponse
-	353, // [353:425] is the sub-list for method output_type
-	281, // [281:353] is the sub-list for method input_type
-	280, // [280:281] is the sub-list for extension type_name
-	278, // [278:280] is the sub-list for extension extendee
-	0,   // [0:278] is the sub-list for field type_name
+	13,  // 2: minder.v1.CursorPage.next:type_name -> minder.v1.Cursor
+	13,  // 3: minder.v1.CursorPage.prev:type_name -> minder.v1.Cursor
+	157, // 4: minder.v1.ListArtifactsRequest.context:type_name -> minder.v1.Context
+	17,  // 5: minder.v1.ListArtifactsResponse.results:type_name -> minder.v1.Artifact
+	18,  // 6: minder.v1.Artifact.versions:type_name -> minder.v1.ArtifactVersion
+	324, // 7: minder.v1.Artifact.created_at:type_name -> google.protobuf.Timestamp
+	157, // 8: minder.v1.Artifact.context:type_name -> minder.v1.Context
+	324, // 9: minder.v1.ArtifactVersion.created_at:type_name -> google.protobuf.Timestamp
+	157, // 10: minder.v1.GetArtifactByIdRequest.context:type_name -> minder.v1.Context
+	17,  // 11: minder.v1.GetArtifactByIdResponse.artifact:type_name -> minder.v1.Artifact
+	18,  // 12: minder.v1.GetArtifactByIdResponse.versions:type_name -> minder.v1.ArtifactVersion
+	157, // 13: minder.v1.GetArtifactByNameRequest.context:type_name -> minder.v1.Context
+	17,  // 14: minder.v1.GetArtifactByNameResponse.artifact:type_name -> minder.v1.Artifact
+	18,  // 15: minder.v1.GetArtifactByNameResponse.versions:type_name -> minder.v1.ArtifactVersion
+	324, // 16: minder.v1.GetInviteDetailsResponse.expires_at:type_name -> google.protobuf.Timestamp
+	157, // 17: minder.v1.GetAuthorizationURLRequest.context:type_name -> minder.v1.Context
+	325, // 18: minder.v1.GetAuthorizationURLRequest.config:type_name -> google.protobuf.Struct
+	157, // 19: minder.v1.StoreProviderTokenRequest.context:type_name -> minder.v1.Context
+	324, // 20: minder.v1.Project.created_at:type_name -> google.protobuf.Timestamp
+	324, // 21: minder.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
+	157, // 22: minder.v1.ListRemoteRepositoriesFromProviderRequest.context:type_name -> minder.v1.Context
+	39,  // 23: minder.v1.ListRemoteRepositoriesFromProviderResponse.results:type_name -> minder.v1.UpstreamRepositoryRef
+	38,  // 24: minder.v1.ListRemoteRepositoriesFromProviderResponse.entities:type_name -> minder.v1.RegistrableUpstreamEntityRef
+	267, // 25: minder.v1.RegistrableUpstreamEntityRef.entity:type_name -> minder.v1.UpstreamEntityRef
+	157, // 26: minder.v1.UpstreamRepositoryRef.context:type_name -> minder.v1.Context
+	157, // 27: minder.v1.Repository.context:type_name -> minder.v1.Context
+	324, // 28: minder.v1.Repository.created_at:type_name -> google.protobuf.Timestamp
+	324, // 29: minder.v1.Repository.updated_at:type_name -> google.protobuf.Timestamp
+	325, // 30: minder.v1.Repository.properties:type_name -> google.protobuf.Struct
+	39,  // 31: minder.v1.RegisterRepositoryRequest.repository:type_name -> minder.v1.UpstreamRepositoryRef
+	157, // 32: minder.v1.RegisterRepositoryRequest.context:type_name -> minder.v1.Context
+	267, // 33: minder.v1.RegisterRepositoryRequest.entity:type_name -> minder.v1.UpstreamEntityRef
+	40,  // 34: minder.v1.RegisterRepoResult.repository:type_name -> minder.v1.Repository
+	277, // 35: minder.v1.RegisterRepoResult.status:type_name -> minder.v1.RegisterRepoResult.Status
+	42,  // 36: minder.v1.RegisterRepositoryResponse.result:type_name -> minder.v1.RegisterRepoResult
+	157, // 37: minder.v1.AutoRegistrationRule.context:type_name -> minder.v1.Context
+	44,  // 38: minder.v1.CreateAutoRegistrationRuleRequest.rule:type_name -> minder.v1.AutoRegistrationRule
+	44,  // 39: minder.v1.CreateAutoRegistrationRuleResponse.rule:type_name -> minder.v1.AutoRegistrationRule
+	157, // 40: minder.v1.ListAutoRegistrationRulesRequest.context:type_name -> minder.v1.Context
+	44,  // 41: minder.v1.ListAutoRegistrationRulesResponse.rules:type_name -> minder.v1.AutoRegistrationRule
+	157, // 42: minder.v1.DeleteAutoRegistrationRuleRequest.context:type_name -> minder.v1.Context
+	157, // 43: minder.v1.ReconcileAutoRegistrationRequest.context:type_name -> minder.v1.Context
+	53,  // 44: minder.v1.ReconcileAutoRegistrationResponse.report:type_name -> minder.v1.AutoRegistrationReport
+	278, // 45: minder.v1.AutoRegistrationReport.registered:type_name -> minder.v1.AutoRegistrationReport.Change
+	278, // 46: minder.v1.AutoRegistrationReport.deregistered:type_name -> minder.v1.AutoRegistrationReport.Change
+	157, // 47: minder.v1.GetRepositoryByIdRequest.context:type_name -> minder.v1.Context
+	40,  // 48: minder.v1.GetRepositoryByIdResponse.repository:type_name -> minder.v1.Repository
+	157, // 49: minder.v1.DeleteRepositoryByIdRequest.context:type_name -> minder.v1.Context
+	157, // 50: minder.v1.GetRepositoryByNameRequest.context:type_name -> minder.v1.Context
+	40,  // 51: minder.v1.GetRepositoryByNameResponse.repository:type_name -> minder.v1.Repository
+	157, // 52: minder.v1.DeleteRepositoryByNameRequest.context:type_name -> minder.v1.Context
+	157, // 53: minder.v1.ListRepositoriesRequest.context:type_name -> minder.v1.Context
+	40,  // 54: minder.v1.ListRepositoriesResponse.results:type_name -> minder.v1.Repository
+	157, // 55: minder.v1.ReconcileEntityRegistrationRequest.context:type_name -> minder.v1.Context
+	324, // 56: minder.v1.VerifyProviderTokenFromRequest.timestamp:type_name -> google.protobuf.Timestamp
+	157, // 57: minder.v1.VerifyProviderTokenFromRequest.context:type_name -> minder.v1.Context
+	157, // 58: minder.v1.VerifyProviderCredentialRequest.context:type_name -> minder.v1.Context
+	324, // 59: minder.v1.CreateUserResponse.created_at:type_name -> google.protobuf.Timestamp
+	157, // 60: minder.v1.CreateUserResponse.context:type_name -> minder.v1.Context
+	324, // 61: minder.v1.UserRecord.created_at:type_name -> google.protobuf.Timestamp
+	324, // 62: minder.v1.UserRecord.updated_at:type_name -> google.protobuf.Timestamp
+	209, // 63: minder.v1.ProjectRole.role:type_name -> minder.v1.Role
+	35,  // 64: minder.v1.ProjectRole.project:type_name -> minder.v1.Project
+	74,  // 65: minder.v1.GetUserResponse.user:type_name -> minder.v1.UserRecord
+	35,  // 66: minder.v1.GetUserResponse.projects:type_name -> minder.v1.Project
+	75,  // 67: minder.v1.GetUserResponse.project_roles:type_name -> minder.v1.ProjectRole
+	268, // 68: minder.v1.CreateDataSourceRequest.data_source:type_name -> minder.v1.DataSource
+	268, // 69: minder.v1.CreateDataSourceResponse.data_source:type_name -> minder.v1.DataSource
+	158, // 70: minder.v1.GetDataSourceByIdRequest.context:type_name -> minder.v1.ContextV2
+	268, // 71: minder.v1.GetDataSourceByIdResponse.data_source:type_name -> minder.v1.DataSource
+	158, // 72: minder.v1.GetDataSourceByNameRequest.context:type_name -> minder.v1.ContextV2
+	268, // 73: minder.v1.GetDataSourceByNameResponse.data_source:type_name -> minder.v1.DataSource
+	158, // 74: minder.v1.ListDataSourcesRequest.context:type_name -> minder.v1.ContextV2
+	268, // 75: minder.v1.ListDataSourcesResponse.data_sources:type_name -> minder.v1.DataSource
+	268, // 76: minder.v1.UpdateDataSourceRequest.data_source:type_name -> minder.v1.DataSource
+	268, // 77: minder.v1.UpdateDataSourceResponse.data_source:type_name -> minder.v1.DataSource
+	158, // 78: minder.v1.DeleteDataSourceByIdRequest.context:type_name -> minder.v1.ContextV2
+	158, // 79: minder.v1.DeleteDataSourceByNameRequest.context:type_name -> minder.v1.ContextV2
+	272, // 80: minder.v1.CreateTrustRootRequest.trust_root:type_name -> minder.v1.TrustRoot
+	272, // 81: minder.v1.CreateTrustRootResponse.trust_root:type_name -> minder.v1.TrustRoot
+	158, // 82: minder.v1.GetTrustRootByNameRequest.context:type_name -> minder.v1.ContextV2
+	272, // 83: minder.v1.GetTrustRootByNameResponse.trust_root:type_name -> minder.v1.TrustRoot
+	158, // 84: minder.v1.ListTrustRootsRequest.context:type_name -> minder.v1.ContextV2
+	272, // 85: minder.v1.ListTrustRootsResponse.trust_roots:type_name -> minder.v1.TrustRoot
+	272, // 86: minder.v1.UpdateTrustRootRequest.trust_root:type_name -> minder.v1.TrustRoot
+	272, // 87: minder.v1.UpdateTrustRootResponse.trust_root:type_name -> minder.v1.TrustRoot
+	158, // 88: minder.v1.DeleteTrustRootByNameRequest.context:type_name -> minder.v1.ContextV2
+	158, // 89: minder.v1.ListBundlesRequest.context:type_name -> minder.v1.ContextV2
+	273, // 90: minder.v1.ListBundlesResponse.bundles:type_name -> minder.v1.BundleInfo
+	158, // 91: minder.v1.ListBundleSubscriptionsRequest.context:type_name -> minder.v1.ContextV2
+	274, // 92: minder.v1.ListBundleSubscriptionsResponse.subscriptions:type_name -> minder.v1.BundleSubscription
+	158, // 93: minder.v1.SubscribeBundleRequest.context:type_name -> minder.v1.ContextV2
+	274, // 94: minder.v1.SubscribeBundleResponse.subscription:type_name -> minder.v1.BundleSubscription
+	158, // 95: minder.v1.UnsubscribeBundleRequest.context:type_name -> minder.v1.ContextV2
+	158, // 96: minder.v1.UpgradeBundleRequest.context:type_name -> minder.v1.ContextV2
+	275, // 97: minder.v1.UpgradeBundleResponse.diff:type_name -> minder.v1.BundleDiff
+	183, // 98: minder.v1.CreateProfileRequest.profile:type_name -> minder.v1.Profile
+	183, // 99: minder.v1.CreateProfileResponse.profile:type_name -> minder.v1.Profile
+	183, // 100: minder.v1.UpdateProfileRequest.profile:type_name -> minder.v1.Profile
+	183, // 101: minder.v1.UpdateProfileResponse.profile:type_name -> minder.v1.Profile
+	157, // 102: minder.v1.PatchProfileRequest.context:type_name -> minder.v1.Context
+	183, // 103: minder.v1.PatchProfileRequest.patch:type_name -> minder.v1.Profile
+	326, // 104: minder.v1.PatchProfileRequest.update_mask:type_name -> google.protobuf.FieldMask
+	183, // 105: minder.v1.PatchProfileResponse.profile:type_name -> minder.v1.Profile
+	157, // 106: minder.v1.DeleteProfileRequest.context:type_name -> minder.v1.Context
+	157, // 107: minder.v1.ListProfilesRequest.context:type_name -> minder.v1.Context
+	183, // 108: minder.v1.ListProfilesResponse.profiles:type_name -> minder.v1.Profile
+	157, // 109: minder.v1.GetProfileByIdRequest.context:type_name -> minder.v1.Context
+	183, // 110: minder.v1.GetProfileByIdResponse.profile:type_name -> minder.v1.Profile
+	157, // 111: minder.v1.GetProfileByNameRequest.context:type_name -> minder.v1.Context
+	183, // 112: minder.v1.GetProfileByNameResponse.profile:type_name -> minder.v1.Profile
+	324, // 113: minder.v1.ProfileStatus.last_updated:type_name -> google.protobuf.Timestamp
+	324, // 114: minder.v1.EvalResultAlert.last_updated:type_name -> google.protobuf.Timestamp
+	324, // 115: minder.v1.RuleEvaluationStatus.last_updated:type_name -> google.protobuf.Timestamp
+	279, // 116: minder.v1.RuleEvaluationStatus.entity_info:type_name -> minder.v1.RuleEvaluationStatus.EntityInfoEntry
+	324, // 117: minder.v1.RuleEvaluationStatus.remediation_last_updated:type_name -> google.protobuf.Timestamp
+	127, // 118: minder.v1.RuleEvaluationStatus.alert:type_name -> minder.v1.EvalResultAlert
+	181, // 119: minder.v1.RuleEvaluationStatus.severity:type_name -> minder.v1.Severity
+	4,   // 120: minder.v1.RuleEvaluationStatus.release_phase:type_name -> minder.v1.RuleTypeReleasePhase
+	3,   // 121: minder.v1.EntityTypedId.type:type_name -> minder.v1.Entity
+	157, // 122: minder.v1.GetProfileStatusByNameRequest.context:type_name -> minder.v1.Context
+	129, // 123: minder.v1.GetProfileStatusByNameRequest.entity:type_name -> minder.v1.EntityTypedId
+	126, // 124: minder.v1.GetProfileStatusByNameResponse.profile_status:type_name -> minder.v1.ProfileStatus
+	128, // 125: minder.v1.GetProfileStatusByNameResponse.rule_evaluation_status:type_name -> minder.v1.RuleEvaluationStatus
+	157, // 126: minder.v1.GetProfileStatusByIdRequest.context:type_name -> minder.v1.Context
+	129, // 127: minder.v1.GetProfileStatusByIdRequest.entity:type_name -> minder.v1.EntityTypedId
+	126, // 128: minder.v1.GetProfileStatusByIdResponse.profile_status:type_name -> minder.v1.ProfileStatus
+	128, // 129: minder.v1.GetProfileStatusByIdResponse.rule_evaluation_status:type_name -> minder.v1.RuleEvaluationStatus
+	157, // 130: minder.v1.GetProfileStatusByProjectRequest.context:type_name -> minder.v1.Context
+	126, // 131: minder.v1.GetProfileStatusByProjectResponse.profile_status:type_name -> minder.v1.ProfileStatus
+	157, // 132: minder.v1.Waiver.context:type_name -> minder.v1.Context
+	129, // 133: minder.v1.Waiver.entity:type_name -> minder.v1.EntityTypedId
+	324, // 134: minder.v1.Waiver.expires_at:type_name -> google.protobuf.Timestamp
+	324, // 135: minder.v1.Waiver.created_at:type_name -> google.protobuf.Timestamp
+	324, // 136: minder.v1.Waiver.updated_at:type_name -> google.protobuf.Timestamp
+	136, // 137: minder.v1.CreateWaiverRequest.waiver:type_name -> minder.v1.Waiver
+	136, // 138: minder.v1.CreateWaiverResponse.waiver:type_name -> minder.v1.Waiver
+	157, // 139: minder.v1.UpdateWaiverRequest.context:type_name -> minder.v1.Context
+	324, // 140: minder.v1.UpdateWaiverRequest.expires_at:type_name -> google.protobuf.Timestamp
+	136, // 141: minder.v1.UpdateWaiverResponse.waiver:type_name -> minder.v1.Waiver
+	157, // 142: minder.v1.GetWaiverByIdRequest.context:type_name -> minder.v1.Context
+	136, // 143: minder.v1.GetWaiverByIdResponse.waiver:type_name -> minder.v1.Waiver
+	157, // 144: minder.v1.ListWaiversRequest.context:type_name -> minder.v1.Context
+	136, // 145: minder.v1.ListWaiversResponse.waivers:type_name -> minder.v1.Waiver
+	157, // 146: minder.v1.DeleteWaiverRequest.context:type_name -> minder.v1.Context
+	280, // 147: minder.v1.AutoRegistration.entities:type_name -> minder.v1.AutoRegistration.EntitiesEntry
+	148, // 148: minder.v1.ProviderConfig.auto_registration:type_name -> minder.v1.AutoRegistration
+	157, // 149: minder.v1.ListRuleTypesRequest.context:type_name -> minder.v1.Context
+	182, // 150: minder.v1.ListRuleTypesResponse.rule_types:type_name -> minder.v1.RuleType
+	157, // 151: minder.v1.GetRuleTypeByNameRequest.context:type_name -> minder.v1.Context
+	182, // 152: minder.v1.GetRuleTypeByNameResponse.rule_type:type_name -> minder.v1.RuleType
+	157, // 153: minder.v1.GetRuleTypeByIdRequest.context:type_name -> minder.v1.Context
+	182, // 154: minder.v1.GetRuleTypeByIdResponse.rule_type:type_name -> minder.v1.RuleType
+	182, // 155: minder.v1.CreateRuleTypeRequest.rule_type:type_name -> minder.v1.RuleType
+	182, // 156: minder.v1.CreateRuleTypeResponse.rule_type:type_name -> minder.v1.RuleType
+	182, // 157: minder.v1.UpdateRuleTypeRequest.rule_type:type_name -> minder.v1.RuleType
+	182, // 158: minder.v1.UpdateRuleTypeResponse.rule_type:type_name -> minder.v1.RuleType
+	169, // 159: minder.v1.UpdateRuleTypeResponse.migrations:type_name -> minder.v1.RuleMigration
+	3,   // 160: minder.v1.RuleMigration.entity:type_name -> minder.v1.Entity
+	325, // 161: minder.v1.RuleMigration.def:type_name -> google.protobuf.Struct
+	325, // 162: minder.v1.RuleMigration.params:type_name -> google.protobuf.Struct
+	157, // 163: minder.v1.DeleteRuleTypeRequest.context:type_name -> minder.v1.Context
+	157, // 164: minder.v1.ListEvaluationResultsRequest.context:type_name -> minder.v1.Context
+	129, // 165: minder.v1.ListEvaluationResultsRequest.entity:type_name -> minder.v1.EntityTypedId
+	282, // 166: minder.v1.ListEvaluationResultsResponse.entities:type_name -> minder.v1.ListEvaluationResultsResponse.EntityEvaluationResults
+	283, // 167: minder.v1.RestType.fallback:type_name -> minder.v1.RestType.Fallback
+	284, // 168: minder.v1.DiffType.ecosystems:type_name -> minder.v1.DiffType.Ecosystem
+	285, // 169: minder.v1.DepsType.repo:type_name -> minder.v1.DepsType.RepoConfigs
+	286, // 170: minder.v1.DepsType.pr:type_name -> minder.v1.DepsType.PullRequestConfigs
+	10,  // 171: minder.v1.Severity.value:type_name -> minder.v1.Severity.Value
+	157, // 172: minder.v1.RuleType.context:type_name -> minder.v1.Context
+	287, // 173: minder.v1.RuleType.def:type_name -> minder.v1.RuleType.Definition
+	181, // 174: minder.v1.RuleType.severity:type_name -> minder.v1.Severity
+	4,   // 175: minder.v1.RuleType.release_phase:type_name -> minder.v1.RuleTypeReleasePhase
+	157, // 176: minder.v1.Profile.context:type_name -> minder.v1.Context
+	307, // 177: minder.v1.Profile.repository:type_name -> minder.v1.Profile.Rule
+	307, // 178: minder.v1.Profile.build_environment:type_name -> minder.v1.Profile.Rule
+	307, // 179: minder.v1.Profile.artifact:type_name -> minder.v1.Profile.Rule
+	307, // 180: minder.v1.Profile.pull_request:type_name -> minder.v1.Profile.Rule
+	307, // 181: minder.v1.Profile.release:type_name -> minder.v1.Profile.Rule
+	307, // 182: minder.v1.Profile.pipeline_run:type_name -> minder.v1.Profile.Rule
+	307, // 183: minder.v1.Profile.task_run:type_name -> minder.v1.Profile.Rule
+	307, // 184: minder.v1.Profile.build:type_name -> minder.v1.Profile.Rule
+	308, // 185: minder.v1.Profile.selection:type_name -> minder.v1.Profile.Selector
+	35,  // 186: minder.v1.ListProjectsResponse.projects:type_name -> minder.v1.Project
+	157, // 187: minder.v1.CreateProjectRequest.context:type_name -> minder.v1.Context
+	35,  // 188: minder.v1.CreateProjectResponse.project:type_name -> minder.v1.Project
+	157, // 189: minder.v1.DeleteProjectRequest.context:type_name -> minder.v1.Context
+	157, // 190: minder.v1.UpdateProjectRequest.context:type_name -> minder.v1.Context
+	35,  // 191: minder.v1.UpdateProjectResponse.project:type_name -> minder.v1.Project
+	157, // 192: minder.v1.PatchProjectRequest.context:type_name -> minder.v1.Context
+	192, // 193: minder.v1.PatchProjectRequest.patch:type_name -> minder.v1.ProjectPatch
+	326, // 194: minder.v1.PatchProjectRequest.update_mask:type_name -> google.protobuf.FieldMask
+	35,  // 195: minder.v1.PatchProjectResponse.project:type_name -> minder.v1.Project
+	158, // 196: minder.v1.ListChildProjectsRequest.context:type_name -> minder.v1.ContextV2
+	35,  // 197: minder.v1.ListChildProjectsResponse.projects:type_name -> minder.v1.Project
+	129, // 198: minder.v1.CreateEntityReconciliationTaskRequest.entity:type_name -> minder.v1.EntityTypedId
+	157, // 199: minder.v1.CreateEntityReconciliationTaskRequest.context:type_name -> minder.v1.Context
+	157, // 200: minder.v1.ListRolesRequest.context:type_name -> minder.v1.Context
+	209, // 201: minder.v1.ListRolesResponse.roles:type_name -> minder.v1.Role
+	157, // 202: minder.v1.ListRoleAssignmentsRequest.context:type_name -> minder.v1.Context
+	210, // 203: minder.v1.ListRoleAssignmentsResponse.role_assignments:type_name -> minder.v1.RoleAssignment
+	215, // 204: minder.v1.ListRoleAssignmentsResponse.invitations:type_name -> minder.v1.Invitation
+	157, // 205: minder.v1.AssignRoleRequest.context:type_name -> minder.v1.Context
+	210, // 206: minder.v1.AssignRoleRequest.role_assignment:type_name -> minder.v1.RoleAssignment
+	210, // 207: minder.v1.AssignRoleResponse.role_assignment:type_name -> minder.v1.RoleAssignment
+	215, // 208: minder.v1.AssignRoleResponse.invitation:type_name -> minder.v1.Invitation
+	157, // 209: minder.v1.UpdateRoleRequest.context:type_name -> minder.v1.Context
+	210, // 210: minder.v1.UpdateRoleResponse.role_assignments:type_name -> minder.v1.RoleAssignment
+	215, // 211: minder.v1.UpdateRoleResponse.invitations:type_name -> minder.v1.Invitation
+	157, // 212: minder.v1.RemoveRoleRequest.context:type_name -> minder.v1.Context
+	210, // 213: minder.v1.RemoveRoleRequest.role_assignment:type_name -> minder.v1.RoleAssignment
+	210, // 214: minder.v1.RemoveRoleResponse.role_assignment:type_name -> minder.v1.RoleAssignment
+	215, // 215: minder.v1.RemoveRoleResponse.invitation:type_name -> minder.v1.Invitation
+	215, // 216: minder.v1.ListInvitationsResponse.invitations:type_name -> minder.v1.Invitation
+	324, // 217: minder.v1.Invitation.created_at:type_name -> google.protobuf.Timestamp
+	324, // 218: minder.v1.Invitation.expires_at:type_name -> google.protobuf.Timestamp
+	157, // 219: minder.v1.GetProviderRequest.context:type_name -> minder.v1.Context
+	233, // 220: minder.v1.GetProviderResponse.provider:type_name -> minder.v1.Provider
+	157, // 221: minder.v1.ListProvidersRequest.context:type_name -> minder.v1.Context
+	233, // 222: minder.v1.ListProvidersResponse.providers:type_name -> minder.v1.Provider
+	157, // 223: minder.v1.CreateProviderRequest.context:type_name -> minder.v1.Context
+	233, // 224: minder.v1.CreateProviderRequest.provider:type_name -> minder.v1.Provider
+	233, // 225: minder.v1.CreateProviderResponse.provider:type_name -> minder.v1.Provider
+	230, // 226: minder.v1.CreateProviderResponse.authorization:type_name -> minder.v1.AuthorizationParams
+	157, // 227: minder.v1.DeleteProviderRequest.context:type_name -> minder.v1.Context
+	157, // 228: minder.v1.DeleteProviderByIDRequest.context:type_name -> minder.v1.Context
+	157, // 229: minder.v1.ListProviderClassesRequest.context:type_name -> minder.v1.Context
+	157, // 230: minder.v1.PatchProviderRequest.context:type_name -> minder.v1.Context
+	233, // 231: minder.v1.PatchProviderRequest.patch:type_name -> minder.v1.Provider
+	326, // 232: minder.v1.PatchProviderRequest.update_mask:type_name -> google.protobuf.FieldMask
+	233, // 233: minder.v1.PatchProviderResponse.provider:type_name -> minder.v1.Provider
+	232, // 234: minder.v1.ProviderParameter.github_app:type_name -> minder.v1.GitHubAppParams
+	5,   // 235: minder.v1.Provider.implements:type_name -> minder.v1.ProviderType
+	325, // 236: minder.v1.Provider.config:type_name -> google.protobuf.Struct
+	7,   // 237: minder.v1.Provider.auth_flows:type_name -> minder.v1.AuthorizationFlow
+	231, // 238: minder.v1.Provider.parameters:type_name -> minder.v1.ProviderParameter
+	234, // 239: minder.v1.Provider.health:type_name -> minder.v1.ProviderHealth
+	324, // 240: minder.v1.ProviderHealth.checked_at:type_name -> google.protobuf.Timestamp
+	324, // 241: minder.v1.ProviderHealth.last_healthy_at:type_name -> google.protobuf.Timestamp
+	324, // 242: minder.v1.ProviderHealth.credential_expires_at:type_name -> google.protobuf.Timestamp
+	157, // 243: minder.v1.GetEvaluationHistoryRequest.context:type_name -> minder.v1.Context
+	157, // 244: minder.v1.ListEvaluationHistoryRequest.context:type_name -> minder.v1.Context
+	324, // 245: minder.v1.ListEvaluationHistoryRequest.from:type_name -> google.protobuf.Timestamp
+	324, // 246: minder.v1.ListEvaluationHistoryRequest.to:type_name -> google.protobuf.Timestamp
+	13,  // 247: minder.v1.ListEvaluationHistoryRequest.cursor:type_name -> minder.v1.Cursor
+	247, // 248: minder.v1.GetEvaluationHistoryResponse.evaluation:type_name -> minder.v1.EvaluationHistory
+	157, // 249: minder.v1.WatchEvaluationsRequest.context:type_name -> minder.v1.Context
+	247, // 250: minder.v1.WatchEvaluationsResponse.evaluation:type_name -> minder.v1.EvaluationHistory
+	157, // 251: minder.v1.ExplainEvaluationRequest.context:type_name -> minder.v1.Context
+	242, // 252: minder.v1.ExplainEvaluationResponse.explanation:type_name -> minder.v1.EvaluationExplanation
+	247, // 253: minder.v1.EvaluationExplanation.evaluation:type_name -> minder.v1.EvaluationHistory
+	250, // 254: minder.v1.EvaluationExplanation.status:type_name -> minder.v1.EvaluationHistoryStatus
+	309, // 255: minder.v1.EvaluationExplanation.rules:type_name -> minder.v1.EvaluationExplanation.Rule
+	325, // 256: minder.v1.EvaluationExplanation.input:type_name -> google.protobuf.Struct
+	157, // 257: minder.v1.GenerateComplianceReportRequest.context:type_name -> minder.v1.Context
+	324, // 258: minder.v1.GenerateComplianceReportRequest.from:type_name -> google.protobuf.Timestamp
+	324, // 259: minder.v1.GenerateComplianceReportRequest.to:type_name -> google.protobuf.Timestamp
+	245, // 260: minder.v1.GenerateComplianceReportResponse.report:type_name -> minder.v1.ComplianceReport
+	324, // 261: minder.v1.ComplianceReport.generated_at:type_name -> google.protobuf.Timestamp
+	324, // 262: minder.v1.ComplianceReport.from:type_name -> google.protobuf.Timestamp
+	324, // 263: minder.v1.ComplianceReport.to:type_name -> google.protobuf.Timestamp
+	310, // 264: minder.v1.ComplianceReport.totals:type_name -> minder.v1.ComplianceReport.Counts
+	311, // 265: minder.v1.ComplianceReport.by_project:type_name -> minder.v1.ComplianceReport.Group
+	311, // 266: minder.v1.ComplianceReport.by_profile:type_name -> minder.v1.ComplianceReport.Group
+	311, // 267: minder.v1.ComplianceReport.by_rule_type:type_name -> minder.v1.ComplianceReport.Group
+	311, // 268: minder.v1.ComplianceReport.by_severity:type_name -> minder.v1.ComplianceReport.Group
+	311, // 269: minder.v1.ComplianceReport.by_entity:type_name -> minder.v1.ComplianceReport.Group
+	312, // 270: minder.v1.ComplianceReport.findings:type_name -> minder.v1.ComplianceReport.Finding
+	313, // 271: minder.v1.ComplianceReport.trend:type_name -> minder.v1.ComplianceReport.TrendPoint
+	247, // 272: minder.v1.ListEvaluationHistoryResponse.data:type_name -> minder.v1.EvaluationHistory
+	14,  // 273: minder.v1.ListEvaluationHistoryResponse.page:type_name -> minder.v1.CursorPage
+	248, // 274: minder.v1.EvaluationHistory.entity:type_name -> minder.v1.EvaluationHistoryEntity
+	249, // 275: minder.v1.EvaluationHistory.rule:type_name -> minder.v1.EvaluationHistoryRule
+	250, // 276: minder.v1.EvaluationHistory.status:type_name -> minder.v1.EvaluationHistoryStatus
+	252, // 277: minder.v1.EvaluationHistory.alert:type_name -> minder.v1.EvaluationHistoryAlert
+	251, // 278: minder.v1.EvaluationHistory.remediation:type_name -> minder.v1.EvaluationHistoryRemediation
+	324, // 279: minder.v1.EvaluationHistory.evaluated_at:type_name -> google.protobuf.Timestamp
+	3,   // 280: minder.v1.EvaluationHistoryEntity.type:type_name -> minder.v1.Entity
+	181, // 281: minder.v1.EvaluationHistoryRule.severity:type_name -> minder.v1.Severity
+	158, // 282: minder.v1.EntityInstance.context:type_name -> minder.v1.ContextV2
+	3,   // 283: minder.v1.EntityInstance.type:type_name -> minder.v1.Entity
+	325, // 284: minder.v1.EntityInstance.properties:type_name -> google.protobuf.Struct
+	158, // 285: minder.v1.ListEntitiesRequest.context:type_name -> minder.v1.ContextV2
+	3,   // 286: minder.v1.ListEntitiesRequest.entity_type:type_name -> minder.v1.Entity
+	13,  // 287: minder.v1.ListEntitiesRequest.cursor:type_name -> minder.v1.Cursor
+	253, // 288: minder.v1.ListEntitiesResponse.results:type_name -> minder.v1.EntityInstance
+	14,  // 289: minder.v1.ListEntitiesResponse.page:type_name -> minder.v1.CursorPage
+	158, // 290: minder.v1.GetEntityByIdRequest.context:type_name -> minder.v1.ContextV2
+	253, // 291: minder.v1.GetEntityByIdResponse.entity:type_name -> minder.v1.EntityInstance
+	158, // 292: minder.v1.GetEntityByNameRequest.context:type_name -> minder.v1.ContextV2
+	3,   // 293: minder.v1.GetEntityByNameRequest.entity_type:type_name -> minder.v1.Entity
+	253, // 294: minder.v1.GetEntityByNameResponse.entity:type_name -> minder.v1.EntityInstance
+	158, // 295: minder.v1.ListEntityPropertyHistoryRequest.context:type_name -> minder.v1.ContextV2
+	13,  // 296: minder.v1.ListEntityPropertyHistoryRequest.cursor:type_name -> minder.v1.Cursor
+	327, // 297: minder.v1.EntityPropertyChange.old_value:type_name -> google.protobuf.Value
+	327, // 298: minder.v1.EntityPropertyChange.new_value:type_name -> google.protobuf.Value
+	324, // 299: minder.v1.EntityPropertyChange.changed_at:type_name -> google.protobuf.Timestamp
+	261, // 300: minder.v1.ListEntityPropertyHistoryResponse.results:type_name -> minder.v1.EntityPropertyChange
+	14,  // 301: minder.v1.ListEntityPropertyHistoryResponse.page:type_name -> minder.v1.CursorPage
+	158, // 302: minder.v1.DeleteEntityByIdRequest.context:type_name -> minder.v1.ContextV2
+	158, // 303: minder.v1.RegisterEntityRequest.context:type_name -> minder.v1.ContextV2
+	3,   // 304: minder.v1.RegisterEntityRequest.entity_type:type_name -> minder.v1.Entity
+	314, // 305: minder.v1.RegisterEntityRequest.identifying_properties:type_name -> minder.v1.RegisterEntityRequest.IdentifyingPropertiesEntry
+	253, // 306: minder.v1.RegisterEntityResponse.entity:type_name -> minder.v1.EntityInstance
+	158, // 307: minder.v1.UpstreamEntityRef.context:type_name -> minder.v1.ContextV2
+	3,   // 308: minder.v1.UpstreamEntityRef.type:type_name -> minder.v1.Entity
+	325, // 309: minder.v1.UpstreamEntityRef.properties:type_name -> google.protobuf.Struct
+	158, // 310: minder.v1.DataSource.context:type_name -> minder.v1.ContextV2
+	269, // 311: minder.v1.DataSource.structured:type_name -> minder.v1.StructDataSource
+	270, // 312: minder.v1.DataSource.rest:type_name -> minder.v1.RestDataSource
+	316, // 313: minder.v1.StructDataSource.def:type_name -> minder.v1.StructDataSource.DefEntry
+	319, // 314: minder.v1.RestDataSource.def:type_name -> minder.v1.RestDataSource.DefEntry
+	158, // 315: minder.v1.TrustRoot.context:type_name -> minder.v1.ContextV2
+	322, // 316: minder.v1.TrustRoot.sigstore:type_name -> minder.v1.TrustRoot.SigstoreRoot
+	323, // 317: minder.v1.TrustRoot.public_keys:type_name -> minder.v1.TrustRoot.PublicKey
+	276, // 318: minder.v1.BundleDiff.rule_types:type_name -> minder.v1.BundleDiffEntry
+	276, // 319: minder.v1.BundleDiff.profiles:type_name -> minder.v1.BundleDiffEntry
+	276, // 320: minder.v1.BundleDiff.data_sources:type_name -> minder.v1.BundleDiffEntry
+	11,  // 321: minder.v1.BundleDiffEntry.change:type_name -> minder.v1.BundleDiffEntry.Change
+	147, // 322: minder.v1.AutoRegistration.EntitiesEntry.value:type_name -> minder.v1.EntityAutoRegistrationConfig
+	126, // 323: minder.v1.ListEvaluationResultsResponse.EntityProfileEvaluationResults.profile_status:type_name -> minder.v1.ProfileStatus
+	128, // 324: minder.v1.ListEvaluationResultsResponse.EntityProfileEvaluationResults.results:type_name -> minder.v1.RuleEvaluationStatus
+	129, // 325: minder.v1.ListEvaluationResultsResponse.EntityEvaluationResults.entity:type_name -> minder.v1.EntityTypedId
+	281, // 326: minder.v1.ListEvaluationResultsResponse.EntityEvaluationResults.profiles:type_name -> minder.v1.ListEvaluationResultsResponse.EntityProfileEvaluationResults
+	325, // 327: minder.v1.RuleType.Definition.rule_schema:type_name -> google.protobuf.Struct
+	325, // 328: minder.v1.RuleType.Definition.param_schema:type_name -> google.protobuf.Struct
+	288, // 329: minder.v1.RuleType.Definition.ingest:type_name -> minder.v1.RuleType.Definition.Ingest
+	289, // 330: minder.v1.RuleType.Definition.eval:type_name -> minder.v1.RuleType.Definition.Eval
+	290, // 331: minder.v1.RuleType.Definition.remediate:type_name -> minder.v1.RuleType.Definition.Remediate
+	291, // 332: minder.v1.RuleType.Definition.alert:type_name -> minder.v1.RuleType.Definition.Alert
+	292, // 333: minder.v1.RuleType.Definition.limits:type_name -> minder.v1.RuleType.Definition.Limits
+	293, // 334: minder.v1.RuleType.Definition.migration:type_name -> minder.v1.RuleType.Definition.Migration
+	174, // 335: minder.v1.RuleType.Definition.Ingest.rest:type_name -> minder.v1.RestType
+	175, // 336: minder.v1.RuleType.Definition.Ingest.builtin:type_name -> minder.v1.BuiltinType
+	176, // 337: minder.v1.RuleType.Definition.Ingest.artifact:type_name -> minder.v1.ArtifactType
+	177, // 338: minder.v1.RuleType.Definition.Ingest.git:type_name -> minder.v1.GitType
+	178, // 339: minder.v1.RuleType.Definition.Ingest.diff:type_name -> minder.v1.DiffType
+	179, // 340: minder.v1.RuleType.Definition.Ingest.deps:type_name -> minder.v1.DepsType
+	180, // 341: minder.v1.RuleType.Definition.Ingest.alerts:type_name -> minder.v1.AlertsType
+	294, // 342: minder.v1.RuleType.Definition.Eval.jq:type_name -> minder.v1.RuleType.Definition.Eval.JQComparison
+	295, // 343: minder.v1.RuleType.Definition.Eval.rego:type_name -> minder.v1.RuleType.Definition.Eval.Rego
+	296, // 344: minder.v1.RuleType.Definition.Eval.vulncheck:type_name -> minder.v1.RuleType.Definition.Eval.Vulncheck
+	297, // 345: minder.v1.RuleType.Definition.Eval.trusty:type_name -> minder.v1.RuleType.Definition.Eval.Trusty
+	298, // 346: minder.v1.RuleType.Definition.Eval.homoglyphs:type_name -> minder.v1.RuleType.Definition.Eval.Homoglyphs
+	271, // 347: minder.v1.RuleType.Definition.Eval.data_sources:type_name -> minder.v1.DataSourceReference
+	174, // 348: minder.v1.RuleType.Definition.Remediate.rest:type_name -> minder.v1.RestType
+	300, // 349: minder.v1.RuleType.Definition.Remediate.gh_branch_protection:type_name -> minder.v1.RuleType.Definition.Remediate.GhBranchProtectionType
+	302, // 350: minder.v1.RuleType.Definition.Remediate.pull_request:type_name -> minder.v1.RuleType.Definition.Remediate.PullRequestRemediation
+	301, // 351: minder.v1.RuleType.Definition.Remediate.gh_ruleset:type_name -> minder.v1.RuleType.Definition.Remediate.GhRulesetType
+	305, // 352: minder.v1.RuleType.Definition.Alert.security_advisory:type_name -> minder.v1.RuleType.Definition.Alert.AlertTypeSA
+	306, // 353: minder.v1.RuleType.Definition.Alert.pull_request_comment:type_name -> minder.v1.RuleType.Definition.Alert.AlertTypePRComment
+	299, // 354: minder.v1.RuleType.Definition.Eval.JQComparison.ingested:type_name -> minder.v1.RuleType.Definition.Eval.JQComparison.Operator
+	299, // 355: minder.v1.RuleType.Definition.Eval.JQComparison.profile:type_name -> minder.v1.RuleType.Definition.Eval.JQComparison.Operator
+	327, // 356: minder.v1.RuleType.Definition.Eval.JQComparison.constant:type_name -> google.protobuf.Value
+	303, // 357: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.contents:type_name -> minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.Content
+	325, // 358: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.params:type_name -> google.protobuf.Struct
+	304, // 359: minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.actions_replace_tags_with_sha:type_name -> minder.v1.RuleType.Definition.Remediate.PullRequestRemediation.ActionsReplaceTagsWithSha
+	325, // 360: minder.v1.Profile.Rule.params:type_name -> google.protobuf.Struct
+	325, // 361: minder.v1.Profile.Rule.def:type_name -> google.protobuf.Struct
+	310, // 362: minder.v1.ComplianceReport.Group.counts:type_name -> minder.v1.ComplianceReport.Counts
+	324, // 363: minder.v1.ComplianceReport.Finding.last_updated:type_name -> google.protobuf.Timestamp
+	324, // 364: minder.v1.ComplianceReport.TrendPoint.day:type_name -> google.protobuf.Timestamp
+	310, // 365: minder.v1.ComplianceReport.TrendPoint.counts:type_name -> minder.v1.ComplianceReport.Counts
+	327, // 366: minder.v1.RegisterEntityRequest.IdentifyingPropertiesEntry.value:type_name -> google.protobuf.Value
+	317, // 367: minder.v1.StructDataSource.Def.path:type_name -> minder.v1.StructDataSource.Def.Path
+	315, // 368: minder.v1.StructDataSource.DefEntry.value:type_name -> minder.v1.StructDataSource.Def
+	320, // 369: minder.v1.RestDataSource.Def.headers:type_name -> minder.v1.RestDataSource.Def.HeadersEntry
+	325, // 370: minder.v1.RestDataSource.Def.bodyobj:type_name -> google.protobuf.Struct
+	321, // 371: minder.v1.RestDataSource.Def.fallback:type_name -> minder.v1.RestDataSource.Def.Fallback
+	325, // 372: minder.v1.RestDataSource.Def.input_schema:type_name -> google.protobuf.Struct
+	318, // 373: minder.v1.RestDataSource.DefEntry.value:type_name -> minder.v1.RestDataSource.Def
+	328, // 374: minder.v1.name:extendee -> google.protobuf.EnumValueOptions
+	329, // 375: minder.v1.rpc_options:extendee -> google.protobuf.MethodOptions
+	12,  // 376: minder.v1.rpc_options:type_name -> minder.v1.RpcOptions
+	29,  // 377: minder.v1.HealthService.CheckHealth:input_type -> minder.v1.CheckHealthRequest
+	15,  // 378: minder.v1.ArtifactService.ListArtifacts:input_type -> minder.v1.ListArtifactsRequest
+	19,  // 379: minder.v1.ArtifactService.GetArtifactById:input_type -> minder.v1.GetArtifactByIdRequest
+	21,  // 380: minder.v1.ArtifactService.GetArtifactByName:input_type -> minder.v1.GetArtifactByNameRequest
+	31,  // 381: minder.v1.OAuthService.GetAuthorizationURL:input_type -> minder.v1.GetAuthorizationURLRequest
+	33,  // 382: minder.v1.OAuthService.StoreProviderToken:input_type -> minder.v1.StoreProviderTokenRequest
+	66,  // 383: minder.v1.OAuthService.VerifyProviderTokenFrom:input_type -> minder.v1.VerifyProviderTokenFromRequest
+	68,  // 384: minder.v1.OAuthService.VerifyProviderCredential:input_type -> minder.v1.VerifyProviderCredentialRequest
+	41,  // 385: minder.v1.RepositoryService.RegisterRepository:input_type -> minder.v1.RegisterRepositoryRequest
+	36,  // 386: minder.v1.RepositoryService.ListRemoteRepositoriesFromProvider:input_type -> minder.v1.ListRemoteRepositoriesFromProviderRequest
+	62,  // 387: minder.v1.RepositoryService.ListRepositories:input_type -> minder.v1.ListRepositoriesRequest
+	54,  // 388: minder.v1.RepositoryService.GetRepositoryById:input_type -> minder.v1.GetRepositoryByIdRequest
+	58,  // 389: minder.v1.RepositoryService.GetRepositoryByName:input_type -> minder.v1.GetRepositoryByNameRequest
+	56,  // 390: minder.v1.RepositoryService.DeleteRepositoryById:input_type -> minder.v1.DeleteRepositoryByIdRequest
+	60,  // 391: minder.v1.RepositoryService.DeleteRepositoryByName:input_type -> minder.v1.DeleteRepositoryByNameRequest
+	45,  // 392: minder.v1.RepositoryService.CreateAutoRegistrationRule:input_type -> minder.v1.CreateAutoRegistrationRuleRequest
+	47,  // 393: minder.v1.RepositoryService.ListAutoRegistrationRules:input_type -> minder.v1.ListAutoRegistrationRulesRequest
+	49,  // 394: minder.v1.RepositoryService.DeleteAutoRegistrationRule:input_type -> minder.v1.DeleteAutoRegistrationRuleRequest
+	51,  // 395: minder.v1.RepositoryService.ReconcileAutoRegistration:input_type -> minder.v1.ReconcileAutoRegistrationRequest
+	70,  // 396: minder.v1.UserService.CreateUser:input_type -> minder.v1.CreateUserRequest
+	72,  // 397: minder.v1.UserService.DeleteUser:input_type -> minder.v1.DeleteUserRequest
+	76,  // 398: minder.v1.UserService.GetUser:input_type -> minder.v1.GetUserRequest
+	211, // 399: minder.v1.UserService.ListInvitations:input_type -> minder.v1.ListInvitationsRequest
+	213, // 400: minder.v1.UserService.ResolveInvitation:input_type -> minder.v1.ResolveInvitationRequest
+	112, // 401: minder.v1.ProfileService.CreateProfile:input_type -> minder.v1.CreateProfileRequest
+	114, // 402: minder.v1.ProfileService.UpdateProfile:input_type -> minder.v1.UpdateProfileRequest
+	116, // 403: minder.v1.ProfileService.PatchProfile:input_type -> minder.v1.PatchProfileRequest
+	118, // 404: minder.v1.ProfileService.DeleteProfile:input_type -> minder.v1.DeleteProfileRequest
+	120, // 405: minder.v1.ProfileService.ListProfiles:input_type -> minder.v1.ListProfilesRequest
+	122, // 406: minder.v1.ProfileService.GetProfileById:input_type -> minder.v1.GetProfileByIdRequest
+	124, // 407: minder.v1.ProfileService.GetProfileByName:input_type -> minder.v1.GetProfileByNameRequest
+	130, // 408: minder.v1.ProfileService.GetProfileStatusByName:input_type -> minder.v1.GetProfileStatusByNameRequest
+	132, // 409: minder.v1.ProfileService.GetProfileStatusById:input_type -> minder.v1.GetProfileStatusByIdRequest
+	134, // 410: minder.v1.ProfileService.GetProfileStatusByProject:input_type -> minder.v1.GetProfileStatusByProjectRequest
+	137, // 411: minder.v1.ProfileService.CreateWaiver:input_type -> minder.v1.CreateWaiverRequest
+	139, // 412: minder.v1.ProfileService.UpdateWaiver:input_type -> minder.v1.UpdateWaiverRequest
+	141, // 413: minder.v1.ProfileService.GetWaiverById:input_type -> minder.v1.GetWaiverByIdRequest
+	143, // 414: minder.v1.ProfileService.ListWaivers:input_type -> minder.v1.ListWaiversRequest
+	145, // 415: minder.v1.ProfileService.DeleteWaiver:input_type -> minder.v1.DeleteWaiverRequest
+	78,  // 416: minder.v1.DataSourceService.CreateDataSource:input_type -> minder.v1.CreateDataSourceRequest
+	80,  // 417: minder.v1.DataSourceService.GetDataSourceById:input_type -> minder.v1.GetDataSourceByIdRequest
+	82,  // 418: minder.v1.DataSourceService.GetDataSourceByName:input_type -> minder.v1.GetDataSourceByNameRequest
+	84,  // 419: minder.v1.DataSourceService.ListDataSources:input_type -> minder.v1.ListDataSourcesRequest
+	86,  // 420: minder.v1.DataSourceService.UpdateDataSource:input_type -> minder.v1.UpdateDataSourceRequest
+	88,  // 421: minder.v1.DataSourceService.DeleteDataSourceById:input_type -> minder.v1.DeleteDataSourceByIdRequest
+	90,  // 422: minder.v1.DataSourceService.DeleteDataSourceByName:input_type -> minder.v1.DeleteDataSourceByNameRequest
+	92,  // 423: minder.v1.TrustRootService.CreateTrustRoot:input_type -> minder.v1.CreateTrustRootRequest
+	94,  // 424: minder.v1.TrustRootService.GetTrustRootByName:input_type -> minder.v1.GetTrustRootByNameRequest
+	96,  // 425: minder.v1.TrustRootService.ListTrustRoots:input_type -> minder.v1.ListTrustRootsRequest
+	98,  // 426: minder.v1.TrustRootService.UpdateTrustRoot:input_type -> minder.v1.UpdateTrustRootRequest
+	100, // 427: minder.v1.TrustRootService.DeleteTrustRootByName:input_type -> minder.v1.DeleteTrustRootByNameRequest
+	102, // 428: minder.v1.MarketplaceService.ListBundles:input_type -> minder.v1.ListBundlesRequest
+	104, // 429: minder.v1.MarketplaceService.ListBundleSubscriptions:input_type -> minder.v1.ListBundleSubscriptionsRequest
+	106, // 430: minder.v1.MarketplaceService.SubscribeBundle:input_type -> minder.v1.SubscribeBundleRequest
+	108, // 431: minder.v1.MarketplaceService.UnsubscribeBundle:input_type -> minder.v1.UnsubscribeBundleRequest
+	110, // 432: minder.v1.MarketplaceService.UpgradeBundle:input_type -> minder.v1.UpgradeBundleRequest
+	159, // 433: minder.v1.RuleTypeService.ListRuleTypes:input_type -> minder.v1.ListRuleTypesRequest
+	161, // 434: minder.v1.RuleTypeService.GetRuleTypeByName:input_type -> minder.v1.GetRuleTypeByNameRequest
+	163, // 435: minder.v1.RuleTypeService.GetRuleTypeById:input_type -> minder.v1.GetRuleTypeByIdRequest
+	165, // 436: minder.v1.RuleTypeService.CreateRuleType:input_type -> minder.v1.CreateRuleTypeRequest
+	167, // 437: minder.v1.RuleTypeService.UpdateRuleType:input_type -> minder.v1.UpdateRuleTypeRequest
+	170, // 438: minder.v1.RuleTypeService.DeleteRuleType:input_type -> minder.v1.DeleteRuleTypeRequest
+	172, // 439: minder.v1.EvalResultsService.ListEvaluationResults:input_type -> minder.v1.ListEvaluationResultsRequest
+	236, // 440: minder.v1.EvalResultsService.ListEvaluationHistory:input_type -> minder.v1.ListEvaluationHistoryRequest
+	235, // 441: minder.v1.EvalResultsService.GetEvaluationHistory:input_type -> minder.v1.GetEvaluationHistoryRequest
+	238, // 442: minder.v1.EvalResultsService.WatchEvaluations:input_type -> minder.v1.WatchEvaluationsRequest
+	240, // 443: minder.v1.EvalResultsService.ExplainEvaluation:input_type -> minder.v1.ExplainEvaluationRequest
+	243, // 444: minder.v1.EvalResultsService.GenerateComplianceReport:input_type -> minder.v1.GenerateComplianceReportRequest
+	199, // 445: minder.v1.PermissionsService.ListRoles:input_type -> minder.v1.ListRolesRequest
+	201, // 446: minder.v1.PermissionsService.ListRoleAssignments:input_type -> minder.v1.ListRoleAssignmentsRequest
+	203, // 447: minder.v1.PermissionsService.AssignRole:input_type -> minder.v1.AssignRoleRequest
+	205, // 448: minder.v1.PermissionsService.UpdateRole:input_type -> minder.v1.UpdateRoleRequest
+	207, // 449: minder.v1.PermissionsService.RemoveRole:input_type -> minder.v1.RemoveRoleRequest
+	184, // 450: minder.v1.ProjectsService.ListProjects:input_type -> minder.v1.ListProjectsRequest
+	186, // 451: minder.v1.ProjectsService.CreateProject:input_type -> minder.v1.CreateProjectRequest
+	195, // 452: minder.v1.ProjectsService.ListChildProjects:input_type -> minder.v1.ListChildProjectsRequest
+	188, // 453: minder.v1.ProjectsService.DeleteProject:input_type -> minder.v1.DeleteProjectRequest
+	190, // 454: minder.v1.ProjectsService.UpdateProject:input_type -> minder.v1.UpdateProjectRequest
+	193, // 455: minder.v1.ProjectsService.PatchProject:input_type -> minder.v1.PatchProjectRequest
+	197, // 456: minder.v1.ProjectsService.CreateEntityReconciliationTask:input_type -> minder.v1.CreateEntityReconciliationTaskRequest
+	228, // 457: minder.v1.ProvidersService.PatchProvider:input_type -> minder.v1.PatchProviderRequest
+	216, // 458: minder.v1.ProvidersService.GetProvider:input_type -> minder.v1.GetProviderRequest
+	218, // 459: minder.v1.ProvidersService.ListProviders:input_type -> minder.v1.ListProvidersRequest
+	220, // 460: minder.v1.ProvidersService.CreateProvider:input_type -> minder.v1.CreateProviderRequest
+	222, // 461: minder.v1.ProvidersService.DeleteProvider:input_type -> minder.v1.DeleteProviderRequest
+	224, // 462: minder.v1.ProvidersService.DeleteProviderByID:input_type -> minder.v1.DeleteProviderByIDRequest
+	226, // 463: minder.v1.ProvidersService.ListProviderClasses:input_type -> minder.v1.ListProviderClassesRequest
+	64,  // 464: minder.v1.ProvidersService.ReconcileEntityRegistration:input_type -> minder.v1.ReconcileEntityRegistrationRequest
+	27,  // 465: minder.v1.InviteService.GetInviteDetails:input_type -> minder.v1.GetInviteDetailsRequest
+	254, // 466: minder.v1.EntityInstanceService.ListEntities:input_type -> minder.v1.ListEntitiesRequest
+	256, // 467: minder.v1.EntityInstanceService.GetEntityById:input_type -> minder.v1.GetEntityByIdRequest
+	258, // 468: minder.v1.EntityInstanceService.GetEntityByName:input_type -> minder.v1.GetEntityByNameRequest
+	260, // 469: minder.v1.EntityInstanceService.ListEntityPropertyHistory:input_type -> minder.v1.ListEntityPropertyHistoryRequest
+	263, // 470: minder.v1.EntityInstanceService.DeleteEntityById:input_type -> minder.v1.DeleteEntityByIdRequest
+	265, // 471: minder.v1.EntityInstanceService.RegisterEntity:input_type -> minder.v1.RegisterEntityRequest
+	30,  // 472: minder.v1.HealthService.CheckHealth:output_type -> minder.v1.CheckHealthResponse
+	16,  // 473: minder.v1.ArtifactService.ListArtifacts:output_type -> minder.v1.ListArtifactsResponse
+	20,  // 474: minder.v1.ArtifactService.GetArtifactById:output_type -> minder.v1.GetArtifactByIdResponse
+	22,  // 475: minder.v1.ArtifactService.GetArtifactByName:output_type -> minder.v1.GetArtifactByNameResponse
+	32,  // 476: minder.v1.OAuthService.GetAuthorizationURL:output_type -> minder.v1.GetAuthorizationURLResponse
+	34,  // 477: minder.v1.OAuthService.StoreProviderToken:output_type -> minder.v1.StoreProviderTokenResponse
+	67,  // 478: minder.v1.OAuthService.VerifyProviderTokenFrom:output_type -> minder.v1.VerifyProviderTokenFromResponse
+	69,  // 479: minder.v1.OAuthService.VerifyProviderCredential:output_type -> minder.v1.VerifyProviderCredentialResponse
+	43,  // 480: minder.v1.RepositoryService.RegisterRepository:output_type -> minder.v1.RegisterRepositoryResponse
+	37,  // 481: minder.v1.RepositoryService.ListRemoteRepositoriesFromProvider:output_type -> minder.v1.ListRemoteRepositoriesFromProviderResponse
+	63,  // 482: minder.v1.RepositoryService.ListRepositories:output_type -> minder.v1.ListRepositoriesResponse
+	55,  // 483: minder.v1.RepositoryService.GetRepositoryById:output_type -> minder.v1.GetRepositoryByIdResponse
+	59,  // 484: minder.v1.RepositoryService.GetRepositoryByName:output_type -> minder.v1.GetRepositoryByNameResponse
+	57,  // 485: minder.v1.RepositoryService.DeleteRepositoryById:output_type -> minder.v1.DeleteRepositoryByIdResponse
+	61,  // 486: minder.v1.RepositoryService.DeleteRepositoryByName:output_type -> minder.v1.DeleteRepositoryByNameResponse
+	46,  // 487: minder.v1.RepositoryService.CreateAutoRegistrationRule:output_type -> minder.v1.CreateAutoRegistrationRuleResponse
+	48,  // 488: minder.v1.RepositoryService.ListAutoRegistrationRules:output_type -> minder.v1.ListAutoRegistrationRulesResponse
+	50,  // 489: minder.v1.RepositoryService.DeleteAutoRegistrationRule:output_type -> minder.v1.DeleteAutoRegistrationRuleResponse
+	52,  // 490: minder.v1.RepositoryService.ReconcileAutoRegistration:output_type -> minder.v1.ReconcileAutoRegistrationResponse
+	71,  // 491: minder.v1.UserService.CreateUser:output_type -> minder.v1.CreateUserResponse
+	73,  // 492: minder.v1.UserService.DeleteUser:output_type -> minder.v1.DeleteUserResponse
+	77,  // 493: minder.v1.UserService.GetUser:output_type -> minder.v1.GetUserResponse
+	212, // 494: minder.v1.UserService.ListInvitations:output_type -> minder.v1.ListInvitationsResponse
+	214, // 495: minder.v1.UserService.ResolveInvitation:output_type -> minder.v1.ResolveInvitationResponse
+	113, // 496: minder.v1.ProfileService.CreateProfile:output_type -> minder.v1.CreateProfileResponse
+	115, // 497: minder.v1.ProfileService.UpdateProfile:output_type -> minder.v1.UpdateProfileResponse
+	117, // 498: minder.v1.ProfileService.PatchProfile:output_type -> minder.v1.PatchProfileResponse
+	119, // 499: minder.v1.ProfileService.DeleteProfile:output_type -> minder.v1.DeleteProfileResponse
+	121, // 500: minder.v1.ProfileService.ListProfiles:output_type -> minder.v1.ListProfilesResponse
+	123, // 501: minder.v1.ProfileService.GetProfileById:output_type -> minder.v1.GetProfileByIdResponse
+	125, // 502: minder.v1.ProfileService.GetProfileByName:output_type -> minder.v1.GetProfileByNameResponse
+	131, // 503: minder.v1.ProfileService.GetProfileStatusByName:output_type -> minder.v1.GetProfileStatusByNameResponse
+	133, // 504: minder.v1.ProfileService.GetProfileStatusById:output_type -> minder.v1.GetProfileStatusByIdResponse
+	135, // 505: minder.v1.ProfileService.GetProfileStatusByProject:output_type -> minder.v1.GetProfileStatusByProjectResponse
+	138, // 506: minder.v1.ProfileService.CreateWaiver:output_type -> minder.v1.CreateWaiverResponse
+	140, // 507: minder.v1.ProfileService.UpdateWaiver:output_type -> minder.v1.UpdateWaiverResponse
+	142, // 508: minder.v1.ProfileService.GetWaiverById:output_type -> minder.v1.GetWaiverByIdResponse
+	144, // 509: minder.v1.ProfileService.ListWaivers:output_type -> minder.v1.ListWaiversResponse
+	146, // 510: minder.v1.ProfileService.DeleteWaiver:output_type -> minder.v1.DeleteWaiverResponse
+	79,  // 511: minder.v1.DataSourceService.CreateDataSource:output_type -> minder.v1.CreateDataSourceResponse
+	81,  // 512: minder.v1.DataSourceService.GetDataSourceById:output_type -> minder.v1.GetDataSourceByIdResponse
+	83,  // 513: minder.v1.DataSourceService.GetDataSourceByName:output_type -> minder.v1.GetDataSourceByNameResponse
+	85,  // 514: minder.v1.DataSourceService.ListDataSources:output_type -> minder.v1.ListDataSourcesResponse
+	87,  // 515: minder.v1.DataSourceService.UpdateDataSource:output_type -> minder.v1.UpdateDataSourceResponse
+	89,  // 516: minder.v1.DataSourceService.DeleteDataSourceById:output_type -> minder.v1.DeleteDataSourceByIdResponse
+	91,  // 517: minder.v1.DataSourceService.DeleteDataSourceByName:output_type -> minder.v1.DeleteDataSourceByNameResponse
+	93,  // 518: minder.v1.TrustRootService.CreateTrustRoot:output_type -> minder.v1.CreateTrustRootResponse
+	95,  // 519: minder.v1.TrustRootService.GetTrustRootByName:output_type -> minder.v1.GetTrustRootByNameResponse
+	97,  // 520: minder.v1.TrustRootService.ListTrustRoots:output_type -> minder.v1.ListTrustRootsResponse
+	99,  // 521: minder.v1.TrustRootService.UpdateTrustRoot:output_type -> minder.v1.UpdateTrustRootResponse
+	101, // 522: minder.v1.TrustRootService.DeleteTrustRootByName:output_type -> minder.v1.DeleteTrustRootByNameResponse
+	103, // 523: minder.v1.MarketplaceService.ListBundles:output_type -> minder.v1.ListBundlesResponse
+	105, // 524: minder.v1.MarketplaceService.ListBundleSubscriptions:output_type -> minder.v1.ListBundleSubscriptionsResponse
+	107, // 525: minder.v1.MarketplaceService.SubscribeBundle:output_type -> minder.v1.SubscribeBundleResponse
+	109, // 526: minder.v1.MarketplaceService.UnsubscribeBundle:output_type -> minder.v1.UnsubscribeBundleResponse
+	111, // 527: minder.v1.MarketplaceService.UpgradeBundle:output_type -> minder.v1.UpgradeBundleResponse
+	160, // 528: minder.v1.RuleTypeService.ListRuleTypes:output_type -> minder.v1.ListRuleTypesResponse
+	162, // 529: minder.v1.RuleTypeService.GetRuleTypeByName:output_type -> minder.v1.GetRuleTypeByNameResponse
+	164, // 530: minder.v1.RuleTypeService.GetRuleTypeById:output_type -> minder.v1.GetRuleTypeByIdResponse
+	166, // 531: minder.v1.RuleTypeService.CreateRuleType:output_type -> minder.v1.CreateRuleTypeResponse
+	168, // 532: minder.v1.RuleTypeService.UpdateRuleType:output_type -> minder.v1.UpdateRuleTypeResponse
+	171, // 533: minder.v1.RuleTypeService.DeleteRuleType:output_type -> minder.v1.DeleteRuleTypeResponse
+	173, // 534: minder.v1.EvalResultsService.ListEvaluationResults:output_type -> minder.v1.ListEvaluationResultsResponse
+	246, // 535: minder.v1.EvalResultsService.ListEvaluationHistory:output_type -> minder.v1.ListEvaluationHistoryResponse
+	237, // 536: minder.v1.EvalResultsService.GetEvaluationHistory:output_type -> minder.v1.GetEvaluationHistoryResponse
+	239, // 537: minder.v1.EvalResultsService.WatchEvaluations:output_type -> minder.v1.WatchEvaluationsResponse
+	241, // 538: minder.v1.EvalResultsService.ExplainEvaluation:output_type -> minder.v1.ExplainEvaluationResponse
+	244, // 539: minder.v1.EvalResultsService.GenerateComplianceReport:output_type -> minder.v1.GenerateComplianceReportResponse
+	200, // 540: minder.v1.PermissionsService.ListRoles:output_type -> minder.v1.ListRolesResponse
+	202, // 541: minder.v1.PermissionsService.ListRoleAssignments:output_type -> minder.v1.ListRoleAssignmentsResponse
+	204, // 542: minder.v1.PermissionsService.AssignRole:output_type -> minder.v1.AssignRoleResponse
+	206, // 543: minder.v1.PermissionsService.UpdateRole:output_type -> minder.v1.UpdateRoleResponse
+	208, // 544: minder.v1.PermissionsService.RemoveRole:output_type -> minder.v1.RemoveRoleResponse
+	185, // 545: minder.v1.ProjectsService.ListProjects:output_type -> minder.v1.ListProjectsResponse
+	187, // 546: minder.v1.ProjectsService.CreateProject:output_type -> minder.v1.CreateProjectResponse
+	196, // 547: minder.v1.ProjectsService.ListChildProjects:output_type -> minder.v1.ListChildProjectsResponse
+	189, // 548: minder.v1.ProjectsService.DeleteProject:output_type -> minder.v1.DeleteProjectResponse
+	191, // 549: minder.v1.ProjectsService.UpdateProject:output_type -> minder.v1.UpdateProjectResponse
+	194, // 550: minder.v1.ProjectsService.PatchProject:output_type -> minder.v1.PatchProjectResponse
+	198, // 551: minder.v1.ProjectsService.CreateEntityReconciliationTask:output_type -> minder.v1.CreateEntityReconciliationTaskResponse
+	229, // 552: minder.v1.ProvidersService.PatchProvider:output_type -> minder.v1.PatchProviderResponse
+	217, // 553: minder.v1.ProvidersService.GetProvider:output_type -> minder.v1.GetProviderResponse
+	219, // 554: minder.v1.ProvidersService.ListProviders:output_type -> minder.v1.ListProvidersResponse
+	221, // 555: minder.v1.ProvidersService.CreateProvider:output_type -> minder.v1.CreateProviderResponse
+	223, // 556: minder.v1.ProvidersService.DeleteProvider:output_type -> minder.v1.DeleteProviderResponse
+	225, // 557: minder.v1.ProvidersService.DeleteProviderByID:output_type -> minder.v1.DeleteProviderByIDResponse
+	227, // 558: minder.v1.ProvidersService.ListProviderClasses:output_type -> minder.v1.ListProviderClassesResponse
+	65,  // 559: minder.v1.ProvidersService.ReconcileEntityRegistration:output_type -> minder.v1.ReconcileEntityRegistrationResponse
+	28,  // 560: minder.v1.InviteService.GetInviteDetails:output_type -> minder.v1.GetInviteDetailsResponse
+	255, // 561: minder.v1.EntityInstanceService.ListEntities:output_type -> minder.v1.ListEntitiesResponse
+	257, // 562: minder.v1.EntityInstanceService.GetEntityById:output_type -> minder.v1.GetEntityByIdResponse
+	259, // 563: minder.v1.EntityInstanceService.GetEntityByName:output_type -> minder.v1.GetEntityByNameResponse
+	262, // 564: minder.v1.EntityInstanceService.ListEntityPropertyHistory:output_type -> minder.v1.ListEntityPropertyHistoryResponse
+	264, // 565: minder.v1.EntityInstanceService.DeleteEntityById:output_type -> minder.v1.DeleteEntityByIdResponse
+	266, // 566: minder.v1.EntityInstanceService.RegisterEntity:output_type -> minder.v1.RegisterEntityResponse
+	472, // [472:567] is the sub-list for method output_type
+	377, // [377:472] is the sub-list for method input_type
+	376, // [376:377] is the sub-list for extension type_name
+	374, // [374:376] is the sub-list for extension extendee
+	0,   // [0:374] is the sub-list for field type_name
 }
 
 func init() { file_minder_v1_minder_proto_init() }
@@ -16680,46 +22014,46 @@ func file_minder_v1_minder_proto_init() {
 	file_minder_v1_minder_proto_msgTypes[19].OneofWrappers = []any{}
 	file_minder_v1_minder_proto_msgTypes[21].OneofWrappers = []any{}
 	file_minder_v1_minder_proto_msgTypes[28].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[55].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[86].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[94].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[96].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[97].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[98].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[99].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[101].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[102].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[103].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[117].OneofWrappers = []any{
+	file_minder_v1_minder_proto_msgTypes[65].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[116].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[135].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[137].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[138].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[139].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[140].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[143].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[144].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[145].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[160].OneofWrappers = []any{
 		(*ListEvaluationResultsRequest_Profile)(nil),
 		(*ListEvaluationResultsRequest_LabelFilter)(nil),
 	}
-	file_minder_v1_minder_proto_msgTypes[119].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[124].OneofWrappers = []any{
+	file_minder_v1_minder_proto_msgTypes[162].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[167].OneofWrappers = []any{
 		(*DepsType_Repo)(nil),
 		(*DepsType_Pr)(nil),
 	}
-	file_minder_v1_minder_proto_msgTypes[126].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[127].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[136].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[154].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[175].OneofWrappers = []any{
+	file_minder_v1_minder_proto_msgTypes[170].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[171].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[180].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[198].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[219].OneofWrappers = []any{
 		(*ProviderParameter_GithubApp)(nil),
 	}
-	file_minder_v1_minder_proto_msgTypes[200].OneofWrappers = []any{
+	file_minder_v1_minder_proto_msgTypes[256].OneofWrappers = []any{
 		(*DataSource_Structured)(nil),
 		(*DataSource_Rest)(nil),
 	}
-	file_minder_v1_minder_proto_msgTypes[204].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[213].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[214].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[215].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[216].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[217].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[219].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[225].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[226].OneofWrappers = []any{}
-	file_minder_v1_minder_proto_msgTypes[236].OneofWrappers = []any{
+	file_minder_v1_minder_proto_msgTypes[265].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[275].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[276].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[277].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[278].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[279].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[283].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[290].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[291].OneofWrappers = []any{}
+	file_minder_v1_minder_proto_msgTypes[306].OneofWrappers = []any{
 		(*RestDataSource_Def_Bodyobj)(nil),
 		(*RestDataSource_Def_Bodystr)(nil),
 		(*RestDataSource_Def_BodyFromField)(nil),
@@ -16729,10 +22063,10 @@ func file_minder_v1_minder_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_minder_v1_minder_proto_rawDesc), len(file_minder_v1_minder_proto_rawDesc)),
-			NumEnums:      10,
-			NumMessages:   240,
+			NumEnums:      12,
+			NumMessages:   312,
 			NumExtensions: 2,
-			NumServices:   14,
+			NumServices:   16,
 		},
 		GoTypes:           file_minder_v1_minder_proto_goTypes,
 		DependencyIndexes: file_minder_v1_minder_proto_depIdxs,
@@ -538,6 +538,10 @@ const (
 	RepositoryService_GetRepositoryByName_FullMethodName                = "/minder.v1.RepositoryService/GetRepositoryByName"
 	RepositoryService_DeleteRepositoryById_FullMethodName               = "/minder.v1.RepositoryService/DeleteRepositoryById"
 	RepositoryService_DeleteRepositoryByName_FullMethodName             = "/minder.v1.RepositoryService/DeleteRepositoryByName"
+	RepositoryService_CreateAutoRegistrationRule_FullMethodName         = "/minder.v1.RepositoryService/CreateAutoRegistrationRule"
+	RepositoryService_ListAutoRegistrationRules_FullMethodName          = "/minder.v1.RepositoryService/ListAutoRegistrationRules"
+	RepositoryService_DeleteAutoRegistrationRule_FullMethodName         = "/minder.v1.RepositoryService/DeleteAutoRegistrationRule"
+	RepositoryService_ReconcileAutoRegistration_FullMethodName          = "/minder.v1.RepositoryService/ReconcileAutoRegistration"
 )
 
 // RepositoryServiceClient is the client API for RepositoryService service.
@@ -551,6 +555,13 @@ type RepositoryServiceClient interface {
 	GetRepositoryByName(ctx context.Context, in *GetRepositoryByNameRequest, opts ...grpc.CallOption) (*GetRepositoryByNameResponse, error)
 	DeleteRepositoryById(ctx context.Context, in *DeleteRepositoryByIdRequest, opts ...grpc.CallOption) (*DeleteRepositoryByIdResponse, error)
 	DeleteRepositoryByName(ctx context.Context, in *DeleteRepositoryByNameRequest, opts ...grpc.CallOption) (*DeleteRepositoryByNameResponse, error)
+	CreateAutoRegistrationRule(ctx context.Context, in *CreateAutoRegistrationRuleRequest, opts ...grpc.CallOption) (*CreateAutoRegistrationRuleResponse, error)
+	ListAutoRegistrationRules(ctx context.Context, in *ListAutoRegistrationRulesRequest, opts ...grpc.CallOption) (*ListAutoRegistrationRulesResponse, error)
+	DeleteAutoRegistrationRule(ctx context.Context, in *DeleteAutoRegistrationRuleRequest, opts ...grpc.CallOption) (*DeleteAutoRegistrationRuleResponse, error)
+	// ReconcileAutoRegistration registers the remote repositories matching
+	// the auto-registration rules of the project and deregisters the
+	// auto-registered repositories which no longer match any rule.
+	ReconcileAutoRegistration(ctx context.Context, in *ReconcileAutoRegistrationRequest, opts ...grpc.CallOption) (*ReconcileAutoRegistrationResponse, error)
 }
 
 type repositoryServiceClient struct {
@@ -631,6 +642,46 @@ func (c *repositoryServiceClient) DeleteRepositoryByName(ctx context.Context, in
 	return out, nil
 }
 
+func (c *repositoryServiceClient) CreateAutoRegistrationRule(ctx context.Context, in *CreateAutoRegistrationRuleRequest, opts ...grpc.CallOption) (*CreateAutoRegistrationRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAutoRegistrationRuleResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_CreateAutoRegistrationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) ListAutoRegistrationRules(ctx context.Context, in *ListAutoRegistrationRulesRequest, opts ...grpc.CallOption) (*ListAutoRegistrationRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAutoRegistrationRulesResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_ListAutoRegistrationRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) DeleteAutoRegistrationRule(ctx context.Context, in *DeleteAutoRegistrationRuleRequest, opts ...grpc.CallOption) (*DeleteAutoRegistrationRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAutoRegistrationRuleResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_DeleteAutoRegistrationRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryServiceClient) ReconcileAutoRegistration(ctx context.Context, in *ReconcileAutoRegistrationRequest, opts ...grpc.CallOption) (*ReconcileAutoRegistrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileAutoRegistrationResponse)
+	err := c.cc.Invoke(ctx, RepositoryService_ReconcileAutoRegistration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RepositoryServiceServer is the server API for RepositoryService service.
 // All implementations must embed UnimplementedRepositoryServiceServer
 // for forward compatibility.
@@ -642,6 +693,13 @@ type RepositoryServiceServer interface {
 	GetRepositoryByName(context.Context, *GetRepositoryByNameRequest) (*GetRepositoryByNameResponse, error)
 	DeleteRepositoryById(context.Context, *DeleteRepositoryByIdRequest) (*DeleteRepositoryByIdResponse, error)
 	DeleteRepositoryByName(context.Context, *DeleteRepositoryByNameRequest) (*DeleteRepositoryByNameResponse, error)
+	CreateAutoRegistrationRule(context.Context, *CreateAutoRegistrationRuleRequest) (*CreateAutoRegistrationRuleResponse, error)
+	ListAutoRegistrationRules(context.Context, *ListAutoRegistrationRulesRequest) (*ListAutoRegistrationRulesResponse, error)
+	DeleteAutoRegistrationRule(context.Context, *DeleteAutoRegistrationRuleRequest) (*DeleteAutoRegistrationRuleResponse, error)
+	// ReconcileAutoRegistration registers the remote repositories matching
+	// the auto-registration rules of the project and deregisters the
+	// auto-registered repositories which no longer match any rule.
+	ReconcileAutoRegistration(context.Context, *ReconcileAutoRegistrationRequest) (*ReconcileAutoRegistrationResponse, error)
 	mustEmbedUnimplementedRepositoryServiceServer()
 }
 
@@ -673,6 +731,18 @@ func (UnimplementedRepositoryServiceServer) DeleteRepositoryById(context.Context
 func (UnimplementedRepositoryServiceServer) DeleteRepositoryByName(context.Context, *DeleteRepositoryByNameRequest) (*DeleteRepositoryByNameResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteRepositoryByName not implemented")
 }
+func (UnimplementedRepositoryServiceServer) CreateAutoRegistrationRule(context.Context, *CreateAutoRegistrationRuleRequest) (*CreateAutoRegistrationRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAutoRegistrationRule not implemented")
+}
+func (UnimplementedRepositoryServiceServer) ListAutoRegistrationRules(context.Context, *ListAutoRegistrationRulesRequest) (*ListAutoRegistrationRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAutoRegistrationRules not implemented")
+}
+func (UnimplementedRepositoryServiceServer) DeleteAutoRegistrationRule(context.Context, *DeleteAutoRegistrationRuleRequest) (*DeleteAutoRegistrationRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteAutoRegistrationRule not implemented")
+}
+func (UnimplementedRepositoryServiceServer) ReconcileAutoRegistration(context.Context, *ReconcileAutoRegistrationRequest) (*ReconcileAutoRegistrationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReconcileAutoRegistration not implemented")
+}
 func (UnimplementedRepositoryServiceServer) mustEmbedUnimplementedRepositoryServiceServer() {}
 func (UnimplementedRepositoryServiceServer) testEmbeddedByValue()                           {}
 
@@ -820,6 +890,78 @@ func _RepositoryService_DeleteRepositoryByName_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_CreateAutoRegistrationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAutoRegistrationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).CreateAutoRegistrationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_CreateAutoRegistrationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).CreateAutoRegistrationRule(ctx, req.(*CreateAutoRegistrationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_ListAutoRegistrationRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAutoRegistrationRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ListAutoRegistrationRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_ListAutoRegistrationRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ListAutoRegistrationRules(ctx, req.(*ListAutoRegistrationRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_DeleteAutoRegistrationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAutoRegistrationRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).DeleteAutoRegistrationRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_DeleteAutoRegistrationRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).DeleteAutoRegistrationRule(ctx, req.(*DeleteAutoRegistrationRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepositoryService_ReconcileAutoRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileAutoRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).ReconcileAutoRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepositoryService_ReconcileAutoRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).ReconcileAutoRegistration(ctx, req.(*ReconcileAutoRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RepositoryService_ServiceDesc is the grpc.ServiceDesc for RepositoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -855,6 +997,22 @@ var RepositoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRepositoryByName",
 			Handler:    _RepositoryService_DeleteRepositoryByName_Handler,
 		},
+		{
+			MethodName: "CreateAutoRegistrationRule",
+			Handler:    _RepositoryService_CreateAutoRegistrationRule_Handler,
+		},
+		{
+			MethodName: "ListAutoRegistrationRules",
+			Handler:    _RepositoryService_ListAutoRegistrationRules_Handler,
+		},
+		{
+			MethodName: "DeleteAutoRegistrationRule",
+			Handler:    _RepositoryService_DeleteAutoRegistrationRule_Handler,
+		},
+		{
+			MethodName: "ReconcileAutoRegistration",
+			Handler:    _RepositoryService_ReconcileAutoRegistration_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "minder/v1/minder.proto",
@@ -1163,6 +1321,11 @@ const (
 	ProfileService_GetProfileStatusByName_FullMethodName    = "/minder.v1.ProfileService/GetProfileStatusByName"
 	ProfileService_GetProfileStatusById_FullMethodName      = "/minder.v1.ProfileService/GetProfileStatusById"
 	ProfileService_GetProfileStatusByProject_FullMethodName = "/minder.v1.ProfileService/GetProfileStatusByProject"
+	ProfileService_CreateWaiver_FullMethodName              = "/minder.v1.ProfileService/CreateWaiver"
+	ProfileService_UpdateWaiver_FullMethodName              = "/minder.v1.ProfileService/UpdateWaiver"
+	ProfileService_GetWaiverById_FullMethodName             = "/minder.v1.ProfileService/GetWaiverById"
+	ProfileService_ListWaivers_FullMethodName               = "/minder.v1.ProfileService/ListWaivers"
+	ProfileService_DeleteWaiver_FullMethodName              = "/minder.v1.ProfileService/DeleteWaiver"
 )
 
 // ProfileServiceClient is the client API for ProfileService service.
@@ -1179,6 +1342,14 @@ type ProfileServiceClient interface {
 	GetProfileStatusByName(ctx context.Context, in *GetProfileStatusByNameRequest, opts ...grpc.CallOption) (*GetProfileStatusByNameResponse, error)
 	GetProfileStatusById(ctx context.Context, in *GetProfileStatusByIdRequest, opts ...grpc.CallOption) (*GetProfileStatusByIdResponse, error)
 	GetProfileStatusByProject(ctx context.Context, in *GetProfileStatusByProjectRequest, opts ...grpc.CallOption) (*GetProfileStatusByProjectResponse, error)
+	// CreateWaiver accepts the failure of a rule of a profile for a single
+	// entity until the waiver expires. Creating a waiver for a rule and
+	// entity which already have one replaces it.
+	CreateWaiver(ctx context.Context, in *CreateWaiverRequest, opts ...grpc.CallOption) (*CreateWaiverResponse, error)
+	UpdateWaiver(ctx context.Context, in *UpdateWaiverRequest, opts ...grpc.CallOption) (*UpdateWaiverResponse, error)
+	GetWaiverById(ctx context.Context, in *GetWaiverByIdRequest, opts ...grpc.CallOption) (*GetWaiverByIdResponse, error)
+	ListWaivers(ctx context.Context, in *ListWaiversRequest, opts ...grpc.CallOption) (*ListWaiversResponse, error)
+	DeleteWaiver(ctx context.Context, in *DeleteWaiverRequest, opts ...grpc.CallOption) (*DeleteWaiverResponse, error)
 }
 
 type profileServiceClient struct {
@@ -1289,6 +1460,56 @@ func (c *profileServiceClient) GetProfileStatusByProject(ctx context.Context, in
 	return out, nil
 }
 
+func (c *profileServiceClient) CreateWaiver(ctx context.Context, in *CreateWaiverRequest, opts ...grpc.CallOption) (*CreateWaiverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWaiverResponse)
+	err := c.cc.Invoke(ctx, ProfileService_CreateWaiver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) UpdateWaiver(ctx context.Context, in *UpdateWaiverRequest, opts ...grpc.CallOption) (*UpdateWaiverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateWaiverResponse)
+	err := c.cc.Invoke(ctx, ProfileService_UpdateWaiver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) GetWaiverById(ctx context.Context, in *GetWaiverByIdRequest, opts ...grpc.CallOption) (*GetWaiverByIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWaiverByIdResponse)
+	err := c.cc.Invoke(ctx, ProfileService_GetWaiverById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ListWaivers(ctx context.Context, in *ListWaiversRequest, opts ...grpc.CallOption) (*ListWaiversResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWaiversResponse)
+	err := c.cc.Invoke(ctx, ProfileService_ListWaivers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) DeleteWaiver(ctx context.Context, in *DeleteWaiverRequest, opts ...grpc.CallOption) (*DeleteWaiverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWaiverResponse)
+	err := c.cc.Invoke(ctx, ProfileService_DeleteWaiver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProfileServiceServer is the server API for ProfileService service.
 // All implementations must embed UnimplementedProfileServiceServer
 // for forward compatibility.
@@ -1303,6 +1524,14 @@ type ProfileServiceServer interface {
 	GetProfileStatusByName(context.Context, *GetProfileStatusByNameRequest) (*GetProfileStatusByNameResponse, error)
 	GetProfileStatusById(context.Context, *GetProfileStatusByIdRequest) (*GetProfileStatusByIdResponse, error)
 	GetProfileStatusByProject(context.Context, *GetProfileStatusByProjectRequest) (*GetProfileStatusByProjectResponse, error)
+	// CreateWaiver accepts the failure of a rule of a profile for a single
+	// entity until the waiver expires. Creating a waiver for a rule and
+	// entity which already have one replaces it.
+	CreateWaiver(context.Context, *CreateWaiverRequest) (*CreateWaiverResponse, error)
+	UpdateWaiver(context.Context, *UpdateWaiverRequest) (*UpdateWaiverResponse, error)
+	GetWaiverById(context.Context, *GetWaiverByIdRequest) (*GetWaiverByIdResponse, error)
+	ListWaivers(context.Context, *ListWaiversRequest) (*ListWaiversResponse, error)
+	DeleteWaiver(context.Context, *DeleteWaiverRequest) (*DeleteWaiverResponse, error)
 	mustEmbedUnimplementedProfileServiceServer()
 }
 
@@ -1343,6 +1572,21 @@ func (UnimplementedProfileServiceServer) GetProfileStatusById(context.Context, *
 func (UnimplementedProfileServiceServer) GetProfileStatusByProject(context.Context, *GetProfileStatusByProjectRequest) (*GetProfileStatusByProjectResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetProfileStatusByProject not implemented")
 }
+func (UnimplementedProfileServiceServer) CreateWaiver(context.Context, *CreateWaiverRequest) (*CreateWaiverResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateWaiver not implemented")
+}
+func (UnimplementedProfileServiceServer) UpdateWaiver(context.Context, *UpdateWaiverRequest) (*UpdateWaiverResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateWaiver not implemented")
+}
+func (UnimplementedProfileServiceServer) GetWaiverById(context.Context, *GetWaiverByIdRequest) (*GetWaiverByIdResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetWaiverById not implemented")
+}
+func (UnimplementedProfileServiceServer) ListWaivers(context.Context, *ListWaiversRequest) (*ListWaiversResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWaivers not implemented")
+}
+func (UnimplementedProfileServiceServer) DeleteWaiver(context.Context, *DeleteWaiverRequest) (*DeleteWaiverResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteWaiver not implemented")
+}
 func (UnimplementedProfileServiceServer) mustEmbedUnimplementedProfileServiceServer() {}
 func (UnimplementedProfileServiceServer) testEmbeddedByValue()                        {}
 
@@ -1544,6 +1788,96 @@ func _ProfileService_GetProfileStatusByProject_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfileService_CreateWaiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWaiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).CreateWaiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_CreateWaiver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).CreateWaiver(ctx, req.(*CreateWaiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_UpdateWaiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWaiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).UpdateWaiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_UpdateWaiver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).UpdateWaiver(ctx, req.(*UpdateWaiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_GetWaiverById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWaiverByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).GetWaiverById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_GetWaiverById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).GetWaiverById(ctx, req.(*GetWaiverByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ListWaivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWaiversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ListWaivers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_ListWaivers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ListWaivers(ctx, req.(*ListWaiversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_DeleteWaiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWaiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).DeleteWaiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileService_DeleteWaiver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).DeleteWaiver(ctx, req.(*DeleteWaiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProfileService_ServiceDesc is the grpc.ServiceDesc for ProfileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1591,6 +1925,26 @@ var ProfileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProfileStatusByProject",
 			Handler:    _ProfileService_GetProfileStatusByProject_Handler,
 		},
+		{
+			MethodName: "CreateWaiver",
+			Handler:    _ProfileService_CreateWaiver_Handler,
+		},
+		{
+			MethodName: "UpdateWaiver",
+			Handler:    _ProfileService_UpdateWaiver_Handler,
+		},
+		{
+			MethodName: "GetWaiverById",
+			Handler:    _ProfileService_GetWaiverById_Handler,
+		},
+		{
+			MethodName: "ListWaivers",
+			Handler:    _ProfileService_ListWaivers_Handler,
+		},
+		{
+			MethodName: "DeleteWaiver",
+			Handler:    _ProfileService_DeleteWaiver_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "minder/v1/minder.proto",
@@ -1619,307 +1973,821 @@ type DataSourceServiceClient interface {
 	DeleteDataSourceByName(ctx context.Context, in *DeleteDataSourceByNameRequest, opts ...grpc.CallOption) (*DeleteDataSourceByNameResponse, error)
 }
 
-type dataSourceServiceClient struct {
-	cc grpc.ClientConnInterface
-}
+type dataSourceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDataSourceServiceClient(cc grpc.ClientConnInterface) DataSourceServiceClient {
+	return &dataSourceServiceClient{cc}
+}
+
+func (c *dataSourceServiceClient) CreateDataSource(ctx context.Context, in *CreateDataSourceRequest, opts ...grpc.CallOption) (*CreateDataSourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDataSourceResponse)
+	err := c.cc.Invoke(ctx, DataSourceService_CreateDataSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataSourceServiceClient) GetDataSourceById(ctx context.Context, in *GetDataSourceByIdRequest, opts ...grpc.CallOption) (*GetDataSourceByIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDataSourceByIdResponse)
+	err := c.cc.Invoke(ctx, DataSourceService_GetDataSourceById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataSourceServiceClient) GetDataSourceByName(ctx context.Context, in *GetDataSourceByNameRequest, opts ...grpc.CallOption) (*GetDataSourceByNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDataSourceByNameResponse)
+	err := c.cc.Invoke(ctx, DataSourceService_GetDataSourceByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataSourceServiceClient) ListDataSources(ctx context.Context, in *ListDataSourcesRequest, opts ...grpc.CallOption) (*ListDataSourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDataSourcesResponse)
+	err := c.cc.Invoke(ctx, DataSourceService_ListDataSources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataSourceServiceClient) UpdateDataSource(ctx context.Context, in *UpdateDataSourceRequest, opts ...grpc.CallOption) (*UpdateDataSourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDataSourceResponse)
+	err := c.cc.Invoke(ctx, DataSourceService_UpdateDataSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataSourceServiceClient) DeleteDataSourceById(ctx context.Context, in *DeleteDataSourceByIdRequest, opts ...grpc.CallOption) (*DeleteDataSourceByIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDataSourceByIdResponse)
+	err := c.cc.Invoke(ctx, DataSourceService_DeleteDataSourceById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataSourceServiceClient) DeleteDataSourceByName(ctx context.Context, in *DeleteDataSourceByNameRequest, opts ...grpc.CallOption) (*DeleteDataSourceByNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDataSourceByNameResponse)
+	err := c.cc.Invoke(ctx, DataSourceService_DeleteDataSourceByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DataSourceServiceServer is the server API for DataSourceService service.
+// All implementations must embed UnimplementedDataSourceServiceServer
+// for forward compatibility.
+type DataSourceServiceServer interface {
+	CreateDataSource(context.Context, *CreateDataSourceRequest) (*CreateDataSourceResponse, error)
+	GetDataSourceById(context.Context, *GetDataSourceByIdRequest) (*GetDataSourceByIdResponse, error)
+	GetDataSourceByName(context.Context, *GetDataSourceByNameRequest) (*GetDataSourceByNameResponse, error)
+	ListDataSources(context.Context, *ListDataSourcesRequest) (*ListDataSourcesResponse, error)
+	UpdateDataSource(context.Context, *UpdateDataSourceRequest) (*UpdateDataSourceResponse, error)
+	DeleteDataSourceById(context.Context, *DeleteDataSourceByIdRequest) (*DeleteDataSourceByIdResponse, error)
+	DeleteDataSourceByName(context.Context, *DeleteDataSourceByNameRequest) (*DeleteDataSourceByNameResponse, error)
+	mustEmbedUnimplementedDataSourceServiceServer()
+}
+
+// UnimplementedDataSourceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDataSourceServiceServer struct{}
+
+func (UnimplementedDataSourceServiceServer) CreateDataSource(context.Context, *CreateDataSourceRequest) (*CreateDataSourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateDataSource not implemented")
+}
+func (UnimplementedDataSourceServiceServer) GetDataSourceById(context.Context, *GetDataSourceByIdRequest) (*GetDataSourceByIdResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDataSourceById not implemented")
+}
+func (UnimplementedDataSourceServiceServer) GetDataSourceByName(context.Context, *GetDataSourceByNameRequest) (*GetDataSourceByNameResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDataSourceByName not implemented")
+}
+func (UnimplementedDataSourceServiceServer) ListDataSources(context.Context, *ListDataSourcesRequest) (*ListDataSourcesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDataSources not implemented")
+}
+func (UnimplementedDataSourceServiceServer) UpdateDataSource(context.Context, *UpdateDataSourceRequest) (*UpdateDataSourceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateDataSource not implemented")
+}
+func (UnimplementedDataSourceServiceServer) DeleteDataSourceById(context.Context, *DeleteDataSourceByIdRequest) (*DeleteDataSourceByIdResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteDataSourceById not implemented")
+}
+func (UnimplementedDataSourceServiceServer) DeleteDataSourceByName(context.Context, *DeleteDataSourceByNameRequest) (*DeleteDataSourceByNameResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteDataSourceByName not implemented")
+}
+func (UnimplementedDataSourceServiceServer) mustEmbedUnimplementedDataSourceServiceServer() {}
+func (UnimplementedDataSourceServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeDataSourceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DataSourceServiceServer will
+// result in compilation errors.
+type UnsafeDataSourceServiceServer interface {
+	mustEmbedUnimplementedDataSourceServiceServer()
+}
+
+func RegisterDataSourceServiceServer(s grpc.ServiceRegistrar, srv DataSourceServiceServer) {
+	// If the following call panics, it indicates UnimplementedDataSourceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DataSourceService_ServiceDesc, srv)
+}
+
+func _DataSourceService_CreateDataSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDataSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).CreateDataSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_CreateDataSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).CreateDataSource(ctx, req.(*CreateDataSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataSourceService_GetDataSourceById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataSourceByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).GetDataSourceById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_GetDataSourceById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).GetDataSourceById(ctx, req.(*GetDataSourceByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataSourceService_GetDataSourceByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataSourceByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).GetDataSourceByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_GetDataSourceByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).GetDataSourceByName(ctx, req.(*GetDataSourceByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataSourceService_ListDataSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDataSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).ListDataSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_ListDataSources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).ListDataSources(ctx, req.(*ListDataSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataSourceService_UpdateDataSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDataSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).UpdateDataSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_UpdateDataSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).UpdateDataSource(ctx, req.(*UpdateDataSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataSourceService_DeleteDataSourceById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDataSourceByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).DeleteDataSourceById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_DeleteDataSourceById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).DeleteDataSourceById(ctx, req.(*DeleteDataSourceByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataSourceService_DeleteDataSourceByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDataSourceByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataSourceServiceServer).DeleteDataSourceByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataSourceService_DeleteDataSourceByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataSourceServiceServer).DeleteDataSourceByName(ctx, req.(*DeleteDataSourceByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DataSourceService_ServiceDesc is the grpc.ServiceDesc for DataSourceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DataSourceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "minder.v1.DataSourceService",
+	HandlerType: (*DataSourceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDataSource",
+			Handler:    _DataSourceService_CreateDataSource_Handler,
+		},
+		{
+			MethodName: "GetDataSourceById",
+			Handler:    _DataSourceService_GetDataSourceById_Handler,
+		},
+		{
+			MethodName: "GetDataSourceByName",
+			Handler:    _DataSourceService_GetDataSourceByName_Handler,
+		},
+		{
+			MethodName: "ListDataSources",
+			Handler:    _DataSourceService_ListDataSources_Handler,
+		},
+		{
+			MethodName: "UpdateDataSource",
+			Handler:    _DataSourceService_UpdateDataSource_Handler,
+		},
+		{
+			MethodName: "DeleteDataSourceById",
+			Handler:    _DataSourceService_DeleteDataSourceById_Handler,
+		},
+		{
+			MethodName: "DeleteDataSourceByName",
+			Handler:    _DataSourceService_DeleteDataSourceByName_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "minder/v1/minder.proto",
+}
+
+const (
+	TrustRootService_CreateTrustRoot_FullMethodName       = "/minder.v1.TrustRootService/CreateTrustRoot"
+	TrustRootService_GetTrustRootByName_FullMethodName    = "/minder.v1.TrustRootService/GetTrustRootByName"
+	TrustRootService_ListTrustRoots_FullMethodName        = "/minder.v1.TrustRootService/ListTrustRoots"
+	TrustRootService_UpdateTrustRoot_FullMethodName       = "/minder.v1.TrustRootService/UpdateTrustRoot"
+	TrustRootService_DeleteTrustRootByName_FullMethodName = "/minder.v1.TrustRootService/DeleteTrustRootByName"
+)
+
+// TrustRootServiceClient is the client API for TrustRootService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TrustRootService manages the custom Sigstore trust roots that artifact
+// signatures can be verified against.
+type TrustRootServiceClient interface {
+	CreateTrustRoot(ctx context.Context, in *CreateTrustRootRequest, opts ...grpc.CallOption) (*CreateTrustRootResponse, error)
+	GetTrustRootByName(ctx context.Context, in *GetTrustRootByNameRequest, opts ...grpc.CallOption) (*GetTrustRootByNameResponse, error)
+	ListTrustRoots(ctx context.Context, in *ListTrustRootsRequest, opts ...grpc.CallOption) (*ListTrustRootsResponse, error)
+	UpdateTrustRoot(ctx context.Context, in *UpdateTrustRootRequest, opts ...grpc.CallOption) (*UpdateTrustRootResponse, error)
+	DeleteTrustRootByName(ctx context.Context, in *DeleteTrustRootByNameRequest, opts ...grpc.CallOption) (*DeleteTrustRootByNameResponse, error)
+}
+
+type trustRootServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTrustRootServiceClient(cc grpc.ClientConnInterface) TrustRootServiceClient {
+	return &trustRootServiceClient{cc}
+}
+
+func (c *trustRootServiceClient) CreateTrustRoot(ctx context.Context, in *CreateTrustRootRequest, opts ...grpc.CallOption) (*CreateTrustRootResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTrustRootResponse)
+	err := c.cc.Invoke(ctx, TrustRootService_CreateTrustRoot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trustRootServiceClient) GetTrustRootByName(ctx context.Context, in *GetTrustRootByNameRequest, opts ...grpc.CallOption) (*GetTrustRootByNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrustRootByNameResponse)
+	err := c.cc.Invoke(ctx, TrustRootService_GetTrustRootByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trustRootServiceClient) ListTrustRoots(ctx context.Context, in *ListTrustRootsRequest, opts ...grpc.CallOption) (*ListTrustRootsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrustRootsResponse)
+	err := c.cc.Invoke(ctx, TrustRootService_ListTrustRoots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trustRootServiceClient) UpdateTrustRoot(ctx context.Context, in *UpdateTrustRootRequest, opts ...grpc.CallOption) (*UpdateTrustRootResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTrustRootResponse)
+	err := c.cc.Invoke(ctx, TrustRootService_UpdateTrustRoot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trustRootServiceClient) DeleteTrustRootByName(ctx context.Context, in *DeleteTrustRootByNameRequest, opts ...grpc.CallOption) (*DeleteTrustRootByNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTrustRootByNameResponse)
+	err := c.cc.Invoke(ctx, TrustRootService_DeleteTrustRootByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TrustRootServiceServer is the server API for TrustRootService service.
+// All implementations must embed UnimplementedTrustRootServiceServer
+// for forward compatibility.
+//
+// TrustRootService manages the custom Sigstore trust roots that artifact
+// signatures can be verified against.
+type TrustRootServiceServer interface {
+	CreateTrustRoot(context.Context, *CreateTrustRootRequest) (*CreateTrustRootResponse, error)
+	GetTrustRootByName(context.Context, *GetTrustRootByNameRequest) (*GetTrustRootByNameResponse, error)
+	ListTrustRoots(context.Context, *ListTrustRootsRequest) (*ListTrustRootsResponse, error)
+	UpdateTrustRoot(context.Context, *UpdateTrustRootRequest) (*UpdateTrustRootResponse, error)
+	DeleteTrustRootByName(context.Context, *DeleteTrustRootByNameRequest) (*DeleteTrustRootByNameResponse, error)
+	mustEmbedUnimplementedTrustRootServiceServer()
+}
+
+// UnimplementedTrustRootServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTrustRootServiceServer struct{}
+
+func (UnimplementedTrustRootServiceServer) CreateTrustRoot(context.Context, *CreateTrustRootRequest) (*CreateTrustRootResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTrustRoot not implemented")
+}
+func (UnimplementedTrustRootServiceServer) GetTrustRootByName(context.Context, *GetTrustRootByNameRequest) (*GetTrustRootByNameResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTrustRootByName not implemented")
+}
+func (UnimplementedTrustRootServiceServer) ListTrustRoots(context.Context, *ListTrustRootsRequest) (*ListTrustRootsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTrustRoots not implemented")
+}
+func (UnimplementedTrustRootServiceServer) UpdateTrustRoot(context.Context, *UpdateTrustRootRequest) (*UpdateTrustRootResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTrustRoot not implemented")
+}
+func (UnimplementedTrustRootServiceServer) DeleteTrustRootByName(context.Context, *DeleteTrustRootByNameRequest) (*DeleteTrustRootByNameResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTrustRootByName not implemented")
+}
+func (UnimplementedTrustRootServiceServer) mustEmbedUnimplementedTrustRootServiceServer() {}
+func (UnimplementedTrustRootServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeTrustRootServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TrustRootServiceServer will
+// result in compilation errors.
+type UnsafeTrustRootServiceServer interface {
+	mustEmbedUnimplementedTrustRootServiceServer()
+}
+
+func RegisterTrustRootServiceServer(s grpc.ServiceRegistrar, srv TrustRootServiceServer) {
+	// If the following call panics, it indicates UnimplementedTrustRootServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TrustRootService_ServiceDesc, srv)
+}
+
+func _TrustRootService_CreateTrustRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTrustRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrustRootServiceServer).CreateTrustRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrustRootService_CreateTrustRoot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrustRootServiceServer).CreateTrustRoot(ctx, req.(*CreateTrustRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrustRootService_GetTrustRootByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrustRootByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrustRootServiceServer).GetTrustRootByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrustRootService_GetTrustRootByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrustRootServiceServer).GetTrustRootByName(ctx, req.(*GetTrustRootByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrustRootService_ListTrustRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrustRootsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrustRootServiceServer).ListTrustRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrustRootService_ListTrustRoots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrustRootServiceServer).ListTrustRoots(ctx, req.(*ListTrustRootsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrustRootService_UpdateTrustRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTrustRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrustRootServiceServer).UpdateTrustRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrustRootService_UpdateTrustRoot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrustRootServiceServer).UpdateTrustRoot(ctx, req.(*UpdateTrustRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrustRootService_DeleteTrustRootByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTrustRootByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrustRootServiceServer).DeleteTrustRootByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrustRootService_DeleteTrustRootByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrustRootServiceServer).DeleteTrustRootByName(ctx, req.(*DeleteTrustRootByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TrustRootService_ServiceDesc is the grpc.ServiceDesc for TrustRootService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TrustRootService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "minder.v1.TrustRootService",
+	HandlerType: (*TrustRootServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTrustRoot",
+			Handler:    _TrustRootService_CreateTrustRoot_Handler,
+		},
+		{
+			MethodName: "GetTrustRootByName",
+			Handler:    _TrustRootService_GetTrustRootByName_Handler,
+		},
+		{
+			MethodName: "ListTrustRoots",
+			Handler:    _TrustRootService_ListTrustRoots_Handler,
+		},
+		{
+			MethodName: "UpdateTrustRoot",
+			Handler:    _TrustRootService_UpdateTrustRoot_Handler,
+		},
+		{
+			MethodName: "DeleteTrustRootByName",
+			Handler:    _TrustRootService_DeleteTrustRootByName_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "minder/v1/minder.proto",
+}
+
+const (
+	MarketplaceService_ListBundles_FullMethodName             = "/minder.v1.MarketplaceService/ListBundles"
+	MarketplaceService_ListBundleSubscriptions_FullMethodName = "/minder.v1.MarketplaceService/ListBundleSubscriptions"
+	MarketplaceService_SubscribeBundle_FullMethodName         = "/minder.v1.MarketplaceService/SubscribeBundle"
+	MarketplaceService_UnsubscribeBundle_FullMethodName       = "/minder.v1.MarketplaceService/UnsubscribeBundle"
+	MarketplaceService_UpgradeBundle_FullMethodName           = "/minder.v1.MarketplaceService/UpgradeBundle"
+)
 
-func NewDataSourceServiceClient(cc grpc.ClientConnInterface) DataSourceServiceClient {
-	return &dataSourceServiceClient{cc}
+// MarketplaceServiceClient is the client API for MarketplaceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MarketplaceServiceClient interface {
+	ListBundles(ctx context.Context, in *ListBundlesRequest, opts ...grpc.CallOption) (*ListBundlesResponse, error)
+	ListBundleSubscriptions(ctx context.Context, in *ListBundleSubscriptionsRequest, opts ...grpc.CallOption) (*ListBundleSubscriptionsResponse, error)
+	SubscribeBundle(ctx context.Context, in *SubscribeBundleRequest, opts ...grpc.CallOption) (*SubscribeBundleResponse, error)
+	UnsubscribeBundle(ctx context.Context, in *UnsubscribeBundleRequest, opts ...grpc.CallOption) (*UnsubscribeBundleResponse, error)
+	UpgradeBundle(ctx context.Context, in *UpgradeBundleRequest, opts ...grpc.CallOption) (*UpgradeBundleResponse, error)
 }
 
-func (c *dataSourceServiceClient) CreateDataSource(ctx context.Context, in *CreateDataSourceRequest, opts ...grpc.CallOption) (*CreateDataSourceResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateDataSourceResponse)
-	err := c.cc.Invoke(ctx, DataSourceService_CreateDataSource_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type marketplaceServiceClient struct {
+	cc grpc.ClientConnInterface
 }
 
-func (c *dataSourceServiceClient) GetDataSourceById(ctx context.Context, in *GetDataSourceByIdRequest, opts ...grpc.CallOption) (*GetDataSourceByIdResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetDataSourceByIdResponse)
-	err := c.cc.Invoke(ctx, DataSourceService_GetDataSourceById_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func NewMarketplaceServiceClient(cc grpc.ClientConnInterface) MarketplaceServiceClient {
+	return &marketplaceServiceClient{cc}
 }
 
-func (c *dataSourceServiceClient) GetDataSourceByName(ctx context.Context, in *GetDataSourceByNameRequest, opts ...grpc.CallOption) (*GetDataSourceByNameResponse, error) {
+func (c *marketplaceServiceClient) ListBundles(ctx context.Context, in *ListBundlesRequest, opts ...grpc.CallOption) (*ListBundlesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetDataSourceByNameResponse)
-	err := c.cc.Invoke(ctx, DataSourceService_GetDataSourceByName_FullMethodName, in, out, cOpts...)
+	out := new(ListBundlesResponse)
+	err := c.cc.Invoke(ctx, MarketplaceService_ListBundles_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataSourceServiceClient) ListDataSources(ctx context.Context, in *ListDataSourcesRequest, opts ...grpc.CallOption) (*ListDataSourcesResponse, error) {
+func (c *marketplaceServiceClient) ListBundleSubscriptions(ctx context.Context, in *ListBundleSubscriptionsRequest, opts ...grpc.CallOption) (*ListBundleSubscriptionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListDataSourcesResponse)
-	err := c.cc.Invoke(ctx, DataSourceService_ListDataSources_FullMethodName, in, out, cOpts...)
+	out := new(ListBundleSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, MarketplaceService_ListBundleSubscriptions_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataSourceServiceClient) UpdateDataSource(ctx context.Context, in *UpdateDataSourceRequest, opts ...grpc.CallOption) (*UpdateDataSourceResponse, error) {
+func (c *marketplaceServiceClient) SubscribeBundle(ctx context.Context, in *SubscribeBundleRequest, opts ...grpc.CallOption) (*SubscribeBundleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdateDataSourceResponse)
-	err := c.cc.Invoke(ctx, DataSourceService_UpdateDataSource_FullMethodName, in, out, cOpts...)
+	out := new(SubscribeBundleResponse)
+	err := c.cc.Invoke(ctx, MarketplaceService_SubscribeBundle_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataSourceServiceClient) DeleteDataSourceById(ctx context.Context, in *DeleteDataSourceByIdRequest, opts ...grpc.CallOption) (*DeleteDataSourceByIdResponse, error) {
+func (c *marketplaceServiceClient) UnsubscribeBundle(ctx context.Context, in *UnsubscribeBundleRequest, opts ...grpc.CallOption) (*UnsubscribeBundleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteDataSourceByIdResponse)
-	err := c.cc.Invoke(ctx, DataSourceService_DeleteDataSourceById_FullMethodName, in, out, cOpts...)
+	out := new(UnsubscribeBundleResponse)
+	err := c.cc.Invoke(ctx, MarketplaceService_UnsubscribeBundle_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataSourceServiceClient) DeleteDataSourceByName(ctx context.Context, in *DeleteDataSourceByNameRequest, opts ...grpc.CallOption) (*DeleteDataSourceByNameResponse, error) {
+func (c *marketplaceServiceClient) UpgradeBundle(ctx context.Context, in *UpgradeBundleRequest, opts ...grpc.CallOption) (*UpgradeBundleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteDataSourceByNameResponse)
-	err := c.cc.Invoke(ctx, DataSourceService_DeleteDataSourceByName_FullMethodName, in, out, cOpts...)
+	out := new(UpgradeBundleResponse)
+	err := c.cc.Invoke(ctx, MarketplaceService_UpgradeBundle_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// DataSourceServiceServer is the server API for DataSourceService service.
-// All implementations must embed UnimplementedDataSourceServiceServer
+// MarketplaceServiceServer is the server API for MarketplaceService service.
+// All implementations must embed UnimplementedMarketplaceServiceServer
 // for forward compatibility.
-type DataSourceServiceServer interface {
-	CreateDataSource(context.Context, *CreateDataSourceRequest) (*CreateDataSourceResponse, error)
-	GetDataSourceById(context.Context, *GetDataSourceByIdRequest) (*GetDataSourceByIdResponse, error)
-	GetDataSourceByName(context.Context, *GetDataSourceByNameRequest) (*GetDataSourceByNameResponse, error)
-	ListDataSources(context.Context, *ListDataSourcesRequest) (*ListDataSourcesResponse, error)
-	UpdateDataSource(context.Context, *UpdateDataSourceRequest) (*UpdateDataSourceResponse, error)
-	DeleteDataSourceById(context.Context, *DeleteDataSourceByIdRequest) (*DeleteDataSourceByIdResponse, error)
-	DeleteDataSourceByName(context.Context, *DeleteDataSourceByNameRequest) (*DeleteDataSourceByNameResponse, error)
-	mustEmbedUnimplementedDataSourceServiceServer()
+type MarketplaceServiceServer interface {
+	ListBundles(context.Context, *ListBundlesRequest) (*ListBundlesResponse, error)
+	ListBundleSubscriptions(context.Context, *ListBundleSubscriptionsRequest) (*ListBundleSubscriptionsResponse, error)
+	SubscribeBundle(context.Context, *SubscribeBundleRequest) (*SubscribeBundleResponse, error)
+	UnsubscribeBundle(context.Context, *UnsubscribeBundleRequest) (*UnsubscribeBundleResponse, error)
+	UpgradeBundle(context.Context, *UpgradeBundleRequest) (*UpgradeBundleResponse, error)
+	mustEmbedUnimplementedMarketplaceServiceServer()
 }
 
-// UnimplementedDataSourceServiceServer must be embedded to have
+// UnimplementedMarketplaceServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedDataSourceServiceServer struct{}
+type UnimplementedMarketplaceServiceServer struct{}
 
-func (UnimplementedDataSourceServiceServer) CreateDataSource(context.Context, *CreateDataSourceRequest) (*CreateDataSourceResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateDataSource not implemented")
-}
-func (UnimplementedDataSourceServiceServer) GetDataSourceById(context.Context, *GetDataSourceByIdRequest) (*GetDataSourceByIdResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetDataSourceById not implemented")
+func (UnimplementedMarketplaceServiceServer) ListBundles(context.Context, *ListBundlesRequest) (*ListBundlesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBundles not implemented")
 }
-func (UnimplementedDataSourceServiceServer) GetDataSourceByName(context.Context, *GetDataSourceByNameRequest) (*GetDataSourceByNameResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetDataSourceByName not implemented")
-}
-func (UnimplementedDataSourceServiceServer) ListDataSources(context.Context, *ListDataSourcesRequest) (*ListDataSourcesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListDataSources not implemented")
+func (UnimplementedMarketplaceServiceServer) ListBundleSubscriptions(context.Context, *ListBundleSubscriptionsRequest) (*ListBundleSubscriptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBundleSubscriptions not implemented")
 }
-func (UnimplementedDataSourceServiceServer) UpdateDataSource(context.Context, *UpdateDataSourceRequest) (*UpdateDataSourceResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateDataSource not implemented")
+func (UnimplementedMarketplaceServiceServer) SubscribeBundle(context.Context, *SubscribeBundleRequest) (*SubscribeBundleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubscribeBundle not implemented")
 }
-func (UnimplementedDataSourceServiceServer) DeleteDataSourceById(context.Context, *DeleteDataSourceByIdRequest) (*DeleteDataSourceByIdResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteDataSourceById not implemented")
+func (UnimplementedMarketplaceServiceServer) UnsubscribeBundle(context.Context, *UnsubscribeBundleRequest) (*UnsubscribeBundleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnsubscribeBundle not implemented")
 }
-func (UnimplementedDataSourceServiceServer) DeleteDataSourceByName(context.Context, *DeleteDataSourceByNameRequest) (*DeleteDataSourceByNameResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteDataSourceByName not implemented")
+func (UnimplementedMarketplaceServiceServer) UpgradeBundle(context.Context, *UpgradeBundleRequest) (*UpgradeBundleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpgradeBundle not implemented")
 }
-func (UnimplementedDataSourceServiceServer) mustEmbedUnimplementedDataSourceServiceServer() {}
-func (UnimplementedDataSourceServiceServer) testEmbeddedByValue()                           {}
+func (UnimplementedMarketplaceServiceServer) mustEmbedUnimplementedMarketplaceServiceServer() {}
+func (UnimplementedMarketplaceServiceServer) testEmbeddedByValue()                            {}
 
-// UnsafeDataSourceServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to DataSourceServiceServer will
+// UnsafeMarketplaceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MarketplaceServiceServer will
 // result in compilation errors.
-type UnsafeDataSourceServiceServer interface {
-	mustEmbedUnimplementedDataSourceServiceServer()
+type UnsafeMarketplaceServiceServer interface {
+	mustEmbedUnimplementedMarketplaceServiceServer()
 }
 
-func RegisterDataSourceServiceServer(s grpc.ServiceRegistrar, srv DataSourceServiceServer) {
-	// If the following call panics, it indicates UnimplementedDataSourceServiceServer was
+func RegisterMarketplaceServiceServer(s grpc.ServiceRegistrar, srv MarketplaceServiceServer) {
+	// If the following call panics, it indicates UnimplementedMarketplaceServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&DataSourceService_ServiceDesc, srv)
-}
-
-func _DataSourceService_CreateDataSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateDataSourceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DataSourceServiceServer).CreateDataSource(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: DataSourceService_CreateDataSource_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataSourceServiceServer).CreateDataSource(ctx, req.(*CreateDataSourceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _DataSourceService_GetDataSourceById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDataSourceByIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DataSourceServiceServer).GetDataSourceById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: DataSourceService_GetDataSourceById_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataSourceServiceServer).GetDataSourceById(ctx, req.(*GetDataSourceByIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	s.RegisterService(&MarketplaceService_ServiceDesc, srv)
 }
 
-func _DataSourceService_GetDataSourceByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDataSourceByNameRequest)
+func _MarketplaceService_ListBundles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBundlesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataSourceServiceServer).GetDataSourceByName(ctx, in)
+		return srv.(MarketplaceServiceServer).ListBundles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DataSourceService_GetDataSourceByName_FullMethodName,
+		FullMethod: MarketplaceService_ListBundles_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataSourceServiceServer).GetDataSourceByName(ctx, req.(*GetDataSourceByNameRequest))
+		return srv.(MarketplaceServiceServer).ListBundles(ctx, req.(*ListBundlesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataSourceService_ListDataSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListDataSourcesRequest)
+func _MarketplaceService_ListBundleSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBundleSubscriptionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataSourceServiceServer).ListDataSources(ctx, in)
+		return srv.(MarketplaceServiceServer).ListBundleSubscriptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DataSourceService_ListDataSources_FullMethodName,
+		FullMethod: MarketplaceService_ListBundleSubscriptions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataSourceServiceServer).ListDataSources(ctx, req.(*ListDataSourcesRequest))
+		return srv.(MarketplaceServiceServer).ListBundleSubscriptions(ctx, req.(*ListBundleSubscriptionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataSourceService_UpdateDataSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateDataSourceRequest)
+func _MarketplaceService_SubscribeBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeBundleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataSourceServiceServer).UpdateDataSource(ctx, in)
+		return srv.(MarketplaceServiceServer).SubscribeBundle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DataSourceService_UpdateDataSource_FullMethodName,
+		FullMethod: MarketplaceService_SubscribeBundle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataSourceServiceServer).UpdateDataSource(ctx, req.(*UpdateDataSourceRequest))
+		return srv.(MarketplaceServiceServer).SubscribeBundle(ctx, req.(*SubscribeBundleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataSourceService_DeleteDataSourceById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteDataSourceByIdRequest)
+func _MarketplaceService_UnsubscribeBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeBundleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataSourceServiceServer).DeleteDataSourceById(ctx, in)
+		return srv.(MarketplaceServiceServer).UnsubscribeBundle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DataSourceService_DeleteDataSourceById_FullMethodName,
+		FullMethod: MarketplaceService_UnsubscribeBundle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataSourceServiceServer).DeleteDataSourceById(ctx, req.(*DeleteDataSourceByIdRequest))
+		return srv.(MarketplaceServiceServer).UnsubscribeBundle(ctx, req.(*UnsubscribeBundleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DataSourceService_DeleteDataSourceByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteDataSourceByNameRequest)
+func _MarketplaceService_UpgradeBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeBundleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataSourceServiceServer).DeleteDataSourceByName(ctx, in)
+		return srv.(MarketplaceServiceServer).UpgradeBundle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DataSourceService_DeleteDataSourceByName_FullMethodName,
+		FullMethod: MarketplaceService_UpgradeBundle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataSourceServiceServer).DeleteDataSourceByName(ctx, req.(*DeleteDataSourceByNameRequest))
+		return srv.(MarketplaceServiceServer).UpgradeBundle(ctx, req.(*UpgradeBundleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// DataSourceService_ServiceDesc is the grpc.ServiceDesc for DataSourceService service.
+// MarketplaceService_ServiceDesc is the grpc.ServiceDesc for MarketplaceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var DataSourceService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "minder.v1.DataSourceService",
-	HandlerType: (*DataSourceServiceServer)(nil),
+var MarketplaceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "minder.v1.MarketplaceService",
+	HandlerType: (*MarketplaceServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateDataSource",
-			Handler:    _DataSourceService_CreateDataSource_Handler,
-		},
-		{
-			MethodName: "GetDataSourceById",
-			Handler:    _DataSourceService_GetDataSourceById_Handler,
-		},
-		{
-			MethodName: "GetDataSourceByName",
-			Handler:    _DataSourceService_GetDataSourceByName_Handler,
+			MethodName: "ListBundles",
+			Handler:    _MarketplaceService_ListBundles_Handler,
 		},
 		{
-			MethodName: "ListDataSources",
-			Handler:    _DataSourceService_ListDataSources_Handler,
+			MethodName: "ListBundleSubscriptions",
+			Handler:    _MarketplaceService_ListBundleSubscriptions_Handler,
 		},
 		{
-			MethodName: "UpdateDataSource",
-			Handler:    _DataSourceService_UpdateDataSource_Handler,
+			MethodName: "SubscribeBundle",
+			Handler:    _MarketplaceService_SubscribeBundle_Handler,
 		},
 		{
-			MethodName: "DeleteDataSourceById",
-			Handler:    _DataSourceService_DeleteDataSourceById_Handler,
+			MethodName: "UnsubscribeBundle",
+			Handler:    _MarketplaceService_UnsubscribeBundle_Handler,
 		},
 		{
-			MethodName: "DeleteDataSourceByName",
-			Handler:    _DataSourceService_DeleteDataSourceByName_Handler,
+			MethodName: "UpgradeBundle",
+			Handler:    _MarketplaceService_UpgradeBundle_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -2219,9 +3087,12 @@ var RuleTypeService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	EvalResultsService_ListEvaluationResults_FullMethodName = "/minder.v1.EvalResultsService/ListEvaluationResults"
-	EvalResultsService_ListEvaluationHistory_FullMethodName = "/minder.v1.EvalResultsService/ListEvaluationHistory"
-	EvalResultsService_GetEvaluationHistory_FullMethodName  = "/minder.v1.EvalResultsService/GetEvaluationHistory"
+	EvalResultsService_ListEvaluationResults_FullMethodName    = "/minder.v1.EvalResultsService/ListEvaluationResults"
+	EvalResultsService_ListEvaluationHistory_FullMethodName    = "/minder.v1.EvalResultsService/ListEvaluationHistory"
+	EvalResultsService_GetEvaluationHistory_FullMethodName     = "/minder.v1.EvalResultsService/GetEvaluationHistory"
+	EvalResultsService_WatchEvaluations_FullMethodName         = "/minder.v1.EvalResultsService/WatchEvaluations"
+	EvalResultsService_ExplainEvaluation_FullMethodName        = "/minder.v1.EvalResultsService/ExplainEvaluation"
+	EvalResultsService_GenerateComplianceReport_FullMethodName = "/minder.v1.EvalResultsService/GenerateComplianceReport"
 )
 
 // EvalResultsServiceClient is the client API for EvalResultsService service.
@@ -2231,6 +3102,20 @@ type EvalResultsServiceClient interface {
 	ListEvaluationResults(ctx context.Context, in *ListEvaluationResultsRequest, opts ...grpc.CallOption) (*ListEvaluationResultsResponse, error)
 	ListEvaluationHistory(ctx context.Context, in *ListEvaluationHistoryRequest, opts ...grpc.CallOption) (*ListEvaluationHistoryResponse, error)
 	GetEvaluationHistory(ctx context.Context, in *GetEvaluationHistoryRequest, opts ...grpc.CallOption) (*GetEvaluationHistoryResponse, error)
+	// WatchEvaluations streams the evaluations of the project as they are
+	// recorded in the evaluation history, along with their remediation and
+	// alert events.
+	WatchEvaluations(ctx context.Context, in *WatchEvaluationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEvaluationsResponse], error)
+	// ExplainEvaluation evaluates again the rule and entity of a past
+	// evaluation, explaining how its policy came to the result. It is a re-run
+	// against the current state of the entity and rule, not a replay of the
+	// past evaluation, so its result may differ. As the explanation includes
+	// the input of the policy, it requires the permission to update profiles.
+	ExplainEvaluation(ctx context.Context, in *ExplainEvaluationRequest, opts ...grpc.CallOption) (*ExplainEvaluationResponse, error)
+	// GenerateComplianceReport builds a snapshot of the latest evaluation
+	// results of a project and its sub-projects, along with the trends of
+	// their evaluation history over a time range.
+	GenerateComplianceReport(ctx context.Context, in *GenerateComplianceReportRequest, opts ...grpc.CallOption) (*GenerateComplianceReportResponse, error)
 }
 
 type evalResultsServiceClient struct {
@@ -2271,6 +3156,45 @@ func (c *evalResultsServiceClient) GetEvaluationHistory(ctx context.Context, in
 	return out, nil
 }
 
+func (c *evalResultsServiceClient) WatchEvaluations(ctx context.Context, in *WatchEvaluationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEvaluationsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EvalResultsService_ServiceDesc.Streams[0], EvalResultsService_WatchEvaluations_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchEvaluationsRequest, WatchEvaluationsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EvalResultsService_WatchEvaluationsClient = grpc.ServerStreamingClient[WatchEvaluationsResponse]
+
+func (c *evalResultsServiceClient) ExplainEvaluation(ctx context.Context, in *ExplainEvaluationRequest, opts ...grpc.CallOption) (*ExplainEvaluationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExplainEvaluationResponse)
+	err := c.cc.Invoke(ctx, EvalResultsService_ExplainEvaluation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evalResultsServiceClient) GenerateComplianceReport(ctx context.Context, in *GenerateComplianceReportRequest, opts ...grpc.CallOption) (*GenerateComplianceReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateComplianceReportResponse)
+	err := c.cc.Invoke(ctx, EvalResultsService_GenerateComplianceReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EvalResultsServiceServer is the server API for EvalResultsService service.
 // All implementations must embed UnimplementedEvalResultsServiceServer
 // for forward compatibility.
@@ -2278,6 +3202,20 @@ type EvalResultsServiceServer interface {
 	ListEvaluationResults(context.Context, *ListEvaluationResultsRequest) (*ListEvaluationResultsResponse, error)
 	ListEvaluationHistory(context.Context, *ListEvaluationHistoryRequest) (*ListEvaluationHistoryResponse, error)
 	GetEvaluationHistory(context.Context, *GetEvaluationHistoryRequest) (*GetEvaluationHistoryResponse, error)
+	// WatchEvaluations streams the evaluations of the project as they are
+	// recorded in the evaluation history, along with their remediation and
+	// alert events.
+	WatchEvaluations(*WatchEvaluationsRequest, grpc.ServerStreamingServer[WatchEvaluationsResponse]) error
+	// ExplainEvaluation evaluates again the rule and entity of a past
+	// evaluation, explaining how its policy came to the result. It is a re-run
+	// against the current state of the entity and rule, not a replay of the
+	// past evaluation, so its result may differ. As the explanation includes
+	// the input of the policy, it requires the permission to update profiles.
+	ExplainEvaluation(context.Context, *ExplainEvaluationRequest) (*ExplainEvaluationResponse, error)
+	// GenerateComplianceReport builds a snapshot of the latest evaluation
+	// results of a project and its sub-projects, along with the trends of
+	// their evaluation history over a time range.
+	GenerateComplianceReport(context.Context, *GenerateComplianceReportRequest) (*GenerateComplianceReportResponse, error)
 	mustEmbedUnimplementedEvalResultsServiceServer()
 }
 
@@ -2297,6 +3235,15 @@ func (UnimplementedEvalResultsServiceServer) ListEvaluationHistory(context.Conte
 func (UnimplementedEvalResultsServiceServer) GetEvaluationHistory(context.Context, *GetEvaluationHistoryRequest) (*GetEvaluationHistoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetEvaluationHistory not implemented")
 }
+func (UnimplementedEvalResultsServiceServer) WatchEvaluations(*WatchEvaluationsRequest, grpc.ServerStreamingServer[WatchEvaluationsResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchEvaluations not implemented")
+}
+func (UnimplementedEvalResultsServiceServer) ExplainEvaluation(context.Context, *ExplainEvaluationRequest) (*ExplainEvaluationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExplainEvaluation not implemented")
+}
+func (UnimplementedEvalResultsServiceServer) GenerateComplianceReport(context.Context, *GenerateComplianceReportRequest) (*GenerateComplianceReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GenerateComplianceReport not implemented")
+}
 func (UnimplementedEvalResultsServiceServer) mustEmbedUnimplementedEvalResultsServiceServer() {}
 func (UnimplementedEvalResultsServiceServer) testEmbeddedByValue()                            {}
 
@@ -2372,6 +3319,53 @@ func _EvalResultsService_GetEvaluationHistory_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EvalResultsService_WatchEvaluations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEvaluationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EvalResultsServiceServer).WatchEvaluations(m, &grpc.GenericServerStream[WatchEvaluationsRequest, WatchEvaluationsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EvalResultsService_WatchEvaluationsServer = grpc.ServerStreamingServer[WatchEvaluationsResponse]
+
+func _EvalResultsService_ExplainEvaluation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainEvaluationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvalResultsServiceServer).ExplainEvaluation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EvalResultsService_ExplainEvaluation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvalResultsServiceServer).ExplainEvaluation(ctx, req.(*ExplainEvaluationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EvalResultsService_GenerateComplianceReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateComplianceReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvalResultsServiceServer).GenerateComplianceReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EvalResultsService_GenerateComplianceReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvalResultsServiceServer).GenerateComplianceReport(ctx, req.(*GenerateComplianceReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EvalResultsService_ServiceDesc is the grpc.ServiceDesc for EvalResultsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2391,8 +3385,22 @@ var EvalResultsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEvaluationHistory",
 			Handler:    _EvalResultsService_GetEvaluationHistory_Handler,
 		},
+		{
+			MethodName: "ExplainEvaluation",
+			Handler:    _EvalResultsService_ExplainEvaluation_Handler,
+		},
+		{
+			MethodName: "GenerateComplianceReport",
+			Handler:    _EvalResultsService_GenerateComplianceReport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvaluations",
+			Handler:       _EvalResultsService_WatchEvaluations_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "minder/v1/minder.proto",
 }
 
@@ -3451,11 +4459,12 @@ var InviteService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	EntityInstanceService_ListEntities_FullMethodName     = "/minder.v1.EntityInstanceService/ListEntities"
-	EntityInstanceService_GetEntityById_FullMethodName    = "/minder.v1.EntityInstanceService/GetEntityById"
-	EntityInstanceService_GetEntityByName_FullMethodName  = "/minder.v1.EntityInstanceService/GetEntityByName"
-	EntityInstanceService_DeleteEntityById_FullMethodName = "/minder.v1.EntityInstanceService/DeleteEntityById"
-	EntityInstanceService_RegisterEntity_FullMethodName   = "/minder.v1.EntityInstanceService/RegisterEntity"
+	EntityInstanceService_ListEntities_FullMethodName              = "/minder.v1.EntityInstanceService/ListEntities"
+	EntityInstanceService_GetEntityById_FullMethodName             = "/minder.v1.EntityInstanceService/GetEntityById"
+	EntityInstanceService_GetEntityByName_FullMethodName           = "/minder.v1.EntityInstanceService/GetEntityByName"
+	EntityInstanceService_ListEntityPropertyHistory_FullMethodName = "/minder.v1.EntityInstanceService/ListEntityPropertyHistory"
+	EntityInstanceService_DeleteEntityById_FullMethodName          = "/minder.v1.EntityInstanceService/DeleteEntityById"
+	EntityInstanceService_RegisterEntity_FullMethodName            = "/minder.v1.EntityInstanceService/RegisterEntity"
 )
 
 // EntityInstanceServiceClient is the client API for EntityInstanceService service.
@@ -3470,6 +4479,9 @@ type EntityInstanceServiceClient interface {
 	GetEntityById(ctx context.Context, in *GetEntityByIdRequest, opts ...grpc.CallOption) (*GetEntityByIdResponse, error)
 	// GetEntityByName returns an entity instance for a given entity name
 	GetEntityByName(ctx context.Context, in *GetEntityByNameRequest, opts ...grpc.CallOption) (*GetEntityByNameResponse, error)
+	// ListEntityPropertyHistory returns the changes of the properties of an
+	// entity instance, newest first
+	ListEntityPropertyHistory(ctx context.Context, in *ListEntityPropertyHistoryRequest, opts ...grpc.CallOption) (*ListEntityPropertyHistoryResponse, error)
 	// DeleteEntityById deletes an entity instance for a given entity ID
 	DeleteEntityById(ctx context.Context, in *DeleteEntityByIdRequest, opts ...grpc.CallOption) (*DeleteEntityByIdResponse, error)
 	// RegisterEntity creates a new entity instance
@@ -3514,6 +4526,16 @@ func (c *entityInstanceServiceClient) GetEntityByName(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *entityInstanceServiceClient) ListEntityPropertyHistory(ctx context.Context, in *ListEntityPropertyHistoryRequest, opts ...grpc.CallOption) (*ListEntityPropertyHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEntityPropertyHistoryResponse)
+	err := c.cc.Invoke(ctx, EntityInstanceService_ListEntityPropertyHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *entityInstanceServiceClient) DeleteEntityById(ctx context.Context, in *DeleteEntityByIdRequest, opts ...grpc.CallOption) (*DeleteEntityByIdResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteEntityByIdResponse)
@@ -3546,6 +4568,9 @@ type EntityInstanceServiceServer interface {
 	GetEntityById(context.Context, *GetEntityByIdRequest) (*GetEntityByIdResponse, error)
 	// GetEntityByName returns an entity instance for a given entity name
 	GetEntityByName(context.Context, *GetEntityByNameRequest) (*GetEntityByNameResponse, error)
+	// ListEntityPropertyHistory returns the changes of the properties of an
+	// entity instance, newest first
+	ListEntityPropertyHistory(context.Context, *ListEntityPropertyHistoryRequest) (*ListEntityPropertyHistoryResponse, error)
 	// DeleteEntityById deletes an entity instance for a given entity ID
 	DeleteEntityById(context.Context, *DeleteEntityByIdRequest) (*DeleteEntityByIdResponse, error)
 	// RegisterEntity creates a new entity instance
@@ -3569,6 +4594,9 @@ func (UnimplementedEntityInstanceServiceServer) GetEntityById(context.Context, *
 func (UnimplementedEntityInstanceServiceServer) GetEntityByName(context.Context, *GetEntityByNameRequest) (*GetEntityByNameResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetEntityByName not implemented")
 }
+func (UnimplementedEntityInstanceServiceServer) ListEntityPropertyHistory(context.Context, *ListEntityPropertyHistoryRequest) (*ListEntityPropertyHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEntityPropertyHistory not implemented")
+}
 func (UnimplementedEntityInstanceServiceServer) DeleteEntityById(context.Context, *DeleteEntityByIdRequest) (*DeleteEntityByIdResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteEntityById not implemented")
 }
@@ -3650,6 +4678,24 @@ func _EntityInstanceService_GetEntityByName_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EntityInstanceService_ListEntityPropertyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntityPropertyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityInstanceServiceServer).ListEntityPropertyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityInstanceService_ListEntityPropertyHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityInstanceServiceServer).ListEntityPropertyHistory(ctx, req.(*ListEntityPropertyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EntityInstanceService_DeleteEntityById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteEntityByIdRequest)
 	if err := dec(in); err != nil {
@@ -3705,6 +4751,10 @@ var EntityInstanceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEntityByName",
 			Handler:    _EntityInstanceService_GetEntityByName_Handler,
 		},
+		{
+			MethodName: "ListEntityPropertyHistory",
+			Handler:    _EntityInstanceService_ListEntityPropertyHistory_Handler,
+		},
 		{
 			MethodName: "DeleteEntityById",
 			Handler:    _EntityInstanceService_DeleteEntityById_Handler,
@@ -29,3 +29,8 @@ func (p ProviderClass) ToString() string {
 func (c CredentialsState) ToString() string {
 	return enumToStringViaDescriptor(c.Descriptor(), c.Number())
 }
+
+// ToString returns the string representation of the ProviderHealthStatus
+func (h ProviderHealthStatus) ToString() string {
+	return enumToStringViaDescriptor(h.Descriptor(), h.Number())
+}
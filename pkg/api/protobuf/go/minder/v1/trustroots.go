@@ -0,0 +1,15 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+// GetContext returns the v2 context from the CreateTrustRootRequest trust root.
+func (r *CreateTrustRootRequest) GetContext() *ContextV2 {
+	return r.TrustRoot.GetContext()
+}
+
+// GetContext returns the v2 context embedded in the UpdateTrustRootRequest
+// trust root.
+func (r *UpdateTrustRootRequest) GetContext() *ContextV2 {
+	return r.TrustRoot.GetContext()
+}
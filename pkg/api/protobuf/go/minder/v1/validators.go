@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/itchyny/gojq"
@@ -114,6 +115,18 @@ func (d *DockerHubProviderConfig) Validate() error {
 	return nil
 }
 
+// Ensure AzureDevOpsProviderConfig implements the Validator interface
+var _ Validator = (*AzureDevOpsProviderConfig)(nil)
+
+// Validate is a utility function which allows for the validation of a struct.
+func (a *AzureDevOpsProviderConfig) Validate() error {
+	if a.GetOrganization() == "" {
+		return fmt.Errorf("organization is required")
+	}
+
+	return nil
+}
+
 // Ensure Entity implements the Validator interface
 var _ Validator = (*Entity)(nil)
 
@@ -195,9 +208,70 @@ func (def *RuleType_Definition) Validate() error {
 		}
 	}
 
+	// Limits are not required and can be nil
+	if def.Limits != nil {
+		if err := def.Limits.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Migration is not required and can be nil
+	if def.Migration != nil {
+		if err := def.Migration.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return def.Eval.Validate()
 }
 
+// Validate validates the migration of a rule type definition. The
+// transforms are compiled when the rule type is updated.
+func (m *RuleType_Definition_Migration) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	switch m.GetType() {
+	case "jq", "cel":
+	default:
+		return fmt.Errorf("%w: invalid migration type: %s", ErrInvalidRuleTypeDefinition, m.GetType())
+	}
+
+	if m.GetDef() == "" && m.GetParams() == "" {
+		return fmt.Errorf("%w: migration has no def or params transform", ErrInvalidRuleTypeDefinition)
+	}
+
+	return nil
+}
+
+// Validate validates the evaluation limits of a rule type definition
+func (l *RuleType_Definition_Limits) Validate() error {
+	if l == nil {
+		return nil
+	}
+
+	if l.GetTimeout() != "" {
+		timeout, err := time.ParseDuration(l.GetTimeout())
+		if err != nil {
+			return fmt.Errorf("%w: invalid timeout: %s", ErrInvalidRuleTypeDefinition, err)
+		}
+		if timeout <= 0 {
+			return fmt.Errorf("%w: timeout must be positive", ErrInvalidRuleTypeDefinition)
+		}
+	}
+
+	if l.GetMaxRegoInstructions() < 0 {
+		return fmt.Errorf("%w: max_rego_instructions cannot be negative", ErrInvalidRuleTypeDefinition)
+	}
+
+	if l.GetMaxDataSourceCalls() < 0 {
+		return fmt.Errorf("%w: max_data_source_calls cannot be negative", ErrInvalidRuleTypeDefinition)
+	}
+
+	return nil
+}
+
 // Validate validates a rule type definition eval
 func (ev *RuleType_Definition_Eval) Validate() error {
 	if ev == nil {
@@ -425,6 +499,10 @@ func (rem *RuleType_Definition_Remediate) Validate() error {
 		if err := rem.GetGhBranchProtection().Validate(); err != nil {
 			return err
 		}
+	case "gh_ruleset":
+		if err := rem.GetGhRuleset().Validate(); err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("%w: remediate type cannot be empty", ErrInvalidRuleTypeDefinition)
 	}
@@ -439,6 +517,9 @@ func (rem *RuleType_Definition_Remediate) Validate() error {
 	if rem.GetPullRequest() != nil {
 		fieldsSet++
 	}
+	if rem.GetGhRuleset() != nil {
+		fieldsSet++
+	}
 	if fieldsSet > 1 {
 		return fmt.Errorf("%w: only one remediation type can be set", ErrInvalidRuleTypeDefinition)
 	}
@@ -487,6 +568,30 @@ func (ghp *RuleType_Definition_Remediate_GhBranchProtectionType) Validate() erro
 	return nil
 }
 
+// Validate validates a GitHub ruleset remediation
+func (ghr *RuleType_Definition_Remediate_GhRulesetType) Validate() error {
+	if ghr == nil {
+		return fmt.Errorf("%w: github ruleset remediation is nil", ErrInvalidRuleTypeDefinition)
+	}
+
+	if ghr.Name == "" {
+		return fmt.Errorf("%w: ruleset name cannot be empty", ErrInvalidRuleTypeDefinition)
+	}
+
+	switch ghr.PatchType {
+	case "", "merge", "json":
+	default:
+		return fmt.Errorf("%w: unknown ruleset patch type %s", ErrInvalidRuleTypeDefinition, ghr.PatchType)
+	}
+
+	_, err := util.NewSafeTextTemplate(&ghr.Patch, "patch")
+	if err != nil {
+		return fmt.Errorf("%w: patch template is not parsable: %w", ErrInvalidRuleTypeDefinition, err)
+	}
+
+	return nil
+}
+
 // Validate validates a pull request remediation
 func (prRem *RuleType_Definition_Remediate_PullRequestRemediation) Validate() error {
 	if prRem == nil {
@@ -426,6 +426,56 @@ func TestRuleType_Definition_Alert_Validate(t *testing.T) {
 	}
 }
 
+func TestRuleType_Definition_Limits_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		limits  *RuleType_Definition_Limits
+		wantErr bool
+	}{
+		{
+			name: "valid limits",
+			limits: &RuleType_Definition_Limits{
+				Timeout:             "1m30s",
+				MaxRegoInstructions: 100000,
+				MaxDataSourceCalls:  10,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "nil limits are valid",
+			limits:  nil,
+			wantErr: false,
+		},
+		{
+			name:    "invalid timeout",
+			limits:  &RuleType_Definition_Limits{Timeout: "soon"},
+			wantErr: true,
+		},
+		{
+			name:    "zero timeout",
+			limits:  &RuleType_Definition_Limits{Timeout: "0s"},
+			wantErr: true,
+		},
+		{
+			name:    "negative data source calls",
+			limits:  &RuleType_Definition_Limits{MaxDataSourceCalls: -1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.limits.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestRuleType_Definition_Alert_AlertTypePRComment_Validate(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -701,3 +751,64 @@ func TestRuleType_Definition_Remediate_GhBranchProtectionType_Validate(t *testin
 		})
 	}
 }
+
+func TestRuleType_Definition_Remediate_GhRulesetType_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		ghr     *RuleType_Definition_Remediate_GhRulesetType
+		wantErr bool
+	}{
+		{
+			name: "valid GitHub ruleset remediation",
+			ghr: &RuleType_Definition_Remediate_GhRulesetType{
+				Name:  "minder",
+				Patch: "patch content",
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid GitHub ruleset JSON patch remediation",
+			ghr: &RuleType_Definition_Remediate_GhRulesetType{
+				Name:      "minder",
+				Patch:     "patch content",
+				PatchType: "json",
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty ruleset name",
+			ghr: &RuleType_Definition_Remediate_GhRulesetType{
+				Patch: "patch content",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown ruleset patch type",
+			ghr: &RuleType_Definition_Remediate_GhRulesetType{
+				Name:      "minder",
+				Patch:     "patch content",
+				PatchType: "strategic",
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty ruleset patch template",
+			ghr: &RuleType_Definition_Remediate_GhRulesetType{
+				Name: "minder",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.ghr.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -22,6 +22,18 @@ type RecurrenceConfig struct {
 	BatchSize int `mapstructure:"batch_size" default:"100"`
 	// MinElapsed is the minimum time after last update before sending a reminder
 	MinElapsed time.Duration `mapstructure:"min_elapsed" default:"1h"`
+	// AutoRegistrationInterval is the time between reconciliations of the
+	// auto-registration rules of projects. A zero interval disables them.
+	AutoRegistrationInterval time.Duration `mapstructure:"auto_registration_interval" default:"24h"`
+	// ProviderHealthInterval is the time between health checks of the
+	// providers. A zero interval disables them.
+	ProviderHealthInterval time.Duration `mapstructure:"provider_health_interval" default:"1h"`
+	// WebhookReconcileInterval is the time between reconciliations of the
+	// webhooks of registered entities. A zero interval disables them.
+	WebhookReconcileInterval time.Duration `mapstructure:"webhook_reconcile_interval" default:"24h"`
+	// PropertyHistoryRetention is how long the changes of entity properties
+	// are kept. A zero retention keeps them forever.
+	PropertyHistoryRetention time.Duration `mapstructure:"property_history_retention" default:"2160h"`
 }
 
 // Validate checks that the recurrence config is valid
@@ -34,6 +46,22 @@ func (r RecurrenceConfig) Validate() error {
 		return fmt.Errorf("interval %s cannot be negative", r.Interval)
 	}
 
+	if r.AutoRegistrationInterval < 0 {
+		return fmt.Errorf("auto_registration_interval %s cannot be negative", r.AutoRegistrationInterval)
+	}
+
+	if r.ProviderHealthInterval < 0 {
+		return fmt.Errorf("provider_health_interval %s cannot be negative", r.ProviderHealthInterval)
+	}
+
+	if r.WebhookReconcileInterval < 0 {
+		return fmt.Errorf("webhook_reconcile_interval %s cannot be negative", r.WebhookReconcileInterval)
+	}
+
+	if r.PropertyHistoryRetention < 0 {
+		return fmt.Errorf("property_history_retention %s cannot be negative", r.PropertyHistoryRetention)
+	}
+
 	return nil
 }
 
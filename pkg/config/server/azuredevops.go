@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+// AzureDevOpsConfig is the configuration for the Azure DevOps providers
+type AzureDevOpsConfig struct {
+	OAuthClientConfig `mapstructure:",squash"`
+
+	// WebhookSecrets is the configuration for the Azure DevOps service hook
+	// secrets setup and verification. This is used to verify incoming service
+	// hook requests from Azure DevOps.
+	WebhookSecrets `mapstructure:",squash"`
+
+	// TenantID is the Microsoft Entra ID tenant of the OAuth application.
+	// If empty, "organizations" is used, which accepts any work or school account.
+	TenantID string `mapstructure:"tenant_id"`
+
+	// Scopes is the list of scopes to request from the Microsoft identity platform
+	Scopes []string `mapstructure:"scopes"`
+}
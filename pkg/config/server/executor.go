@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "time"
+
+// ExecutorConfig is the configuration for the rule evaluation executor
+type ExecutorConfig struct {
+	// Limits bounds the resources a single rule evaluation can use. Rule types
+	// can set stricter limits in their definition.
+	Limits EvaluationLimitsConfig `mapstructure:"limits"`
+}
+
+// EvaluationLimitsConfig bounds the resources a single rule evaluation can use.
+// Zero values mean no limit.
+type EvaluationLimitsConfig struct {
+	// Timeout is the maximum time the ingestion and evaluation of a rule can take
+	Timeout time.Duration `mapstructure:"timeout" default:"5m"`
+	// MaxRegoInstructions is the maximum number of expressions a Rego policy can evaluate
+	MaxRegoInstructions int64 `mapstructure:"max_rego_instructions" default:"0"`
+	// MaxDataSourceCalls is the maximum number of data source function calls
+	// a single evaluation can make
+	MaxDataSourceCalls int64 `mapstructure:"max_data_source_calls" default:"0"`
+}
@@ -6,6 +6,9 @@ package server
 import (
 	"errors"
 	"fmt"
+	"time"
+
+	"github.com/mindersec/minder/internal/verifier/sigstore/container"
 )
 
 // ConfigBundleSource is an enum of valid config sources
@@ -14,6 +17,10 @@ type ConfigBundleSource string
 const (
 	// TgzSource represents a bundle in a .tar.gz file
 	TgzSource ConfigBundleSource = "tgz"
+	// OCISource represents a bundle pushed to an OCI registry
+	OCISource ConfigBundleSource = "oci"
+	// GitSource represents a bundle stored in a git repository
+	GitSource ConfigBundleSource = "git"
 	// Unknown is a default value
 	Unknown = "unknown"
 )
@@ -31,16 +38,65 @@ type MarketplaceConfig struct {
 
 // BundleSourceConfig holds details about where the bundle gets loaded from
 type BundleSourceConfig struct {
-	Type     string `mapstructure:"type"`
+	Type string `mapstructure:"type"`
+	// Location is the path of the .tar.gz file, the reference of the OCI
+	// artifact (by tag or digest) or the URL of the git repository
 	Location string `mapstructure:"location"`
+	// Ref is the branch, tag or commit to check out from a git repository.
+	// Defaults to the HEAD of the repository.
+	Ref string `mapstructure:"ref"`
+	// Path is the directory of the bundle in a git repository
+	Path string `mapstructure:"path"`
+	// RefreshInterval is how often the bundle is pulled again from an OCI
+	// registry or git repository. Zero disables refreshing.
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+	// Signature configures the verification of the signatures of OCI bundles
+	Signature BundleSignatureConfig `mapstructure:"signature"`
+}
+
+// BundleSignatureConfig holds the requirements for the signature of a bundle
+type BundleSignatureConfig struct {
+	// Required rejects OCI bundles which lack a verified signature
+	Required bool `mapstructure:"required"`
+	// TUFRepoURL is the TUF repository holding the sigstore trusted root.
+	// Defaults to the public sigstore instance.
+	TUFRepoURL string `mapstructure:"tuf_repo_url"`
+	// Identities are the identities allowed to sign the bundle. At least one
+	// is required, and each must match both the issuer and the subject of
+	// the signing certificate.
+	Identities []container.IdentityPolicy `mapstructure:"identities"`
+}
+
+// Validate checks that the signature requirements pin down who may sign the
+// bundle: without an expected issuer and subject, any keyless signer would
+// be accepted.
+func (c *BundleSignatureConfig) Validate() error {
+	if len(c.Identities) == 0 {
+		return errors.New("at least one signing identity is required")
+	}
+	for i := range c.Identities {
+		id := &c.Identities[i]
+		if err := id.Validate(); err != nil {
+			return fmt.Errorf("invalid signing identity %d: %w", i, err)
+		}
+		if id.Issuer == "" && id.IssuerRegex == "" {
+			return fmt.Errorf("signing identity %d must set an issuer", i)
+		}
+		if id.Subject == "" && id.SubjectRegex == "" && id.WorkflowRef == "" &&
+			id.WorkflowRefRegex == "" && id.SourceRepository == "" {
+			return fmt.Errorf("signing identity %d must set a subject, workflow_ref or source_repository", i)
+		}
+	}
+	return nil
 }
 
 // GetType returns the source as an enum type, or error if invalid
 // TODO: investigate whether mapstructure would allow us to validate during
 // deserialization.
 func (b *BundleSourceConfig) GetType() (ConfigBundleSource, error) {
-	if b.Type == string(TgzSource) {
-		return TgzSource, nil
+	switch ConfigBundleSource(b.Type) {
+	case TgzSource, OCISource, GitSource:
+		return ConfigBundleSource(b.Type), nil
 	}
 	return Unknown, fmt.Errorf("%w: %s", ErrInvalidBundleSource, b.Type)
 }
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: Copyright 2026 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/internal/verifier/sigstore/container"
+	serverconfig "github.com/mindersec/minder/pkg/config/server"
+)
+
+func TestBundleSignatureConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		identities []container.IdentityPolicy
+		wantErr    bool
+	}{
+		{
+			name: "issuer and subject",
+			identities: []container.IdentityPolicy{{
+				Issuer:       "https://token.actions.githubusercontent.com",
+				SubjectRegex: "^https://github.com/mindersec/",
+			}},
+		},
+		{
+			name: "issuer and source repository",
+			identities: []container.IdentityPolicy{{
+				IssuerRegex:      "^https://token.actions.githubusercontent.com$",
+				SourceRepository: "https://github.com/mindersec/bundles",
+			}},
+		},
+		{
+			name:    "no identities",
+			wantErr: true,
+		},
+		{
+			name: "identity without issuer",
+			identities: []container.IdentityPolicy{{
+				Subject: "https://github.com/mindersec/bundles/.github/workflows/release.yml@refs/heads/main",
+			}},
+			wantErr: true,
+		},
+		{
+			name: "identity without subject",
+			identities: []container.IdentityPolicy{{
+				Issuer: "https://token.actions.githubusercontent.com",
+			}},
+			wantErr: true,
+		},
+		{
+			name: "invalid identity",
+			identities: []container.IdentityPolicy{{
+				Issuer:       "https://token.actions.githubusercontent.com",
+				SubjectRegex: "(",
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := serverconfig.BundleSignatureConfig{Required: true, Identities: tt.identities}
+			err := cfg.Validate()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -3,12 +3,26 @@
 
 package server
 
+import "time"
+
 // ProviderConfig is the configuration for the providers
 type ProviderConfig struct {
-	GitHubApp *GitHubAppConfig `mapstructure:"github-app"`
-	GitHub    *GitHubConfig    `mapstructure:"github"`
-	Git       GitConfig        `mapstructure:"git"`
-	GitLab    *GitLabConfig    `mapstructure:"gitlab"`
+	GitHubApp   *GitHubAppConfig   `mapstructure:"github-app"`
+	GitHub      *GitHubConfig      `mapstructure:"github"`
+	Git         GitConfig          `mapstructure:"git"`
+	GitLab      *GitLabConfig      `mapstructure:"gitlab"`
+	AzureDevOps *AzureDevOpsConfig `mapstructure:"azure-devops"`
+	Health      HealthConfig       `mapstructure:"health"`
+}
+
+// HealthConfig is the configuration for the periodic provider health checks
+type HealthConfig struct {
+	// ExpiryWarning is how long before a credential expires the provider is
+	// flagged as degraded and a notification is published
+	ExpiryWarning time.Duration `mapstructure:"expiry_warning" default:"168h"`
+	// ProbeWebhooks makes the health check verify that the webhook endpoint
+	// of the provider class is reachable from the outside
+	ProbeWebhooks bool `mapstructure:"probe_webhooks" default:"true"`
 }
 
 // GitConfig provides server-side configuration for Git operations like "clone"
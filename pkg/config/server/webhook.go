@@ -4,9 +4,12 @@
 package server
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 )
 
 // WebhookConfig is the configuration for our webhook capabilities
@@ -19,6 +22,14 @@ type WebhookConfig struct {
 	ExternalWebhookURL string `mapstructure:"external_webhook_url"`
 	// ExternalPingURL is the URL that we will send our ping to
 	ExternalPingURL string `mapstructure:"external_ping_url"`
+	// QuietAfter is how long a registered entity may go without webhook
+	// deliveries before it is flagged as quiet. A zero duration disables it.
+	QuietAfter time.Duration `mapstructure:"quiet_after" default:"168h"`
+	// EvaluateOnPropertyChange maps webhook event types to the entity
+	// properties of which at least one must change when refreshing the
+	// entity for the event to trigger an evaluation. Event types which
+	// are not listed always trigger an evaluation.
+	EvaluateOnPropertyChange map[string][]string `mapstructure:"evaluate_on_property_change"`
 }
 
 // WebhookSecrets is the configuration for the webhook secrets. this is useful
@@ -48,6 +59,14 @@ func (wc *WebhookSecrets) GetPreviousWebhookSecrets() ([]string, error) {
 	return secrets, nil
 }
 
+// WebhookSecretFingerprint returns a short fingerprint of the given webhook
+// secret. It is stored alongside the webhooks instead of the secret itself to
+// find the webhooks still configured with a previous secret.
+func WebhookSecretFingerprint(secret string) string {
+	sum := sha256.Sum256([]byte("minder-webhook-secret:" + secret))
+	return hex.EncodeToString(sum[:8])
+}
+
 // GetWebhookSecret returns the GitHub App's webhook secret
 func (wc *WebhookSecrets) GetWebhookSecret() (string, error) {
 	return fileOrArg(wc.WebhookSecretFile, wc.WebhookSecret, "webhook secret")
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path"
 	"strings"
 	"sync"
 
@@ -196,6 +197,7 @@ func newEnvForEntity(varName string, typ proto.Message, typName string) (*cel.En
 		cel.Types(typ), cel.Types(&internalpb.SelectorEntity{}),
 		cel.Variable("entity", types.NewObjectType(string(entityPtr.ProtoReflect().Descriptor().FullName()))),
 		cel.Variable(varName, types.NewObjectType(typName)),
+		globFunction(),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create CEL environment for %s: %v", varName, err)
@@ -204,6 +206,32 @@ func newEnvForEntity(varName string, typ proto.Message, typName string) (*cel.En
 	return env, nil
 }
 
+// globFunction declares the glob member function of strings, which reports
+// whether the string matches a shell pattern, e.g. `repository.name.glob("org/svc-*")`.
+// As in path.Match, wildcards do not match the '/' separator.
+func globFunction() cel.EnvOption {
+	return cel.Function("glob",
+		cel.MemberOverload("string_glob_string",
+			[]*cel.Type{cel.StringType, cel.StringType}, cel.BoolType,
+			cel.BinaryBinding(func(lhs, rhs ref.Val) ref.Val {
+				str, ok := lhs.(types.String)
+				if !ok {
+					return types.MaybeNoSuchOverloadErr(lhs)
+				}
+				pattern, ok := rhs.(types.String)
+				if !ok {
+					return types.MaybeNoSuchOverloadErr(rhs)
+				}
+				matched, err := path.Match(string(pattern), string(str))
+				if err != nil {
+					return types.NewErr("invalid glob pattern %q: %v", pattern, err)
+				}
+				return types.Bool(matched)
+			}),
+		),
+	)
+}
+
 type compiledSelector struct {
 	orig    string
 	ast     *cel.Ast
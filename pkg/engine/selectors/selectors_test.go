@@ -152,6 +152,33 @@ func newTestPullRequestSelectorEntity(provSelBld testProviderSelectorBuilder, pr
 	}
 }
 
+type testSelectorEntityOption func(se *internalpb.SelectorEntity)
+
+func withSelectorEntityOptions(bld testSelectorEntityBuilder, opts ...testSelectorEntityOption) testSelectorEntityBuilder {
+	return func() *internalpb.SelectorEntity {
+		se := bld()
+		for _, opt := range opts {
+			opt(se)
+		}
+		return se
+	}
+}
+
+func withOriginatedFrom(originBld testSelectorEntityBuilder) testSelectorEntityOption {
+	return func(se *internalpb.SelectorEntity) {
+		se.OriginatedFrom = originBld()
+	}
+}
+
+func withEvaluation(status string, rules map[string]string) testSelectorEntityOption {
+	return func(se *internalpb.SelectorEntity) {
+		se.Evaluation = &internalpb.SelectorEvaluation{
+			Status: status,
+			Rules:  rules,
+		}
+	}
+}
+
 func TestSelectSelectorEntity(t *testing.T) {
 	t.Parallel()
 
@@ -217,6 +244,28 @@ func TestSelectSelectorEntity(t *testing.T) {
 			selectorEntityBld: newTestRepoSelectorEntity(newGithubProviderSelector()),
 			selected:          false,
 		},
+		{
+			name: "Glob repository name expression",
+			exprs: []models.ProfileSelector{
+				{
+					Entity:   minderv1.Entity_ENTITY_REPOSITORIES,
+					Selector: "repository.name.glob('testorg/test*') && !repository.name.glob('*/other*')",
+				},
+			},
+			selectorEntityBld: newTestRepoSelectorEntity(newGithubProviderSelector()),
+			selected:          true,
+		},
+		{
+			name: "Glob does not match the separator",
+			exprs: []models.ProfileSelector{
+				{
+					Entity:   minderv1.Entity_ENTITY_REPOSITORIES,
+					Selector: "repository.name.glob('test*')",
+				},
+			},
+			selectorEntityBld: newTestRepoSelectorEntity(newGithubProviderSelector()),
+			selected:          false,
+		},
 		{
 			name: "Simple true pull request expression",
 			exprs: []models.ProfileSelector{
@@ -692,6 +741,139 @@ func TestSelectSelectorEntity(t *testing.T) {
 			expectedSelectErr: ErrResultUnknown,
 			selected:          false,
 		},
+		{
+			name: "Use a property of the originating repository and true result",
+			exprs: []models.ProfileSelector{
+				{
+					Entity:   minderv1.Entity_ENTITY_PULL_REQUESTS,
+					Selector: "'prod' in entity.originated_from.repository.properties.topics",
+				},
+			},
+			selectorEntityBld: withSelectorEntityOptions(
+				newTestPullRequestSelectorEntity(newGithubProviderSelector()),
+				withOriginatedFrom(newTestRepoSelectorEntity(
+					newGithubProviderSelector(),
+					repoWithProperties(map[string]any{
+						"topics": []any{"prod", "backend"},
+					}),
+				)),
+			),
+			selected: true,
+		},
+		{
+			name: "Use an attribute of the originating repository and false result",
+			exprs: []models.ProfileSelector{
+				{
+					Entity:   minderv1.Entity_ENTITY_ARTIFACTS,
+					Selector: "entity.originated_from.repository.is_private == false",
+				},
+			},
+			selectorEntityBld: withSelectorEntityOptions(
+				newTestArtifactSelectorEntity(newGithubProviderSelector()),
+				withOriginatedFrom(newTestRepoSelectorEntity(
+					newGithubProviderSelector(),
+					func(selRepo *internalpb.SelectorRepository) {
+						isPrivate := true
+						selRepo.IsPrivate = &isPrivate
+					},
+				)),
+			),
+			selected: false,
+		},
+		{
+			name: "The originating entity of an entity without a parent is empty",
+			exprs: []models.ProfileSelector{
+				{
+					Entity:   minderv1.Entity_ENTITY_ARTIFACTS,
+					Selector: "entity.originated_from.repository.is_private == false",
+				},
+			},
+			selectorEntityBld: newTestArtifactSelectorEntity(newGithubProviderSelector()),
+			selected:          true,
+		},
+		{
+			name: "Check that the originating entity is set with has()",
+			exprs: []models.ProfileSelector{
+				{
+					Entity:   minderv1.Entity_ENTITY_ARTIFACTS,
+					Selector: "has(entity.originated_from) && entity.originated_from.repository.is_private == false",
+				},
+			},
+			selectorEntityBld: newTestArtifactSelectorEntity(newGithubProviderSelector()),
+			selected:          false,
+		},
+		{
+			name: "Use the originating entity but explicitly tell Select that it's not resolved yet",
+			exprs: []models.ProfileSelector{
+				{
+					Entity:   minderv1.Entity_ENTITY_PULL_REQUESTS,
+					Selector: "entity.originated_from.name == 'testorg/testrepo'",
+				},
+			},
+			selectOptions: []SelectOption{
+				WithUnknownPaths("entity.originated_from"),
+			},
+			selectorEntityBld: newTestPullRequestSelectorEntity(newGithubProviderSelector()),
+			expectedSelectErr: ErrResultUnknown,
+			selected:          false,
+		},
+		{
+			name: "The selector shortcuts if the originating entity is not needed",
+			exprs: []models.ProfileSelector{
+				{
+					Entity:   minderv1.Entity_ENTITY_PULL_REQUESTS,
+					Selector: "pull_request.name == 'testorg/testrepo/456' && entity.originated_from.name == 'testorg/testrepo'",
+				},
+			},
+			selectOptions: []SelectOption{
+				WithUnknownPaths("entity.originated_from", "entity.evaluation"),
+			},
+			selectorEntityBld: newTestPullRequestSelectorEntity(newGithubProviderSelector()),
+			selected:          false,
+		},
+		{
+			name: "Use the aggregated evaluation status",
+			exprs: []models.ProfileSelector{
+				{
+					Entity:   minderv1.Entity_ENTITY_REPOSITORIES,
+					Selector: "entity.evaluation.status != 'error'",
+				},
+			},
+			selectorEntityBld: withSelectorEntityOptions(
+				newTestRepoSelectorEntity(newGithubProviderSelector()),
+				withEvaluation("failure", map[string]string{"secret_scanning": "failure"}),
+			),
+			selected: true,
+		},
+		{
+			name: "Use the evaluation status of a rule",
+			exprs: []models.ProfileSelector{
+				{
+					Entity:   minderv1.Entity_ENTITY_REPOSITORIES,
+					Selector: "entity.evaluation.rules['secret_scanning'] == 'success'",
+				},
+			},
+			selectorEntityBld: withSelectorEntityOptions(
+				newTestRepoSelectorEntity(newGithubProviderSelector()),
+				withEvaluation("failure", map[string]string{"secret_scanning": "failure"}),
+			),
+			selected: false,
+		},
+		{
+			name: "Use the evaluation status but explicitly tell Select that it's not resolved yet",
+			exprs: []models.ProfileSelector{
+				{
+					Entity:   minderv1.Entity_ENTITY_REPOSITORIES,
+					Selector: "entity.evaluation.status == 'success'",
+				},
+			},
+			selectOptions: []SelectOption{
+				WithUnknownPaths("entity.originated_from", "entity.evaluation"),
+			},
+			selectorEntityBld: newTestRepoSelectorEntity(newGithubProviderSelector()),
+			expectedSelectErr: ErrResultUnknown,
+			selected:          false,
+		},
 		{
 			name: "Use a PR property that is defined and true result",
 			exprs: []models.ProfileSelector{
@@ -67,6 +67,14 @@ type GitHubListAndClone interface {
 	Clone(ctx context.Context, repoURL, ref string) (*git.Repository, error)
 }
 
+// GitHubSecurityAlerts is an interface that defines the methods needed to list
+// the open security alerts of a GitHub repository
+type GitHubSecurityAlerts interface {
+	ListCodeScanningAlerts(ctx context.Context, owner, repo string) ([]*github.Alert, error)
+	ListSecretScanningAlerts(ctx context.Context, owner, repo string) ([]*github.SecretScanningAlert, error)
+	ListDependabotAlerts(ctx context.Context, owner, repo string) ([]*github.DependabotAlert, error)
+}
+
 // As is a type-cast function for Providers
 func As[T any](provider Provider) (T, error) {
 	result, ok := provider.(T)
@@ -179,6 +179,11 @@ func (r *RuleTypeEngine) Eval(
 	return res, err
 }
 
+// GetIngester returns the rule data ingester of the rule type engine
+func (r *RuleTypeEngine) GetIngester() interfaces.Ingester {
+	return r.ingester
+}
+
 // WithCustomIngester sets a custom ingester for the rule type engine. This is handy for testing
 // but should not be used in production.
 func (r *RuleTypeEngine) WithCustomIngester(ing interfaces.Ingester) *RuleTypeEngine {
@@ -31,6 +31,8 @@ const (
 	TopicQueueRefreshEntityByIDAndEvaluate = "refresh.entity.by.id.evaluate.event"
 	// TopicQueueRefreshEntityAndEvaluate makes sure that entity properties are up-to-date and schedules an evaluation
 	TopicQueueRefreshEntityAndEvaluate = "refresh.entity.evaluate.event"
+	// TopicQueuePrefetchEntityProperties refreshes the properties of a batch of entities ahead of their evaluation
+	TopicQueuePrefetchEntityProperties = "prefetch.entity.properties.event"
 	// TopicQueueEntityEvaluate is the topic for entity evaluation events from webhooks
 	TopicQueueEntityEvaluate = "execute.entity.event"
 	// TopicQueueEntityFlush is the topic for flushing internal webhook events
@@ -45,4 +47,15 @@ const (
 	TopicQueueReconcileEntityAdd = "internal.entity.add.event"
 	// TopicQueueRepoReminder is the topic for repo reminder events
 	TopicQueueRepoReminder = "repo.reminder.event"
+	// TopicQueueReconcileAutoRegistration is the topic for reconciling the auto-registration rules of a provider
+	TopicQueueReconcileAutoRegistration = "internal.autoregistration.reconcile.event"
+	// TopicQueueAutoRegisterEntity is the topic for evaluating a newly seen entity against auto-registration rules
+	TopicQueueAutoRegisterEntity = "internal.autoregistration.entity.event"
+	// TopicQueueReconcileProviderHealth is the topic for checking the health of a provider
+	TopicQueueReconcileProviderHealth = "internal.provider.health.reconcile.event"
+	// TopicQueueProviderHealthNotification is the topic for notifications about the health of
+	// providers, e.g. a credential which is about to expire
+	TopicQueueProviderHealthNotification = "provider.health.notification.event"
+	// TopicQueueReconcileWebhooks is the topic for reconciling the webhooks of the entities of a provider
+	TopicQueueReconcileWebhooks = "internal.webhook.reconcile.event"
 )
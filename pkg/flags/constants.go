@@ -10,6 +10,8 @@ const (
 	DockerHubProvider Experiment = "dockerhub_provider"
 	// GitLabProvider enables the GitLab provider.
 	GitLabProvider Experiment = "gitlab_provider"
+	// AzureDevOpsProvider enables the Azure DevOps provider.
+	AzureDevOpsProvider Experiment = "azure_devops_provider"
 	// AlternateMessageDriver enables an an alternate message driver.
 	AlternateMessageDriver Experiment = "alternate_message_driver"
 	// GitPRDiffs enables the git ingester for pull requests.
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"bytes"
+	"fmt"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	"github.com/mindersec/minder/pkg/mindpak"
+)
+
+const (
+	annotationTitle   = "org.opencontainers.image.title"
+	annotationVersion = "org.opencontainers.image.version"
+)
+
+// Image packs a bundle as an OCI artifact which can be pushed to a registry.
+// The artifact has a single layer holding the bundle archive.
+func (p *Packer) Image(bundle *mindpak.Bundle) (v1.Image, error) {
+	if bundle.Metadata == nil {
+		return nil, fmt.Errorf("unable to pack bundle, metadata not defined")
+	}
+
+	var buf bytes.Buffer
+	if err := p.Write(bundle, &buf); err != nil {
+		return nil, fmt.Errorf("writing bundle archive: %w", err)
+	}
+
+	img := mutate.MediaType(empty.Image, types.OCIManifestSchema1)
+	img = mutate.ConfigMediaType(img, mindpak.ConfigMediaType)
+	img, err := mutate.Append(img, mutate.Addendum{
+		Layer:     static.NewLayer(buf.Bytes(), mindpak.LayerMediaType),
+		MediaType: mindpak.LayerMediaType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("adding bundle layer: %w", err)
+	}
+
+	id := mindpak.ID(bundle.Metadata.Namespace, bundle.Metadata.Name)
+	annotated, ok := mutate.Annotations(img, map[string]string{
+		annotationTitle:   id.String(),
+		annotationVersion: bundle.Metadata.Version,
+	}).(v1.Image)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type when annotating bundle image")
+	}
+	return annotated, nil
+}
@@ -69,9 +69,20 @@ func NewBundleFromTarGZ(path string) (*Bundle, error) {
 	}
 	defer file.Close()
 
-	gz, err := gzip.NewReader(file)
+	bundle, err := NewBundleFromTarGZReader(file)
 	if err != nil {
-		return nil, fmt.Errorf("error while creating gzip reader for %s: %w", path, err)
+		return nil, fmt.Errorf("error while loading %s: %w", path, err)
+	}
+	return bundle, nil
+}
+
+// NewBundleFromTarGZReader loads a bundle from a stream in .tar.gz format,
+// e.g. a layer of an OCI artifact. Like NewBundleFromTarGZ, it loads the
+// entire contents of the bundle into memory.
+func NewBundleFromTarGZReader(r io.Reader) (*Bundle, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating gzip reader: %w", err)
 	}
 	defer gz.Close()
 
@@ -81,11 +92,17 @@ func NewBundleFromTarGZ(path string) (*Bundle, error) {
 		return nil, err
 	}
 
+	return NewBundleFromFS(sourceFS)
+}
+
+// NewBundleFromFS returns a bundle loaded with the contents of a filesystem
+// holding the bundle structure at its root
+func NewBundleFromFS(sourceFS fs.StatFS) (*Bundle, error) {
 	bundle := &Bundle{
 		Source: sourceFS,
 	}
 	if err := bundle.ReadSource(); err != nil {
-		return nil, fmt.Errorf("reading bundle data from %q: %w", path, err)
+		return nil, fmt.Errorf("reading bundle data: %w", err)
 	}
 
 	return bundle, nil
@@ -178,6 +195,12 @@ func (b *Bundle) ReadSource() error {
 	return nil
 }
 
+// IsBundlePath returns whether the path, relative to the root of a bundle, is
+// part of the bundle structure
+func IsBundlePath(path string) bool {
+	return pathInKnownDirectory(path)
+}
+
 func pathInKnownDirectory(path string) bool {
 	return strings.HasPrefix(path, PathProfiles+"/") ||
 		strings.HasPrefix(path, PathRuleTypes+"/") ||
@@ -24,6 +24,16 @@ const (
 	ManifestFileName = "manifest.json"
 )
 
+const (
+	// ConfigMediaType is the media type of the config of bundles pushed to
+	// OCI registries
+	ConfigMediaType = "application/vnd.mindersec.mindpak.config.v1+json"
+
+	// LayerMediaType is the media type of the layer holding the .tar.gz
+	// archive of bundles pushed to OCI registries
+	LayerMediaType = "application/vnd.mindersec.mindpak.layer.v1.tar+gzip"
+)
+
 const (
 	// SHA256 is the algorith name constant for the manifest and tests
 	SHA256 = HashAlgorithm("sha-256")
@@ -5,6 +5,7 @@
 package reader
 
 import (
+	"errors"
 	"fmt"
 	"io/fs"
 	"strings"
@@ -33,6 +34,9 @@ type BundleReader interface {
 	ForEachDataSource(func(source *v1.DataSource) error) error
 }
 
+// ErrProfileNotFound is returned when the bundle does not contain the profile
+var ErrProfileNotFound = errors.New("profile does not exist in bundle")
+
 type profileSetType = map[string]struct{}
 type bundleReader struct {
 	original *mindpak.Bundle
@@ -70,7 +74,7 @@ func (b *bundleReader) GetProfile(name string) (*v1.Profile, error) {
 	// validate that profile exists
 	_, ok := b.profiles[name]
 	if !ok {
-		return nil, fmt.Errorf("profile does not exist in bundle: %s", name)
+		return nil, fmt.Errorf("%w: %s", ErrProfileNotFound, name)
 	}
 
 	// read from bundle
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/spf13/afero"
+
+	"github.com/mindersec/minder/pkg/mindpak"
+)
+
+type gitSource struct {
+	url  string
+	ref  string
+	path string
+}
+
+// NewSourceFromGit creates a RefreshableSource which pulls a bundle from a
+// directory of a git repository. The ref may be a branch, a tag or a commit
+// hash, and defaults to the HEAD of the repository. Branches and tags are
+// resolved again on every refresh.
+func NewSourceFromGit(ctx context.Context, url, ref, dir string) (RefreshableSource, error) {
+	source := &gitSource{
+		url:  url,
+		ref:  ref,
+		path: strings.Trim(path.Clean("/"+dir), "/"),
+	}
+
+	remoteSource, err := newRemoteSource(ctx, source.pull)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load bundle from %s: %w", url, err)
+	}
+	return remoteSource, nil
+}
+
+func (s *gitSource) pull(ctx context.Context) (*mindpak.Bundle, error) {
+	repo, err := git.CloneContext(ctx, memory.NewStorage(), nil, &git.CloneOptions{
+		URL:  s.url,
+		Tags: git.AllTags,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while cloning %s: %w", s.url, err)
+	}
+
+	hash, err := s.resolve(repo)
+	if err != nil {
+		return nil, err
+	}
+	commit, err := repo.CommitObject(*hash)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading commit %s: %w", hash, err)
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("error while reading tree of commit %s: %w", hash, err)
+	}
+	if s.path != "" {
+		tree, err = tree.Tree(s.path)
+		if err != nil {
+			return nil, fmt.Errorf("error while reading %s at %s: %w", s.path, hash, err)
+		}
+	}
+
+	sourceFS, err := copyTreeIntoMemory(tree)
+	if err != nil {
+		return nil, err
+	}
+	return mindpak.NewBundleFromFS(sourceFS)
+}
+
+// resolve returns the commit the ref points to. Branches are looked up
+// among the remote branches, as the clone has no local branches besides
+// the default one.
+func (s *gitSource) resolve(repo *git.Repository) (*plumbing.Hash, error) {
+	if s.ref == "" {
+		head, err := repo.Head()
+		if err != nil {
+			return nil, fmt.Errorf("error while resolving HEAD: %w", err)
+		}
+		hash := head.Hash()
+		return &hash, nil
+	}
+
+	for _, rev := range []string{s.ref, git.DefaultRemoteName + "/" + s.ref} {
+		hash, err := repo.ResolveRevision(plumbing.Revision(rev))
+		if err == nil {
+			return hash, nil
+		}
+		if !errors.Is(err, plumbing.ErrReferenceNotFound) {
+			return nil, fmt.Errorf("error while resolving %s: %w", s.ref, err)
+		}
+	}
+	return nil, fmt.Errorf("ref %s not found in %s", s.ref, s.url)
+}
+
+// copyTreeIntoMemory copies the files of the bundle structure from a git
+// tree into an in-memory filesystem, skipping anything else in the
+// directory, e.g. a README
+func copyTreeIntoMemory(tree *object.Tree) (afero.IOFS, error) {
+	sourceFS := afero.NewIOFS(afero.NewMemMapFs())
+	err := tree.Files().ForEach(func(f *object.File) error {
+		if !f.Mode.IsFile() || !mindpak.IsBundlePath(f.Name) {
+			return nil
+		}
+		if err := sourceFS.MkdirAll(path.Dir(f.Name), 0700); err != nil {
+			return fmt.Errorf("error creating directory in memfs: %w", err)
+		}
+
+		contents, err := f.Reader()
+		if err != nil {
+			return fmt.Errorf("error while reading %s: %w", f.Name, err)
+		}
+		defer contents.Close()
+
+		memFile, err := sourceFS.Create(f.Name)
+		if err != nil {
+			return fmt.Errorf("error while creating memfs file: %w", err)
+		}
+		defer memFile.Close()
+
+		if _, err := io.Copy(memFile, contents); err != nil {
+			return fmt.Errorf("error while copying file into memfs: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return afero.IOFS{}, err
+	}
+	return sourceFS, nil
+}
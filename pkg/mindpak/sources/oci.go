@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	"github.com/mindersec/minder/pkg/mindpak"
+)
+
+// SignatureVerifier verifies the signature of a bundle pulled from an OCI
+// registry. It is given the repository of the bundle and the digest of its
+// manifest, and returns an error if the signature is missing or invalid.
+type SignatureVerifier func(ctx context.Context, repo name.Repository, digest string) error
+
+// OCIOption configures a bundle source backed by an OCI registry
+type OCIOption func(*ociSource)
+
+// WithKeychain sets the keychain used to authenticate to the registry.
+// Defaults to the docker config of the user running the server.
+func WithKeychain(keychain authn.Keychain) OCIOption {
+	return func(s *ociSource) {
+		s.keychain = keychain
+	}
+}
+
+// WithSignatureVerifier requires the bundle to be signed, checking its
+// signature with the given verifier each time the bundle is pulled
+func WithSignatureVerifier(verifier SignatureVerifier) OCIOption {
+	return func(s *ociSource) {
+		s.verify = verifier
+	}
+}
+
+// WithRemoteOptions sets additional options for the registry client
+func WithRemoteOptions(opts ...remote.Option) OCIOption {
+	return func(s *ociSource) {
+		s.remoteOpts = append(s.remoteOpts, opts...)
+	}
+}
+
+type ociSource struct {
+	ref        name.Reference
+	keychain   authn.Keychain
+	verify     SignatureVerifier
+	remoteOpts []remote.Option
+}
+
+// NewSourceFromOCI creates a RefreshableSource which pulls a bundle pushed to
+// an OCI registry, e.g. by `minder-dev bundle push`. The reference may point
+// to a tag, which is resolved again on every refresh, or to a digest.
+func NewSourceFromOCI(ctx context.Context, ref string, opts ...OCIOption) (RefreshableSource, error) {
+	parsed, err := name.ParseReference(ref)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OCI reference %s: %w", ref, err)
+	}
+	source := &ociSource{
+		ref:      parsed,
+		keychain: authn.DefaultKeychain,
+	}
+	for _, opt := range opts {
+		opt(source)
+	}
+
+	remoteSource, err := newRemoteSource(ctx, source.pull)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load bundle from %s: %w", ref, err)
+	}
+	return remoteSource, nil
+}
+
+func (s *ociSource) pull(ctx context.Context) (*mindpak.Bundle, error) {
+	opts := append([]remote.Option{
+		remote.WithContext(ctx),
+		remote.WithAuthFromKeychain(s.keychain),
+	}, s.remoteOpts...)
+
+	img, err := remote.Image(s.ref, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error while pulling %s: %w", s.ref, err)
+	}
+	digest, err := img.Digest()
+	if err != nil {
+		return nil, fmt.Errorf("error while computing digest of %s: %w", s.ref, err)
+	}
+
+	if s.verify != nil {
+		if err := s.verify(ctx, s.ref.Context(), digest.String()); err != nil {
+			return nil, fmt.Errorf("signature verification failed for %s@%s: %w", s.ref.Context(), digest, err)
+		}
+	}
+
+	layers, err := img.Layers()
+	if err != nil {
+		return nil, fmt.Errorf("error while reading layers of %s: %w", s.ref, err)
+	}
+	for _, layer := range layers {
+		mediaType, err := layer.MediaType()
+		if err != nil {
+			return nil, fmt.Errorf("error while reading layer media type: %w", err)
+		}
+		if mediaType != mindpak.LayerMediaType {
+			continue
+		}
+
+		rc, err := layer.Compressed()
+		if err != nil {
+			return nil, fmt.Errorf("error while reading bundle layer: %w", err)
+		}
+		defer rc.Close()
+		return mindpak.NewBundleFromTarGZReader(rc)
+	}
+	return nil, fmt.Errorf("%s is not a bundle: no layer of type %s", s.ref, mindpak.LayerMediaType)
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sources
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/mindersec/minder/pkg/mindpak"
+	"github.com/mindersec/minder/pkg/mindpak/reader"
+)
+
+// RefreshableSource is a BundleSource which pulls its bundle from a remote
+// location, and can pull it again to pick up newer versions
+type RefreshableSource interface {
+	BundleSource
+	// Refresh pulls the bundle again. The bundle served by the source is only
+	// replaced if the new one was loaded successfully.
+	Refresh(ctx context.Context) error
+}
+
+// fetchFunc pulls a bundle from a remote location
+type fetchFunc func(ctx context.Context) (*mindpak.Bundle, error)
+
+// remoteSource is a RefreshableSource serving the last bundle it pulled. The
+// identity of the bundle is fixed by the first pull, as the marketplace maps
+// bundles to sources when it is created.
+type remoteSource struct {
+	fetch  fetchFunc
+	mu     sync.RWMutex
+	bundle reader.BundleReader
+}
+
+func newRemoteSource(ctx context.Context, fetch fetchFunc) (*remoteSource, error) {
+	bundle, err := fetchBundle(ctx, fetch)
+	if err != nil {
+		return nil, err
+	}
+	return &remoteSource{
+		fetch:  fetch,
+		bundle: bundle,
+	}, nil
+}
+
+func (s *remoteSource) GetBundle(id mindpak.BundleID) (reader.BundleReader, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return (&singleBundleSource{bundle: s.bundle}).GetBundle(id)
+}
+
+func (s *remoteSource) ListBundles() ([]mindpak.BundleID, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return (&singleBundleSource{bundle: s.bundle}).ListBundles()
+}
+
+func (s *remoteSource) Refresh(ctx context.Context) error {
+	bundle, err := fetchBundle(ctx, s.fetch)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current := s.bundle.GetMetadata()
+	next := bundle.GetMetadata()
+	if current.Namespace != next.Namespace || current.Name != next.Name {
+		return fmt.Errorf("bundle changed from %s to %s",
+			mindpak.ID(current.Namespace, current.Name), mindpak.ID(next.Namespace, next.Name))
+	}
+	s.bundle = bundle
+	return nil
+}
+
+func fetchBundle(ctx context.Context, fetch fetchFunc) (reader.BundleReader, error) {
+	bundle, err := fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := bundle.Verify(); err != nil {
+		return nil, fmt.Errorf("bundle failed verification: %w", err)
+	}
+	return reader.NewBundleReader(bundle), nil
+}
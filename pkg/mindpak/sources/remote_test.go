@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sources_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mindersec/minder/pkg/mindpak"
+	"github.com/mindersec/minder/pkg/mindpak/build"
+	"github.com/mindersec/minder/pkg/mindpak/sources"
+)
+
+func TestOCISource(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(registry.New())
+	t.Cleanup(srv.Close)
+	ref := strings.TrimPrefix(srv.URL, "http://") + "/bundles/t2:latest"
+
+	digest := pushBundle(t, ref, "v0.0.1")
+	source, err := sources.NewSourceFromOCI(context.Background(), ref)
+	require.NoError(t, err)
+	requireBundleVersion(t, source, "v0.0.1")
+
+	// by digest
+	pinned, err := sources.NewSourceFromOCI(context.Background(), strings.Split(ref, ":latest")[0]+"@"+digest)
+	require.NoError(t, err)
+	requireBundleVersion(t, pinned, "v0.0.1")
+
+	// the tag is resolved again on refresh
+	pushBundle(t, ref, "v0.0.2")
+	require.NoError(t, source.Refresh(context.Background()))
+	requireBundleVersion(t, source, "v0.0.2")
+	require.NoError(t, pinned.Refresh(context.Background()))
+	requireBundleVersion(t, pinned, "v0.0.1")
+}
+
+func TestOCISource_Signature(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(registry.New())
+	t.Cleanup(srv.Close)
+	ref := strings.TrimPrefix(srv.URL, "http://") + "/bundles/t2:v0.0.1"
+	digest := pushBundle(t, ref, "v0.0.1")
+
+	var verified string
+	_, err := sources.NewSourceFromOCI(context.Background(), ref,
+		sources.WithSignatureVerifier(func(_ context.Context, repo name.Repository, d string) error {
+			verified = repo.String() + "@" + d
+			return nil
+		}))
+	require.NoError(t, err)
+	require.Equal(t, strings.TrimSuffix(ref, ":v0.0.1")+"@"+digest, verified)
+
+	_, err = sources.NewSourceFromOCI(context.Background(), ref,
+		sources.WithSignatureVerifier(func(context.Context, name.Repository, string) error {
+			return errors.New("no verified signature found")
+		}))
+	require.ErrorContains(t, err, "signature verification failed")
+}
+
+func TestOCISource_NotABundle(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(registry.New())
+	t.Cleanup(srv.Close)
+	ref, err := name.ParseReference(strings.TrimPrefix(srv.URL, "http://") + "/images/empty:latest")
+	require.NoError(t, err)
+
+	require.NoError(t, remote.Write(ref, empty.Image))
+
+	_, err = sources.NewSourceFromOCI(context.Background(), ref.String())
+	require.ErrorContains(t, err, "is not a bundle")
+}
+
+func TestGitSource(t *testing.T) {
+	t.Parallel()
+
+	repoDir := t.TempDir()
+	repo, err := git.PlainInit(repoDir, false)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "README.md"), []byte("bundles"), 0600))
+	extractBundle(t, filepath.Join(repoDir, "bundles", "t2"), "v0.0.1")
+	first := commitAll(t, repo)
+	_, err = repo.CreateTag("v0.0.1", first, nil)
+	require.NoError(t, err)
+
+	extractBundle(t, filepath.Join(repoDir, "bundles", "t2"), "v0.0.2")
+	commitAll(t, repo)
+
+	head, err := sources.NewSourceFromGit(context.Background(), repoDir, "", "bundles/t2")
+	require.NoError(t, err)
+	requireBundleVersion(t, head, "v0.0.2")
+
+	tag, err := sources.NewSourceFromGit(context.Background(), repoDir, "v0.0.1", "/bundles/t2/")
+	require.NoError(t, err)
+	requireBundleVersion(t, tag, "v0.0.1")
+
+	commit, err := sources.NewSourceFromGit(context.Background(), repoDir, first.String(), "bundles/t2")
+	require.NoError(t, err)
+	requireBundleVersion(t, commit, "v0.0.1")
+
+	branchRef, err := repo.Head()
+	require.NoError(t, err)
+	branch, err := sources.NewSourceFromGit(context.Background(), repoDir, branchRef.Name().Short(), "bundles/t2")
+	require.NoError(t, err)
+	requireBundleVersion(t, branch, "v0.0.2")
+
+	// the branch is resolved again on refresh
+	extractBundle(t, filepath.Join(repoDir, "bundles", "t2"), "v0.0.3")
+	commitAll(t, repo)
+	require.NoError(t, branch.Refresh(context.Background()))
+	requireBundleVersion(t, branch, "v0.0.3")
+
+	_, err = sources.NewSourceFromGit(context.Background(), repoDir, "does-not-exist", "bundles/t2")
+	require.ErrorContains(t, err, "not found")
+	_, err = sources.NewSourceFromGit(context.Background(), repoDir, "", "bundles/t3")
+	require.ErrorContains(t, err, "unable to load bundle")
+}
+
+func requireBundleVersion(t *testing.T, source sources.BundleSource, version string) {
+	t.Helper()
+	bundle, err := source.GetBundle(mindpak.ID("stacklok", "t2"))
+	require.NoError(t, err)
+	require.Equal(t, version, bundle.GetMetadata().Version)
+}
+
+// extractBundle extracts the sample bundle into dir, setting its version
+func extractBundle(t *testing.T, dir, version string) {
+	t.Helper()
+	f, err := os.Open(sampleDataPath)
+	require.NoError(t, err)
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		target := filepath.Join(dir, filepath.Clean(header.Name))
+		if header.Typeflag == tar.TypeDir {
+			require.NoError(t, os.MkdirAll(target, 0700))
+			continue
+		}
+		contents, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		if header.Name == mindpak.ManifestFileName {
+			contents = []byte(strings.Replace(string(contents), "v0.0.1", version, 1))
+		}
+		require.NoError(t, os.MkdirAll(filepath.Dir(target), 0700))
+		require.NoError(t, os.WriteFile(target, contents, 0600))
+	}
+}
+
+func loadBundle(t *testing.T, version string) *mindpak.Bundle {
+	t.Helper()
+	dir := t.TempDir()
+	extractBundle(t, dir, version)
+	bundle, err := mindpak.NewBundleFromDirectory(dir)
+	require.NoError(t, err)
+	bundle.Metadata = bundle.Manifest.Metadata
+	return bundle
+}
+
+func pushBundle(t *testing.T, ref, version string) string {
+	t.Helper()
+	parsed, err := name.ParseReference(ref)
+	require.NoError(t, err)
+	img, err := build.NewPacker().Image(loadBundle(t, version))
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(parsed, img))
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	return digest.String()
+}
+
+func commitAll(t *testing.T, repo *git.Repository) plumbing.Hash {
+	t.Helper()
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+	require.NoError(t, wt.AddGlob("."))
+	hash, err := wt.Commit("update bundle", &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+	return hash
+}
@@ -59,4 +59,14 @@ type GitLabCredential interface {
 	RestCredential
 	GitCredential
 	OAuth2TokenCredential
+
+	GetCacheKey() string
+}
+
+// AzureDevOpsCredential is the interface for credentials used when interacting with Azure DevOps
+type AzureDevOpsCredential interface {
+	RestCredential
+	GitCredential
+
+	GetCacheKey() string
 }
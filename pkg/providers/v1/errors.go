@@ -12,4 +12,7 @@ var (
 	ErrRepositoryEmpty = errors.New("repository is empty")
 	// ErrRepositoryTooLarge is returned when the configured size limit is exceeded
 	ErrRepositoryTooLarge = errors.New("repository is too large to clone")
+	// ErrSecurityAlertsDisabled is returned when a kind of security alerts
+	// is disabled or not available for a repository
+	ErrSecurityAlertsDisabled = errors.New("security alerts are disabled for the repository")
 )
@@ -604,6 +604,428 @@ func (mr *MockRepoListerMockRecorder) SupportsEntity(entType any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsEntity", reflect.TypeOf((*MockRepoLister)(nil).SupportsEntity), entType)
 }
 
+// MockBatchPropertyFetcher is a mock of BatchPropertyFetcher interface.
+type MockBatchPropertyFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchPropertyFetcherMockRecorder
+	isgomock struct{}
+}
+
+// MockBatchPropertyFetcherMockRecorder is the mock recorder for MockBatchPropertyFetcher.
+type MockBatchPropertyFetcherMockRecorder struct {
+	mock *MockBatchPropertyFetcher
+}
+
+// NewMockBatchPropertyFetcher creates a new mock instance.
+func NewMockBatchPropertyFetcher(ctrl *gomock.Controller) *MockBatchPropertyFetcher {
+	mock := &MockBatchPropertyFetcher{ctrl: ctrl}
+	mock.recorder = &MockBatchPropertyFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchPropertyFetcher) EXPECT() *MockBatchPropertyFetcherMockRecorder {
+	return m.recorder
+}
+
+// CreationOptions mocks base method.
+func (m *MockBatchPropertyFetcher) CreationOptions(entType v10.Entity) *v11.EntityCreationOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreationOptions", entType)
+	ret0, _ := ret[0].(*v11.EntityCreationOptions)
+	return ret0
+}
+
+// CreationOptions indicates an expected call of CreationOptions.
+func (mr *MockBatchPropertyFetcherMockRecorder) CreationOptions(entType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreationOptions", reflect.TypeOf((*MockBatchPropertyFetcher)(nil).CreationOptions), entType)
+}
+
+// DeregisterEntity mocks base method.
+func (m *MockBatchPropertyFetcher) DeregisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterEntity indicates an expected call of DeregisterEntity.
+func (mr *MockBatchPropertyFetcherMockRecorder) DeregisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterEntity", reflect.TypeOf((*MockBatchPropertyFetcher)(nil).DeregisterEntity), ctx, entType, props)
+}
+
+// FetchAllProperties mocks base method.
+func (m *MockBatchPropertyFetcher) FetchAllProperties(ctx context.Context, getByProps *properties.Properties, entType v10.Entity, cachedProps *properties.Properties) (*properties.Properties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchAllProperties", ctx, getByProps, entType, cachedProps)
+	ret0, _ := ret[0].(*properties.Properties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchAllProperties indicates an expected call of FetchAllProperties.
+func (mr *MockBatchPropertyFetcherMockRecorder) FetchAllProperties(ctx, getByProps, entType, cachedProps any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchAllProperties", reflect.TypeOf((*MockBatchPropertyFetcher)(nil).FetchAllProperties), ctx, getByProps, entType, cachedProps)
+}
+
+// FetchAllPropertiesBatch mocks base method.
+func (m *MockBatchPropertyFetcher) FetchAllPropertiesBatch(ctx context.Context, getByProps []*properties.Properties, entType v10.Entity, cachedProps []*properties.Properties) []v11.BatchPropertiesResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchAllPropertiesBatch", ctx, getByProps, entType, cachedProps)
+	ret0, _ := ret[0].([]v11.BatchPropertiesResult)
+	return ret0
+}
+
+// FetchAllPropertiesBatch indicates an expected call of FetchAllPropertiesBatch.
+func (mr *MockBatchPropertyFetcherMockRecorder) FetchAllPropertiesBatch(ctx, getByProps, entType, cachedProps any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchAllPropertiesBatch", reflect.TypeOf((*MockBatchPropertyFetcher)(nil).FetchAllPropertiesBatch), ctx, getByProps, entType, cachedProps)
+}
+
+// GetEntityName mocks base method.
+func (m *MockBatchPropertyFetcher) GetEntityName(entType v10.Entity, props *properties.Properties) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntityName", entType, props)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntityName indicates an expected call of GetEntityName.
+func (mr *MockBatchPropertyFetcherMockRecorder) GetEntityName(entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityName", reflect.TypeOf((*MockBatchPropertyFetcher)(nil).GetEntityName), entType, props)
+}
+
+// PropertiesToProtoMessage mocks base method.
+func (m *MockBatchPropertyFetcher) PropertiesToProtoMessage(entType v10.Entity, props *properties.Properties) (protoreflect.ProtoMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PropertiesToProtoMessage", entType, props)
+	ret0, _ := ret[0].(protoreflect.ProtoMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PropertiesToProtoMessage indicates an expected call of PropertiesToProtoMessage.
+func (mr *MockBatchPropertyFetcherMockRecorder) PropertiesToProtoMessage(entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PropertiesToProtoMessage", reflect.TypeOf((*MockBatchPropertyFetcher)(nil).PropertiesToProtoMessage), entType, props)
+}
+
+// RegisterEntity mocks base method.
+func (m *MockBatchPropertyFetcher) RegisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) (*properties.Properties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(*properties.Properties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterEntity indicates an expected call of RegisterEntity.
+func (mr *MockBatchPropertyFetcherMockRecorder) RegisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterEntity", reflect.TypeOf((*MockBatchPropertyFetcher)(nil).RegisterEntity), ctx, entType, props)
+}
+
+// SupportsEntity mocks base method.
+func (m *MockBatchPropertyFetcher) SupportsEntity(entType v10.Entity) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SupportsEntity", entType)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// SupportsEntity indicates an expected call of SupportsEntity.
+func (mr *MockBatchPropertyFetcherMockRecorder) SupportsEntity(entType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsEntity", reflect.TypeOf((*MockBatchPropertyFetcher)(nil).SupportsEntity), entType)
+}
+
+// MockHealthChecker is a mock of HealthChecker interface.
+type MockHealthChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockHealthCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockHealthCheckerMockRecorder is the mock recorder for MockHealthChecker.
+type MockHealthCheckerMockRecorder struct {
+	mock *MockHealthChecker
+}
+
+// NewMockHealthChecker creates a new mock instance.
+func NewMockHealthChecker(ctrl *gomock.Controller) *MockHealthChecker {
+	mock := &MockHealthChecker{ctrl: ctrl}
+	mock.recorder = &MockHealthCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHealthChecker) EXPECT() *MockHealthCheckerMockRecorder {
+	return m.recorder
+}
+
+// CheckHealth mocks base method.
+func (m *MockHealthChecker) CheckHealth(ctx context.Context) (*v11.HealthReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckHealth", ctx)
+	ret0, _ := ret[0].(*v11.HealthReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckHealth indicates an expected call of CheckHealth.
+func (mr *MockHealthCheckerMockRecorder) CheckHealth(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckHealth", reflect.TypeOf((*MockHealthChecker)(nil).CheckHealth), ctx)
+}
+
+// CreationOptions mocks base method.
+func (m *MockHealthChecker) CreationOptions(entType v10.Entity) *v11.EntityCreationOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreationOptions", entType)
+	ret0, _ := ret[0].(*v11.EntityCreationOptions)
+	return ret0
+}
+
+// CreationOptions indicates an expected call of CreationOptions.
+func (mr *MockHealthCheckerMockRecorder) CreationOptions(entType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreationOptions", reflect.TypeOf((*MockHealthChecker)(nil).CreationOptions), entType)
+}
+
+// DeregisterEntity mocks base method.
+func (m *MockHealthChecker) DeregisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterEntity indicates an expected call of DeregisterEntity.
+func (mr *MockHealthCheckerMockRecorder) DeregisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterEntity", reflect.TypeOf((*MockHealthChecker)(nil).DeregisterEntity), ctx, entType, props)
+}
+
+// FetchAllProperties mocks base method.
+func (m *MockHealthChecker) FetchAllProperties(ctx context.Context, getByProps *properties.Properties, entType v10.Entity, cachedProps *properties.Properties) (*properties.Properties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchAllProperties", ctx, getByProps, entType, cachedProps)
+	ret0, _ := ret[0].(*properties.Properties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchAllProperties indicates an expected call of FetchAllProperties.
+func (mr *MockHealthCheckerMockRecorder) FetchAllProperties(ctx, getByProps, entType, cachedProps any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchAllProperties", reflect.TypeOf((*MockHealthChecker)(nil).FetchAllProperties), ctx, getByProps, entType, cachedProps)
+}
+
+// GetEntityName mocks base method.
+func (m *MockHealthChecker) GetEntityName(entType v10.Entity, props *properties.Properties) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntityName", entType, props)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntityName indicates an expected call of GetEntityName.
+func (mr *MockHealthCheckerMockRecorder) GetEntityName(entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityName", reflect.TypeOf((*MockHealthChecker)(nil).GetEntityName), entType, props)
+}
+
+// PropertiesToProtoMessage mocks base method.
+func (m *MockHealthChecker) PropertiesToProtoMessage(entType v10.Entity, props *properties.Properties) (protoreflect.ProtoMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PropertiesToProtoMessage", entType, props)
+	ret0, _ := ret[0].(protoreflect.ProtoMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PropertiesToProtoMessage indicates an expected call of PropertiesToProtoMessage.
+func (mr *MockHealthCheckerMockRecorder) PropertiesToProtoMessage(entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PropertiesToProtoMessage", reflect.TypeOf((*MockHealthChecker)(nil).PropertiesToProtoMessage), entType, props)
+}
+
+// RegisterEntity mocks base method.
+func (m *MockHealthChecker) RegisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) (*properties.Properties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(*properties.Properties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterEntity indicates an expected call of RegisterEntity.
+func (mr *MockHealthCheckerMockRecorder) RegisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterEntity", reflect.TypeOf((*MockHealthChecker)(nil).RegisterEntity), ctx, entType, props)
+}
+
+// SupportsEntity mocks base method.
+func (m *MockHealthChecker) SupportsEntity(entType v10.Entity) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SupportsEntity", entType)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// SupportsEntity indicates an expected call of SupportsEntity.
+func (mr *MockHealthCheckerMockRecorder) SupportsEntity(entType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsEntity", reflect.TypeOf((*MockHealthChecker)(nil).SupportsEntity), entType)
+}
+
+// MockWebhookReconciler is a mock of WebhookReconciler interface.
+type MockWebhookReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookReconcilerMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookReconcilerMockRecorder is the mock recorder for MockWebhookReconciler.
+type MockWebhookReconcilerMockRecorder struct {
+	mock *MockWebhookReconciler
+}
+
+// NewMockWebhookReconciler creates a new mock instance.
+func NewMockWebhookReconciler(ctrl *gomock.Controller) *MockWebhookReconciler {
+	mock := &MockWebhookReconciler{ctrl: ctrl}
+	mock.recorder = &MockWebhookReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookReconciler) EXPECT() *MockWebhookReconcilerMockRecorder {
+	return m.recorder
+}
+
+// CreationOptions mocks base method.
+func (m *MockWebhookReconciler) CreationOptions(entType v10.Entity) *v11.EntityCreationOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreationOptions", entType)
+	ret0, _ := ret[0].(*v11.EntityCreationOptions)
+	return ret0
+}
+
+// CreationOptions indicates an expected call of CreationOptions.
+func (mr *MockWebhookReconcilerMockRecorder) CreationOptions(entType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreationOptions", reflect.TypeOf((*MockWebhookReconciler)(nil).CreationOptions), entType)
+}
+
+// DeregisterEntity mocks base method.
+func (m *MockWebhookReconciler) DeregisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterEntity indicates an expected call of DeregisterEntity.
+func (mr *MockWebhookReconcilerMockRecorder) DeregisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterEntity", reflect.TypeOf((*MockWebhookReconciler)(nil).DeregisterEntity), ctx, entType, props)
+}
+
+// FetchAllProperties mocks base method.
+func (m *MockWebhookReconciler) FetchAllProperties(ctx context.Context, getByProps *properties.Properties, entType v10.Entity, cachedProps *properties.Properties) (*properties.Properties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchAllProperties", ctx, getByProps, entType, cachedProps)
+	ret0, _ := ret[0].(*properties.Properties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchAllProperties indicates an expected call of FetchAllProperties.
+func (mr *MockWebhookReconcilerMockRecorder) FetchAllProperties(ctx, getByProps, entType, cachedProps any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchAllProperties", reflect.TypeOf((*MockWebhookReconciler)(nil).FetchAllProperties), ctx, getByProps, entType, cachedProps)
+}
+
+// GetEntityName mocks base method.
+func (m *MockWebhookReconciler) GetEntityName(entType v10.Entity, props *properties.Properties) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntityName", entType, props)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntityName indicates an expected call of GetEntityName.
+func (mr *MockWebhookReconcilerMockRecorder) GetEntityName(entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityName", reflect.TypeOf((*MockWebhookReconciler)(nil).GetEntityName), entType, props)
+}
+
+// PropertiesToProtoMessage mocks base method.
+func (m *MockWebhookReconciler) PropertiesToProtoMessage(entType v10.Entity, props *properties.Properties) (protoreflect.ProtoMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PropertiesToProtoMessage", entType, props)
+	ret0, _ := ret[0].(protoreflect.ProtoMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PropertiesToProtoMessage indicates an expected call of PropertiesToProtoMessage.
+func (mr *MockWebhookReconcilerMockRecorder) PropertiesToProtoMessage(entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PropertiesToProtoMessage", reflect.TypeOf((*MockWebhookReconciler)(nil).PropertiesToProtoMessage), entType, props)
+}
+
+// ReconcileWebhook mocks base method.
+func (m *MockWebhookReconciler) ReconcileWebhook(ctx context.Context, entType v10.Entity, props *properties.Properties) (*v11.WebhookReconcileResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileWebhook", ctx, entType, props)
+	ret0, _ := ret[0].(*v11.WebhookReconcileResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileWebhook indicates an expected call of ReconcileWebhook.
+func (mr *MockWebhookReconcilerMockRecorder) ReconcileWebhook(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileWebhook", reflect.TypeOf((*MockWebhookReconciler)(nil).ReconcileWebhook), ctx, entType, props)
+}
+
+// RegisterEntity mocks base method.
+func (m *MockWebhookReconciler) RegisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) (*properties.Properties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(*properties.Properties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterEntity indicates an expected call of RegisterEntity.
+func (mr *MockWebhookReconcilerMockRecorder) RegisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterEntity", reflect.TypeOf((*MockWebhookReconciler)(nil).RegisterEntity), ctx, entType, props)
+}
+
+// SupportsEntity mocks base method.
+func (m *MockWebhookReconciler) SupportsEntity(entType v10.Entity) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SupportsEntity", entType)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// SupportsEntity indicates an expected call of SupportsEntity.
+func (mr *MockWebhookReconcilerMockRecorder) SupportsEntity(entType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsEntity", reflect.TypeOf((*MockWebhookReconciler)(nil).SupportsEntity), entType)
+}
+
 // MockGetArtifactVersionsFilter is a mock of GetArtifactVersionsFilter interface.
 type MockGetArtifactVersionsFilter struct {
 	ctrl     *gomock.Controller
@@ -823,6 +1245,21 @@ func (mr *MockGitHubMockRecorder) CreateReview(arg0, arg1, arg2, arg3, arg4 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReview", reflect.TypeOf((*MockGitHub)(nil).CreateReview), arg0, arg1, arg2, arg3, arg4)
 }
 
+// CreateRuleset mocks base method.
+func (m *MockGitHub) CreateRuleset(ctx context.Context, owner, repo string, ruleset *github.Ruleset) (*github.Ruleset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRuleset", ctx, owner, repo, ruleset)
+	ret0, _ := ret[0].(*github.Ruleset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRuleset indicates an expected call of CreateRuleset.
+func (mr *MockGitHubMockRecorder) CreateRuleset(ctx, owner, repo, ruleset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRuleset", reflect.TypeOf((*MockGitHub)(nil).CreateRuleset), ctx, owner, repo, ruleset)
+}
+
 // CreateSecurityAdvisory mocks base method.
 func (m *MockGitHub) CreateSecurityAdvisory(ctx context.Context, owner, repo, severity, summary, description string, v []*github.AdvisoryVulnerability) (string, error) {
 	m.ctrl.T.Helper()
@@ -1132,6 +1569,21 @@ func (mr *MockGitHubMockRecorder) GetRepository(arg0, arg1, arg2 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepository", reflect.TypeOf((*MockGitHub)(nil).GetRepository), arg0, arg1, arg2)
 }
 
+// GetRuleset mocks base method.
+func (m *MockGitHub) GetRuleset(ctx context.Context, owner, repo string, id int64) (*github.Ruleset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRuleset", ctx, owner, repo, id)
+	ret0, _ := ret[0].(*github.Ruleset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRuleset indicates an expected call of GetRuleset.
+func (mr *MockGitHubMockRecorder) GetRuleset(ctx, owner, repo, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuleset", reflect.TypeOf((*MockGitHub)(nil).GetRuleset), ctx, owner, repo, id)
+}
+
 // GetUserId mocks base method.
 func (m *MockGitHub) GetUserId(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -1176,6 +1628,36 @@ func (mr *MockGitHubMockRecorder) ListAllRepositories(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllRepositories", reflect.TypeOf((*MockGitHub)(nil).ListAllRepositories), arg0)
 }
 
+// ListCodeScanningAlerts mocks base method.
+func (m *MockGitHub) ListCodeScanningAlerts(ctx context.Context, owner, repo string) ([]*github.Alert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCodeScanningAlerts", ctx, owner, repo)
+	ret0, _ := ret[0].([]*github.Alert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCodeScanningAlerts indicates an expected call of ListCodeScanningAlerts.
+func (mr *MockGitHubMockRecorder) ListCodeScanningAlerts(ctx, owner, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCodeScanningAlerts", reflect.TypeOf((*MockGitHub)(nil).ListCodeScanningAlerts), ctx, owner, repo)
+}
+
+// ListDependabotAlerts mocks base method.
+func (m *MockGitHub) ListDependabotAlerts(ctx context.Context, owner, repo string) ([]*github.DependabotAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDependabotAlerts", ctx, owner, repo)
+	ret0, _ := ret[0].([]*github.DependabotAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDependabotAlerts indicates an expected call of ListDependabotAlerts.
+func (mr *MockGitHubMockRecorder) ListDependabotAlerts(ctx, owner, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDependabotAlerts", reflect.TypeOf((*MockGitHub)(nil).ListDependabotAlerts), ctx, owner, repo)
+}
+
 // ListFiles mocks base method.
 func (m *MockGitHub) ListFiles(ctx context.Context, owner, repo string, prNumber, perPage, pageNumber int) ([]*github.CommitFile, *github.Response, error) {
 	m.ctrl.T.Helper()
@@ -1282,6 +1764,36 @@ func (mr *MockGitHubMockRecorder) ListReviews(arg0, arg1, arg2, arg3, arg4 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReviews", reflect.TypeOf((*MockGitHub)(nil).ListReviews), arg0, arg1, arg2, arg3, arg4)
 }
 
+// ListRulesets mocks base method.
+func (m *MockGitHub) ListRulesets(ctx context.Context, owner, repo string) ([]*github.Ruleset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRulesets", ctx, owner, repo)
+	ret0, _ := ret[0].([]*github.Ruleset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRulesets indicates an expected call of ListRulesets.
+func (mr *MockGitHubMockRecorder) ListRulesets(ctx, owner, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRulesets", reflect.TypeOf((*MockGitHub)(nil).ListRulesets), ctx, owner, repo)
+}
+
+// ListSecretScanningAlerts mocks base method.
+func (m *MockGitHub) ListSecretScanningAlerts(ctx context.Context, owner, repo string) ([]*github.SecretScanningAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretScanningAlerts", ctx, owner, repo)
+	ret0, _ := ret[0].([]*github.SecretScanningAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretScanningAlerts indicates an expected call of ListSecretScanningAlerts.
+func (mr *MockGitHubMockRecorder) ListSecretScanningAlerts(ctx, owner, repo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretScanningAlerts", reflect.TypeOf((*MockGitHub)(nil).ListSecretScanningAlerts), ctx, owner, repo)
+}
+
 // NewRequest mocks base method.
 func (m *MockGitHub) NewRequest(method, url string, body any) (*http.Request, error) {
 	m.ctrl.T.Helper()
@@ -1429,6 +1941,21 @@ func (mr *MockGitHubMockRecorder) UpdateReview(arg0, arg1, arg2, arg3, arg4, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockGitHub)(nil).UpdateReview), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// UpdateRuleset mocks base method.
+func (m *MockGitHub) UpdateRuleset(ctx context.Context, owner, repo string, id int64, ruleset *github.Ruleset) (*github.Ruleset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRuleset", ctx, owner, repo, id, ruleset)
+	ret0, _ := ret[0].(*github.Ruleset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRuleset indicates an expected call of UpdateRuleset.
+func (mr *MockGitHubMockRecorder) UpdateRuleset(ctx, owner, repo, id, ruleset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRuleset", reflect.TypeOf((*MockGitHub)(nil).UpdateRuleset), ctx, owner, repo, id, ruleset)
+}
+
 // MockImageLister is a mock of ImageLister interface.
 type MockImageLister struct {
 	ctrl     *gomock.Controller
@@ -1584,6 +2111,161 @@ func (mr *MockImageListerMockRecorder) SupportsEntity(entType any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsEntity", reflect.TypeOf((*MockImageLister)(nil).SupportsEntity), entType)
 }
 
+// MockContainerRegistry is a mock of ContainerRegistry interface.
+type MockContainerRegistry struct {
+	ctrl     *gomock.Controller
+	recorder *MockContainerRegistryMockRecorder
+	isgomock struct{}
+}
+
+// MockContainerRegistryMockRecorder is the mock recorder for MockContainerRegistry.
+type MockContainerRegistryMockRecorder struct {
+	mock *MockContainerRegistry
+}
+
+// NewMockContainerRegistry creates a new mock instance.
+func NewMockContainerRegistry(ctrl *gomock.Controller) *MockContainerRegistry {
+	mock := &MockContainerRegistry{ctrl: ctrl}
+	mock.recorder = &MockContainerRegistryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContainerRegistry) EXPECT() *MockContainerRegistryMockRecorder {
+	return m.recorder
+}
+
+// CreationOptions mocks base method.
+func (m *MockContainerRegistry) CreationOptions(entType v10.Entity) *v11.EntityCreationOptions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreationOptions", entType)
+	ret0, _ := ret[0].(*v11.EntityCreationOptions)
+	return ret0
+}
+
+// CreationOptions indicates an expected call of CreationOptions.
+func (mr *MockContainerRegistryMockRecorder) CreationOptions(entType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreationOptions", reflect.TypeOf((*MockContainerRegistry)(nil).CreationOptions), entType)
+}
+
+// DeregisterEntity mocks base method.
+func (m *MockContainerRegistry) DeregisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterEntity indicates an expected call of DeregisterEntity.
+func (mr *MockContainerRegistryMockRecorder) DeregisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterEntity", reflect.TypeOf((*MockContainerRegistry)(nil).DeregisterEntity), ctx, entType, props)
+}
+
+// FetchAllProperties mocks base method.
+func (m *MockContainerRegistry) FetchAllProperties(ctx context.Context, getByProps *properties.Properties, entType v10.Entity, cachedProps *properties.Properties) (*properties.Properties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchAllProperties", ctx, getByProps, entType, cachedProps)
+	ret0, _ := ret[0].(*properties.Properties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchAllProperties indicates an expected call of FetchAllProperties.
+func (mr *MockContainerRegistryMockRecorder) FetchAllProperties(ctx, getByProps, entType, cachedProps any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchAllProperties", reflect.TypeOf((*MockContainerRegistry)(nil).FetchAllProperties), ctx, getByProps, entType, cachedProps)
+}
+
+// GetAuthenticator mocks base method.
+func (m *MockContainerRegistry) GetAuthenticator() (authn.Authenticator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthenticator")
+	ret0, _ := ret[0].(authn.Authenticator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthenticator indicates an expected call of GetAuthenticator.
+func (mr *MockContainerRegistryMockRecorder) GetAuthenticator() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthenticator", reflect.TypeOf((*MockContainerRegistry)(nil).GetAuthenticator))
+}
+
+// GetEntityName mocks base method.
+func (m *MockContainerRegistry) GetEntityName(entType v10.Entity, props *properties.Properties) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntityName", entType, props)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntityName indicates an expected call of GetEntityName.
+func (mr *MockContainerRegistryMockRecorder) GetEntityName(entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityName", reflect.TypeOf((*MockContainerRegistry)(nil).GetEntityName), entType, props)
+}
+
+// GetRegistry mocks base method.
+func (m *MockContainerRegistry) GetRegistry() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistry")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetRegistry indicates an expected call of GetRegistry.
+func (mr *MockContainerRegistryMockRecorder) GetRegistry() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistry", reflect.TypeOf((*MockContainerRegistry)(nil).GetRegistry))
+}
+
+// PropertiesToProtoMessage mocks base method.
+func (m *MockContainerRegistry) PropertiesToProtoMessage(entType v10.Entity, props *properties.Properties) (protoreflect.ProtoMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PropertiesToProtoMessage", entType, props)
+	ret0, _ := ret[0].(protoreflect.ProtoMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PropertiesToProtoMessage indicates an expected call of PropertiesToProtoMessage.
+func (mr *MockContainerRegistryMockRecorder) PropertiesToProtoMessage(entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PropertiesToProtoMessage", reflect.TypeOf((*MockContainerRegistry)(nil).PropertiesToProtoMessage), entType, props)
+}
+
+// RegisterEntity mocks base method.
+func (m *MockContainerRegistry) RegisterEntity(ctx context.Context, entType v10.Entity, props *properties.Properties) (*properties.Properties, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterEntity", ctx, entType, props)
+	ret0, _ := ret[0].(*properties.Properties)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterEntity indicates an expected call of RegisterEntity.
+func (mr *MockContainerRegistryMockRecorder) RegisterEntity(ctx, entType, props any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterEntity", reflect.TypeOf((*MockContainerRegistry)(nil).RegisterEntity), ctx, entType, props)
+}
+
+// SupportsEntity mocks base method.
+func (m *MockContainerRegistry) SupportsEntity(entType v10.Entity) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SupportsEntity", entType)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// SupportsEntity indicates an expected call of SupportsEntity.
+func (mr *MockContainerRegistryMockRecorder) SupportsEntity(entType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupportsEntity", reflect.TypeOf((*MockContainerRegistry)(nil).SupportsEntity), entType)
+}
+
 // MockOCI is a mock of OCI interface.
 type MockOCI struct {
 	ctrl     *gomock.Controller
@@ -1681,6 +2363,21 @@ func (mr *MockOCIMockRecorder) GetAuthenticator() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthenticator", reflect.TypeOf((*MockOCI)(nil).GetAuthenticator))
 }
 
+// GetBlob mocks base method.
+func (m *MockOCI) GetBlob(ctx context.Context, name, digest string, maxBytes int64) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlob", ctx, name, digest, maxBytes)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlob indicates an expected call of GetBlob.
+func (mr *MockOCIMockRecorder) GetBlob(ctx, name, digest, maxBytes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlob", reflect.TypeOf((*MockOCI)(nil).GetBlob), ctx, name, digest, maxBytes)
+}
+
 // GetDigest mocks base method.
 func (m *MockOCI) GetDigest(ctx context.Context, name, tag string) (string, error) {
 	m.ctrl.T.Helper()
@@ -1727,10 +2424,10 @@ func (mr *MockOCIMockRecorder) GetManifest(ctx, name, tag any) *gomock.Call {
 }
 
 // GetReferrer mocks base method.
-func (m *MockOCI) GetReferrer(ctx context.Context, name, tag, artifactType string) (any, error) {
+func (m *MockOCI) GetReferrer(ctx context.Context, name, tag, artifactType string) (*v1.IndexManifest, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetReferrer", ctx, name, tag, artifactType)
-	ret0, _ := ret[0].(any)
+	ret0, _ := ret[0].(*v1.IndexManifest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -134,6 +134,73 @@ type RepoLister interface {
 	ListAllRepositories(context.Context) ([]*minderv1.Repository, error)
 }
 
+// BatchPropertiesResult is the result of fetching the properties of one of
+// the entities of a batch. Exactly one of Properties and Err is set.
+type BatchPropertiesResult struct {
+	Properties *properties.Properties
+	Err        error
+}
+
+// BatchPropertyFetcher is the interface for providers which can fetch the
+// properties of many entities of the same type with few upstream calls
+type BatchPropertyFetcher interface {
+	Provider
+
+	// FetchAllPropertiesBatch fetches all properties for the given entities,
+	// which must be of the same type. The results are in the order of
+	// getByProps, and for each entity are the same as FetchAllProperties
+	// would return. cachedProps, if not nil, holds the cached properties of
+	// each entity.
+	FetchAllPropertiesBatch(
+		ctx context.Context, getByProps []*properties.Properties, entType minderv1.Entity,
+		cachedProps []*properties.Properties,
+	) []BatchPropertiesResult
+}
+
+// HealthReport is the result of a health check of a provider
+type HealthReport struct {
+	// CredentialExpiresAt is when the credential of the provider expires.
+	// It is zero if the credential does not expire or the expiry is unknown.
+	CredentialExpiresAt time.Time
+	// Warnings are problems which do not prevent the provider from working
+	Warnings []string
+}
+
+// HealthChecker is the interface for providers which can check that their
+// credentials are valid and the upstream API is reachable
+type HealthChecker interface {
+	Provider
+
+	// CheckHealth makes a cheap authenticated call to the upstream API. It
+	// returns an error if the provider is not usable.
+	CheckHealth(ctx context.Context) (*HealthReport, error)
+}
+
+// WebhookReconcileResult is the result of reconciling the webhook of an entity
+type WebhookReconcileResult struct {
+	// Repaired lists the settings of the webhook which had drifted and were
+	// repaired, e.g. "url" or "events". "missing" means the webhook was
+	// created again.
+	Repaired []string
+	// Properties are the webhook properties of the entity which changed,
+	// e.g. the ID of a webhook created again. It is nil if none changed.
+	Properties *properties.Properties
+}
+
+// WebhookReconciler is the interface for providers which can check and
+// repair the webhooks they registered for entities
+type WebhookReconciler interface {
+	Provider
+
+	// ReconcileWebhook checks that the webhook registered for the entity
+	// exists upstream with the expected URL, secret, events and active flag,
+	// and repairs it otherwise. Entities registered without a webhook are
+	// left alone.
+	ReconcileWebhook(
+		ctx context.Context, entType minderv1.Entity, props *properties.Properties,
+	) (*WebhookReconcileResult, error)
+}
+
 var (
 	// ArtifactTypeContainerRetentionPeriod represents the retention period for container artifacts
 	ArtifactTypeContainerRetentionPeriod = time.Now().AddDate(0, -6, 0)
@@ -167,6 +234,10 @@ type GitHub interface {
 	GetRepository(context.Context, string, string) (*github.Repository, error)
 	GetBranchProtection(context.Context, string, string, string) (*github.Protection, error)
 	UpdateBranchProtection(context.Context, string, string, string, *github.ProtectionRequest) error
+	ListRulesets(ctx context.Context, owner, repo string) ([]*github.Ruleset, error)
+	GetRuleset(ctx context.Context, owner, repo string, id int64) (*github.Ruleset, error)
+	CreateRuleset(ctx context.Context, owner, repo string, ruleset *github.Ruleset) (*github.Ruleset, error)
+	UpdateRuleset(ctx context.Context, owner, repo string, id int64, ruleset *github.Ruleset) (*github.Ruleset, error)
 	ListPackagesByRepository(context.Context, string, string, int64, int, int) ([]*github.Package, error)
 	GetPackageByName(context.Context, string, string, string) (*github.Package, error)
 	GetPackageVersionById(context.Context, string, string, string, int64) (*github.PackageVersion, error)
@@ -202,6 +273,9 @@ type GitHub interface {
 	AddAuthToPushOptions(ctx context.Context, options *git.PushOptions) error
 	StartCheckRun(context.Context, string, string, *github.CreateCheckRunOptions) (*github.CheckRun, error)
 	UpdateCheckRun(context.Context, string, string, int64, *github.UpdateCheckRunOptions) (*github.CheckRun, error)
+	ListCodeScanningAlerts(ctx context.Context, owner, repo string) ([]*github.Alert, error)
+	ListSecretScanningAlerts(ctx context.Context, owner, repo string) ([]*github.SecretScanningAlert, error)
+	ListDependabotAlerts(ctx context.Context, owner, repo string) ([]*github.DependabotAlert, error)
 }
 
 // ImageLister is the interface for listing images
@@ -215,30 +289,41 @@ type ImageLister interface {
 	GetNamespaceURL() string
 }
 
+// ContainerRegistry is the interface for providers hosting a container registry
+// that artifacts can be verified against
+type ContainerRegistry interface {
+	Provider
+
+	// GetRegistry returns the registry name
+	GetRegistry() string
+
+	// GetAuthenticator returns the authenticator for the registry
+	GetAuthenticator() (authn.Authenticator, error)
+}
+
 // OCI is the interface for interacting with OCI registries
 type OCI interface {
 	Provider
 	ArtifactProvider
+	ContainerRegistry
 
 	// GetDigest returns the digest for the given tag of the given container in the given namespace
 	// for the OCI provider.
 	GetDigest(ctx context.Context, name, tag string) (string, error)
 
-	// GetReferrer returns the referrer for the given tag of the given container in the given namespace
-	// for the OCI provider. It returns the referrer as a golang struct given the OCI spec.
-	// TODO - Define the referrer struct
-	GetReferrer(ctx context.Context, name, tag, artifactType string) (any, error)
+	// GetReferrer returns the referrers of the given artifact type for the given tag or digest of the
+	// given container in the given namespace for the OCI provider. It returns the referrers as an
+	// index manifest given the OCI spec.
+	GetReferrer(ctx context.Context, name, tag, artifactType string) (*v1.IndexManifest, error)
 
-	// GetManifest returns the manifest for the given tag of the given container in the given namespace
+	// GetManifest returns the manifest for the given tag or digest of the given container in the given namespace
 	// for the OCI provider. It returns the manifest as a golang struct given the OCI spec.
 	// TODO - Define the manifest struct
 	GetManifest(ctx context.Context, name, tag string) (*v1.Manifest, error)
 
-	// GetRegistry returns the registry name
-	GetRegistry() string
-
-	// GetAuthenticator returns the authenticator for the OCI provider
-	GetAuthenticator() (authn.Authenticator, error)
+	// GetBlob returns the content of the blob with the given digest in the given container, reading at most
+	// maxBytes bytes.
+	GetBlob(ctx context.Context, name, digest string, maxBytes int64) ([]byte, error)
 }
 
 // ParseAndValidate parses the given provider configuration and validates it.
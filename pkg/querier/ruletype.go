@@ -81,7 +81,8 @@ func (q *querierType) UpdateRuleType(
 	if q.ruleSvc == nil {
 		return nil, ErrRuleSvcMissing
 	}
-	return q.ruleSvc.UpdateRuleType(ctx, projectID, subscriptionID, ruleType, q.querier)
+	ruleType, _, err := q.ruleSvc.UpdateRuleType(ctx, projectID, subscriptionID, ruleType, q.querier)
+	return ruleType, err
 }
 
 // CreateRuleType creates a rule type
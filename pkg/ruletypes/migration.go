@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ruletypes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/mindersec/minder/internal/db"
+	"github.com/mindersec/minder/internal/engine/entities"
+	"github.com/mindersec/minder/internal/util/schemaupdate"
+	"github.com/mindersec/minder/internal/util/schemavalidate"
+	pb "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
+)
+
+// schemaVersionChanged returns true if the update moves the rule type to a
+// new schema version, returning an error if the version decreases
+func schemaVersionChanged(oldDef, newDef *pb.RuleType_Definition) (bool, error) {
+	oldVersion, newVersion := oldDef.GetSchemaVersion(), newDef.GetSchemaVersion()
+	if newVersion < oldVersion {
+		return false, fmt.Errorf("%w: schema version cannot decrease from %d to %d",
+			ErrRuleTypeInvalid, oldVersion, newVersion)
+	}
+	return newVersion > oldVersion, nil
+}
+
+// migrateRuleInstances rewrites the rules of the profiles using the rule
+// type with the migration of its new definition, and checks them against
+// the new schemas. Rules are kept as is if there is no migration.
+func migrateRuleInstances(
+	ctx context.Context,
+	ruleTypeID uuid.UUID,
+	newDef *pb.RuleType_Definition,
+	qtx db.Querier,
+) ([]*pb.RuleMigration, error) {
+	migration := &schemaupdate.Migration{}
+	if m := newDef.GetMigration(); m != nil {
+		var err error
+		migration, err = schemaupdate.NewMigration(m.GetType(), m.GetDef(), m.GetParams())
+		if err != nil {
+			return nil, errors.Join(ErrRuleTypeInvalid, err)
+		}
+	}
+
+	ruleSchema, err := schemavalidate.CompileSchemaFromPB(newDef.GetRuleSchema())
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot compile rule schema: %w", ErrRuleTypeInvalid, err)
+	}
+	paramSchema, err := schemavalidate.CompileSchemaFromPB(newDef.GetParamSchema())
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot compile parameter schema: %w", ErrRuleTypeInvalid, err)
+	}
+
+	instances, err := qtx.ListRuleInstancesForRuleType(ctx, ruleTypeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rule instances: %w", err)
+	}
+
+	migrations := make([]*pb.RuleMigration, 0, len(instances))
+	for _, instance := range instances {
+		def, err := unmarshalRuleObject(instance.Def)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse definition of rule %s: %w", instance.Name, err)
+		}
+		params, err := unmarshalRuleObject(instance.Params)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse parameters of rule %s: %w", instance.Name, err)
+		}
+
+		newRuleDef, newParams, err := migration.Apply(ctx, def, params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: rule %s of profile %s: %w",
+				ErrRuleTypeInvalid, instance.Name, instance.ProfileName, err)
+		}
+		if newRuleDef == nil {
+			newRuleDef = map[string]any{}
+		}
+		// Like profiles, rules are only checked against the schemas that are set
+		if ruleSchema != nil {
+			if err := schemavalidate.ValidateAgainstSchema(ruleSchema, newRuleDef); err != nil {
+				return nil, fmt.Errorf("%w: migrated rule %s of profile %s does not match the rule schema: %w",
+					ErrRuleTypeInvalid, instance.Name, instance.ProfileName, err)
+			}
+		}
+		if paramSchema != nil && newParams != nil {
+			if err := schemavalidate.ValidateAgainstSchema(paramSchema, newParams); err != nil {
+				return nil, fmt.Errorf("%w: migrated rule %s of profile %s does not match the parameter schema: %w",
+					ErrRuleTypeInvalid, instance.Name, instance.ProfileName, err)
+			}
+		}
+
+		defBytes, err := json.Marshal(newRuleDef)
+		if err != nil {
+			return nil, fmt.Errorf("cannot serialize definition of rule %s: %w", instance.Name, err)
+		}
+		paramsBytes, err := json.Marshal(newParams)
+		if err != nil {
+			return nil, fmt.Errorf("cannot serialize parameters of rule %s: %w", instance.Name, err)
+		}
+		if err := qtx.UpdateRuleInstanceDefinition(ctx, db.UpdateRuleInstanceDefinitionParams{
+			ID:     instance.ID,
+			Def:    defBytes,
+			Params: paramsBytes,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to update rule %s: %w", instance.Name, err)
+		}
+
+		pbDef, err := structpb.NewStruct(newRuleDef)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert definition of rule %s: %w", instance.Name, err)
+		}
+		pbParams, err := structpb.NewStruct(newParams)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert parameters of rule %s: %w", instance.Name, err)
+		}
+		migrations = append(migrations, &pb.RuleMigration{
+			ProfileId:   instance.ProfileID.String(),
+			ProfileName: instance.ProfileName,
+			ProjectId:   instance.ProjectID.String(),
+			RuleName:    instance.Name,
+			Entity:      entities.EntityTypeFromDB(instance.EntityType),
+			Def:         pbDef,
+			Params:      pbParams,
+		})
+	}
+
+	return migrations, nil
+}
+
+// unmarshalRuleObject parses the definition or parameters of a rule, which
+// may be null
+func unmarshalRuleObject(raw json.RawMessage) (map[string]any, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var obj map[string]any
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
@@ -54,5 +54,5 @@ func WithSuccessfulCreateRuleType(mock RuleTypeSvcMock) {
 func WithSuccessfulUpdateRuleType(mock RuleTypeSvcMock) {
 	mock.EXPECT().
 		UpdateRuleType(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		Return(nil, nil)
+		Return(nil, nil, nil)
 }
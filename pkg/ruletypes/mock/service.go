@@ -59,12 +59,13 @@ func (mr *MockRuleTypeServiceMockRecorder) CreateRuleType(ctx, projectID, subscr
 }
 
 // UpdateRuleType mocks base method.
-func (m *MockRuleTypeService) UpdateRuleType(ctx context.Context, projectID, subscriptionID uuid.UUID, ruleType *v1.RuleType, qtx db.Querier) (*v1.RuleType, error) {
+func (m *MockRuleTypeService) UpdateRuleType(ctx context.Context, projectID, subscriptionID uuid.UUID, ruleType *v1.RuleType, qtx db.Querier) (*v1.RuleType, []*v1.RuleMigration, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateRuleType", ctx, projectID, subscriptionID, ruleType, qtx)
 	ret0, _ := ret[0].(*v1.RuleType)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].([]*v1.RuleMigration)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // UpdateRuleType indicates an expected call of UpdateRuleType.
@@ -46,15 +46,18 @@ type RuleTypeService interface {
 
 	// UpdateRuleType updates rule types in the database
 	// the new rule type is validated, and backwards compatibility verified
+	// unless the update moves it to a new schema version, in which case the
+	// rules of the profiles using it are migrated to the new schemas
 	// if the rule does not already exist - this will return an error
-	// returns the pb definition of the updated rule type on success
+	// returns the pb definition of the updated rule type and the migrated
+	// rules on success
 	UpdateRuleType(
 		ctx context.Context,
 		projectID uuid.UUID,
 		subscriptionID uuid.UUID,
 		ruleType *pb.RuleType,
 		qtx db.Querier,
-	) (*pb.RuleType, error)
+	) (*pb.RuleType, []*pb.RuleMigration, error)
 
 	// UpsertRuleType creates the rule type if it does not exist
 	// or updates it if it already exists. This is used in the subscription
@@ -185,12 +188,12 @@ func (*ruleTypeService) UpdateRuleType(
 	subscriptionID uuid.UUID,
 	ruleType *pb.RuleType,
 	qtx db.Querier,
-) (*pb.RuleType, error) {
+) (*pb.RuleType, []*pb.RuleMigration, error) {
 	// Telemetry logging
 	logger.BusinessRecord(ctx).Project = projectID
 
 	if err := ruleType.Validate(); err != nil {
-		return nil, errors.Join(ErrRuleTypeInvalid, err)
+		return nil, nil, errors.Join(ErrRuleTypeInvalid, err)
 	}
 
 	ruleTypeName := ruleType.GetName()
@@ -203,35 +206,35 @@ func (*ruleTypeService) UpdateRuleType(
 	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrRuleNotFound
+			return nil, nil, ErrRuleNotFound
 		}
-		return nil, fmt.Errorf("failed to get rule type: %w", err)
+		return nil, nil, fmt.Errorf("failed to get rule type: %w", err)
 	}
 
 	if err = namespaces.DoesSubscriptionIDMatch(subscriptionID, oldRuleType.SubscriptionID); err != nil {
-		return nil, errors.Join(ErrRuleTypeInvalid, err)
+		return nil, nil, errors.Join(ErrRuleTypeInvalid, err)
 	}
 
 	// extra validation applies when updating rules to make sure the update
 	// does not break profiles which use the rule
-	err = validateRuleUpdate(&oldRuleType, ruleType)
+	migrate, err := validateRuleUpdate(&oldRuleType, ruleType)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	serializedRule, err := util.GetBytesFromProto(ruleTypeDef)
 	if err != nil {
-		return nil, fmt.Errorf("cannot convert rule definition to db: %w", err)
+		return nil, nil, fmt.Errorf("cannot convert rule definition to db: %w", err)
 	}
 
 	severity, err := getRuleTypeSeverity(ruleType.GetSeverity())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	releasePhase, err := GetDBReleaseStatusFromPBReleasePhase(ruleType.GetReleasePhase())
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	ruleType = ruleType.WithDefaultDisplayName().WithDefaultShortFailureMessage()
@@ -245,12 +248,12 @@ func (*ruleTypeService) UpdateRuleType(
 		ReleasePhase:        *releasePhase,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update rule type: %w", err)
+		return nil, nil, fmt.Errorf("failed to update rule type: %w", err)
 	}
 
 	projects, err := qtx.GetParentProjects(ctx, projectID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get parent projects: %w", err)
+		return nil, nil, fmt.Errorf("failed to get parent projects: %w", err)
 	}
 
 	// Data Sources reference update. Note that this step can be
@@ -258,17 +261,27 @@ func (*ruleTypeService) UpdateRuleType(
 	// we need from the previous code is project id and rule id.
 	ds := ruleTypeDef.GetEval().GetDataSources()
 	if err := processDataSources(ctx, oldRuleType.ID, ds, projectID, projects, qtx); err != nil {
-		return nil, fmt.Errorf("failed updating references to data sources: %w", err)
+		return nil, nil, fmt.Errorf("failed updating references to data sources: %w", err)
+	}
+
+	// Rewrite the rules of the profiles for the new schema version. Any
+	// rule failing to migrate aborts the update.
+	var migrations []*pb.RuleMigration
+	if migrate {
+		migrations, err = migrateRuleInstances(ctx, oldRuleType.ID, ruleTypeDef, qtx)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	logger.BusinessRecord(ctx).RuleType = logger.RuleType{Name: oldRuleType.Name, ID: oldRuleType.ID}
 
 	result, err := RuleTypePBFromDB(&updatedRuleType)
 	if err != nil {
-		return nil, fmt.Errorf("cannot convert rule type %s to pb: %w", oldRuleType.Name, err)
+		return nil, nil, fmt.Errorf("cannot convert rule type %s to pb: %w", oldRuleType.Name, err)
 	}
 
-	return result, nil
+	return result, migrations, nil
 }
 
 func (s *ruleTypeService) UpsertRuleType(
@@ -290,7 +303,7 @@ func (s *ruleTypeService) UpsertRuleType(
 	}
 
 	// If we get here: rule already exists. Let's update it.
-	_, err = s.UpdateRuleType(ctx, projectID, subscriptionID, ruleType, qtx)
+	_, _, err = s.UpdateRuleType(ctx, projectID, subscriptionID, ruleType, qtx)
 	if err != nil {
 		return fmt.Errorf("error while updating rule: %w", err)
 	}
@@ -310,27 +323,36 @@ func getRuleTypeSeverity(severity *pb.Severity) (*db.Severity, error) {
 	return &seval, nil
 }
 
-func validateRuleUpdate(existingRecord *db.RuleType, newRuleType *pb.RuleType) error {
+// validateRuleUpdate checks that the update does not break the profiles
+// using the rule type. It returns true if the update moves the rule type to
+// a new schema version, in which case the rules of the profiles must be
+// migrated instead.
+func validateRuleUpdate(existingRecord *db.RuleType, newRuleType *pb.RuleType) (bool, error) {
 	oldRuleType, err := RuleTypePBFromDB(existingRecord)
 	if err != nil {
-		return fmt.Errorf("cannot convert rule type %s to pb: %w", newRuleType.GetName(), err)
+		return false, fmt.Errorf("cannot convert rule type %s to pb: %w", newRuleType.GetName(), err)
 	}
 
 	oldDef := oldRuleType.GetDef()
 	newDef := newRuleType.GetDef()
 
+	migrate, err := schemaVersionChanged(oldDef, newDef)
+	if err != nil || migrate {
+		return migrate, err
+	}
+
 	// In case we have profiles that use this rule type, we need to validate
 	// that the incoming rule is valid against the old rule. Unlike previous
 	// iterations of this code, the checks are carried out irrespective of
 	// whether any profiles currently use this rule type.
 	if err := schemaupdate.ValidateSchemaUpdate(oldDef.GetRuleSchema(), newDef.GetRuleSchema()); err != nil {
-		return fmt.Errorf("%w: rule schema update is invalid: %w", ErrRuleTypeInvalid, err)
+		return false, fmt.Errorf("%w: rule schema update is invalid: %w", ErrRuleTypeInvalid, err)
 	}
 	if err := schemaupdate.ValidateSchemaUpdate(oldDef.GetParamSchema(), newDef.GetParamSchema()); err != nil {
-		return fmt.Errorf("%w: parameter schema update is invalid: %w", ErrRuleTypeInvalid, err)
+		return false, fmt.Errorf("%w: parameter schema update is invalid: %w", ErrRuleTypeInvalid, err)
 	}
 
-	return nil
+	return false, nil
 }
 
 func processDataSources(
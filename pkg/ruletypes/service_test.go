@@ -179,6 +179,22 @@ func TestRuleTypeService(t *testing.T) {
 			DBSetup:       dbf.NewDBMock(withSuccessfulGet, withFailedUpdate),
 			TestMethod:    update,
 		},
+		{
+			Name:     "UpdateRuleType migrates rules to a new schema version",
+			RuleType: newRuleType(withBasicStructure, withIncompatibleDef, withMigration),
+			DBSetup: dbf.NewDBMock(withHierarchyGet, withSuccessfulGet, withSuccessfulUpdate,
+				withSuccessfulDeleteRuleTypeDataSource, withRuleInstances(`{"language": "go"}`),
+				withSuccessfulRuleInstanceUpdate),
+			TestMethod: update,
+		},
+		{
+			Name:          "UpdateRuleType rejects migrated rules not matching the new schema",
+			RuleType:      newRuleType(withBasicStructure, withIncompatibleDef, withMigration),
+			ExpectedError: "does not match the rule schema",
+			DBSetup: dbf.NewDBMock(withHierarchyGet, withSuccessfulGet, withSuccessfulUpdate,
+				withSuccessfulDeleteRuleTypeDataSource, withRuleInstances(`{"language": 1}`)),
+			TestMethod: update,
+		},
 		{
 			Name:       "UpdateRuleType successfully updates an existing rule",
 			RuleType:   newRuleType(withBasicStructure),
@@ -325,7 +341,7 @@ func TestRuleTypeService(t *testing.T) {
 					store,
 				)
 			case update:
-				res, err = svc.UpdateRuleType(
+				res, _, err = svc.UpdateRuleType(
 					ctx,
 					projectID,
 					scenario.SubscriptionID,
@@ -449,6 +465,58 @@ func withIncompatibleParams(ruleType *pb.RuleType) {
 	ruleType.Def.ParamSchema = incompatibleSchema
 }
 
+// withMigration moves the rule type to a new schema version, which expects
+// a list of languages instead of a single one
+func withMigration(ruleType *pb.RuleType) {
+	ruleType.Def.SchemaVersion = 1
+	ruleType.Def.RuleSchema = &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"type":     structpb.NewStringValue("object"),
+			"required": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("languages")}}),
+			"properties": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+				"languages": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+					"type": structpb.NewStringValue("array"),
+					"items": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+						"type": structpb.NewStringValue("string"),
+					}}),
+				}}),
+			}}),
+		},
+	}
+	ruleType.Def.Migration = &pb.RuleType_Definition_Migration{
+		Type: "jq",
+		Def:  ".def | {languages: [.language]}",
+	}
+}
+
+func withRuleInstances(def string) func(dbf.DBMock) {
+	return func(mock dbf.DBMock) {
+		mock.EXPECT().
+			ListRuleInstancesForRuleType(gomock.Any(), ruleTypeID).
+			Return([]db.ListRuleInstancesForRuleTypeRow{{
+				ID:          uuid.New(),
+				ProfileID:   uuid.New(),
+				Name:        "rule",
+				EntityType:  db.EntitiesRepository,
+				Def:         []byte(def),
+				Params:      []byte(`{}`),
+				ProjectID:   projectID,
+				ProfileName: "profile",
+			}}, nil)
+	}
+}
+
+func withSuccessfulRuleInstanceUpdate(mock dbf.DBMock) {
+	mock.EXPECT().
+		UpdateRuleInstanceDefinition(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.UpdateRuleInstanceDefinitionParams) error {
+			if string(arg.Def) != `{"languages":["go"]}` {
+				return fmt.Errorf("unexpected migrated definition %s", arg.Def)
+			}
+			return nil
+		})
+}
+
 func withHierarchyGet(mock dbf.DBMock) {
 	mock.EXPECT().
 		GetParentProjects(gomock.Any(), gomock.Any()).
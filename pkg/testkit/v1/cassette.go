@@ -0,0 +1,343 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CassetteVersion is the version of the cassette file format
+const CassetteVersion = "v1"
+
+// scrubbed replaces the secrets removed from a cassette
+const scrubbed = "REDACTED"
+
+var (
+	// ErrNotRecorded is returned when replaying a request or a clone which
+	// is not in the cassette
+	ErrNotRecorded = errors.New("not recorded in cassette")
+
+	// sensitiveHeaders are the response headers which are never recorded
+	sensitiveHeaders = []string{
+		"Authorization",
+		"Cookie",
+		"Proxy-Authorization",
+		"Set-Cookie",
+		"X-Api-Key",
+	}
+
+	// sensitiveParams are the query parameters whose values are scrubbed
+	sensitiveParams = []string{
+		"access_token",
+		"api_key",
+		"client_secret",
+		"key",
+		"private_token",
+		"token",
+	}
+)
+
+// Cassette holds the HTTP exchanges and git clones made by providers and
+// data sources, so that a rule type can be evaluated again without network
+// access. Secrets are scrubbed from the cassette as it is recorded.
+type Cassette struct {
+	Version string `yaml:"version"`
+	// BaseURL is the base URL of the REST API of the recorded provider
+	BaseURL      string        `yaml:"base_url,omitempty"`
+	Interactions []Interaction `yaml:"interactions,omitempty"`
+	Clones       []Clone       `yaml:"clones,omitempty"`
+
+	mu      sync.Mutex
+	secrets []string
+	// replayed tracks the interactions already served during replay
+	replayed map[int]bool
+}
+
+// Interaction is a recorded HTTP exchange
+type Interaction struct {
+	Request  RecordedRequest  `yaml:"request"`
+	Response RecordedResponse `yaml:"response"`
+}
+
+// RecordedRequest is the request of a recorded HTTP exchange. Request
+// headers are not recorded, as they mostly carry credentials.
+type RecordedRequest struct {
+	Method string `yaml:"method"`
+	URL    string `yaml:"url"`
+	Body   string `yaml:"body,omitempty"`
+}
+
+// RecordedResponse is the response of a recorded HTTP exchange
+type RecordedResponse struct {
+	Status  int               `yaml:"status"`
+	Headers map[string]string `yaml:"headers,omitempty"`
+	Body    string            `yaml:"body,omitempty"`
+}
+
+// Clone is a recorded git clone, holding the files of the worktree
+type Clone struct {
+	URL    string            `yaml:"url"`
+	Branch string            `yaml:"branch,omitempty"`
+	Files  map[string]string `yaml:"files,omitempty"`
+}
+
+// NewCassette creates an empty cassette to record into
+func NewCassette() *Cassette {
+	return &Cassette{
+		Version: CassetteVersion,
+	}
+}
+
+// LoadCassette reads a cassette from a file
+func LoadCassette(path string) (*Cassette, error) {
+	contents, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("error reading cassette: %w", err)
+	}
+
+	c := &Cassette{}
+	if err := yaml.Unmarshal(contents, c); err != nil {
+		return nil, fmt.Errorf("error parsing cassette %s: %w", path, err)
+	}
+	if c.Version != CassetteVersion {
+		return nil, fmt.Errorf("unsupported cassette version %q in %s", c.Version, path)
+	}
+	return c, nil
+}
+
+// Save writes the cassette to a file
+func (c *Cassette) Save(path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(c); err != nil {
+		return fmt.Errorf("error encoding cassette: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("error encoding cassette: %w", err)
+	}
+	//nolint:gosec // the cassette is meant to be readable, and holds no secrets
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
+
+// AddSecrets registers values, e.g. provider tokens, which are replaced
+// wherever they appear in the recorded exchanges and clones
+func (c *Cassette) AddSecrets(secrets ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, s := range secrets {
+		if s != "" {
+			c.secrets = append(c.secrets, s)
+		}
+	}
+}
+
+// RecordingTransport returns an HTTP transport which sends requests through
+// the given transport and records the exchanges into the cassette
+func (c *Cassette) RecordingTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return c.record(req, base.RoundTrip)
+	})
+}
+
+// ReplayingTransport returns an HTTP transport which serves the requests
+// from the exchanges recorded in the cassette
+func (c *Cassette) ReplayingTransport() http.RoundTripper {
+	return roundTripperFunc(c.replay)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// record performs the request and records the exchange
+func (c *Cassette) record(
+	req *http.Request, do func(*http.Request) (*http.Response, error),
+) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+
+	resp, err := do(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	headers := make(map[string]string, len(resp.Header))
+	for k := range resp.Header {
+		headers[k] = resp.Header.Get(k)
+	}
+	for _, h := range sensitiveHeaders {
+		delete(headers, http.CanonicalHeaderKey(h))
+	}
+	// Scrubbing may change the length of the body
+	delete(headers, "Content-Length")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, v := range headers {
+		headers[k] = c.scrub(v)
+	}
+	c.Interactions = append(c.Interactions, Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    c.scrub(scrubURL(req.URL)),
+			Body:   c.scrub(string(reqBody)),
+		},
+		Response: RecordedResponse{
+			Status:  resp.StatusCode,
+			Headers: headers,
+			Body:    c.scrub(string(respBody)),
+		},
+	})
+	return resp, nil
+}
+
+// replay serves a request from the recorded exchanges. Exchanges are served
+// in the order they were recorded; once all the matching exchanges were
+// served, the last one is served again.
+func (c *Cassette) replay(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+	reqURL := scrubURL(req.URL)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.replayed == nil {
+		c.replayed = make(map[int]bool)
+	}
+	match := -1
+	for i, in := range c.Interactions {
+		if in.Request.Method != req.Method || in.Request.URL != reqURL || !bodiesMatch(in.Request.Body, reqBody) {
+			continue
+		}
+		match = i
+		if !c.replayed[i] {
+			break
+		}
+	}
+	if match < 0 {
+		return nil, fmt.Errorf("%w: %s %s", ErrNotRecorded, req.Method, reqURL)
+	}
+	c.replayed[match] = true
+
+	recorded := c.Interactions[match].Response
+	rec := httptest.NewRecorder()
+	for k, v := range recorded.Headers {
+		rec.Header().Set(k, v)
+	}
+	rec.WriteHeader(recorded.Status)
+	_, _ = rec.WriteString(recorded.Body)
+	resp := rec.Result()
+	resp.Request = req
+	return resp, nil
+}
+
+func (c *Cassette) recordClone(clone Clone) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clone.URL = c.scrub(clone.URL)
+	for name, contents := range clone.Files {
+		clone.Files[name] = c.scrub(contents)
+	}
+	c.Clones = append(c.Clones, clone)
+}
+
+func (c *Cassette) findClone(cloneURL, branch string) (*Clone, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := range c.Clones {
+		if c.Clones[i].URL == cloneURL && c.Clones[i].Branch == branch {
+			return &c.Clones[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: clone of %s at branch %q", ErrNotRecorded, cloneURL, branch)
+}
+
+// scrub replaces the registered secrets. Must be called with the lock held.
+func (c *Cassette) scrub(s string) string {
+	for _, secret := range c.secrets {
+		s = strings.ReplaceAll(s, secret, scrubbed)
+	}
+	return s
+}
+
+// bodiesMatch compares a recorded request body with the body of a request
+// being replayed. JSON bodies match when they hold the same value, as
+// clients differ in how they encode them.
+func bodiesMatch(recorded string, body []byte) bool {
+	if recorded == string(body) {
+		return true
+	}
+	var want, got any
+	if json.Unmarshal([]byte(recorded), &want) != nil || json.Unmarshal(body, &got) != nil {
+		return false
+	}
+	return reflect.DeepEqual(want, got)
+}
+
+// scrubURL returns the URL with the values of sensitive query parameters and
+// user info scrubbed
+func scrubURL(u *url.URL) string {
+	scrubbedURL := *u
+	if scrubbedURL.User != nil {
+		scrubbedURL.User = url.User(scrubbed)
+	}
+	query := scrubbedURL.Query()
+	changed := false
+	for _, p := range sensitiveParams {
+		if query.Has(p) {
+			query.Set(p, scrubbed)
+			changed = true
+		}
+	}
+	if changed {
+		scrubbedURL.RawQuery = query.Encode()
+	}
+	return scrubbedURL.String()
+}
+
+// readBody reads a request or response body, replacing it so that it can be
+// read again
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	contents, err := io.ReadAll(*body)
+	if err != nil {
+		return nil, err
+	}
+	_ = (*body).Close()
+	*body = io.NopCloser(bytes.NewReader(contents))
+	return contents, nil
+}
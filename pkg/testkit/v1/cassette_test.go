@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	t.Parallel()
+
+	const secret = "s3cr3t-token"
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Set-Cookie", "session="+secret)
+		w.Header().Set("X-Request", r.URL.Path)
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+		_, _ = io.WriteString(w, `{"token":"`+secret+`","path":"`+r.URL.Path+`"}`)
+	}))
+	defer server.Close()
+
+	recording := NewCassette()
+	recording.AddSecrets(secret)
+	cli := &http.Client{Transport: recording.RecordingTransport(http.DefaultTransport)}
+
+	resp, err := cli.Get(server.URL + "/repos?access_token=" + secret)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	// The caller sees the live response, only the cassette is scrubbed
+	require.Contains(t, string(body), secret)
+
+	resp, err = cli.Post(server.URL+"/hooks", "application/json", strings.NewReader(`{"a": 1}`))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	path := filepath.Join(t.TempDir(), "cassette.yaml")
+	require.NoError(t, recording.Save(path))
+	replaying, err := LoadCassette(path)
+	require.NoError(t, err)
+
+	require.Len(t, replaying.Interactions, 2)
+	for _, in := range replaying.Interactions {
+		require.NotContains(t, in.Request.URL, secret)
+		require.NotContains(t, in.Response.Body, secret)
+		require.NotContains(t, in.Response.Headers, "Set-Cookie")
+	}
+
+	cli = &http.Client{Transport: replaying.ReplayingTransport()}
+	for i := 0; i < 2; i++ {
+		resp, err = cli.Get(server.URL + "/repos?access_token=other")
+		require.NoError(t, err)
+		body, err = io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "/repos", resp.Header.Get("X-Request"))
+		require.JSONEq(t, `{"token":"REDACTED","path":"/repos"}`, string(body))
+	}
+
+	// JSON bodies match regardless of their encoding
+	resp, err = cli.Post(server.URL+"/hooks", "application/json", strings.NewReader("{\"a\":1}\n"))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	_, err = cli.Get(server.URL + "/unknown")
+	require.ErrorIs(t, err, ErrNotRecorded)
+	require.Equal(t, 2, calls)
+}
+
+func TestTestKitReplay(t *testing.T) {
+	t.Parallel()
+
+	cassette := &Cassette{
+		Version: CassetteVersion,
+		BaseURL: "https://api.example.com/",
+		Interactions: []Interaction{{
+			Request:  RecordedRequest{Method: http.MethodGet, URL: "https://api.example.com/repos/foo"},
+			Response: RecordedResponse{Status: http.StatusOK, Body: `{"name":"foo"}`},
+		}},
+		Clones: []Clone{{
+			URL:    "https://example.com/foo.git",
+			Branch: "main",
+			Files:  map[string]string{"README.md": "hello", ".github/workflows/ci.yml": "on: push"},
+		}},
+	}
+	tk := NewTestKit(WithReplay(cassette))
+
+	req, err := tk.NewRequest(http.MethodGet, "repos/foo", nil)
+	require.NoError(t, err)
+	resp, err := tk.Do(context.Background(), req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, `{"name":"foo"}`, string(body))
+	require.Len(t, tk.Requests(), 1)
+
+	repo, err := tk.Clone(context.Background(), "https://example.com/foo.git", "main")
+	require.NoError(t, err)
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+	contents, err := util.ReadFile(wt.Filesystem, ".github/workflows/ci.yml")
+	require.NoError(t, err)
+	require.Equal(t, "on: push", string(contents))
+	_, err = repo.Head()
+	require.NoError(t, err)
+
+	_, err = tk.Clone(context.Background(), "https://example.com/foo.git", "other")
+	require.ErrorIs(t, err, ErrNotRecorded)
+}
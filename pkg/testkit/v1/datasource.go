@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: Copyright 2025 The Minder Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	v1datasources "github.com/mindersec/minder/pkg/datasources/v1"
+	"github.com/mindersec/minder/pkg/engine/v1/interfaces"
+)
+
+var (
+	// ErrNoDataSourceResponse is returned when a function of a FakeDataSource
+	// is called more times than it has responses
+	ErrNoDataSourceResponse = errors.New("no data source response left")
+)
+
+// FakeDataSource is a data source whose functions return mocked responses,
+// in order, one per call
+type FakeDataSource struct {
+	funcs map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef
+}
+
+// Ensure that FakeDataSource implements the DataSource interface
+var _ v1datasources.DataSource = &FakeDataSource{}
+
+// NewFakeDataSource creates a FakeDataSource with the given responses for
+// each of its functions
+func NewFakeDataSource(responses map[string][]any) *FakeDataSource {
+	funcs := make(map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef, len(responses))
+	for name, resps := range responses {
+		funcs[v1datasources.DataSourceFuncKey(name)] = &fakeDataSourceFunc{
+			name:      name,
+			responses: resps,
+		}
+	}
+	return &FakeDataSource{funcs: funcs}
+}
+
+// GetFuncs implements the DataSource interface
+func (f *FakeDataSource) GetFuncs() map[v1datasources.DataSourceFuncKey]v1datasources.DataSourceFuncDef {
+	return f.funcs
+}
+
+type fakeDataSourceFunc struct {
+	name      string
+	mu        sync.Mutex
+	responses []any
+}
+
+func (*fakeDataSourceFunc) ValidateArgs(_ any) error {
+	return nil
+}
+
+func (*fakeDataSourceFunc) ValidateUpdate(_ *structpb.Struct) error {
+	return nil
+}
+
+func (f *fakeDataSourceFunc) Call(_ context.Context, _ *interfaces.Ingested, _ any) (any, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.responses) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoDataSourceResponse, f.name)
+	}
+	resp := f.responses[0]
+	f.responses = f.responses[1:]
+	return resp, nil
+}
+
+func (*fakeDataSourceFunc) GetArgsSchema() *structpb.Struct {
+	return nil
+}
@@ -7,8 +7,10 @@ package v1
 
 import (
 	"net/http/httptest"
+	"sync"
 
 	"github.com/mindersec/minder/internal/engine/ingester/git"
+	provv1 "github.com/mindersec/minder/pkg/providers/v1"
 )
 
 // TestKit implements a set of interfaces for testing
@@ -23,6 +25,15 @@ type TestKit struct {
 	httpStatus   int
 	httpBody     []byte
 	httpHeaders  map[string]string
+
+	mu            sync.Mutex
+	httpResponses []HTTPResponse
+	httpRequests  []HTTPRequest
+
+	// cassette records or replays HTTP exchanges and git clones. When
+	// recording, the live provider performs them.
+	cassette *Cassette
+	live     provv1.Provider
 }
 
 // Option is a functional option type for TestKit
@@ -48,6 +59,28 @@ func WithHTTP(status int, body []byte, headers map[string]string) Option {
 	}
 }
 
+// WithRecording is a functional option to perform the HTTP requests and git
+// clones with a live provider, recording them into the cassette
+func WithRecording(live provv1.Provider, cassette *Cassette) Option {
+	return func(tp *TestKit) {
+		tp.live = live
+		tp.cassette = cassette
+		// Replays resolve relative URLs against the base URL of the provider
+		if rest, ok := live.(provv1.REST); ok {
+			cassette.BaseURL = rest.GetBaseURL()
+		}
+	}
+}
+
+// WithReplay is a functional option to serve the HTTP requests and git
+// clones from a recorded cassette
+func WithReplay(cassette *Cassette) Option {
+	return func(tp *TestKit) {
+		tp.live = nil
+		tp.cassette = cassette
+	}
+}
+
 // NewTestKit creates a new TestKit
 func NewTestKit(opts ...Option) *TestKit {
 	pt := &TestKit{
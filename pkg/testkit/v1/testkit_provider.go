@@ -6,8 +6,16 @@ package v1
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"time"
 
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	minderv1 "github.com/mindersec/minder/pkg/api/protobuf/go/minder/v1"
@@ -78,9 +86,94 @@ func (*TestKit) PropertiesToProtoMessage(_ minderv1.Entity, _ *properties.Proper
 	return nil, nil
 }
 
-// Clone Implements the Git trait. This is a stub implementation that allows us to instantiate a Git ingester.
-// This will later be overridden by the actual implementation.
-func (*TestKit) Clone(_ context.Context, _ string, _ string) (*git.Repository, error) {
-	// Note that this should not be called. If it is, it means that the ingester has not been overridden.
-	return nil, ErrNotIngesterOverridden
+// Clone Implements the Git trait. When recording or replaying, the clone is
+// recorded into or served from the cassette. Otherwise, this is a stub
+// implementation that allows us to instantiate a Git ingester, which will
+// later be overridden by the actual implementation.
+func (tk *TestKit) Clone(ctx context.Context, url string, branch string) (*git.Repository, error) {
+	if tk.cassette == nil {
+		// Note that this should not be called. If it is, it means that the ingester has not been overridden.
+		return nil, ErrNotIngesterOverridden
+	}
+
+	if tk.live == nil {
+		clone, err := tk.cassette.findClone(url, branch)
+		if err != nil {
+			return nil, err
+		}
+		return repositoryFromFiles(clone.Files)
+	}
+
+	gitProv, ok := tk.live.(provv1.Git)
+	if !ok {
+		return nil, fmt.Errorf("provider does not implement git: %w", ErrNotIngesterOverridden)
+	}
+	repo, err := gitProv.Clone(ctx, url, branch)
+	if err != nil {
+		return nil, err
+	}
+	files, err := worktreeFiles(repo)
+	if err != nil {
+		return nil, fmt.Errorf("error recording clone: %w", err)
+	}
+	tk.cassette.recordClone(Clone{URL: url, Branch: branch, Files: files})
+	return repo, nil
+}
+
+// worktreeFiles returns the contents of the files in the worktree of a
+// repository
+func worktreeFiles(repo *git.Repository) (map[string]string, error) {
+	wt, err := repo.Worktree()
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]string)
+	err = util.Walk(wt.Filesystem, "/", func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !info.Mode().IsRegular() {
+			return nil
+		}
+		contents, err := util.ReadFile(wt.Filesystem, path)
+		if err != nil {
+			return err
+		}
+		files[strings.TrimPrefix(path, "/")] = string(contents)
+		return nil
+	})
+	return files, err
+}
+
+// repositoryFromFiles creates an in-memory repository with a single commit
+// holding the given files
+func repositoryFromFiles(files map[string]string) (*git.Repository, error) {
+	memFS := memfs.New()
+	repo, err := git.Init(memory.NewStorage(), memFS)
+	if err != nil {
+		return nil, fmt.Errorf("error creating repository: %w", err)
+	}
+	for name, contents := range files {
+		if err := util.WriteFile(memFS, name, []byte(contents), 0600); err != nil {
+			return nil, fmt.Errorf("error writing %s: %w", name, err)
+		}
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return nil, err
+	}
+	if err := wt.AddWithOptions(&git.AddOptions{All: true}); err != nil {
+		return nil, fmt.Errorf("error adding files: %w", err)
+	}
+	// A fixed signature keeps the commit hash stable across replays
+	sig := &object.Signature{Name: "minder", Email: "minder@example.com", When: time.Unix(0, 0).UTC()}
+	if _, err := wt.Commit("replayed clone", &git.CommitOptions{
+		Author:            sig,
+		AllowEmptyCommits: true,
+	}); err != nil {
+		return nil, fmt.Errorf("error committing files: %w", err)
+	}
+	return repo, nil
 }
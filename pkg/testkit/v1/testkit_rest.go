@@ -6,23 +6,94 @@ package v1
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	neturl "net/url"
 
 	"github.com/rs/zerolog"
+
+	provv1 "github.com/mindersec/minder/pkg/providers/v1"
+)
+
+var (
+	// ErrUnexpectedRequest is returned when an HTTP request does not match the
+	// next mocked response, or there are no mocked responses left
+	ErrUnexpectedRequest = errors.New("unexpected HTTP request")
 )
 
+// HTTPResponse is a mocked HTTP response. If Method or Path are set, the
+// request must match them.
+type HTTPResponse struct {
+	Method  string
+	Path    string
+	Status  int
+	Body    []byte
+	Headers map[string]string
+}
+
+// HTTPRequest is an HTTP request made through the TestKit
+type HTTPRequest struct {
+	Method string
+	URL    string
+	Body   []byte
+}
+
+// WithHTTPResponses is a functional option to set a sequence of HTTP
+// responses, which are returned in order, one per request
+func WithHTTPResponses(responses ...HTTPResponse) Option {
+	return func(tp *TestKit) {
+		tp.httpResponses = append(tp.httpResponses, responses...)
+	}
+}
+
 // GetBaseURL implements the REST interface.
-func (*TestKit) GetBaseURL() string {
+func (tk *TestKit) GetBaseURL() string {
+	if rest, ok := tk.liveREST(); ok {
+		return rest.GetBaseURL()
+	}
+	if tk.cassette != nil {
+		return tk.cassette.BaseURL
+	}
 	return ""
 }
 
-// NewRequest implements the REST interface.
-func (*TestKit) NewRequest(method, url string, body any) (*http.Request, error) {
+// NewRequest implements the REST interface. Like the REST clients of the
+// providers, it accepts raw bodies as well as values to encode as JSON.
+func (tk *TestKit) NewRequest(method, url string, body any) (*http.Request, error) {
+	if rest, ok := tk.liveREST(); ok {
+		return rest.NewRequest(method, url, body)
+	}
+
 	var r io.Reader
-	if body != nil {
-		r = bytes.NewReader(body.([]byte))
+	switch b := body.(type) {
+	case nil:
+	case []byte:
+		r = bytes.NewReader(b)
+	case io.Reader:
+		r = b
+	default:
+		buf, err := json.Marshal(b)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding request body: %w", err)
+		}
+		r = bytes.NewReader(buf)
+	}
+
+	// When replaying, relative URLs are resolved like the recorded provider did
+	if tk.cassette != nil && tk.cassette.BaseURL != "" {
+		base, err := neturl.Parse(tk.cassette.BaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing base URL: %w", err)
+		}
+		ref, err := neturl.Parse(url)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing URL: %w", err)
+		}
+		url = base.ResolveReference(ref).String()
 	}
 	return httptest.NewRequest(method, url, r), nil
 }
@@ -35,16 +106,99 @@ func (tk *TestKit) Do(ctx context.Context, req *http.Request) (*http.Response, e
 		Str("url", req.URL.String()).
 		Msg("HTTP request")
 
-	h := func(w http.ResponseWriter, _ *http.Request) {
-		for k, v := range tk.httpHeaders {
-			w.Header().Set(k, v)
-		}
+	if err := tk.recordRequest(req); err != nil {
+		return nil, err
+	}
 
-		w.WriteHeader(tk.httpStatus)
-		_, _ = w.Write(tk.httpBody)
+	if rest, ok := tk.liveREST(); ok {
+		return tk.cassette.record(req, func(req *http.Request) (*http.Response, error) {
+			return rest.Do(ctx, req)
+		})
+	}
+	if tk.cassette != nil {
+		return tk.cassette.replay(req)
 	}
 
-	h(tk.httpRecorder, req)
+	resp, err := tk.nextResponse(req)
+	if err != nil {
+		return nil, err
+	}
+
+	rec := httptest.NewRecorder()
+	for k, v := range resp.Headers {
+		rec.Header().Set(k, v)
+	}
+	rec.WriteHeader(resp.Status)
+	_, _ = rec.Write(resp.Body)
 
-	return tk.httpRecorder.Result(), nil
+	return rec.Result(), nil
+}
+
+// liveREST returns the REST trait of the live provider, when recording
+func (tk *TestKit) liveREST() (provv1.REST, bool) {
+	if tk.live == nil || tk.cassette == nil {
+		return nil, false
+	}
+	rest, ok := tk.live.(provv1.REST)
+	return rest, ok
+}
+
+// Requests returns the HTTP requests made through the TestKit so far
+func (tk *TestKit) Requests() []HTTPRequest {
+	tk.mu.Lock()
+	defer tk.mu.Unlock()
+	return append([]HTTPRequest(nil), tk.httpRequests...)
+}
+
+// ResetRequests forgets the HTTP requests made through the TestKit so far
+func (tk *TestKit) ResetRequests() {
+	tk.mu.Lock()
+	defer tk.mu.Unlock()
+	tk.httpRequests = nil
+}
+
+func (tk *TestKit) recordRequest(req *http.Request) error {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		if err != nil {
+			return fmt.Errorf("error reading request body: %w", err)
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	tk.mu.Lock()
+	defer tk.mu.Unlock()
+	tk.httpRequests = append(tk.httpRequests, HTTPRequest{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Body:   body,
+	})
+	return nil
+}
+
+// nextResponse returns the response set with WithHTTP, if any, or else the
+// next one of the sequence set with WithHTTPResponses
+func (tk *TestKit) nextResponse(req *http.Request) (*HTTPResponse, error) {
+	if tk.httpRecorder != nil {
+		return &HTTPResponse{
+			Status:  tk.httpStatus,
+			Body:    tk.httpBody,
+			Headers: tk.httpHeaders,
+		}, nil
+	}
+
+	tk.mu.Lock()
+	defer tk.mu.Unlock()
+	if len(tk.httpResponses) == 0 {
+		return nil, fmt.Errorf("%w: %s %s, no responses left", ErrUnexpectedRequest, req.Method, req.URL)
+	}
+	resp := tk.httpResponses[0]
+	if (resp.Method != "" && resp.Method != req.Method) || (resp.Path != "" && resp.Path != req.URL.Path) {
+		return nil, fmt.Errorf("%w: %s %s, expected %s %s",
+			ErrUnexpectedRequest, req.Method, req.URL.Path, resp.Method, resp.Path)
+	}
+	tk.httpResponses = tk.httpResponses[1:]
+	return &resp, nil
 }